@@ -16,6 +16,7 @@ import (
 	"github.com/siderolabs/talos/internal/app/machined/pkg/runtime"
 	"github.com/siderolabs/talos/internal/app/machined/pkg/runtime/v1alpha1/board"
 	"github.com/siderolabs/talos/internal/app/machined/pkg/runtime/v1alpha1/bootloader"
+	"github.com/siderolabs/talos/internal/pkg/install/progress"
 	"github.com/siderolabs/talos/internal/pkg/meta"
 	"github.com/siderolabs/talos/internal/pkg/mount"
 	"github.com/siderolabs/talos/pkg/machinery/constants"
@@ -74,6 +75,8 @@ func Install(p runtime.Platform, seq runtime.Sequence, opts *Options) (err error
 
 	log.Printf("installation of %s complete", version.Tag)
 
+	progress.Emit(log.Writer(), progress.StepComplete, 100)
+
 	return nil
 }
 
@@ -122,6 +125,8 @@ func NewInstaller(cmdline *procfs.Cmdline, seq runtime.Sequence, opts *Options)
 //
 //nolint:gocyclo,cyclop
 func (i *Installer) Install(seq runtime.Sequence) (err error) {
+	progress.Emit(log.Writer(), progress.StepStarted, 5)
+
 	errataBTF()
 
 	if seq == runtime.SequenceUpgrade {
@@ -130,6 +135,8 @@ func (i *Installer) Install(seq runtime.Sequence) (err error) {
 		}
 	}
 
+	progress.Emit(log.Writer(), progress.StepPreflight, 10)
+
 	if err = i.runPreflightChecks(seq); err != nil {
 		return err
 	}
@@ -151,6 +158,8 @@ func (i *Installer) Install(seq runtime.Sequence) (err error) {
 		i.cmdline.SetAll(b.KernelArgs().Strings())
 	}
 
+	progress.Emit(log.Writer(), progress.StepPartitioning, 30)
+
 	if err = i.manifest.Execute(); err != nil {
 		return err
 	}
@@ -222,6 +231,8 @@ func (i *Installer) Install(seq runtime.Sequence) (err error) {
 		}
 	}()
 
+	progress.Emit(log.Writer(), progress.StepBootloader, 60)
+
 	// Install the bootloader.
 	if err = i.bootloader.Install(i.options.Disk, i.options.Arch, i.cmdline.String()); err != nil {
 		return err
@@ -242,6 +253,8 @@ func (i *Installer) Install(seq runtime.Sequence) (err error) {
 		}
 	}
 
+	progress.Emit(log.Writer(), progress.StepFinalizing, 80)
+
 	if seq == runtime.SequenceUpgrade || len(i.options.MetaValues.values) > 0 {
 		var metaState *meta.Meta
 
@@ -0,0 +1,125 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+// Package main implements the reference KMS server for the network-bound disk encryption.
+package main
+
+import (
+	"context"
+	"crypto/tls"
+	"encoding/base64"
+	"fmt"
+	"log"
+	"net"
+	"net/netip"
+	"os"
+	"os/signal"
+	"strings"
+	"syscall"
+
+	"github.com/spf13/cobra"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+
+	"github.com/siderolabs/talos/cmd/talos-kms/pkg/server"
+	"github.com/siderolabs/talos/pkg/machinery/api/kms"
+)
+
+var kmsFlags struct {
+	listenAddr      string
+	keyFile         string
+	allowedNetworks []string
+	tlsCertFile     string
+	tlsKeyFile      string
+}
+
+var rootCmd = &cobra.Command{
+	Use:   "talos-kms",
+	Short: "Reference KMS server for the Talos network-bound disk encryption",
+	Long: `Reference KMS server seals and unseals the disk encryption keys of the Talos nodes.
+
+The server key is read from the key file, which should contain 32 bytes encoded as base64,
+e.g. generated with 'head -c 32 /dev/urandom | base64'.`,
+	Args: cobra.NoArgs,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+		defer stop()
+
+		return run(ctx)
+	},
+}
+
+func run(ctx context.Context) error {
+	keyData, err := os.ReadFile(kmsFlags.keyFile)
+	if err != nil {
+		return fmt.Errorf("error reading key file: %w", err)
+	}
+
+	key, err := base64.StdEncoding.DecodeString(strings.TrimSpace(string(keyData)))
+	if err != nil {
+		return fmt.Errorf("error decoding key: %w", err)
+	}
+
+	allowedNetworks := make([]netip.Prefix, 0, len(kmsFlags.allowedNetworks))
+
+	for _, network := range kmsFlags.allowedNetworks {
+		prefix, err := netip.ParsePrefix(network)
+		if err != nil {
+			return fmt.Errorf("error parsing allowed network: %w", err)
+		}
+
+		allowedNetworks = append(allowedNetworks, prefix)
+	}
+
+	srv, err := server.NewServer(key, allowedNetworks)
+	if err != nil {
+		return err
+	}
+
+	var opts []grpc.ServerOption
+
+	if kmsFlags.tlsCertFile != "" {
+		cert, err := tls.LoadX509KeyPair(kmsFlags.tlsCertFile, kmsFlags.tlsKeyFile)
+		if err != nil {
+			return fmt.Errorf("error loading TLS certificate: %w", err)
+		}
+
+		opts = append(opts, grpc.Creds(credentials.NewServerTLSFromCert(&cert)))
+	}
+
+	grpcServer := grpc.NewServer(opts...)
+	kms.RegisterKMSServiceServer(grpcServer, srv)
+
+	lis, err := net.Listen("tcp", kmsFlags.listenAddr)
+	if err != nil {
+		return fmt.Errorf("error listening: %w", err)
+	}
+
+	go func() {
+		<-ctx.Done()
+
+		grpcServer.GracefulStop()
+	}()
+
+	log.Printf("starting KMS server on %s", lis.Addr())
+
+	return grpcServer.Serve(lis)
+}
+
+func main() {
+	if err := rootCmd.Execute(); err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(1)
+	}
+}
+
+func init() {
+	rootCmd.Flags().StringVar(&kmsFlags.listenAddr, "listen", ":4050", "address to listen on")
+	rootCmd.Flags().StringVar(&kmsFlags.keyFile, "key-file", "", "path to the file with the base64 encoded 32 bytes server key")
+	rootCmd.Flags().StringSliceVar(&kmsFlags.allowedNetworks, "allowed-network", nil, "CIDR of the network allowed to access the KMS (if not set, all networks are allowed)")
+	rootCmd.Flags().StringVar(&kmsFlags.tlsCertFile, "tls-cert-file", "", "path to the TLS certificate (if not set, TLS is disabled)")
+	rootCmd.Flags().StringVar(&kmsFlags.tlsKeyFile, "tls-key-file", "", "path to the TLS certificate key")
+
+	rootCmd.MarkFlagRequired("key-file") //nolint:errcheck
+}
@@ -0,0 +1,129 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+// Package server implements the reference KMS server.
+package server
+
+import (
+	"context"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"fmt"
+	"io"
+	"net"
+	"net/netip"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
+
+	"github.com/siderolabs/talos/pkg/machinery/api/kms"
+)
+
+// Server implements the KMS service.
+//
+// Data is sealed with AES-GCM using the server key, and the node UUID is used as the additional data,
+// so the sealed data can be unsealed only on behalf of the same node.
+// Requests are accepted only from the allowed networks (if set).
+type Server struct {
+	kms.UnimplementedKMSServiceServer
+
+	aead            cipher.AEAD
+	allowedNetworks []netip.Prefix
+}
+
+// NewServer creates a new KMS server.
+func NewServer(key []byte, allowedNetworks []netip.Prefix) (*Server, error) {
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, err
+	}
+
+	aead, err := cipher.NewGCM(block)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Server{
+		aead:            aead,
+		allowedNetworks: allowedNetworks,
+	}, nil
+}
+
+// Seal encrypts the incoming data.
+func (srv *Server) Seal(ctx context.Context, req *kms.Request) (*kms.Response, error) {
+	if err := srv.checkRequest(ctx, req); err != nil {
+		return nil, err
+	}
+
+	nonce := make([]byte, srv.aead.NonceSize())
+
+	if _, err := io.ReadFull(rand.Reader, nonce); err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &kms.Response{
+		Data: srv.aead.Seal(nonce, nonce, req.Data, []byte(req.NodeUuid)),
+	}, nil
+}
+
+// Unseal decrypts the incoming data.
+func (srv *Server) Unseal(ctx context.Context, req *kms.Request) (*kms.Response, error) {
+	if err := srv.checkRequest(ctx, req); err != nil {
+		return nil, err
+	}
+
+	nonceSize := srv.aead.NonceSize()
+
+	if len(req.Data) < nonceSize {
+		return nil, status.Error(codes.InvalidArgument, "sealed data is too short")
+	}
+
+	data, err := srv.aead.Open(nil, req.Data[:nonceSize], req.Data[nonceSize:], []byte(req.NodeUuid))
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "failed to unseal the data")
+	}
+
+	return &kms.Response{
+		Data: data,
+	}, nil
+}
+
+func (srv *Server) checkRequest(ctx context.Context, req *kms.Request) error {
+	if req.NodeUuid == "" {
+		return status.Error(codes.InvalidArgument, "node UUID is required")
+	}
+
+	if len(srv.allowedNetworks) == 0 {
+		return nil
+	}
+
+	addr, err := peerAddr(ctx)
+	if err != nil {
+		return status.Error(codes.PermissionDenied, err.Error())
+	}
+
+	for _, network := range srv.allowedNetworks {
+		if network.Contains(addr) {
+			return nil
+		}
+	}
+
+	return status.Errorf(codes.PermissionDenied, "address %s is not allowed", addr)
+}
+
+func peerAddr(ctx context.Context) (netip.Addr, error) {
+	p, ok := peer.FromContext(ctx)
+	if !ok {
+		return netip.Addr{}, fmt.Errorf("peer address is not available")
+	}
+
+	tcpAddr, ok := p.Addr.(*net.TCPAddr)
+	if !ok {
+		return netip.Addr{}, fmt.Errorf("unsupported peer address %s", p.Addr)
+	}
+
+	return tcpAddr.AddrPort().Addr().Unmap(), nil
+}
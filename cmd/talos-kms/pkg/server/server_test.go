@@ -0,0 +1,53 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package server_test
+
+import (
+	"context"
+	"net"
+	"net/netip"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
+
+	"github.com/siderolabs/talos/cmd/talos-kms/pkg/server"
+	"github.com/siderolabs/talos/pkg/machinery/api/kms"
+)
+
+func peerContext(addr string) context.Context {
+	return peer.NewContext(context.Background(), &peer.Peer{
+		Addr: &net.TCPAddr{
+			IP:   net.ParseIP(addr),
+			Port: 51234,
+		},
+	})
+}
+
+func TestSealUnseal(t *testing.T) {
+	srv, err := server.NewServer(make([]byte, 32), []netip.Prefix{netip.MustParsePrefix("10.5.0.0/24")})
+	require.NoError(t, err)
+
+	ctx := peerContext("10.5.0.2")
+
+	sealed, err := srv.Seal(ctx, &kms.Request{NodeUuid: "node-1", Data: []byte("secret")})
+	require.NoError(t, err)
+	assert.NotContains(t, string(sealed.Data), "secret")
+
+	unsealed, err := srv.Unseal(ctx, &kms.Request{NodeUuid: "node-1", Data: sealed.Data})
+	require.NoError(t, err)
+	assert.Equal(t, []byte("secret"), unsealed.Data)
+
+	// sealed data is bound to the node UUID
+	_, err = srv.Unseal(ctx, &kms.Request{NodeUuid: "node-2", Data: sealed.Data})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+
+	// node outside of the allowed network
+	_, err = srv.Unseal(peerContext("192.168.1.2"), &kms.Request{NodeUuid: "node-1", Data: sealed.Data})
+	assert.Equal(t, codes.PermissionDenied, status.Code(err))
+}
@@ -7,18 +7,22 @@ package talos
 import (
 	"context"
 	"crypto/sha256"
+	"encoding/hex"
 	"fmt"
 	"io"
 	"os"
 	"strings"
 	"sync"
 	"text/tabwriter"
+	"time"
 
+	"github.com/cosi-project/runtime/pkg/safe"
 	"github.com/dustin/go-humanize"
 	"github.com/siderolabs/gen/slices"
 	"github.com/spf13/cobra"
 	snapshot "go.etcd.io/etcd/etcdutl/v3/snapshot"
 	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
 
 	"github.com/siderolabs/talos/cmd/talosctl/pkg/talos/helpers"
 	"github.com/siderolabs/talos/pkg/cli"
@@ -408,12 +412,161 @@ var etcdSnapshotCmd = &cobra.Command{
 	},
 }
 
+var etcdSnapshotsCmd = &cobra.Command{
+	Use:   "snapshots",
+	Short: "Manage scheduled etcd snapshots stored on the nodes",
+	Long:  ``,
+}
+
+var etcdSnapshotListCmd = &cobra.Command{
+	Use:     "list",
+	Aliases: []string{"ls"},
+	Short:   "List scheduled etcd snapshots stored on the nodes.",
+	Long:    ``,
+	Args:    cobra.NoArgs,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		return WithClient(func(ctx context.Context, c *client.Client) error {
+			md, _ := metadata.FromOutgoingContext(ctx)
+			nodes := md.Get("nodes")
+
+			if len(nodes) == 0 {
+				// use "current" node
+				nodes = []string{""}
+			}
+
+			w := tabwriter.NewWriter(os.Stdout, 0, 0, 3, ' ', 0)
+			fmt.Fprintln(w, "NODE\tID\tCREATED\tREVISION\tSIZE")
+
+			var errs error
+
+			for _, node := range nodes {
+				nodeCtx := ctx
+
+				if node != "" {
+					nodeCtx = client.WithNode(ctx, node)
+				}
+
+				items, err := safe.StateListAll[*etcdresource.Snapshot](nodeCtx, c.COSI)
+				if err != nil {
+					errs = helpers.AppendErrors(errs, fmt.Errorf("%s: error listing etcd snapshots: %w", node, err))
+
+					continue
+				}
+
+				for iter := safe.IteratorFromList(items); iter.Next(); {
+					spec := iter.Value().TypedSpec()
+
+					fmt.Fprintf(w, "%s\t%s\t%s\t%d\t%s\n",
+						node,
+						iter.Value().Metadata().ID(),
+						spec.CreatedAt.Format(time.RFC3339),
+						spec.Revision,
+						humanize.Bytes(uint64(spec.Size)),
+					)
+				}
+			}
+
+			if err := w.Flush(); err != nil {
+				return err
+			}
+
+			return errs
+		})
+	},
+}
+
+var etcdSnapshotGetCmd = &cobra.Command{
+	Use:   "get <id> [<path>]",
+	Short: "Download scheduled etcd snapshot from the node to the path.",
+	Long:  `If the path is not specified, the snapshot is saved to the current directory as <id>.snapshot.`,
+	Args:  cobra.RangeArgs(1, 2),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		return WithClient(func(ctx context.Context, c *client.Client) error {
+			if err := helpers.FailIfMultiNodes(ctx, "etcd snapshots get"); err != nil {
+				return err
+			}
+
+			snapshotResource, err := safe.StateGetByID[*etcdresource.Snapshot](ctx, c.COSI, args[0])
+			if err != nil {
+				return fmt.Errorf("error getting etcd snapshot %q: %w", args[0], err)
+			}
+
+			dbPath := args[0] + ".snapshot"
+			if len(args) > 1 {
+				dbPath = args[1]
+			}
+
+			partPath := dbPath + ".part"
+
+			defer os.RemoveAll(partPath) //nolint:errcheck
+
+			dest, err := os.OpenFile(partPath, os.O_WRONLY|os.O_CREATE|os.O_TRUNC, 0o600)
+			if err != nil {
+				return fmt.Errorf("error creating temporary file: %w", err)
+			}
+
+			defer dest.Close() //nolint:errcheck
+
+			r, errCh, err := c.Read(ctx, snapshotResource.TypedSpec().Path)
+			if err != nil {
+				return fmt.Errorf("error reading file: %w", err)
+			}
+
+			defer r.Close() //nolint:errcheck
+
+			var wg sync.WaitGroup
+
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				for err := range errCh {
+					fmt.Fprintln(os.Stderr, err.Error())
+				}
+			}()
+
+			defer wg.Wait()
+
+			hash := sha256.New()
+
+			size, err := io.Copy(io.MultiWriter(dest, hash), r)
+			if err != nil {
+				return fmt.Errorf("error reading: %w", err)
+			}
+
+			if expected := snapshotResource.TypedSpec().SHA256; expected != "" && expected != hex.EncodeToString(hash.Sum(nil)) {
+				return fmt.Errorf("etcd snapshot checksum mismatch: expected %s", expected)
+			}
+
+			if err = dest.Sync(); err != nil {
+				return fmt.Errorf("failed to fsync: %w", err)
+			}
+
+			if err = dest.Close(); err != nil {
+				return fmt.Errorf("failed to close: %w", err)
+			}
+
+			if err = os.Rename(partPath, dbPath); err != nil {
+				return fmt.Errorf("error renaming to final location: %w", err)
+			}
+
+			fmt.Printf("etcd snapshot saved to %q (%d bytes, revision %d)\n", dbPath, size, snapshotResource.TypedSpec().Revision)
+
+			return nil
+		})
+	},
+}
+
 func init() {
 	etcdAlarmCmd.AddCommand(
 		etcdAlarmListCmd,
 		etcdAlarmDisarmCmd,
 	)
 
+	etcdSnapshotsCmd.AddCommand(
+		etcdSnapshotListCmd,
+		etcdSnapshotGetCmd,
+	)
+
 	etcdCmd.AddCommand(
 		etcdAlarmCmd,
 		etcdDefragCmd,
@@ -422,6 +575,7 @@ func init() {
 		etcdMemberListCmd,
 		etcdMemberRemoveCmd,
 		etcdSnapshotCmd,
+		etcdSnapshotsCmd,
 		etcdStatusCmd,
 	)
 
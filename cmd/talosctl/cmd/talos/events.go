@@ -95,6 +95,15 @@ var eventsCmd = &cobra.Command{
 					args = []interface{}{"error", msg.GetError()}
 				case *machine.AddressEvent:
 					args = []interface{}{msg.GetHostname(), fmt.Sprintf("ADDRESSES: %s", strings.Join(msg.GetAddresses(), ","))}
+				case *machine.UpgradeProgressEvent:
+					args = []interface{}{msg.GetTask(), msg.GetAction().String()}
+
+					switch msg.GetAction() { //nolint:exhaustive
+					case machine.UpgradeProgressEvent_PROGRESS:
+						args[1] = fmt.Sprintf("%d%%: %s", msg.GetPercent(), msg.GetMessage())
+					case machine.UpgradeProgressEvent_FAILED:
+						args[1] = "error: " + msg.GetError()
+					}
 				case *machine.MachineStatusEvent:
 					args = []interface{}{
 						msg.GetStage().String(),
@@ -0,0 +1,170 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package talos
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"text/tabwriter"
+
+	humanize "github.com/dustin/go-humanize"
+	"github.com/spf13/cobra"
+
+	"github.com/siderolabs/talos/cmd/talosctl/pkg/talos/helpers"
+	"github.com/siderolabs/talos/pkg/machinery/api/common"
+	"github.com/siderolabs/talos/pkg/machinery/api/machine"
+	"github.com/siderolabs/talos/pkg/machinery/client"
+)
+
+var imageCmdFlags struct {
+	namespace string
+}
+
+func parseContainerdNamespace(namespace string) (common.ContainerdNamespace, error) {
+	switch namespace {
+	case "cri":
+		return common.ContainerdNamespace_NS_CRI, nil
+	case "system":
+		return common.ContainerdNamespace_NS_SYSTEM, nil
+	default:
+		return 0, fmt.Errorf("unsupported namespace %q, expected one of: cri, system", namespace)
+	}
+}
+
+// imageCmd represents the image command.
+var imageCmd = &cobra.Command{
+	Use:   "image",
+	Short: "Manage CRI container images",
+	Long:  ``,
+	Args:  cobra.NoArgs,
+}
+
+// imageListCmd represents the image list command.
+var imageListCmd = &cobra.Command{
+	Use:     "list",
+	Aliases: []string{"ls"},
+	Short:   "List CRI images",
+	Long:    ``,
+	Args:    cobra.NoArgs,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		return WithClient(func(ctx context.Context, c *client.Client) error {
+			namespace, err := parseContainerdNamespace(imageCmdFlags.namespace)
+			if err != nil {
+				return err
+			}
+
+			rcv, err := c.ImageList(ctx, namespace)
+			if err != nil {
+				return fmt.Errorf("error listing images: %w", err)
+			}
+
+			w := tabwriter.NewWriter(os.Stdout, 0, 0, 3, ' ', 0)
+			fmt.Fprintln(w, "NODE\tIMAGE\tDIGEST\tSIZE\tCREATED")
+
+			if err = helpers.ReadGRPCStream(rcv, func(msg *machine.ImageListResponse, node string, multipleNodes bool) error {
+				fmt.Fprintf(w, "%s\t%s\t%s\t%s\t%s\n",
+					node,
+					msg.Name,
+					msg.Digest,
+					humanize.Bytes(uint64(msg.Size)),
+					humanize.Time(msg.CreatedAt.AsTime()),
+				)
+
+				return nil
+			}); err != nil {
+				return err
+			}
+
+			return w.Flush()
+		})
+	},
+}
+
+// imagePullCmd represents the image pull command.
+var imagePullCmd = &cobra.Command{
+	Use:   "pull <image>",
+	Short: "Pull an image into CRI",
+	Long:  ``,
+	Args:  cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		return WithClient(func(ctx context.Context, c *client.Client) error {
+			namespace, err := parseContainerdNamespace(imageCmdFlags.namespace)
+			if err != nil {
+				return err
+			}
+
+			rcv, err := c.ImagePull(ctx, namespace, args[0])
+			if err != nil {
+				return fmt.Errorf("error pulling image: %w", err)
+			}
+
+			return helpers.ReadGRPCStream(rcv, func(msg *machine.ImagePullResponse, node string, multipleNodes bool) error {
+				prefix := ""
+				if multipleNodes {
+					prefix = node + ": "
+				}
+
+				if msg.Name != "" {
+					fmt.Printf("%spulled %s (%s)\n", prefix, msg.Name, msg.Digest)
+
+					return nil
+				}
+
+				fmt.Printf("%sfetched layer %s (%s), %s / %s\n",
+					prefix,
+					msg.LayerDigest,
+					humanize.Bytes(uint64(msg.LayerSize)),
+					humanize.Bytes(uint64(msg.Fetched)),
+					humanize.Bytes(uint64(msg.Total)),
+				)
+
+				return nil
+			})
+		})
+	},
+}
+
+// imageRemoveCmd represents the image remove command.
+var imageRemoveCmd = &cobra.Command{
+	Use:     "remove <image>...",
+	Aliases: []string{"rm"},
+	Short:   "Remove images from CRI",
+	Long:    ``,
+	Args:    cobra.MinimumNArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		return WithClient(func(ctx context.Context, c *client.Client) error {
+			namespace, err := parseContainerdNamespace(imageCmdFlags.namespace)
+			if err != nil {
+				return err
+			}
+
+			rcv, err := c.ImageRemove(ctx, namespace, args)
+			if err != nil {
+				return fmt.Errorf("error removing image: %w", err)
+			}
+
+			return helpers.ReadGRPCStream(rcv, func(msg *machine.ImageRemoveResponse, node string, multipleNodes bool) error {
+				prefix := ""
+				if multipleNodes {
+					prefix = node + ": "
+				}
+
+				fmt.Printf("%sremoved %s (%s)\n", prefix, msg.Name, msg.Digest)
+
+				return nil
+			})
+		})
+	},
+}
+
+func init() {
+	imageCmd.PersistentFlags().StringVar(&imageCmdFlags.namespace, "namespace", "cri", "namespace to use: system (etcd and kubelet images) or cri for all Kubernetes workloads")
+
+	imageCmd.AddCommand(imageListCmd)
+	imageCmd.AddCommand(imagePullCmd)
+	imageCmd.AddCommand(imageRemoveCmd)
+	addCommand(imageCmd)
+}
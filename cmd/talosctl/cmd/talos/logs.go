@@ -27,6 +27,7 @@ import (
 var (
 	follow    bool
 	tailLines int32
+	boot      int32
 )
 
 // logsCmd represents the logs command.
@@ -61,7 +62,14 @@ var logsCmd = &cobra.Command{
 				driver = common.ContainerDriver_CONTAINERD
 			}
 
-			stream, err := c.Logs(ctx, namespace, driver, args[0], follow, tailLines)
+			stream, err := c.MachineClient.Logs(ctx, &machine.LogsRequest{
+				Namespace: namespace,
+				Driver:    driver,
+				Id:        args[0],
+				Follow:    follow,
+				TailLines: tailLines,
+				Boot:      boot,
+			})
 			if err != nil {
 				return fmt.Errorf("error fetching logs: %s", err)
 			}
@@ -210,6 +218,7 @@ func init() {
 	logsCmd.Flags().BoolVarP(&kubernetes, "kubernetes", "k", false, "use the k8s.io containerd namespace")
 	logsCmd.Flags().BoolVarP(&follow, "follow", "f", false, "specify if the logs should be streamed")
 	logsCmd.Flags().Int32VarP(&tailLines, "tail", "", -1, "lines of log file to display (default is to show from the beginning)")
+	logsCmd.Flags().Int32VarP(&boot, "boot", "", 0, "boot to show the logs for: 0 is the current boot, -1 is the previous one, and so on (requires persistent logs)")
 
 	logsCmd.Flags().BoolP("use-cri", "c", false, "use the CRI driver")
 	logsCmd.Flags().MarkHidden("use-cri") //nolint:errcheck
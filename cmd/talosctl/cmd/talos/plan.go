@@ -0,0 +1,76 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package talos
+
+import (
+	"fmt"
+	"io"
+	"strings"
+	"text/tabwriter"
+
+	"google.golang.org/grpc/peer"
+
+	"github.com/siderolabs/talos/pkg/machinery/api/common"
+	machineapi "github.com/siderolabs/talos/pkg/machinery/api/machine"
+	"github.com/siderolabs/talos/pkg/machinery/client"
+)
+
+// printSequencePlan prints the sequence plan returned in dry-run mode.
+func printSequencePlan(out io.Writer, remotePeer *peer.Peer, metadata *common.Metadata, plan *machineapi.SequencePlan) error {
+	if plan == nil {
+		return fmt.Errorf("no plan returned, make sure the node supports dry run")
+	}
+
+	node := client.AddrFromPeer(remotePeer)
+
+	if metadata != nil {
+		node = metadata.Hostname
+	}
+
+	fmt.Fprintf(out, "NODE: %s\n", node)
+	fmt.Fprintf(out, "SEQUENCE: %s\n\n", plan.Sequence)
+
+	w := tabwriter.NewWriter(out, 0, 0, 3, ' ', 0)
+	fmt.Fprintln(w, "#\tPHASE\tTASKS")
+
+	for i, phase := range plan.Phases {
+		fmt.Fprintf(w, "%d\t%s\t%s\n", i+1, phase.Name, strings.Join(phase.Tasks, ", "))
+	}
+
+	if err := w.Flush(); err != nil {
+		return err
+	}
+
+	fmt.Fprintf(out, "\nPARTITIONS TO WIPE: %s\n", joinOrNone(plan.PartitionsToWipe))
+	fmt.Fprintf(out, "SERVICES TO STOP: %s\n", joinOrNone(plan.ServicesToStop))
+
+	if len(plan.Checks) == 0 {
+		return nil
+	}
+
+	fmt.Fprintf(out, "\nPRE-FLIGHT CHECKS:\n")
+
+	w = tabwriter.NewWriter(out, 0, 0, 3, ' ', 0)
+
+	for _, check := range plan.Checks {
+		result := "ok"
+
+		if check.Error != "" {
+			result = "failed: " + check.Error
+		}
+
+		fmt.Fprintf(w, "  %s\t%s\n", check.Name, result)
+	}
+
+	return w.Flush()
+}
+
+func joinOrNone(items []string) string {
+	if len(items) == 0 {
+		return "none"
+	}
+
+	return strings.Join(items, ", ")
+}
@@ -7,15 +7,19 @@ package talos
 import (
 	"context"
 	"fmt"
+	"os"
 	"sort"
 	"strings"
 
 	"github.com/siderolabs/gen/maps"
 	"github.com/spf13/cobra"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/peer"
 
 	"github.com/siderolabs/talos/cmd/talosctl/cmd/common"
 	"github.com/siderolabs/talos/cmd/talosctl/pkg/talos/action"
 	"github.com/siderolabs/talos/cmd/talosctl/pkg/talos/helpers"
+	"github.com/siderolabs/talos/pkg/cli"
 	machineapi "github.com/siderolabs/talos/pkg/machinery/api/machine"
 	"github.com/siderolabs/talos/pkg/machinery/client"
 )
@@ -73,6 +77,7 @@ var resetCmdFlags struct {
 	graceful           bool
 	reboot             bool
 	insecure           bool
+	dryRun             bool
 	wipeMode           WipeMode
 	userDisksToWipe    []string
 	systemLabelsToWipe []string
@@ -91,6 +96,10 @@ var resetCmd = &cobra.Command{
 
 		resetRequest := buildResetRequest()
 
+		if resetCmdFlags.dryRun {
+			return runResetDryRun(resetRequest)
+		}
+
 		if resetCmdFlags.wait && resetCmdFlags.insecure {
 			return fmt.Errorf("cannot use --wait and --insecure together")
 		}
@@ -172,6 +181,45 @@ func buildResetRequest() *machineapi.ResetRequest {
 	}
 }
 
+func runResetDryRun(req *machineapi.ResetRequest) error {
+	req.DryRun = true
+
+	resetFn := func(ctx context.Context, c *client.Client) error {
+		if err := helpers.ClientVersionCheck(ctx, c); err != nil {
+			return err
+		}
+
+		var remotePeer peer.Peer
+
+		resp, err := c.ResetGenericWithResponse(ctx, req, grpc.Peer(&remotePeer))
+		if err != nil {
+			if resp == nil {
+				return fmt.Errorf("error planning reset: %s", err)
+			}
+
+			cli.Warning("%s", err)
+		}
+
+		for i, msg := range resp.Messages {
+			if i > 0 {
+				fmt.Println()
+			}
+
+			if err = printSequencePlan(os.Stdout, &remotePeer, msg.Metadata, msg.Plan); err != nil {
+				return err
+			}
+		}
+
+		return nil
+	}
+
+	if resetCmdFlags.insecure {
+		return WithClientMaintenance(nil, resetFn)
+	}
+
+	return WithClient(resetFn)
+}
+
 func resetGetActorID(ctx context.Context, c *client.Client, req *machineapi.ResetRequest) (string, error) {
 	resp, err := c.ResetGenericWithResponse(ctx, req)
 	if err != nil {
@@ -188,6 +236,7 @@ func resetGetActorID(ctx context.Context, c *client.Client, req *machineapi.Rese
 func init() {
 	resetCmd.Flags().BoolVar(&resetCmdFlags.graceful, "graceful", true, "if true, attempt to cordon/drain node and leave etcd (if applicable)")
 	resetCmd.Flags().BoolVar(&resetCmdFlags.reboot, "reboot", false, "if true, reboot the node after resetting instead of shutting down")
+	resetCmd.Flags().BoolVar(&resetCmdFlags.dryRun, "dry-run", false, "print the reset plan (phases, tasks, partitions to wipe and services to stop) without resetting")
 	resetCmd.Flags().BoolVar(&resetCmdFlags.insecure, "insecure", false, "reset using the insecure (encrypted with no auth) maintenance service")
 	resetCmd.Flags().Var(&resetCmdFlags.wipeMode, "wipe-mode", "disk reset mode")
 	resetCmd.Flags().StringSliceVar(&resetCmdFlags.userDisksToWipe, "user-disks-to-wipe", nil, "if set, wipes defined devices in the list")
@@ -0,0 +1,68 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package talos
+
+import (
+	"context"
+
+	"github.com/spf13/cobra"
+
+	"github.com/siderolabs/talos/cmd/talosctl/pkg/talos/helpers"
+	"github.com/siderolabs/talos/pkg/cluster"
+	k8s "github.com/siderolabs/talos/pkg/cluster/kubernetes"
+	"github.com/siderolabs/talos/pkg/machinery/client"
+)
+
+// rotateSecretsEncryptionCmd represents the rotate-secrets-encryption command.
+var rotateSecretsEncryptionCmd = &cobra.Command{
+	Use:   "rotate-secrets-encryption",
+	Short: "Re-encrypt Kubernetes secrets with the current encryption key.",
+	Long: `Command waits for kube-apiserver on all controlplane nodes to pick up the current encryption configuration,
+and rewrites all Kubernetes secrets, so that they get encrypted with the current encryption key.
+
+The command should be run after the KMS plugin key is rotated, or the encryption configuration is changed
+(e.g. KMS encryption is enabled). Once the secrets are re-encrypted, the previous encryption keys can be removed
+from the machine configuration.`,
+	Args: cobra.NoArgs,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		return WithClient(rotateSecretsEncryption)
+	},
+}
+
+var reencryptOptions k8s.ReencryptOptions
+
+func init() {
+	rotateSecretsEncryptionCmd.Flags().StringVar(&reencryptOptions.ControlPlaneEndpoint, "endpoint", "", "the cluster control plane endpoint")
+	rotateSecretsEncryptionCmd.Flags().BoolVar(&reencryptOptions.DryRun, "dry-run", false, "skip rewriting the secrets and show the secrets which would be rewritten instead")
+	addCommand(rotateSecretsEncryptionCmd)
+}
+
+func rotateSecretsEncryption(ctx context.Context, c *client.Client) error {
+	if err := helpers.FailIfMultiNodes(ctx, "rotate-secrets-encryption"); err != nil {
+		return err
+	}
+
+	if err := helpers.ClientVersionCheck(ctx, c); err != nil {
+		return err
+	}
+
+	clientProvider := &cluster.ConfigClientProvider{
+		DefaultClient: c,
+	}
+	defer clientProvider.Close() //nolint:errcheck
+
+	state := struct {
+		cluster.ClientProvider
+		cluster.K8sProvider
+	}{
+		ClientProvider: clientProvider,
+		K8sProvider: &cluster.KubernetesClient{
+			ClientProvider: clientProvider,
+			ForceEndpoint:  reencryptOptions.ControlPlaneEndpoint,
+		},
+	}
+
+	return k8s.ReencryptSecrets(ctx, &state, reencryptOptions)
+}
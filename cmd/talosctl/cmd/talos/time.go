@@ -53,7 +53,7 @@ var timeCmd = &cobra.Command{
 			}
 
 			w := tabwriter.NewWriter(os.Stdout, 0, 0, 3, ' ', 0)
-			fmt.Fprintln(w, "NODE\tNTP-SERVER\tNODE-TIME\tNTP-SERVER-TIME")
+			fmt.Fprintln(w, "NODE\tNTP-SERVER\tNODE-TIME\tNTP-SERVER-TIME\tSTRATUM\tSYNCED\tSYNC-SERVER")
 
 			defaultNode := client.AddrFromPeer(&remotePeer)
 
@@ -76,7 +76,12 @@ var timeCmd = &cobra.Command{
 				localtime = msg.Localtime.AsTime()
 				remotetime = msg.Remotetime.AsTime()
 
-				fmt.Fprintf(w, "%s\t%s\t%s\t%s\n", node, msg.Server, localtime.String(), remotetime.String())
+				syncServer := msg.SyncServer
+				if syncServer == "" {
+					syncServer = "-"
+				}
+
+				fmt.Fprintf(w, "%s\t%s\t%s\t%s\t%d\t%t\t%s\n", node, msg.Server, localtime.String(), remotetime.String(), msg.Stratum, msg.Synced, syncServer)
 			}
 
 			return w.Flush()
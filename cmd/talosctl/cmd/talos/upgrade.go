@@ -20,6 +20,7 @@ import (
 	"github.com/siderolabs/talos/cmd/talosctl/pkg/talos/helpers"
 	"github.com/siderolabs/talos/pkg/cli"
 	"github.com/siderolabs/talos/pkg/images"
+	machineapi "github.com/siderolabs/talos/pkg/machinery/api/machine"
 	"github.com/siderolabs/talos/pkg/machinery/client"
 	"github.com/siderolabs/talos/pkg/version"
 )
@@ -31,6 +32,7 @@ var upgradeCmdFlags struct {
 	stage        bool
 	force        bool
 	insecure     bool
+	dryRun       bool
 }
 
 // upgradeCmd represents the processes command.
@@ -44,6 +46,10 @@ var upgradeCmd = &cobra.Command{
 			upgradeCmdFlags.wait = true
 		}
 
+		if upgradeCmdFlags.dryRun {
+			return runUpgradeDryRun()
+		}
+
 		if upgradeCmdFlags.wait && upgradeCmdFlags.insecure {
 			return fmt.Errorf("cannot use --wait and --insecure together")
 		}
@@ -115,6 +121,53 @@ func runUpgradeNoWait() error {
 	return WithClient(upgradeFn)
 }
 
+func runUpgradeDryRun() error {
+	upgradeFn := func(ctx context.Context, c *client.Client) error {
+		if err := helpers.ClientVersionCheck(ctx, c); err != nil {
+			return err
+		}
+
+		var remotePeer peer.Peer
+
+		resp, err := c.UpgradeGeneric(
+			ctx,
+			&machineapi.UpgradeRequest{
+				Image:    upgradeCmdFlags.upgradeImage,
+				Preserve: upgradeCmdFlags.preserve,
+				Stage:    upgradeCmdFlags.stage,
+				Force:    upgradeCmdFlags.force,
+				DryRun:   true,
+			},
+			grpc.Peer(&remotePeer),
+		)
+		if err != nil {
+			if resp == nil {
+				return fmt.Errorf("error planning upgrade: %s", err)
+			}
+
+			cli.Warning("%s", err)
+		}
+
+		for i, msg := range resp.Messages {
+			if i > 0 {
+				fmt.Println()
+			}
+
+			if err = printSequencePlan(os.Stdout, &remotePeer, msg.Metadata, msg.Plan); err != nil {
+				return err
+			}
+		}
+
+		return nil
+	}
+
+	if upgradeCmdFlags.insecure {
+		return WithClientMaintenance(nil, upgradeFn)
+	}
+
+	return WithClient(upgradeFn)
+}
+
 func upgradeGetActorID(ctx context.Context, c *client.Client) (string, error) {
 	resp, err := c.Upgrade(
 		ctx,
@@ -141,6 +194,7 @@ func init() {
 	upgradeCmd.Flags().BoolVarP(&upgradeCmdFlags.preserve, "preserve", "p", false, "preserve data")
 	upgradeCmd.Flags().BoolVarP(&upgradeCmdFlags.stage, "stage", "s", false, "stage the upgrade to perform it after a reboot")
 	upgradeCmd.Flags().BoolVarP(&upgradeCmdFlags.force, "force", "f", false, "force the upgrade (skip checks on etcd health and members, might lead to data loss)")
+	upgradeCmd.Flags().BoolVar(&upgradeCmdFlags.dryRun, "dry-run", false, "print the upgrade plan (phases, tasks, partitions to wipe and services to stop) without upgrading")
 	upgradeCmd.Flags().BoolVar(&upgradeCmdFlags.insecure, "insecure", false, "upgrade using the insecure (encrypted with no auth) maintenance service")
 	upgradeCmdFlags.addTrackActionFlags(upgradeCmd)
 	addCommand(upgradeCmd)
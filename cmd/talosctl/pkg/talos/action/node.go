@@ -289,6 +289,26 @@ func (a *nodeTracker) handleEvent(event client.Event) error {
 			return fmt.Errorf("sequence error: %s", msg.GetError().GetMessage())
 		}
 
+	case *machineapi.UpgradeProgressEvent:
+		if msg.GetAction() == machineapi.UpgradeProgressEvent_FAILED {
+			a.update(reporter.Update{
+				Message: fmt.Sprintf("%s: failed: %s", upgradeProgressTask(msg), msg.GetError()),
+				Status:  reporter.StatusError,
+			})
+
+			return fmt.Errorf("upgrade task %q failed: %s", msg.GetTask(), msg.GetError())
+		}
+
+		status := msg.GetAction().String()
+		if msg.GetAction() == machineapi.UpgradeProgressEvent_PROGRESS {
+			status = fmt.Sprintf("%s %s", progressBar(int(msg.GetPercent())), msg.GetMessage())
+		}
+
+		a.update(reporter.Update{
+			Message: fmt.Sprintf("%s: %s", upgradeProgressTask(msg), status),
+			Status:  reporter.StatusRunning,
+		})
+
 	case *machineapi.MachineStatusEvent:
 		a.update(reporter.Update{
 			Message: fmt.Sprintf("stage: %v ready: %v unmetCond: %v", msg.GetStage(), msg.GetStatus().GetReady(), msg.GetStatus().GetUnmetConditions()),
@@ -304,3 +324,35 @@ func (a *nodeTracker) handleEvent(event client.Event) error {
 
 	return nil
 }
+
+// upgradeProgressTask describes the task of the upgrade progress event.
+func upgradeProgressTask(msg *machineapi.UpgradeProgressEvent) string {
+	// tasks run before the upgrade sequence starts don't belong to any phase
+	if msg.GetPhaseIndex() == 0 {
+		return fmt.Sprintf("task: %s", msg.GetTask())
+	}
+
+	return fmt.Sprintf("phase %d/%d: %s task: %s", msg.GetPhaseIndex(), msg.GetPhaseCount(), msg.GetPhase(), msg.GetTask())
+}
+
+// progressBarWidth is the width of the progress bar, excluding the brackets.
+const progressBarWidth = 20
+
+// progressBar renders the percent done as a progress bar, e.g. "[=========>          ]  50%".
+func progressBar(percent int) string {
+	switch {
+	case percent < 0:
+		percent = 0
+	case percent > 100:
+		percent = 100
+	}
+
+	filled := percent * progressBarWidth / 100
+
+	bar := strings.Repeat("=", filled)
+	if filled < progressBarWidth {
+		bar += ">" + strings.Repeat(" ", progressBarWidth-filled-1)
+	}
+
+	return fmt.Sprintf("[%s] %3d%%", bar, percent)
+}
@@ -237,7 +237,12 @@ func (b *ConstBlocks) FormatProtoFile(w io.Writer) error {
 
 		fmt.Fprintf(w, "enum %s {\n", block.ProtoMessageName())
 
-		if hasDuplicates(block.Consts, func(c Constant) string { return c.Value }) {
+		// proto3 enums should start with zero value, UNSPECIFIED zero value is added if the first constant is not zero,
+		// so it might become an alias for another zero constant
+		needsUnspecified := block.Consts[0].Value != "0"
+
+		if hasDuplicates(block.Consts, func(c Constant) string { return c.Value }) ||
+			(needsUnspecified && hasValue(block.Consts, "0")) {
 			fmt.Fprintln(w, "  option allow_alias = true;")
 		}
 
@@ -246,7 +251,7 @@ func (b *ConstBlocks) FormatProtoFile(w io.Writer) error {
 				fmt.Fprintln(w, " ", comment)
 			}
 
-			if i == 0 && constant.Value != "0" {
+			if i == 0 && needsUnspecified {
 				fmt.Fprintf(w,
 					"  %s_%s_UNSPECIFIED = 0;\n",
 					strings.ToUpper(block.TypePkg),
@@ -296,6 +301,16 @@ func hasDuplicates[T any, K comparable](slc []T, fn func(T) K) bool {
 	return false
 }
 
+func hasValue(consts []Constant, value string) bool {
+	for _, constant := range consts {
+		if constant.Value == value {
+			return true
+		}
+	}
+
+	return false
+}
+
 // toCapitalSnakeCase converts a string to a capital snake case.
 func toCapitalSnakeCase(str string) string {
 	snake := matchFirstCap.ReplaceAllString(str, "${1}_${2}")
@@ -278,8 +278,13 @@ func PrepareProtoData(pkgsTypes slices.Sorted[*types.Type], constants consts.Con
 				case fieldTyp.Pkg == "":
 					typeName = fmt.Sprintf("repeated %s", getProtoBasicName(fieldTyp.Name))
 				default:
-					importName, typeName = mustFormatTypeName(fieldTyp.Pkg, fieldTyp.Name, pkgType.Pkg)
-					typeName = fmt.Sprintf("repeated %s", typeName)
+					if block, ok := constants.Get(fieldTyp.Pkg, fieldTyp.Name); ok {
+						importName = "resource/definitions/enums/enums.proto"
+						typeName = "repeated talos.resource.definitions.enums." + block.ProtoMessageName()
+					} else {
+						importName, typeName = mustFormatTypeName(fieldTyp.Pkg, fieldTyp.Name, pkgType.Pkg)
+						typeName = fmt.Sprintf("repeated %s", typeName)
+					}
 				}
 
 				if importName != "" {
@@ -0,0 +1,24 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package runtime
+
+import (
+	"context"
+
+	"github.com/containerd/containerd/images"
+
+	"github.com/siderolabs/talos/internal/pkg/containers/image"
+	"github.com/siderolabs/talos/pkg/machinery/config/config"
+)
+
+// SetCRIImagePuller replaces the CRI image puller, and returns a function to restore the original one.
+func SetCRIImagePuller(f func(ctx context.Context, reg config.Registries, ref string, opts ...image.PullOption) (images.Image, error)) (restore func()) {
+	orig := criImagePuller
+	criImagePuller = f
+
+	return func() {
+		criImagePuller = orig
+	}
+}
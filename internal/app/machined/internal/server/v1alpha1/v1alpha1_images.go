@@ -0,0 +1,197 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package runtime
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/containerd/containerd"
+	"github.com/containerd/containerd/errdefs"
+	"github.com/containerd/containerd/images"
+	"github.com/containerd/containerd/namespaces"
+	criconstants "github.com/containerd/containerd/pkg/cri/constants"
+	ocispec "github.com/opencontainers/image-spec/specs-go/v1"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/siderolabs/talos/internal/pkg/containers/image"
+	"github.com/siderolabs/talos/pkg/machinery/api/common"
+	"github.com/siderolabs/talos/pkg/machinery/api/machine"
+	"github.com/siderolabs/talos/pkg/machinery/config/config"
+	"github.com/siderolabs/talos/pkg/machinery/constants"
+)
+
+// ImageList lists images in the CRI.
+func (s *Server) ImageList(req *machine.ImageListRequest, srv machine.MachineService_ImageListServer) error {
+	namespace, err := containerdNamespaceHelper(req.GetNamespace())
+	if err != nil {
+		return err
+	}
+
+	client, err := containerd.New(constants.CRIContainerdAddress)
+	if err != nil {
+		return fmt.Errorf("error creating CRI containerd client: %w", err)
+	}
+
+	defer client.Close() //nolint:errcheck
+
+	ctx := namespaces.WithNamespace(srv.Context(), namespace)
+
+	imgs, err := client.ListImages(ctx)
+	if err != nil {
+		return fmt.Errorf("error listing images: %w", err)
+	}
+
+	for _, img := range imgs {
+		size, err := img.Size(ctx)
+		if err != nil {
+			return fmt.Errorf("error getting size of image %q: %w", img.Name(), err)
+		}
+
+		if err = srv.Send(&machine.ImageListResponse{
+			Name:      img.Name(),
+			Digest:    img.Target().Digest.String(),
+			Size:      size,
+			CreatedAt: timestamppb.New(img.Metadata().CreatedAt),
+		}); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// ImagePull pulls an image into the CRI.
+//
+// Progress is streamed back as each image layer is fetched.
+func (s *Server) ImagePull(req *machine.ImagePullRequest, srv machine.MachineService_ImagePullServer) error {
+	namespace, err := containerdNamespaceHelper(req.GetNamespace())
+	if err != nil {
+		return err
+	}
+
+	if req.GetReference() == "" {
+		return status.Error(codes.InvalidArgument, "image reference is required")
+	}
+
+	ctx := namespaces.WithNamespace(srv.Context(), namespace)
+
+	// progress is reported from the image handlers, so keep the first send error to return it once the pull is done
+	var sendErr error
+
+	img, err := criImagePuller(ctx, s.Controller.Runtime().Config().Machine().Registries(), req.GetReference(),
+		image.WithSkipIfAlreadyPulled(),
+		image.WithProgress(func(layer ocispec.Descriptor, layerFetched, fetched, total int64) {
+			// the response is sent once per fetched layer
+			if sendErr != nil || layerFetched < layer.Size {
+				return
+			}
+
+			sendErr = srv.Send(&machine.ImagePullResponse{
+				LayerDigest: layer.Digest.String(),
+				LayerSize:   layer.Size,
+				Fetched:     fetched,
+				Total:       total,
+			})
+		}),
+	)
+	if err != nil {
+		if errdefs.IsNotFound(err) {
+			return status.Error(codes.NotFound, err.Error())
+		}
+
+		return err
+	}
+
+	if sendErr != nil {
+		return sendErr
+	}
+
+	return srv.Send(&machine.ImagePullResponse{
+		Name:   img.Name,
+		Digest: img.Target.Digest.String(),
+	})
+}
+
+// criImagePuller pulls the image into the CRI containerd, it is overridden in tests.
+var criImagePuller = func(ctx context.Context, reg config.Registries, ref string, opts ...image.PullOption) (images.Image, error) {
+	client, err := containerd.New(constants.CRIContainerdAddress)
+	if err != nil {
+		return images.Image{}, fmt.Errorf("error creating CRI containerd client: %w", err)
+	}
+
+	defer client.Close() //nolint:errcheck
+
+	img, err := image.Pull(ctx, reg, client, ref, opts...)
+	if err != nil {
+		return images.Image{}, err
+	}
+
+	return img.Metadata(), nil
+}
+
+// ImageRemove removes images from the CRI.
+//
+// A message is streamed back for each removed image.
+func (s *Server) ImageRemove(req *machine.ImageRemoveRequest, srv machine.MachineService_ImageRemoveServer) error {
+	namespace, err := containerdNamespaceHelper(req.GetNamespace())
+	if err != nil {
+		return err
+	}
+
+	if len(req.GetReferences()) == 0 {
+		return status.Error(codes.InvalidArgument, "image reference is required")
+	}
+
+	client, err := containerd.New(constants.CRIContainerdAddress)
+	if err != nil {
+		return fmt.Errorf("error creating CRI containerd client: %w", err)
+	}
+
+	defer client.Close() //nolint:errcheck
+
+	ctx := namespaces.WithNamespace(srv.Context(), namespace)
+
+	for _, ref := range req.GetReferences() {
+		img, err := client.ImageService().Get(ctx, ref)
+		if err == nil {
+			err = client.ImageService().Delete(ctx, ref, images.SynchronousDelete())
+		}
+
+		if err != nil {
+			if errdefs.IsNotFound(err) {
+				return status.Errorf(codes.NotFound, "image %q not found", ref)
+			}
+
+			return fmt.Errorf("error removing image %q: %w", ref, err)
+		}
+
+		if err = srv.Send(&machine.ImageRemoveResponse{
+			Name:   img.Name,
+			Digest: img.Target.Digest.String(),
+		}); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// containerdNamespaceHelper maps API namespace selector to the containerd namespace.
+//
+// Both namespaces live in the CRI containerd instance: the system one holds images
+// pulled by Talos itself (kubelet, etcd), while the CRI one is used by Kubernetes.
+func containerdNamespaceHelper(ns common.ContainerdNamespace) (string, error) {
+	switch ns { //nolint:exhaustive
+	case common.ContainerdNamespace_NS_SYSTEM:
+		return constants.SystemContainerdNamespace, nil
+	case common.ContainerdNamespace_NS_CRI:
+		return criconstants.K8sContainerdNamespace, nil
+	default:
+		return "", status.Errorf(codes.InvalidArgument, "unsupported containerd namespace %q", ns)
+	}
+}
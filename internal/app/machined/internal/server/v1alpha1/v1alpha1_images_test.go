@@ -0,0 +1,201 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package runtime_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"path/filepath"
+	"testing"
+
+	"github.com/containerd/containerd/errdefs"
+	"github.com/containerd/containerd/images"
+	"github.com/containerd/containerd/namespaces"
+	"github.com/opencontainers/go-digest"
+	ocispec "github.com/opencontainers/image-spec/specs-go/v1"
+	"github.com/stretchr/testify/suite"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/status"
+
+	runtime "github.com/siderolabs/talos/internal/app/machined/internal/server/v1alpha1"
+	v1alpha1runtime "github.com/siderolabs/talos/internal/app/machined/pkg/runtime"
+	"github.com/siderolabs/talos/internal/pkg/containers/image"
+	"github.com/siderolabs/talos/pkg/grpc/dialer"
+	"github.com/siderolabs/talos/pkg/grpc/factory"
+	"github.com/siderolabs/talos/pkg/machinery/api/common"
+	"github.com/siderolabs/talos/pkg/machinery/api/machine"
+	"github.com/siderolabs/talos/pkg/machinery/config"
+	configconfig "github.com/siderolabs/talos/pkg/machinery/config/config"
+	"github.com/siderolabs/talos/pkg/machinery/config/container"
+	"github.com/siderolabs/talos/pkg/machinery/config/types/v1alpha1"
+	"github.com/siderolabs/talos/pkg/machinery/constants"
+)
+
+type ImagesSuite struct {
+	suite.Suite
+
+	client machine.MachineServiceClient
+}
+
+func TestImagesSuite(t *testing.T) {
+	suite.Run(t, new(ImagesSuite))
+}
+
+type mockController struct {
+	v1alpha1runtime.Controller
+}
+
+func (*mockController) Runtime() v1alpha1runtime.Runtime {
+	return &mockRuntime{}
+}
+
+type mockRuntime struct {
+	v1alpha1runtime.Runtime
+}
+
+func (*mockRuntime) Config() config.Config {
+	return container.NewV1Alpha1(&v1alpha1.Config{
+		MachineConfig: &v1alpha1.MachineConfig{},
+	})
+}
+
+// imagesServer registers only the machine service, as the full server requires the resource state.
+type imagesServer struct {
+	*runtime.Server
+}
+
+func (s imagesServer) Register(obj *grpc.Server) {
+	machine.RegisterMachineServiceServer(obj, s.Server)
+}
+
+func (suite *ImagesSuite) SetupTest() {
+	server := factory.NewServer(imagesServer{&runtime.Server{Controller: &mockController{}}})
+
+	listener, err := factory.NewListener(
+		factory.Network("unix"),
+		factory.SocketPath(filepath.Join(suite.T().TempDir(), "machined.sock")),
+	)
+	suite.Require().NoError(err)
+
+	//nolint:errcheck
+	go server.Serve(listener)
+
+	suite.T().Cleanup(server.Stop)
+
+	conn, err := grpc.Dial(
+		fmt.Sprintf("%s://%s", "unix", listener.Addr().String()),
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+		grpc.WithContextDialer(dialer.DialUnix()),
+	)
+	suite.Require().NoError(err)
+
+	suite.T().Cleanup(func() { conn.Close() }) //nolint:errcheck
+
+	suite.client = machine.NewMachineServiceClient(conn)
+}
+
+func (suite *ImagesSuite) pull(req *machine.ImagePullRequest) ([]*machine.ImagePullResponse, error) {
+	stream, err := suite.client.ImagePull(context.Background(), req)
+	suite.Require().NoError(err)
+
+	var msgs []*machine.ImagePullResponse
+
+	for {
+		msg, err := stream.Recv()
+		if errors.Is(err, io.EOF) {
+			return msgs, nil
+		}
+
+		if err != nil {
+			return msgs, err
+		}
+
+		msgs = append(msgs, msg)
+	}
+}
+
+func (suite *ImagesSuite) TestPullInvalidArgument() {
+	suite.T().Cleanup(runtime.SetCRIImagePuller(func(context.Context, configconfig.Registries, string, ...image.PullOption) (images.Image, error) {
+		suite.Fail("image should not be pulled")
+
+		return images.Image{}, nil
+	}))
+
+	_, err := suite.pull(&machine.ImagePullRequest{
+		Namespace: common.ContainerdNamespace_NS_UNKNOWN,
+		Reference: "ghcr.io/siderolabs/kubelet:v1.27.2",
+	})
+	suite.Assert().Equal(codes.InvalidArgument, status.Code(err))
+
+	_, err = suite.pull(&machine.ImagePullRequest{
+		Namespace: common.ContainerdNamespace_NS_CRI,
+	})
+	suite.Assert().Equal(codes.InvalidArgument, status.Code(err))
+}
+
+func (suite *ImagesSuite) TestPullProgress() {
+	layer1 := ocispec.Descriptor{MediaType: ocispec.MediaTypeImageLayerGzip, Digest: digest.FromString("layer1"), Size: 300}
+	layer2 := ocispec.Descriptor{MediaType: ocispec.MediaTypeImageLayerGzip, Digest: digest.FromString("layer2"), Size: 100}
+	target := digest.FromString("manifest")
+
+	suite.T().Cleanup(runtime.SetCRIImagePuller(func(ctx context.Context, _ configconfig.Registries, ref string, opts ...image.PullOption) (images.Image, error) {
+		ns, _ := namespaces.Namespace(ctx)
+		suite.Assert().Equal(constants.SystemContainerdNamespace, ns)
+
+		var options image.PullOptions
+
+		for _, opt := range opts {
+			opt(&options)
+		}
+
+		suite.Require().NotNil(options.Progress)
+
+		options.Progress(layer1, 100, 100, 400) // partially fetched layers are not sent
+		options.Progress(layer1, 300, 300, 400)
+		options.Progress(layer2, 100, 400, 400)
+
+		return images.Image{
+			Name:   ref,
+			Target: ocispec.Descriptor{Digest: target},
+		}, nil
+	}))
+
+	msgs, err := suite.pull(&machine.ImagePullRequest{
+		Namespace: common.ContainerdNamespace_NS_SYSTEM,
+		Reference: "ghcr.io/siderolabs/kubelet:v1.27.2",
+	})
+	suite.Require().NoError(err)
+	suite.Require().Len(msgs, 3)
+
+	suite.Assert().Equal(layer1.Digest.String(), msgs[0].LayerDigest)
+	suite.Assert().EqualValues(300, msgs[0].LayerSize)
+	suite.Assert().EqualValues(300, msgs[0].Fetched)
+	suite.Assert().EqualValues(400, msgs[0].Total)
+
+	suite.Assert().Equal(layer2.Digest.String(), msgs[1].LayerDigest)
+	suite.Assert().EqualValues(100, msgs[1].LayerSize)
+	suite.Assert().EqualValues(400, msgs[1].Fetched)
+	suite.Assert().EqualValues(400, msgs[1].Total)
+
+	suite.Assert().Equal("ghcr.io/siderolabs/kubelet:v1.27.2", msgs[2].Name)
+	suite.Assert().Equal(target.String(), msgs[2].Digest)
+	suite.Assert().Empty(msgs[2].LayerDigest)
+}
+
+func (suite *ImagesSuite) TestPullNotFound() {
+	suite.T().Cleanup(runtime.SetCRIImagePuller(func(context.Context, configconfig.Registries, string, ...image.PullOption) (images.Image, error) {
+		return images.Image{}, fmt.Errorf("failed to resolve: %w", errdefs.ErrNotFound)
+	}))
+
+	_, err := suite.pull(&machine.ImagePullRequest{
+		Namespace: common.ContainerdNamespace_NS_CRI,
+		Reference: "ghcr.io/siderolabs/missing:v1.0.0",
+	})
+	suite.Assert().Equal(codes.NotFound, status.Code(err))
+}
@@ -37,7 +37,6 @@ import (
 	"github.com/prometheus/procfs"
 	"github.com/rs/xid"
 	"github.com/siderolabs/gen/slices"
-	"github.com/siderolabs/go-blockdevice/blockdevice/partition/gpt"
 	bddisk "github.com/siderolabs/go-blockdevice/blockdevice/util/disk"
 	"github.com/siderolabs/go-kmsg"
 	"github.com/siderolabs/go-pointer"
@@ -148,7 +147,10 @@ func (s *Server) Register(obj *grpc.Server) {
 	cosiv1alpha1.RegisterStateServer(obj, server.NewState(resourceState))
 	inspect.RegisterInspectServiceServer(obj, &InspectServer{server: s})
 	storage.RegisterStorageServiceServer(obj, &storaged.Server{Controller: s.Controller})
-	timeapi.RegisterTimeServiceServer(obj, &TimeServer{ConfigProvider: s.Controller.Runtime()})
+	timeapi.RegisterTimeServiceServer(obj, &TimeServer{
+		ConfigProvider: s.Controller.Runtime(),
+		Resources:      s.Controller.Runtime().State().V1Alpha2().Resources(),
+	})
 }
 
 // ApplyConfiguration implements machine.MachineService.
@@ -306,6 +308,21 @@ func (s *Server) Reboot(ctx context.Context, in *machine.RebootRequest) (reply *
 		return nil, err
 	}
 
+	if in.GetDryRun() {
+		plan, err := s.Controller.Plan(runtime.SequenceReboot, in)
+		if err != nil {
+			return nil, fmt.Errorf("error planning reboot: %w", err)
+		}
+
+		return &machine.RebootResponse{
+			Messages: []*machine.Reboot{
+				{
+					Plan: plan,
+				},
+			},
+		}, nil
+	}
+
 	rebootCtx := context.WithValue(context.Background(), runtime.ActorIDCtxKey{}, actorID)
 
 	go func() {
@@ -451,33 +468,30 @@ func (s *Server) Upgrade(ctx context.Context, in *machine.UpgradeRequest) (*mach
 		return nil, err
 	}
 
-	log.Printf("upgrade request received: preserve %v, staged %v, force %v", in.GetPreserve(), in.GetStage(), in.GetForce())
-
-	log.Printf("validating %q", in.GetImage())
+	log.Printf("upgrade request received: preserve %v, staged %v, force %v, dry run %v", in.GetPreserve(), in.GetStage(), in.GetForce(), in.GetDryRun())
 
-	if err := install.PullAndValidateInstallerImage(ctx, s.Controller.Runtime().Config().Machine().Registries(), in.GetImage()); err != nil {
-		return nil, fmt.Errorf("error validating installer image %q: %w", in.GetImage(), err)
+	if in.GetDryRun() {
+		return s.upgradeDryRun(ctx, in)
 	}
 
-	if s.Controller.Runtime().Config().Machine().Type() != machinetype.TypeWorker && !in.GetForce() {
-		client, err := etcd.NewClientFromControlPlaneIPs(ctx, s.Controller.Runtime().State().V1Alpha2().Resources())
-		if err != nil {
-			return nil, fmt.Errorf("failed to create etcd client: %w", err)
-		}
-
-		// acquire the upgrade mutex
-		if mu, err = upgradeMutex(client); err != nil {
-			return nil, fmt.Errorf("failed to acquire upgrade mutex: %w", err)
-		}
-
-		if err = mu.TryLock(ctx); err != nil {
-			return nil, fmt.Errorf("failed to acquire upgrade lock: %w", err)
-		}
+	if err := s.validateInstallerImage(ctx, in.GetImage(),
+		install.WithProgress(func(message string, percent int) {
+			s.Controller.Runtime().Events().Publish(ctx, &machine.UpgradeProgressEvent{
+				Task:    "pullInstallerImage",
+				Action:  machine.UpgradeProgressEvent_PROGRESS,
+				Percent: int32(percent),
+				Message: message,
+			})
+		}),
+	); err != nil {
+		return nil, err
+	}
 
-		if err = client.ValidateForUpgrade(ctx, s.Controller.Runtime().Config(), in.GetPreserve()); err != nil {
-			mu.Unlock(ctx) //nolint:errcheck
+	if s.upgradeRequiresEtcdValidation(in) {
+		var err error
 
-			return nil, fmt.Errorf("error validating etcd for upgrade: %w", err)
+		if mu, err = s.lockEtcdForUpgrade(ctx, in); err != nil {
+			return nil, err
 		}
 	}
 
@@ -543,6 +557,130 @@ func (s *Server) Upgrade(ctx context.Context, in *machine.UpgradeRequest) (*mach
 	}, nil
 }
 
+// upgradeDryRun returns the plan of the upgrade along with the results of the pre-flight checks.
+//
+// The pre-flight checks are the same as for the actual upgrade, but the upgrade lock is released right away.
+func (s *Server) upgradeDryRun(ctx context.Context, in *machine.UpgradeRequest) (*machine.UpgradeResponse, error) {
+	seq := runtime.SequenceUpgrade
+	if in.GetStage() {
+		seq = runtime.SequenceStageUpgrade
+	}
+
+	plan, err := s.Controller.Plan(seq, in)
+	if err != nil {
+		return nil, fmt.Errorf("error planning upgrade: %w", err)
+	}
+
+	// dry run checks are read-only: the installer image is not pulled, and the upgrade lock is not acquired
+	plan.Checks = append(plan.Checks, planCheck("resolveInstallerImage", s.resolveInstallerImage(ctx, in.GetImage())))
+
+	if s.upgradeRequiresEtcdValidation(in) {
+		plan.Checks = append(plan.Checks, planCheck("validateEtcd", s.checkEtcdForUpgrade(ctx, in)))
+	}
+
+	return &machine.UpgradeResponse{
+		Messages: []*machine.Upgrade{
+			{
+				Ack:  "Upgrade dry run",
+				Plan: plan,
+			},
+		},
+	}, nil
+}
+
+func planCheck(name string, err error) *machine.SequencePlanCheck {
+	check := &machine.SequencePlanCheck{
+		Name: name,
+	}
+
+	if err != nil {
+		check.Error = err.Error()
+	}
+
+	return check
+}
+
+// validateInstallerImage pulls the installer image and validates that it can be used for the upgrade.
+func (s *Server) validateInstallerImage(ctx context.Context, image string, opts ...install.Option) error {
+	log.Printf("validating %q", image)
+
+	if err := install.PullAndValidateInstallerImage(ctx, s.Controller.Runtime().Config().Machine().Registries(), image, opts...); err != nil {
+		return fmt.Errorf("error validating installer image %q: %w", image, err)
+	}
+
+	return nil
+}
+
+// resolveInstallerImage checks that the installer image exists in the registry without pulling it.
+func (s *Server) resolveInstallerImage(ctx context.Context, image string) error {
+	if err := install.ResolveInstallerImage(ctx, s.Controller.Runtime().Config().Machine().Registries(), image); err != nil {
+		return fmt.Errorf("error resolving installer image %q: %w", image, err)
+	}
+
+	return nil
+}
+
+// upgradeRequiresEtcdValidation returns true if the etcd cluster should be checked before the upgrade.
+func (s *Server) upgradeRequiresEtcdValidation(in *machine.UpgradeRequest) bool {
+	return s.Controller.Runtime().Config().Machine().Type() != machinetype.TypeWorker && !in.GetForce()
+}
+
+// lockEtcdForUpgrade acquires the upgrade lock and validates the etcd cluster state for the upgrade.
+//
+// The returned mutex should be unlocked once the upgrade is done.
+func (s *Server) lockEtcdForUpgrade(ctx context.Context, in *machine.UpgradeRequest) (*concurrency.Mutex, error) {
+	client, err := etcd.NewClientFromControlPlaneIPs(ctx, s.Controller.Runtime().State().V1Alpha2().Resources())
+	if err != nil {
+		return nil, fmt.Errorf("failed to create etcd client: %w", err)
+	}
+
+	// acquire the upgrade mutex
+	mu, err := upgradeMutex(client)
+	if err != nil {
+		return nil, fmt.Errorf("failed to acquire upgrade mutex: %w", err)
+	}
+
+	if err = mu.TryLock(ctx); err != nil {
+		return nil, fmt.Errorf("failed to acquire upgrade lock: %w", err)
+	}
+
+	if err = client.ValidateForUpgrade(ctx, s.Controller.Runtime().Config(), in.GetPreserve()); err != nil {
+		mu.Unlock(ctx) //nolint:errcheck
+
+		return nil, fmt.Errorf("error validating etcd for upgrade: %w", err)
+	}
+
+	return mu, nil
+}
+
+// checkEtcdForUpgrade validates the etcd cluster state for the upgrade without acquiring the upgrade lock.
+//
+// If the upgrade lock is held by another node, the upgrade would fail, so it is reported as an error.
+func (s *Server) checkEtcdForUpgrade(ctx context.Context, in *machine.UpgradeRequest) error {
+	client, err := etcd.NewClientFromControlPlaneIPs(ctx, s.Controller.Runtime().State().V1Alpha2().Resources())
+	if err != nil {
+		return fmt.Errorf("failed to create etcd client: %w", err)
+	}
+
+	defer client.Close() //nolint:errcheck
+
+	// the upgrade mutex is held while there is a key under its prefix
+	resp, err := client.Get(ctx, constants.EtcdTalosEtcdUpgradeMutex+"/", clientv3.WithPrefix(), clientv3.WithCountOnly())
+	if err != nil {
+		return fmt.Errorf("failed to check upgrade lock: %w", err)
+	}
+
+	if resp.Count > 0 {
+		return errors.New("upgrade lock is held by another upgrade")
+	}
+
+	if err = client.ValidateForUpgrade(ctx, s.Controller.Runtime().Config(), in.GetPreserve()); err != nil {
+		return fmt.Errorf("error validating etcd for upgrade: %w", err)
+	}
+
+	return nil
+}
+
 // ResetOptions implements runtime.ResetOptions interface.
 type ResetOptions struct {
 	*machine.ResetRequest
@@ -560,8 +698,6 @@ func (opt *ResetOptions) GetSystemDiskTargets() []runtime.PartitionTarget {
 }
 
 // Reset resets the node.
-//
-//nolint:gocyclo,cyclop
 func (s *Server) Reset(ctx context.Context, in *machine.ResetRequest) (reply *machine.ResetResponse, err error) {
 	actorID := uuid.New().String()
 
@@ -571,16 +707,64 @@ func (s *Server) Reset(ctx context.Context, in *machine.ResetRequest) (reply *ma
 		ResetRequest: in,
 	}
 
+	validationErr := s.validateResetRequest(in, &opts)
+
+	if in.GetDryRun() {
+		var plan *machine.SequencePlan
+
+		plan, err = s.Controller.Plan(runtime.SequenceReset, &opts)
+		if err != nil {
+			return nil, fmt.Errorf("error planning reset: %w", err)
+		}
+
+		plan.Checks = append(plan.Checks, planCheck("validateResetRequest", validationErr))
+
+		return &machine.ResetResponse{
+			Messages: []*machine.Reset{
+				{
+					Plan: plan,
+				},
+			},
+		}, nil
+	}
+
+	if validationErr != nil {
+		return nil, validationErr
+	}
+
+	resetCtx := context.WithValue(context.Background(), runtime.ActorIDCtxKey{}, actorID)
+
+	go func() {
+		if err := s.Controller.Run(resetCtx, runtime.SequenceReset, &opts); err != nil {
+			if !runtime.IsRebootError(err) {
+				log.Println("reset failed:", err)
+			}
+		}
+	}()
+
+	reply = &machine.ResetResponse{
+		Messages: []*machine.Reset{
+			{
+				ActorId: actorID,
+			},
+		},
+	}
+
+	return reply, nil
+}
+
+// validateResetRequest validates the disks and partitions to wipe, and resolves the system disk targets into the options.
+//
+//nolint:gocyclo
+func (s *Server) validateResetRequest(in *machine.ResetRequest, opts *ResetOptions) error {
 	if len(in.GetUserDisksToWipe()) > 0 {
 		if in.Mode == machine.ResetRequest_SYSTEM_DISK {
-			return nil, fmt.Errorf("reset failed: invalid input, wipe mode SYSTEM_DISK doesn't support UserDisksToWipe parameter")
+			return fmt.Errorf("reset failed: invalid input, wipe mode SYSTEM_DISK doesn't support UserDisksToWipe parameter")
 		}
 
-		var diskList []*bddisk.Disk
-
-		diskList, err = bddisk.List()
+		diskList, err := bddisk.List()
 		if err != nil {
-			return nil, err
+			return err
 		}
 
 		disks := slices.ToMap(diskList, func(disk *bddisk.Disk) (string, *bddisk.Disk) {
@@ -593,42 +777,40 @@ func (s *Server) Reset(ctx context.Context, in *machine.ResetRequest) (reply *ma
 		for _, deviceName := range in.GetUserDisksToWipe() {
 			disk, ok := disks[deviceName]
 			if !ok {
-				return nil, fmt.Errorf("reset user disk failed: device %s wasn't found", deviceName)
+				return fmt.Errorf("reset user disk failed: device %s wasn't found", deviceName)
 			}
 
 			if disk.ReadOnly {
-				return nil, fmt.Errorf("reset user disk failed: device %s is readonly", deviceName)
+				return fmt.Errorf("reset user disk failed: device %s is readonly", deviceName)
 			}
 
 			if systemDisk != nil && deviceName == systemDisk.Device().Name() {
-				return nil, fmt.Errorf("reset user disk failed: device %s is the system disk", deviceName)
+				return fmt.Errorf("reset user disk failed: device %s is the system disk", deviceName)
 			}
 		}
 	}
 
 	if len(in.GetSystemPartitionsToWipe()) > 0 {
 		if in.Mode == machine.ResetRequest_USER_DISKS {
-			return nil, fmt.Errorf("reset failed: invalid input, wipe mode USER_DISKS doesn't support SystemPartitionsToWipe parameter")
+			return fmt.Errorf("reset failed: invalid input, wipe mode USER_DISKS doesn't support SystemPartitionsToWipe parameter")
 		}
 
 		bd := s.Controller.Runtime().State().Machine().Disk().BlockDevice
 
-		var pt *gpt.GPT
-
-		pt, err = bd.PartitionTable()
+		pt, err := bd.PartitionTable()
 		if err != nil {
-			return nil, fmt.Errorf("error reading partition table: %w", err)
+			return fmt.Errorf("error reading partition table: %w", err)
 		}
 
 		for _, spec := range in.GetSystemPartitionsToWipe() {
 			target, err := installer.ParseTarget(spec.Label, bd.Device().Name())
 			if err != nil {
-				return nil, err
+				return err
 			}
 
 			_, err = target.Locate(pt)
 			if err != nil {
-				return nil, fmt.Errorf("failed location partition with label %q: %w", spec.Label, err)
+				return fmt.Errorf("failed location partition with label %q: %w", spec.Label, err)
 			}
 
 			if spec.Wipe {
@@ -637,25 +819,7 @@ func (s *Server) Reset(ctx context.Context, in *machine.ResetRequest) (reply *ma
 		}
 	}
 
-	resetCtx := context.WithValue(context.Background(), runtime.ActorIDCtxKey{}, actorID)
-
-	go func() {
-		if err := s.Controller.Run(resetCtx, runtime.SequenceReset, &opts); err != nil {
-			if !runtime.IsRebootError(err) {
-				log.Println("reset failed:", err)
-			}
-		}
-	}()
-
-	reply = &machine.ResetResponse{
-		Messages: []*machine.Reset{
-			{
-				ActorId: actorID,
-			},
-		},
-	}
-
-	return reply, nil
+	return nil
 }
 
 // ServiceList returns list of the registered services and their status.
@@ -1201,6 +1365,10 @@ func (s *Server) Logs(req *machine.LogsRequest, l machine.MachineService_LogsSer
 			options = append(options, runtime.WithTailLines(int(req.TailLines)))
 		}
 
+		if req.Boot != 0 {
+			options = append(options, runtime.WithBoot(int(req.Boot)))
+		}
+
 		var logR io.ReadCloser
 
 		logR, err = s.Controller.Runtime().Logging().ServiceLog(req.Id).Reader(options...)
@@ -1213,6 +1381,10 @@ func (s *Server) Logs(req *machine.LogsRequest, l machine.MachineService_LogsSer
 
 		chunk = stream.NewChunker(l.Context(), logR)
 	default:
+		if req.Boot != 0 {
+			return status.Error(codes.InvalidArgument, "boot selector is supported only for system services")
+		}
+
 		var file io.Closer
 
 		if chunk, file, err = k8slogs(l.Context(), req); err != nil {
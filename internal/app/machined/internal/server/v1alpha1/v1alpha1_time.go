@@ -7,16 +7,21 @@ package runtime
 import (
 	"context"
 	"fmt"
+	"strings"
 	"time"
 
 	"github.com/beevik/ntp"
+	"github.com/cosi-project/runtime/pkg/safe"
+	"github.com/cosi-project/runtime/pkg/state"
 	"google.golang.org/grpc"
 	"google.golang.org/protobuf/types/known/emptypb"
 	"google.golang.org/protobuf/types/known/timestamppb"
 
+	"github.com/siderolabs/talos/internal/pkg/ntp/nts"
 	timeapi "github.com/siderolabs/talos/pkg/machinery/api/time"
 	"github.com/siderolabs/talos/pkg/machinery/config"
 	"github.com/siderolabs/talos/pkg/machinery/constants"
+	timeresource "github.com/siderolabs/talos/pkg/machinery/resources/time"
 )
 
 // ConfigProvider defines an interface sufficient for the TimeServer.
@@ -29,6 +34,8 @@ type TimeServer struct {
 	timeapi.UnimplementedTimeServiceServer
 
 	ConfigProvider ConfigProvider
+	// Resources is used to report the node time sync status, optional.
+	Resources state.State
 }
 
 // Register implements the factory.Registrator interface.
@@ -50,8 +57,16 @@ func (r *TimeServer) Time(ctx context.Context, in *emptypb.Empty) (reply *timeap
 }
 
 // TimeCheck issues a query to the specified ntp server and displays the results.
+//
+// Servers in `nts://` format are queried using NTS.
 func (r *TimeServer) TimeCheck(ctx context.Context, in *timeapi.TimeRequest) (reply *timeapi.TimeResponse, err error) {
-	rt, err := ntp.Query(in.Server)
+	query := ntp.Query
+
+	if strings.HasPrefix(in.Server, nts.SchemePrefix) {
+		query = nts.NewClient().Query
+	}
+
+	rt, err := query(in.Server)
 	if err != nil {
 		return nil, fmt.Errorf("error querying NTP server %q: %w", in.Server, err)
 	}
@@ -60,13 +75,28 @@ func (r *TimeServer) TimeCheck(ctx context.Context, in *timeapi.TimeRequest) (re
 		return nil, fmt.Errorf("error validating NTP response: %w", err)
 	}
 
+	msg := &timeapi.Time{
+		Server:     in.Server,
+		Localtime:  timestamppb.New(time.Now()),
+		Remotetime: timestamppb.New(rt.Time),
+		Stratum:    uint32(rt.Stratum),
+	}
+
+	if r.Resources != nil {
+		var status *timeresource.Status
+
+		status, err = safe.StateGet[*timeresource.Status](ctx, r.Resources, timeresource.NewStatus().Metadata())
+		if err != nil && !state.IsNotFoundError(err) {
+			return nil, fmt.Errorf("error getting time status: %w", err)
+		}
+
+		if status != nil {
+			msg.Synced = status.TypedSpec().Synced
+			msg.SyncServer = status.TypedSpec().SyncServer
+		}
+	}
+
 	return &timeapi.TimeResponse{
-		Messages: []*timeapi.Time{
-			{
-				Server:     in.Server,
-				Localtime:  timestamppb.New(time.Now()),
-				Remotetime: timestamppb.New(rt.Time),
-			},
-		},
+		Messages: []*timeapi.Time{msg},
 	}, nil
 }
@@ -0,0 +1,53 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package network
+
+import (
+	"github.com/mdlayher/netlink"
+	"golang.org/x/sys/unix"
+
+	"github.com/siderolabs/talos/pkg/machinery/nethelpers"
+	"github.com/siderolabs/talos/pkg/machinery/resources/network"
+)
+
+// IPVLANSpec adapter provides encoding/decoding to netlink structures.
+//
+//nolint:revive,golint
+func IPVLANSpec(r *network.IPVLANSpec) ipvlanSpec {
+	return ipvlanSpec{
+		IPVLANSpec: r,
+	}
+}
+
+type ipvlanSpec struct {
+	*network.IPVLANSpec
+}
+
+// Encode the IPVLANSpec into netlink attributes.
+func (a ipvlanSpec) Encode() ([]byte, error) {
+	encoder := netlink.NewAttributeEncoder()
+
+	encoder.Uint16(unix.IFLA_IPVLAN_MODE, uint16(a.IPVLANSpec.Mode))
+
+	return encoder.Encode()
+}
+
+// Decode the IPVLANSpec from netlink attributes.
+func (a ipvlanSpec) Decode(data []byte) error {
+	ipvlan := a.IPVLANSpec
+
+	decoder, err := netlink.NewAttributeDecoder(data)
+	if err != nil {
+		return err
+	}
+
+	for decoder.Next() {
+		if decoder.Type() == unix.IFLA_IPVLAN_MODE {
+			ipvlan.Mode = nethelpers.IPVLANMode(decoder.Uint16())
+		}
+	}
+
+	return decoder.Err()
+}
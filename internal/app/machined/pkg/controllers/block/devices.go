@@ -0,0 +1,188 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package block
+
+import (
+	"context"
+	"fmt"
+	"path/filepath"
+
+	"github.com/cosi-project/runtime/pkg/controller"
+	"github.com/cosi-project/runtime/pkg/resource"
+	"github.com/cosi-project/runtime/pkg/safe"
+	"go.uber.org/zap"
+
+	runtimetalos "github.com/siderolabs/talos/internal/app/machined/pkg/runtime"
+	"github.com/siderolabs/talos/internal/pkg/kobject"
+	"github.com/siderolabs/talos/pkg/machinery/resources/block"
+)
+
+// DevicesController provides a view of available block devices with information about their hardware.
+type DevicesController struct {
+	V1Alpha1Mode runtimetalos.Mode
+
+	// SysfsRoot is the path to the sysfs, defaults to /sys.
+	SysfsRoot string
+
+	generations map[string]int
+}
+
+// Name implements controller.Controller interface.
+func (ctrl *DevicesController) Name() string {
+	return "block.DevicesController"
+}
+
+// Inputs implements controller.Controller interface.
+func (ctrl *DevicesController) Inputs() []controller.Input {
+	return nil
+}
+
+// Outputs implements controller.Controller interface.
+func (ctrl *DevicesController) Outputs() []controller.Output {
+	return []controller.Output{
+		{
+			Type: block.BlockDeviceType,
+			Kind: controller.OutputExclusive,
+		},
+	}
+}
+
+// Run implements controller.Controller interface.
+//
+//nolint:gocyclo
+func (ctrl *DevicesController) Run(ctx context.Context, r controller.Runtime, logger *zap.Logger) error {
+	// block devices are managed by the host in container mode
+	if ctrl.V1Alpha1Mode == runtimetalos.ModeContainer {
+		return nil
+	}
+
+	if ctrl.SysfsRoot == "" {
+		ctrl.SysfsRoot = "/sys"
+	}
+
+	if ctrl.generations == nil {
+		ctrl.generations = map[string]int{}
+	}
+
+	// start watching before the initial scan, so that no events are missed
+	watcher, err := kobject.NewWatcher()
+	if err != nil {
+		return fmt.Errorf("error creating uevent watcher: %w", err)
+	}
+
+	defer watcher.Close() //nolint:errcheck
+
+	watchCtx, watchCancel := context.WithCancel(ctx)
+	defer watchCancel()
+
+	evCh := watcher.Run(watchCtx, logger)
+
+	if err = ctrl.resync(ctx, r); err != nil {
+		return err
+	}
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-r.EventCh():
+			continue
+		case ev, ok := <-evCh:
+			if !ok {
+				return fmt.Errorf("uevent watcher stopped")
+			}
+
+			if !ctrl.processEvent(ev) {
+				continue
+			}
+		}
+
+		// coalesce the events which are already queued into a single resync
+	drain:
+		for {
+			select {
+			case ev, ok := <-evCh:
+				if !ok {
+					return fmt.Errorf("uevent watcher stopped")
+				}
+
+				ctrl.processEvent(ev)
+			default:
+				break drain
+			}
+		}
+
+		if err = ctrl.resync(ctx, r); err != nil {
+			return err
+		}
+
+		r.ResetRestartBackoff()
+	}
+}
+
+// processEvent returns true if the event requires a resync.
+func (ctrl *DevicesController) processEvent(ev *kobject.Event) bool {
+	if ev.Action == kobject.ActionOverflow {
+		return true
+	}
+
+	if ev.Subsystem != "block" {
+		return false
+	}
+
+	// kernel device name matches the name in /sys/class/block
+	id := filepath.Base(ev.DevPath)
+
+	switch ev.Action {
+	case kobject.ActionChange:
+		ctrl.generations[id]++
+	case kobject.ActionRemove:
+		delete(ctrl.generations, id)
+	}
+
+	return true
+}
+
+func (ctrl *DevicesController) resync(ctx context.Context, r controller.Runtime) error {
+	devices, err := scanSysfs(ctrl.SysfsRoot)
+	if err != nil {
+		return err
+	}
+
+	touchedIDs := make(map[resource.ID]struct{}, len(devices))
+
+	for _, device := range devices {
+		device := device
+
+		touchedIDs[device.ID] = struct{}{}
+
+		if err = safe.WriterModify(ctx, r, block.NewBlockDevice(block.NamespaceName, device.ID), func(res *block.BlockDevice) error {
+			*res.TypedSpec() = device.Spec
+			res.TypedSpec().Generation = ctrl.generations[device.ID]
+
+			return nil
+		}); err != nil {
+			return fmt.Errorf("error updating block device %q: %w", device.ID, err)
+		}
+	}
+
+	// list keys for cleanup
+	list, err := safe.ReaderListAll[*block.BlockDevice](ctx, r)
+	if err != nil {
+		return fmt.Errorf("error listing resources: %w", err)
+	}
+
+	for iter := safe.IteratorFromList(list); iter.Next(); {
+		res := iter.Value()
+
+		if _, ok := touchedIDs[res.Metadata().ID()]; !ok {
+			if err = r.Destroy(ctx, res.Metadata()); err != nil {
+				return fmt.Errorf("error cleaning up block devices: %w", err)
+			}
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,183 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package block
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/cosi-project/runtime/pkg/controller"
+	"github.com/cosi-project/runtime/pkg/resource"
+	"github.com/cosi-project/runtime/pkg/safe"
+	"go.uber.org/zap"
+
+	"github.com/siderolabs/talos/pkg/machinery/resources/block"
+)
+
+// DiscoveryController probes the block devices and publishes the discovered partition tables and filesystems.
+type DiscoveryController struct {
+	// cache of the probe results, the key is the block device ID
+	cache map[resource.ID]discoveryCacheEntry
+}
+
+type discoveryCacheEntry struct {
+	version string
+	result  probeResult
+}
+
+// Name implements controller.Controller interface.
+func (ctrl *DiscoveryController) Name() string {
+	return "block.DiscoveryController"
+}
+
+// Inputs implements controller.Controller interface.
+func (ctrl *DiscoveryController) Inputs() []controller.Input {
+	return []controller.Input{
+		{
+			Namespace: block.NamespaceName,
+			Type:      block.BlockDeviceType,
+			Kind:      controller.InputWeak,
+		},
+	}
+}
+
+// Outputs implements controller.Controller interface.
+func (ctrl *DiscoveryController) Outputs() []controller.Output {
+	return []controller.Output{
+		{
+			Type: block.DiscoveredVolumeType,
+			Kind: controller.OutputExclusive,
+		},
+	}
+}
+
+// Run implements controller.Controller interface.
+//
+//nolint:gocyclo,cyclop
+func (ctrl *DiscoveryController) Run(ctx context.Context, r controller.Runtime, logger *zap.Logger) error {
+	if ctrl.cache == nil {
+		ctrl.cache = map[resource.ID]discoveryCacheEntry{}
+	}
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-r.EventCh():
+		}
+
+		devices, err := safe.ReaderListAll[*block.BlockDevice](ctx, r)
+		if err != nil {
+			return fmt.Errorf("error listing block devices: %w", err)
+		}
+
+		disks := map[resource.ID]*block.BlockDevice{}
+
+		for iter := safe.IteratorFromList(devices); iter.Next(); {
+			if iter.Value().TypedSpec().Type == block.DeviceTypeDisk {
+				disks[iter.Value().Metadata().ID()] = iter.Value()
+			}
+		}
+
+		touchedIDs := make(map[resource.ID]struct{}, devices.Len())
+
+		// disks are probed first, as partitions use the partition table of the disk
+		for _, probeDisks := range []bool{true, false} {
+			for iter := safe.IteratorFromList(devices); iter.Next(); {
+				device := iter.Value()
+
+				if (device.TypedSpec().Type == block.DeviceTypeDisk) != probeDisks {
+					continue
+				}
+
+				id := device.Metadata().ID()
+				touchedIDs[id] = struct{}{}
+
+				version := device.Metadata().Version().String()
+
+				if !probeDisks {
+					if parent, ok := disks[device.TypedSpec().Parent]; ok {
+						version += "/" + parent.Metadata().Version().String()
+					}
+				}
+
+				entry, ok := ctrl.cache[id]
+
+				if !ok || entry.version != version {
+					var result probeResult
+
+					if probeDisks {
+						result, err = probeDisk(device.TypedSpec().DevPath)
+					} else {
+						result, err = probeFilesystem(device.TypedSpec().DevPath)
+					}
+
+					if err != nil {
+						// keep the volume published, as the device might be not readable (e.g. no media)
+						logger.Debug("error probing block device", zap.String("device", id), zap.Error(err))
+					}
+
+					entry = discoveryCacheEntry{
+						version: version,
+						result:  result,
+					}
+
+					ctrl.cache[id] = entry
+				}
+
+				var partition partitionInfo
+
+				if !probeDisks {
+					partition = ctrl.cache[device.TypedSpec().Parent].result.Partitions[device.TypedSpec().PartitionNumber]
+				}
+
+				if err = safe.WriterModify(ctx, r, block.NewDiscoveredVolume(block.NamespaceName, id), func(res *block.DiscoveredVolume) error {
+					spec := res.TypedSpec()
+
+					spec.Type = device.TypedSpec().Type
+					spec.DevPath = device.TypedSpec().DevPath
+					spec.Parent = device.TypedSpec().Parent
+					spec.Size = device.TypedSpec().Size
+					spec.PartitionTable = entry.result.PartitionTable
+					spec.PartitionIndex = device.TypedSpec().PartitionNumber
+					spec.PartitionLabel = partition.Label
+					spec.PartitionUUID = partition.UUID
+					spec.PartitionType = partition.Type
+					spec.FilesystemType = entry.result.FilesystemType
+					spec.Label = entry.result.Label
+					spec.UUID = entry.result.UUID
+
+					return nil
+				}); err != nil {
+					return fmt.Errorf("error updating discovered volume %q: %w", id, err)
+				}
+			}
+		}
+
+		for id := range ctrl.cache {
+			if _, ok := touchedIDs[id]; !ok {
+				delete(ctrl.cache, id)
+			}
+		}
+
+		// list keys for cleanup
+		list, err := safe.ReaderListAll[*block.DiscoveredVolume](ctx, r)
+		if err != nil {
+			return fmt.Errorf("error listing resources: %w", err)
+		}
+
+		for iter := safe.IteratorFromList(list); iter.Next(); {
+			res := iter.Value()
+
+			if _, ok := touchedIDs[res.Metadata().ID()]; !ok {
+				if err = r.Destroy(ctx, res.Metadata()); err != nil {
+					return fmt.Errorf("error cleaning up discovered volumes: %w", err)
+				}
+			}
+		}
+
+		r.ResetRestartBackoff()
+	}
+}
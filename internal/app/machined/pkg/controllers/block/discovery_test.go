@@ -0,0 +1,121 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package block_test
+
+import (
+	"encoding/binary"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/cosi-project/runtime/pkg/resource"
+	"github.com/cosi-project/runtime/pkg/resource/rtestutils"
+	"github.com/siderolabs/go-blockdevice/blockdevice/partition/gpt"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+
+	blockctrls "github.com/siderolabs/talos/internal/app/machined/pkg/controllers/block"
+	"github.com/siderolabs/talos/internal/app/machined/pkg/controllers/ctest"
+	"github.com/siderolabs/talos/pkg/machinery/resources/block"
+)
+
+type DiscoverySuite struct {
+	ctest.DefaultSuite
+}
+
+func TestDiscoverySuite(t *testing.T) {
+	suite.Run(t, &DiscoverySuite{
+		DefaultSuite: ctest.DefaultSuite{
+			Timeout: 10 * time.Second,
+			AfterSetup: func(suite *ctest.DefaultSuite) {
+				suite.Require().NoError(suite.Runtime().RegisterController(&blockctrls.DiscoveryController{}))
+			},
+		},
+	})
+}
+
+func (suite *DiscoverySuite) createImage(name string, size int64) string {
+	path := filepath.Join(suite.T().TempDir(), name)
+
+	f, err := os.Create(path)
+	suite.Require().NoError(err)
+
+	suite.Require().NoError(f.Truncate(size))
+	suite.Require().NoError(f.Close())
+
+	return path
+}
+
+func (suite *DiscoverySuite) TestDiscovery() {
+	diskPath := suite.createImage("disk", 16*1024*1024)
+
+	f, err := os.OpenFile(diskPath, os.O_RDWR, 0)
+	suite.Require().NoError(err)
+
+	pt, err := gpt.New(f)
+	suite.Require().NoError(err)
+
+	_, err = pt.Add(1024*1024, gpt.WithPartitionName("EPHEMERAL"))
+	suite.Require().NoError(err)
+
+	// syncing the kernel partitions fails for a regular file, but the partition table is written at that point
+	pt.Write() //nolint:errcheck
+
+	suite.Require().NoError(f.Close())
+
+	// ext4 superblock with the magic and the label
+	partPath := suite.createImage("part", 1024*1024)
+
+	f, err = os.OpenFile(partPath, os.O_RDWR, 0)
+	suite.Require().NoError(err)
+
+	_, err = f.WriteAt(binary.LittleEndian.AppendUint16(nil, 0xef53), 1024+0x38)
+	suite.Require().NoError(err)
+
+	_, err = f.WriteAt([]byte("DATA"), 1024+0x78)
+	suite.Require().NoError(err)
+
+	suite.Require().NoError(f.Close())
+
+	disk := block.NewBlockDevice(block.NamespaceName, "vda")
+	disk.TypedSpec().Type = block.DeviceTypeDisk
+	disk.TypedSpec().DevPath = diskPath
+	disk.TypedSpec().Size = 16 * 1024 * 1024
+	suite.Require().NoError(suite.State().Create(suite.Ctx(), disk))
+
+	part := block.NewBlockDevice(block.NamespaceName, "vda1")
+	part.TypedSpec().Type = block.DeviceTypePartition
+	part.TypedSpec().DevPath = partPath
+	part.TypedSpec().Parent = "vda"
+	part.TypedSpec().PartitionNumber = 1
+	part.TypedSpec().Size = 1024 * 1024
+	suite.Require().NoError(suite.State().Create(suite.Ctx(), part))
+
+	rtestutils.AssertResources(suite.Ctx(), suite.T(), suite.State(), []resource.ID{"vda"},
+		func(r *block.DiscoveredVolume, asrt *assert.Assertions) {
+			asrt.Equal(block.DeviceTypeDisk, r.TypedSpec().Type)
+			asrt.Equal("gpt", r.TypedSpec().PartitionTable)
+			asrt.Empty(r.TypedSpec().FilesystemType)
+		},
+	)
+
+	rtestutils.AssertResources(suite.Ctx(), suite.T(), suite.State(), []resource.ID{"vda1"},
+		func(r *block.DiscoveredVolume, asrt *assert.Assertions) {
+			asrt.Equal(block.DeviceTypePartition, r.TypedSpec().Type)
+			asrt.Equal("vda", r.TypedSpec().Parent)
+			asrt.Equal(1, r.TypedSpec().PartitionIndex)
+			asrt.Equal("EPHEMERAL", r.TypedSpec().PartitionLabel)
+			asrt.NotEmpty(r.TypedSpec().PartitionUUID)
+			asrt.Equal("ext4", r.TypedSpec().FilesystemType)
+			asrt.Equal("DATA", r.TypedSpec().Label)
+		},
+	)
+
+	// device removal
+	suite.Require().NoError(suite.State().Destroy(suite.Ctx(), part.Metadata()))
+
+	rtestutils.AssertNoResource[*block.DiscoveredVolume](suite.Ctx(), suite.T(), suite.State(), "vda1")
+}
@@ -0,0 +1,210 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package block
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+
+	"github.com/google/uuid"
+	"github.com/siderolabs/go-blockdevice/blockdevice/filesystem"
+	"github.com/siderolabs/go-blockdevice/blockdevice/filesystem/ext4"
+	"github.com/siderolabs/go-blockdevice/blockdevice/filesystem/iso9660"
+	"github.com/siderolabs/go-blockdevice/blockdevice/filesystem/luks"
+	"github.com/siderolabs/go-blockdevice/blockdevice/filesystem/msdos"
+	"github.com/siderolabs/go-blockdevice/blockdevice/filesystem/vfat"
+	"github.com/siderolabs/go-blockdevice/blockdevice/filesystem/xfs"
+	"github.com/siderolabs/go-blockdevice/blockdevice/partition/gpt"
+)
+
+// Partition table types.
+const (
+	partitionTableGPT = "gpt"
+	partitionTableDOS = "dos"
+)
+
+// partitionInfo is the information about the partition from the partition table.
+type partitionInfo struct {
+	Label string
+	UUID  string
+	Type  string
+}
+
+// probeResult is the result of probing the device contents.
+type probeResult struct {
+	PartitionTable string
+	// Partitions is only filled for the GPT partition table, key is the partition number.
+	Partitions map[int]partitionInfo
+
+	FilesystemType string
+	Label          string
+	UUID           string
+}
+
+// probeDisk detects the partition table or the filesystem on the whole disk.
+func probeDisk(path string) (probeResult, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return probeResult{}, err
+	}
+
+	defer f.Close() //nolint:errcheck
+
+	var result probeResult
+
+	if g, err := gpt.Open(f); err == nil {
+		if err = g.Read(); err == nil {
+			result.PartitionTable = partitionTableGPT
+			result.Partitions = map[int]partitionInfo{}
+
+			for _, part := range g.Partitions().Items() {
+				if part == nil {
+					continue
+				}
+
+				result.Partitions[int(part.Number)] = partitionInfo{
+					Label: part.Name,
+					UUID:  part.ID.String(),
+					Type:  strings.ToUpper(part.Type.String()),
+				}
+			}
+
+			return result, nil
+		}
+	}
+
+	if result, err = probeFilesystem(path); err != nil || result.FilesystemType != "" {
+		return result, err
+	}
+
+	// FAT filesystems have the same signature, so check for the MBR after the filesystems
+	mbr := make([]byte, 512)
+
+	if _, err = f.ReadAt(mbr, 0); err != nil {
+		if errors.Is(err, io.EOF) {
+			return result, nil
+		}
+
+		return result, err
+	}
+
+	if mbr[510] == 0x55 && mbr[511] == 0xaa {
+		result.PartitionTable = partitionTableDOS
+	}
+
+	return result, nil
+}
+
+// Offsets of the filesystem identifiers which are not decoded by the superblocks.
+const (
+	// ext4UUIDOffset is the offset of s_uuid: the superblock is at 0x400, and s_uuid is at 0x68 in the superblock.
+	ext4UUIDOffset = 0x468
+	// fat16SerialOffset is the offset of the volume serial number in the FAT12/FAT16 boot sector.
+	fat16SerialOffset = 0x27
+)
+
+// probeFilesystem detects the filesystem on the device.
+//
+//nolint:gocyclo
+func probeFilesystem(path string) (probeResult, error) {
+	sb, err := filesystem.Probe(path)
+	if err != nil {
+		if errors.Is(err, io.EOF) || errors.Is(err, io.ErrUnexpectedEOF) {
+			// device is too small to contain the filesystem
+			return probeResult{}, nil
+		}
+
+		return probeResult{}, err
+	}
+
+	if sb == nil {
+		return probeResult{}, nil
+	}
+
+	result := probeResult{
+		FilesystemType: sb.Type(),
+	}
+
+	switch sb := sb.(type) {
+	case *xfs.SuperBlock:
+		result.Label = trimLabel(sb.Fname[:])
+		result.UUID = uuid.UUID(sb.UUID).String()
+	case *ext4.SuperBlock:
+		result.Label = trimLabel(sb.Label[:])
+
+		var id []byte
+
+		if id, err = readAt(path, ext4UUIDOffset, 16); err != nil {
+			return result, err
+		}
+
+		result.UUID = uuid.UUID(id).String()
+	case *vfat.SuperBlock:
+		result.Label = trimFATLabel(sb.Label[:])
+		result.UUID = fatSerial(sb.Serno[:])
+	case *msdos.SuperBlock:
+		result.Label = trimFATLabel(sb.Label[:])
+
+		var serial []byte
+
+		if serial, err = readAt(path, fat16SerialOffset, 4); err != nil {
+			return result, err
+		}
+
+		result.UUID = fatSerial(serial)
+	case *luks.SuperBlock:
+		result.Label = trimLabel(sb.Label[:])
+		result.UUID = trimLabel(sb.UUID[:])
+	case *iso9660.SuperBlock:
+		result.Label = trimLabel(sb.VolumeID[:])
+	}
+
+	return result, nil
+}
+
+// readAt reads the bytes of the device at the offset.
+func readAt(path string, off int64, size int) ([]byte, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+
+	defer f.Close() //nolint:errcheck
+
+	b := make([]byte, size)
+
+	if _, err = f.ReadAt(b, off); err != nil {
+		return nil, err
+	}
+
+	return b, nil
+}
+
+// fatSerial formats the FAT volume serial number the way blkid does, e.g. 1A2B-3C4D.
+func fatSerial(b []byte) string {
+	serial := binary.LittleEndian.Uint32(b)
+
+	return fmt.Sprintf("%04X-%04X", serial>>16, serial&0xffff)
+}
+
+func trimLabel(b []byte) string {
+	return string(bytes.TrimSpace(bytes.TrimRight(b, "\x00")))
+}
+
+func trimFATLabel(b []byte) string {
+	label := trimLabel(b)
+
+	// FAT default label
+	if label == "NO NAME" {
+		return ""
+	}
+
+	return label
+}
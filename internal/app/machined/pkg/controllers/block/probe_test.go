@@ -0,0 +1,112 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package block //nolint:testpackage
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// writeImage writes a disk image with the chunks of data at the offsets.
+func writeImage(t *testing.T, chunks map[int64][]byte) string {
+	t.Helper()
+
+	path := filepath.Join(t.TempDir(), "disk.img")
+
+	// the image should be large enough to hold all probed superblocks
+	image := make([]byte, 1024*1024)
+
+	for off, data := range chunks {
+		copy(image[off:], data)
+	}
+
+	require.NoError(t, os.WriteFile(path, image, 0o644))
+
+	return path
+}
+
+func TestProbeFilesystem(t *testing.T) {
+	t.Parallel()
+
+	for _, test := range []struct {
+		name   string
+		chunks map[int64][]byte
+
+		expected probeResult
+	}{
+		{
+			name: "ext4",
+			chunks: map[int64][]byte{
+				0x438: {0x53, 0xef},
+				0x468: {
+					0x6b, 0x7a, 0x53, 0x2c, 0x0e, 0x7d, 0x4a, 0x1b,
+					0x9f, 0x3a, 0x2f, 0x8e, 0x5d, 0x4c, 0x1a, 0x0b,
+				},
+				0x478: []byte("EPHEMERAL"),
+			},
+			expected: probeResult{
+				FilesystemType: "ext4",
+				Label:          "EPHEMERAL",
+				UUID:           "6b7a532c-0e7d-4a1b-9f3a-2f8e5d4c1a0b",
+			},
+		},
+		{
+			name: "vfat",
+			chunks: map[int64][]byte{
+				0x43:  {0x4d, 0x3c, 0x2b, 0x1a},
+				0x47:  []byte("EFI        "),
+				0x52:  []byte("FAT32   "),
+				0x1fe: {0x55, 0xaa},
+			},
+			expected: probeResult{
+				FilesystemType: "vfat",
+				Label:          "EFI",
+				UUID:           "1A2B-3C4D",
+			},
+		},
+		{
+			name: "msdos",
+			chunks: map[int64][]byte{
+				0x27:  {0x78, 0x56, 0x34, 0x12},
+				0x2b:  []byte("NO NAME    "),
+				0x36:  []byte("FAT16   "),
+				0x1fe: {0x55, 0xaa},
+			},
+			expected: probeResult{
+				FilesystemType: "vfat",
+				UUID:           "1234-5678",
+			},
+		},
+		{
+			name: "empty",
+		},
+	} {
+		test := test
+
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			result, err := probeFilesystem(writeImage(t, test.chunks))
+			require.NoError(t, err)
+
+			assert.Equal(t, test.expected, result)
+		})
+	}
+}
+
+func TestProbeDiskMBR(t *testing.T) {
+	t.Parallel()
+
+	result, err := probeDisk(writeImage(t, map[int64][]byte{
+		0x1fe: {0x55, 0xaa},
+	}))
+	require.NoError(t, err)
+
+	assert.Equal(t, probeResult{PartitionTable: partitionTableDOS}, result)
+}
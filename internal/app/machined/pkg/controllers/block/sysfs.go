@@ -0,0 +1,196 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package block
+
+import (
+	"bufio"
+	"bytes"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+
+	"github.com/siderolabs/talos/pkg/machinery/resources/block"
+)
+
+const sectorSize = 512
+
+type sysfsDevice struct {
+	ID   string
+	Spec block.BlockDeviceSpec
+}
+
+// scanSysfs lists the block devices via the /sys/class/block.
+func scanSysfs(sysfsRoot string) ([]sysfsDevice, error) {
+	classPath := filepath.Join(sysfsRoot, "class", "block")
+
+	entries, err := os.ReadDir(classPath)
+	if err != nil {
+		return nil, fmt.Errorf("error listing block devices: %w", err)
+	}
+
+	devices := make([]sysfsDevice, 0, len(entries))
+
+	for _, entry := range entries {
+		device, err := readSysfsDevice(sysfsRoot, filepath.Join(classPath, entry.Name()))
+		if err != nil {
+			if os.IsNotExist(err) {
+				// the device was removed while being scanned
+				continue
+			}
+
+			return nil, fmt.Errorf("error reading block device %q: %w", entry.Name(), err)
+		}
+
+		if device.Spec.Size == 0 {
+			// skip devices without media (e.g. unused loop devices, empty CD-ROMs)
+			continue
+		}
+
+		devices = append(devices, device)
+	}
+
+	return devices, nil
+}
+
+//nolint:gocyclo
+func readSysfsDevice(sysfsRoot, path string) (sysfsDevice, error) {
+	id := filepath.Base(path)
+
+	resolved, err := filepath.EvalSymlinks(path)
+	if err != nil {
+		return sysfsDevice{}, err
+	}
+
+	device := sysfsDevice{
+		ID: id,
+		Spec: block.BlockDeviceSpec{
+			Type:    block.DeviceTypeDisk,
+			DevPath: "/dev/" + strings.ReplaceAll(id, "!", "/"),
+		},
+	}
+
+	// attributes of the hardware are only available on the disk, so partitions use the parent ones
+	attrPath := resolved
+
+	if partition, err := readSysfsString(resolved, "partition"); err == nil {
+		device.Spec.Type = block.DeviceTypePartition
+		device.Spec.Parent = filepath.Base(filepath.Dir(resolved))
+
+		if device.Spec.PartitionNumber, err = strconv.Atoi(partition); err != nil {
+			return sysfsDevice{}, fmt.Errorf("error parsing partition number: %w", err)
+		}
+
+		attrPath = filepath.Dir(resolved)
+	}
+
+	dev, err := readSysfsString(resolved, "dev")
+	if err != nil {
+		return sysfsDevice{}, err
+	}
+
+	if _, err = fmt.Sscanf(dev, "%d:%d", &device.Spec.Major, &device.Spec.Minor); err != nil {
+		return sysfsDevice{}, fmt.Errorf("error parsing device number: %w", err)
+	}
+
+	size, err := readSysfsString(resolved, "size")
+	if err != nil {
+		return sysfsDevice{}, err
+	}
+
+	sectors, err := strconv.ParseUint(size, 10, 64)
+	if err != nil {
+		return sysfsDevice{}, fmt.Errorf("error parsing device size: %w", err)
+	}
+
+	device.Spec.Size = sectors * sectorSize
+
+	if devName := readUeventValue(resolved, "DEVNAME"); devName != "" {
+		device.Spec.DevPath = "/dev/" + devName
+	}
+
+	readOnly, _ := readSysfsString(resolved, "ro") //nolint:errcheck
+	device.Spec.ReadOnly = readOnly == "1"
+
+	rotational, _ := readSysfsString(attrPath, "queue/rotational") //nolint:errcheck
+	device.Spec.Rotational = rotational == "1"
+
+	device.Spec.Model = readSysfsFirst(attrPath, "device/model")
+	device.Spec.Serial = readSysfsFirst(attrPath, "serial", "device/serial")
+	device.Spec.WWID = readSysfsFirst(attrPath, "wwid", "device/wwid")
+
+	devicesPath := filepath.Join(sysfsRoot, "devices")
+
+	if rel, err := filepath.Rel(devicesPath, filepath.Dir(attrPath)); err == nil && !strings.HasPrefix(rel, "..") {
+		busPath := "/" + filepath.ToSlash(rel)
+
+		device.Spec.BusPath = strings.TrimSuffix(busPath, "/block")
+		device.Spec.Transport = transport(device.Spec.BusPath)
+	}
+
+	return device, nil
+}
+
+// transport detects the device transport from the bus path.
+func transport(busPath string) string {
+	switch {
+	case strings.Contains(busPath, "/nvme"):
+		return "nvme"
+	case strings.Contains(busPath, "/usb"):
+		return "usb"
+	case strings.Contains(busPath, "/ata"):
+		return "sata"
+	case strings.Contains(busPath, "/mmc_host/"):
+		return "mmc"
+	case strings.HasPrefix(busPath, "/virtual"):
+		return "virtual"
+	case strings.Contains(busPath, "/host") && strings.Contains(busPath, "/target"):
+		return "scsi"
+	case strings.Contains(busPath, "/virtio"):
+		return "virtio"
+	default:
+		return ""
+	}
+}
+
+func readSysfsString(path, attr string) (string, error) {
+	contents, err := os.ReadFile(filepath.Join(path, attr))
+	if err != nil {
+		return "", err
+	}
+
+	return string(bytes.TrimSpace(contents)), nil
+}
+
+// readSysfsFirst returns the first non-empty attribute value.
+func readSysfsFirst(path string, attrs ...string) string {
+	for _, attr := range attrs {
+		if value, err := readSysfsString(path, attr); err == nil && value != "" {
+			return value
+		}
+	}
+
+	return ""
+}
+
+func readUeventValue(path, key string) string {
+	f, err := os.Open(filepath.Join(path, "uevent"))
+	if err != nil {
+		return ""
+	}
+
+	defer f.Close() //nolint:errcheck
+
+	scanner := bufio.NewScanner(f)
+
+	for scanner.Scan() {
+		if value, ok := strings.CutPrefix(scanner.Text(), key+"="); ok {
+			return value
+		}
+	}
+
+	return ""
+}
@@ -0,0 +1,126 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package block //nolint:testpackage
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/siderolabs/talos/pkg/machinery/resources/block"
+)
+
+func writeSysfs(t *testing.T, root string, files map[string]string) {
+	t.Helper()
+
+	for path, contents := range files {
+		path = filepath.Join(root, path)
+
+		require.NoError(t, os.MkdirAll(filepath.Dir(path), 0o755))
+		require.NoError(t, os.WriteFile(path, []byte(contents+"\n"), 0o644))
+	}
+}
+
+func TestScanSysfs(t *testing.T) {
+	t.Parallel()
+
+	root := t.TempDir()
+
+	const (
+		vda   = "devices/pci0000:00/0000:00:05.0/virtio2/block/vda"
+		sda   = "devices/pci0000:00/0000:00:1f.2/ata1/host0/target0:0:0/0:0:0:0/block/sda"
+		loop0 = "devices/virtual/block/loop0"
+	)
+
+	writeSysfs(t, root, map[string]string{
+		vda + "/dev":              "252:0",
+		vda + "/size":             "20971520",
+		vda + "/ro":               "0",
+		vda + "/uevent":           "MAJOR=252\nMINOR=0\nDEVNAME=vda\nDEVTYPE=disk",
+		vda + "/serial":           "SERIAL123",
+		vda + "/queue/rotational": "1",
+		vda + "/vda1/dev":         "252:1",
+		vda + "/vda1/size":        "2048",
+		vda + "/vda1/ro":          "0",
+		vda + "/vda1/partition":   "1",
+		vda + "/vda1/uevent":      "MAJOR=252\nMINOR=1\nDEVNAME=vda1\nDEVTYPE=partition",
+
+		sda + "/dev":              "8:0",
+		sda + "/size":             "1000215216",
+		sda + "/ro":               "0",
+		sda + "/queue/rotational": "0",
+		sda + "/device/model":     "Samsung SSD 860",
+		sda + "/device/wwid":      "t10.ATA Samsung SSD 860",
+
+		loop0 + "/dev":  "7:0",
+		loop0 + "/size": "0",
+		loop0 + "/ro":   "0",
+	})
+
+	require.NoError(t, os.MkdirAll(filepath.Join(root, "class", "block"), 0o755))
+
+	for name, target := range map[string]string{
+		"vda":   vda,
+		"vda1":  vda + "/vda1",
+		"sda":   sda,
+		"loop0": loop0,
+	} {
+		require.NoError(t, os.Symlink(filepath.Join("..", "..", target), filepath.Join(root, "class", "block", name)))
+	}
+
+	devices, err := scanSysfs(root)
+	require.NoError(t, err)
+
+	assert.Equal(t, []sysfsDevice{
+		{
+			ID: "sda",
+			Spec: block.BlockDeviceSpec{
+				Type:       block.DeviceTypeDisk,
+				DevPath:    "/dev/sda",
+				Major:      8,
+				Minor:      0,
+				Size:       1000215216 * 512,
+				Model:      "Samsung SSD 860",
+				WWID:       "t10.ATA Samsung SSD 860",
+				Transport:  "sata",
+				BusPath:    "/pci0000:00/0000:00:1f.2/ata1/host0/target0:0:0/0:0:0:0",
+				Rotational: false,
+			},
+		},
+		{
+			ID: "vda",
+			Spec: block.BlockDeviceSpec{
+				Type:       block.DeviceTypeDisk,
+				DevPath:    "/dev/vda",
+				Major:      252,
+				Minor:      0,
+				Size:       20971520 * 512,
+				Serial:     "SERIAL123",
+				Transport:  "virtio",
+				BusPath:    "/pci0000:00/0000:00:05.0/virtio2",
+				Rotational: true,
+			},
+		},
+		{
+			ID: "vda1",
+			Spec: block.BlockDeviceSpec{
+				Type:            block.DeviceTypePartition,
+				DevPath:         "/dev/vda1",
+				Major:           252,
+				Minor:           1,
+				Parent:          "vda",
+				PartitionNumber: 1,
+				Size:            2048 * 512,
+				Serial:          "SERIAL123",
+				Transport:       "virtio",
+				BusPath:         "/pci0000:00/0000:00:05.0/virtio2",
+				Rotational:      true,
+			},
+		},
+	}, devices)
+}
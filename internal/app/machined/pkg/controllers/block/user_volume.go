@@ -0,0 +1,193 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package block
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/cosi-project/runtime/pkg/controller"
+	"github.com/cosi-project/runtime/pkg/resource"
+	"github.com/cosi-project/runtime/pkg/safe"
+	"github.com/cosi-project/runtime/pkg/state"
+	"github.com/siderolabs/go-pointer"
+	"go.uber.org/zap"
+
+	runtimetalos "github.com/siderolabs/talos/internal/app/machined/pkg/runtime"
+	"github.com/siderolabs/talos/internal/pkg/uservolume"
+	talosconfig "github.com/siderolabs/talos/pkg/machinery/config/config"
+	"github.com/siderolabs/talos/pkg/machinery/constants"
+	"github.com/siderolabs/talos/pkg/machinery/resources/block"
+	"github.com/siderolabs/talos/pkg/machinery/resources/config"
+	runtimeres "github.com/siderolabs/talos/pkg/machinery/resources/runtime"
+)
+
+// UserVolumeController provisions and mounts the user volumes, and publishes their status.
+type UserVolumeController struct {
+	V1Alpha1Mode runtimetalos.Mode
+
+	// status of the mounted volumes, the key is the volume name
+	mounted map[string]block.VolumeStatusSpec
+}
+
+// Name implements controller.Controller interface.
+func (ctrl *UserVolumeController) Name() string {
+	return "block.UserVolumeController"
+}
+
+// Inputs implements controller.Controller interface.
+func (ctrl *UserVolumeController) Inputs() []controller.Input {
+	return []controller.Input{
+		{
+			Namespace: config.NamespaceName,
+			Type:      config.MachineConfigType,
+			ID:        pointer.To(config.V1Alpha1ID),
+			Kind:      controller.InputWeak,
+		},
+		{
+			Namespace: runtimeres.NamespaceName,
+			Type:      runtimeres.MountStatusType,
+			ID:        pointer.To(constants.EphemeralPartitionLabel),
+			Kind:      controller.InputWeak,
+		},
+		{
+			Namespace: block.NamespaceName,
+			Type:      block.BlockDeviceType,
+			Kind:      controller.InputWeak,
+		},
+		{
+			Namespace: block.NamespaceName,
+			Type:      block.DiscoveredVolumeType,
+			Kind:      controller.InputWeak,
+		},
+	}
+}
+
+// Outputs implements controller.Controller interface.
+func (ctrl *UserVolumeController) Outputs() []controller.Output {
+	return []controller.Output{
+		{
+			Type: block.VolumeStatusType,
+			Kind: controller.OutputExclusive,
+		},
+	}
+}
+
+// Run implements controller.Controller interface.
+//
+//nolint:gocyclo,cyclop
+func (ctrl *UserVolumeController) Run(ctx context.Context, r controller.Runtime, logger *zap.Logger) error {
+	// in container mode there are no block devices to provision the volumes on
+	if ctrl.V1Alpha1Mode == runtimetalos.ModeContainer {
+		return nil
+	}
+
+	if ctrl.mounted == nil {
+		ctrl.mounted = map[string]block.VolumeStatusSpec{}
+	}
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-r.EventCh():
+		}
+
+		// user volumes are mounted under /var, so wait for the EPHEMERAL to be mounted
+		_, err := safe.ReaderGet[*runtimeres.MountStatus](ctx, r, runtimeres.NewMountStatus(runtimeres.NamespaceName, constants.EphemeralPartitionLabel).Metadata())
+		if err != nil {
+			if state.IsNotFoundError(err) {
+				continue
+			}
+
+			return fmt.Errorf("error getting ephemeral mount status: %w", err)
+		}
+
+		cfg, err := safe.ReaderGetByID[*config.MachineConfig](ctx, r, config.V1Alpha1ID)
+		if err != nil && !state.IsNotFoundError(err) {
+			return fmt.Errorf("error getting machine config: %w", err)
+		}
+
+		var volumes []talosconfig.UserVolumeConfig
+
+		if cfg != nil {
+			volumes = cfg.Config().UserVolumes()
+		}
+
+		touchedIDs := make(map[resource.ID]struct{}, len(volumes))
+
+		for _, volume := range volumes {
+			touchedIDs[volume.Name()] = struct{}{}
+
+			// mounted volumes are left as is, the changes to their configuration are applied on the next boot
+			status, mounted := ctrl.mounted[volume.Name()]
+
+			if !mounted {
+				spec, err := uservolume.Mount(ctx, logger, r, volume)
+
+				switch {
+				case err == nil:
+					status = *spec
+					ctrl.mounted[volume.Name()] = status
+				case errors.Is(err, uservolume.ErrDiscoveryIncomplete):
+					status = block.VolumeStatusSpec{
+						Phase: block.VolumePhaseWaiting,
+					}
+				default:
+					// failed volumes are retried on the next change of the block devices or the configuration
+					logger.Error("error mounting user volume", zap.String("volume", volume.Name()), zap.Error(err))
+
+					status = block.VolumeStatusSpec{
+						Phase:        block.VolumePhaseFailed,
+						ErrorMessage: err.Error(),
+					}
+				}
+			}
+
+			if err = safe.WriterModify(ctx, r, block.NewVolumeStatus(block.NamespaceName, volume.Name()), func(res *block.VolumeStatus) error {
+				*res.TypedSpec() = status
+
+				return nil
+			}); err != nil {
+				return fmt.Errorf("error updating volume status: %w", err)
+			}
+		}
+
+		// unmount the volumes which were removed from the configuration
+		for name := range ctrl.mounted {
+			if _, ok := touchedIDs[name]; ok {
+				continue
+			}
+
+			if err = uservolume.Unmount(name); err != nil {
+				return err
+			}
+
+			delete(ctrl.mounted, name)
+
+			logger.Info("unmounted user volume", zap.String("volume", name))
+		}
+
+		list, err := safe.ReaderListAll[*block.VolumeStatus](ctx, r)
+		if err != nil {
+			return fmt.Errorf("error listing volume statuses: %w", err)
+		}
+
+		for iter := safe.IteratorFromList(list); iter.Next(); {
+			res := iter.Value()
+
+			if _, ok := touchedIDs[res.Metadata().ID()]; ok {
+				continue
+			}
+
+			if err = r.Destroy(ctx, res.Metadata()); err != nil {
+				return fmt.Errorf("error destroying volume status: %w", err)
+			}
+		}
+
+		r.ResetRestartBackoff()
+	}
+}
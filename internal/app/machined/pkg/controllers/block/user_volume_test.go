@@ -0,0 +1,87 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package block_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/cosi-project/runtime/pkg/resource/rtestutils"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+
+	blockctrls "github.com/siderolabs/talos/internal/app/machined/pkg/controllers/block"
+	"github.com/siderolabs/talos/internal/app/machined/pkg/controllers/ctest"
+	"github.com/siderolabs/talos/pkg/machinery/config/container"
+	blockcfg "github.com/siderolabs/talos/pkg/machinery/config/types/block"
+	"github.com/siderolabs/talos/pkg/machinery/constants"
+	"github.com/siderolabs/talos/pkg/machinery/resources/block"
+	"github.com/siderolabs/talos/pkg/machinery/resources/config"
+	"github.com/siderolabs/talos/pkg/machinery/resources/runtime"
+)
+
+type UserVolumeSuite struct {
+	ctest.DefaultSuite
+}
+
+func TestUserVolumeSuite(t *testing.T) {
+	suite.Run(t, &UserVolumeSuite{
+		DefaultSuite: ctest.DefaultSuite{
+			Timeout: 10 * time.Second,
+			AfterSetup: func(suite *ctest.DefaultSuite) {
+				suite.Require().NoError(suite.Runtime().RegisterController(&blockctrls.UserVolumeController{}))
+			},
+		},
+	})
+}
+
+func (suite *UserVolumeSuite) assertPhase(phase, errorMessage string) {
+	rtestutils.AssertResources(suite.Ctx(), suite.T(), suite.State(), []string{"data"},
+		func(status *block.VolumeStatus, asrt *assert.Assertions) {
+			asrt.Equal(phase, status.TypedSpec().Phase)
+			asrt.Equal(errorMessage, status.TypedSpec().ErrorMessage)
+		})
+}
+
+func (suite *UserVolumeSuite) TestStatus() {
+	volume := blockcfg.NewUserVolumeConfigV1Alpha1()
+	volume.MetaName = "data"
+
+	cfg, err := container.New(volume)
+	suite.Require().NoError(err)
+
+	machineConfig := config.NewMachineConfig(cfg)
+	suite.Require().NoError(suite.State().Create(suite.Ctx(), machineConfig))
+
+	// EPHEMERAL is not mounted yet
+	time.Sleep(500 * time.Millisecond)
+	rtestutils.AssertNoResource[*block.VolumeStatus](suite.Ctx(), suite.T(), suite.State(), "data")
+
+	suite.Require().NoError(suite.State().Create(suite.Ctx(), runtime.NewMountStatus(runtime.NamespaceName, constants.EphemeralPartitionLabel)))
+
+	// no block devices discovered yet
+	suite.assertPhase(block.VolumePhaseWaiting, "")
+
+	disk := block.NewBlockDevice(block.NamespaceName, "sda")
+	disk.TypedSpec().Type = block.DeviceTypeDisk
+	disk.TypedSpec().DevPath = "/dev/sda"
+	suite.Require().NoError(suite.State().Create(suite.Ctx(), disk))
+
+	// the disk is not probed yet
+	suite.assertPhase(block.VolumePhaseWaiting, "")
+
+	// the disk is not used for the volumes, as it has a filesystem
+	discovered := block.NewDiscoveredVolume(block.NamespaceName, "sda")
+	discovered.TypedSpec().Type = block.DeviceTypeDisk
+	discovered.TypedSpec().FilesystemType = "xfs"
+	suite.Require().NoError(suite.State().Create(suite.Ctx(), discovered))
+
+	suite.assertPhase(block.VolumePhaseFailed, "no disk matching the disk selector with enough free space found")
+
+	// the status is removed with the volume configuration
+	suite.Require().NoError(suite.State().Destroy(suite.Ctx(), machineConfig.Metadata()))
+
+	rtestutils.AssertNoResource[*block.VolumeStatus](suite.Ctx(), suite.T(), suite.State(), "data")
+}
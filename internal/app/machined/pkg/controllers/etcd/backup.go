@@ -0,0 +1,494 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package etcd
+
+import (
+	"bufio"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/benbjohnson/clock"
+	"github.com/cosi-project/runtime/pkg/controller"
+	"github.com/cosi-project/runtime/pkg/resource"
+	"github.com/cosi-project/runtime/pkg/safe"
+	"github.com/cosi-project/runtime/pkg/state"
+	"github.com/siderolabs/go-pointer"
+	"go.uber.org/zap"
+
+	pkgetcd "github.com/siderolabs/talos/internal/pkg/etcd"
+	talosconfig "github.com/siderolabs/talos/pkg/machinery/config/config"
+	"github.com/siderolabs/talos/pkg/machinery/constants"
+	"github.com/siderolabs/talos/pkg/machinery/resources/config"
+	"github.com/siderolabs/talos/pkg/machinery/resources/etcd"
+	"github.com/siderolabs/talos/pkg/machinery/resources/v1alpha1"
+)
+
+const (
+	snapshotPrefix       = "etcd-"
+	snapshotExtension    = ".snapshot"
+	checksumExtension    = ".sha256"
+	snapshotTimestampFmt = "20060102T150405Z"
+)
+
+// BackupController takes scheduled etcd snapshots on the etcd leader and prunes them according to the retention policy.
+type BackupController struct {
+	// BackupPath is the directory to store snapshots in, defaults to constants.EtcdBackupPath.
+	BackupPath string
+	// SnapshotFunc returns a snapshot of the etcd database and its revision.
+	//
+	// If the local member is not the etcd leader, SnapshotFunc returns nil reader.
+	SnapshotFunc func(ctx context.Context) (io.ReadCloser, int64, error)
+	Clock        clock.Clock
+}
+
+// Name implements controller.Controller interface.
+func (ctrl *BackupController) Name() string {
+	return "etcd.BackupController"
+}
+
+// Inputs implements controller.Controller interface.
+func (ctrl *BackupController) Inputs() []controller.Input {
+	return []controller.Input{
+		{
+			Namespace: config.NamespaceName,
+			Type:      config.MachineConfigType,
+			ID:        pointer.To(config.V1Alpha1ID),
+			Kind:      controller.InputWeak,
+		},
+		{
+			Namespace: v1alpha1.NamespaceName,
+			Type:      v1alpha1.ServiceType,
+			ID:        pointer.To(etcdServiceID),
+			Kind:      controller.InputWeak,
+		},
+	}
+}
+
+// Outputs implements controller.Controller interface.
+func (ctrl *BackupController) Outputs() []controller.Output {
+	return []controller.Output{
+		{
+			Type: etcd.SnapshotType,
+			Kind: controller.OutputExclusive,
+		},
+	}
+}
+
+// Run implements controller.Controller interface.
+//
+//nolint:gocyclo,cyclop
+func (ctrl *BackupController) Run(ctx context.Context, r controller.Runtime, logger *zap.Logger) error {
+	if ctrl.BackupPath == "" {
+		ctrl.BackupPath = constants.EtcdBackupPath
+	}
+
+	if ctrl.SnapshotFunc == nil {
+		ctrl.SnapshotFunc = leaderSnapshot
+	}
+
+	if ctrl.Clock == nil {
+		ctrl.Clock = clock.New()
+	}
+
+	var (
+		ticker   *clock.Ticker
+		tickerC  <-chan time.Time
+		interval time.Duration
+
+		// snapshotCh is non-nil while a snapshot is being taken in the background
+		snapshotCh     chan error
+		snapshotCancel context.CancelFunc
+	)
+
+	defer func() {
+		if ticker != nil {
+			ticker.Stop()
+		}
+
+		if snapshotCh != nil {
+			snapshotCancel()
+
+			<-snapshotCh
+		}
+	}()
+
+	for {
+		var takeSnapshot bool
+
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-r.EventCh():
+		case <-tickerC:
+			takeSnapshot = true
+		case err := <-snapshotCh:
+			snapshotCancel()
+
+			snapshotCh, snapshotCancel = nil, nil
+
+			// snapshot failures are not fatal, the next attempt is made on the next tick
+			if err != nil {
+				logger.Error("failed to take etcd snapshot", zap.Error(err))
+			}
+		}
+
+		cfg, err := safe.ReaderGetByID[*config.MachineConfig](ctx, r, config.V1Alpha1ID)
+		if err != nil && !state.IsNotFoundError(err) {
+			return fmt.Errorf("error getting machine config: %w", err)
+		}
+
+		var backupConfig talosconfig.EtcdBackupConfig
+
+		if cfg != nil {
+			backupConfig = cfg.Config().EtcdBackup()
+		}
+
+		switch {
+		case backupConfig == nil && ticker != nil:
+			ticker.Stop()
+
+			ticker, tickerC, interval = nil, nil, 0
+		case backupConfig != nil && backupConfig.Interval() != interval:
+			if ticker != nil {
+				ticker.Stop()
+			}
+
+			interval = backupConfig.Interval()
+			ticker = ctrl.Clock.Ticker(interval)
+			tickerC = ticker.C
+		}
+
+		if takeSnapshot && backupConfig != nil && snapshotCh != nil {
+			logger.Warn("skipping etcd snapshot, previous snapshot is still in progress")
+		}
+
+		if takeSnapshot && backupConfig != nil && snapshotCh == nil {
+			etcdService, err := safe.ReaderGetByID[*v1alpha1.Service](ctx, r, etcdServiceID)
+			if err != nil && !state.IsNotFoundError(err) {
+				return fmt.Errorf("error getting etcd service resource: %w", err)
+			}
+
+			if etcdService != nil && etcdService.Metadata().Phase() == resource.PhaseRunning && etcdService.TypedSpec().Healthy {
+				snapshotCh, snapshotCancel = ctrl.startSnapshot(ctx, logger)
+			}
+		}
+
+		snapshots, err := ctrl.listSnapshots()
+		if err != nil {
+			return fmt.Errorf("error listing etcd snapshots: %w", err)
+		}
+
+		if backupConfig != nil {
+			snapshots, err = ctrl.prune(snapshots, backupConfig, logger)
+			if err != nil {
+				return fmt.Errorf("error pruning etcd snapshots: %w", err)
+			}
+		}
+
+		touchedIDs := make(map[resource.ID]struct{}, len(snapshots))
+
+		for _, snapshot := range snapshots {
+			if err = safe.WriterModify(ctx, r, etcd.NewSnapshot(etcd.NamespaceName, snapshot.id), func(res *etcd.Snapshot) error {
+				*res.TypedSpec() = snapshot.spec
+
+				return nil
+			}); err != nil {
+				return fmt.Errorf("error updating etcd snapshot resource: %w", err)
+			}
+
+			touchedIDs[snapshot.id] = struct{}{}
+		}
+
+		list, err := safe.ReaderListAll[*etcd.Snapshot](ctx, r)
+		if err != nil {
+			return fmt.Errorf("error listing etcd snapshot resources: %w", err)
+		}
+
+		for iter := safe.IteratorFromList(list); iter.Next(); {
+			res := iter.Value()
+
+			if _, ok := touchedIDs[res.Metadata().ID()]; ok {
+				continue
+			}
+
+			if err = r.Destroy(ctx, res.Metadata()); err != nil {
+				return fmt.Errorf("error destroying etcd snapshot resource: %w", err)
+			}
+		}
+
+		r.ResetRestartBackoff()
+	}
+}
+
+// startSnapshot takes a snapshot in the background, as it might take a while for a large database.
+//
+// The result is delivered to the returned channel, the snapshot is aborted by calling the returned cancel function.
+func (ctrl *BackupController) startSnapshot(ctx context.Context, logger *zap.Logger) (chan error, context.CancelFunc) {
+	ctx, cancel := context.WithCancel(ctx)
+	ch := make(chan error, 1)
+
+	go func() {
+		ch <- ctrl.snapshot(ctx, logger)
+	}()
+
+	return ch, cancel
+}
+
+// snapshot takes a snapshot and stores it along with the checksum file.
+func (ctrl *BackupController) snapshot(ctx context.Context, logger *zap.Logger) error {
+	rd, revision, err := ctrl.SnapshotFunc(ctx)
+	if err != nil {
+		return err
+	}
+
+	if rd == nil {
+		// not a leader
+		return nil
+	}
+
+	defer rd.Close() //nolint:errcheck
+
+	if err = os.MkdirAll(ctrl.BackupPath, 0o700); err != nil {
+		return err
+	}
+
+	name := snapshotName(ctrl.Clock.Now(), revision)
+	path := filepath.Join(ctrl.BackupPath, name+snapshotExtension)
+	partPath := path + ".part"
+
+	defer os.Remove(partPath) //nolint:errcheck
+
+	dest, err := os.OpenFile(partPath, os.O_WRONLY|os.O_CREATE|os.O_TRUNC, 0o600)
+	if err != nil {
+		return err
+	}
+
+	defer dest.Close() //nolint:errcheck
+
+	hash := sha256.New()
+
+	size, err := io.Copy(io.MultiWriter(dest, hash), rd)
+	if err != nil {
+		return fmt.Errorf("error writing snapshot: %w", err)
+	}
+
+	// etcd appends sha256 hash of the database to the snapshot, see go.etcd.io/etcd/client/v3/snapshot
+	if size%512 != sha256.Size {
+		return fmt.Errorf("etcd snapshot checksum not found (size %d)", size)
+	}
+
+	if err = dest.Sync(); err != nil {
+		return err
+	}
+
+	if err = dest.Close(); err != nil {
+		return err
+	}
+
+	checksum := hex.EncodeToString(hash.Sum(nil))
+
+	// checksum file uses sha256sum format, so that it can be verified with 'sha256sum -c'
+	if err = os.WriteFile(filepath.Join(ctrl.BackupPath, name+checksumExtension), []byte(checksum+"  "+name+snapshotExtension+"\n"), 0o600); err != nil {
+		return err
+	}
+
+	if err = os.Rename(partPath, path); err != nil {
+		return err
+	}
+
+	logger.Info("etcd snapshot saved", zap.String("path", path), zap.Int64("size", size), zap.Int64("revision", revision))
+
+	return nil
+}
+
+type backupSnapshot struct {
+	id   resource.ID
+	spec etcd.SnapshotSpec
+}
+
+// listSnapshots returns the list of complete snapshots, newest first.
+func (ctrl *BackupController) listSnapshots() ([]backupSnapshot, error) {
+	entries, err := os.ReadDir(ctrl.BackupPath)
+	if err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			return nil, nil
+		}
+
+		return nil, err
+	}
+
+	var snapshots []backupSnapshot
+
+	for _, entry := range entries {
+		if !entry.Type().IsRegular() || !strings.HasSuffix(entry.Name(), snapshotExtension) {
+			continue
+		}
+
+		name := strings.TrimSuffix(entry.Name(), snapshotExtension)
+
+		createdAt, revision, ok := parseSnapshotName(name)
+		if !ok {
+			continue
+		}
+
+		info, err := entry.Info()
+		if err != nil {
+			return nil, err
+		}
+
+		checksum, err := readChecksum(filepath.Join(ctrl.BackupPath, name+checksumExtension))
+		if err != nil {
+			return nil, err
+		}
+
+		snapshots = append(snapshots, backupSnapshot{
+			id: name,
+			spec: etcd.SnapshotSpec{
+				Path:      filepath.Join(ctrl.BackupPath, entry.Name()),
+				Size:      info.Size(),
+				SHA256:    checksum,
+				Revision:  revision,
+				CreatedAt: createdAt,
+			},
+		})
+	}
+
+	sort.Slice(snapshots, func(i, j int) bool {
+		return snapshots[i].spec.CreatedAt.After(snapshots[j].spec.CreatedAt)
+	})
+
+	return snapshots, nil
+}
+
+// prune removes snapshots which are outside of the retention policy.
+func (ctrl *BackupController) prune(snapshots []backupSnapshot, backupConfig talosconfig.EtcdBackupConfig, logger *zap.Logger) ([]backupSnapshot, error) {
+	now := ctrl.Clock.Now()
+	kept := snapshots[:0]
+
+	for i, snapshot := range snapshots {
+		expired := (backupConfig.RetentionCount() > 0 && i >= backupConfig.RetentionCount()) ||
+			(backupConfig.RetentionMaxAge() > 0 && now.Sub(snapshot.spec.CreatedAt) > backupConfig.RetentionMaxAge())
+
+		if !expired {
+			kept = append(kept, snapshot)
+
+			continue
+		}
+
+		for _, path := range []string{
+			snapshot.spec.Path,
+			filepath.Join(ctrl.BackupPath, snapshot.id+checksumExtension),
+		} {
+			if err := os.Remove(path); err != nil && !errors.Is(err, os.ErrNotExist) {
+				return nil, err
+			}
+		}
+
+		logger.Info("etcd snapshot pruned", zap.String("path", snapshot.spec.Path))
+	}
+
+	return kept, nil
+}
+
+func snapshotName(createdAt time.Time, revision int64) string {
+	return fmt.Sprintf("%s%s-%d", snapshotPrefix, createdAt.UTC().Format(snapshotTimestampFmt), revision)
+}
+
+func parseSnapshotName(name string) (createdAt time.Time, revision int64, ok bool) {
+	timestamp, rev, found := strings.Cut(strings.TrimPrefix(name, snapshotPrefix), "-")
+	if !found || !strings.HasPrefix(name, snapshotPrefix) {
+		return time.Time{}, 0, false
+	}
+
+	createdAt, err := time.Parse(snapshotTimestampFmt, timestamp)
+	if err != nil {
+		return time.Time{}, 0, false
+	}
+
+	revision, err = strconv.ParseInt(rev, 10, 64)
+	if err != nil {
+		return time.Time{}, 0, false
+	}
+
+	return createdAt, revision, true
+}
+
+// readChecksum reads sha256sum-formatted checksum file, missing file results in empty checksum.
+func readChecksum(path string) (string, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			return "", nil
+		}
+
+		return "", err
+	}
+
+	defer f.Close() //nolint:errcheck
+
+	line, err := bufio.NewReader(f).ReadString('\n')
+	if err != nil && !errors.Is(err, io.EOF) {
+		return "", err
+	}
+
+	checksum, _, _ := strings.Cut(line, " ")
+
+	return strings.TrimSpace(checksum), nil
+}
+
+type snapshotReader struct {
+	io.ReadCloser
+
+	client *pkgetcd.Client
+}
+
+func (rd *snapshotReader) Close() error {
+	rd.ReadCloser.Close() //nolint:errcheck
+
+	return rd.client.Close()
+}
+
+// leaderSnapshot takes a snapshot of the local etcd member if it is the cluster leader.
+func leaderSnapshot(ctx context.Context) (io.ReadCloser, int64, error) {
+	client, err := pkgetcd.NewLocalClient(ctx)
+	if err != nil {
+		return nil, 0, fmt.Errorf("error creating etcd client: %w", err)
+	}
+
+	status, err := client.Status(ctx, client.Endpoints()[0])
+	if err != nil {
+		client.Close() //nolint:errcheck
+
+		return nil, 0, fmt.Errorf("error getting etcd status: %w", err)
+	}
+
+	if status.Header.MemberId != status.Leader {
+		client.Close() //nolint:errcheck
+
+		return nil, 0, nil
+	}
+
+	rd, err := client.Snapshot(ctx)
+	if err != nil {
+		client.Close() //nolint:errcheck
+
+		return nil, 0, fmt.Errorf("error reading etcd snapshot: %w", err)
+	}
+
+	return &snapshotReader{
+		ReadCloser: rd,
+		client:     client,
+	}, status.Header.Revision, nil
+}
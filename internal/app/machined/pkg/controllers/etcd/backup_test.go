@@ -0,0 +1,253 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package etcd_test
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"io"
+	"os"
+	"path/filepath"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/benbjohnson/clock"
+	"github.com/cosi-project/runtime/pkg/resource"
+	"github.com/cosi-project/runtime/pkg/resource/rtestutils"
+	"github.com/cosi-project/runtime/pkg/safe"
+	"github.com/siderolabs/go-retry/retry"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/siderolabs/talos/internal/app/machined/pkg/controllers/ctest"
+	etcdctrl "github.com/siderolabs/talos/internal/app/machined/pkg/controllers/etcd"
+	"github.com/siderolabs/talos/pkg/machinery/config/container"
+	etcdcfg "github.com/siderolabs/talos/pkg/machinery/config/types/etcd"
+	"github.com/siderolabs/talos/pkg/machinery/resources/config"
+	"github.com/siderolabs/talos/pkg/machinery/resources/etcd"
+	"github.com/siderolabs/talos/pkg/machinery/resources/v1alpha1"
+)
+
+// fakeSnapshot has the size of a valid etcd snapshot: database pages followed by sha256 hash.
+var fakeSnapshot = bytes.Repeat([]byte{0x42}, 512+sha256.Size)
+
+func TestBackupSuite(t *testing.T) {
+	fakeClock := clock.NewMock()
+
+	s := &BackupSuite{
+		fakeClock: fakeClock,
+	}
+
+	s.ctrl = &etcdctrl.BackupController{
+		Clock: fakeClock,
+		SnapshotFunc: func(ctx context.Context) (io.ReadCloser, int64, error) {
+			s.calls.Add(1)
+
+			if block := s.block.Load(); block != nil {
+				<-*block
+			}
+
+			if !s.leader.Load() {
+				return nil, 0, nil
+			}
+
+			return io.NopCloser(bytes.NewReader(fakeSnapshot)), s.revision.Add(1), nil
+		},
+	}
+
+	s.DefaultSuite = ctest.DefaultSuite{
+		AfterSetup: func(suite *ctest.DefaultSuite) {
+			s.ctrl.BackupPath = suite.T().TempDir()
+			s.leader.Store(true)
+			s.revision.Store(0)
+			s.calls.Store(0)
+			s.block.Store(nil)
+
+			suite.Require().NoError(suite.Runtime().RegisterController(s.ctrl))
+		},
+	}
+
+	suite.Run(t, s)
+}
+
+type BackupSuite struct {
+	ctest.DefaultSuite
+
+	ctrl      *etcdctrl.BackupController
+	fakeClock *clock.Mock
+	leader    atomic.Bool
+	revision  atomic.Int64
+	calls     atomic.Int64
+	block     atomic.Pointer[chan struct{}]
+}
+
+func (suite *BackupSuite) setup(retention etcdcfg.BackupRetention) {
+	etcdService := v1alpha1.NewService("etcd")
+	etcdService.TypedSpec().Running = true
+	etcdService.TypedSpec().Healthy = true
+
+	suite.Require().NoError(suite.State().Create(suite.Ctx(), etcdService))
+
+	backupConfig := etcdcfg.NewBackupConfigV1Alpha1()
+	backupConfig.BackupInterval = time.Hour
+	backupConfig.Retention = retention
+
+	cfg, err := container.New(backupConfig)
+	suite.Require().NoError(err)
+
+	suite.Require().NoError(suite.State().Create(suite.Ctx(), config.NewMachineConfig(cfg)))
+}
+
+// tickUntil advances the fake clock until the condition is met.
+func (suite *BackupSuite) tickUntil(condition func() error) {
+	suite.Require().NoError(retry.Constant(5*time.Second, retry.WithUnits(100*time.Millisecond)).Retry(func() error {
+		suite.fakeClock.Add(time.Hour)
+
+		return condition()
+	}))
+}
+
+// waitLastSnapshot waits for the snapshot started by the last tick to be saved, as snapshots are taken in the background.
+func (suite *BackupSuite) waitLastSnapshot() {
+	suite.Require().NoError(retry.Constant(5*time.Second, retry.WithUnits(100*time.Millisecond)).Retry(func() error {
+		list, err := safe.StateListAll[*etcd.Snapshot](suite.Ctx(), suite.State())
+		if err != nil {
+			return err
+		}
+
+		for iter := safe.IteratorFromList(list); iter.Next(); {
+			if iter.Value().TypedSpec().Revision == suite.revision.Load() {
+				return nil
+			}
+		}
+
+		return retry.ExpectedErrorf("last snapshot not saved yet")
+	}))
+}
+
+func (suite *BackupSuite) snapshotIDs() []resource.ID {
+	list, err := safe.StateListAll[*etcd.Snapshot](suite.Ctx(), suite.State())
+	suite.Require().NoError(err)
+
+	var ids []resource.ID
+
+	for iter := safe.IteratorFromList(list); iter.Next(); {
+		ids = append(ids, iter.Value().Metadata().ID())
+	}
+
+	return ids
+}
+
+func (suite *BackupSuite) TestRetentionCount() {
+	suite.setup(etcdcfg.BackupRetention{Count: 2})
+
+	suite.tickUntil(func() error {
+		if suite.revision.Load() < 4 {
+			return retry.ExpectedErrorf("not enough snapshots taken yet")
+		}
+
+		if len(suite.snapshotIDs()) != 2 {
+			return retry.ExpectedErrorf("expected 2 snapshots, got %d", len(suite.snapshotIDs()))
+		}
+
+		return nil
+	})
+
+	suite.waitLastSnapshot()
+
+	expectedChecksum := sha256.Sum256(fakeSnapshot)
+
+	rtestutils.AssertResources(suite.Ctx(), suite.T(), suite.State(), suite.snapshotIDs(),
+		func(snapshot *etcd.Snapshot, asrt *assert.Assertions) {
+			spec := snapshot.TypedSpec()
+
+			asrt.Equal(int64(len(fakeSnapshot)), spec.Size)
+			asrt.Equal(hex.EncodeToString(expectedChecksum[:]), spec.SHA256)
+			asrt.Greater(spec.Revision, int64(1))
+			asrt.Equal(filepath.Join(suite.ctrl.BackupPath, snapshot.Metadata().ID()+".snapshot"), spec.Path)
+
+			contents, err := os.ReadFile(spec.Path)
+			asrt.NoError(err)
+			asrt.Equal(fakeSnapshot, contents)
+		})
+
+	// only the kept snapshots and their checksums are left on disk
+	entries, err := os.ReadDir(suite.ctrl.BackupPath)
+	suite.Require().NoError(err)
+	suite.Assert().Len(entries, 4)
+}
+
+func (suite *BackupSuite) TestRetentionMaxAge() {
+	suite.setup(etcdcfg.BackupRetention{MaxAge: 90 * time.Minute})
+
+	suite.tickUntil(func() error {
+		if suite.revision.Load() < 3 {
+			return retry.ExpectedErrorf("not enough snapshots taken yet")
+		}
+
+		// snapshots are taken every hour, so only the last two are younger than 90 minutes
+		if len(suite.snapshotIDs()) > 2 {
+			return retry.ExpectedErrorf("expected at most 2 snapshots, got %d", len(suite.snapshotIDs()))
+		}
+
+		return nil
+	})
+}
+
+func (suite *BackupSuite) TestNotLeader() {
+	suite.leader.Store(false)
+
+	suite.setup(etcdcfg.BackupRetention{})
+
+	suite.tickUntil(func() error {
+		if suite.calls.Load() < 2 {
+			return retry.ExpectedErrorf("snapshot not attempted yet")
+		}
+
+		return nil
+	})
+
+	suite.Assert().Empty(suite.snapshotIDs())
+
+	entries, err := os.ReadDir(suite.ctrl.BackupPath)
+	suite.Require().NoError(err)
+	suite.Assert().Empty(entries)
+}
+
+func (suite *BackupSuite) TestSlowSnapshot() {
+	block := make(chan struct{})
+	suite.block.Store(&block)
+
+	suite.setup(etcdcfg.BackupRetention{})
+
+	suite.tickUntil(func() error {
+		if suite.calls.Load() < 1 {
+			return retry.ExpectedErrorf("snapshot not attempted yet")
+		}
+
+		return nil
+	})
+
+	// controller keeps processing ticks while the snapshot is in progress, but doesn't start another one
+	for i := 0; i < 3; i++ {
+		suite.fakeClock.Add(time.Hour)
+	}
+
+	suite.Assert().EqualValues(1, suite.calls.Load())
+	suite.Assert().Empty(suite.snapshotIDs())
+
+	close(block)
+
+	suite.tickUntil(func() error {
+		if len(suite.snapshotIDs()) == 0 {
+			return retry.ExpectedErrorf("snapshot not saved yet")
+		}
+
+		return nil
+	})
+}
@@ -167,6 +167,26 @@ func (ctrl *ControlPlaneController) manageAPIServerConfig(ctx context.Context, r
 		advertisedAddress = ""
 	}
 
+	extraVolumes := convertVolumes(cfgProvider.Cluster().APIServer().ExtraVolumes())
+
+	var (
+		kmsPluginImage     string
+		kmsPluginExtraArgs map[string]string
+	)
+
+	if kms := cfgProvider.Cluster().KMSEncryption(); kms.Enabled() {
+		// kube-apiserver connects to the KMS plugin via the socket in the shared directory
+		extraVolumes = append(extraVolumes, k8s.ExtraVolume{
+			Name:      "kms-plugin",
+			HostPath:  constants.KubernetesKMSPluginSocketDir,
+			MountPath: constants.KubernetesKMSPluginSocketDir,
+			ReadOnly:  true,
+		})
+
+		kmsPluginImage = kms.PluginImage()
+		kmsPluginExtraArgs = kms.PluginExtraArgs()
+	}
+
 	return safe.WriterModify(ctx, r, k8s.NewAPIServerConfig(), func(r *k8s.APIServerConfig) error {
 		*r.TypedSpec() = k8s.APIServerConfigSpec{
 			Image:                    cfgProvider.Cluster().APIServer().Image(),
@@ -176,10 +196,12 @@ func (ctrl *ControlPlaneController) manageAPIServerConfig(ctx context.Context, r
 			LocalPort:                cfgProvider.Cluster().LocalAPIServerPort(),
 			ServiceCIDRs:             cfgProvider.Cluster().Network().ServiceCIDRs(),
 			ExtraArgs:                cfgProvider.Cluster().APIServer().ExtraArgs(),
-			ExtraVolumes:             convertVolumes(cfgProvider.Cluster().APIServer().ExtraVolumes()),
+			ExtraVolumes:             extraVolumes,
 			EnvironmentVariables:     cfgProvider.Cluster().APIServer().Env(),
 			PodSecurityPolicyEnabled: !cfgProvider.Cluster().APIServer().DisablePodSecurityPolicy(),
 			AdvertisedAddress:        advertisedAddress,
+			KMSPluginImage:           kmsPluginImage,
+			KMSPluginExtraArgs:       kmsPluginExtraArgs,
 		}
 
 		return nil
@@ -160,6 +160,10 @@ func (ctrl *ControlPlaneStaticPodController) Run(ctx context.Context, r controll
 				f:  ctrl.manageAPIServer,
 				md: k8s.NewAPIServerConfig().Metadata(),
 			},
+			{
+				f:  ctrl.manageKMSPlugin,
+				md: k8s.NewAPIServerConfig().Metadata(),
+			},
 			{
 				f:  ctrl.manageControllerManager,
 				md: k8s.NewControllerManagerConfig().Metadata(),
@@ -484,6 +488,88 @@ func (ctrl *ControlPlaneStaticPodController) manageAPIServer(ctx context.Context
 	})
 }
 
+func (ctrl *ControlPlaneStaticPodController) manageKMSPlugin(ctx context.Context, r controller.Runtime, logger *zap.Logger,
+	configResource resource.Resource, secretsVersion, configVersion string,
+) (string, error) {
+	cfg := configResource.(*k8s.APIServerConfig).TypedSpec()
+
+	if cfg.KMSPluginImage == "" {
+		// KMS plugin is not managed by Talos
+		return "", nil
+	}
+
+	builder := argsbuilder.Args{}
+
+	if err := builder.Merge(cfg.KMSPluginExtraArgs); err != nil {
+		return "", err
+	}
+
+	return k8s.KMSPluginID, r.Modify(ctx, k8s.NewStaticPod(k8s.NamespaceName, k8s.KMSPluginID), func(r resource.Resource) error {
+		return k8sadapter.StaticPod(r.(*k8s.StaticPod)).SetPod(&v1.Pod{
+			TypeMeta: metav1.TypeMeta{
+				APIVersion: "v1",
+				Kind:       "Pod",
+			},
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      k8s.KMSPluginID,
+				Namespace: "kube-system",
+				Annotations: map[string]string{
+					constants.AnnotationStaticPodConfigVersion: configResource.Metadata().Version().String(),
+				},
+				Labels: map[string]string{
+					"tier":    "control-plane",
+					"k8s-app": k8s.KMSPluginID,
+				},
+			},
+			Spec: v1.PodSpec{
+				Priority:          pointer.To(systemCriticalPriority),
+				PriorityClassName: "system-cluster-critical",
+				Containers: []v1.Container{
+					{
+						Name:  k8s.KMSPluginID,
+						Image: cfg.KMSPluginImage,
+						Args:  builder.Args(),
+						VolumeMounts: []v1.VolumeMount{
+							{
+								// the plugin creates the socket kube-apiserver connects to
+								Name:      "kms-plugin",
+								MountPath: constants.KubernetesKMSPluginSocketDir,
+							},
+						},
+						Resources: v1.ResourceRequirements{
+							Requests: v1.ResourceList{
+								v1.ResourceCPU:    apiresource.MustParse("10m"),
+								v1.ResourceMemory: apiresource.MustParse("32Mi"),
+							},
+						},
+						SecurityContext: &v1.SecurityContext{
+							AllowPrivilegeEscalation: pointer.To(false),
+							Capabilities: &v1.Capabilities{
+								Drop: []v1.Capability{"ALL"},
+							},
+							SeccompProfile: &v1.SeccompProfile{
+								Type: v1.SeccompProfileTypeRuntimeDefault,
+							},
+						},
+					},
+				},
+				// the plugin might need to reach the key management system before the pod network is up
+				HostNetwork: true,
+				Volumes: []v1.Volume{
+					{
+						Name: "kms-plugin",
+						VolumeSource: v1.VolumeSource{
+							HostPath: &v1.HostPathVolumeSource{
+								Path: constants.KubernetesKMSPluginSocketDir,
+							},
+						},
+					},
+				},
+			},
+		})
+	})
+}
+
 func (ctrl *ControlPlaneStaticPodController) manageControllerManager(ctx context.Context, r controller.Runtime,
 	logger *zap.Logger, configResource resource.Resource, secretsVersion, configVersion string,
 ) (string, error) {
@@ -625,6 +625,76 @@ func (suite *ControlPlaneStaticPodSuite) TestControlPlaneStaticPodsExceptSchedul
 	)
 }
 
+func (suite *ControlPlaneStaticPodSuite) TestReconcileKMSPlugin() {
+	configStatus := k8s.NewConfigStatus(k8s.ControlPlaneNamespaceName, k8s.ConfigStatusStaticPodID)
+	secretStatus := k8s.NewSecretsStatus(k8s.ControlPlaneNamespaceName, k8s.StaticPodSecretsStaticPodID)
+	configAPIServer := k8s.NewAPIServerConfig()
+	*configAPIServer.TypedSpec() = k8s.APIServerConfigSpec{
+		KMSPluginImage: "registry.example.com/kms-plugin:v1.0.0",
+		KMSPluginExtraArgs: map[string]string{
+			"listen-addr": "unix:///var/run/kmsplugin/kms.sock",
+		},
+	}
+
+	suite.Require().NoError(suite.state.Create(suite.ctx, configStatus))
+	suite.Require().NoError(suite.state.Create(suite.ctx, secretStatus))
+	suite.Require().NoError(suite.state.Create(suite.ctx, configAPIServer))
+
+	suite.Assert().NoError(
+		retry.Constant(10*time.Second, retry.WithUnits(100*time.Millisecond)).Retry(
+			func() error {
+				return suite.assertControlPlaneStaticPods(
+					[]string{
+						"kms-plugin",
+						"kube-apiserver",
+					},
+				)
+			},
+		),
+	)
+
+	r, err := suite.state.Get(
+		suite.ctx,
+		resource.NewMetadata(k8s.NamespaceName, k8s.StaticPodType, "kms-plugin", resource.VersionUndefined),
+	)
+	suite.Require().NoError(err)
+
+	kmsPluginPod, err := k8sadapter.StaticPod(r.(*k8s.StaticPod)).Pod()
+	suite.Require().NoError(err)
+
+	suite.Require().Len(kmsPluginPod.Spec.Containers, 1)
+	suite.Assert().Equal("registry.example.com/kms-plugin:v1.0.0", kmsPluginPod.Spec.Containers[0].Image)
+	suite.Assert().Equal([]string{"--listen-addr=unix:///var/run/kmsplugin/kms.sock"}, kmsPluginPod.Spec.Containers[0].Args)
+	suite.Assert().Equal(
+		[]v1.VolumeMount{
+			{
+				Name:      "kms-plugin",
+				MountPath: constants.KubernetesKMSPluginSocketDir,
+			},
+		}, kmsPluginPod.Spec.Containers[0].VolumeMounts,
+	)
+
+	// KMS plugin is run by the user
+	ctest.UpdateWithConflicts(suite, configAPIServer, func(r *k8s.APIServerConfig) error {
+		r.TypedSpec().KMSPluginImage = ""
+		r.TypedSpec().KMSPluginExtraArgs = nil
+
+		return nil
+	})
+
+	suite.Assert().NoError(
+		retry.Constant(10*time.Second, retry.WithUnits(100*time.Millisecond)).Retry(
+			func() error {
+				return suite.assertControlPlaneStaticPods(
+					[]string{
+						"kube-apiserver",
+					},
+				)
+			},
+		),
+	)
+}
+
 func (suite *ControlPlaneStaticPodSuite) TearDownTest() {
 	suite.T().Log("tear down")
 
@@ -257,6 +257,55 @@ func (suite *K8sControlPlaneSuite) TestReconcileExtraVolumes() {
 	)
 }
 
+func (suite *K8sControlPlaneSuite) TestReconcileKMSEncryption() {
+	u, err := url.Parse("https://foo:6443")
+	suite.Require().NoError(err)
+
+	cfg := config.NewMachineConfig(
+		container.NewV1Alpha1(
+			&v1alpha1.Config{
+				ConfigVersion: "v1alpha1",
+				MachineConfig: &v1alpha1.MachineConfig{},
+				ClusterConfig: &v1alpha1.ClusterConfig{
+					ControlPlane: &v1alpha1.ControlPlaneConfig{
+						Endpoint: &v1alpha1.Endpoint{
+							URL: u,
+						},
+					},
+					ClusterKMSEncryptionConfig: &v1alpha1.KMSEncryptionConfig{
+						KMSEnabled:     pointer.To(true),
+						KMSPluginImage: "registry.example.com/kms-plugin:v1.0.0",
+						KMSPluginExtraArgs: map[string]string{
+							"listen-addr": "unix:///var/run/kmsplugin/kms.sock",
+						},
+					},
+				},
+			},
+		),
+	)
+
+	suite.setupMachine(cfg)
+
+	rtestutils.AssertResources(suite.Ctx(), suite.T(), suite.State(), []resource.ID{k8s.APIServerConfigID},
+		func(apiServer *k8s.APIServerConfig, assert *assert.Assertions) {
+			apiServerCfg := apiServer.TypedSpec()
+
+			assert.Equal(
+				[]k8s.ExtraVolume{
+					{
+						Name:      "kms-plugin",
+						HostPath:  constants.KubernetesKMSPluginSocketDir,
+						MountPath: constants.KubernetesKMSPluginSocketDir,
+						ReadOnly:  true,
+					},
+				}, apiServerCfg.ExtraVolumes,
+			)
+			assert.Equal("registry.example.com/kms-plugin:v1.0.0", apiServerCfg.KMSPluginImage)
+			assert.Equal(map[string]string{"listen-addr": "unix:///var/run/kmsplugin/kms.sock"}, apiServerCfg.KMSPluginExtraArgs)
+		},
+	)
+}
+
 func (suite *K8sControlPlaneSuite) TestReconcileEnvironment() {
 	u, err := url.Parse("https://foo:6443")
 	suite.Require().NoError(err)
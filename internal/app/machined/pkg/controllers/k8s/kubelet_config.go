@@ -18,6 +18,7 @@ import (
 	"go.uber.org/zap"
 
 	talosconfig "github.com/siderolabs/talos/pkg/machinery/config"
+	"github.com/siderolabs/talos/pkg/machinery/constants"
 	"github.com/siderolabs/talos/pkg/machinery/resources/config"
 	"github.com/siderolabs/talos/pkg/machinery/resources/k8s"
 )
@@ -146,6 +147,12 @@ func modifyKubeletConfig(cfgProvider talosconfig.Config, staticPodListURL string
 		kubeletConfig.DisableManifestsDirectory = cfgProvider.Machine().Kubelet().DisableManifestsDirectory()
 		kubeletConfig.EnableFSQuotaMonitoring = cfgProvider.Machine().Features().DiskQuotaSupportEnabled()
 
+		if cfgProvider.Machine().Features().HostDNS().Enabled() {
+			kubeletConfig.ResolverConfig = constants.PodResolvConfPath
+		} else {
+			kubeletConfig.ResolverConfig = ""
+		}
+
 		return nil
 	}
 }
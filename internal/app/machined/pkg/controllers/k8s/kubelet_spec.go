@@ -317,6 +317,10 @@ func NewKubeletConfiguration(cfgSpec *k8s.KubeletConfigSpec) (*kubeletconfig.Kub
 		config.ClusterDNS = cfgSpec.ClusterDNS
 	}
 
+	if config.ResolverConfig == nil && cfgSpec.ResolverConfig != "" {
+		config.ResolverConfig = pointer.To(cfgSpec.ResolverConfig)
+	}
+
 	if config.SerializeImagePulls == nil {
 		config.SerializeImagePulls = pointer.To(false)
 	}
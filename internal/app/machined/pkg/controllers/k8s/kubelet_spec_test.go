@@ -569,6 +569,17 @@ func TestNewKubeletConfigurationMerge(t *testing.T) {
 				}
 			},
 		},
+		{
+			name: "host DNS resolver config",
+			cfgSpec: &k8s.KubeletConfigSpec{
+				ClusterDNS:     []string{"10.0.0.5"},
+				ClusterDomain:  "cluster.local",
+				ResolverConfig: "/system/resolved/resolv.conf",
+			},
+			expectedOverrides: func(kc *kubeletconfig.KubeletConfiguration) {
+				kc.ResolverConfig = pointer.To("/system/resolved/resolv.conf")
+			},
+		},
 	} {
 		tt := tt
 
@@ -7,6 +7,8 @@ package k8s
 import (
 	"bytes"
 	"context"
+	"crypto/sha256"
+	"encoding/hex"
 	"fmt"
 	"os"
 	"path/filepath"
@@ -144,6 +146,13 @@ func (ctrl *RenderSecretsStaticPodController) Run(ctx context.Context, r control
 		k8sSecrets := secretsRes.TypedSpec()
 		k8sCerts := certsRes.TypedSpec()
 
+		if rootK8sSecrets.KMSEncryptionEndpoint != "" {
+			// the directory is shared between the KMS plugin and kube-apiserver
+			if err = os.MkdirAll(constants.KubernetesKMSPluginSocketDir, 0o755); err != nil {
+				return fmt.Errorf("error creating KMS plugin socket directory: %w", err)
+			}
+		}
+
 		serviceAccountKey, err := rootK8sSecrets.ServiceAccount.GetKey()
 		if err != nil {
 			return fmt.Errorf("error parsing service account key: %w", err)
@@ -329,7 +338,8 @@ func (ctrl *RenderSecretsStaticPodController) Run(ctx context.Context, r control
 
 		if err = r.Modify(ctx, k8s.NewSecretsStatus(k8s.ControlPlaneNamespaceName, k8s.StaticPodSecretsStaticPodID), func(r resource.Resource) error {
 			r.(*k8s.SecretsStatus).TypedSpec().Ready = true
-			r.(*k8s.SecretsStatus).TypedSpec().Version = secretsRes.Metadata().Version().String()
+			// encryption config is rendered from the root secrets, but any other root secrets change should not restart the pods
+			r.(*k8s.SecretsStatus).TypedSpec().Version = secretsRes.Metadata().Version().String() + "-" + encryptionConfigVersion(rootK8sSecrets)
 
 			return nil
 		}); err != nil {
@@ -339,3 +349,22 @@ func (ctrl *RenderSecretsStaticPodController) Run(ctx context.Context, r control
 		r.ResetRestartBackoff()
 	}
 }
+
+// encryptionConfigVersion returns a version of the encryption config which changes only with the encryption settings.
+func encryptionConfigVersion(root *secrets.KubernetesRootSpec) string {
+	hash := sha256.New()
+
+	for _, field := range []string{
+		root.AESCBCEncryptionSecret,
+		root.SecretboxEncryptionSecret,
+		root.KMSEncryptionName,
+		root.KMSEncryptionEndpoint,
+		root.KMSEncryptionTimeout.String(),
+	} {
+		// fields are separated, so that moving a value to another field changes the version
+		hash.Write([]byte(field))
+		hash.Write([]byte{0})
+	}
+
+	return hex.EncodeToString(hash.Sum(nil))[:8]
+}
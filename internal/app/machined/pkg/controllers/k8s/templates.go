@@ -14,6 +14,13 @@ resources:
 - resources:
   - secrets
   providers:
+  {{if .Root.KMSEncryptionEndpoint}}
+  - kms:
+      apiVersion: v2
+      name: {{ .Root.KMSEncryptionName }}
+      endpoint: {{ .Root.KMSEncryptionEndpoint }}
+      timeout: {{ .Root.KMSEncryptionTimeout }}
+  {{end}}
   {{if .Root.SecretboxEncryptionSecret}}
   - secretbox:
       keys:
@@ -0,0 +1,208 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package network
+
+import (
+	"context"
+	"fmt"
+	"net/netip"
+
+	"github.com/cosi-project/runtime/pkg/controller"
+	"github.com/cosi-project/runtime/pkg/resource"
+	"github.com/cosi-project/runtime/pkg/safe"
+	"github.com/cosi-project/runtime/pkg/state"
+	"github.com/siderolabs/gen/slices"
+	"github.com/siderolabs/go-pointer"
+	"go.uber.org/zap"
+
+	"github.com/siderolabs/talos/internal/pkg/dns"
+	"github.com/siderolabs/talos/pkg/machinery/resources/network"
+)
+
+// DNSResolveCacheController runs the host caching DNS resolver based on network.HostDNSConfig.
+type DNSResolveCacheController struct {
+	handler *dns.Handler
+	servers map[netip.AddrPort]*dns.Server
+}
+
+// Name implements controller.Controller interface.
+func (ctrl *DNSResolveCacheController) Name() string {
+	return "network.DNSResolveCacheController"
+}
+
+// Inputs implements controller.Controller interface.
+func (ctrl *DNSResolveCacheController) Inputs() []controller.Input {
+	return []controller.Input{
+		{
+			Namespace: network.NamespaceName,
+			Type:      network.HostDNSConfigType,
+			ID:        pointer.To(network.HostDNSConfigID),
+			Kind:      controller.InputWeak,
+		},
+		{
+			Namespace: network.NamespaceName,
+			Type:      network.ResolverStatusType,
+			ID:        pointer.To(network.ResolverID),
+			Kind:      controller.InputWeak,
+		},
+	}
+}
+
+// Outputs implements controller.Controller interface.
+func (ctrl *DNSResolveCacheController) Outputs() []controller.Output {
+	return []controller.Output{
+		{
+			Type: network.DNSResolveCacheType,
+			Kind: controller.OutputExclusive,
+		},
+	}
+}
+
+// Run implements controller.Controller interface.
+//
+//nolint:gocyclo
+func (ctrl *DNSResolveCacheController) Run(ctx context.Context, r controller.Runtime, logger *zap.Logger) error {
+	logger = logger.Named("dns-resolve-cache")
+
+	ctrl.handler = dns.NewHandler(logger)
+	ctrl.servers = map[netip.AddrPort]*dns.Server{}
+
+	defer ctrl.stopServers(logger, nil)
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-r.EventCh():
+		}
+
+		cfg, err := safe.ReaderGetByID[*network.HostDNSConfig](ctx, r, network.HostDNSConfigID)
+		if err != nil && !state.IsNotFoundError(err) {
+			return fmt.Errorf("error getting host DNS config: %w", err)
+		}
+
+		var spec network.HostDNSConfigSpec
+
+		if cfg != nil {
+			spec = *cfg.TypedSpec()
+		}
+
+		if !spec.Enabled {
+			spec.ListenAddresses = nil
+		}
+
+		resolverStatus, err := safe.ReaderGetByID[*network.ResolverStatus](ctx, r, network.ResolverID)
+		if err != nil && !state.IsNotFoundError(err) {
+			return fmt.Errorf("error getting resolver status: %w", err)
+		}
+
+		listening := make(map[netip.Addr]struct{}, len(spec.ListenAddresses))
+
+		for _, addr := range spec.ListenAddresses {
+			listening[addr.Addr()] = struct{}{}
+		}
+
+		var upstreams []netip.AddrPort
+
+		if resolverStatus != nil {
+			for _, server := range resolverStatus.TypedSpec().DNSServers {
+				// don't forward the queries back to the resolver itself
+				if _, ok := listening[server]; ok {
+					continue
+				}
+
+				upstreams = append(upstreams, netip.AddrPortFrom(server, 53))
+			}
+		}
+
+		ctrl.handler.SetUpstreams(upstreams)
+		ctrl.handler.SetForwardRules(slices.Map(spec.ForwardRules, func(rule network.DNSForwardRule) dns.ForwardRule {
+			return dns.ForwardRule{
+				Domain:  rule.Domain,
+				Servers: rule.Servers,
+			}
+		}))
+
+		hosts := map[string][]netip.Addr{}
+
+		for _, extraHost := range spec.ExtraHosts {
+			for _, hostname := range extraHost.Hostnames {
+				hosts[hostname] = append(hosts[hostname], extraHost.Address)
+			}
+		}
+
+		ctrl.handler.SetHosts(hosts)
+
+		keep := make(map[netip.AddrPort]struct{}, len(spec.ListenAddresses))
+
+		for _, addr := range spec.ListenAddresses {
+			keep[addr] = struct{}{}
+		}
+
+		ctrl.stopServers(logger, keep)
+
+		touchedIDs := make(map[resource.ID]struct{}, len(spec.ListenAddresses))
+
+		for _, addr := range spec.ListenAddresses {
+			if _, ok := ctrl.servers[addr]; !ok {
+				server, err := dns.NewServer(addr, ctrl.handler, logger)
+				if err != nil {
+					return fmt.Errorf("error starting DNS server: %w", err)
+				}
+
+				server.Start()
+
+				ctrl.servers[addr] = server
+
+				logger.Info("host DNS resolver is listening", zap.Stringer("address", addr))
+			}
+
+			id := addr.String()
+
+			if err = safe.WriterModify(ctx, r, network.NewDNSResolveCache(network.NamespaceName, id), func(res *network.DNSResolveCache) error {
+				res.TypedSpec().Status = "running"
+
+				return nil
+			}); err != nil {
+				return fmt.Errorf("error modifying DNS resolve cache status: %w", err)
+			}
+
+			touchedIDs[id] = struct{}{}
+		}
+
+		// list statuses for cleanup
+		list, err := safe.ReaderListAll[*network.DNSResolveCache](ctx, r)
+		if err != nil {
+			return fmt.Errorf("error listing resources: %w", err)
+		}
+
+		for it := safe.IteratorFromList(list); it.Next(); {
+			res := it.Value()
+
+			if _, ok := touchedIDs[res.Metadata().ID()]; !ok {
+				if err = r.Destroy(ctx, res.Metadata()); err != nil {
+					return fmt.Errorf("error cleaning up DNS resolve cache statuses: %w", err)
+				}
+			}
+		}
+
+		r.ResetRestartBackoff()
+	}
+}
+
+// stopServers stops the servers which don't listen on the addresses to keep.
+func (ctrl *DNSResolveCacheController) stopServers(logger *zap.Logger, keep map[netip.AddrPort]struct{}) {
+	for addr, server := range ctrl.servers {
+		if _, ok := keep[addr]; ok {
+			continue
+		}
+
+		server.Stop()
+
+		delete(ctrl.servers, addr)
+
+		logger.Info("host DNS resolver is stopped", zap.Stringer("address", addr))
+	}
+}
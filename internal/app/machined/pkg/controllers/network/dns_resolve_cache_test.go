@@ -0,0 +1,89 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package network_test
+
+import (
+	"context"
+	"net"
+	"net/netip"
+	"testing"
+	"time"
+
+	"github.com/cosi-project/runtime/pkg/resource"
+	"github.com/cosi-project/runtime/pkg/resource/rtestutils"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/siderolabs/talos/internal/app/machined/pkg/controllers/ctest"
+	netctrl "github.com/siderolabs/talos/internal/app/machined/pkg/controllers/network"
+	"github.com/siderolabs/talos/pkg/machinery/resources/network"
+)
+
+type DNSResolveCacheSuite struct {
+	ctest.DefaultSuite
+}
+
+func (suite *DNSResolveCacheSuite) TestServeExtraHosts() {
+	conn, err := net.ListenPacket("udp", "127.0.0.1:0")
+	suite.Require().NoError(err)
+
+	addr := conn.LocalAddr().(*net.UDPAddr).AddrPort() //nolint:forcetypeassert
+
+	suite.Require().NoError(conn.Close())
+
+	cfg := network.NewHostDNSConfig(network.NamespaceName, network.HostDNSConfigID)
+	*cfg.TypedSpec() = network.HostDNSConfigSpec{
+		Enabled:         true,
+		ListenAddresses: []netip.AddrPort{addr},
+		ExtraHosts: []network.DNSExtraHost{
+			{
+				Address:   netip.MustParseAddr("10.0.0.1"),
+				Hostnames: []string{"registry.local"},
+			},
+		},
+	}
+
+	suite.Require().NoError(suite.State().Create(suite.Ctx(), cfg))
+
+	rtestutils.AssertResources(suite.Ctx(), suite.T(), suite.State(), []resource.ID{addr.String()},
+		func(r *network.DNSResolveCache, asrt *assert.Assertions) {
+			asrt.Equal("running", r.TypedSpec().Status)
+		},
+	)
+
+	resolver := &net.Resolver{
+		PreferGo: true,
+		Dial: func(ctx context.Context, network, _ string) (net.Conn, error) {
+			var d net.Dialer
+
+			return d.DialContext(ctx, network, addr.String())
+		},
+	}
+
+	addrs, err := resolver.LookupNetIP(suite.Ctx(), "ip4", "registry.local")
+	suite.Require().NoError(err)
+	suite.Assert().Equal([]netip.Addr{netip.MustParseAddr("10.0.0.1")}, addrs)
+
+	cfg.TypedSpec().Enabled = false
+	suite.Require().NoError(suite.State().Update(suite.Ctx(), cfg))
+
+	rtestutils.AssertNoResource[*network.DNSResolveCache](suite.Ctx(), suite.T(), suite.State(), addr.String())
+
+	// the listener is released once the resolver is disabled
+	tcpListener, err := net.Listen("tcp", addr.String())
+	suite.Require().NoError(err)
+	suite.Require().NoError(tcpListener.Close())
+}
+
+func TestDNSResolveCacheSuite(t *testing.T) {
+	suite.Run(t, &DNSResolveCacheSuite{
+		DefaultSuite: ctest.DefaultSuite{
+			Timeout: 5 * time.Second,
+			AfterSetup: func(s *ctest.DefaultSuite) {
+				s.Require().NoError(s.Runtime().RegisterController(&netctrl.DNSResolveCacheController{}))
+			},
+		},
+	})
+}
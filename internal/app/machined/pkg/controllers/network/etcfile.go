@@ -8,6 +8,9 @@ import (
 	"bytes"
 	"context"
 	"fmt"
+	"net/netip"
+	"os"
+	"path/filepath"
 	"strings"
 	"text/tabwriter"
 
@@ -18,13 +21,17 @@ import (
 	"go.uber.org/zap"
 
 	talosconfig "github.com/siderolabs/talos/pkg/machinery/config"
+	"github.com/siderolabs/talos/pkg/machinery/constants"
 	"github.com/siderolabs/talos/pkg/machinery/resources/config"
 	"github.com/siderolabs/talos/pkg/machinery/resources/files"
 	"github.com/siderolabs/talos/pkg/machinery/resources/network"
 )
 
 // EtcFileController creates /etc/hostname and /etc/resolv.conf files based on finalized network configuration.
-type EtcFileController struct{}
+type EtcFileController struct {
+	// PodResolvConfPath is the path to the resolv.conf passed to the pods when the host DNS resolver is enabled.
+	PodResolvConfPath string
+}
 
 // Name implements controller.Controller interface.
 func (ctrl *EtcFileController) Name() string {
@@ -58,6 +65,12 @@ func (ctrl *EtcFileController) Inputs() []controller.Input {
 			ID:        pointer.To(network.NodeAddressDefaultID),
 			Kind:      controller.InputWeak,
 		},
+		{
+			Namespace: network.NamespaceName,
+			Type:      network.HostDNSConfigType,
+			ID:        pointer.To(network.HostDNSConfigID),
+			Kind:      controller.InputWeak,
+		},
 	}
 }
 
@@ -126,16 +139,48 @@ func (ctrl *EtcFileController) Run(ctx context.Context, r controller.Runtime, lo
 			nodeAddressStatus = naStatus.(*network.NodeAddress).TypedSpec()
 		}
 
+		var hostDNSConfig *network.HostDNSConfigSpec
+
+		hdConfig, err := r.Get(ctx, resource.NewMetadata(network.NamespaceName, network.HostDNSConfigType, network.HostDNSConfigID, resource.VersionUndefined))
+		if err != nil {
+			if !state.IsNotFoundError(err) {
+				return fmt.Errorf("error getting host DNS config: %w", err)
+			}
+		} else {
+			hostDNSConfig = hdConfig.(*network.HostDNSConfig).TypedSpec()
+		}
+
 		if resolverStatus != nil {
+			hostResolvers := resolverStatus.DNSServers
+
+			if hostDNSConfig != nil && hostDNSConfig.Enabled {
+				// host processes use the host DNS resolver
+				hostResolvers = []netip.Addr{netip.MustParseAddr(constants.HostDNSLocalAddress)}
+			}
+
 			if err = r.Modify(ctx, files.NewEtcFileSpec(files.NamespaceName, "resolv.conf"),
 				func(r resource.Resource) error {
-					r.(*files.EtcFileSpec).TypedSpec().Contents = ctrl.renderResolvConf(resolverStatus, hostnameStatus, cfgProvider)
+					r.(*files.EtcFileSpec).TypedSpec().Contents = ctrl.renderResolvConf(hostResolvers, hostnameStatus, cfgProvider)
 					r.(*files.EtcFileSpec).TypedSpec().Mode = 0o644
 
 					return nil
 				}); err != nil {
 				return fmt.Errorf("error modifying resolv.conf: %w", err)
 			}
+
+			if ctrl.PodResolvConfPath != "" {
+				// pods can't reach the loopback address of the host, so they use either the link-local address of the resolver or the upstream servers;
+				// the file is kept up to date with the upstream servers when the host DNS is disabled, as kubelet might still point pods to it
+				podResolvers := resolverStatus.DNSServers
+
+				if hostDNSConfig != nil && hostDNSConfig.Enabled && hostDNSConfig.ServiceHostDNSAddress.IsValid() {
+					podResolvers = []netip.Addr{hostDNSConfig.ServiceHostDNSAddress}
+				}
+
+				if err = ctrl.writePodResolvConf(ctrl.renderResolvConf(podResolvers, hostnameStatus, cfgProvider)); err != nil {
+					return fmt.Errorf("error writing pod resolv.conf: %w", err)
+				}
+			}
 		}
 
 		if hostnameStatus != nil && nodeAddressStatus != nil {
@@ -154,10 +199,10 @@ func (ctrl *EtcFileController) Run(ctx context.Context, r controller.Runtime, lo
 	}
 }
 
-func (ctrl *EtcFileController) renderResolvConf(resolverStatus *network.ResolverStatusSpec, hostnameStatus *network.HostnameStatusSpec, cfgProvider talosconfig.Config) []byte {
+func (ctrl *EtcFileController) renderResolvConf(resolvers []netip.Addr, hostnameStatus *network.HostnameStatusSpec, cfgProvider talosconfig.Config) []byte {
 	var buf bytes.Buffer
 
-	for i, resolver := range resolverStatus.DNSServers {
+	for i, resolver := range resolvers {
 		if i >= 3 {
 			// only use firt 3 nameservers, see MAXNS in https://linux.die.net/man/5/resolv.conf
 			break
@@ -178,6 +223,14 @@ func (ctrl *EtcFileController) renderResolvConf(resolverStatus *network.Resolver
 	return buf.Bytes()
 }
 
+func (ctrl *EtcFileController) writePodResolvConf(contents []byte) error {
+	if err := os.MkdirAll(filepath.Dir(ctrl.PodResolvConfPath), 0o755); err != nil {
+		return err
+	}
+
+	return os.WriteFile(ctrl.PodResolvConfPath, contents, 0o644)
+}
+
 func (ctrl *EtcFileController) renderHosts(hostnameStatus *network.HostnameStatusSpec, nodeAddressStatus *network.NodeAddressSpec, cfgProvider talosconfig.Config) ([]byte, error) {
 	var buf bytes.Buffer
 
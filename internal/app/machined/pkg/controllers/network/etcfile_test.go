@@ -10,6 +10,8 @@ import (
 	"log"
 	"net/netip"
 	"net/url"
+	"os"
+	"path/filepath"
 	"sync"
 	"testing"
 	"time"
@@ -20,6 +22,7 @@ import (
 	"github.com/cosi-project/runtime/pkg/state/impl/inmem"
 	"github.com/cosi-project/runtime/pkg/state/impl/namespaced"
 	"github.com/siderolabs/go-pointer"
+	"github.com/siderolabs/go-retry/retry"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/suite"
 
@@ -47,6 +50,8 @@ type EtcFileConfigSuite struct {
 	defaultAddress *network.NodeAddress
 	hostnameStatus *network.HostnameStatus
 	resolverStatus *network.ResolverStatus
+
+	podResolvConfPath string
 }
 
 func (suite *EtcFileConfigSuite) SetupTest() {
@@ -61,7 +66,11 @@ func (suite *EtcFileConfigSuite) SetupTest() {
 
 	suite.startRuntime()
 
-	suite.Require().NoError(suite.runtime.RegisterController(&netctrl.EtcFileController{}))
+	suite.podResolvConfPath = filepath.Join(suite.T().TempDir(), "resolved", "resolv.conf")
+
+	suite.Require().NoError(suite.runtime.RegisterController(&netctrl.EtcFileController{
+		PodResolvConfPath: suite.podResolvConfPath,
+	}))
 
 	u, err := url.Parse("https://foo:6443")
 	suite.Require().NoError(err)
@@ -229,6 +238,63 @@ func (suite *EtcFileConfigSuite) TestOnlyHostname() {
 	)
 }
 
+func (suite *EtcFileConfigSuite) TestHostDNS() {
+	hostDNSConfig := network.NewHostDNSConfig(network.NamespaceName, network.HostDNSConfigID)
+	hostDNSConfig.TypedSpec().Enabled = true
+	hostDNSConfig.TypedSpec().ServiceHostDNSAddress = netip.MustParseAddr("169.254.116.108")
+
+	suite.testFiles(
+		[]resource.Resource{hostDNSConfig, suite.defaultAddress, suite.hostnameStatus, suite.resolverStatus},
+		"nameserver 127.0.0.53\n\nsearch example.com\n",
+		"127.0.0.1   localhost\n33.11.22.44 foo.example.com foo\n::1         localhost ip6-localhost ip6-loopback\nff02::1     ip6-allnodes\nff02::2     ip6-allrouters\n",
+	)
+
+	suite.Assert().NoError(
+		retry.Constant(5*time.Second, retry.WithUnits(100*time.Millisecond)).Retry(
+			func() error {
+				contents, err := os.ReadFile(suite.podResolvConfPath)
+				if err != nil {
+					return retry.ExpectedError(err)
+				}
+
+				if string(contents) != "nameserver 169.254.116.108\n\nsearch example.com\n" {
+					return retry.ExpectedErrorf("unexpected pod resolv.conf contents: %q", string(contents))
+				}
+
+				return nil
+			},
+		),
+	)
+
+	// disable host DNS, pods should use the upstream servers again
+	hostDNSConfig.TypedSpec().Enabled = false
+	suite.Require().NoError(suite.state.Update(suite.ctx, hostDNSConfig))
+
+	suite.assertEtcFiles(
+		[]string{"resolv.conf"},
+		func(r *files.EtcFileSpec, asrt *assert.Assertions) {
+			asrt.Equal("nameserver 1.1.1.1\nnameserver 2.2.2.2\nnameserver 3.3.3.3\n\nsearch example.com\n", string(r.TypedSpec().Contents))
+		},
+	)
+
+	suite.Assert().NoError(
+		retry.Constant(5*time.Second, retry.WithUnits(100*time.Millisecond)).Retry(
+			func() error {
+				contents, err := os.ReadFile(suite.podResolvConfPath)
+				if err != nil {
+					return retry.ExpectedError(err)
+				}
+
+				if string(contents) != "nameserver 1.1.1.1\nnameserver 2.2.2.2\nnameserver 3.3.3.3\n\nsearch example.com\n" {
+					return retry.ExpectedErrorf("unexpected pod resolv.conf contents: %q", string(contents))
+				}
+
+				return nil
+			},
+		),
+	)
+}
+
 func (suite *EtcFileConfigSuite) TearDownTest() {
 	suite.T().Log("tear down")
 
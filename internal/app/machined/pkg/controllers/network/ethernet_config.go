@@ -0,0 +1,136 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package network
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/cosi-project/runtime/pkg/controller"
+	"github.com/cosi-project/runtime/pkg/resource"
+	"github.com/cosi-project/runtime/pkg/safe"
+	"github.com/cosi-project/runtime/pkg/state"
+	"github.com/siderolabs/go-pointer"
+	"go.uber.org/zap"
+
+	talosconfig "github.com/siderolabs/talos/pkg/machinery/config/config"
+	"github.com/siderolabs/talos/pkg/machinery/resources/config"
+	"github.com/siderolabs/talos/pkg/machinery/resources/network"
+)
+
+// EthernetConfigController manages network.EthernetSpec based on machine configuration.
+type EthernetConfigController struct{}
+
+// Name implements controller.Controller interface.
+func (ctrl *EthernetConfigController) Name() string {
+	return "network.EthernetConfigController"
+}
+
+// Inputs implements controller.Controller interface.
+func (ctrl *EthernetConfigController) Inputs() []controller.Input {
+	return []controller.Input{
+		{
+			Namespace: config.NamespaceName,
+			Type:      config.MachineConfigType,
+			ID:        pointer.To(config.V1Alpha1ID),
+			Kind:      controller.InputWeak,
+		},
+	}
+}
+
+// Outputs implements controller.Controller interface.
+func (ctrl *EthernetConfigController) Outputs() []controller.Output {
+	return []controller.Output{
+		{
+			Type: network.EthernetSpecType,
+			Kind: controller.OutputExclusive,
+		},
+	}
+}
+
+// Run implements controller.Controller interface.
+func (ctrl *EthernetConfigController) Run(ctx context.Context, r controller.Runtime, _ *zap.Logger) error {
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-r.EventCh():
+		}
+
+		cfg, err := safe.ReaderGetByID[*config.MachineConfig](ctx, r, config.V1Alpha1ID)
+		if err != nil && !state.IsNotFoundError(err) {
+			return fmt.Errorf("error getting machine config: %w", err)
+		}
+
+		touchedIDs := make(map[resource.ID]struct{})
+
+		if cfg != nil {
+			for _, ethernetConfig := range cfg.Config().EthernetConfigs() {
+				if err = safe.WriterModify(ctx, r, network.NewEthernetSpec(network.NamespaceName, ethernetConfig.Name()),
+					func(spec *network.EthernetSpec) error {
+						*spec.TypedSpec() = buildEthernetSpec(ethernetConfig)
+
+						return nil
+					}); err != nil {
+					return fmt.Errorf("error updating ethernet spec: %w", err)
+				}
+
+				touchedIDs[ethernetConfig.Name()] = struct{}{}
+			}
+		}
+
+		// list specs for cleanup
+		list, err := safe.ReaderListAll[*network.EthernetSpec](ctx, r)
+		if err != nil {
+			return fmt.Errorf("error listing resources: %w", err)
+		}
+
+		for it := safe.IteratorFromList(list); it.Next(); {
+			if _, ok := touchedIDs[it.Value().Metadata().ID()]; ok {
+				continue
+			}
+
+			if err = r.Destroy(ctx, it.Value().Metadata()); err != nil {
+				return fmt.Errorf("error cleaning up ethernet specs: %w", err)
+			}
+		}
+
+		r.ResetRestartBackoff()
+	}
+}
+
+func buildEthernetSpec(cfg talosconfig.EthernetConfig) network.EthernetSpecSpec {
+	rings := cfg.Rings()
+	channels := cfg.Channels()
+	pause := cfg.Pause()
+
+	spec := network.EthernetSpecSpec{
+		Rings: network.EthernetRingsSpec{
+			RX: rings.RX,
+			TX: rings.TX,
+		},
+		Channels: network.EthernetChannelsSpec{
+			RX:       channels.RX,
+			TX:       channels.TX,
+			Other:    channels.Other,
+			Combined: channels.Combined,
+		},
+		Pause: network.EthernetPauseSpec{
+			Autonegotiate: pause.Autonegotiate,
+			RX:            pause.RX,
+			TX:            pause.TX,
+		},
+	}
+
+	if features := cfg.Features(); len(features) > 0 {
+		spec.Features = make(map[string]bool, len(features))
+
+		for name, enabled := range features {
+			spec.Features[name] = enabled
+		}
+	}
+
+	return spec
+}
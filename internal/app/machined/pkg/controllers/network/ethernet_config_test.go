@@ -0,0 +1,94 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package network_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/cosi-project/runtime/pkg/resource"
+	"github.com/cosi-project/runtime/pkg/resource/rtestutils"
+	"github.com/siderolabs/go-pointer"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/siderolabs/talos/internal/app/machined/pkg/controllers/ctest"
+	netctrl "github.com/siderolabs/talos/internal/app/machined/pkg/controllers/network"
+	"github.com/siderolabs/talos/pkg/machinery/config/container"
+	networkcfg "github.com/siderolabs/talos/pkg/machinery/config/types/network"
+	"github.com/siderolabs/talos/pkg/machinery/config/types/v1alpha1"
+	"github.com/siderolabs/talos/pkg/machinery/resources/config"
+	"github.com/siderolabs/talos/pkg/machinery/resources/network"
+)
+
+type EthernetConfigSuite struct {
+	ctest.DefaultSuite
+}
+
+func (suite *EthernetConfigSuite) TestConfig() {
+	eth0Cfg := networkcfg.NewEthernetConfigV1Alpha1("eth0")
+	eth0Cfg.RingsConfig.RX = pointer.To[uint32](4096)
+	eth0Cfg.FeaturesConfig = map[string]bool{
+		"rx-gro": false,
+	}
+
+	eth1Cfg := networkcfg.NewEthernetConfigV1Alpha1("eth1")
+	eth1Cfg.ChannelsConfig.Combined = pointer.To[uint32](4)
+	eth1Cfg.PauseConfig.TX = pointer.To(false)
+
+	cfg, err := container.New(
+		&v1alpha1.Config{
+			ConfigVersion: "v1alpha1",
+			MachineConfig: &v1alpha1.MachineConfig{},
+		},
+		eth0Cfg,
+		eth1Cfg,
+	)
+	suite.Require().NoError(err)
+
+	machineConfig := config.NewMachineConfig(cfg)
+	suite.Require().NoError(suite.State().Create(suite.Ctx(), machineConfig))
+
+	rtestutils.AssertResources(suite.Ctx(), suite.T(), suite.State(), []resource.ID{"eth0", "eth1"},
+		func(spec *network.EthernetSpec, asrt *assert.Assertions) {
+			switch spec.Metadata().ID() {
+			case "eth0":
+				asrt.Equal(network.EthernetSpecSpec{
+					Rings: network.EthernetRingsSpec{
+						RX: pointer.To[uint32](4096),
+					},
+					Features: map[string]bool{
+						"rx-gro": false,
+					},
+				}, *spec.TypedSpec())
+			case "eth1":
+				asrt.Equal(network.EthernetSpecSpec{
+					Channels: network.EthernetChannelsSpec{
+						Combined: pointer.To[uint32](4),
+					},
+					Pause: network.EthernetPauseSpec{
+						TX: pointer.To(false),
+					},
+				}, *spec.TypedSpec())
+			}
+		})
+
+	// remove the config
+	suite.Require().NoError(suite.State().Destroy(suite.Ctx(), machineConfig.Metadata()))
+
+	rtestutils.AssertNoResource[*network.EthernetSpec](suite.Ctx(), suite.T(), suite.State(), "eth0")
+	rtestutils.AssertNoResource[*network.EthernetSpec](suite.Ctx(), suite.T(), suite.State(), "eth1")
+}
+
+func TestEthernetConfigSuite(t *testing.T) {
+	suite.Run(t, &EthernetConfigSuite{
+		DefaultSuite: ctest.DefaultSuite{
+			Timeout: 5 * time.Second,
+			AfterSetup: func(s *ctest.DefaultSuite) {
+				s.Require().NoError(s.Runtime().RegisterController(&netctrl.EthernetConfigController{}))
+			},
+		},
+	})
+}
@@ -0,0 +1,157 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package network
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/cosi-project/runtime/pkg/controller"
+	"github.com/cosi-project/runtime/pkg/safe"
+	"github.com/cosi-project/runtime/pkg/state"
+	"github.com/hashicorp/go-multierror"
+	"go.uber.org/zap"
+
+	"github.com/siderolabs/talos/internal/app/machined/pkg/controllers/network/internal/ethtool"
+	"github.com/siderolabs/talos/pkg/machinery/resources/network"
+)
+
+// EthtoolClient is implemented by ethtool.Client, interface for mocking.
+type EthtoolClient interface {
+	SetRings(linkName string, rings network.EthernetRingsSpec) error
+	SetChannels(linkName string, channels network.EthernetChannelsSpec) error
+	SetPause(linkName string, pause network.EthernetPauseSpec) error
+	SetFeatures(linkName string, features map[string]bool) error
+	Close() error
+}
+
+// NewEthtoolClientFunc function allows to replace ethtool.Client with the mock.
+type NewEthtoolClientFunc func() (EthtoolClient, error)
+
+// EthernetSpecController applies network.EthernetSpec to the actual interfaces.
+type EthernetSpecController struct {
+	NewEthtoolClient NewEthtoolClientFunc
+}
+
+// Name implements controller.Controller interface.
+func (ctrl *EthernetSpecController) Name() string {
+	return "network.EthernetSpecController"
+}
+
+// Inputs implements controller.Controller interface.
+func (ctrl *EthernetSpecController) Inputs() []controller.Input {
+	return []controller.Input{
+		{
+			Namespace: network.NamespaceName,
+			Type:      network.EthernetSpecType,
+			Kind:      controller.InputStrong,
+		},
+		{
+			// link status changes trigger re-applying the settings, as they might be reset on link flaps
+			Namespace: network.NamespaceName,
+			Type:      network.LinkStatusType,
+			Kind:      controller.InputWeak,
+		},
+	}
+}
+
+// Outputs implements controller.Controller interface.
+func (ctrl *EthernetSpecController) Outputs() []controller.Output {
+	return nil
+}
+
+// Run implements controller.Controller interface.
+func (ctrl *EthernetSpecController) Run(ctx context.Context, r controller.Runtime, logger *zap.Logger) error {
+	if ctrl.NewEthtoolClient == nil {
+		ctrl.NewEthtoolClient = func() (EthtoolClient, error) {
+			client, err := ethtool.New()
+			if err != nil {
+				return nil, err
+			}
+
+			return client, nil
+		}
+	}
+
+	client, err := ctrl.NewEthtoolClient()
+	if err != nil {
+		logger.Warn("error dialing ethtool socket", zap.Error(err))
+	} else {
+		defer client.Close() //nolint:errcheck
+	}
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-r.EventCh():
+		}
+
+		var specs safe.List[*network.EthernetSpec]
+
+		specs, err = safe.ReaderListAll[*network.EthernetSpec](ctx, r)
+		if err != nil {
+			return fmt.Errorf("error listing ethernet specs: %w", err)
+		}
+
+		if client == nil && specs.Len() > 0 {
+			logger.Warn("ethtool is not available, ethernet settings are not applied")
+
+			continue
+		}
+
+		for it := safe.IteratorFromList(specs); it.Next(); {
+			spec := it.Value()
+			linkName := spec.Metadata().ID()
+
+			if _, err = safe.ReaderGetByID[*network.LinkStatus](ctx, r, linkName); err != nil {
+				if state.IsNotFoundError(err) {
+					// link doesn't exist (yet), settings will be applied once it appears
+					continue
+				}
+
+				return fmt.Errorf("error getting link status: %w", err)
+			}
+
+			if err = ctrl.apply(client, linkName, spec.TypedSpec()); err != nil {
+				logger.Warn("error applying ethernet settings", zap.String("link", linkName), zap.Error(err))
+			}
+		}
+
+		r.ResetRestartBackoff()
+	}
+}
+
+func (ctrl *EthernetSpecController) apply(client EthtoolClient, linkName string, spec *network.EthernetSpecSpec) error {
+	// each group of settings is applied independently, so that a failure (e.g. not supported by the driver)
+	// doesn't prevent other settings from being applied
+	var errs error
+
+	if spec.Rings.RX != nil || spec.Rings.TX != nil {
+		if err := client.SetRings(linkName, spec.Rings); err != nil {
+			errs = multierror.Append(errs, fmt.Errorf("error setting rings: %w", err))
+		}
+	}
+
+	if spec.Channels.RX != nil || spec.Channels.TX != nil || spec.Channels.Other != nil || spec.Channels.Combined != nil {
+		if err := client.SetChannels(linkName, spec.Channels); err != nil {
+			errs = multierror.Append(errs, fmt.Errorf("error setting channels: %w", err))
+		}
+	}
+
+	if spec.Pause.Autonegotiate != nil || spec.Pause.RX != nil || spec.Pause.TX != nil {
+		if err := client.SetPause(linkName, spec.Pause); err != nil {
+			errs = multierror.Append(errs, fmt.Errorf("error setting pause: %w", err))
+		}
+	}
+
+	if len(spec.Features) > 0 {
+		if err := client.SetFeatures(linkName, spec.Features); err != nil {
+			errs = multierror.Append(errs, fmt.Errorf("error setting features: %w", err))
+		}
+	}
+
+	return errs
+}
@@ -0,0 +1,222 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package network_test
+
+import (
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/siderolabs/go-pointer"
+	"github.com/siderolabs/go-retry/retry"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/siderolabs/talos/internal/app/machined/pkg/controllers/ctest"
+	netctrl "github.com/siderolabs/talos/internal/app/machined/pkg/controllers/network"
+	"github.com/siderolabs/talos/pkg/machinery/resources/network"
+)
+
+type EthernetSpecSuite struct {
+	ctest.DefaultSuite
+
+	client *mockEthtoolClient
+}
+
+type mockEthtoolClient struct {
+	mu sync.Mutex
+
+	rings    map[string][]network.EthernetRingsSpec
+	channels map[string][]network.EthernetChannelsSpec
+	pause    map[string][]network.EthernetPauseSpec
+	features map[string][]map[string]bool
+}
+
+func newMockEthtoolClient() *mockEthtoolClient {
+	return &mockEthtoolClient{
+		rings:    map[string][]network.EthernetRingsSpec{},
+		channels: map[string][]network.EthernetChannelsSpec{},
+		pause:    map[string][]network.EthernetPauseSpec{},
+		features: map[string][]map[string]bool{},
+	}
+}
+
+func (mock *mockEthtoolClient) SetRings(linkName string, rings network.EthernetRingsSpec) error {
+	mock.mu.Lock()
+	defer mock.mu.Unlock()
+
+	mock.rings[linkName] = append(mock.rings[linkName], rings)
+
+	return nil
+}
+
+func (mock *mockEthtoolClient) SetChannels(linkName string, channels network.EthernetChannelsSpec) error {
+	mock.mu.Lock()
+	defer mock.mu.Unlock()
+
+	mock.channels[linkName] = append(mock.channels[linkName], channels)
+
+	return nil
+}
+
+func (mock *mockEthtoolClient) SetPause(linkName string, pause network.EthernetPauseSpec) error {
+	mock.mu.Lock()
+	defer mock.mu.Unlock()
+
+	mock.pause[linkName] = append(mock.pause[linkName], pause)
+
+	return nil
+}
+
+func (mock *mockEthtoolClient) SetFeatures(linkName string, features map[string]bool) error {
+	mock.mu.Lock()
+	defer mock.mu.Unlock()
+
+	mock.features[linkName] = append(mock.features[linkName], features)
+
+	return nil
+}
+
+func (mock *mockEthtoolClient) Close() error {
+	return nil
+}
+
+func (mock *mockEthtoolClient) ringsCalls(linkName string) []network.EthernetRingsSpec {
+	mock.mu.Lock()
+	defer mock.mu.Unlock()
+
+	return append([]network.EthernetRingsSpec(nil), mock.rings[linkName]...)
+}
+
+func (mock *mockEthtoolClient) channelsCalls(linkName string) []network.EthernetChannelsSpec {
+	mock.mu.Lock()
+	defer mock.mu.Unlock()
+
+	return append([]network.EthernetChannelsSpec(nil), mock.channels[linkName]...)
+}
+
+func (mock *mockEthtoolClient) pauseCalls(linkName string) []network.EthernetPauseSpec {
+	mock.mu.Lock()
+	defer mock.mu.Unlock()
+
+	return append([]network.EthernetPauseSpec(nil), mock.pause[linkName]...)
+}
+
+func (mock *mockEthtoolClient) featuresCalls(linkName string) []map[string]bool {
+	mock.mu.Lock()
+	defer mock.mu.Unlock()
+
+	return append([]map[string]bool(nil), mock.features[linkName]...)
+}
+
+func (suite *EthernetSpecSuite) assertCalls(check func() error) {
+	suite.Assert().NoError(retry.Constant(3*time.Second, retry.WithUnits(10*time.Millisecond)).Retry(check))
+}
+
+func (suite *EthernetSpecSuite) TestApply() {
+	spec := network.NewEthernetSpec(network.NamespaceName, "eth0")
+	spec.TypedSpec().Rings.RX = pointer.To[uint32](4096)
+	spec.TypedSpec().Features = map[string]bool{
+		"rx-gro": false,
+	}
+	suite.Create(spec)
+
+	// the link doesn't exist yet, so nothing should be applied
+	time.Sleep(100 * time.Millisecond)
+
+	suite.Assert().Empty(suite.client.ringsCalls("eth0"))
+	suite.Assert().Empty(suite.client.featuresCalls("eth0"))
+
+	// link appears, settings are applied
+	linkStatus := network.NewLinkStatus(network.NamespaceName, "eth0")
+	suite.Create(linkStatus)
+
+	suite.assertCalls(func() error {
+		if len(suite.client.ringsCalls("eth0")) != 1 || len(suite.client.featuresCalls("eth0")) != 1 {
+			return retry.ExpectedErrorf("settings are not applied yet")
+		}
+
+		return nil
+	})
+
+	suite.Assert().Equal([]network.EthernetRingsSpec{{RX: pointer.To[uint32](4096)}}, suite.client.ringsCalls("eth0"))
+	suite.Assert().Equal([]map[string]bool{{"rx-gro": false}}, suite.client.featuresCalls("eth0"))
+
+	// settings which are not set in the spec are not applied
+	suite.Assert().Empty(suite.client.channelsCalls("eth0"))
+	suite.Assert().Empty(suite.client.pauseCalls("eth0"))
+
+	// link flaps, settings are re-applied
+	linkStatus.TypedSpec().LinkState = true
+	suite.Require().NoError(suite.State().Update(suite.Ctx(), linkStatus))
+
+	suite.assertCalls(func() error {
+		if len(suite.client.ringsCalls("eth0")) != 2 {
+			return retry.ExpectedErrorf("settings are not re-applied yet")
+		}
+
+		return nil
+	})
+
+	// spec changes, new settings are applied
+	spec.TypedSpec().Pause.TX = pointer.To(false)
+	suite.Require().NoError(suite.State().Update(suite.Ctx(), spec))
+
+	suite.assertCalls(func() error {
+		if len(suite.client.pauseCalls("eth0")) != 1 {
+			return retry.ExpectedErrorf("pause settings are not applied yet")
+		}
+
+		return nil
+	})
+
+	suite.Assert().Equal([]network.EthernetPauseSpec{{TX: pointer.To(false)}}, suite.client.pauseCalls("eth0"))
+
+	rings := suite.client.ringsCalls("eth0")
+	suite.Assert().Equal(network.EthernetRingsSpec{RX: pointer.To[uint32](4096)}, rings[len(rings)-1])
+}
+
+func (suite *EthernetSpecSuite) TestNoLink() {
+	spec := network.NewEthernetSpec(network.NamespaceName, "eth1")
+	spec.TypedSpec().Channels.Combined = pointer.To[uint32](4)
+	suite.Create(spec)
+
+	// a status for another link doesn't trigger applying the settings
+	suite.Create(network.NewLinkStatus(network.NamespaceName, "eth2"))
+
+	time.Sleep(100 * time.Millisecond)
+
+	suite.Assert().Empty(suite.client.channelsCalls("eth1"))
+
+	suite.Create(network.NewLinkStatus(network.NamespaceName, "eth1"))
+
+	suite.assertCalls(func() error {
+		if len(suite.client.channelsCalls("eth1")) != 1 {
+			return retry.ExpectedErrorf("channel settings are not applied yet")
+		}
+
+		return nil
+	})
+
+	suite.Assert().Equal([]network.EthernetChannelsSpec{{Combined: pointer.To[uint32](4)}}, suite.client.channelsCalls("eth1"))
+}
+
+func TestEthernetSpecSuite(t *testing.T) {
+	s := &EthernetSpecSuite{}
+
+	s.DefaultSuite = ctest.DefaultSuite{
+		Timeout: 5 * time.Second,
+		AfterSetup: func(*ctest.DefaultSuite) {
+			s.client = newMockEthtoolClient()
+
+			s.Require().NoError(s.Runtime().RegisterController(&netctrl.EthernetSpecController{
+				NewEthtoolClient: func() (netctrl.EthtoolClient, error) {
+					return s.client, nil
+				},
+			}))
+		},
+	}
+
+	suite.Run(t, s)
+}
@@ -0,0 +1,168 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package network
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/cosi-project/runtime/pkg/controller"
+	"github.com/cosi-project/runtime/pkg/resource"
+	"github.com/cosi-project/runtime/pkg/safe"
+	"go.uber.org/zap"
+
+	"github.com/siderolabs/talos/internal/app/machined/pkg/controllers/network/internal/ethtool"
+	"github.com/siderolabs/talos/internal/app/machined/pkg/controllers/network/watch"
+	"github.com/siderolabs/talos/pkg/machinery/resources/network"
+)
+
+// EthernetStatusController reports ethtool settings of the physical links as network.EthernetStatus.
+type EthernetStatusController struct{}
+
+// Name implements controller.Controller interface.
+func (ctrl *EthernetStatusController) Name() string {
+	return "network.EthernetStatusController"
+}
+
+// Inputs implements controller.Controller interface.
+func (ctrl *EthernetStatusController) Inputs() []controller.Input {
+	return []controller.Input{
+		{
+			Namespace: network.NamespaceName,
+			Type:      network.LinkStatusType,
+			Kind:      controller.InputWeak,
+		},
+	}
+}
+
+// Outputs implements controller.Controller interface.
+func (ctrl *EthernetStatusController) Outputs() []controller.Output {
+	return []controller.Output{
+		{
+			Type: network.EthernetStatusType,
+			Kind: controller.OutputExclusive,
+		},
+	}
+}
+
+// Run implements controller.Controller interface.
+func (ctrl *EthernetStatusController) Run(ctx context.Context, r controller.Runtime, logger *zap.Logger) error {
+	// ethtool notifications are used to refresh the status when the settings are changed
+	ethtoolWatcher, err := watch.NewEthtool(r)
+	if err != nil {
+		logger.Warn("ethtool watcher failed to start", zap.Error(err))
+	} else {
+		defer ethtoolWatcher.Done()
+	}
+
+	client, err := ethtool.New()
+	if err != nil {
+		logger.Warn("error dialing ethtool socket", zap.Error(err))
+	} else {
+		defer client.Close() //nolint:errcheck
+	}
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-r.EventCh():
+		}
+
+		if err = ctrl.reconcile(ctx, r, logger, client); err != nil {
+			return err
+		}
+
+		r.ResetRestartBackoff()
+	}
+}
+
+func (ctrl *EthernetStatusController) reconcile(ctx context.Context, r controller.Runtime, logger *zap.Logger, client *ethtool.Client) error {
+	touchedIDs := make(map[resource.ID]struct{})
+
+	if client != nil {
+		links, err := safe.ReaderListAll[*network.LinkStatus](ctx, r)
+		if err != nil {
+			return fmt.Errorf("error listing links: %w", err)
+		}
+
+		for it := safe.IteratorFromList(links); it.Next(); {
+			link := it.Value()
+
+			if !link.TypedSpec().Physical() {
+				continue
+			}
+
+			linkName := link.Metadata().ID()
+
+			var status network.EthernetStatusSpec
+
+			status, err = ctrl.query(client, linkName)
+			if err != nil {
+				logger.Warn("error querying ethernet settings", zap.String("link", linkName), zap.Error(err))
+
+				continue
+			}
+
+			if err = safe.WriterModify(ctx, r, network.NewEthernetStatus(network.NamespaceName, linkName),
+				func(res *network.EthernetStatus) error {
+					*res.TypedSpec() = status
+
+					return nil
+				}); err != nil {
+				return fmt.Errorf("error updating ethernet status: %w", err)
+			}
+
+			touchedIDs[linkName] = struct{}{}
+		}
+	}
+
+	list, err := safe.ReaderListAll[*network.EthernetStatus](ctx, r)
+	if err != nil {
+		return fmt.Errorf("error listing resources: %w", err)
+	}
+
+	for it := safe.IteratorFromList(list); it.Next(); {
+		if _, ok := touchedIDs[it.Value().Metadata().ID()]; ok {
+			continue
+		}
+
+		if err = r.Destroy(ctx, it.Value().Metadata()); err != nil {
+			return fmt.Errorf("error cleaning up ethernet status: %w", err)
+		}
+	}
+
+	return nil
+}
+
+// query reads current ethtool settings, settings not supported by the driver are left empty.
+func (ctrl *EthernetStatusController) query(client *ethtool.Client, linkName string) (network.EthernetStatusSpec, error) {
+	var (
+		status network.EthernetStatusSpec
+		err    error
+	)
+
+	status.Rings, err = client.Rings(linkName)
+	if err != nil && !ethtool.IsNotSupported(err) {
+		return status, fmt.Errorf("error getting rings: %w", err)
+	}
+
+	status.Channels, err = client.Channels(linkName)
+	if err != nil && !ethtool.IsNotSupported(err) {
+		return status, fmt.Errorf("error getting channels: %w", err)
+	}
+
+	status.Pause, err = client.Pause(linkName)
+	if err != nil && !ethtool.IsNotSupported(err) {
+		return status, fmt.Errorf("error getting pause: %w", err)
+	}
+
+	status.Features, err = client.Features(linkName)
+	if err != nil && !ethtool.IsNotSupported(err) {
+		return status, fmt.Errorf("error getting features: %w", err)
+	}
+
+	return status, nil
+}
@@ -0,0 +1,125 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package network_test
+
+import (
+	"net"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/cosi-project/runtime/pkg/resource"
+	"github.com/cosi-project/runtime/pkg/resource/rtestutils"
+	"github.com/jsimonetti/rtnetlink"
+	"github.com/mdlayher/netlink"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+	"golang.org/x/sys/unix"
+
+	"github.com/siderolabs/talos/internal/app/machined/pkg/controllers/ctest"
+	netctrl "github.com/siderolabs/talos/internal/app/machined/pkg/controllers/network"
+	"github.com/siderolabs/talos/pkg/machinery/nethelpers"
+	"github.com/siderolabs/talos/pkg/machinery/resources/network"
+)
+
+type EthernetStatusSuite struct {
+	ctest.DefaultSuite
+}
+
+func ethernetFeatureStatus(features []network.EthernetFeatureStatus, name string) string {
+	for _, feature := range features {
+		if feature.Name == name {
+			return feature.Status
+		}
+	}
+
+	return ""
+}
+
+func (suite *EthernetStatusSuite) TestApply() {
+	if os.Geteuid() != 0 {
+		suite.T().Skip("requires root")
+	}
+
+	conn, err := rtnetlink.Dial(nil)
+	suite.Require().NoError(err)
+
+	defer conn.Close() //nolint:errcheck
+
+	peerMessage, err := (&rtnetlink.LinkMessage{
+		Attributes: &rtnetlink.LinkAttributes{
+			Name: "ethstatus1",
+		},
+	}).MarshalBinary()
+	suite.Require().NoError(err)
+
+	encoder := netlink.NewAttributeEncoder()
+	encoder.Bytes(vethInfoPeer, peerMessage)
+
+	vethData, err := encoder.Encode()
+	suite.Require().NoError(err)
+
+	suite.Require().NoError(conn.Link.New(&rtnetlink.LinkMessage{
+		Type: unix.ARPHRD_ETHER,
+		Attributes: &rtnetlink.LinkAttributes{
+			Name: "ethstatus0",
+			Info: &rtnetlink.LinkInfo{
+				Kind: "veth",
+				Data: vethData,
+			},
+		},
+	}))
+
+	iface, err := net.InterfaceByName("ethstatus0")
+	suite.Require().NoError(err)
+
+	defer conn.Link.Delete(uint32(iface.Index)) //nolint:errcheck
+
+	// pretend that the veth link is physical
+	linkStatus := network.NewLinkStatus(network.NamespaceName, iface.Name)
+	linkStatus.TypedSpec().Index = uint32(iface.Index)
+	linkStatus.TypedSpec().Type = nethelpers.LinkEther
+
+	suite.Require().NoError(suite.State().Create(suite.Ctx(), linkStatus))
+
+	rtestutils.AssertResources(suite.Ctx(), suite.T(), suite.State(), []resource.ID{iface.Name},
+		func(status *network.EthernetStatus, asrt *assert.Assertions) {
+			asrt.NotNil(status.TypedSpec().Channels)
+			asrt.Nil(status.TypedSpec().Pause)
+			asrt.Equal("off", ethernetFeatureStatus(status.TypedSpec().Features, "rx-gro"))
+			asrt.Equal("on", ethernetFeatureStatus(status.TypedSpec().Features, "tx-tcp-segmentation"))
+		})
+
+	spec := network.NewEthernetSpec(network.NamespaceName, iface.Name)
+	spec.TypedSpec().Features = map[string]bool{
+		"rx-gro":              true,
+		"tx-tcp-segmentation": false,
+	}
+
+	suite.Require().NoError(suite.State().Create(suite.Ctx(), spec))
+
+	rtestutils.AssertResources(suite.Ctx(), suite.T(), suite.State(), []resource.ID{iface.Name},
+		func(status *network.EthernetStatus, asrt *assert.Assertions) {
+			asrt.Equal("on", ethernetFeatureStatus(status.TypedSpec().Features, "rx-gro"))
+			asrt.Equal("off", ethernetFeatureStatus(status.TypedSpec().Features, "tx-tcp-segmentation"))
+		})
+
+	// status is removed once the link is gone
+	suite.Require().NoError(suite.State().Destroy(suite.Ctx(), linkStatus.Metadata()))
+
+	rtestutils.AssertNoResource[*network.EthernetStatus](suite.Ctx(), suite.T(), suite.State(), iface.Name)
+}
+
+func TestEthernetStatusSuite(t *testing.T) {
+	suite.Run(t, &EthernetStatusSuite{
+		DefaultSuite: ctest.DefaultSuite{
+			Timeout: 10 * time.Second,
+			AfterSetup: func(s *ctest.DefaultSuite) {
+				s.Require().NoError(s.Runtime().RegisterController(&netctrl.EthernetSpecController{}))
+				s.Require().NoError(s.Runtime().RegisterController(&netctrl.EthernetStatusController{}))
+			},
+		},
+	})
+}
@@ -0,0 +1,205 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package network
+
+import (
+	"context"
+	"fmt"
+	"net/netip"
+
+	"github.com/cosi-project/runtime/pkg/controller"
+	"github.com/cosi-project/runtime/pkg/resource"
+	"github.com/cosi-project/runtime/pkg/state"
+	"github.com/siderolabs/go-pointer"
+	"go.uber.org/zap"
+
+	talosconfig "github.com/siderolabs/talos/pkg/machinery/config"
+	"github.com/siderolabs/talos/pkg/machinery/constants"
+	"github.com/siderolabs/talos/pkg/machinery/nethelpers"
+	"github.com/siderolabs/talos/pkg/machinery/resources/config"
+	"github.com/siderolabs/talos/pkg/machinery/resources/network"
+)
+
+// HostDNSConfigController manages network.HostDNSConfig based on machine configuration.
+type HostDNSConfigController struct{}
+
+// Name implements controller.Controller interface.
+func (ctrl *HostDNSConfigController) Name() string {
+	return "network.HostDNSConfigController"
+}
+
+// Inputs implements controller.Controller interface.
+func (ctrl *HostDNSConfigController) Inputs() []controller.Input {
+	return []controller.Input{
+		{
+			Namespace: config.NamespaceName,
+			Type:      config.MachineConfigType,
+			ID:        pointer.To(config.V1Alpha1ID),
+			Kind:      controller.InputWeak,
+		},
+	}
+}
+
+// Outputs implements controller.Controller interface.
+func (ctrl *HostDNSConfigController) Outputs() []controller.Output {
+	return []controller.Output{
+		{
+			Type: network.HostDNSConfigType,
+			Kind: controller.OutputExclusive,
+		},
+		{
+			Type: network.AddressSpecType,
+			Kind: controller.OutputShared,
+		},
+	}
+}
+
+// Run implements controller.Controller interface.
+//
+//nolint:gocyclo
+func (ctrl *HostDNSConfigController) Run(ctx context.Context, r controller.Runtime, logger *zap.Logger) error {
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-r.EventCh():
+		}
+
+		var cfgProvider talosconfig.Config
+
+		cfg, err := r.Get(ctx, resource.NewMetadata(config.NamespaceName, config.MachineConfigType, config.V1Alpha1ID, resource.VersionUndefined))
+		if err != nil {
+			if !state.IsNotFoundError(err) {
+				return fmt.Errorf("error getting config: %w", err)
+			}
+		} else {
+			cfgProvider = cfg.(*config.MachineConfig).Config()
+		}
+
+		spec := ctrl.parseMachineConfiguration(logger, cfgProvider)
+
+		if err = r.Modify(ctx, network.NewHostDNSConfig(network.NamespaceName, network.HostDNSConfigID), func(r resource.Resource) error {
+			*r.(*network.HostDNSConfig).TypedSpec() = spec
+
+			return nil
+		}); err != nil {
+			return fmt.Errorf("error modifying host DNS config: %w", err)
+		}
+
+		touchedIDs := make(map[resource.ID]struct{})
+
+		// pods reach the resolver via the link-local address assigned to the loopback interface
+		if spec.Enabled && spec.ServiceHostDNSAddress.IsValid() {
+			address := network.AddressSpecSpec{
+				Address:     netip.PrefixFrom(spec.ServiceHostDNSAddress, spec.ServiceHostDNSAddress.BitLen()),
+				Family:      nethelpers.FamilyInet4,
+				Scope:       nethelpers.ScopeHost,
+				Flags:       nethelpers.AddressFlags(nethelpers.AddressPermanent),
+				LinkName:    "lo",
+				ConfigLayer: network.ConfigMachineConfiguration,
+			}
+
+			id := network.LayeredID(address.ConfigLayer, network.AddressID(address.LinkName, address.Address))
+
+			if err = r.Modify(ctx, network.NewAddressSpec(network.ConfigNamespaceName, id), func(r resource.Resource) error {
+				*r.(*network.AddressSpec).TypedSpec() = address
+
+				return nil
+			}); err != nil {
+				return fmt.Errorf("error modifying host DNS address: %w", err)
+			}
+
+			touchedIDs[id] = struct{}{}
+		}
+
+		// list addresses for cleanup
+		list, err := r.List(ctx, resource.NewMetadata(network.ConfigNamespaceName, network.AddressSpecType, "", resource.VersionUndefined))
+		if err != nil {
+			return fmt.Errorf("error listing resources: %w", err)
+		}
+
+		for _, res := range list.Items {
+			if res.Metadata().Owner() != ctrl.Name() {
+				// skip specs created by other controllers
+				continue
+			}
+
+			if _, ok := touchedIDs[res.Metadata().ID()]; !ok {
+				if err = r.Destroy(ctx, res.Metadata()); err != nil {
+					return fmt.Errorf("error cleaning up addresses: %w", err)
+				}
+			}
+		}
+
+		r.ResetRestartBackoff()
+	}
+}
+
+func (ctrl *HostDNSConfigController) parseMachineConfiguration(logger *zap.Logger, cfgProvider talosconfig.Config) (spec network.HostDNSConfigSpec) {
+	if cfgProvider == nil {
+		return spec
+	}
+
+	hostDNS := cfgProvider.Machine().Features().HostDNS()
+	if !hostDNS.Enabled() {
+		return spec
+	}
+
+	spec.Enabled = true
+	spec.ListenAddresses = []netip.AddrPort{
+		netip.AddrPortFrom(netip.MustParseAddr(constants.HostDNSLocalAddress), 53),
+	}
+
+	if hostDNS.ForwardKubeDNSToHost() {
+		spec.ServiceHostDNSAddress = netip.MustParseAddr(constants.HostDNSAddress)
+		spec.ListenAddresses = append(spec.ListenAddresses, netip.AddrPortFrom(spec.ServiceHostDNSAddress, 53))
+	}
+
+	for _, domain := range hostDNS.ForwardDomains() {
+		rule := network.DNSForwardRule{
+			Domain: domain.Domain(),
+		}
+
+		for _, server := range domain.Servers() {
+			addr, err := parseDNSServer(server)
+			if err != nil {
+				logger.Warn("skipping DNS forward server", zap.String("domain", domain.Domain()), zap.String("server", server), zap.Error(err))
+
+				continue
+			}
+
+			rule.Servers = append(rule.Servers, addr)
+		}
+
+		if len(rule.Servers) > 0 {
+			spec.ForwardRules = append(spec.ForwardRules, rule)
+		}
+	}
+
+	for _, extraHost := range cfgProvider.Machine().Network().ExtraHosts() {
+		addr, err := netip.ParseAddr(extraHost.IP())
+		if err != nil {
+			logger.Warn("skipping extra host", zap.String("ip", extraHost.IP()), zap.Error(err))
+
+			continue
+		}
+
+		spec.ExtraHosts = append(spec.ExtraHosts, network.DNSExtraHost{
+			Address:   addr,
+			Hostnames: extraHost.Aliases(),
+		})
+	}
+
+	return spec
+}
+
+// parseDNSServer parses the DNS server address with an optional port (53 by default).
+func parseDNSServer(server string) (netip.AddrPort, error) {
+	if addr, err := netip.ParseAddr(server); err == nil {
+		return netip.AddrPortFrom(addr, 53), nil
+	}
+
+	return netip.ParseAddrPort(server)
+}
@@ -0,0 +1,132 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package network_test
+
+import (
+	"net/netip"
+	"testing"
+	"time"
+
+	"github.com/cosi-project/runtime/pkg/resource"
+	"github.com/cosi-project/runtime/pkg/resource/rtestutils"
+	"github.com/siderolabs/go-pointer"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/siderolabs/talos/internal/app/machined/pkg/controllers/ctest"
+	netctrl "github.com/siderolabs/talos/internal/app/machined/pkg/controllers/network"
+	"github.com/siderolabs/talos/pkg/machinery/config/container"
+	"github.com/siderolabs/talos/pkg/machinery/config/types/v1alpha1"
+	"github.com/siderolabs/talos/pkg/machinery/nethelpers"
+	"github.com/siderolabs/talos/pkg/machinery/resources/config"
+	"github.com/siderolabs/talos/pkg/machinery/resources/network"
+)
+
+type HostDNSConfigSuite struct {
+	ctest.DefaultSuite
+}
+
+func (suite *HostDNSConfigSuite) TestDefault() {
+	rtestutils.AssertResources(suite.Ctx(), suite.T(), suite.State(), []resource.ID{network.HostDNSConfigID},
+		func(r *network.HostDNSConfig, asrt *assert.Assertions) {
+			asrt.Equal(network.HostDNSConfigSpec{}, *r.TypedSpec())
+		},
+	)
+}
+
+func (suite *HostDNSConfigSuite) TestMachineConfiguration() {
+	cfg := config.NewMachineConfig(
+		container.NewV1Alpha1(
+			&v1alpha1.Config{
+				ConfigVersion: "v1alpha1",
+				MachineConfig: &v1alpha1.MachineConfig{
+					MachineNetwork: &v1alpha1.NetworkConfig{
+						ExtraHostEntries: []*v1alpha1.ExtraHost{
+							{
+								HostIP:      "10.0.0.1",
+								HostAliases: []string{"a", "b"},
+							},
+						},
+					},
+					MachineFeatures: &v1alpha1.FeaturesConfig{
+						HostDNSSupport: &v1alpha1.HostDNSConfig{
+							HostDNSEnabled:              pointer.To(true),
+							HostDNSForwardKubeDNSToHost: pointer.To(true),
+							HostDNSForwardDomains: []*v1alpha1.HostDNSForwardDomain{
+								{
+									ForwardDomain:  "corp.example",
+									ForwardServers: []string{"10.0.0.53", "10.0.1.53:5353"},
+								},
+							},
+						},
+					},
+				},
+				ClusterConfig: &v1alpha1.ClusterConfig{},
+			},
+		),
+	)
+
+	suite.Require().NoError(suite.State().Create(suite.Ctx(), cfg))
+
+	rtestutils.AssertResources(suite.Ctx(), suite.T(), suite.State(), []resource.ID{network.HostDNSConfigID},
+		func(r *network.HostDNSConfig, asrt *assert.Assertions) {
+			asrt.Equal(network.HostDNSConfigSpec{
+				Enabled: true,
+				ListenAddresses: []netip.AddrPort{
+					netip.MustParseAddrPort("127.0.0.53:53"),
+					netip.MustParseAddrPort("169.254.116.108:53"),
+				},
+				ServiceHostDNSAddress: netip.MustParseAddr("169.254.116.108"),
+				ForwardRules: []network.DNSForwardRule{
+					{
+						Domain: "corp.example",
+						Servers: []netip.AddrPort{
+							netip.MustParseAddrPort("10.0.0.53:53"),
+							netip.MustParseAddrPort("10.0.1.53:5353"),
+						},
+					},
+				},
+				ExtraHosts: []network.DNSExtraHost{
+					{
+						Address:   netip.MustParseAddr("10.0.0.1"),
+						Hostnames: []string{"a", "b"},
+					},
+				},
+			}, *r.TypedSpec())
+		},
+	)
+
+	rtestutils.AssertResources(suite.Ctx(), suite.T(), suite.State(), []resource.ID{"configuration/lo/169.254.116.108/32"},
+		func(r *network.AddressSpec, asrt *assert.Assertions) {
+			asrt.Equal(netip.MustParsePrefix("169.254.116.108/32"), r.TypedSpec().Address)
+			asrt.Equal(nethelpers.ScopeHost, r.TypedSpec().Scope)
+			asrt.Equal("lo", r.TypedSpec().LinkName)
+		},
+		rtestutils.WithNamespace(network.ConfigNamespaceName),
+	)
+
+	suite.Require().NoError(suite.State().Destroy(suite.Ctx(), cfg.Metadata()))
+
+	rtestutils.AssertResources(suite.Ctx(), suite.T(), suite.State(), []resource.ID{network.HostDNSConfigID},
+		func(r *network.HostDNSConfig, asrt *assert.Assertions) {
+			asrt.False(r.TypedSpec().Enabled)
+		},
+	)
+
+	rtestutils.AssertNoResource[*network.AddressSpec](suite.Ctx(), suite.T(), suite.State(), "configuration/lo/169.254.116.108/32",
+		rtestutils.WithNamespace(network.ConfigNamespaceName),
+	)
+}
+
+func TestHostDNSConfigSuite(t *testing.T) {
+	suite.Run(t, &HostDNSConfigSuite{
+		DefaultSuite: ctest.DefaultSuite{
+			Timeout: 5 * time.Second,
+			AfterSetup: func(s *ctest.DefaultSuite) {
+				s.Require().NoError(s.Runtime().RegisterController(&netctrl.HostDNSConfigController{}))
+			},
+		},
+	})
+}
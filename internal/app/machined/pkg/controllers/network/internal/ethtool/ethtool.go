@@ -0,0 +1,398 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+// Package ethtool implements querying and changing the link settings via ethtool genetlink family.
+package ethtool
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+
+	"github.com/mdlayher/genetlink"
+	"github.com/mdlayher/netlink"
+	"golang.org/x/sys/unix"
+
+	"github.com/siderolabs/talos/pkg/machinery/resources/network"
+)
+
+// Client is an ethtool genetlink client.
+type Client struct {
+	conn   *genetlink.Conn
+	family genetlink.Family
+}
+
+// New creates a new ethtool client.
+func New() (*Client, error) {
+	conn, err := genetlink.Dial(nil)
+	if err != nil {
+		return nil, fmt.Errorf("error dialing genetlink: %w", err)
+	}
+
+	family, err := conn.GetFamily(unix.ETHTOOL_GENL_NAME)
+	if err != nil {
+		conn.Close() //nolint:errcheck
+
+		return nil, fmt.Errorf("error getting family information for ethtool: %w", err)
+	}
+
+	return &Client{
+		conn:   conn,
+		family: family,
+	}, nil
+}
+
+// Close the client.
+func (c *Client) Close() error {
+	return c.conn.Close()
+}
+
+// IsNotSupported returns true if the error indicates that the link driver doesn't support the operation.
+func IsNotSupported(err error) bool {
+	return errors.Is(err, unix.EOPNOTSUPP)
+}
+
+// Rings returns the current and maximum ring buffer sizes.
+func (c *Client) Rings(linkName string) (*network.EthernetRingsStatus, error) {
+	ad, err := c.get(unix.ETHTOOL_MSG_RINGS_GET, unix.ETHTOOL_A_RINGS_HEADER, linkName)
+	if err != nil {
+		return nil, err
+	}
+
+	var rings network.EthernetRingsStatus
+
+	for ad.Next() {
+		switch ad.Type() {
+		case unix.ETHTOOL_A_RINGS_RX_MAX:
+			rings.RXMax = ad.Uint32()
+		case unix.ETHTOOL_A_RINGS_TX_MAX:
+			rings.TXMax = ad.Uint32()
+		case unix.ETHTOOL_A_RINGS_RX:
+			rings.RX = ad.Uint32()
+		case unix.ETHTOOL_A_RINGS_TX:
+			rings.TX = ad.Uint32()
+		}
+	}
+
+	return &rings, ad.Err()
+}
+
+// SetRings changes the ring buffer sizes which are set in the spec.
+func (c *Client) SetRings(linkName string, rings network.EthernetRingsSpec) error {
+	return c.set(unix.ETHTOOL_MSG_RINGS_SET, unix.ETHTOOL_A_RINGS_HEADER, linkName, func(ae *netlink.AttributeEncoder) {
+		putUint32(ae, unix.ETHTOOL_A_RINGS_RX, rings.RX)
+		putUint32(ae, unix.ETHTOOL_A_RINGS_TX, rings.TX)
+	})
+}
+
+// Channels returns the current and maximum channel counts.
+func (c *Client) Channels(linkName string) (*network.EthernetChannelsStatus, error) {
+	ad, err := c.get(unix.ETHTOOL_MSG_CHANNELS_GET, unix.ETHTOOL_A_CHANNELS_HEADER, linkName)
+	if err != nil {
+		return nil, err
+	}
+
+	var channels network.EthernetChannelsStatus
+
+	for ad.Next() {
+		switch ad.Type() {
+		case unix.ETHTOOL_A_CHANNELS_RX_MAX:
+			channels.RXMax = ad.Uint32()
+		case unix.ETHTOOL_A_CHANNELS_TX_MAX:
+			channels.TXMax = ad.Uint32()
+		case unix.ETHTOOL_A_CHANNELS_OTHER_MAX:
+			channels.OtherMax = ad.Uint32()
+		case unix.ETHTOOL_A_CHANNELS_COMBINED_MAX:
+			channels.CombinedMax = ad.Uint32()
+		case unix.ETHTOOL_A_CHANNELS_RX_COUNT:
+			channels.RX = ad.Uint32()
+		case unix.ETHTOOL_A_CHANNELS_TX_COUNT:
+			channels.TX = ad.Uint32()
+		case unix.ETHTOOL_A_CHANNELS_OTHER_COUNT:
+			channels.Other = ad.Uint32()
+		case unix.ETHTOOL_A_CHANNELS_COMBINED_COUNT:
+			channels.Combined = ad.Uint32()
+		}
+	}
+
+	return &channels, ad.Err()
+}
+
+// SetChannels changes the channel counts which are set in the spec.
+func (c *Client) SetChannels(linkName string, channels network.EthernetChannelsSpec) error {
+	return c.set(unix.ETHTOOL_MSG_CHANNELS_SET, unix.ETHTOOL_A_CHANNELS_HEADER, linkName, func(ae *netlink.AttributeEncoder) {
+		putUint32(ae, unix.ETHTOOL_A_CHANNELS_RX_COUNT, channels.RX)
+		putUint32(ae, unix.ETHTOOL_A_CHANNELS_TX_COUNT, channels.TX)
+		putUint32(ae, unix.ETHTOOL_A_CHANNELS_OTHER_COUNT, channels.Other)
+		putUint32(ae, unix.ETHTOOL_A_CHANNELS_COMBINED_COUNT, channels.Combined)
+	})
+}
+
+// Pause returns the pause frame settings.
+func (c *Client) Pause(linkName string) (*network.EthernetPauseStatus, error) {
+	ad, err := c.get(unix.ETHTOOL_MSG_PAUSE_GET, unix.ETHTOOL_A_PAUSE_HEADER, linkName)
+	if err != nil {
+		return nil, err
+	}
+
+	var pause network.EthernetPauseStatus
+
+	for ad.Next() {
+		switch ad.Type() {
+		case unix.ETHTOOL_A_PAUSE_AUTONEG:
+			pause.Autonegotiate = ad.Uint8() != 0
+		case unix.ETHTOOL_A_PAUSE_RX:
+			pause.RX = ad.Uint8() != 0
+		case unix.ETHTOOL_A_PAUSE_TX:
+			pause.TX = ad.Uint8() != 0
+		}
+	}
+
+	return &pause, ad.Err()
+}
+
+// SetPause changes the pause frame settings which are set in the spec.
+func (c *Client) SetPause(linkName string, pause network.EthernetPauseSpec) error {
+	return c.set(unix.ETHTOOL_MSG_PAUSE_SET, unix.ETHTOOL_A_PAUSE_HEADER, linkName, func(ae *netlink.AttributeEncoder) {
+		putBool(ae, unix.ETHTOOL_A_PAUSE_AUTONEG, pause.Autonegotiate)
+		putBool(ae, unix.ETHTOOL_A_PAUSE_RX, pause.RX)
+		putBool(ae, unix.ETHTOOL_A_PAUSE_TX, pause.TX)
+	})
+}
+
+// Features returns the state of the link features sorted by name.
+//
+// Features which are neither active nor changeable might be not listed.
+func (c *Client) Features(linkName string) ([]network.EthernetFeatureStatus, error) {
+	ad, err := c.get(unix.ETHTOOL_MSG_FEATURES_GET, unix.ETHTOOL_A_FEATURES_HEADER, linkName)
+	if err != nil {
+		return nil, err
+	}
+
+	var hw, active, noChange map[string]struct{}
+
+	for ad.Next() {
+		switch ad.Type() {
+		case unix.ETHTOOL_A_FEATURES_HW:
+			ad.Nested(func(nad *netlink.AttributeDecoder) error {
+				hw = decodeBitset(nad)
+
+				return nil
+			})
+		case unix.ETHTOOL_A_FEATURES_ACTIVE:
+			ad.Nested(func(nad *netlink.AttributeDecoder) error {
+				active = decodeBitset(nad)
+
+				return nil
+			})
+		case unix.ETHTOOL_A_FEATURES_NOCHANGE:
+			ad.Nested(func(nad *netlink.AttributeDecoder) error {
+				noChange = decodeBitset(nad)
+
+				return nil
+			})
+		}
+	}
+
+	if err = ad.Err(); err != nil {
+		return nil, err
+	}
+
+	names := map[string]struct{}{}
+
+	for _, set := range []map[string]struct{}{hw, active, noChange} {
+		for name := range set {
+			names[name] = struct{}{}
+		}
+	}
+
+	features := make([]network.EthernetFeatureStatus, 0, len(names))
+
+	for name := range names {
+		status := "off"
+
+		if _, ok := active[name]; ok {
+			status = "on"
+		}
+
+		_, changeable := hw[name]
+		_, fixed := noChange[name]
+
+		if !changeable || fixed {
+			status += " [fixed]"
+		}
+
+		features = append(features, network.EthernetFeatureStatus{
+			Name:   name,
+			Status: status,
+		})
+	}
+
+	sort.Slice(features, func(i, j int) bool {
+		return features[i].Name < features[j].Name
+	})
+
+	return features, nil
+}
+
+// SetFeatures changes the state of the listed features.
+func (c *Client) SetFeatures(linkName string, features map[string]bool) error {
+	return c.set(unix.ETHTOOL_MSG_FEATURES_SET, unix.ETHTOOL_A_FEATURES_HEADER, linkName, func(ae *netlink.AttributeEncoder) {
+		names := make([]string, 0, len(features))
+
+		for name := range features {
+			names = append(names, name)
+		}
+
+		sort.Strings(names)
+
+		ae.Nested(unix.ETHTOOL_A_FEATURES_WANTED, func(nae *netlink.AttributeEncoder) error {
+			nae.Nested(unix.ETHTOOL_A_BITSET_BITS, func(bitsEncoder *netlink.AttributeEncoder) error {
+				for _, name := range names {
+					bitsEncoder.Nested(unix.ETHTOOL_A_BITSET_BITS_BIT, func(bitEncoder *netlink.AttributeEncoder) error {
+						bitEncoder.String(unix.ETHTOOL_A_BITSET_BIT_NAME, name)
+
+						if features[name] {
+							bitEncoder.Flag(unix.ETHTOOL_A_BITSET_BIT_VALUE, true)
+						}
+
+						return nil
+					})
+				}
+
+				return nil
+			})
+
+			return nil
+		})
+	})
+}
+
+func (c *Client) get(cmd uint8, headerType uint16, linkName string) (*netlink.AttributeDecoder, error) {
+	ae := netlink.NewAttributeEncoder()
+	encodeHeader(ae, headerType, linkName, 0)
+
+	msgs, err := c.execute(cmd, ae, netlink.Request)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(msgs) != 1 {
+		return nil, fmt.Errorf("unexpected number of ethtool replies: %d", len(msgs))
+	}
+
+	return netlink.NewAttributeDecoder(msgs[0].Data)
+}
+
+func (c *Client) set(cmd uint8, headerType uint16, linkName string, encode func(ae *netlink.AttributeEncoder)) error {
+	ae := netlink.NewAttributeEncoder()
+	// only the acknowledgement is expected
+	encodeHeader(ae, headerType, linkName, unix.ETHTOOL_FLAG_OMIT_REPLY)
+	encode(ae)
+
+	_, err := c.execute(cmd, ae, netlink.Request|netlink.Acknowledge)
+
+	return err
+}
+
+func (c *Client) execute(cmd uint8, ae *netlink.AttributeEncoder, flags netlink.HeaderFlags) ([]genetlink.Message, error) {
+	data, err := ae.Encode()
+	if err != nil {
+		return nil, err
+	}
+
+	return c.conn.Execute(genetlink.Message{
+		Header: genetlink.Header{
+			Command: cmd,
+			Version: c.family.Version,
+		},
+		Data: data,
+	}, c.family.ID, flags)
+}
+
+func encodeHeader(ae *netlink.AttributeEncoder, headerType uint16, linkName string, flags uint32) {
+	ae.Nested(headerType, func(nae *netlink.AttributeEncoder) error {
+		nae.String(unix.ETHTOOL_A_HEADER_DEV_NAME, linkName)
+
+		if flags != 0 {
+			nae.Uint32(unix.ETHTOOL_A_HEADER_FLAGS, flags)
+		}
+
+		return nil
+	})
+}
+
+// decodeBitset decodes the names of the bits set in the verbose ethtool bitset.
+func decodeBitset(ad *netlink.AttributeDecoder) map[string]struct{} {
+	noMask := false
+	set := map[string]struct{}{}
+
+	type bit struct {
+		name  string
+		value bool
+	}
+
+	var bits []bit
+
+	for ad.Next() {
+		switch ad.Type() {
+		case unix.ETHTOOL_A_BITSET_NOMASK:
+			noMask = true
+		case unix.ETHTOOL_A_BITSET_BITS:
+			ad.Nested(func(bitsDecoder *netlink.AttributeDecoder) error {
+				for bitsDecoder.Next() {
+					if bitsDecoder.Type() != unix.ETHTOOL_A_BITSET_BITS_BIT {
+						continue
+					}
+
+					bitsDecoder.Nested(func(bitDecoder *netlink.AttributeDecoder) error {
+						var b bit
+
+						for bitDecoder.Next() {
+							switch bitDecoder.Type() {
+							case unix.ETHTOOL_A_BITSET_BIT_NAME:
+								b.name = bitDecoder.String()
+							case unix.ETHTOOL_A_BITSET_BIT_VALUE:
+								b.value = true
+							}
+						}
+
+						bits = append(bits, b)
+
+						return nil
+					})
+				}
+
+				return nil
+			})
+		}
+	}
+
+	for _, b := range bits {
+		// without the mask, only the bits which are set are listed
+		if noMask || b.value {
+			set[b.name] = struct{}{}
+		}
+	}
+
+	return set
+}
+
+func putUint32(ae *netlink.AttributeEncoder, typ uint16, v *uint32) {
+	if v != nil {
+		ae.Uint32(typ, *v)
+	}
+}
+
+func putBool(ae *netlink.AttributeEncoder, typ uint16, v *bool) {
+	if v == nil {
+		return
+	}
+
+	if *v {
+		ae.Uint8(typ, 1)
+	} else {
+		ae.Uint8(typ, 0)
+	}
+}
@@ -0,0 +1,117 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package ethtool_test
+
+import (
+	"net"
+	"os"
+	"testing"
+
+	"github.com/jsimonetti/rtnetlink"
+	"github.com/mdlayher/netlink"
+	"github.com/siderolabs/go-pointer"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/sys/unix"
+
+	"github.com/siderolabs/talos/internal/app/machined/pkg/controllers/network/internal/ethtool"
+	"github.com/siderolabs/talos/pkg/machinery/resources/network"
+)
+
+// vethInfoPeer is VETH_INFO_PEER from linux/veth.h.
+const vethInfoPeer = 1
+
+func createVeth(t *testing.T, name, peerName string) {
+	t.Helper()
+
+	conn, err := rtnetlink.Dial(nil)
+	require.NoError(t, err)
+
+	t.Cleanup(func() { conn.Close() }) //nolint:errcheck
+
+	peerMessage, err := (&rtnetlink.LinkMessage{
+		Attributes: &rtnetlink.LinkAttributes{
+			Name: peerName,
+		},
+	}).MarshalBinary()
+	require.NoError(t, err)
+
+	encoder := netlink.NewAttributeEncoder()
+	encoder.Bytes(vethInfoPeer, peerMessage)
+
+	vethData, err := encoder.Encode()
+	require.NoError(t, err)
+
+	require.NoError(t, conn.Link.New(&rtnetlink.LinkMessage{
+		Type: unix.ARPHRD_ETHER,
+		Attributes: &rtnetlink.LinkAttributes{
+			Name: name,
+			Info: &rtnetlink.LinkInfo{
+				Kind: "veth",
+				Data: vethData,
+			},
+		},
+	}))
+
+	iface, err := net.InterfaceByName(name)
+	require.NoError(t, err)
+
+	t.Cleanup(func() { conn.Link.Delete(uint32(iface.Index)) }) //nolint:errcheck
+}
+
+func featureStatus(features []network.EthernetFeatureStatus, name string) string {
+	for _, feature := range features {
+		if feature.Name == name {
+			return feature.Status
+		}
+	}
+
+	return ""
+}
+
+func TestClient(t *testing.T) {
+	if os.Geteuid() != 0 {
+		t.Skip("requires root")
+	}
+
+	createVeth(t, "ethtooltest0", "ethtooltest1")
+
+	client, err := ethtool.New()
+	require.NoError(t, err)
+
+	t.Cleanup(func() { client.Close() }) //nolint:errcheck
+
+	features, err := client.Features("ethtooltest0")
+	require.NoError(t, err)
+
+	assert.Equal(t, "off", featureStatus(features, "rx-gro"))
+	assert.Equal(t, "on", featureStatus(features, "tx-tcp-segmentation"))
+	assert.Equal(t, "off [fixed]", featureStatus(features, "vlan-challenged"))
+
+	require.NoError(t, client.SetFeatures("ethtooltest0", map[string]bool{"rx-gro": true, "tx-tcp-segmentation": false}))
+
+	features, err = client.Features("ethtooltest0")
+	require.NoError(t, err)
+
+	assert.Equal(t, "on", featureStatus(features, "rx-gro"))
+	assert.Equal(t, "off", featureStatus(features, "tx-tcp-segmentation"))
+
+	assert.Error(t, client.SetFeatures("ethtooltest0", map[string]bool{"no-such-feature": true}))
+
+	channels, err := client.Channels("ethtooltest0")
+	require.NoError(t, err)
+
+	if channels.RXMax > 1 {
+		require.NoError(t, client.SetChannels("ethtooltest0", network.EthernetChannelsSpec{RX: pointer.To[uint32](2)}))
+
+		channels, err = client.Channels("ethtooltest0")
+		require.NoError(t, err)
+
+		assert.EqualValues(t, 2, channels.RX)
+	}
+
+	_, err = client.Pause("ethtooltest0")
+	assert.True(t, ethtool.IsNotSupported(err), "unexpected error: %v", err)
+}
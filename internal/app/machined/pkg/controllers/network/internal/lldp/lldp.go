@@ -0,0 +1,193 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+// Package lldp implements receiving and transmitting of the LLDP frames.
+package lldp
+
+import (
+	"errors"
+	"net"
+	"net/netip"
+	"time"
+	"unicode/utf8"
+
+	"github.com/google/gopacket"
+	"github.com/google/gopacket/layers"
+
+	"github.com/siderolabs/talos/pkg/machinery/resources/network"
+)
+
+// EtherType of the LLDP frames.
+const EtherType = 0x88cc
+
+// maxStringLength is the maximum length of the string TLVs.
+const maxStringLength = 255
+
+// MulticastAddr is the nearest bridge destination address of the LLDP frames.
+var MulticastAddr = net.HardwareAddr{0x01, 0x80, 0xc2, 0x00, 0x00, 0x0e}
+
+// Advertisement describes the LLDP frame sent by the node.
+type Advertisement struct {
+	ChassisID         net.HardwareAddr
+	PortID            string
+	PortDescription   string
+	SystemName        string
+	SystemDescription string
+	TTL               time.Duration
+}
+
+// Equal returns true if the advertisements are equal.
+func (adv *Advertisement) Equal(other *Advertisement) bool {
+	if adv == nil || other == nil {
+		return adv == other
+	}
+
+	return adv.ChassisID.String() == other.ChassisID.String() &&
+		adv.PortID == other.PortID &&
+		adv.PortDescription == other.PortDescription &&
+		adv.SystemName == other.SystemName &&
+		adv.SystemDescription == other.SystemDescription &&
+		adv.TTL == other.TTL
+}
+
+// Encode the advertisement as LLDPDU (without the Ethernet header).
+func Encode(adv *Advertisement) ([]byte, error) {
+	lldp := &layers.LinkLayerDiscovery{
+		ChassisID: layers.LLDPChassisID{
+			Subtype: layers.LLDPChassisIDSubTypeMACAddr,
+			ID:      adv.ChassisID,
+		},
+		PortID: layers.LLDPPortID{
+			Subtype: layers.LLDPPortIDSubtypeIfaceName,
+			ID:      []byte(adv.PortID),
+		},
+		TTL: uint16(adv.TTL / time.Second),
+	}
+
+	for _, tlv := range []struct {
+		typ   layers.LLDPTLVType
+		value string
+	}{
+		{layers.LLDPTLVPortDescription, adv.PortDescription},
+		{layers.LLDPTLVSysName, adv.SystemName},
+		{layers.LLDPTLVSysDescription, adv.SystemDescription},
+	} {
+		if tlv.value == "" {
+			continue
+		}
+
+		if len(tlv.value) > maxStringLength {
+			tlv.value = tlv.value[:maxStringLength]
+		}
+
+		lldp.Values = append(lldp.Values, layers.LinkLayerDiscoveryValue{
+			Type:   tlv.typ,
+			Length: uint16(len(tlv.value)),
+			Value:  []byte(tlv.value),
+		})
+	}
+
+	buf := gopacket.NewSerializeBuffer()
+
+	if err := lldp.SerializeTo(buf, gopacket.SerializeOptions{}); err != nil {
+		return nil, err
+	}
+
+	return buf.Bytes(), nil
+}
+
+// Decode the LLDPDU (without the Ethernet header) into the neighbor spec.
+//
+// The link name of the returned spec is not set.
+func Decode(data []byte) (network.LLDPNeighborSpec, error) {
+	packet := gopacket.NewPacket(data, layers.LayerTypeLinkLayerDiscovery, gopacket.DecodeOptions{NoCopy: true})
+
+	if errLayer := packet.ErrorLayer(); errLayer != nil {
+		return network.LLDPNeighborSpec{}, errLayer.Error()
+	}
+
+	lldp, ok := packet.Layer(layers.LayerTypeLinkLayerDiscovery).(*layers.LinkLayerDiscovery)
+	if !ok {
+		return network.LLDPNeighborSpec{}, errors.New("not a LLDP frame")
+	}
+
+	spec := network.LLDPNeighborSpec{
+		ChassisID: formatChassisID(lldp.ChassisID),
+		PortID:    formatPortID(lldp.PortID),
+		TTL:       time.Duration(lldp.TTL) * time.Second,
+	}
+
+	if info, ok := packet.Layer(layers.LayerTypeLinkLayerDiscoveryInfo).(*layers.LinkLayerDiscoveryInfo); ok {
+		spec.PortDescription = info.PortDescription
+		spec.SystemName = info.SysName
+		spec.SystemDescription = info.SysDescription
+
+		switch info.MgmtAddress.Subtype { //nolint:exhaustive
+		case layers.IANAAddressFamilyIPV4, layers.IANAAddressFamilyIPV6:
+			if addr, ok := netip.AddrFromSlice(info.MgmtAddress.Address); ok {
+				spec.ManagementAddress = addr
+			}
+		}
+
+		if info8021, err := info.Decode8021(); err == nil {
+			spec.VLANID = info8021.PVID
+		}
+	}
+
+	return spec, nil
+}
+
+func formatChassisID(id layers.LLDPChassisID) string {
+	switch id.Subtype { //nolint:exhaustive
+	case layers.LLDPChassisIDSubTypeMACAddr:
+		return net.HardwareAddr(id.ID).String()
+	case layers.LLDPChassisIDSubTypeNetworkAddr:
+		return formatNetworkAddr(id.ID)
+	default:
+		return formatString(id.ID)
+	}
+}
+
+func formatPortID(id layers.LLDPPortID) string {
+	switch id.Subtype { //nolint:exhaustive
+	case layers.LLDPPortIDSubtypeMACAddr:
+		return net.HardwareAddr(id.ID).String()
+	case layers.LLDPPortIDSubtypeNetworkAddr:
+		return formatNetworkAddr(id.ID)
+	default:
+		return formatString(id.ID)
+	}
+}
+
+// formatNetworkAddr formats the address prefixed with the IANA address family.
+func formatNetworkAddr(b []byte) string {
+	if len(b) > 1 {
+		if addr, ok := netip.AddrFromSlice(b[1:]); ok {
+			return addr.String()
+		}
+	}
+
+	return formatString(b)
+}
+
+// formatString formats the ID as a string if it is printable, and as a MAC-like hex string otherwise.
+func formatString(b []byte) string {
+	if utf8.Valid(b) {
+		printable := true
+
+		for _, r := range string(b) {
+			if r < 0x20 || r == 0x7f {
+				printable = false
+
+				break
+			}
+		}
+
+		if printable {
+			return string(b)
+		}
+	}
+
+	return net.HardwareAddr(b).String()
+}
@@ -0,0 +1,173 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package lldp_test
+
+import (
+	"context"
+	"net"
+	"os"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/jsimonetti/rtnetlink"
+	"github.com/mdlayher/netlink"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap/zaptest"
+	"golang.org/x/sys/unix"
+
+	"github.com/siderolabs/talos/internal/app/machined/pkg/controllers/network/internal/lldp"
+	"github.com/siderolabs/talos/pkg/machinery/resources/network"
+)
+
+func TestEncodeDecode(t *testing.T) {
+	t.Parallel()
+
+	frame, err := lldp.Encode(&lldp.Advertisement{
+		ChassisID:         net.HardwareAddr{0x00, 0x11, 0x22, 0x33, 0x44, 0x55},
+		PortID:            "eth0",
+		PortDescription:   "uplink",
+		SystemName:        "talos-node",
+		SystemDescription: strings.Repeat("x", 300),
+		TTL:               2 * time.Minute,
+	})
+	require.NoError(t, err)
+
+	spec, err := lldp.Decode(frame)
+	require.NoError(t, err)
+
+	assert.Equal(t, network.LLDPNeighborSpec{
+		ChassisID:         "00:11:22:33:44:55",
+		PortID:            "eth0",
+		PortDescription:   "uplink",
+		SystemName:        "talos-node",
+		SystemDescription: strings.Repeat("x", 255),
+		TTL:               2 * time.Minute,
+	}, spec)
+}
+
+func TestDecodeInvalid(t *testing.T) {
+	t.Parallel()
+
+	_, err := lldp.Decode([]byte{0x02, 0x07})
+	assert.Error(t, err)
+}
+
+func TestAdvertisementEqual(t *testing.T) {
+	t.Parallel()
+
+	a := &lldp.Advertisement{ChassisID: net.HardwareAddr{0x00, 0x11, 0x22, 0x33, 0x44, 0x55}, PortID: "eth0", TTL: time.Minute}
+	b := &lldp.Advertisement{ChassisID: net.HardwareAddr{0x00, 0x11, 0x22, 0x33, 0x44, 0x55}, PortID: "eth0", TTL: time.Minute}
+
+	assert.True(t, a.Equal(b))
+	assert.False(t, a.Equal(nil))
+	assert.True(t, (*lldp.Advertisement)(nil).Equal(nil))
+
+	b.SystemName = "node"
+
+	assert.False(t, a.Equal(b))
+}
+
+// vethInfoPeer is VETH_INFO_PEER from linux/veth.h.
+const vethInfoPeer = 1
+
+func TestRunner(t *testing.T) {
+	if os.Geteuid() != 0 {
+		t.Skip("requires root")
+	}
+
+	conn, err := rtnetlink.Dial(nil)
+	require.NoError(t, err)
+
+	t.Cleanup(func() { conn.Close() }) //nolint:errcheck
+
+	peerMessage, err := (&rtnetlink.LinkMessage{
+		Attributes: &rtnetlink.LinkAttributes{
+			Name: "lldptest1",
+		},
+	}).MarshalBinary()
+	require.NoError(t, err)
+
+	encoder := netlink.NewAttributeEncoder()
+	encoder.Bytes(vethInfoPeer, peerMessage)
+
+	vethData, err := encoder.Encode()
+	require.NoError(t, err)
+
+	require.NoError(t, conn.Link.New(&rtnetlink.LinkMessage{
+		Type: unix.ARPHRD_ETHER,
+		Attributes: &rtnetlink.LinkAttributes{
+			Name: "lldptest0",
+			Info: &rtnetlink.LinkInfo{
+				Kind: "veth",
+				Data: vethData,
+			},
+		},
+	}))
+
+	local, err := net.InterfaceByName("lldptest0")
+	require.NoError(t, err)
+
+	t.Cleanup(func() { conn.Link.Delete(uint32(local.Index)) }) //nolint:errcheck
+
+	peer, err := net.InterfaceByName("lldptest1")
+	require.NoError(t, err)
+
+	for _, iface := range []*net.Interface{local, peer} {
+		require.NoError(t, conn.Link.Set(&rtnetlink.LinkMessage{
+			Type:   unix.ARPHRD_ETHER,
+			Index:  uint32(iface.Index),
+			Flags:  unix.IFF_UP,
+			Change: unix.IFF_UP,
+		}))
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	t.Cleanup(cancel)
+
+	logger := zaptest.NewLogger(t)
+
+	transmitter := lldp.Runner{
+		LinkName:         peer.Name,
+		LinkIndex:        peer.Index,
+		HardwareAddr:     peer.HardwareAddr,
+		TransmitInterval: 100 * time.Millisecond,
+		Advertisement: &lldp.Advertisement{
+			ChassisID:  peer.HardwareAddr,
+			PortID:     peer.Name,
+			SystemName: "peer",
+			TTL:        time.Second,
+		},
+	}
+
+	receiver := lldp.Runner{
+		LinkName:     local.Name,
+		LinkIndex:    local.Index,
+		HardwareAddr: local.HardwareAddr,
+	}
+
+	notifyCh := make(chan lldp.Notification)
+
+	require.NoError(t, receiver.Start(ctx, notifyCh, logger))
+	t.Cleanup(receiver.Stop)
+
+	require.NoError(t, transmitter.Start(ctx, make(chan lldp.Notification), logger))
+	t.Cleanup(transmitter.Stop)
+
+	select {
+	case <-ctx.Done():
+		require.FailNow(t, "timed out waiting for the LLDP notification")
+	case ev := <-notifyCh:
+		assert.Equal(t, local.Name, ev.LinkName)
+		assert.Equal(t, network.LLDPNeighborSpec{
+			LinkName:   local.Name,
+			ChassisID:  peer.HardwareAddr.String(),
+			PortID:     peer.Name,
+			SystemName: "peer",
+			TTL:        time.Second,
+		}, ev.Neighbor)
+	}
+}
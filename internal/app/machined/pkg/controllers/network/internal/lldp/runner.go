@@ -0,0 +1,194 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package lldp
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"net"
+	"sync"
+	"time"
+
+	"github.com/mdlayher/packet"
+	"github.com/siderolabs/gen/channel"
+	"go.uber.org/zap"
+	"golang.org/x/sys/unix"
+
+	"github.com/siderolabs/talos/pkg/machinery/resources/network"
+)
+
+// Runner listens for the LLDP frames on a link and optionally transmits the advertisements.
+type Runner struct {
+	LinkName     string
+	LinkIndex    int
+	HardwareAddr net.HardwareAddr
+
+	// Advertisement is transmitted every TransmitInterval if set.
+	Advertisement    *Advertisement
+	TransmitInterval time.Duration
+
+	cancel context.CancelFunc
+	wg     sync.WaitGroup
+}
+
+// Notification of a neighbor seen on the link.
+type Notification struct {
+	LinkName string
+	Neighbor network.LLDPNeighborSpec
+}
+
+// Start a runner with a given context.
+func (runner *Runner) Start(ctx context.Context, notifyCh chan<- Notification, logger *zap.Logger) error {
+	conn, err := packet.Listen(&net.Interface{
+		Index:        runner.LinkIndex,
+		Name:         runner.LinkName,
+		HardwareAddr: runner.HardwareAddr,
+	}, packet.Datagram, EtherType, nil)
+	if err != nil {
+		return fmt.Errorf("error listening on %q: %w", runner.LinkName, err)
+	}
+
+	if err = joinMulticast(conn, runner.LinkIndex); err != nil {
+		conn.Close() //nolint:errcheck
+
+		return fmt.Errorf("error joining LLDP multicast group on %q: %w", runner.LinkName, err)
+	}
+
+	logger = logger.With(zap.String("link", runner.LinkName))
+
+	ctx, runner.cancel = context.WithCancel(ctx)
+
+	runner.wg.Add(2)
+
+	go func() {
+		defer runner.wg.Done()
+
+		<-ctx.Done()
+
+		if runner.Advertisement != nil {
+			// tell the neighbors to forget about the node
+			shutdown := *runner.Advertisement
+			shutdown.TTL = 0
+
+			runner.transmit(conn, &shutdown, logger)
+		}
+
+		conn.Close() //nolint:errcheck
+	}()
+
+	go func() {
+		defer runner.wg.Done()
+
+		runner.receive(ctx, conn, notifyCh, logger)
+	}()
+
+	if runner.Advertisement != nil {
+		runner.wg.Add(1)
+
+		go func() {
+			defer runner.wg.Done()
+
+			runner.run(ctx, conn, logger)
+		}()
+	}
+
+	return nil
+}
+
+// Stop a runner.
+func (runner *Runner) Stop() {
+	runner.cancel()
+
+	runner.wg.Wait()
+}
+
+func (runner *Runner) receive(ctx context.Context, conn *packet.Conn, notifyCh chan<- Notification, logger *zap.Logger) {
+	buf := make([]byte, 1500)
+
+	for {
+		n, addr, err := conn.ReadFrom(buf)
+		if err != nil {
+			// the connection is closed once the runner is stopped
+			if ctx.Err() == nil {
+				logger.Error("error reading LLDP frame", zap.Error(err))
+			}
+
+			return
+		}
+
+		// skip the frames transmitted by the node itself
+		if packetAddr, ok := addr.(*packet.Addr); ok && bytes.Equal(packetAddr.HardwareAddr, runner.HardwareAddr) {
+			continue
+		}
+
+		neighbor, err := Decode(buf[:n])
+		if err != nil {
+			logger.Debug("error decoding LLDP frame", zap.Stringer("source", addr), zap.Error(err))
+
+			continue
+		}
+
+		neighbor.LinkName = runner.LinkName
+
+		if !channel.SendWithContext(ctx, notifyCh, Notification{LinkName: runner.LinkName, Neighbor: neighbor}) {
+			return
+		}
+	}
+}
+
+func (runner *Runner) run(ctx context.Context, conn *packet.Conn, logger *zap.Logger) {
+	ticker := time.NewTicker(runner.TransmitInterval)
+	defer ticker.Stop()
+
+	for {
+		runner.transmit(conn, runner.Advertisement, logger)
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+func (runner *Runner) transmit(conn *packet.Conn, adv *Advertisement, logger *zap.Logger) {
+	frame, err := Encode(adv)
+	if err != nil {
+		logger.Error("error encoding LLDP frame", zap.Error(err))
+
+		return
+	}
+
+	if _, err = conn.WriteTo(frame, &packet.Addr{HardwareAddr: MulticastAddr}); err != nil {
+		logger.Debug("error sending LLDP frame", zap.Error(err))
+	}
+}
+
+// joinMulticast makes the link accept the frames sent to the LLDP multicast address.
+func joinMulticast(conn *packet.Conn, linkIndex int) error {
+	rawConn, err := conn.SyscallConn()
+	if err != nil {
+		return err
+	}
+
+	mreq := unix.PacketMreq{
+		Ifindex: int32(linkIndex),
+		Type:    unix.PACKET_MR_MULTICAST,
+		Alen:    uint16(len(MulticastAddr)),
+	}
+
+	copy(mreq.Address[:], MulticastAddr)
+
+	var sockErr error
+
+	if err = rawConn.Control(func(fd uintptr) {
+		sockErr = unix.SetsockoptPacketMreq(int(fd), unix.SOL_PACKET, unix.PACKET_ADD_MEMBERSHIP, &mreq)
+	}); err != nil {
+		return err
+	}
+
+	return sockErr
+}
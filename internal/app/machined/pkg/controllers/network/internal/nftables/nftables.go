@@ -0,0 +1,276 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+// Package nftables provides compilation of network.NfTablesRule into nftables expressions.
+package nftables
+
+import (
+	"fmt"
+	"net/netip"
+
+	"github.com/google/nftables"
+	"github.com/google/nftables/binaryutil"
+	"github.com/google/nftables/expr"
+	"golang.org/x/sys/unix"
+
+	"github.com/siderolabs/talos/pkg/machinery/nethelpers"
+	"github.com/siderolabs/talos/pkg/machinery/resources/network"
+)
+
+// Compile compiles the rule into the list of nftables rules (as expressions).
+//
+// Matches which can't be expressed with a single nftables rule without sets
+// (multiple subnets, multiple port ranges) are expanded into multiple nftables rules
+// with the same verdict, so the resulting rules are OR-ed.
+func Compile(rule network.NfTablesRule) ([][]expr.Any, error) {
+	// each rule is built as a list of alternatives, every match multiplies the alternatives
+	rules := [][]expr.Any{nil}
+
+	multiply := func(alternatives [][]expr.Any) {
+		result := make([][]expr.Any, 0, len(rules)*len(alternatives))
+
+		for _, r := range rules {
+			for _, alternative := range alternatives {
+				result = append(result, append(append([]expr.Any(nil), r...), alternative...))
+			}
+		}
+
+		rules = result
+	}
+
+	if rule.MatchIIfName != nil {
+		multiply([][]expr.Any{matchIIfName(rule.MatchIIfName.InterfaceName)})
+	}
+
+	if rule.MatchConntrackState != nil {
+		multiply([][]expr.Any{matchConntrackState(rule.MatchConntrackState.States)})
+	}
+
+	if rule.MatchSourceAddress != nil {
+		alternatives, err := matchSourceAddress(rule.MatchSourceAddress)
+		if err != nil {
+			return nil, err
+		}
+
+		multiply(alternatives)
+	}
+
+	if rule.MatchLayer4 != nil {
+		multiply(matchLayer4(rule.MatchLayer4))
+	}
+
+	if rule.AnonCounter {
+		multiply([][]expr.Any{{&expr.Counter{}}})
+	}
+
+	verdict, err := compileVerdict(rule.Verdict)
+	if err != nil {
+		return nil, err
+	}
+
+	multiply([][]expr.Any{{verdict}})
+
+	return rules, nil
+}
+
+func compileVerdict(verdict nethelpers.NfTablesVerdict) (*expr.Verdict, error) {
+	switch verdict {
+	case nethelpers.VerdictAccept:
+		return &expr.Verdict{Kind: expr.VerdictAccept}, nil
+	case nethelpers.VerdictDrop:
+		return &expr.Verdict{Kind: expr.VerdictDrop}, nil
+	default:
+		return nil, fmt.Errorf("unsupported verdict: %s", verdict)
+	}
+}
+
+// matchIIfName builds: iifname "name".
+func matchIIfName(name string) []expr.Any {
+	return []expr.Any{
+		&expr.Meta{Key: expr.MetaKeyIIFNAME, Register: 1},
+		&expr.Cmp{
+			Op:       expr.CmpOpEq,
+			Register: 1,
+			Data:     ifname(name),
+		},
+	}
+}
+
+// matchConntrackState builds: ct state { states }.
+func matchConntrackState(states []nethelpers.ConntrackState) []expr.Any {
+	var mask uint32
+
+	for _, state := range states {
+		mask |= uint32(state)
+	}
+
+	return []expr.Any{
+		&expr.Ct{Key: expr.CtKeySTATE, Register: 1},
+		&expr.Bitwise{
+			SourceRegister: 1,
+			DestRegister:   1,
+			Len:            4,
+			Mask:           binaryutil.NativeEndian.PutUint32(mask),
+			Xor:            binaryutil.NativeEndian.PutUint32(0),
+		},
+		&expr.Cmp{
+			Op:       expr.CmpOpNeq,
+			Register: 1,
+			Data:     binaryutil.NativeEndian.PutUint32(0),
+		},
+	}
+}
+
+// matchSourceAddress builds: ip[6] saddr include and ip[6] saddr != exclude, for each included subnet.
+func matchSourceAddress(match *network.NfTablesAddressMatch) ([][]expr.Any, error) {
+	includeSubnets := match.IncludeSubnets
+
+	if len(includeSubnets) == 0 {
+		if len(match.ExcludeSubnets) == 0 {
+			return [][]expr.Any{nil}, nil
+		}
+
+		includeSubnets = []netip.Prefix{netip.MustParsePrefix("0.0.0.0/0"), netip.MustParsePrefix("::/0")}
+	}
+
+	alternatives := make([][]expr.Any, 0, len(includeSubnets))
+
+	for _, include := range includeSubnets {
+		if !include.IsValid() {
+			return nil, fmt.Errorf("invalid subnet: %s", include)
+		}
+
+		exprs := append(matchFamily(include.Addr()), matchSourcePrefix(include, expr.CmpOpEq)...)
+
+		for _, exclude := range match.ExcludeSubnets {
+			if !exclude.IsValid() {
+				return nil, fmt.Errorf("invalid subnet: %s", exclude)
+			}
+
+			if exclude.Addr().Is4() != include.Addr().Is4() {
+				continue
+			}
+
+			exprs = append(exprs, matchSourcePrefix(exclude, expr.CmpOpNeq)...)
+		}
+
+		alternatives = append(alternatives, exprs)
+	}
+
+	return alternatives, nil
+}
+
+// matchFamily builds: meta nfproto ipv4|ipv6.
+func matchFamily(addr netip.Addr) []expr.Any {
+	family := nftables.TableFamilyIPv4
+
+	if addr.Is6() {
+		family = nftables.TableFamilyIPv6
+	}
+
+	return []expr.Any{
+		&expr.Meta{Key: expr.MetaKeyNFPROTO, Register: 1},
+		&expr.Cmp{
+			Op:       expr.CmpOpEq,
+			Register: 1,
+			Data:     []byte{byte(family)},
+		},
+	}
+}
+
+// matchSourcePrefix compares the source address masked by the prefix length with the prefix address.
+func matchSourcePrefix(prefix netip.Prefix, op expr.CmpOp) []expr.Any {
+	prefix = prefix.Masked()
+
+	var offset, length uint32
+
+	if prefix.Addr().Is4() {
+		offset, length = 12, 4
+	} else {
+		offset, length = 8, 16
+	}
+
+	mask := make([]byte, length)
+
+	for i := 0; i < prefix.Bits(); i++ {
+		mask[i/8] |= 0x80 >> (i % 8)
+	}
+
+	return []expr.Any{
+		&expr.Payload{
+			DestRegister: 1,
+			Base:         expr.PayloadBaseNetworkHeader,
+			Offset:       offset,
+			Len:          length,
+		},
+		&expr.Bitwise{
+			SourceRegister: 1,
+			DestRegister:   1,
+			Len:            length,
+			Mask:           mask,
+			Xor:            make([]byte, length),
+		},
+		&expr.Cmp{
+			Op:       op,
+			Register: 1,
+			Data:     prefix.Addr().AsSlice(),
+		},
+	}
+}
+
+// matchLayer4 builds: meta l4proto protocol [th dport lo-hi], for each port range.
+func matchLayer4(match *network.NfTablesLayer4Match) [][]expr.Any {
+	protocolMatch := []expr.Any{
+		&expr.Meta{Key: expr.MetaKeyL4PROTO, Register: 1},
+		&expr.Cmp{
+			Op:       expr.CmpOpEq,
+			Register: 1,
+			Data:     []byte{byte(match.Protocol)},
+		},
+	}
+
+	if match.MatchDestinationPort == nil || len(match.MatchDestinationPort.Ranges) == 0 {
+		return [][]expr.Any{protocolMatch}
+	}
+
+	alternatives := make([][]expr.Any, 0, len(match.MatchDestinationPort.Ranges))
+
+	for _, portRange := range match.MatchDestinationPort.Ranges {
+		exprs := append(append([]expr.Any(nil), protocolMatch...),
+			// destination port is at the same offset for TCP and UDP
+			&expr.Payload{
+				DestRegister: 1,
+				Base:         expr.PayloadBaseTransportHeader,
+				Offset:       2,
+				Len:          2,
+			},
+		)
+
+		if portRange.Lo == portRange.Hi {
+			exprs = append(exprs, &expr.Cmp{
+				Op:       expr.CmpOpEq,
+				Register: 1,
+				Data:     binaryutil.BigEndian.PutUint16(portRange.Lo),
+			})
+		} else {
+			exprs = append(exprs, &expr.Range{
+				Op:       expr.CmpOpEq,
+				Register: 1,
+				FromData: binaryutil.BigEndian.PutUint16(portRange.Lo),
+				ToData:   binaryutil.BigEndian.PutUint16(portRange.Hi),
+			})
+		}
+
+		alternatives = append(alternatives, exprs)
+	}
+
+	return alternatives
+}
+
+func ifname(name string) []byte {
+	b := make([]byte, unix.IFNAMSIZ)
+	copy(b, name)
+
+	return b
+}
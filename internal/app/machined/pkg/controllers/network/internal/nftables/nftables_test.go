@@ -0,0 +1,104 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package nftables_test
+
+import (
+	"net/netip"
+	"testing"
+
+	"github.com/google/nftables/expr"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/siderolabs/talos/internal/app/machined/pkg/controllers/network/internal/nftables"
+	"github.com/siderolabs/talos/pkg/machinery/nethelpers"
+	"github.com/siderolabs/talos/pkg/machinery/resources/network"
+)
+
+func TestCompile(t *testing.T) {
+	t.Parallel()
+
+	for _, test := range []struct {
+		name string
+		rule network.NfTablesRule
+
+		expectedRules int
+		expectedLen   []int
+	}{
+		{
+			name: "verdict only",
+			rule: network.NfTablesRule{
+				Verdict: nethelpers.VerdictDrop,
+			},
+
+			expectedRules: 1,
+			expectedLen:   []int{1},
+		},
+		{
+			name: "iifname",
+			rule: network.NfTablesRule{
+				MatchIIfName: &network.NfTablesIfNameMatch{
+					InterfaceName: "lo",
+				},
+				Verdict: nethelpers.VerdictAccept,
+			},
+
+			expectedRules: 1,
+			expectedLen:   []int{3},
+		},
+		{
+			name: "ingress",
+			rule: network.NfTablesRule{
+				MatchSourceAddress: &network.NfTablesAddressMatch{
+					IncludeSubnets: []netip.Prefix{
+						netip.MustParsePrefix("10.0.0.0/8"),
+						netip.MustParsePrefix("2001:db8::/32"),
+					},
+					ExcludeSubnets: []netip.Prefix{
+						netip.MustParsePrefix("10.3.0.0/16"),
+					},
+				},
+				MatchLayer4: &network.NfTablesLayer4Match{
+					Protocol: nethelpers.ProtocolUDP,
+					MatchDestinationPort: &network.NfTablesPortMatch{
+						Ranges: []network.PortRange{
+							{Lo: 53, Hi: 53},
+							{Lo: 5000, Hi: 6000},
+						},
+					},
+				},
+				AnonCounter: true,
+				Verdict:     nethelpers.VerdictAccept,
+			},
+
+			// (10.0.0.0/8 except 10.3.0.0/16, 2001:db8::/32) x (53, 5000-6000)
+			expectedRules: 4,
+			expectedLen:   []int{14, 14, 11, 11},
+		},
+	} {
+		test := test
+
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			rules, err := nftables.Compile(test.rule)
+			require.NoError(t, err)
+
+			require.Len(t, rules, test.expectedRules)
+
+			for i, rule := range rules {
+				assert.Len(t, rule, test.expectedLen[i])
+				assert.IsType(t, &expr.Verdict{}, rule[len(rule)-1])
+			}
+		})
+	}
+}
+
+func TestCompileInvalidVerdict(t *testing.T) {
+	_, err := nftables.Compile(network.NfTablesRule{
+		Verdict: nethelpers.NfTablesVerdict(42),
+	})
+	require.Error(t, err)
+}
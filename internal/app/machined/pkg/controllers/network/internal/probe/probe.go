@@ -7,14 +7,22 @@ package probe
 
 import (
 	"context"
+	"crypto/tls"
 	"fmt"
+	"io"
 	"net"
+	"net/http"
+	"net/netip"
+	"os"
 	"sync"
 	"syscall"
 
 	"github.com/benbjohnson/clock"
 	"github.com/siderolabs/gen/channel"
 	"go.uber.org/zap"
+	"golang.org/x/net/icmp"
+	"golang.org/x/net/ipv4"
+	"golang.org/x/net/ipv6"
 
 	"github.com/siderolabs/talos/pkg/machinery/resources/network"
 )
@@ -27,6 +35,8 @@ type Runner struct {
 
 	cancel context.CancelFunc
 	wg     sync.WaitGroup
+
+	icmpSeq uint16
 }
 
 // Notification of a runner status.
@@ -126,11 +136,22 @@ func (runner *Runner) run(ctx context.Context, notifyCh chan<- Notification, log
 
 // probe runs a probe.
 func (runner *Runner) probe(ctx context.Context) error {
-	var zeroTCP network.TCPProbeSpec
+	var (
+		zeroTCP  network.TCPProbeSpec
+		zeroICMP network.ICMPProbeSpec
+		zeroHTTP network.HTTPProbeSpec
+		zeroDNS  network.DNSProbeSpec
+	)
 
 	switch {
 	case runner.Spec.TCP != zeroTCP:
 		return runner.probeTCP(ctx)
+	case runner.Spec.ICMP != zeroICMP:
+		return runner.probeICMP(ctx)
+	case runner.Spec.HTTP != zeroHTTP:
+		return runner.probeHTTP(ctx)
+	case runner.Spec.DNS != zeroDNS:
+		return runner.probeDNS(ctx)
 	default:
 		return fmt.Errorf("no probe type specified")
 	}
@@ -157,3 +178,167 @@ func (runner *Runner) probeTCP(ctx context.Context) error {
 
 	return conn.Close()
 }
+
+// probeICMP runs an ICMP echo probe.
+//
+//nolint:gocyclo
+func (runner *Runner) probeICMP(ctx context.Context) error {
+	ctx, cancel := context.WithTimeout(ctx, runner.Spec.ICMP.Timeout)
+	defer cancel()
+
+	addr, err := netip.ParseAddr(runner.Spec.ICMP.Host)
+	if err != nil {
+		var addrs []netip.Addr
+
+		addrs, err = net.DefaultResolver.LookupNetIP(ctx, "ip", runner.Spec.ICMP.Host)
+		if err != nil {
+			return err
+		}
+
+		addr = addrs[0]
+	}
+
+	addr = addr.Unmap()
+
+	var (
+		listenNetwork, listenAddr string
+		proto                     int
+		requestType, replyType    icmp.Type
+	)
+
+	if addr.Is4() {
+		listenNetwork, listenAddr, proto = "ip4:icmp", "0.0.0.0", 1
+		requestType, replyType = ipv4.ICMPTypeEcho, ipv4.ICMPTypeEchoReply
+	} else {
+		listenNetwork, listenAddr, proto = "ip6:ipv6-icmp", "::", 58
+		requestType, replyType = ipv6.ICMPTypeEchoRequest, ipv6.ICMPTypeEchoReply
+	}
+
+	conn, err := icmp.ListenPacket(listenNetwork, listenAddr)
+	if err != nil {
+		return err
+	}
+
+	defer conn.Close() //nolint:errcheck
+
+	if deadline, ok := ctx.Deadline(); ok {
+		if err = conn.SetDeadline(deadline); err != nil {
+			return err
+		}
+	}
+
+	runner.icmpSeq++
+
+	echo := &icmp.Echo{
+		ID:   os.Getpid() & 0xffff,
+		Seq:  int(runner.icmpSeq),
+		Data: []byte("talos"),
+	}
+
+	request, err := (&icmp.Message{Type: requestType, Body: echo}).Marshal(nil)
+	if err != nil {
+		return err
+	}
+
+	if _, err = conn.WriteTo(request, &net.IPAddr{IP: addr.AsSlice()}); err != nil {
+		return err
+	}
+
+	buf := make([]byte, 1500)
+
+	for {
+		n, peer, err := conn.ReadFrom(buf)
+		if err != nil {
+			return fmt.Errorf("no echo reply from %s: %w", addr, err)
+		}
+
+		// raw ICMP socket receives all ICMP packets, so skip everything but the reply to our request
+		if peerAddr, ok := peer.(*net.IPAddr); !ok || !peerAddr.IP.Equal(addr.AsSlice()) {
+			continue
+		}
+
+		reply, err := icmp.ParseMessage(proto, buf[:n])
+		if err != nil || reply.Type != replyType {
+			continue
+		}
+
+		if replyEcho, ok := reply.Body.(*icmp.Echo); ok && replyEcho.ID == echo.ID && replyEcho.Seq == echo.Seq {
+			return nil
+		}
+	}
+}
+
+// probeHTTP runs an HTTP(S) GET probe.
+func (runner *Runner) probeHTTP(ctx context.Context) error {
+	ctx, cancel := context.WithTimeout(ctx, runner.Spec.HTTP.Timeout)
+	defer cancel()
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, runner.Spec.HTTP.URL, nil)
+	if err != nil {
+		return err
+	}
+
+	client := &http.Client{
+		Transport: &http.Transport{
+			Proxy:             http.ProxyFromEnvironment,
+			DisableKeepAlives: true,
+			TLSClientConfig: &tls.Config{
+				MinVersion: tls.VersionTLS12,
+			},
+		},
+		// don't follow redirects, so that the status code can be checked as is
+		CheckRedirect: func(*http.Request, []*http.Request) error {
+			return http.ErrUseLastResponse
+		},
+	}
+
+	resp, err := client.Do(req)
+	if err != nil {
+		return err
+	}
+
+	defer resp.Body.Close() //nolint:errcheck
+
+	_, _ = io.Copy(io.Discard, io.LimitReader(resp.Body, 4096)) //nolint:errcheck
+
+	expectedStatus := runner.Spec.HTTP.ExpectedStatus
+	if expectedStatus == 0 {
+		expectedStatus = http.StatusOK
+	}
+
+	if resp.StatusCode != expectedStatus {
+		return fmt.Errorf("unexpected HTTP status %d, expected %d", resp.StatusCode, expectedStatus)
+	}
+
+	return nil
+}
+
+// probeDNS runs a DNS resolution probe.
+func (runner *Runner) probeDNS(ctx context.Context) error {
+	ctx, cancel := context.WithTimeout(ctx, runner.Spec.DNS.Timeout)
+	defer cancel()
+
+	resolver := net.DefaultResolver
+
+	if runner.Spec.DNS.Server != "" {
+		resolver = &net.Resolver{
+			PreferGo: true,
+			Dial: func(ctx context.Context, network, _ string) (net.Conn, error) {
+				var dialer net.Dialer
+
+				return dialer.DialContext(ctx, network, runner.Spec.DNS.Server)
+			},
+		}
+	}
+
+	addrs, err := resolver.LookupHost(ctx, runner.Spec.DNS.Name)
+	if err != nil {
+		return err
+	}
+
+	if len(addrs) == 0 {
+		return fmt.Errorf("no addresses resolved for %q", runner.Spec.DNS.Name)
+	}
+
+	return nil
+}
@@ -9,6 +9,7 @@ import (
 	"net/http"
 	"net/http/httptest"
 	"net/url"
+	"os"
 	"testing"
 	"time"
 
@@ -150,3 +151,123 @@ func TestProbeConsecutiveFailures(t *testing.T) {
 	assert.Equal(t, "consecutive-failures", notify.ID)
 	assert.False(t, notify.Status.Success)
 }
+
+func TestProbeHTTPStatus(t *testing.T) {
+	t.Parallel()
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/healthz" {
+			w.WriteHeader(http.StatusNoContent)
+
+			return
+		}
+
+		w.WriteHeader(http.StatusServiceUnavailable)
+	}))
+	t.Cleanup(server.Close)
+
+	for _, test := range []struct {
+		name           string
+		path           string
+		expectedStatus int
+		expectedError  string
+	}{
+		{
+			name:           "expected status",
+			path:           "/healthz",
+			expectedStatus: http.StatusNoContent,
+		},
+		{
+			name:          "default status",
+			path:          "/healthz",
+			expectedError: "unexpected HTTP status 204, expected 200",
+		},
+		{
+			name:           "unexpected status",
+			path:           "/",
+			expectedStatus: http.StatusNoContent,
+			expectedError:  "unexpected HTTP status 503, expected 204",
+		},
+	} {
+		test := test
+
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			notification := runOnce(t, network.ProbeSpecSpec{
+				Interval: 10 * time.Millisecond,
+				HTTP: network.HTTPProbeSpec{
+					URL:            server.URL + test.path,
+					ExpectedStatus: test.expectedStatus,
+					Timeout:        time.Second,
+				},
+			})
+
+			if test.expectedError == "" {
+				assert.True(t, notification.Status.Success)
+			} else {
+				assert.False(t, notification.Status.Success)
+				assert.Equal(t, test.expectedError, notification.Status.LastError)
+			}
+		})
+	}
+}
+
+func TestProbeDNS(t *testing.T) {
+	t.Parallel()
+
+	notification := runOnce(t, network.ProbeSpecSpec{
+		Interval: 10 * time.Millisecond,
+		DNS: network.DNSProbeSpec{
+			Name:    "localhost",
+			Timeout: time.Second,
+		},
+	})
+
+	assert.True(t, notification.Status.Success, notification.Status.LastError)
+}
+
+func TestProbeICMP(t *testing.T) {
+	t.Parallel()
+
+	if os.Geteuid() != 0 {
+		t.Skip("requires root")
+	}
+
+	notification := runOnce(t, network.ProbeSpecSpec{
+		Interval: 10 * time.Millisecond,
+		ICMP: network.ICMPProbeSpec{
+			Host:    "127.0.0.1",
+			Timeout: time.Second,
+		},
+	})
+
+	assert.True(t, notification.Status.Success, notification.Status.LastError)
+}
+
+// runOnce runs the probe until the first notification.
+func runOnce(t *testing.T, spec network.ProbeSpecSpec) probe.Notification {
+	t.Helper()
+
+	p := probe.Runner{
+		ID:   t.Name(),
+		Spec: spec,
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 3*time.Second)
+	t.Cleanup(cancel)
+
+	notifyCh := make(chan probe.Notification)
+
+	p.Start(ctx, notifyCh, zaptest.NewLogger(t))
+	t.Cleanup(p.Stop)
+
+	select {
+	case notification := <-notifyCh:
+		return notification
+	case <-ctx.Done():
+		require.FailNow(t, "timed out waiting for probe notification")
+
+		return probe.Notification{}
+	}
+}
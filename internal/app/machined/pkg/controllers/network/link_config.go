@@ -175,6 +175,12 @@ func (ctrl *LinkConfigController) Run(ctx context.Context, r controller.Runtime,
 						configuredLinks[link] = struct{}{}
 					}
 				}
+
+				if device.VRF() != nil {
+					for _, link := range device.VRF().Interfaces() {
+						configuredLinks[link] = struct{}{}
+					}
+				}
 			}
 		}
 
@@ -277,12 +283,23 @@ func (ctrl *LinkConfigController) processDevicesConfiguration(logger *zap.Logger
 	// scan for the bonds or bridges
 	bondedLinks := map[string]ordered.Pair[string, int]{} // mapping physical interface -> bond interface
 	bridgedLinks := map[string]string{}                   // mapping physical interface -> bridge interface
+	vrfLinks := map[string]string{}                       // mapping interface -> VRF interface
 
 	for _, device := range devices {
 		if device.Ignore() {
 			continue
 		}
 
+		if device.VRF() != nil {
+			for _, linkName := range device.VRF().Interfaces() {
+				if iface, exists := vrfLinks[linkName]; exists && iface != device.Interface() {
+					logger.Sugar().Warnf("link %q is included into more than two VRFs", linkName)
+				}
+
+				vrfLinks[linkName] = device.Interface()
+			}
+		}
+
 		if device.Bond() == nil && device.Bridge() == nil {
 			continue
 		}
@@ -357,6 +374,28 @@ func (ctrl *LinkConfigController) processDevicesConfiguration(logger *zap.Logger
 			dummyLink(linkMap[device.Interface()])
 		}
 
+		if device.VRF() != nil {
+			vrfLink(linkMap[device.Interface()], device.VRF())
+		}
+
+		if device.VXLAN() != nil {
+			if err := vxlanLink(linkMap[device.Interface()], device.VXLAN()); err != nil {
+				logger.Error("error parsing vxlan config", zap.Error(err))
+			}
+		}
+
+		if device.MACVLAN() != nil {
+			if err := macvlanLink(linkMap[device.Interface()], device.MACVLAN()); err != nil {
+				logger.Error("error parsing macvlan config", zap.Error(err))
+			}
+		}
+
+		if device.IPVLAN() != nil {
+			if err := ipvlanLink(linkMap[device.Interface()], device.IPVLAN()); err != nil {
+				logger.Error("error parsing ipvlan config", zap.Error(err))
+			}
+		}
+
 		for _, vlan := range device.Vlans() {
 			vlanName := fmt.Sprintf("%s.%d", device.Interface(), vlan.ID())
 
@@ -394,6 +433,20 @@ func (ctrl *LinkConfigController) processDevicesConfiguration(logger *zap.Logger
 		SetBridgeSlave(linkMap[slaveName], bridgeIface)
 	}
 
+	for slaveName, vrfIface := range vrfLinks {
+		if _, exists := linkMap[slaveName]; !exists {
+			linkMap[slaveName] = &network.LinkSpecSpec{
+				Name:        slaveName,
+				Up:          true,
+				ConfigLayer: network.ConfigMachineConfiguration,
+			}
+		}
+
+		linkMap[slaveName].VRFSlave = network.VRFSlave{
+			MasterName: vrfIface,
+		}
+	}
+
 	return maps.ValuesFunc(linkMap, func(link *network.LinkSpecSpec) network.LinkSpecSpec { return *link })
 }
 
@@ -454,3 +507,72 @@ func dummyLink(link *network.LinkSpecSpec) {
 	link.Kind = "dummy"
 	link.Type = nethelpers.LinkEther
 }
+
+func vrfLink(link *network.LinkSpecSpec, vrf talosconfig.VRF) {
+	link.Logical = true
+	link.Kind = network.LinkKindVRF
+	link.Type = nethelpers.LinkEther
+	link.VRFMaster = network.VRFMasterSpec{
+		Table: nethelpers.RoutingTable(vrf.Table()),
+	}
+}
+
+func vxlanLink(link *network.LinkSpecSpec, vxlan talosconfig.VXLAN) error {
+	link.Logical = true
+	link.Kind = network.LinkKindVXLAN
+	link.Type = nethelpers.LinkEther
+	link.VXLAN = network.VXLANSpec{
+		VNI:     vxlan.ID(),
+		DstPort: vxlan.Port(),
+	}
+
+	var err error
+
+	if vxlan.Local() != "" {
+		if link.VXLAN.Local, err = netip.ParseAddr(vxlan.Local()); err != nil {
+			return err
+		}
+	}
+
+	if vxlan.Remote() != "" {
+		if link.VXLAN.Remote, err = netip.ParseAddr(vxlan.Remote()); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func macvlanLink(link *network.LinkSpecSpec, macvlan talosconfig.MACVLAN) error {
+	mode, err := nethelpers.MACVLANModeByName(macvlan.Mode())
+	if err != nil {
+		return err
+	}
+
+	link.Logical = true
+	link.Kind = network.LinkKindMACVLAN
+	link.Type = nethelpers.LinkEther
+	link.ParentName = macvlan.Parent()
+	link.MACVLAN = network.MACVLANSpec{
+		Mode: mode,
+	}
+
+	return nil
+}
+
+func ipvlanLink(link *network.LinkSpecSpec, ipvlan talosconfig.IPVLAN) error {
+	mode, err := nethelpers.IPVLANModeByName(ipvlan.Mode())
+	if err != nil {
+		return err
+	}
+
+	link.Logical = true
+	link.Kind = network.LinkKindIPVLAN
+	link.Type = nethelpers.LinkEther
+	link.ParentName = ipvlan.Parent()
+	link.IPVLAN = network.IPVLANSpec{
+		Mode: mode,
+	}
+
+	return nil
+}
@@ -236,6 +236,35 @@ func (suite *LinkConfigSuite) TestMachineConfiguration() {
 								DeviceInterface: "dummy0",
 								DeviceDummy:     pointer.To(true),
 							},
+							{
+								DeviceInterface: "vrf0",
+								DeviceVRF: &v1alpha1.VRF{
+									VRFInterfaces: []string{"eth8"},
+									VRFTable:      10,
+								},
+							},
+							{
+								DeviceInterface: "vxlan0",
+								DeviceVXLAN: &v1alpha1.VXLAN{
+									VXLANID:     100,
+									VXLANLocal:  "10.0.0.1",
+									VXLANRemote: "239.1.1.1",
+									VXLANPort:   4789,
+								},
+							},
+							{
+								DeviceInterface: "macvlan0",
+								DeviceMACVLAN: &v1alpha1.MACVLAN{
+									MACVLANParent: "eth1",
+									MACVLANMode:   "private",
+								},
+							},
+							{
+								DeviceInterface: "ipvlan0",
+								DeviceIPVLAN: &v1alpha1.IPVLAN{
+									IPVLANParent: "eth1",
+								},
+							},
 							{
 								DeviceInterface: "wireguard0",
 								DeviceWireguardConfig: &v1alpha1.DeviceWireguardConfig{
@@ -290,6 +319,11 @@ func (suite *LinkConfigSuite) TestMachineConfiguration() {
 			"configuration/br0",
 			"configuration/dummy0",
 			"configuration/wireguard0",
+			"configuration/vrf0",
+			"configuration/eth8",
+			"configuration/vxlan0",
+			"configuration/macvlan0",
+			"configuration/ipvlan0",
 		}, func(r *network.LinkSpec, asrt *assert.Assertions) {
 			asrt.Equal(network.ConfigMachineConfiguration, r.TypedSpec().ConfigLayer)
 
@@ -350,6 +384,35 @@ func (suite *LinkConfigSuite) TestMachineConfiguration() {
 				asrt.Equal(nethelpers.LinkEther, r.TypedSpec().Type)
 				asrt.Equal(network.LinkKindBridge, r.TypedSpec().Kind)
 				asrt.Equal(true, r.TypedSpec().BridgeMaster.STP.Enabled)
+			case "vrf0":
+				asrt.True(r.TypedSpec().Logical)
+				asrt.Equal(network.LinkKindVRF, r.TypedSpec().Kind)
+				asrt.Equal(nethelpers.RoutingTable(10), r.TypedSpec().VRFMaster.Table)
+			case "eth8":
+				asrt.True(r.TypedSpec().Up)
+				asrt.False(r.TypedSpec().Logical)
+				asrt.Equal("vrf0", r.TypedSpec().VRFSlave.MasterName)
+			case "vxlan0":
+				asrt.True(r.TypedSpec().Logical)
+				asrt.Equal(network.LinkKindVXLAN, r.TypedSpec().Kind)
+				asrt.Equal(
+					network.VXLANSpec{
+						VNI:     100,
+						Local:   netip.MustParseAddr("10.0.0.1"),
+						Remote:  netip.MustParseAddr("239.1.1.1"),
+						DstPort: 4789,
+					}, r.TypedSpec().VXLAN,
+				)
+			case "macvlan0":
+				asrt.True(r.TypedSpec().Logical)
+				asrt.Equal(network.LinkKindMACVLAN, r.TypedSpec().Kind)
+				asrt.Equal("eth1", r.TypedSpec().ParentName)
+				asrt.Equal(nethelpers.MACVLANModePrivate, r.TypedSpec().MACVLAN.Mode)
+			case "ipvlan0":
+				asrt.True(r.TypedSpec().Logical)
+				asrt.Equal(network.LinkKindIPVLAN, r.TypedSpec().Kind)
+				asrt.Equal("eth1", r.TypedSpec().ParentName)
+				asrt.Equal(nethelpers.IPVLANModeL2, r.TypedSpec().IPVLAN.Mode)
 			case "wireguard0":
 				asrt.True(r.TypedSpec().Up)
 				asrt.True(r.TypedSpec().Logical)
@@ -183,11 +183,11 @@ func findLink(links []rtnetlink.LinkMessage, name string) *rtnetlink.LinkMessage
 //
 // If the logical link kind or type got changed (for example, "link0" was a bond, and now it's wireguard interface), the link
 // is dropped and replaced with the new one.
-// Same replace flow is used for VLAN links, as VLAN settings can't be changed on the fly.
+// Same replace flow is used for VLAN, VRF, VXLAN, MACVLAN and IPVLAN links, as their settings can't be changed on the fly.
 //
 // For bonded links, there are two sync steps applied:
 //
-//   - bond slave interfaces are enslaved to be part of the bond (by changing MasterIndex)
+//   - bond slave interfaces are enslaved to be part of the bond (by changing MasterIndex), same applies to bridge and VRF slaves
 //   - bond master link settings are synced with the spec: some settings can't be applied on UP bond and a bond which has slaves,
 //     so slaves are removed and bond is brought down (these settings are going to be reconciled back in the next sync cycle)
 //
@@ -275,6 +275,20 @@ func (ctrl *LinkSpecController) syncLink(ctx context.Context, r controller.Runti
 				}
 			}
 
+			// sync VRF, VXLAN, MACVLAN and IPVLAN specs, as they can't be modified on the fly either
+			if !replace {
+				changed, err := logicalLinkSettingsChanged(existing, link.TypedSpec())
+				if err != nil {
+					return fmt.Errorf("error decoding %s properties on %q: %w", link.TypedSpec().Kind, link.TypedSpec().Name, err)
+				}
+
+				if changed {
+					logger.Info("replacing link with changed settings", zap.String("kind", link.TypedSpec().Kind))
+
+					replace = true
+				}
+			}
+
 			if replace {
 				if err := conn.Link.Delete(existing.Index); err != nil {
 					return fmt.Errorf("error deleting link %q: %w", link.TypedSpec().Name, err)
@@ -310,11 +324,32 @@ func (ctrl *LinkSpecController) syncLink(ctx context.Context, r controller.Runti
 				parentIndex = parent.Index
 			}
 
-			if link.TypedSpec().Kind == network.LinkKindVLAN {
+			switch link.TypedSpec().Kind {
+			case network.LinkKindVLAN:
 				data, err = networkadapter.VLANSpec(&link.TypedSpec().VLAN).Encode()
 				if err != nil {
 					return fmt.Errorf("error encoding VLAN attributes for link %q: %w", link.TypedSpec().Name, err)
 				}
+			case network.LinkKindVRF:
+				data, err = networkadapter.VRFMasterSpec(&link.TypedSpec().VRFMaster).Encode()
+				if err != nil {
+					return fmt.Errorf("error encoding VRF attributes for link %q: %w", link.TypedSpec().Name, err)
+				}
+			case network.LinkKindVXLAN:
+				data, err = networkadapter.VXLANSpec(&link.TypedSpec().VXLAN).Encode()
+				if err != nil {
+					return fmt.Errorf("error encoding VXLAN attributes for link %q: %w", link.TypedSpec().Name, err)
+				}
+			case network.LinkKindMACVLAN:
+				data, err = networkadapter.MACVLANSpec(&link.TypedSpec().MACVLAN).Encode()
+				if err != nil {
+					return fmt.Errorf("error encoding MACVLAN attributes for link %q: %w", link.TypedSpec().Name, err)
+				}
+			case network.LinkKindIPVLAN:
+				data, err = networkadapter.IPVLANSpec(&link.TypedSpec().IPVLAN).Encode()
+				if err != nil {
+					return fmt.Errorf("error encoding IPVLAN attributes for link %q: %w", link.TypedSpec().Name, err)
+				}
 			}
 
 			if err = conn.Link.New(&rtnetlink.LinkMessage{
@@ -582,6 +617,14 @@ func (ctrl *LinkSpecController) syncLink(ctx context.Context, r controller.Runti
 			}
 		}
 
+		vrfMasterName := link.TypedSpec().VRFSlave.MasterName
+		if vrfMasterName != "" {
+			if master := findLink(*links, vrfMasterName); master != nil {
+				masterName = vrfMasterName
+				masterIndex = master.Index
+			}
+		}
+
 		if (existing.Attributes.Master == nil && masterIndex != 0) || (existing.Attributes.Master != nil && *existing.Attributes.Master != masterIndex) {
 			if err := conn.Link.Set(&rtnetlink.LinkMessage{
 				Family: existing.Family,
@@ -611,3 +654,48 @@ func (ctrl *LinkSpecController) syncLink(ctx context.Context, r controller.Runti
 
 	return nil
 }
+
+// logicalLinkSettingsChanged checks if the settings of the existing logical link (which can't be modified on the fly) differ from the spec.
+func logicalLinkSettingsChanged(existing *rtnetlink.LinkMessage, spec *network.LinkSpecSpec) (bool, error) {
+	switch spec.Kind {
+	case network.LinkKindVRF:
+		var existingVRF network.VRFMasterSpec
+
+		if err := networkadapter.VRFMasterSpec(&existingVRF).Decode(existing.Attributes.Info.Data); err != nil {
+			return false, err
+		}
+
+		return existingVRF != spec.VRFMaster, nil
+	case network.LinkKindVXLAN:
+		var existingVXLAN network.VXLANSpec
+
+		if err := networkadapter.VXLANSpec(&existingVXLAN).Decode(existing.Attributes.Info.Data); err != nil {
+			return false, err
+		}
+
+		// zero port in the spec means the kernel default port
+		if spec.VXLAN.DstPort == 0 {
+			existingVXLAN.DstPort = 0
+		}
+
+		return existingVXLAN != spec.VXLAN, nil
+	case network.LinkKindMACVLAN:
+		var existingMACVLAN network.MACVLANSpec
+
+		if err := networkadapter.MACVLANSpec(&existingMACVLAN).Decode(existing.Attributes.Info.Data); err != nil {
+			return false, err
+		}
+
+		return existingMACVLAN != spec.MACVLAN, nil
+	case network.LinkKindIPVLAN:
+		var existingIPVLAN network.IPVLANSpec
+
+		if err := networkadapter.IPVLANSpec(&existingIPVLAN).Decode(existing.Attributes.Info.Data); err != nil {
+			return false, err
+		}
+
+		return existingIPVLAN != spec.IPVLAN, nil
+	default:
+		return false, nil
+	}
+}
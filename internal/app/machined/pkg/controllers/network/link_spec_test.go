@@ -892,6 +892,100 @@ func (suite *LinkSpecSuite) TestWireguard() {
 	)
 }
 
+func (suite *LinkSpecSuite) TestVRF() {
+	vrfName := suite.uniqueDummyInterface()
+	dummyInterface := suite.uniqueDummyInterface()
+
+	vrf := network.NewLinkSpec(network.NamespaceName, vrfName)
+	*vrf.TypedSpec() = network.LinkSpecSpec{
+		Name:    vrfName,
+		Type:    nethelpers.LinkEther,
+		Kind:    network.LinkKindVRF,
+		Up:      true,
+		Logical: true,
+		VRFMaster: network.VRFMasterSpec{
+			Table: 100,
+		},
+		ConfigLayer: network.ConfigDefault,
+	}
+
+	dummy := network.NewLinkSpec(network.NamespaceName, dummyInterface)
+	*dummy.TypedSpec() = network.LinkSpecSpec{
+		Name:    dummyInterface,
+		Type:    nethelpers.LinkEther,
+		Kind:    "dummy",
+		Up:      true,
+		Logical: true,
+		VRFSlave: network.VRFSlave{
+			MasterName: vrfName,
+		},
+		ConfigLayer: network.ConfigDefault,
+	}
+
+	for _, res := range []resource.Resource{vrf, dummy} {
+		suite.Require().NoError(suite.state.Create(suite.ctx, res), "%v", res.Spec())
+	}
+
+	var vrfIndex uint32
+
+	suite.Assert().NoError(
+		retry.Constant(3*time.Second, retry.WithUnits(100*time.Millisecond)).Retry(
+			func() error {
+				return suite.assertInterfaces(
+					[]string{vrfName}, func(r *network.LinkStatus) error {
+						suite.Assert().Equal(network.LinkKindVRF, r.TypedSpec().Kind)
+						suite.Assert().Equal(nethelpers.RoutingTable(100), r.TypedSpec().VRFMaster.Table)
+
+						vrfIndex = r.TypedSpec().Index
+
+						return nil
+					},
+				)
+			},
+		),
+	)
+
+	suite.Assert().NoError(
+		retry.Constant(3*time.Second, retry.WithUnits(100*time.Millisecond)).Retry(
+			func() error {
+				return suite.assertInterfaces(
+					[]string{dummyInterface}, func(r *network.LinkStatus) error {
+						if r.TypedSpec().MasterIndex != vrfIndex {
+							return retry.ExpectedErrorf("unexpected master index %d", r.TypedSpec().MasterIndex)
+						}
+
+						return nil
+					},
+				)
+			},
+		),
+	)
+
+	// teardown the links
+	for _, r := range []resource.Resource{dummy, vrf} {
+		for {
+			ready, err := suite.state.Teardown(suite.ctx, r.Metadata())
+			suite.Require().NoError(err)
+
+			if ready {
+				break
+			}
+
+			time.Sleep(100 * time.Millisecond)
+		}
+	}
+
+	for _, name := range []string{vrfName, dummyInterface} {
+		suite.Assert().NoError(
+			retry.Constant(3*time.Second, retry.WithUnits(100*time.Millisecond)).Retry(
+				func() error {
+					return suite.assertNoInterface(name)
+				},
+			),
+		)
+	}
+}
+
 func (suite *LinkSpecSuite) TearDownTest() {
 	suite.T().Log("tear down")
 
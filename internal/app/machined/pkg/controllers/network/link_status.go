@@ -312,6 +312,22 @@ func (ctrl *LinkStatusController) reconcile(
 				if err = networkadapter.BridgeMasterSpec(&status.BridgeMaster).Decode(link.Attributes.Info.Data); err != nil {
 					logger.Warn("failure decoding bridge attributes", zap.Error(err), zap.String("link", link.Attributes.Name))
 				}
+			case network.LinkKindVRF:
+				if err = networkadapter.VRFMasterSpec(&status.VRFMaster).Decode(link.Attributes.Info.Data); err != nil {
+					logger.Warn("failure decoding VRF attributes", zap.Error(err), zap.String("link", link.Attributes.Name))
+				}
+			case network.LinkKindVXLAN:
+				if err = networkadapter.VXLANSpec(&status.VXLAN).Decode(link.Attributes.Info.Data); err != nil {
+					logger.Warn("failure decoding VXLAN attributes", zap.Error(err), zap.String("link", link.Attributes.Name))
+				}
+			case network.LinkKindMACVLAN:
+				if err = networkadapter.MACVLANSpec(&status.MACVLAN).Decode(link.Attributes.Info.Data); err != nil {
+					logger.Warn("failure decoding MACVLAN attributes", zap.Error(err), zap.String("link", link.Attributes.Name))
+				}
+			case network.LinkKindIPVLAN:
+				if err = networkadapter.IPVLANSpec(&status.IPVLAN).Decode(link.Attributes.Info.Data); err != nil {
+					logger.Warn("failure decoding IPVLAN attributes", zap.Error(err), zap.String("link", link.Attributes.Name))
+				}
 			case network.LinkKindWireguard:
 				if wgClient == nil {
 					return fmt.Errorf("wireguard client not available, but wireguard interface was discovered: %q", link.Attributes.Name)
@@ -0,0 +1,293 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package network
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"time"
+
+	"github.com/cosi-project/runtime/pkg/controller"
+	"github.com/cosi-project/runtime/pkg/resource"
+	"github.com/cosi-project/runtime/pkg/safe"
+	"github.com/cosi-project/runtime/pkg/state"
+	"github.com/siderolabs/go-pointer"
+	"go.uber.org/zap"
+
+	"github.com/siderolabs/talos/internal/app/machined/pkg/controllers/network/internal/lldp"
+	"github.com/siderolabs/talos/pkg/machinery/resources/config"
+	"github.com/siderolabs/talos/pkg/machinery/resources/network"
+	"github.com/siderolabs/talos/pkg/version"
+)
+
+// LLDPController listens for LLDP frames on the physical links and outputs LLDPNeighbors.
+//
+// If enabled in the machine configuration, the controller also transmits LLDP advertisements.
+type LLDPController struct {
+	runners   map[string]*lldp.Runner
+	neighbors map[resource.ID]lldpNeighbor
+}
+
+type lldpNeighbor struct {
+	linkName string
+	expires  time.Time
+}
+
+// lldpExpireInterval is the interval to check for the expired neighbors.
+const lldpExpireInterval = 5 * time.Second
+
+// Name implements controller.Controller interface.
+func (ctrl *LLDPController) Name() string {
+	return "network.LLDPController"
+}
+
+// Inputs implements controller.Controller interface.
+func (ctrl *LLDPController) Inputs() []controller.Input {
+	return []controller.Input{
+		{
+			Namespace: network.NamespaceName,
+			Type:      network.LinkStatusType,
+			Kind:      controller.InputWeak,
+		},
+		{
+			Namespace: network.NamespaceName,
+			Type:      network.HostnameStatusType,
+			ID:        pointer.To(network.HostnameID),
+			Kind:      controller.InputWeak,
+		},
+		{
+			Namespace: config.NamespaceName,
+			Type:      config.MachineConfigType,
+			ID:        pointer.To(config.V1Alpha1ID),
+			Kind:      controller.InputWeak,
+		},
+	}
+}
+
+// Outputs implements controller.Controller interface.
+func (ctrl *LLDPController) Outputs() []controller.Output {
+	return []controller.Output{
+		{
+			Type: network.LLDPNeighborType,
+			Kind: controller.OutputExclusive,
+		},
+	}
+}
+
+// Run implements controller.Controller interface.
+func (ctrl *LLDPController) Run(ctx context.Context, r controller.Runtime, logger *zap.Logger) error {
+	notifyCh := make(chan lldp.Notification)
+
+	ctrl.runners = make(map[string]*lldp.Runner)
+	ctrl.neighbors = make(map[resource.ID]lldpNeighbor)
+
+	defer func() {
+		for _, runner := range ctrl.runners {
+			runner.Stop()
+		}
+	}()
+
+	ticker := time.NewTicker(lldpExpireInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-r.EventCh():
+			if err := ctrl.reconcileRunners(ctx, r, logger, notifyCh); err != nil {
+				return err
+			}
+		case ev := <-notifyCh:
+			if err := ctrl.reconcileNeighbor(ctx, r, ev); err != nil {
+				return err
+			}
+		case <-ticker.C:
+		}
+
+		if err := ctrl.cleanupNeighbors(ctx, r); err != nil {
+			return err
+		}
+
+		r.ResetRestartBackoff()
+	}
+}
+
+//nolint:gocyclo,cyclop
+func (ctrl *LLDPController) reconcileRunners(ctx context.Context, r controller.Runtime, logger *zap.Logger, notifyCh chan<- lldp.Notification) error {
+	var (
+		transmit         bool
+		transmitInterval time.Duration
+	)
+
+	cfg, err := safe.ReaderGetByID[*config.MachineConfig](ctx, r, config.V1Alpha1ID)
+	if err != nil {
+		if !state.IsNotFoundError(err) {
+			return fmt.Errorf("error getting config: %w", err)
+		}
+	} else if cfg.Config().Machine() != nil {
+		lldpConfig := cfg.Config().Machine().Network().LLDP()
+
+		transmit = lldpConfig.Transmit()
+		transmitInterval = lldpConfig.TransmitInterval()
+	}
+
+	var systemName string
+
+	hostnameStatus, err := safe.ReaderGetByID[*network.HostnameStatus](ctx, r, network.HostnameID)
+	if err != nil {
+		if !state.IsNotFoundError(err) {
+			return fmt.Errorf("error getting hostname status: %w", err)
+		}
+	} else {
+		systemName = hostnameStatus.TypedSpec().FQDN()
+	}
+
+	linkList, err := safe.ReaderListAll[*network.LinkStatus](ctx, r)
+	if err != nil {
+		return fmt.Errorf("error listing links: %w", err)
+	}
+
+	// figure out which runners should run
+	shouldRun := make(map[string]*lldp.Runner)
+
+	var chassisID net.HardwareAddr
+
+	for iter := safe.IteratorFromList(linkList); iter.Next(); {
+		link := iter.Value()
+
+		if !link.TypedSpec().Physical() {
+			continue
+		}
+
+		// as link statuses are listed in sorted order, first physical link identifies the chassis
+		if chassisID == nil {
+			chassisID = net.HardwareAddr(link.TypedSpec().HardwareAddr)
+		}
+
+		runner := &lldp.Runner{
+			LinkName:         link.Metadata().ID(),
+			LinkIndex:        int(link.TypedSpec().Index),
+			HardwareAddr:     net.HardwareAddr(link.TypedSpec().HardwareAddr),
+			TransmitInterval: transmitInterval,
+		}
+
+		if transmit {
+			runner.Advertisement = &lldp.Advertisement{
+				ChassisID:         chassisID,
+				PortID:            link.Metadata().ID(),
+				SystemName:        systemName,
+				SystemDescription: fmt.Sprintf("%s (%s)", version.Name, version.Tag),
+				TTL:               4 * transmitInterval,
+			}
+		}
+
+		shouldRun[runner.LinkName] = runner
+	}
+
+	// stop runners which shouldn't run
+	for linkName, runner := range ctrl.runners {
+		if newRunner, exists := shouldRun[linkName]; !exists {
+			logger.Debug("stopping LLDP", zap.String("link", linkName))
+
+			runner.Stop()
+			delete(ctrl.runners, linkName)
+
+			ctrl.forgetLinkNeighbors(linkName)
+		} else if newRunner.LinkIndex != runner.LinkIndex ||
+			newRunner.HardwareAddr.String() != runner.HardwareAddr.String() ||
+			newRunner.TransmitInterval != runner.TransmitInterval ||
+			!newRunner.Advertisement.Equal(runner.Advertisement) {
+			logger.Debug("replacing LLDP", zap.String("link", linkName))
+
+			runner.Stop()
+			delete(ctrl.runners, linkName)
+		}
+	}
+
+	// start runners which aren't running
+	for linkName, runner := range shouldRun {
+		if _, exists := ctrl.runners[linkName]; exists {
+			continue
+		}
+
+		logger.Debug("starting LLDP", zap.String("link", linkName), zap.Bool("transmit", runner.Advertisement != nil))
+
+		if err = runner.Start(ctx, notifyCh, logger); err != nil {
+			logger.Warn("failed to start LLDP", zap.String("link", linkName), zap.Error(err))
+
+			continue
+		}
+
+		ctrl.runners[linkName] = runner
+	}
+
+	return nil
+}
+
+func (ctrl *LLDPController) reconcileNeighbor(ctx context.Context, r controller.Runtime, ev lldp.Notification) error {
+	if _, exists := ctrl.runners[ev.LinkName]; !exists {
+		// runner was already stopped, late notification, ignore it
+		return nil
+	}
+
+	id := network.LLDPNeighborID(ev.LinkName, ev.Neighbor.ChassisID, ev.Neighbor.PortID)
+
+	// zero TTL means the neighbor is shutting down
+	if ev.Neighbor.TTL == 0 {
+		delete(ctrl.neighbors, id)
+
+		return nil
+	}
+
+	ctrl.neighbors[id] = lldpNeighbor{
+		linkName: ev.LinkName,
+		expires:  time.Now().Add(ev.Neighbor.TTL),
+	}
+
+	return safe.WriterModify(ctx, r, network.NewLLDPNeighbor(network.NamespaceName, id),
+		func(neighbor *network.LLDPNeighbor) error {
+			*neighbor.TypedSpec() = ev.Neighbor
+
+			return nil
+		})
+}
+
+func (ctrl *LLDPController) forgetLinkNeighbors(linkName string) {
+	for id, neighbor := range ctrl.neighbors {
+		if neighbor.linkName == linkName {
+			delete(ctrl.neighbors, id)
+		}
+	}
+}
+
+// cleanupNeighbors removes the neighbors which expired or are no longer tracked.
+func (ctrl *LLDPController) cleanupNeighbors(ctx context.Context, r controller.Runtime) error {
+	now := time.Now()
+
+	for id, neighbor := range ctrl.neighbors {
+		if now.After(neighbor.expires) {
+			delete(ctrl.neighbors, id)
+		}
+	}
+
+	list, err := safe.ReaderListAll[*network.LLDPNeighbor](ctx, r)
+	if err != nil {
+		return fmt.Errorf("error listing LLDP neighbors: %w", err)
+	}
+
+	for iter := safe.IteratorFromList(list); iter.Next(); {
+		if _, exists := ctrl.neighbors[iter.Value().Metadata().ID()]; exists {
+			continue
+		}
+
+		if err = r.Destroy(ctx, iter.Value().Metadata()); err != nil && !state.IsNotFoundError(err) {
+			return fmt.Errorf("error destroying LLDP neighbor: %w", err)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,155 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package network_test
+
+import (
+	"net"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/cosi-project/runtime/pkg/resource"
+	"github.com/cosi-project/runtime/pkg/resource/rtestutils"
+	"github.com/jsimonetti/rtnetlink"
+	"github.com/mdlayher/netlink"
+	"github.com/siderolabs/go-pointer"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+	"golang.org/x/sys/unix"
+
+	"github.com/siderolabs/talos/internal/app/machined/pkg/controllers/ctest"
+	netctrl "github.com/siderolabs/talos/internal/app/machined/pkg/controllers/network"
+	"github.com/siderolabs/talos/pkg/machinery/config/container"
+	"github.com/siderolabs/talos/pkg/machinery/config/types/v1alpha1"
+	"github.com/siderolabs/talos/pkg/machinery/nethelpers"
+	"github.com/siderolabs/talos/pkg/machinery/resources/config"
+	"github.com/siderolabs/talos/pkg/machinery/resources/network"
+)
+
+type LLDPSuite struct {
+	ctest.DefaultSuite
+}
+
+// vethInfoPeer is VETH_INFO_PEER from linux/veth.h.
+const vethInfoPeer = 1
+
+func (suite *LLDPSuite) TestNeighbors() {
+	if os.Geteuid() != 0 {
+		suite.T().Skip("requires root")
+	}
+
+	conn, err := rtnetlink.Dial(nil)
+	suite.Require().NoError(err)
+
+	defer conn.Close() //nolint:errcheck
+
+	peerMessage, err := (&rtnetlink.LinkMessage{
+		Attributes: &rtnetlink.LinkAttributes{
+			Name: "lldpctrl1",
+		},
+	}).MarshalBinary()
+	suite.Require().NoError(err)
+
+	encoder := netlink.NewAttributeEncoder()
+	encoder.Bytes(vethInfoPeer, peerMessage)
+
+	vethData, err := encoder.Encode()
+	suite.Require().NoError(err)
+
+	suite.Require().NoError(conn.Link.New(&rtnetlink.LinkMessage{
+		Type: unix.ARPHRD_ETHER,
+		Attributes: &rtnetlink.LinkAttributes{
+			Name: "lldpctrl0",
+			Info: &rtnetlink.LinkInfo{
+				Kind: "veth",
+				Data: vethData,
+			},
+		},
+	}))
+
+	local, err := net.InterfaceByName("lldpctrl0")
+	suite.Require().NoError(err)
+
+	defer conn.Link.Delete(uint32(local.Index)) //nolint:errcheck
+
+	peer, err := net.InterfaceByName("lldpctrl1")
+	suite.Require().NoError(err)
+
+	for _, iface := range []*net.Interface{local, peer} {
+		suite.Require().NoError(conn.Link.Set(&rtnetlink.LinkMessage{
+			Type:   unix.ARPHRD_ETHER,
+			Index:  uint32(iface.Index),
+			Flags:  unix.IFF_UP,
+			Change: unix.IFF_UP,
+		}))
+
+		// pretend that the veth links are physical
+		linkStatus := network.NewLinkStatus(network.NamespaceName, iface.Name)
+		linkStatus.TypedSpec().Index = uint32(iface.Index)
+		linkStatus.TypedSpec().Type = nethelpers.LinkEther
+		linkStatus.TypedSpec().HardwareAddr = nethelpers.HardwareAddr(iface.HardwareAddr)
+
+		suite.Require().NoError(suite.State().Create(suite.Ctx(), linkStatus))
+	}
+
+	hostnameStatus := network.NewHostnameStatus(network.NamespaceName, network.HostnameID)
+	hostnameStatus.TypedSpec().Hostname = "talos-node"
+
+	suite.Require().NoError(suite.State().Create(suite.Ctx(), hostnameStatus))
+
+	cfg := config.NewMachineConfig(
+		container.NewV1Alpha1(
+			&v1alpha1.Config{
+				ConfigVersion: "v1alpha1",
+				MachineConfig: &v1alpha1.MachineConfig{
+					MachineNetwork: &v1alpha1.NetworkConfig{
+						NetworkLLDP: &v1alpha1.NetworkLLDP{
+							LLDPTransmit:         pointer.To(true),
+							LLDPTransmitInterval: time.Second,
+						},
+					},
+				},
+				ClusterConfig: &v1alpha1.ClusterConfig{},
+			},
+		),
+	)
+
+	suite.Require().NoError(suite.State().Create(suite.Ctx(), cfg))
+
+	// each end of the veth pair sees the other one as a neighbor
+	rtestutils.AssertResources(suite.Ctx(), suite.T(), suite.State(),
+		[]resource.ID{
+			network.LLDPNeighborID(local.Name, local.HardwareAddr.String(), peer.Name),
+			network.LLDPNeighborID(peer.Name, local.HardwareAddr.String(), local.Name),
+		},
+		func(r *network.LLDPNeighbor, asrt *assert.Assertions) {
+			asrt.Equal("talos-node", r.TypedSpec().SystemName)
+			asrt.Equal(4*time.Second, r.TypedSpec().TTL)
+		},
+	)
+
+	// the neighbors are removed once the links are gone
+	for _, iface := range []*net.Interface{local, peer} {
+		suite.Require().NoError(suite.State().Destroy(suite.Ctx(), network.NewLinkStatus(network.NamespaceName, iface.Name).Metadata()))
+	}
+
+	rtestutils.AssertNoResource[*network.LLDPNeighbor](suite.Ctx(), suite.T(), suite.State(),
+		network.LLDPNeighborID(local.Name, local.HardwareAddr.String(), peer.Name),
+	)
+	rtestutils.AssertNoResource[*network.LLDPNeighbor](suite.Ctx(), suite.T(), suite.State(),
+		network.LLDPNeighborID(peer.Name, local.HardwareAddr.String(), local.Name),
+	)
+}
+
+func TestLLDPSuite(t *testing.T) {
+	suite.Run(t, &LLDPSuite{
+		DefaultSuite: ctest.DefaultSuite{
+			Timeout: 10 * time.Second,
+			AfterSetup: func(s *ctest.DefaultSuite) {
+				s.Require().NoError(s.Runtime().RegisterController(&netctrl.LLDPController{}))
+			},
+		},
+	})
+}
@@ -0,0 +1,167 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package network
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"net/netip"
+
+	"github.com/cosi-project/runtime/pkg/controller"
+	"github.com/cosi-project/runtime/pkg/resource"
+	"github.com/cosi-project/runtime/pkg/state"
+	"go.uber.org/zap"
+
+	talosconfig "github.com/siderolabs/talos/pkg/machinery/config/config"
+	"github.com/siderolabs/talos/pkg/machinery/nethelpers"
+	"github.com/siderolabs/talos/pkg/machinery/resources/network"
+)
+
+// NeighborConfigController manages network.NeighborSpec based on machine configuration.
+type NeighborConfigController struct{}
+
+// Name implements controller.Controller interface.
+func (ctrl *NeighborConfigController) Name() string {
+	return "network.NeighborConfigController"
+}
+
+// Inputs implements controller.Controller interface.
+func (ctrl *NeighborConfigController) Inputs() []controller.Input {
+	return []controller.Input{
+		{
+			Namespace: network.NamespaceName,
+			Type:      network.DeviceConfigSpecType,
+			Kind:      controller.InputWeak,
+		},
+	}
+}
+
+// Outputs implements controller.Controller interface.
+func (ctrl *NeighborConfigController) Outputs() []controller.Output {
+	return []controller.Output{
+		{
+			Type: network.NeighborSpecType,
+			Kind: controller.OutputShared,
+		},
+	}
+}
+
+// Run implements controller.Controller interface.
+func (ctrl *NeighborConfigController) Run(ctx context.Context, r controller.Runtime, logger *zap.Logger) error {
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-r.EventCh():
+		}
+
+		touchedIDs := make(map[resource.ID]struct{})
+
+		items, err := r.List(ctx, resource.NewMetadata(network.NamespaceName, network.DeviceConfigSpecType, "", resource.VersionUndefined))
+		if err != nil {
+			if !state.IsNotFoundError(err) {
+				return fmt.Errorf("error getting config: %w", err)
+			}
+		}
+
+		devices := make([]talosconfig.Device, len(items.Items))
+
+		for i, item := range items.Items {
+			devices[i] = item.(*network.DeviceConfigSpec).TypedSpec().Device
+		}
+
+		for _, neighbor := range ctrl.processDevicesConfiguration(logger, devices) {
+			neighbor := neighbor
+			id := network.LayeredID(neighbor.ConfigLayer, network.NeighborID(neighbor.LinkName, neighbor.Address, neighbor.Proxy))
+
+			if err = r.Modify(
+				ctx,
+				network.NewNeighborSpec(network.ConfigNamespaceName, id),
+				func(r resource.Resource) error {
+					*r.(*network.NeighborSpec).TypedSpec() = neighbor
+
+					return nil
+				},
+			); err != nil {
+				return fmt.Errorf("error applying machine configuration neighbor: %w", err)
+			}
+
+			touchedIDs[id] = struct{}{}
+		}
+
+		// list neighbors for cleanup
+		list, err := r.List(ctx, resource.NewMetadata(network.ConfigNamespaceName, network.NeighborSpecType, "", resource.VersionUndefined))
+		if err != nil {
+			return fmt.Errorf("error listing resources: %w", err)
+		}
+
+		for _, res := range list.Items {
+			if res.Metadata().Owner() != ctrl.Name() {
+				// skip specs created by other controllers
+				continue
+			}
+
+			if _, ok := touchedIDs[res.Metadata().ID()]; !ok {
+				if err = r.Destroy(ctx, res.Metadata()); err != nil {
+					return fmt.Errorf("error cleaning up neighbors: %w", err)
+				}
+			}
+		}
+
+		r.ResetRestartBackoff()
+	}
+}
+
+func (ctrl *NeighborConfigController) processDevicesConfiguration(logger *zap.Logger, devices []talosconfig.Device) (neighbors []network.NeighborSpecSpec) {
+	convert := func(linkName string, in talosconfig.Neighbor) (neighbor network.NeighborSpecSpec, err error) {
+		neighbor.Address, err = netip.ParseAddr(in.IP())
+		if err != nil {
+			return neighbor, fmt.Errorf("error parsing neighbor address: %w", err)
+		}
+
+		if in.LinkAddr() != "" {
+			var mac net.HardwareAddr
+
+			mac, err = net.ParseMAC(in.LinkAddr())
+			if err != nil {
+				return neighbor, fmt.Errorf("error parsing neighbor link-layer address: %w", err)
+			}
+
+			neighbor.HardwareAddr = nethelpers.HardwareAddr(mac)
+		}
+
+		neighbor.Family = nethelpers.FamilyInet4
+
+		if neighbor.Address.Is6() {
+			neighbor.Family = nethelpers.FamilyInet6
+		}
+
+		neighbor.LinkName = linkName
+		neighbor.Proxy = in.Proxy()
+		neighbor.ConfigLayer = network.ConfigMachineConfiguration
+
+		return neighbor, nil
+	}
+
+	for _, device := range devices {
+		if device.Ignore() {
+			continue
+		}
+
+		for _, in := range device.Neighbors() {
+			neighbor, err := convert(device.Interface(), in)
+			if err != nil {
+				logger.Sugar().Infof("skipping neighbor %q on interface %q: %s", in.IP(), device.Interface(), err)
+
+				continue
+			}
+
+			neighbors = append(neighbors, neighbor)
+		}
+	}
+
+	return neighbors
+}
@@ -0,0 +1,151 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package network_test
+
+import (
+	"net"
+	"net/netip"
+	"testing"
+	"time"
+
+	"github.com/cosi-project/runtime/pkg/resource"
+	"github.com/cosi-project/runtime/pkg/resource/rtestutils"
+	"github.com/siderolabs/go-pointer"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/siderolabs/talos/internal/app/machined/pkg/controllers/ctest"
+	netctrl "github.com/siderolabs/talos/internal/app/machined/pkg/controllers/network"
+	"github.com/siderolabs/talos/pkg/machinery/config/container"
+	"github.com/siderolabs/talos/pkg/machinery/config/types/v1alpha1"
+	"github.com/siderolabs/talos/pkg/machinery/nethelpers"
+	"github.com/siderolabs/talos/pkg/machinery/resources/config"
+	"github.com/siderolabs/talos/pkg/machinery/resources/network"
+)
+
+type NeighborConfigSuite struct {
+	ctest.DefaultSuite
+}
+
+func (suite *NeighborConfigSuite) TestMachineConfiguration() {
+	cfg := config.NewMachineConfig(
+		container.NewV1Alpha1(
+			&v1alpha1.Config{
+				ConfigVersion: "v1alpha1",
+				MachineConfig: &v1alpha1.MachineConfig{
+					MachineNetwork: &v1alpha1.NetworkConfig{
+						NetworkInterfaces: []*v1alpha1.Device{
+							{
+								DeviceInterface: "eth0",
+								DeviceNeighbors: []*v1alpha1.Neighbor{
+									{
+										NeighborIP:       "10.0.0.1",
+										NeighborLinkAddr: "02:00:00:00:00:01",
+									},
+									{
+										NeighborIP:    "10.0.0.100",
+										NeighborProxy: true,
+									},
+								},
+							},
+							{
+								DeviceInterface: "eth1",
+								DeviceNeighbors: []*v1alpha1.Neighbor{
+									{
+										NeighborIP:       "2001:db8::1",
+										NeighborLinkAddr: "02:00:00:00:00:02",
+									},
+								},
+							},
+							{
+								DeviceIgnore:    pointer.To(true),
+								DeviceInterface: "eth2",
+								DeviceNeighbors: []*v1alpha1.Neighbor{
+									{
+										NeighborIP:       "10.0.0.2",
+										NeighborLinkAddr: "02:00:00:00:00:03",
+									},
+								},
+							},
+						},
+					},
+				},
+				ClusterConfig: &v1alpha1.ClusterConfig{},
+			},
+		),
+	)
+
+	suite.Require().NoError(suite.State().Create(suite.Ctx(), cfg))
+
+	mac1, err := net.ParseMAC("02:00:00:00:00:01")
+	suite.Require().NoError(err)
+
+	mac2, err := net.ParseMAC("02:00:00:00:00:02")
+	suite.Require().NoError(err)
+
+	rtestutils.AssertResources(suite.Ctx(), suite.T(), suite.State(),
+		[]resource.ID{
+			"configuration/eth0/10.0.0.1",
+			"configuration/eth0/proxy/10.0.0.100",
+			"configuration/eth1/2001:db8::1",
+		},
+		func(r *network.NeighborSpec, asrt *assert.Assertions) {
+			switch r.Metadata().ID() {
+			case "configuration/eth0/10.0.0.1":
+				asrt.Equal(network.NeighborSpecSpec{
+					Family:       nethelpers.FamilyInet4,
+					Address:      netip.MustParseAddr("10.0.0.1"),
+					LinkName:     "eth0",
+					HardwareAddr: nethelpers.HardwareAddr(mac1),
+					ConfigLayer:  network.ConfigMachineConfiguration,
+				}, *r.TypedSpec())
+			case "configuration/eth0/proxy/10.0.0.100":
+				asrt.Equal(network.NeighborSpecSpec{
+					Family:      nethelpers.FamilyInet4,
+					Address:     netip.MustParseAddr("10.0.0.100"),
+					LinkName:    "eth0",
+					Proxy:       true,
+					ConfigLayer: network.ConfigMachineConfiguration,
+				}, *r.TypedSpec())
+			case "configuration/eth1/2001:db8::1":
+				asrt.Equal(network.NeighborSpecSpec{
+					Family:       nethelpers.FamilyInet6,
+					Address:      netip.MustParseAddr("2001:db8::1"),
+					LinkName:     "eth1",
+					HardwareAddr: nethelpers.HardwareAddr(mac2),
+					ConfigLayer:  network.ConfigMachineConfiguration,
+				}, *r.TypedSpec())
+			}
+		},
+		rtestutils.WithNamespace(network.ConfigNamespaceName),
+	)
+
+	rtestutils.AssertNoResource[*network.NeighborSpec](suite.Ctx(), suite.T(), suite.State(), "configuration/eth2/10.0.0.2",
+		rtestutils.WithNamespace(network.ConfigNamespaceName))
+
+	// remove the neighbors from the configuration
+	suite.Require().NoError(suite.State().Destroy(suite.Ctx(), cfg.Metadata()))
+
+	for _, id := range []resource.ID{
+		"configuration/eth0/10.0.0.1",
+		"configuration/eth0/proxy/10.0.0.100",
+		"configuration/eth1/2001:db8::1",
+	} {
+		rtestutils.AssertNoResource[*network.NeighborSpec](suite.Ctx(), suite.T(), suite.State(), id,
+			rtestutils.WithNamespace(network.ConfigNamespaceName))
+	}
+}
+
+func TestNeighborConfigSuite(t *testing.T) {
+	suite.Run(t, &NeighborConfigSuite{
+		DefaultSuite: ctest.DefaultSuite{
+			Timeout: 5 * time.Second,
+			AfterSetup: func(s *ctest.DefaultSuite) {
+				s.Require().NoError(s.Runtime().RegisterController(&netctrl.DeviceConfigController{}))
+				s.Require().NoError(s.Runtime().RegisterController(&netctrl.NeighborConfigController{}))
+			},
+		},
+	})
+}
@@ -0,0 +1,140 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+// Package network provides controllers which manage network resources.
+package network
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/cosi-project/runtime/pkg/controller"
+	"github.com/cosi-project/runtime/pkg/resource"
+	"github.com/cosi-project/runtime/pkg/state"
+	"go.uber.org/zap"
+
+	"github.com/siderolabs/talos/pkg/machinery/resources/network"
+)
+
+// NeighborMergeController merges network.NeighborSpec in network.ConfigNamespace and produces final network.NeighborSpec in network.Namespace.
+type NeighborMergeController struct{}
+
+// Name implements controller.Controller interface.
+func (ctrl *NeighborMergeController) Name() string {
+	return "network.NeighborMergeController"
+}
+
+// Inputs implements controller.Controller interface.
+func (ctrl *NeighborMergeController) Inputs() []controller.Input {
+	return []controller.Input{
+		{
+			Namespace: network.ConfigNamespaceName,
+			Type:      network.NeighborSpecType,
+			Kind:      controller.InputWeak,
+		},
+		{
+			Namespace: network.NamespaceName,
+			Type:      network.NeighborSpecType,
+			Kind:      controller.InputDestroyReady,
+		},
+	}
+}
+
+// Outputs implements controller.Controller interface.
+func (ctrl *NeighborMergeController) Outputs() []controller.Output {
+	return []controller.Output{
+		{
+			Type: network.NeighborSpecType,
+			Kind: controller.OutputShared,
+		},
+	}
+}
+
+// Run implements controller.Controller interface.
+//
+//nolint:gocyclo
+func (ctrl *NeighborMergeController) Run(ctx context.Context, r controller.Runtime, logger *zap.Logger) error {
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-r.EventCh():
+		}
+
+		// list source network configuration resources
+		list, err := r.List(ctx, resource.NewMetadata(network.ConfigNamespaceName, network.NeighborSpecType, "", resource.VersionUndefined))
+		if err != nil {
+			return fmt.Errorf("error listing source neighbors: %w", err)
+		}
+
+		// neighbor is allowed as long as it's not duplicate, for duplicate higher layer takes precedence
+		neighbors := map[string]*network.NeighborSpec{}
+
+		for _, res := range list.Items {
+			neighbor := res.(*network.NeighborSpec) //nolint:errcheck,forcetypeassert
+			id := network.NeighborID(neighbor.TypedSpec().LinkName, neighbor.TypedSpec().Address, neighbor.TypedSpec().Proxy)
+
+			existing, ok := neighbors[id]
+			if ok && existing.TypedSpec().ConfigLayer > neighbor.TypedSpec().ConfigLayer {
+				// skip this neighbor, as existing one is higher layer
+				continue
+			}
+
+			neighbors[id] = neighbor
+		}
+
+		conflictsDetected := 0
+
+		for id, neighbor := range neighbors {
+			neighbor := neighbor
+
+			if err = r.Modify(ctx, network.NewNeighborSpec(network.NamespaceName, id), func(res resource.Resource) error {
+				nn := res.(*network.NeighborSpec) //nolint:errcheck,forcetypeassert
+
+				*nn.TypedSpec() = *neighbor.TypedSpec()
+
+				return nil
+			}); err != nil {
+				if state.IsPhaseConflictError(err) {
+					// phase conflict, resource is being torn down, skip updating it and trigger reconcile
+					// later by failing the
+					conflictsDetected++
+
+					delete(neighbors, id)
+				} else {
+					return fmt.Errorf("error updating resource: %w", err)
+				}
+			}
+		}
+
+		// list neighbors for cleanup
+		list, err = r.List(ctx, resource.NewMetadata(network.NamespaceName, network.NeighborSpecType, "", resource.VersionUndefined))
+		if err != nil {
+			return fmt.Errorf("error listing resources: %w", err)
+		}
+
+		for _, res := range list.Items {
+			if _, ok := neighbors[res.Metadata().ID()]; !ok {
+				var okToDestroy bool
+
+				okToDestroy, err = r.Teardown(ctx, res.Metadata())
+				if err != nil {
+					return fmt.Errorf("error cleaning up neighbors: %w", err)
+				}
+
+				if okToDestroy {
+					if err = r.Destroy(ctx, res.Metadata()); err != nil {
+						return fmt.Errorf("error cleaning up neighbors: %w", err)
+					}
+				}
+			}
+		}
+
+		if conflictsDetected > 0 {
+			return fmt.Errorf("%d conflict(s) detected", conflictsDetected)
+		}
+
+		r.ResetRestartBackoff()
+	}
+}
@@ -0,0 +1,102 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package network_test
+
+import (
+	"net"
+	"net/netip"
+	"testing"
+	"time"
+
+	"github.com/cosi-project/runtime/pkg/resource"
+	"github.com/cosi-project/runtime/pkg/resource/rtestutils"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/siderolabs/talos/internal/app/machined/pkg/controllers/ctest"
+	netctrl "github.com/siderolabs/talos/internal/app/machined/pkg/controllers/network"
+	"github.com/siderolabs/talos/pkg/machinery/nethelpers"
+	"github.com/siderolabs/talos/pkg/machinery/resources/network"
+)
+
+type NeighborMergeSuite struct {
+	ctest.DefaultSuite
+}
+
+func (suite *NeighborMergeSuite) TestMerge() {
+	mac1, err := net.ParseMAC("02:00:00:00:00:01")
+	suite.Require().NoError(err)
+
+	mac2, err := net.ParseMAC("02:00:00:00:00:02")
+	suite.Require().NoError(err)
+
+	platform := network.NewNeighborSpec(network.ConfigNamespaceName, "platform/eth0/10.0.0.1")
+	*platform.TypedSpec() = network.NeighborSpecSpec{
+		Family:       nethelpers.FamilyInet4,
+		Address:      netip.MustParseAddr("10.0.0.1"),
+		LinkName:     "eth0",
+		HardwareAddr: nethelpers.HardwareAddr(mac1),
+		ConfigLayer:  network.ConfigPlatform,
+	}
+
+	static := network.NewNeighborSpec(network.ConfigNamespaceName, "configuration/eth0/10.0.0.1")
+	*static.TypedSpec() = network.NeighborSpecSpec{
+		Family:       nethelpers.FamilyInet4,
+		Address:      netip.MustParseAddr("10.0.0.1"),
+		LinkName:     "eth0",
+		HardwareAddr: nethelpers.HardwareAddr(mac2),
+		ConfigLayer:  network.ConfigMachineConfiguration,
+	}
+
+	proxy := network.NewNeighborSpec(network.ConfigNamespaceName, "configuration/eth0/proxy/10.0.0.1")
+	*proxy.TypedSpec() = network.NeighborSpecSpec{
+		Family:      nethelpers.FamilyInet4,
+		Address:     netip.MustParseAddr("10.0.0.1"),
+		LinkName:    "eth0",
+		Proxy:       true,
+		ConfigLayer: network.ConfigMachineConfiguration,
+	}
+
+	for _, res := range []resource.Resource{platform, static, proxy} {
+		suite.Require().NoError(suite.State().Create(suite.Ctx(), res), "%v", res.Spec())
+	}
+
+	rtestutils.AssertResources(suite.Ctx(), suite.T(), suite.State(), []resource.ID{"eth0/10.0.0.1", "eth0/proxy/10.0.0.1"},
+		func(r *network.NeighborSpec, asrt *assert.Assertions) {
+			asrt.Equal(resource.PhaseRunning, r.Metadata().Phase())
+
+			switch r.Metadata().ID() {
+			case "eth0/10.0.0.1":
+				asrt.Equal(*static.TypedSpec(), *r.TypedSpec())
+			case "eth0/proxy/10.0.0.1":
+				asrt.Equal(*proxy.TypedSpec(), *r.TypedSpec())
+			}
+		},
+	)
+
+	// lower layer takes over once the higher layer is removed
+	suite.Require().NoError(suite.State().Destroy(suite.Ctx(), static.Metadata()))
+
+	rtestutils.AssertResources(suite.Ctx(), suite.T(), suite.State(), []resource.ID{"eth0/10.0.0.1"},
+		func(r *network.NeighborSpec, asrt *assert.Assertions) {
+			asrt.Equal(*platform.TypedSpec(), *r.TypedSpec())
+		},
+	)
+
+	suite.Require().NoError(suite.State().Destroy(suite.Ctx(), proxy.Metadata()))
+
+	rtestutils.AssertNoResource[*network.NeighborSpec](suite.Ctx(), suite.T(), suite.State(), "eth0/proxy/10.0.0.1")
+}
+
+func TestNeighborMergeSuite(t *testing.T) {
+	suite.Run(t, &NeighborMergeSuite{
+		DefaultSuite: ctest.DefaultSuite{
+			Timeout: 5 * time.Second,
+			AfterSetup: func(s *ctest.DefaultSuite) {
+				s.Require().NoError(s.Runtime().RegisterController(&netctrl.NeighborMergeController{}))
+			},
+		},
+	})
+}
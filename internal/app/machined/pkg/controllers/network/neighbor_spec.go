@@ -0,0 +1,222 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package network
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"net/netip"
+
+	"github.com/cosi-project/runtime/pkg/controller"
+	"github.com/cosi-project/runtime/pkg/resource"
+	"github.com/cosi-project/runtime/pkg/safe"
+	"github.com/hashicorp/go-multierror"
+	"github.com/vishvananda/netlink"
+	"go.uber.org/zap"
+	"golang.org/x/sys/unix"
+
+	"github.com/siderolabs/talos/pkg/machinery/resources/network"
+)
+
+// NeighborSpecController applies network.NeighborSpec to the kernel.
+type NeighborSpecController struct{}
+
+// Name implements controller.Controller interface.
+func (ctrl *NeighborSpecController) Name() string {
+	return "network.NeighborSpecController"
+}
+
+// Inputs implements controller.Controller interface.
+func (ctrl *NeighborSpecController) Inputs() []controller.Input {
+	return []controller.Input{
+		{
+			Namespace: network.NamespaceName,
+			Type:      network.NeighborSpecType,
+			Kind:      controller.InputStrong,
+		},
+		{
+			Namespace: network.NamespaceName,
+			Type:      network.LinkStatusType,
+			Kind:      controller.InputWeak,
+		},
+	}
+}
+
+// Outputs implements controller.Controller interface.
+func (ctrl *NeighborSpecController) Outputs() []controller.Output {
+	return nil
+}
+
+// Run implements controller.Controller interface.
+func (ctrl *NeighborSpecController) Run(ctx context.Context, r controller.Runtime, logger *zap.Logger) error {
+	handle, err := netlink.NewHandle(unix.NETLINK_ROUTE)
+	if err != nil {
+		return fmt.Errorf("error dialing netlink socket: %w", err)
+	}
+
+	defer handle.Close()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-r.EventCh():
+		}
+
+		list, err := safe.ReaderListAll[*network.NeighborSpec](ctx, r)
+		if err != nil {
+			return fmt.Errorf("error listing neighbor specs: %w", err)
+		}
+
+		// add finalizers for all live resources
+		for it := safe.IteratorFromList(list); it.Next(); {
+			if it.Value().Metadata().Phase() != resource.PhaseRunning {
+				continue
+			}
+
+			if err = r.AddFinalizer(ctx, it.Value().Metadata(), ctrl.Name()); err != nil {
+				return fmt.Errorf("error adding finalizer: %w", err)
+			}
+		}
+
+		neighbors, err := handle.NeighList(0, 0)
+		if err != nil {
+			return fmt.Errorf("error listing neighbors: %w", err)
+		}
+
+		proxies, err := handle.NeighProxyList(0, 0)
+		if err != nil {
+			return fmt.Errorf("error listing proxy neighbors: %w", err)
+		}
+
+		var multiErr *multierror.Error
+
+		for it := safe.IteratorFromList(list); it.Next(); {
+			existing := neighbors
+
+			if it.Value().TypedSpec().Proxy {
+				existing = proxies
+			}
+
+			if err = ctrl.syncNeighbor(ctx, r, logger, handle, existing, it.Value()); err != nil {
+				multiErr = multierror.Append(multiErr, err)
+			}
+		}
+
+		if err = multiErr.ErrorOrNil(); err != nil {
+			return err
+		}
+
+		r.ResetRestartBackoff()
+	}
+}
+
+func findNeighbor(neighbors []netlink.Neigh, linkIndex int, addr netip.Addr) *netlink.Neigh {
+	for i, neigh := range neighbors {
+		if neigh.LinkIndex != linkIndex {
+			continue
+		}
+
+		if neighAddr, ok := netip.AddrFromSlice(neigh.IP); ok && neighAddr.Unmap() == addr {
+			return &neighbors[i]
+		}
+	}
+
+	return nil
+}
+
+// neighborMatchesSpec checks whether the existing kernel entry matches the spec.
+func neighborMatchesSpec(existing *netlink.Neigh, spec *network.NeighborSpecSpec) bool {
+	if spec.Proxy {
+		// proxy entries carry nothing but the address
+		return true
+	}
+
+	return existing.State&netlink.NUD_PERMANENT != 0 && bytes.Equal(existing.HardwareAddr, spec.HardwareAddr)
+}
+
+func neighborFromSpec(linkIndex int, spec *network.NeighborSpecSpec) *netlink.Neigh {
+	neigh := &netlink.Neigh{
+		LinkIndex: linkIndex,
+		Family:    int(spec.Family),
+		State:     netlink.NUD_PERMANENT,
+		IP:        net.IP(spec.Address.AsSlice()),
+	}
+
+	if spec.Proxy {
+		neigh.Flags = netlink.NTF_PROXY
+	} else {
+		neigh.HardwareAddr = net.HardwareAddr(spec.HardwareAddr)
+	}
+
+	return neigh
+}
+
+func (ctrl *NeighborSpecController) syncNeighbor(ctx context.Context, r controller.Runtime, logger *zap.Logger, handle *netlink.Handle,
+	neighbors []netlink.Neigh, neighbor *network.NeighborSpec,
+) error {
+	spec := neighbor.TypedSpec()
+
+	link, err := handle.LinkByName(spec.LinkName)
+	if err != nil {
+		var notFoundErr netlink.LinkNotFoundError
+
+		if !errors.As(err, &notFoundErr) {
+			return fmt.Errorf("error getting link %q: %w", spec.LinkName, err)
+		}
+	}
+
+	var existing *netlink.Neigh
+
+	if link != nil {
+		existing = findNeighbor(neighbors, link.Attrs().Index, spec.Address)
+	}
+
+	switch neighbor.Metadata().Phase() {
+	case resource.PhaseTearingDown:
+		if existing != nil && neighborMatchesSpec(existing, spec) {
+			if err = handle.NeighDel(neighborFromSpec(link.Attrs().Index, spec)); err != nil && !errors.Is(err, unix.ENOENT) {
+				return fmt.Errorf("error removing neighbor: %w", err)
+			}
+
+			logger.Info("deleted neighbor",
+				zap.String("link", spec.LinkName),
+				zap.Stringer("address", spec.Address),
+				zap.Bool("proxy", spec.Proxy),
+			)
+		}
+
+		// now remove finalizer as neighbor was deleted
+		if err = r.RemoveFinalizer(ctx, neighbor.Metadata(), ctrl.Name()); err != nil {
+			return fmt.Errorf("error removing finalizer: %w", err)
+		}
+	case resource.PhaseRunning:
+		if link == nil {
+			// link doesn't exist (yet), the neighbor will be added when the link shows up
+			return nil
+		}
+
+		if existing != nil && neighborMatchesSpec(existing, spec) {
+			return nil
+		}
+
+		// replaces the dynamic entry for the address, if any
+		if err = handle.NeighSet(neighborFromSpec(link.Attrs().Index, spec)); err != nil {
+			return fmt.Errorf("error adding neighbor %s on %q: %w", spec.Address, spec.LinkName, err)
+		}
+
+		logger.Info("created neighbor",
+			zap.String("link", spec.LinkName),
+			zap.Stringer("address", spec.Address),
+			zap.Stringer("lladdr", spec.HardwareAddr),
+			zap.Bool("proxy", spec.Proxy),
+		)
+	}
+
+	return nil
+}
@@ -0,0 +1,172 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package network_test
+
+import (
+	"net"
+	"net/netip"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/cosi-project/runtime/pkg/resource"
+	"github.com/cosi-project/runtime/pkg/resource/rtestutils"
+	"github.com/jsimonetti/rtnetlink"
+	"github.com/mdlayher/netlink"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+	"golang.org/x/sys/unix"
+
+	"github.com/siderolabs/talos/internal/app/machined/pkg/controllers/ctest"
+	netctrl "github.com/siderolabs/talos/internal/app/machined/pkg/controllers/network"
+	"github.com/siderolabs/talos/pkg/machinery/nethelpers"
+	"github.com/siderolabs/talos/pkg/machinery/resources/network"
+)
+
+type NeighborSpecSuite struct {
+	ctest.DefaultSuite
+}
+
+func (suite *NeighborSpecSuite) TestNeighbors() {
+	if os.Geteuid() != 0 {
+		suite.T().Skip("requires root")
+	}
+
+	conn, err := rtnetlink.Dial(nil)
+	suite.Require().NoError(err)
+
+	defer conn.Close() //nolint:errcheck
+
+	peerMessage, err := (&rtnetlink.LinkMessage{
+		Attributes: &rtnetlink.LinkAttributes{
+			Name: "nbspec1",
+		},
+	}).MarshalBinary()
+	suite.Require().NoError(err)
+
+	encoder := netlink.NewAttributeEncoder()
+	encoder.Bytes(vethInfoPeer, peerMessage)
+
+	vethData, err := encoder.Encode()
+	suite.Require().NoError(err)
+
+	suite.Require().NoError(conn.Link.New(&rtnetlink.LinkMessage{
+		Type: unix.ARPHRD_ETHER,
+		Attributes: &rtnetlink.LinkAttributes{
+			Name: "nbspec0",
+			Info: &rtnetlink.LinkInfo{
+				Kind: "veth",
+				Data: vethData,
+			},
+		},
+	}))
+
+	iface, err := net.InterfaceByName("nbspec0")
+	suite.Require().NoError(err)
+
+	defer conn.Link.Delete(uint32(iface.Index)) //nolint:errcheck
+
+	mac1, err := net.ParseMAC("02:00:00:00:00:01")
+	suite.Require().NoError(err)
+
+	mac2, err := net.ParseMAC("02:00:00:00:00:02")
+	suite.Require().NoError(err)
+
+	inet4 := network.NewNeighborSpec(network.NamespaceName, "nbspec0/10.99.0.1")
+	*inet4.TypedSpec() = network.NeighborSpecSpec{
+		Family:       nethelpers.FamilyInet4,
+		Address:      netip.MustParseAddr("10.99.0.1"),
+		LinkName:     iface.Name,
+		HardwareAddr: nethelpers.HardwareAddr(mac1),
+		ConfigLayer:  network.ConfigMachineConfiguration,
+	}
+
+	inet6 := network.NewNeighborSpec(network.NamespaceName, "nbspec0/2001:db8::1")
+	*inet6.TypedSpec() = network.NeighborSpecSpec{
+		Family:       nethelpers.FamilyInet6,
+		Address:      netip.MustParseAddr("2001:db8::1"),
+		LinkName:     iface.Name,
+		HardwareAddr: nethelpers.HardwareAddr(mac2),
+		ConfigLayer:  network.ConfigMachineConfiguration,
+	}
+
+	proxy := network.NewNeighborSpec(network.NamespaceName, "nbspec0/proxy/10.99.0.100")
+	*proxy.TypedSpec() = network.NeighborSpecSpec{
+		Family:      nethelpers.FamilyInet4,
+		Address:     netip.MustParseAddr("10.99.0.100"),
+		LinkName:    iface.Name,
+		Proxy:       true,
+		ConfigLayer: network.ConfigMachineConfiguration,
+	}
+
+	specs := []*network.NeighborSpec{inet4, inet6, proxy}
+
+	for _, spec := range specs {
+		suite.Require().NoError(suite.State().Create(suite.Ctx(), spec))
+	}
+
+	rtestutils.AssertResources(suite.Ctx(), suite.T(), suite.State(),
+		[]resource.ID{"nbspec0/10.99.0.1", "nbspec0/2001:db8::1", "nbspec0/proxy/10.99.0.100"},
+		func(status *network.NeighborStatus, asrt *assert.Assertions) {
+			asrt.Equal(uint32(iface.Index), status.TypedSpec().LinkIndex)
+			asrt.Equal(iface.Name, status.TypedSpec().LinkName)
+
+			switch status.Metadata().ID() {
+			case "nbspec0/10.99.0.1":
+				asrt.Equal(nethelpers.NeighborStatePermanent, status.TypedSpec().State)
+				asrt.Equal(nethelpers.HardwareAddr(mac1), status.TypedSpec().HardwareAddr)
+			case "nbspec0/2001:db8::1":
+				asrt.Equal(nethelpers.FamilyInet6, status.TypedSpec().Family)
+				asrt.Equal(nethelpers.NeighborStatePermanent, status.TypedSpec().State)
+				asrt.Equal(nethelpers.HardwareAddr(mac2), status.TypedSpec().HardwareAddr)
+			case "nbspec0/proxy/10.99.0.100":
+				asrt.True(status.TypedSpec().Proxy)
+			}
+		})
+
+	// change the hardware address
+	ctest.UpdateWithConflicts(suite, inet4, func(r *network.NeighborSpec) error {
+		r.TypedSpec().HardwareAddr = nethelpers.HardwareAddr(mac2)
+
+		return nil
+	})
+
+	rtestutils.AssertResources(suite.Ctx(), suite.T(), suite.State(), []resource.ID{"nbspec0/10.99.0.1"},
+		func(status *network.NeighborStatus, asrt *assert.Assertions) {
+			asrt.Equal(nethelpers.HardwareAddr(mac2), status.TypedSpec().HardwareAddr)
+		})
+
+	// teardown removes the entries
+	for _, spec := range specs {
+		for {
+			ready, err := suite.State().Teardown(suite.Ctx(), spec.Metadata())
+			suite.Require().NoError(err)
+
+			if ready {
+				break
+			}
+
+			time.Sleep(100 * time.Millisecond)
+		}
+
+		suite.Require().NoError(suite.State().Destroy(suite.Ctx(), spec.Metadata()))
+	}
+
+	for _, id := range []resource.ID{"nbspec0/10.99.0.1", "nbspec0/2001:db8::1", "nbspec0/proxy/10.99.0.100"} {
+		rtestutils.AssertNoResource[*network.NeighborStatus](suite.Ctx(), suite.T(), suite.State(), id)
+	}
+}
+
+func TestNeighborSpecSuite(t *testing.T) {
+	suite.Run(t, &NeighborSpecSuite{
+		DefaultSuite: ctest.DefaultSuite{
+			Timeout: 10 * time.Second,
+			AfterSetup: func(s *ctest.DefaultSuite) {
+				s.Require().NoError(s.Runtime().RegisterController(&netctrl.NeighborSpecController{}))
+				s.Require().NoError(s.Runtime().RegisterController(&netctrl.NeighborStatusController{}))
+			},
+		},
+	})
+}
@@ -0,0 +1,169 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package network
+
+import (
+	"context"
+	"fmt"
+	"net/netip"
+
+	"github.com/cosi-project/runtime/pkg/controller"
+	"github.com/cosi-project/runtime/pkg/resource"
+	"github.com/cosi-project/runtime/pkg/safe"
+	"github.com/vishvananda/netlink"
+	"go.uber.org/zap"
+	"golang.org/x/sys/unix"
+
+	"github.com/siderolabs/talos/internal/app/machined/pkg/controllers/network/watch"
+	"github.com/siderolabs/talos/pkg/machinery/nethelpers"
+	"github.com/siderolabs/talos/pkg/machinery/resources/network"
+)
+
+// NeighborStatusController manages network.NeighborStatus based on the kernel neighbor tables.
+type NeighborStatusController struct{}
+
+// Name implements controller.Controller interface.
+func (ctrl *NeighborStatusController) Name() string {
+	return "network.NeighborStatusController"
+}
+
+// Inputs implements controller.Controller interface.
+//
+// The kernel doesn't send notifications for the proxy entries, so the status is also refreshed
+// when the neighbor specs are updated (e.g. the finalizer is removed once the entry is deleted).
+func (ctrl *NeighborStatusController) Inputs() []controller.Input {
+	return []controller.Input{
+		{
+			Namespace: network.NamespaceName,
+			Type:      network.NeighborSpecType,
+			Kind:      controller.InputWeak,
+		},
+	}
+}
+
+// Outputs implements controller.Controller interface.
+func (ctrl *NeighborStatusController) Outputs() []controller.Output {
+	return []controller.Output{
+		{
+			Type: network.NeighborStatusType,
+			Kind: controller.OutputExclusive,
+		},
+	}
+}
+
+// Run implements controller.Controller interface.
+func (ctrl *NeighborStatusController) Run(ctx context.Context, r controller.Runtime, logger *zap.Logger) error {
+	watcher, err := watch.NewRtNetlink(r, unix.RTMGRP_NEIGH)
+	if err != nil {
+		return err
+	}
+
+	defer watcher.Done()
+
+	handle, err := netlink.NewHandle(unix.NETLINK_ROUTE)
+	if err != nil {
+		return fmt.Errorf("error dialing netlink socket: %w", err)
+	}
+
+	defer handle.Close()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-r.EventCh():
+		}
+
+		if err = ctrl.reconcile(ctx, r, handle); err != nil {
+			return err
+		}
+
+		r.ResetRestartBackoff()
+	}
+}
+
+func (ctrl *NeighborStatusController) reconcile(ctx context.Context, r controller.Runtime, handle *netlink.Handle) error {
+	links, err := handle.LinkList()
+	if err != nil {
+		return fmt.Errorf("error listing links: %w", err)
+	}
+
+	linkLookup := make(map[int]string, len(links))
+
+	for _, link := range links {
+		linkLookup[link.Attrs().Index] = link.Attrs().Name
+	}
+
+	neighbors, err := handle.NeighList(0, 0)
+	if err != nil {
+		return fmt.Errorf("error listing neighbors: %w", err)
+	}
+
+	proxies, err := handle.NeighProxyList(0, 0)
+	if err != nil {
+		return fmt.Errorf("error listing proxy neighbors: %w", err)
+	}
+
+	touchedIDs := make(map[resource.ID]struct{})
+
+	for _, table := range []struct {
+		list  []netlink.Neigh
+		proxy bool
+	}{
+		{list: neighbors},
+		{list: proxies, proxy: true},
+	} {
+		for _, neigh := range table.list {
+			if neigh.Family != unix.AF_INET && neigh.Family != unix.AF_INET6 {
+				continue
+			}
+
+			addr, ok := netip.AddrFromSlice(neigh.IP)
+			if !ok {
+				continue
+			}
+
+			status := network.NeighborStatusSpec{
+				Family:       nethelpers.Family(neigh.Family),
+				Address:      addr.Unmap(),
+				LinkIndex:    uint32(neigh.LinkIndex),
+				LinkName:     linkLookup[neigh.LinkIndex],
+				HardwareAddr: nethelpers.HardwareAddr(neigh.HardwareAddr),
+				State:        nethelpers.NeighborState(neigh.State),
+				Proxy:        table.proxy,
+			}
+
+			id := network.NeighborID(status.LinkName, status.Address, status.Proxy)
+
+			if err = safe.WriterModify(ctx, r, network.NewNeighborStatus(network.NamespaceName, id), func(res *network.NeighborStatus) error {
+				*res.TypedSpec() = status
+
+				return nil
+			}); err != nil {
+				return fmt.Errorf("error modifying resource: %w", err)
+			}
+
+			touchedIDs[id] = struct{}{}
+		}
+	}
+
+	// list resources for cleanup
+	list, err := safe.ReaderListAll[*network.NeighborStatus](ctx, r)
+	if err != nil {
+		return fmt.Errorf("error listing resources: %w", err)
+	}
+
+	for it := safe.IteratorFromList(list); it.Next(); {
+		if _, ok := touchedIDs[it.Value().Metadata().ID()]; ok {
+			continue
+		}
+
+		if err = r.Destroy(ctx, it.Value().Metadata()); err != nil {
+			return fmt.Errorf("error deleting neighbor status %q: %w", it.Value().Metadata().ID(), err)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,129 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package network
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/cosi-project/runtime/pkg/controller"
+	"github.com/cosi-project/runtime/pkg/safe"
+	"github.com/google/nftables"
+	"go.uber.org/zap"
+
+	nftablesrules "github.com/siderolabs/talos/internal/app/machined/pkg/controllers/network/internal/nftables"
+	"github.com/siderolabs/talos/pkg/machinery/resources/network"
+)
+
+// NfTablesChainController applies nftables chains to the kernel.
+//
+// All chains are created in the Talos-managed table, the table is replaced atomically on every change.
+type NfTablesChainController struct {
+	TableName string
+}
+
+// Name implements controller.Controller interface.
+func (ctrl *NfTablesChainController) Name() string {
+	return "network.NfTablesChainController"
+}
+
+// Inputs implements controller.Controller interface.
+func (ctrl *NfTablesChainController) Inputs() []controller.Input {
+	return []controller.Input{
+		{
+			Namespace: network.NamespaceName,
+			Type:      network.NfTablesChainType,
+			Kind:      controller.InputWeak,
+		},
+	}
+}
+
+// Outputs implements controller.Controller interface.
+func (ctrl *NfTablesChainController) Outputs() []controller.Output {
+	return nil
+}
+
+// Run implements controller.Controller interface.
+func (ctrl *NfTablesChainController) Run(ctx context.Context, r controller.Runtime, logger *zap.Logger) error {
+	if ctrl.TableName == "" {
+		ctrl.TableName = "talos"
+	}
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-r.EventCh():
+		}
+
+		list, err := safe.ReaderListAll[*network.NfTablesChain](ctx, r)
+		if err != nil {
+			return fmt.Errorf("error listing nftables chains: %w", err)
+		}
+
+		if err = ctrl.apply(list); err != nil {
+			return err
+		}
+
+		logger.Debug("nftables chains updated", zap.Int("chains", list.Len()))
+
+		r.ResetRestartBackoff()
+	}
+}
+
+func (ctrl *NfTablesChainController) apply(list safe.List[*network.NfTablesChain]) error {
+	conn := &nftables.Conn{}
+
+	table := &nftables.Table{
+		Family: nftables.TableFamilyINet,
+		Name:   ctrl.TableName,
+	}
+
+	// add the table to make sure it exists, delete it to flush all chains and rules,
+	// the whole batch is applied atomically
+	conn.AddTable(table)
+	conn.DelTable(table)
+
+	if list.Len() > 0 {
+		conn.AddTable(table)
+	}
+
+	for iter := safe.IteratorFromList(list); iter.Next(); {
+		chainName := iter.Value().Metadata().ID()
+		spec := iter.Value().TypedSpec()
+
+		policy := nftables.ChainPolicy(spec.Policy)
+
+		chain := conn.AddChain(&nftables.Chain{
+			Name:     chainName,
+			Table:    table,
+			Type:     nftables.ChainTypeFilter,
+			Hooknum:  nftables.ChainHookRef(nftables.ChainHook(spec.Hook)),
+			Priority: nftables.ChainPriorityRef(nftables.ChainPriority(spec.Priority)),
+			Policy:   &policy,
+		})
+
+		for _, rule := range spec.Rules {
+			compiled, err := nftablesrules.Compile(rule)
+			if err != nil {
+				return fmt.Errorf("error compiling rule for chain %q: %w", chainName, err)
+			}
+
+			for _, exprs := range compiled {
+				conn.AddRule(&nftables.Rule{
+					Table: table,
+					Chain: chain,
+					Exprs: exprs,
+				})
+			}
+		}
+	}
+
+	if err := conn.Flush(); err != nil {
+		return fmt.Errorf("error applying nftables chains: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,187 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package network
+
+import (
+	"context"
+	"fmt"
+	"net/netip"
+	"sort"
+
+	"github.com/cosi-project/runtime/pkg/controller"
+	"github.com/cosi-project/runtime/pkg/safe"
+	"github.com/cosi-project/runtime/pkg/state"
+	"github.com/siderolabs/gen/slices"
+	"github.com/siderolabs/go-pointer"
+	"go.uber.org/zap"
+
+	talosconfig "github.com/siderolabs/talos/pkg/machinery/config/config"
+	"github.com/siderolabs/talos/pkg/machinery/nethelpers"
+	"github.com/siderolabs/talos/pkg/machinery/resources/config"
+	"github.com/siderolabs/talos/pkg/machinery/resources/network"
+)
+
+// IngressChainName is the name of the ingress chain.
+const IngressChainName = "ingress"
+
+// NfTablesChainConfigController generates nftables rules based on machine configuration.
+type NfTablesChainConfigController struct{}
+
+// Name implements controller.Controller interface.
+func (ctrl *NfTablesChainConfigController) Name() string {
+	return "network.NfTablesChainConfigController"
+}
+
+// Inputs implements controller.Controller interface.
+func (ctrl *NfTablesChainConfigController) Inputs() []controller.Input {
+	return []controller.Input{
+		{
+			Namespace: config.NamespaceName,
+			Type:      config.MachineConfigType,
+			ID:        pointer.To(config.V1Alpha1ID),
+			Kind:      controller.InputWeak,
+		},
+	}
+}
+
+// Outputs implements controller.Controller interface.
+func (ctrl *NfTablesChainConfigController) Outputs() []controller.Output {
+	return []controller.Output{
+		{
+			Type: network.NfTablesChainType,
+			Kind: controller.OutputExclusive,
+		},
+	}
+}
+
+// Run implements controller.Controller interface.
+func (ctrl *NfTablesChainConfigController) Run(ctx context.Context, r controller.Runtime, _ *zap.Logger) error {
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-r.EventCh():
+		}
+
+		cfg, err := safe.ReaderGetByID[*config.MachineConfig](ctx, r, config.V1Alpha1ID)
+		if err != nil && !state.IsNotFoundError(err) {
+			return fmt.Errorf("error getting machine config: %w", err)
+		}
+
+		var networkRules talosconfig.NetworkRuleConfig
+
+		if cfg != nil {
+			networkRules = cfg.Config().NetworkRules()
+		}
+
+		if networkRules == nil {
+			// no firewall configuration, remove the chain
+			if err = r.Destroy(ctx, network.NewNfTablesChain(network.NamespaceName, IngressChainName).Metadata()); err != nil && !state.IsNotFoundError(err) {
+				return fmt.Errorf("error destroying nftables chain: %w", err)
+			}
+		} else {
+			if err = safe.WriterModify(ctx, r, network.NewNfTablesChain(network.NamespaceName, IngressChainName),
+				func(chain *network.NfTablesChain) error {
+					*chain.TypedSpec() = buildIngressChain(networkRules)
+
+					return nil
+				}); err != nil {
+				return fmt.Errorf("error updating nftables chain: %w", err)
+			}
+		}
+
+		r.ResetRestartBackoff()
+	}
+}
+
+func buildIngressChain(networkRules talosconfig.NetworkRuleConfig) network.NfTablesChainSpec {
+	spec := network.NfTablesChainSpec{
+		Hook:     nethelpers.ChainHookInput,
+		Priority: nethelpers.ChainPriorityFilter,
+		Policy:   nethelpers.VerdictAccept,
+	}
+
+	if networkRules.DefaultAction() == nethelpers.DefaultActionBlock {
+		spec.Policy = nethelpers.VerdictDrop
+
+		// basic rules which keep the node operational when the traffic is blocked by default:
+		// loopback, replies to the outgoing connections and ICMP (required for IPv6 neighbor discovery and PMTU discovery)
+		spec.Rules = []network.NfTablesRule{
+			{
+				MatchIIfName: &network.NfTablesIfNameMatch{
+					InterfaceName: "lo",
+				},
+				Verdict: nethelpers.VerdictAccept,
+			},
+			{
+				MatchConntrackState: &network.NfTablesConntrackStateMatch{
+					States: []nethelpers.ConntrackState{
+						nethelpers.ConntrackStateEstablished,
+						nethelpers.ConntrackStateRelated,
+					},
+				},
+				Verdict: nethelpers.VerdictAccept,
+			},
+			{
+				MatchConntrackState: &network.NfTablesConntrackStateMatch{
+					States: []nethelpers.ConntrackState{
+						nethelpers.ConntrackStateInvalid,
+					},
+				},
+				Verdict: nethelpers.VerdictDrop,
+			},
+			{
+				MatchLayer4: &network.NfTablesLayer4Match{
+					Protocol: nethelpers.ProtocolICMP,
+				},
+				Verdict: nethelpers.VerdictAccept,
+			},
+			{
+				MatchLayer4: &network.NfTablesLayer4Match{
+					Protocol: nethelpers.ProtocolICMPv6,
+				},
+				Verdict: nethelpers.VerdictAccept,
+			},
+		}
+	}
+
+	for _, rule := range networkRules.Rules() {
+		portRanges := make([]network.PortRange, 0, len(rule.PortRanges()))
+
+		for _, portRange := range rule.PortRanges() {
+			portRanges = append(portRanges, network.PortRange{Lo: portRange[0], Hi: portRange[1]})
+		}
+
+		// machine config validation ensures that the ranges don't overlap
+		sort.Slice(portRanges, func(i, j int) bool {
+			return portRanges[i].Lo < portRanges[j].Lo
+		})
+
+		// exceptions apply only to the subnet they are defined for, so each subnet gets a separate rule
+		for _, ingress := range rule.IngressSubnets() {
+			addressMatch := &network.NfTablesAddressMatch{
+				IncludeSubnets: []netip.Prefix{ingress.Subnet},
+			}
+
+			if ingress.Except.IsValid() {
+				addressMatch.ExcludeSubnets = []netip.Prefix{ingress.Except}
+			}
+
+			spec.Rules = append(spec.Rules, network.NfTablesRule{
+				MatchSourceAddress: addressMatch,
+				MatchLayer4: &network.NfTablesLayer4Match{
+					Protocol: rule.Protocol(),
+					MatchDestinationPort: &network.NfTablesPortMatch{
+						Ranges: slices.Clone(portRanges),
+					},
+				},
+				AnonCounter: true,
+				Verdict:     nethelpers.VerdictAccept,
+			})
+		}
+	}
+
+	return spec
+}
@@ -0,0 +1,242 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package network_test
+
+import (
+	"net/netip"
+	"testing"
+	"time"
+
+	"github.com/cosi-project/runtime/pkg/resource"
+	"github.com/cosi-project/runtime/pkg/resource/rtestutils"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/siderolabs/talos/internal/app/machined/pkg/controllers/ctest"
+	netctrl "github.com/siderolabs/talos/internal/app/machined/pkg/controllers/network"
+	configconfig "github.com/siderolabs/talos/pkg/machinery/config/config"
+	"github.com/siderolabs/talos/pkg/machinery/config/container"
+	networkcfg "github.com/siderolabs/talos/pkg/machinery/config/types/network"
+	"github.com/siderolabs/talos/pkg/machinery/config/types/v1alpha1"
+	"github.com/siderolabs/talos/pkg/machinery/nethelpers"
+	"github.com/siderolabs/talos/pkg/machinery/resources/config"
+	"github.com/siderolabs/talos/pkg/machinery/resources/network"
+)
+
+type NfTablesChainConfigTestSuite struct {
+	ctest.DefaultSuite
+}
+
+func (suite *NfTablesChainConfigTestSuite) injectConfig(block bool) {
+	kubeletIngressCfg := networkcfg.NewRuleConfigV1Alpha1()
+	kubeletIngressCfg.MetaName = "kubelet-ingress"
+	kubeletIngressCfg.PortSelector.Ports = []networkcfg.PortRange{
+		{
+			Lo: 10250,
+			Hi: 10250,
+		},
+	}
+	kubeletIngressCfg.PortSelector.Protocol = nethelpers.ProtocolTCP
+	kubeletIngressCfg.Ingress = []networkcfg.IngressRule{
+		{
+			Subnet: networkcfg.Prefix{Prefix: netip.MustParsePrefix("10.0.0.0/8")},
+			Except: networkcfg.Prefix{Prefix: netip.MustParsePrefix("10.3.0.0/16")},
+		},
+		{
+			Subnet: networkcfg.Prefix{Prefix: netip.MustParsePrefix("192.168.0.0/16")},
+		},
+	}
+
+	apidIngressCfg := networkcfg.NewRuleConfigV1Alpha1()
+	apidIngressCfg.MetaName = "apid-ingress"
+	apidIngressCfg.PortSelector.Ports = []networkcfg.PortRange{
+		{
+			Lo: 50000,
+			Hi: 50000,
+		},
+	}
+	apidIngressCfg.PortSelector.Protocol = nethelpers.ProtocolTCP
+	apidIngressCfg.Ingress = []networkcfg.IngressRule{
+		{
+			Subnet: networkcfg.Prefix{Prefix: netip.MustParsePrefix("0.0.0.0/0")},
+		},
+		{
+			Subnet: networkcfg.Prefix{Prefix: netip.MustParsePrefix("::/0")},
+		},
+	}
+
+	docs := []configconfig.Document{
+		&v1alpha1.Config{
+			ConfigVersion: "v1alpha1",
+			MachineConfig: &v1alpha1.MachineConfig{},
+		},
+		kubeletIngressCfg,
+		apidIngressCfg,
+	}
+
+	if block {
+		defaultActionCfg := networkcfg.NewDefaultActionConfigV1Alpha1()
+		defaultActionCfg.Ingress = nethelpers.DefaultActionBlock
+
+		docs = append(docs, defaultActionCfg)
+	}
+
+	cfg, err := container.New(docs...)
+	suite.Require().NoError(err)
+
+	suite.Require().NoError(suite.State().Create(suite.Ctx(), config.NewMachineConfig(cfg)))
+}
+
+func (suite *NfTablesChainConfigTestSuite) TestNoConfig() {
+	suite.Require().NoError(suite.State().Create(suite.Ctx(), config.NewMachineConfig(container.NewV1Alpha1(&v1alpha1.Config{
+		ConfigVersion: "v1alpha1",
+		MachineConfig: &v1alpha1.MachineConfig{},
+	}))))
+
+	rtestutils.AssertNoResource[*network.NfTablesChain](suite.Ctx(), suite.T(), suite.State(), netctrl.IngressChainName)
+}
+
+func (suite *NfTablesChainConfigTestSuite) TestDefaultAccept() {
+	suite.injectConfig(false)
+
+	rtestutils.AssertResources(suite.Ctx(), suite.T(), suite.State(), []resource.ID{netctrl.IngressChainName},
+		func(chain *network.NfTablesChain, asrt *assert.Assertions) {
+			spec := chain.TypedSpec()
+
+			asrt.Equal(nethelpers.ChainHookInput, spec.Hook)
+			asrt.Equal(nethelpers.ChainPriorityFilter, spec.Priority)
+			asrt.Equal(nethelpers.VerdictAccept, spec.Policy)
+
+			asrt.Equal(
+				[]network.NfTablesRule{
+					{
+						MatchSourceAddress: &network.NfTablesAddressMatch{
+							IncludeSubnets: []netip.Prefix{
+								netip.MustParsePrefix("10.0.0.0/8"),
+							},
+							ExcludeSubnets: []netip.Prefix{
+								netip.MustParsePrefix("10.3.0.0/16"),
+							},
+						},
+						MatchLayer4: &network.NfTablesLayer4Match{
+							Protocol: nethelpers.ProtocolTCP,
+							MatchDestinationPort: &network.NfTablesPortMatch{
+								Ranges: []network.PortRange{
+									{
+										Lo: 10250,
+										Hi: 10250,
+									},
+								},
+							},
+						},
+						AnonCounter: true,
+						Verdict:     nethelpers.VerdictAccept,
+					},
+					{
+						MatchSourceAddress: &network.NfTablesAddressMatch{
+							IncludeSubnets: []netip.Prefix{
+								netip.MustParsePrefix("192.168.0.0/16"),
+							},
+						},
+						MatchLayer4: &network.NfTablesLayer4Match{
+							Protocol: nethelpers.ProtocolTCP,
+							MatchDestinationPort: &network.NfTablesPortMatch{
+								Ranges: []network.PortRange{
+									{
+										Lo: 10250,
+										Hi: 10250,
+									},
+								},
+							},
+						},
+						AnonCounter: true,
+						Verdict:     nethelpers.VerdictAccept,
+					},
+					{
+						MatchSourceAddress: &network.NfTablesAddressMatch{
+							IncludeSubnets: []netip.Prefix{
+								netip.MustParsePrefix("0.0.0.0/0"),
+							},
+						},
+						MatchLayer4: &network.NfTablesLayer4Match{
+							Protocol: nethelpers.ProtocolTCP,
+							MatchDestinationPort: &network.NfTablesPortMatch{
+								Ranges: []network.PortRange{
+									{
+										Lo: 50000,
+										Hi: 50000,
+									},
+								},
+							},
+						},
+						AnonCounter: true,
+						Verdict:     nethelpers.VerdictAccept,
+					},
+					{
+						MatchSourceAddress: &network.NfTablesAddressMatch{
+							IncludeSubnets: []netip.Prefix{
+								netip.MustParsePrefix("::/0"),
+							},
+						},
+						MatchLayer4: &network.NfTablesLayer4Match{
+							Protocol: nethelpers.ProtocolTCP,
+							MatchDestinationPort: &network.NfTablesPortMatch{
+								Ranges: []network.PortRange{
+									{
+										Lo: 50000,
+										Hi: 50000,
+									},
+								},
+							},
+						},
+						AnonCounter: true,
+						Verdict:     nethelpers.VerdictAccept,
+					},
+				},
+				spec.Rules)
+		})
+}
+
+func (suite *NfTablesChainConfigTestSuite) TestDefaultBlock() {
+	suite.injectConfig(true)
+
+	rtestutils.AssertResources(suite.Ctx(), suite.T(), suite.State(), []resource.ID{netctrl.IngressChainName},
+		func(chain *network.NfTablesChain, asrt *assert.Assertions) {
+			spec := chain.TypedSpec()
+
+			asrt.Equal(nethelpers.VerdictDrop, spec.Policy)
+
+			// 5 basic rules + 4 configured rules (one per ingress subnet)
+			asrt.Len(spec.Rules, 9)
+
+			asrt.Equal(network.NfTablesRule{
+				MatchIIfName: &network.NfTablesIfNameMatch{
+					InterfaceName: "lo",
+				},
+				Verdict: nethelpers.VerdictAccept,
+			}, spec.Rules[0])
+
+			asrt.Equal(network.NfTablesRule{
+				MatchConntrackState: &network.NfTablesConntrackStateMatch{
+					States: []nethelpers.ConntrackState{
+						nethelpers.ConntrackStateEstablished,
+						nethelpers.ConntrackStateRelated,
+					},
+				},
+				Verdict: nethelpers.VerdictAccept,
+			}, spec.Rules[1])
+		})
+}
+
+func TestNfTablesChainConfig(t *testing.T) {
+	suite.Run(t, &NfTablesChainConfigTestSuite{
+		DefaultSuite: ctest.DefaultSuite{
+			Timeout: 5 * time.Second,
+			AfterSetup: func(s *ctest.DefaultSuite) {
+				s.Require().NoError(s.Runtime().RegisterController(&netctrl.NfTablesChainConfigController{}))
+			},
+		},
+	})
+}
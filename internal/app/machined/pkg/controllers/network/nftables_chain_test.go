@@ -0,0 +1,183 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package network_test
+
+import (
+	"fmt"
+	"net/netip"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/google/nftables"
+	"github.com/siderolabs/go-retry/retry"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/siderolabs/talos/internal/app/machined/pkg/controllers/ctest"
+	netctrl "github.com/siderolabs/talos/internal/app/machined/pkg/controllers/network"
+	"github.com/siderolabs/talos/pkg/machinery/nethelpers"
+	"github.com/siderolabs/talos/pkg/machinery/resources/network"
+)
+
+const testTableName = "talos_test"
+
+type NfTablesChainSuite struct {
+	ctest.DefaultSuite
+}
+
+func (suite *NfTablesChainSuite) assertChain(name string, expectedRules int) {
+	suite.Assert().NoError(retry.Constant(5*time.Second, retry.WithUnits(100*time.Millisecond)).Retry(func() error {
+		conn := &nftables.Conn{}
+
+		chains, err := conn.ListChainsOfTableFamily(nftables.TableFamilyINet)
+		if err != nil {
+			return err
+		}
+
+		for _, chain := range chains {
+			if chain.Table.Name != testTableName || chain.Name != name {
+				continue
+			}
+
+			rules, err := conn.GetRules(chain.Table, chain)
+			if err != nil {
+				return err
+			}
+
+			if len(rules) != expectedRules {
+				return retry.ExpectedError(fmt.Errorf("expected %d rules, got %d", expectedRules, len(rules)))
+			}
+
+			return nil
+		}
+
+		return retry.ExpectedError(fmt.Errorf("chain %q not found", name))
+	}))
+}
+
+func (suite *NfTablesChainSuite) assertNoTable() {
+	suite.Assert().NoError(retry.Constant(5*time.Second, retry.WithUnits(100*time.Millisecond)).Retry(func() error {
+		conn := &nftables.Conn{}
+
+		tables, err := conn.ListTables()
+		if err != nil {
+			return err
+		}
+
+		for _, table := range tables {
+			if table.Name == testTableName {
+				return retry.ExpectedError(fmt.Errorf("table %q still exists", testTableName))
+			}
+		}
+
+		return nil
+	}))
+}
+
+func (suite *NfTablesChainSuite) TestAcceptLo() {
+	chain := network.NewNfTablesChain(network.NamespaceName, "test1")
+	chain.TypedSpec().Hook = nethelpers.ChainHookInput
+	chain.TypedSpec().Priority = nethelpers.ChainPriorityFilter
+	chain.TypedSpec().Policy = nethelpers.VerdictAccept
+	chain.TypedSpec().Rules = []network.NfTablesRule{
+		{
+			MatchIIfName: &network.NfTablesIfNameMatch{
+				InterfaceName: "lo",
+			},
+			Verdict: nethelpers.VerdictAccept,
+		},
+	}
+
+	suite.Require().NoError(suite.State().Create(suite.Ctx(), chain))
+
+	suite.assertChain("test1", 1)
+
+	suite.Require().NoError(suite.State().Destroy(suite.Ctx(), chain.Metadata()))
+
+	suite.assertNoTable()
+}
+
+func (suite *NfTablesChainSuite) TestIngressRules() {
+	chain := network.NewNfTablesChain(network.NamespaceName, "test1")
+	chain.TypedSpec().Hook = nethelpers.ChainHookInput
+	chain.TypedSpec().Priority = nethelpers.ChainPriorityFilter
+	chain.TypedSpec().Policy = nethelpers.VerdictAccept
+	chain.TypedSpec().Rules = []network.NfTablesRule{
+		{
+			MatchConntrackState: &network.NfTablesConntrackStateMatch{
+				States: []nethelpers.ConntrackState{
+					nethelpers.ConntrackStateEstablished,
+					nethelpers.ConntrackStateRelated,
+				},
+			},
+			Verdict: nethelpers.VerdictAccept,
+		},
+		{
+			MatchSourceAddress: &network.NfTablesAddressMatch{
+				IncludeSubnets: []netip.Prefix{
+					netip.MustParsePrefix("10.0.0.0/8"),
+					netip.MustParsePrefix("2001:db8::/32"),
+				},
+				ExcludeSubnets: []netip.Prefix{
+					netip.MustParsePrefix("10.3.0.0/16"),
+				},
+			},
+			MatchLayer4: &network.NfTablesLayer4Match{
+				Protocol: nethelpers.ProtocolTCP,
+				MatchDestinationPort: &network.NfTablesPortMatch{
+					Ranges: []network.PortRange{
+						{
+							Lo: 10250,
+							Hi: 10250,
+						},
+						{
+							Lo: 30000,
+							Hi: 32767,
+						},
+					},
+				},
+			},
+			AnonCounter: true,
+			Verdict:     nethelpers.VerdictAccept,
+		},
+	}
+
+	suite.Require().NoError(suite.State().Create(suite.Ctx(), chain))
+
+	// 1 conntrack rule + 2 subnets x 2 port ranges
+	suite.assertChain("test1", 5)
+
+	chain2 := network.NewNfTablesChain(network.NamespaceName, "test2")
+	chain2.TypedSpec().Hook = nethelpers.ChainHookOutput
+	chain2.TypedSpec().Priority = nethelpers.ChainPriorityFilter
+	chain2.TypedSpec().Policy = nethelpers.VerdictAccept
+
+	suite.Require().NoError(suite.State().Create(suite.Ctx(), chain2))
+
+	suite.assertChain("test1", 5)
+	suite.assertChain("test2", 0)
+
+	suite.Require().NoError(suite.State().Destroy(suite.Ctx(), chain.Metadata()))
+	suite.Require().NoError(suite.State().Destroy(suite.Ctx(), chain2.Metadata()))
+
+	suite.assertNoTable()
+}
+
+func TestNfTablesChainSuite(t *testing.T) {
+	if os.Geteuid() != 0 {
+		t.Skip("requires root")
+	}
+
+	suite.Run(t, &NfTablesChainSuite{
+		DefaultSuite: ctest.DefaultSuite{
+			Timeout: 15 * time.Second,
+			AfterSetup: func(s *ctest.DefaultSuite) {
+				s.Require().NoError(s.Runtime().RegisterController(&netctrl.NfTablesChainController{
+					TableName: testTableName,
+				}))
+			},
+		},
+	})
+}
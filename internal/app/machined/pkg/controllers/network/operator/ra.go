@@ -0,0 +1,754 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package operator
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"net"
+	"net/netip"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"go.uber.org/zap"
+	"golang.org/x/net/icmp"
+	"golang.org/x/net/ipv6"
+
+	"github.com/siderolabs/talos/pkg/machinery/nethelpers"
+	"github.com/siderolabs/talos/pkg/machinery/resources/network"
+)
+
+// Router solicitation parameters, see RFC 4861, section 10.
+const (
+	raMaxRouterSolicitations     = 3
+	raRouterSolicitationInterval = 4 * time.Second
+)
+
+// raExpireInterval is the interval to check for expired routers, prefixes and DNS servers.
+const raExpireInterval = 5 * time.Second
+
+// raInfiniteLifetime is the lifetime value which represents infinity.
+const raInfiniteLifetime = 0xffffffff
+
+// RA implements the IPv6 router advertisement network operator.
+//
+// Router advertisements are processed by the operator instead of the kernel, so that
+// the addresses (SLAAC), default routes and DNS servers (RDNSS) learned from the router advertisements
+// are merged with the other configuration layers.
+type RA struct {
+	logger *zap.Logger
+
+	linkName string
+	spec     network.RAOperatorSpec
+
+	mu           sync.Mutex
+	hardwareAddr net.HardwareAddr
+	routers      map[netip.Addr]time.Time
+	prefixes     map[netip.Prefix]raPrefixState
+	dnsServers   map[netip.Addr]time.Time
+	randomIDs    map[netip.Prefix][8]byte
+}
+
+type raPrefixState struct {
+	validUntil time.Time
+	onLink     bool
+	autonomous bool
+}
+
+// NewRA creates IPv6 router advertisement operator.
+func NewRA(logger *zap.Logger, linkName string, spec network.RAOperatorSpec) *RA {
+	return &RA{
+		logger:     logger,
+		linkName:   linkName,
+		spec:       spec,
+		routers:    map[netip.Addr]time.Time{},
+		prefixes:   map[netip.Prefix]raPrefixState{},
+		dnsServers: map[netip.Addr]time.Time{},
+		randomIDs:  map[netip.Prefix][8]byte{},
+	}
+}
+
+// Prefix returns unique operator prefix which gets prepended to each spec.
+func (ra *RA) Prefix() string {
+	return fmt.Sprintf("ra/%s", ra.linkName)
+}
+
+// Run the operator loop.
+//
+//nolint:gocyclo,cyclop
+func (ra *RA) Run(ctx context.Context, notifyCh chan<- struct{}) {
+	restore := ra.configureKernel()
+	defer restore()
+
+	if !ra.spec.AcceptRA {
+		<-ctx.Done()
+
+		return
+	}
+
+	iface, err := net.InterfaceByName(ra.linkName)
+	if err != nil {
+		ra.logger.Warn("link not found", zap.String("link", ra.linkName))
+
+		return
+	}
+
+	ra.mu.Lock()
+	ra.hardwareAddr = iface.HardwareAddr
+	ra.mu.Unlock()
+
+	conn, err := ra.listen()
+	if err != nil {
+		ra.logger.Warn("error listening for router advertisements", zap.Error(err), zap.String("link", ra.linkName))
+
+		return
+	}
+
+	defer conn.Close() //nolint:errcheck
+
+	advertCh := make(chan *routerAdvertisement)
+
+	go ra.receive(ctx, conn, iface, advertCh)
+
+	solicitTicker := time.NewTicker(raRouterSolicitationInterval)
+	defer solicitTicker.Stop()
+
+	expireTicker := time.NewTicker(raExpireInterval)
+	defer expireTicker.Stop()
+
+	solicitations := 0
+
+	for {
+		if solicitations < raMaxRouterSolicitations {
+			if err = ra.solicit(conn, iface); err != nil {
+				ra.logger.Warn("error sending router solicitation", zap.Error(err), zap.String("link", ra.linkName))
+			}
+
+			solicitations++
+		}
+
+		var changed bool
+
+		select {
+		case <-ctx.Done():
+			return
+		case advert := <-advertCh:
+			// stop soliciting once a router advertisement is received
+			solicitations = raMaxRouterSolicitations
+
+			ra.handleAdvertisement(advert, time.Now())
+
+			changed = true
+		case <-solicitTicker.C:
+		case <-expireTicker.C:
+			changed = ra.expire(time.Now())
+		}
+
+		if changed {
+			select {
+			case notifyCh <- struct{}{}:
+			case <-ctx.Done():
+				return
+			}
+		}
+	}
+}
+
+// AddressSpecs implements Operator interface.
+func (ra *RA) AddressSpecs() []network.AddressSpecSpec {
+	ra.mu.Lock()
+	defer ra.mu.Unlock()
+
+	flags := nethelpers.AddressFlags(nethelpers.AddressPermanent)
+
+	if ra.spec.PrivacyExtensions {
+		// kernel manages temporary addresses for the address
+		flags |= nethelpers.AddressFlags(nethelpers.AddressManagementTemp)
+	}
+
+	var addresses []network.AddressSpecSpec
+
+	for _, prefix := range ra.autoconfPrefixes() {
+		addr, ok := ra.address(prefix)
+		if !ok {
+			continue
+		}
+
+		addresses = append(addresses, network.AddressSpecSpec{
+			Address:     netip.PrefixFrom(addr, prefix.Bits()),
+			LinkName:    ra.linkName,
+			Family:      nethelpers.FamilyInet6,
+			Scope:       nethelpers.ScopeGlobal,
+			Flags:       flags,
+			ConfigLayer: network.ConfigOperator,
+		})
+	}
+
+	return addresses
+}
+
+// LinkSpecs implements Operator interface.
+func (ra *RA) LinkSpecs() []network.LinkSpecSpec {
+	return nil
+}
+
+// RouteSpecs implements Operator interface.
+func (ra *RA) RouteSpecs() []network.RouteSpecSpec {
+	ra.mu.Lock()
+	defer ra.mu.Unlock()
+
+	var routes []network.RouteSpecSpec
+
+	for _, router := range sortedAddrs(ra.routers) {
+		routes = append(routes, network.RouteSpecSpec{
+			Family:      nethelpers.FamilyInet6,
+			Gateway:     router,
+			OutLinkName: ra.linkName,
+			Table:       nethelpers.TableMain,
+			Priority:    ra.spec.RouteMetric,
+			Scope:       nethelpers.ScopeGlobal,
+			Type:        nethelpers.TypeUnicast,
+			Protocol:    nethelpers.ProtocolRA,
+			ConfigLayer: network.ConfigOperator,
+		})
+	}
+
+	autoconf := map[netip.Prefix]struct{}{}
+
+	for _, prefix := range ra.autoconfPrefixes() {
+		autoconf[prefix] = struct{}{}
+	}
+
+	for _, prefix := range sortedPrefixes(ra.prefixes) {
+		// the kernel adds the prefix route for the autoconfigured addresses
+		if _, ok := autoconf[prefix]; ok || !ra.prefixes[prefix].onLink {
+			continue
+		}
+
+		routes = append(routes, network.RouteSpecSpec{
+			Family:      nethelpers.FamilyInet6,
+			Destination: prefix,
+			OutLinkName: ra.linkName,
+			Table:       nethelpers.TableMain,
+			Priority:    ra.spec.RouteMetric,
+			Scope:       nethelpers.ScopeLink,
+			Type:        nethelpers.TypeUnicast,
+			Protocol:    nethelpers.ProtocolRA,
+			ConfigLayer: network.ConfigOperator,
+		})
+	}
+
+	for i := range routes {
+		routes[i].Normalize()
+	}
+
+	return routes
+}
+
+// HostnameSpecs implements Operator interface.
+func (ra *RA) HostnameSpecs() []network.HostnameSpecSpec {
+	return nil
+}
+
+// ResolverSpecs implements Operator interface.
+func (ra *RA) ResolverSpecs() []network.ResolverSpecSpec {
+	ra.mu.Lock()
+	defer ra.mu.Unlock()
+
+	if len(ra.dnsServers) == 0 {
+		return nil
+	}
+
+	return []network.ResolverSpecSpec{
+		{
+			DNSServers:  sortedAddrs(ra.dnsServers),
+			ConfigLayer: network.ConfigOperator,
+		},
+	}
+}
+
+// TimeServerSpecs implements Operator interface.
+func (ra *RA) TimeServerSpecs() []network.TimeServerSpecSpec {
+	return nil
+}
+
+// configureKernel disables router advertisement processing in the kernel for the link
+// and applies the address generation settings.
+//
+// The returned function restores the previous values.
+func (ra *RA) configureKernel() func() {
+	useTempAddr := "0"
+
+	if ra.spec.PrivacyExtensions {
+		// generate temporary addresses and prefer them
+		useTempAddr = "2"
+	}
+
+	settings := []struct {
+		key   string
+		value string
+	}{
+		{"accept_ra", "0"},
+		{"autoconf", "0"},
+		{"use_tempaddr", useTempAddr},
+		{"addr_gen_mode", strconv.Itoa(int(ra.spec.AddrGenMode))},
+	}
+
+	previous := map[string]string{}
+
+	for _, setting := range settings {
+		path := filepath.Join("/proc/sys/net/ipv6/conf", ra.linkName, setting.key)
+
+		value, err := os.ReadFile(path)
+		if err != nil {
+			ra.logger.Warn("error reading IPv6 setting", zap.String("link", ra.linkName), zap.String("key", setting.key), zap.Error(err))
+
+			continue
+		}
+
+		if err = os.WriteFile(path, []byte(setting.value), 0o644); err != nil {
+			ra.logger.Warn("error applying IPv6 setting", zap.String("link", ra.linkName), zap.String("key", setting.key), zap.Error(err))
+
+			continue
+		}
+
+		previous[path] = strings.TrimSpace(string(value))
+	}
+
+	return func() {
+		for path, value := range previous {
+			if err := os.WriteFile(path, []byte(value), 0o644); err != nil && !errors.Is(err, os.ErrNotExist) {
+				ra.logger.Warn("error restoring IPv6 setting", zap.String("path", path), zap.Error(err))
+			}
+		}
+	}
+}
+
+func (ra *RA) listen() (*ipv6.PacketConn, error) {
+	conn, err := icmp.ListenPacket("ip6:ipv6-icmp", "::")
+	if err != nil {
+		return nil, err
+	}
+
+	pc := conn.IPv6PacketConn()
+
+	var filter ipv6.ICMPFilter
+
+	filter.SetAll(true)
+	filter.Accept(ipv6.ICMPTypeRouterAdvertisement)
+
+	if err = pc.SetICMPFilter(&filter); err != nil {
+		conn.Close() //nolint:errcheck
+
+		return nil, err
+	}
+
+	if err = pc.SetControlMessage(ipv6.FlagHopLimit|ipv6.FlagInterface, true); err != nil {
+		conn.Close() //nolint:errcheck
+
+		return nil, err
+	}
+
+	if err = pc.SetMulticastHopLimit(255); err != nil {
+		conn.Close() //nolint:errcheck
+
+		return nil, err
+	}
+
+	return pc, nil
+}
+
+func (ra *RA) receive(ctx context.Context, conn *ipv6.PacketConn, iface *net.Interface, advertCh chan<- *routerAdvertisement) {
+	buf := make([]byte, iface.MTU)
+
+	for {
+		n, cm, src, err := conn.ReadFrom(buf)
+		if err != nil {
+			if ctx.Err() == nil {
+				ra.logger.Warn("error receiving router advertisement", zap.Error(err), zap.String("link", ra.linkName))
+			}
+
+			return
+		}
+
+		// see RFC 4861, section 6.1.2
+		if cm == nil || cm.IfIndex != iface.Index || cm.HopLimit != 255 {
+			continue
+		}
+
+		srcAddr, ok := src.(*net.IPAddr)
+		if !ok || !srcAddr.IP.IsLinkLocalUnicast() {
+			continue
+		}
+
+		msg, err := icmp.ParseMessage(ipv6.ICMPTypeRouterAdvertisement.Protocol(), buf[:n])
+		if err != nil || msg.Type != ipv6.ICMPTypeRouterAdvertisement || msg.Code != 0 {
+			continue
+		}
+
+		body, ok := msg.Body.(*icmp.RawBody)
+		if !ok {
+			continue
+		}
+
+		router, _ := netip.AddrFromSlice(srcAddr.IP)
+
+		advert, err := parseRouterAdvertisement(router, body.Data)
+		if err != nil {
+			ra.logger.Debug("ignored invalid router advertisement", zap.Error(err), zap.String("link", ra.linkName))
+
+			continue
+		}
+
+		select {
+		case advertCh <- advert:
+		case <-ctx.Done():
+			return
+		}
+	}
+}
+
+func (ra *RA) solicit(conn *ipv6.PacketConn, iface *net.Interface) error {
+	// reserved field
+	body := make([]byte, 4)
+
+	if len(iface.HardwareAddr) > 0 {
+		// source link-layer address option
+		body = append(body, 1, byte((len(iface.HardwareAddr)+2+7)/8))
+		body = append(body, iface.HardwareAddr...)
+
+		for len(body)%8 != 4 {
+			body = append(body, 0)
+		}
+	}
+
+	msg, err := (&icmp.Message{
+		Type: ipv6.ICMPTypeRouterSolicitation,
+		Body: &icmp.RawBody{Data: body},
+	}).Marshal(nil)
+	if err != nil {
+		return err
+	}
+
+	_, err = conn.WriteTo(msg, &ipv6.ControlMessage{HopLimit: 255, IfIndex: iface.Index}, &net.IPAddr{
+		IP:   net.ParseIP("ff02::2"),
+		Zone: iface.Name,
+	})
+
+	return err
+}
+
+func (ra *RA) handleAdvertisement(advert *routerAdvertisement, now time.Time) {
+	ra.mu.Lock()
+	defer ra.mu.Unlock()
+
+	ra.logger.Debug("router advertisement", zap.String("link", ra.linkName), zap.Stringer("router", advert.router))
+
+	if advert.routerLifetime > 0 {
+		ra.routers[advert.router] = now.Add(advert.routerLifetime)
+	} else {
+		delete(ra.routers, advert.router)
+	}
+
+	for _, prefix := range advert.prefixes {
+		// see RFC 4862, section 5.5.3
+		if prefix.prefix.Addr().IsLinkLocalUnicast() || prefix.preferredLifetime > prefix.validLifetime {
+			continue
+		}
+
+		validUntil := lifetimeUntil(now, prefix.validLifetime)
+
+		existing, ok := ra.prefixes[prefix.prefix]
+		if !ok {
+			if prefix.validLifetime == 0 {
+				continue
+			}
+		} else {
+			validUntil = updateValidLifetime(now, existing.validUntil, validUntil)
+		}
+
+		ra.prefixes[prefix.prefix] = raPrefixState{
+			validUntil: validUntil,
+			onLink:     prefix.onLink || existing.onLink,
+			autonomous: prefix.autonomous || existing.autonomous,
+		}
+	}
+
+	for _, dns := range advert.dnsServers {
+		for _, server := range dns.servers {
+			if dns.lifetime == 0 {
+				delete(ra.dnsServers, server)
+			} else {
+				ra.dnsServers[server] = lifetimeUntil(now, dns.lifetime)
+			}
+		}
+	}
+
+	ra.expireLocked(now)
+}
+
+func (ra *RA) expire(now time.Time) bool {
+	ra.mu.Lock()
+	defer ra.mu.Unlock()
+
+	return ra.expireLocked(now)
+}
+
+func (ra *RA) expireLocked(now time.Time) bool {
+	changed := false
+
+	for router, until := range ra.routers {
+		if !now.Before(until) {
+			delete(ra.routers, router)
+
+			changed = true
+		}
+	}
+
+	for prefix, state := range ra.prefixes {
+		if !now.Before(state.validUntil) {
+			delete(ra.prefixes, prefix)
+			delete(ra.randomIDs, prefix)
+
+			changed = true
+		}
+	}
+
+	for server, until := range ra.dnsServers {
+		if !now.Before(until) {
+			delete(ra.dnsServers, server)
+
+			changed = true
+		}
+	}
+
+	return changed
+}
+
+// autoconfPrefixes returns the prefixes which are used for address autoconfiguration.
+func (ra *RA) autoconfPrefixes() []netip.Prefix {
+	if !ra.spec.Autoconf || ra.spec.AddrGenMode == nethelpers.AddrGenModeNone {
+		return nil
+	}
+
+	var prefixes []netip.Prefix
+
+	for _, prefix := range sortedPrefixes(ra.prefixes) {
+		// only /64 prefixes are supported, as the interface identifier is 64 bits long
+		if ra.prefixes[prefix].autonomous && prefix.Bits() == 64 {
+			prefixes = append(prefixes, prefix)
+		}
+	}
+
+	return prefixes
+}
+
+// address builds the address for the prefix based on the address generation mode.
+func (ra *RA) address(prefix netip.Prefix) (netip.Addr, bool) {
+	var interfaceID [8]byte
+
+	switch ra.spec.AddrGenMode {
+	case nethelpers.AddrGenModeEUI64:
+		if len(ra.hardwareAddr) != 6 {
+			return netip.Addr{}, false
+		}
+
+		interfaceID = [8]byte{
+			ra.hardwareAddr[0] ^ 0x02, ra.hardwareAddr[1], ra.hardwareAddr[2], 0xff,
+			0xfe, ra.hardwareAddr[3], ra.hardwareAddr[4], ra.hardwareAddr[5],
+		}
+	case nethelpers.AddrGenModeStablePrivacy:
+		// RFC 7217: the interface identifier is stable for the prefix and the link,
+		// but it doesn't expose the hardware address
+		prefixBytes := prefix.Addr().As16()
+
+		hash := sha256.New()
+		hash.Write(prefixBytes[:8])     //nolint:errcheck
+		hash.Write([]byte(ra.linkName)) //nolint:errcheck
+		hash.Write(ra.hardwareAddr)     //nolint:errcheck
+		hash.Write(ra.stableSecret())   //nolint:errcheck
+
+		copy(interfaceID[:], hash.Sum(nil))
+	case nethelpers.AddrGenModeRandom:
+		var ok bool
+
+		if interfaceID, ok = ra.randomIDs[prefix]; !ok {
+			if _, err := rand.Read(interfaceID[:]); err != nil {
+				return netip.Addr{}, false
+			}
+
+			ra.randomIDs[prefix] = interfaceID
+		}
+	case nethelpers.AddrGenModeNone:
+		return netip.Addr{}, false
+	}
+
+	addr := prefix.Addr().As16()
+	copy(addr[8:], interfaceID[:])
+
+	return netip.AddrFrom16(addr), true
+}
+
+// stableSecret returns the stable secret configured for the link in the kernel, if any.
+func (ra *RA) stableSecret() []byte {
+	secret, err := os.ReadFile(filepath.Join("/proc/sys/net/ipv6/conf", ra.linkName, "stable_secret"))
+	if err != nil {
+		return nil
+	}
+
+	return secret
+}
+
+// routerAdvertisement is a parsed Router Advertisement message.
+type routerAdvertisement struct {
+	router         netip.Addr
+	routerLifetime time.Duration
+
+	prefixes   []raPrefix
+	dnsServers []raDNSServers
+}
+
+type raPrefix struct {
+	prefix            netip.Prefix
+	onLink            bool
+	autonomous        bool
+	validLifetime     uint32
+	preferredLifetime uint32
+}
+
+type raDNSServers struct {
+	servers  []netip.Addr
+	lifetime uint32
+}
+
+// Router advertisement options, see RFC 4861, section 4.6 and RFC 8106, section 5.1.
+const (
+	raOptionPrefixInformation = 3
+	raOptionRDNSS             = 25
+)
+
+// parseRouterAdvertisement parses the Router Advertisement message body (following the ICMP header).
+//
+// See RFC 4861, section 4.2.
+func parseRouterAdvertisement(router netip.Addr, b []byte) (*routerAdvertisement, error) {
+	if len(b) < 12 {
+		return nil, fmt.Errorf("message too short: %d bytes", len(b))
+	}
+
+	advert := &routerAdvertisement{
+		router:         router,
+		routerLifetime: time.Duration(binary.BigEndian.Uint16(b[2:4])) * time.Second,
+	}
+
+	for options := b[12:]; len(options) > 0; {
+		if len(options) < 2 {
+			return nil, errors.New("option too short")
+		}
+
+		length := int(options[1]) * 8
+		if length == 0 || length > len(options) {
+			return nil, fmt.Errorf("invalid option length %d", length)
+		}
+
+		option := options[:length]
+		options = options[length:]
+
+		switch option[0] {
+		case raOptionPrefixInformation:
+			if length != 32 {
+				return nil, fmt.Errorf("invalid prefix information option length %d", length)
+			}
+
+			addr := netip.AddrFrom16([16]byte(option[16:32]))
+
+			prefix, err := addr.Prefix(int(option[2]))
+			if err != nil {
+				return nil, err
+			}
+
+			advert.prefixes = append(advert.prefixes, raPrefix{
+				prefix:            prefix,
+				onLink:            option[3]&0x80 != 0,
+				autonomous:        option[3]&0x40 != 0,
+				validLifetime:     binary.BigEndian.Uint32(option[4:8]),
+				preferredLifetime: binary.BigEndian.Uint32(option[8:12]),
+			})
+		case raOptionRDNSS:
+			if length < 24 || (length-8)%16 != 0 {
+				return nil, fmt.Errorf("invalid RDNSS option length %d", length)
+			}
+
+			dns := raDNSServers{
+				lifetime: binary.BigEndian.Uint32(option[4:8]),
+			}
+
+			for i := 8; i < length; i += 16 {
+				dns.servers = append(dns.servers, netip.AddrFrom16([16]byte(option[i:i+16])))
+			}
+
+			advert.dnsServers = append(advert.dnsServers, dns)
+		}
+	}
+
+	return advert, nil
+}
+
+func lifetimeUntil(now time.Time, lifetime uint32) time.Time {
+	if lifetime == raInfiniteLifetime {
+		// effectively never expires
+		return now.AddDate(100, 0, 0)
+	}
+
+	return now.Add(time.Duration(lifetime) * time.Second)
+}
+
+// updateValidLifetime implements the "two hours" rule for the unauthenticated router advertisements.
+//
+// See RFC 4862, section 5.5.3 (e).
+func updateValidLifetime(now, existing, received time.Time) time.Time {
+	const twoHours = 2 * time.Hour
+
+	switch {
+	case received.Sub(now) > twoHours || received.After(existing):
+		return received
+	case existing.Sub(now) <= twoHours:
+		return existing
+	default:
+		return now.Add(twoHours)
+	}
+}
+
+func sortedAddrs(m map[netip.Addr]time.Time) []netip.Addr {
+	addrs := make([]netip.Addr, 0, len(m))
+
+	for addr := range m {
+		addrs = append(addrs, addr)
+	}
+
+	sort.Slice(addrs, func(i, j int) bool { return addrs[i].Less(addrs[j]) })
+
+	return addrs
+}
+
+func sortedPrefixes(m map[netip.Prefix]raPrefixState) []netip.Prefix {
+	prefixes := make([]netip.Prefix, 0, len(m))
+
+	for prefix := range m {
+		prefixes = append(prefixes, prefix)
+	}
+
+	sort.Slice(prefixes, func(i, j int) bool { return prefixes[i].Addr().Less(prefixes[j].Addr()) })
+
+	return prefixes
+}
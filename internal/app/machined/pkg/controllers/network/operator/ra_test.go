@@ -0,0 +1,209 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package operator
+
+import (
+	"encoding/binary"
+	"net"
+	"net/netip"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap/zaptest"
+
+	"github.com/siderolabs/talos/pkg/machinery/nethelpers"
+	"github.com/siderolabs/talos/pkg/machinery/resources/network"
+)
+
+func buildRouterAdvertisement(routerLifetime uint16, prefix netip.Prefix, flags byte, validLifetime, preferredLifetime uint32, dnsLifetime uint32, dnsServers ...netip.Addr) []byte {
+	b := make([]byte, 12)
+	b[0] = 64 // cur hop limit
+	binary.BigEndian.PutUint16(b[2:4], routerLifetime)
+
+	// prefix information option
+	option := make([]byte, 32)
+	option[0] = raOptionPrefixInformation
+	option[1] = 4
+	option[2] = byte(prefix.Bits())
+	option[3] = flags
+	binary.BigEndian.PutUint32(option[4:8], validLifetime)
+	binary.BigEndian.PutUint32(option[8:12], preferredLifetime)
+	addr := prefix.Addr().As16()
+	copy(option[16:], addr[:])
+
+	b = append(b, option...)
+
+	if len(dnsServers) > 0 {
+		option = make([]byte, 8)
+		option[0] = raOptionRDNSS
+		option[1] = byte(1 + 2*len(dnsServers))
+		binary.BigEndian.PutUint32(option[4:8], dnsLifetime)
+
+		for _, server := range dnsServers {
+			addr := server.As16()
+			option = append(option, addr[:]...)
+		}
+
+		b = append(b, option...)
+	}
+
+	return b
+}
+
+func TestParseRouterAdvertisement(t *testing.T) {
+	router := netip.MustParseAddr("fe80::1")
+
+	advert, err := parseRouterAdvertisement(router, buildRouterAdvertisement(
+		1800,
+		netip.MustParsePrefix("2001:db8:1::/64"), 0xc0, 86400, 14400,
+		600, netip.MustParseAddr("2001:db8::53"), netip.MustParseAddr("2001:db8::54"),
+	))
+	require.NoError(t, err)
+
+	assert.Equal(t, &routerAdvertisement{
+		router:         router,
+		routerLifetime: 30 * time.Minute,
+		prefixes: []raPrefix{
+			{
+				prefix:            netip.MustParsePrefix("2001:db8:1::/64"),
+				onLink:            true,
+				autonomous:        true,
+				validLifetime:     86400,
+				preferredLifetime: 14400,
+			},
+		},
+		dnsServers: []raDNSServers{
+			{
+				servers:  []netip.Addr{netip.MustParseAddr("2001:db8::53"), netip.MustParseAddr("2001:db8::54")},
+				lifetime: 600,
+			},
+		},
+	}, advert)
+
+	_, err = parseRouterAdvertisement(router, []byte{64, 0, 0})
+	require.Error(t, err)
+
+	// zero option length
+	_, err = parseRouterAdvertisement(router, append(make([]byte, 12), raOptionRDNSS, 0))
+	require.Error(t, err)
+}
+
+func TestRAHandleAdvertisement(t *testing.T) {
+	ra := NewRA(zaptest.NewLogger(t), "eth0", network.RAOperatorSpec{
+		AcceptRA:    true,
+		Autoconf:    true,
+		AddrGenMode: nethelpers.AddrGenModeEUI64,
+		RouteMetric: 1024,
+	})
+
+	ra.hardwareAddr = net.HardwareAddr{0x52, 0x54, 0x00, 0x12, 0x34, 0x56}
+
+	router := netip.MustParseAddr("fe80::1")
+	now := time.Now()
+
+	advert, err := parseRouterAdvertisement(router, buildRouterAdvertisement(
+		1800,
+		netip.MustParsePrefix("2001:db8:1::/64"), 0xc0, 86400, 14400,
+		600, netip.MustParseAddr("2001:db8::53"),
+	))
+	require.NoError(t, err)
+
+	ra.handleAdvertisement(advert, now)
+
+	assert.Equal(t, []network.AddressSpecSpec{
+		{
+			Address:     netip.MustParsePrefix("2001:db8:1::5054:ff:fe12:3456/64"),
+			LinkName:    "eth0",
+			Family:      nethelpers.FamilyInet6,
+			Scope:       nethelpers.ScopeGlobal,
+			Flags:       nethelpers.AddressFlags(nethelpers.AddressPermanent),
+			ConfigLayer: network.ConfigOperator,
+		},
+	}, ra.AddressSpecs())
+
+	routes := ra.RouteSpecs()
+	require.Len(t, routes, 1)
+	assert.Equal(t, router, routes[0].Gateway)
+	assert.Equal(t, uint32(1024), routes[0].Priority)
+	assert.Equal(t, nethelpers.ProtocolRA, routes[0].Protocol)
+	assert.Equal(t, network.ConfigOperator, routes[0].ConfigLayer)
+
+	assert.Equal(t, []network.ResolverSpecSpec{
+		{
+			DNSServers:  []netip.Addr{netip.MustParseAddr("2001:db8::53")},
+			ConfigLayer: network.ConfigOperator,
+		},
+	}, ra.ResolverSpecs())
+
+	// DNS servers expire first
+	assert.True(t, ra.expire(now.Add(time.Hour)))
+	assert.Empty(t, ra.ResolverSpecs())
+	assert.Len(t, ra.AddressSpecs(), 1)
+	assert.Empty(t, ra.RouteSpecs())
+
+	// the valid lifetime can't be reduced below two hours
+	advert, err = parseRouterAdvertisement(router, buildRouterAdvertisement(
+		0,
+		netip.MustParsePrefix("2001:db8:1::/64"), 0xc0, 0, 0,
+		0,
+	))
+	require.NoError(t, err)
+
+	ra.handleAdvertisement(advert, now.Add(time.Hour))
+
+	assert.False(t, ra.expire(now.Add(2*time.Hour)))
+	assert.Len(t, ra.AddressSpecs(), 1)
+
+	assert.True(t, ra.expire(now.Add(4*time.Hour)))
+	assert.Empty(t, ra.AddressSpecs())
+}
+
+func TestRAAddrGenMode(t *testing.T) {
+	prefix := netip.MustParsePrefix("2001:db8:1::/64")
+
+	for _, test := range []struct {
+		mode nethelpers.AddrGenMode
+
+		expectedAddress string
+	}{
+		{
+			mode:            nethelpers.AddrGenModeEUI64,
+			expectedAddress: "2001:db8:1:0:5054:ff:fe12:3456",
+		},
+		{
+			mode: nethelpers.AddrGenModeStablePrivacy,
+		},
+		{
+			mode: nethelpers.AddrGenModeRandom,
+		},
+	} {
+		test := test
+
+		t.Run(test.mode.String(), func(t *testing.T) {
+			ra := NewRA(zaptest.NewLogger(t), "eth0", network.RAOperatorSpec{
+				AddrGenMode: test.mode,
+			})
+
+			ra.hardwareAddr = net.HardwareAddr{0x52, 0x54, 0x00, 0x12, 0x34, 0x56}
+
+			addr, ok := ra.address(prefix)
+			require.True(t, ok)
+
+			assert.True(t, prefix.Contains(addr))
+
+			if test.expectedAddress != "" {
+				assert.Equal(t, test.expectedAddress, addr.String())
+			}
+
+			// the address is stable for the prefix
+			addr2, ok := ra.address(prefix)
+			require.True(t, ok)
+
+			assert.Equal(t, addr, addr2)
+		})
+	}
+}
@@ -55,6 +55,8 @@ func NewVIP(logger *zap.Logger, linkName string, spec network.VIPOperatorSpec, s
 		handler = vip.NewEquinixMetalHandler(logger, spec.IP.String(), spec.EquinixMetal)
 	case spec.HCloud != network.VIPHCloudSpec{}:
 		handler = vip.NewHCloudHandler(logger, spec.IP.String(), spec.HCloud)
+	case spec.BGP != nil:
+		handler = vip.NewBGPHandler(logger, spec.IP, *spec.BGP)
 	default:
 		handler = vip.NopHandler{}
 	}
@@ -0,0 +1,579 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package vip
+
+import (
+	"context"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"net/netip"
+	"strconv"
+	"sync"
+	"syscall"
+	"time"
+	"unsafe"
+
+	"go.uber.org/zap"
+	"golang.org/x/sys/unix"
+
+	"github.com/siderolabs/talos/pkg/machinery/resources/network"
+)
+
+// BGP protocol constants, see RFC 4271, RFC 4760 and RFC 6793.
+const (
+	bgpPort    = 179
+	bgpVersion = 4
+
+	bgpHeaderLen     = 19
+	bgpMaxMessageLen = 4096
+
+	bgpMsgOpen         = 1
+	bgpMsgUpdate       = 2
+	bgpMsgNotification = 3
+	bgpMsgKeepalive    = 4
+
+	bgpOptParamCapabilities = 2
+
+	bgpCapMultiprotocol = 1
+	bgpCapFourOctetAS   = 65
+
+	bgpAttrFlagOptional   = 0x80
+	bgpAttrFlagTransitive = 0x40
+
+	bgpAttrOrigin      = 1
+	bgpAttrASPath      = 2
+	bgpAttrNextHop     = 3
+	bgpAttrLocalPref   = 5
+	bgpAttrMPReachNLRI = 14
+	bgpAttrMPUnreach   = 15
+
+	bgpOriginIGP     = 0
+	bgpASPathSegment = 2 // AS_SEQUENCE
+
+	bgpAFIIPv4     = 1
+	bgpAFIIPv6     = 2
+	bgpSAFIUnicast = 1
+
+	bgpASTrans = 23456
+
+	bgpNotificationCease              = 6
+	bgpNotificationCeaseAdminShutdown = 2
+
+	bgpHoldTime         = 90 * time.Second
+	bgpConnectRetry     = 5 * time.Second
+	bgpDefaultLocalPref = 100
+	bgpShutdownTimeout  = 5 * time.Second
+)
+
+// BGPHandler announces the virtual IP as a host route to BGP peers while the node is the VIP leader.
+//
+// The handler implements a minimal BGP speaker: it establishes a session with each configured peer,
+// advertises the /32 (/128) route for the virtual IP, and withdraws it before closing the session on release.
+// Any routes received from the peers are ignored.
+type BGPHandler struct {
+	logger *zap.Logger
+
+	vip  netip.Addr
+	spec network.VIPBGPSpec
+	port int
+
+	mu     sync.Mutex
+	cancel context.CancelFunc
+	wg     sync.WaitGroup
+}
+
+// NewBGPHandler creates new BGPHandler.
+func NewBGPHandler(logger *zap.Logger, vip netip.Addr, spec network.VIPBGPSpec) *BGPHandler {
+	return &BGPHandler{
+		logger: logger,
+		vip:    vip,
+		spec:   spec,
+		port:   bgpPort,
+	}
+}
+
+// Acquire implements Handler interface.
+//
+// Sessions are established in the background, so that an unreachable peer doesn't block the VIP from being assigned.
+func (handler *BGPHandler) Acquire(ctx context.Context) error {
+	handler.mu.Lock()
+	defer handler.mu.Unlock()
+
+	if handler.cancel != nil {
+		return nil
+	}
+
+	// sessions outlive the context of the Acquire call, they are stopped by Release
+	sessionCtx, cancel := context.WithCancel(context.Background())
+	handler.cancel = cancel
+
+	for _, peer := range handler.spec.Peers {
+		peer := peer
+
+		handler.wg.Add(1)
+
+		go func() {
+			defer handler.wg.Done()
+
+			handler.runPeer(sessionCtx, peer)
+		}()
+	}
+
+	return nil
+}
+
+// Release implements Handler interface.
+func (handler *BGPHandler) Release(ctx context.Context) error {
+	handler.mu.Lock()
+	defer handler.mu.Unlock()
+
+	if handler.cancel == nil {
+		return nil
+	}
+
+	handler.cancel()
+	handler.cancel = nil
+
+	// wait for the sessions to withdraw the route
+	done := make(chan struct{})
+
+	go func() {
+		handler.wg.Wait()
+		close(done)
+	}()
+
+	select {
+	case <-done:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+func (handler *BGPHandler) runPeer(ctx context.Context, peer network.VIPBGPPeerSpec) {
+	logger := handler.logger.With(zap.Stringer("peer", peer.Address), zap.Uint32("peer_asn", peer.ASN))
+
+	for {
+		err := handler.runSession(ctx, logger, peer)
+		if err != nil && !errors.Is(err, context.Canceled) {
+			logger.Warn("BGP session failed", zap.Error(err))
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(bgpConnectRetry):
+		}
+	}
+}
+
+//nolint:gocyclo
+func (handler *BGPHandler) runSession(ctx context.Context, logger *zap.Logger, peer network.VIPBGPPeerSpec) error {
+	dialer := net.Dialer{
+		Timeout: bgpConnectRetry,
+	}
+
+	if peer.Password != "" {
+		dialer.Control = func(_, _ string, c syscall.RawConn) error {
+			return setTCPMD5Signature(c, peer.Address, peer.Password)
+		}
+	}
+
+	conn, err := dialer.DialContext(ctx, "tcp", net.JoinHostPort(peer.Address.String(), strconv.Itoa(handler.port)))
+	if err != nil {
+		return fmt.Errorf("error connecting to peer: %w", err)
+	}
+
+	defer conn.Close() //nolint:errcheck
+
+	localAddr := conn.LocalAddr().(*net.TCPAddr).AddrPort().Addr().Unmap() //nolint:forcetypeassert
+
+	session := &bgpSession{
+		conn:      conn,
+		localASN:  handler.spec.LocalASN,
+		peerASN:   peer.ASN,
+		localAddr: localAddr,
+		vip:       handler.vip,
+	}
+
+	routerID := handler.spec.RouterID
+	if !routerID.IsValid() {
+		routerID = localAddr
+	}
+
+	if !routerID.Is4() {
+		return fmt.Errorf("router ID should be an IPv4 address, got %s", routerID)
+	}
+
+	if err = session.open(routerID); err != nil {
+		return err
+	}
+
+	if err = session.send(bgpMsgUpdate, session.announce()); err != nil {
+		return fmt.Errorf("error announcing route: %w", err)
+	}
+
+	logger.Info("BGP session established, announced shared IP", zap.Stringer("ip", handler.vip))
+
+	errCh := make(chan error, 1)
+
+	go func() {
+		errCh <- session.receive()
+	}()
+
+	keepaliveTicker := time.NewTicker(session.holdTime / 3)
+	defer keepaliveTicker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			// the read deadline makes sure the receiver goroutine terminates
+			conn.SetDeadline(time.Now().Add(bgpShutdownTimeout)) //nolint:errcheck
+
+			// withdrawing the route explicitly doesn't rely on the peer processing session close in time
+			if err = session.send(bgpMsgUpdate, session.withdraw()); err == nil {
+				session.send(bgpMsgNotification, []byte{bgpNotificationCease, bgpNotificationCeaseAdminShutdown}) //nolint:errcheck
+			}
+
+			logger.Info("BGP session closed, withdrew shared IP", zap.Stringer("ip", handler.vip))
+
+			return ctx.Err()
+		case err = <-errCh:
+			return err
+		case <-keepaliveTicker.C:
+			if err = session.send(bgpMsgKeepalive, nil); err != nil {
+				return fmt.Errorf("error sending keepalive: %w", err)
+			}
+		}
+	}
+}
+
+type bgpSession struct {
+	conn net.Conn
+
+	localASN, peerASN uint32
+	localAddr         netip.Addr
+	vip               netip.Addr
+
+	holdTime    time.Duration
+	fourOctetAS bool
+}
+
+// open performs the BGP OPEN exchange, the session is established when the function returns.
+func (session *bgpSession) open(routerID netip.Addr) error {
+	session.conn.SetDeadline(time.Now().Add(bgpHoldTime)) //nolint:errcheck
+
+	if err := session.send(bgpMsgOpen, session.openMessage(routerID)); err != nil {
+		return fmt.Errorf("error sending open: %w", err)
+	}
+
+	msgType, body, err := session.read()
+	if err != nil {
+		return fmt.Errorf("error reading open: %w", err)
+	}
+
+	if msgType != bgpMsgOpen {
+		return fmt.Errorf("unexpected message type %d, expected open", msgType)
+	}
+
+	if err = session.handleOpen(body); err != nil {
+		return err
+	}
+
+	if err = session.send(bgpMsgKeepalive, nil); err != nil {
+		return fmt.Errorf("error sending keepalive: %w", err)
+	}
+
+	msgType, _, err = session.read()
+	if err != nil {
+		return fmt.Errorf("error reading keepalive: %w", err)
+	}
+
+	if msgType != bgpMsgKeepalive {
+		return fmt.Errorf("unexpected message type %d, expected keepalive", msgType)
+	}
+
+	return session.conn.SetDeadline(time.Time{})
+}
+
+func (session *bgpSession) afi() uint16 {
+	if session.vip.Is4() {
+		return bgpAFIIPv4
+	}
+
+	return bgpAFIIPv6
+}
+
+// twoOctetAS returns local AS for the 2-octet AS fields, AS_TRANS is used for 4-octet AS numbers.
+func (session *bgpSession) twoOctetAS() uint16 {
+	if session.localASN > 0xffff {
+		return bgpASTrans
+	}
+
+	return uint16(session.localASN)
+}
+
+func (session *bgpSession) openMessage(routerID netip.Addr) []byte {
+	capabilities := []byte{bgpCapMultiprotocol, 4, 0, 0, 0, bgpSAFIUnicast}
+	binary.BigEndian.PutUint16(capabilities[2:4], session.afi())
+
+	capabilities = append(capabilities, bgpCapFourOctetAS, 4)
+	capabilities = binary.BigEndian.AppendUint32(capabilities, session.localASN)
+
+	b := []byte{bgpVersion}
+	b = binary.BigEndian.AppendUint16(b, session.twoOctetAS())
+	b = binary.BigEndian.AppendUint16(b, uint16(bgpHoldTime/time.Second))
+	b = append(b, routerID.AsSlice()...)
+	b = append(b, byte(len(capabilities)+2), bgpOptParamCapabilities, byte(len(capabilities)))
+	b = append(b, capabilities...)
+
+	return b
+}
+
+func (session *bgpSession) handleOpen(b []byte) error {
+	if len(b) < 10 {
+		return fmt.Errorf("open message is too short")
+	}
+
+	if b[0] != bgpVersion {
+		return fmt.Errorf("unsupported BGP version %d", b[0])
+	}
+
+	peerAS := uint32(binary.BigEndian.Uint16(b[1:3]))
+	holdTime := time.Duration(binary.BigEndian.Uint16(b[3:5])) * time.Second
+
+	paramsLen := int(b[9])
+	params := b[10:]
+
+	if len(params) < paramsLen {
+		return fmt.Errorf("open message is truncated")
+	}
+
+	for params = params[:paramsLen]; len(params) >= 2; {
+		paramType, paramLen := params[0], int(params[1])
+
+		if len(params) < 2+paramLen {
+			return fmt.Errorf("open message parameter is truncated")
+		}
+
+		if paramType == bgpOptParamCapabilities {
+			for capabilities := params[2 : 2+paramLen]; len(capabilities) >= 2; {
+				code, capLen := capabilities[0], int(capabilities[1])
+
+				if len(capabilities) < 2+capLen {
+					return fmt.Errorf("open message capability is truncated")
+				}
+
+				if code == bgpCapFourOctetAS && capLen == 4 {
+					session.fourOctetAS = true
+					peerAS = binary.BigEndian.Uint32(capabilities[2:6])
+				}
+
+				capabilities = capabilities[2+capLen:]
+			}
+		}
+
+		params = params[2+paramLen:]
+	}
+
+	if peerAS != session.peerASN {
+		session.send(bgpMsgNotification, []byte{2, 2}) //nolint:errcheck // OPEN message error, bad peer AS
+
+		return fmt.Errorf("peer AS mismatch: expected %d, got %d", session.peerASN, peerAS)
+	}
+
+	// hold time is negotiated to the smaller value, zero disables keepalives which is not supported
+	session.holdTime = bgpHoldTime
+
+	if holdTime > 0 && holdTime < session.holdTime {
+		session.holdTime = holdTime
+	}
+
+	return nil
+}
+
+func (session *bgpSession) pathAttributes() []byte {
+	b := []byte{bgpAttrFlagTransitive, bgpAttrOrigin, 1, bgpOriginIGP}
+
+	if session.localASN == session.peerASN {
+		// iBGP: empty AS path, local preference is required
+		b = append(b, bgpAttrFlagTransitive, bgpAttrASPath, 0)
+		b = append(b, bgpAttrFlagTransitive, bgpAttrLocalPref, 4)
+		b = binary.BigEndian.AppendUint32(b, bgpDefaultLocalPref)
+	} else {
+		var asPath []byte
+
+		if session.fourOctetAS {
+			asPath = binary.BigEndian.AppendUint32([]byte{bgpASPathSegment, 1}, session.localASN)
+		} else {
+			asPath = binary.BigEndian.AppendUint16([]byte{bgpASPathSegment, 1}, session.twoOctetAS())
+		}
+
+		b = append(b, bgpAttrFlagTransitive, bgpAttrASPath, byte(len(asPath)))
+		b = append(b, asPath...)
+	}
+
+	return b
+}
+
+func (session *bgpSession) nlri() []byte {
+	return append([]byte{byte(session.vip.BitLen())}, session.vip.AsSlice()...)
+}
+
+// announce builds the UPDATE message body advertising the VIP.
+func (session *bgpSession) announce() []byte {
+	attributes := session.pathAttributes()
+
+	var nlri []byte
+
+	if session.vip.Is4() {
+		attributes = append(attributes, bgpAttrFlagTransitive, bgpAttrNextHop, 4)
+		attributes = append(attributes, session.localAddr.AsSlice()...)
+
+		nlri = session.nlri()
+	} else {
+		nextHop := session.localAddr.AsSlice()
+
+		reach := binary.BigEndian.AppendUint16(nil, bgpAFIIPv6)
+		reach = append(reach, bgpSAFIUnicast, byte(len(nextHop)))
+		reach = append(reach, nextHop...)
+		reach = append(reach, 0) // reserved
+		reach = append(reach, session.nlri()...)
+
+		attributes = append(attributes, bgpAttrFlagOptional, bgpAttrMPReachNLRI, byte(len(reach)))
+		attributes = append(attributes, reach...)
+	}
+
+	b := binary.BigEndian.AppendUint16(nil, 0) // no withdrawn routes
+	b = binary.BigEndian.AppendUint16(b, uint16(len(attributes)))
+	b = append(b, attributes...)
+
+	return append(b, nlri...)
+}
+
+// withdraw builds the UPDATE message body withdrawing the VIP.
+func (session *bgpSession) withdraw() []byte {
+	if session.vip.Is4() {
+		withdrawn := session.nlri()
+
+		b := binary.BigEndian.AppendUint16(nil, uint16(len(withdrawn)))
+		b = append(b, withdrawn...)
+
+		return binary.BigEndian.AppendUint16(b, 0) // no path attributes
+	}
+
+	unreach := binary.BigEndian.AppendUint16(nil, bgpAFIIPv6)
+	unreach = append(unreach, bgpSAFIUnicast)
+	unreach = append(unreach, session.nlri()...)
+
+	attributes := []byte{bgpAttrFlagOptional, bgpAttrMPUnreach, byte(len(unreach))}
+	attributes = append(attributes, unreach...)
+
+	b := binary.BigEndian.AppendUint16(nil, 0) // no withdrawn routes
+	b = binary.BigEndian.AppendUint16(b, uint16(len(attributes)))
+
+	return append(b, attributes...)
+}
+
+// receive processes incoming messages until the session fails or the hold timer expires.
+func (session *bgpSession) receive() error {
+	for {
+		session.conn.SetReadDeadline(time.Now().Add(session.holdTime)) //nolint:errcheck
+
+		msgType, body, err := session.read()
+		if err != nil {
+			return err
+		}
+
+		switch msgType {
+		case bgpMsgKeepalive, bgpMsgUpdate:
+			// routes announced by the peer are ignored
+		case bgpMsgNotification:
+			if len(body) < 2 {
+				return fmt.Errorf("peer sent notification")
+			}
+
+			return fmt.Errorf("peer sent notification: code %d, subcode %d", body[0], body[1])
+		default:
+			return fmt.Errorf("unexpected message type %d", msgType)
+		}
+	}
+}
+
+func (session *bgpSession) send(msgType byte, body []byte) error {
+	b := make([]byte, bgpHeaderLen, bgpHeaderLen+len(body))
+
+	for i := 0; i < 16; i++ {
+		b[i] = 0xff
+	}
+
+	binary.BigEndian.PutUint16(b[16:18], uint16(bgpHeaderLen+len(body)))
+	b[18] = msgType
+
+	_, err := session.conn.Write(append(b, body...))
+
+	return err
+}
+
+func (session *bgpSession) read() (byte, []byte, error) {
+	header := make([]byte, bgpHeaderLen)
+
+	if _, err := io.ReadFull(session.conn, header); err != nil {
+		return 0, nil, err
+	}
+
+	length := int(binary.BigEndian.Uint16(header[16:18]))
+	if length < bgpHeaderLen || length > bgpMaxMessageLen {
+		return 0, nil, fmt.Errorf("invalid message length %d", length)
+	}
+
+	body := make([]byte, length-bgpHeaderLen)
+
+	if _, err := io.ReadFull(session.conn, body); err != nil {
+		return 0, nil, err
+	}
+
+	return header[18], body, nil
+}
+
+// setTCPMD5Signature enables TCP MD5 signature option (RFC 2385) for the connection to the peer.
+func setTCPMD5Signature(c syscall.RawConn, peer netip.Addr, password string) error {
+	if len(password) > unix.TCP_MD5SIG_MAXKEYLEN {
+		return fmt.Errorf("password is too long")
+	}
+
+	sig := unix.TCPMD5Sig{
+		Keylen: uint16(len(password)),
+	}
+
+	copy(sig.Key[:], password)
+
+	if peer.Is4() {
+		sa := (*unix.RawSockaddrInet4)(unsafe.Pointer(&sig.Addr))
+		sa.Family = unix.AF_INET
+		sa.Addr = peer.As4()
+	} else {
+		sa := (*unix.RawSockaddrInet6)(unsafe.Pointer(&sig.Addr))
+		sa.Family = unix.AF_INET6
+		sa.Addr = peer.As16()
+	}
+
+	var sockErr error
+
+	if err := c.Control(func(fd uintptr) {
+		sockErr = unix.SetsockoptTCPMD5Sig(int(fd), unix.IPPROTO_TCP, unix.TCP_MD5SIG, &sig)
+	}); err != nil {
+		return err
+	}
+
+	if sockErr != nil {
+		return fmt.Errorf("error setting TCP MD5 signature: %w", sockErr)
+	}
+
+	return nil
+}
@@ -0,0 +1,274 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package vip
+
+import (
+	"context"
+	"encoding/binary"
+	"net"
+	"net/netip"
+	"syscall"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap/zaptest"
+
+	"github.com/siderolabs/talos/pkg/machinery/resources/network"
+)
+
+// bgpPeer is a minimal passive BGP peer used to test the handler.
+type bgpPeer struct {
+	t *testing.T
+
+	session *bgpSession
+}
+
+func (peer *bgpPeer) expect(msgType byte) []byte {
+	actualType, body, err := peer.session.read()
+	require.NoError(peer.t, err)
+
+	for actualType == bgpMsgKeepalive && msgType != bgpMsgKeepalive {
+		actualType, body, err = peer.session.read()
+		require.NoError(peer.t, err)
+	}
+
+	require.Equal(peer.t, msgType, actualType)
+
+	return body
+}
+
+func TestBGPHandler(t *testing.T) {
+	for _, test := range []struct {
+		name string
+
+		vip      netip.Addr
+		peer     netip.Addr
+		localASN uint32
+		peerASN  uint32
+		password string
+
+		expectedASPath []byte
+	}{
+		{
+			name: "ebgp",
+
+			vip:      netip.MustParseAddr("192.168.10.10"),
+			peer:     netip.MustParseAddr("127.0.0.1"),
+			localASN: 4200000001,
+			peerASN:  65000,
+
+			expectedASPath: []byte{bgpASPathSegment, 1, 0xfa, 0x56, 0xea, 0x01},
+		},
+		{
+			name: "ibgp",
+
+			vip:      netip.MustParseAddr("192.168.10.10"),
+			peer:     netip.MustParseAddr("127.0.0.1"),
+			localASN: 65000,
+			peerASN:  65000,
+
+			expectedASPath: []byte{},
+		},
+		{
+			name: "ipv6",
+
+			vip:      netip.MustParseAddr("2001:db8::10"),
+			peer:     netip.MustParseAddr("::1"),
+			localASN: 65001,
+			peerASN:  65000,
+
+			expectedASPath: []byte{bgpASPathSegment, 1, 0, 0, 0xfd, 0xe9},
+		},
+		{
+			name: "md5",
+
+			vip:      netip.MustParseAddr("192.168.10.10"),
+			peer:     netip.MustParseAddr("127.0.0.1"),
+			localASN: 65001,
+			peerASN:  65000,
+			password: "secret",
+
+			expectedASPath: []byte{bgpASPathSegment, 1, 0, 0, 0xfd, 0xe9},
+		},
+	} {
+		test := test
+
+		t.Run(test.name, func(t *testing.T) {
+			ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+			defer cancel()
+
+			var listenConfig net.ListenConfig
+
+			if test.password != "" {
+				listenConfig.Control = func(_, _ string, c syscall.RawConn) error {
+					return setTCPMD5Signature(c, test.peer, test.password)
+				}
+			}
+
+			listener, err := listenConfig.Listen(ctx, "tcp", netip.AddrPortFrom(test.peer, 0).String())
+			require.NoError(t, err)
+
+			defer listener.Close() //nolint:errcheck
+
+			handler := NewBGPHandler(zaptest.NewLogger(t), test.vip, network.VIPBGPSpec{
+				LocalASN: test.localASN,
+				RouterID: netip.MustParseAddr("10.0.0.1"),
+				Peers: []network.VIPBGPPeerSpec{
+					{
+						Address:  test.peer,
+						ASN:      test.peerASN,
+						Password: test.password,
+					},
+				},
+			})
+			handler.port = listener.Addr().(*net.TCPAddr).Port //nolint:forcetypeassert
+
+			require.NoError(t, handler.Acquire(ctx))
+
+			conn, err := listener.Accept()
+			require.NoError(t, err)
+
+			defer conn.Close() //nolint:errcheck
+
+			require.NoError(t, conn.SetDeadline(time.Now().Add(10*time.Second)))
+
+			// the peer side of the session
+			peer := &bgpPeer{
+				t: t,
+				session: &bgpSession{
+					conn:     conn,
+					localASN: test.peerASN,
+					peerASN:  test.localASN,
+					vip:      test.vip,
+				},
+			}
+
+			require.NoError(t, peer.session.handleOpen(peer.expect(bgpMsgOpen)))
+			assert.True(t, peer.session.fourOctetAS)
+
+			require.NoError(t, peer.session.send(bgpMsgOpen, peer.session.openMessage(netip.MustParseAddr("10.0.0.2"))))
+			require.NoError(t, peer.session.send(bgpMsgKeepalive, nil))
+
+			peer.expect(bgpMsgKeepalive)
+
+			// route is announced
+			update := peer.expect(bgpMsgUpdate)
+
+			assert.Equal(t, []byte{0, 0}, update[:2], "no withdrawn routes")
+
+			attributesLen := int(binary.BigEndian.Uint16(update[2:4]))
+			attributes := parseAttributes(t, update[4:4+attributesLen])
+			nlri := update[4+attributesLen:]
+
+			assert.Equal(t, []byte{bgpOriginIGP}, attributes[bgpAttrOrigin])
+			assert.Equal(t, test.expectedASPath, attributes[bgpAttrASPath])
+
+			if test.localASN == test.peerASN {
+				assert.Equal(t, []byte{0, 0, 0, 100}, attributes[bgpAttrLocalPref])
+			}
+
+			if test.vip.Is4() {
+				assert.Equal(t, []byte{127, 0, 0, 1}, attributes[bgpAttrNextHop])
+				assert.Equal(t, []byte{32, 192, 168, 10, 10}, nlri)
+			} else {
+				assert.Empty(t, nlri)
+
+				reach := attributes[bgpAttrMPReachNLRI]
+				require.NotNil(t, reach)
+
+				assert.Equal(t, append([]byte{0, bgpAFIIPv6, bgpSAFIUnicast, 16}, test.peer.AsSlice()...), reach[:20])
+				assert.Equal(t, append([]byte{128}, test.vip.AsSlice()...), reach[len(reach)-17:])
+			}
+
+			// route is withdrawn on release
+			releaseErrCh := make(chan error, 1)
+
+			go func() {
+				releaseErrCh <- handler.Release(ctx)
+			}()
+
+			update = peer.expect(bgpMsgUpdate)
+
+			if test.vip.Is4() {
+				assert.Equal(t, []byte{0, 5, 32, 192, 168, 10, 10, 0, 0}, update)
+			} else {
+				attributes = parseAttributes(t, update[4:])
+
+				assert.Equal(t, append([]byte{0, bgpAFIIPv6, bgpSAFIUnicast, 128}, test.vip.AsSlice()...), attributes[bgpAttrMPUnreach])
+			}
+
+			assert.Equal(t, []byte{bgpNotificationCease, bgpNotificationCeaseAdminShutdown}, peer.expect(bgpMsgNotification))
+
+			require.NoError(t, <-releaseErrCh)
+		})
+	}
+}
+
+func TestBGPHandlerPeerASMismatch(t *testing.T) {
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+
+	defer listener.Close() //nolint:errcheck
+
+	go func() {
+		conn, acceptErr := listener.Accept()
+		if acceptErr != nil {
+			return
+		}
+
+		defer conn.Close() //nolint:errcheck
+
+		peer := &bgpSession{
+			conn:     conn,
+			localASN: 65002,
+			peerASN:  65001,
+		}
+
+		peer.read()                                                              //nolint:errcheck
+		peer.send(bgpMsgOpen, peer.openMessage(netip.MustParseAddr("10.0.0.2"))) //nolint:errcheck
+		peer.read()                                                              //nolint:errcheck
+	}()
+
+	conn, err := net.Dial("tcp", listener.Addr().String())
+	require.NoError(t, err)
+
+	defer conn.Close() //nolint:errcheck
+
+	session := &bgpSession{
+		conn:     conn,
+		localASN: 65001,
+		peerASN:  65000,
+		vip:      netip.MustParseAddr("192.168.10.10"),
+	}
+
+	assert.EqualError(t, session.open(netip.MustParseAddr("10.0.0.1")), "peer AS mismatch: expected 65000, got 65002")
+}
+
+func parseAttributes(t *testing.T, b []byte) map[byte][]byte {
+	attributes := map[byte][]byte{}
+
+	for len(b) > 0 {
+		require.GreaterOrEqual(t, len(b), 3)
+
+		flags, attrType := b[0], b[1]
+
+		var length, offset int
+
+		if flags&0x10 != 0 { // extended length
+			length, offset = int(binary.BigEndian.Uint16(b[2:4])), 4
+		} else {
+			length, offset = int(b[2]), 3
+		}
+
+		require.GreaterOrEqual(t, len(b), offset+length)
+
+		attributes[attrType] = b[offset : offset+length]
+		b = b[offset+length:]
+	}
+
+	return attributes
+}
@@ -17,6 +17,7 @@ import (
 	"go.uber.org/zap"
 
 	talosconfig "github.com/siderolabs/talos/pkg/machinery/config/config"
+	"github.com/siderolabs/talos/pkg/machinery/nethelpers"
 	"github.com/siderolabs/talos/pkg/machinery/resources/network"
 )
 
@@ -167,6 +168,32 @@ func (ctrl *OperatorConfigController) Run(ctx context.Context, r controller.Runt
 					})
 				}
 
+				if ipv6Config := device.IPv6Config(); ipv6Config != nil {
+					routeMetric := ipv6Config.RouteMetric()
+					if routeMetric == 0 {
+						routeMetric = DefaultRouteMetric
+					}
+
+					addrGenMode, modeErr := nethelpers.AddrGenModeByName(ipv6Config.AddrGenMode())
+					if modeErr != nil {
+						specErrors = multierror.Append(specErrors, modeErr)
+					} else {
+						specs = append(specs, network.OperatorSpecSpec{
+							Operator:  network.OperatorRA,
+							LinkName:  device.Interface(),
+							RequireUp: true,
+							RA: network.RAOperatorSpec{
+								AcceptRA:          ipv6Config.AcceptRA(),
+								Autoconf:          ipv6Config.Autoconf(),
+								PrivacyExtensions: ipv6Config.PrivacyExtensions(),
+								AddrGenMode:       addrGenMode,
+								RouteMetric:       routeMetric,
+							},
+							ConfigLayer: network.ConfigMachineConfiguration,
+						})
+					}
+				}
+
 				for _, vlan := range device.Vlans() {
 					if vlan.DHCP() && vlan.DHCPOptions().IPv4() {
 						routeMetric := vlan.DHCPOptions().RouteMetric()
@@ -423,6 +423,96 @@ func (suite *OperatorConfigSuite) TestMachineConfigurationDHCP6() {
 	)
 }
 
+func (suite *OperatorConfigSuite) TestMachineConfigurationRA() {
+	suite.Require().NoError(suite.runtime.RegisterController(&netctrl.OperatorConfigController{}))
+
+	suite.startRuntime()
+
+	u, err := url.Parse("https://foo:6443")
+	suite.Require().NoError(err)
+
+	cfg := config.NewMachineConfig(
+		container.NewV1Alpha1(
+			&v1alpha1.Config{
+				ConfigVersion: "v1alpha1",
+				MachineConfig: &v1alpha1.MachineConfig{
+					MachineNetwork: &v1alpha1.NetworkConfig{
+						NetworkInterfaces: []*v1alpha1.Device{
+							{
+								DeviceInterface: "eth1",
+								DeviceIPv6:      &v1alpha1.DeviceIPv6Config{},
+							},
+							{
+								DeviceInterface: "eth2",
+								DeviceIPv6: &v1alpha1.DeviceIPv6Config{
+									IPv6Autoconf:          pointer.To(false),
+									IPv6PrivacyExtensions: pointer.To(true),
+									IPv6AddrGenMode:       "stable-privacy",
+									IPv6RouteMetric:       512,
+								},
+							},
+							{
+								DeviceInterface: "eth3",
+							},
+						},
+					},
+				},
+				ClusterConfig: &v1alpha1.ClusterConfig{
+					ControlPlane: &v1alpha1.ControlPlaneConfig{
+						Endpoint: &v1alpha1.Endpoint{
+							URL: u,
+						},
+					},
+				},
+			},
+		),
+	)
+
+	suite.Require().NoError(suite.state.Create(suite.ctx, cfg))
+
+	suite.assertOperators(
+		[]string{
+			"configuration/ra/eth1",
+			"configuration/ra/eth2",
+		}, func(r *network.OperatorSpec, asrt *assert.Assertions) {
+			asrt.Equal(network.OperatorRA, r.TypedSpec().Operator)
+			asrt.True(r.TypedSpec().RequireUp)
+			asrt.Equal(network.ConfigMachineConfiguration, r.TypedSpec().ConfigLayer)
+
+			switch r.Metadata().ID() {
+			case "configuration/ra/eth1":
+				asrt.Equal("eth1", r.TypedSpec().LinkName)
+				asrt.Equal(network.RAOperatorSpec{
+					AcceptRA:    true,
+					Autoconf:    true,
+					AddrGenMode: nethelpers.AddrGenModeEUI64,
+					RouteMetric: netctrl.DefaultRouteMetric,
+				}, r.TypedSpec().RA)
+			case "configuration/ra/eth2":
+				asrt.Equal("eth2", r.TypedSpec().LinkName)
+				asrt.Equal(network.RAOperatorSpec{
+					AcceptRA:          true,
+					PrivacyExtensions: true,
+					AddrGenMode:       nethelpers.AddrGenModeStablePrivacy,
+					RouteMetric:       512,
+				}, r.TypedSpec().RA)
+			}
+		},
+	)
+
+	suite.Assert().NoError(
+		retry.Constant(3*time.Second, retry.WithUnits(100*time.Millisecond)).Retry(
+			func() error {
+				return suite.assertNoOperators(
+					[]string{
+						"configuration/ra/eth3",
+					},
+				)
+			},
+		),
+	)
+}
+
 func (suite *OperatorConfigSuite) TearDownTest() {
 	suite.T().Log("tear down")
 
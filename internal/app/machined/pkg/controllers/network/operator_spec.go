@@ -51,6 +51,11 @@ func (ctrl *OperatorSpecController) Inputs() []controller.Input {
 			Type:      network.LinkStatusType,
 			Kind:      controller.InputWeak,
 		},
+		{
+			Namespace: network.NamespaceName,
+			Type:      network.ProbeStatusType,
+			Kind:      controller.InputWeak,
+		},
 	}
 }
 
@@ -199,6 +204,11 @@ func (ctrl *OperatorSpecController) reconcileOperators(ctx context.Context, r co
 		linkStatuses[linkStatus.Metadata().ID()] = linkStatus.TypedSpec().OperationalState == nethelpers.OperStateUnknown || linkStatus.TypedSpec().OperationalState == nethelpers.OperStateUp
 	}
 
+	probeStatuses, err := buildProbeStatuses(ctx, r)
+	if err != nil {
+		return err
+	}
+
 	// list operator specs
 	list, err = r.List(ctx, resource.NewMetadata(network.NamespaceName, network.OperatorSpecType, "", resource.VersionUndefined))
 	if err != nil {
@@ -223,6 +233,11 @@ func (ctrl *OperatorSpecController) reconcileOperators(ctx context.Context, r co
 			continue
 		}
 
+		// probe is not succeeding and operator requires it, skip it
+		if operatorSpec.TypedSpec().RequireProbe != "" && !probeStatuses[operatorSpec.TypedSpec().RequireProbe] {
+			continue
+		}
+
 		shouldRun[operatorSpec.Metadata().ID()] = operatorSpec.TypedSpec()
 	}
 
@@ -428,6 +443,10 @@ func (ctrl *OperatorSpecController) newOperator(logger *zap.Logger, spec *networ
 		logger = logger.With(zap.String("operator", "dhcp6"))
 
 		return operator.NewDHCP6(logger, spec.LinkName, spec.DHCP6)
+	case network.OperatorRA:
+		logger = logger.With(zap.String("operator", "ra"))
+
+		return operator.NewRA(logger, spec.LinkName, spec.RA)
 	case network.OperatorVIP:
 		logger = logger.With(zap.String("operator", "vip"))
 
@@ -379,6 +379,66 @@ func (suite *OperatorSpecSuite) TestScheduling() {
 	)
 }
 
+func (suite *OperatorSpecSuite) TestRequireProbe() {
+	specVIP := network.NewOperatorSpec(network.NamespaceName, "vip/eth0")
+	*specVIP.TypedSpec() = network.OperatorSpecSpec{
+		Operator:     network.OperatorVIP,
+		LinkName:     "eth0",
+		RequireProbe: "tcp:1.2.3.1:80",
+		VIP: network.VIPOperatorSpec{
+			IP: netip.MustParseAddr("1.2.3.4"),
+		},
+	}
+
+	suite.Require().NoError(suite.state.Create(suite.ctx, specVIP))
+
+	linkState := network.NewLinkStatus(network.NamespaceName, "eth0")
+	*linkState.TypedSpec() = network.LinkStatusSpec{
+		OperationalState: nethelpers.OperStateUp,
+	}
+
+	suite.Require().NoError(suite.state.Create(suite.ctx, linkState))
+
+	// operator shouldn't be running, as the probe status is not known yet
+	time.Sleep(500 * time.Millisecond)
+
+	suite.Assert().NoError(suite.assertRunning(nil, func(op *mockOperator) error { return nil }))
+
+	probeStatus := network.NewProbeStatus(network.NamespaceName, "tcp:1.2.3.1:80")
+	probeStatus.TypedSpec().Success = true
+
+	suite.Require().NoError(suite.state.Create(suite.ctx, probeStatus))
+
+	suite.Assert().NoError(
+		retry.Constant(3*time.Second, retry.WithUnits(100*time.Millisecond)).Retry(
+			func() error {
+				return suite.assertRunning(
+					[]string{"vip/eth0"}, func(op *mockOperator) error {
+						suite.Assert().Equal(netip.MustParseAddr("1.2.3.4"), op.spec.VIP.IP)
+
+						return nil
+					},
+				)
+			},
+		),
+	)
+
+	// probe fails, operator should be stopped
+	ctest.UpdateWithConflicts(suite, probeStatus, func(r *network.ProbeStatus) error {
+		r.TypedSpec().Success = false
+
+		return nil
+	})
+
+	suite.Assert().NoError(
+		retry.Constant(3*time.Second, retry.WithUnits(100*time.Millisecond)).Retry(
+			func() error {
+				return suite.assertRunning(nil, func(op *mockOperator) error { return nil })
+			},
+		),
+	)
+}
+
 func (suite *OperatorSpecSuite) TestPanic() {
 	specPanic := network.NewOperatorSpec(network.NamespaceName, "dhcp6/eth0")
 	*specPanic.TypedSpec() = network.OperatorSpecSpec{
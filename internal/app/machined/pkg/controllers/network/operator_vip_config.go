@@ -215,6 +215,14 @@ func handleVIP(ctx context.Context, vlanConfig talosconfig.VIPConfig, deviceName
 		ConfigLayer: network.ConfigMachineConfiguration,
 	}
 
+	if vlanConfig.Probe() != nil {
+		probe := probeSpecFromConfig(vlanConfig.Probe())
+
+		if spec.RequireProbe, err = probe.ID(); err != nil {
+			return network.OperatorSpecSpec{}, err
+		}
+	}
+
 	switch {
 	// Equinix Metal VIP
 	case vlanConfig.EquinixMetal() != nil:
@@ -232,9 +240,46 @@ func handleVIP(ctx context.Context, vlanConfig talosconfig.VIPConfig, deviceName
 		if err = vip.GetNetworkAndDeviceIDs(ctx, &spec.VIP.HCloud, sharedIP); err != nil {
 			return network.OperatorSpecSpec{}, err
 		}
+	// BGP announced VIP
+	case vlanConfig.BGP() != nil:
+		spec.VIP.GratuitousARP = false
+
+		if spec.VIP.BGP, err = bgpSpecFromConfig(vlanConfig.BGP()); err != nil {
+			return network.OperatorSpecSpec{}, err
+		}
 	// Regular layer 2 VIP
 	default:
 	}
 
 	return spec, nil
 }
+
+func bgpSpecFromConfig(bgpConfig talosconfig.VIPBGP) (*network.VIPBGPSpec, error) {
+	spec := &network.VIPBGPSpec{
+		LocalASN: bgpConfig.LocalASN(),
+	}
+
+	if bgpConfig.RouterID() != "" {
+		routerID, err := netip.ParseAddr(bgpConfig.RouterID())
+		if err != nil {
+			return nil, fmt.Errorf("error parsing BGP router ID: %w", err)
+		}
+
+		spec.RouterID = routerID
+	}
+
+	for _, peer := range bgpConfig.Peers() {
+		address, err := netip.ParseAddr(peer.Address())
+		if err != nil {
+			return nil, fmt.Errorf("error parsing BGP peer address: %w", err)
+		}
+
+		spec.Peers = append(spec.Peers, network.VIPBGPPeerSpec{
+			Address:  address,
+			ASN:      peer.ASN(),
+			Password: peer.Password(),
+		})
+	}
+
+	return spec, nil
+}
@@ -114,6 +114,22 @@ func (suite *OperatorVIPConfigSuite) TestMachineConfigurationVIP() {
 									},
 								},
 							},
+							{
+								DeviceInterface: "eth4",
+								DeviceVIPConfig: &v1alpha1.DeviceVIPConfig{
+									SharedIP: "10.5.0.100",
+									BGPConfig: &v1alpha1.VIPBGPConfig{
+										BGPLocalASN: 65001,
+										BGPPeers: []*v1alpha1.VIPBGPPeer{
+											{
+												PeerAddress:  "10.5.0.1",
+												PeerASN:      65000,
+												PeerPassword: "secret",
+											},
+										},
+									},
+								},
+							},
 						},
 					},
 				},
@@ -135,6 +151,7 @@ func (suite *OperatorVIPConfigSuite) TestMachineConfigurationVIP() {
 			"configuration/vip/eth1",
 			"configuration/vip/eth2",
 			"configuration/vip/eth3.26",
+			"configuration/vip/eth4",
 		}, func(r *network.OperatorSpec, asrt *assert.Assertions) {
 			asrt.Equal(network.OperatorVIP, r.TypedSpec().Operator)
 			asrt.True(r.TypedSpec().RequireUp)
@@ -152,6 +169,20 @@ func (suite *OperatorVIPConfigSuite) TestMachineConfigurationVIP() {
 			case "configuration/vip/eth3.26":
 				asrt.Equal("eth3.26", r.TypedSpec().LinkName)
 				asrt.EqualValues(netip.MustParseAddr("5.5.4.4"), r.TypedSpec().VIP.IP)
+			case "configuration/vip/eth4":
+				asrt.Equal("eth4", r.TypedSpec().LinkName)
+				asrt.EqualValues(netip.MustParseAddr("10.5.0.100"), r.TypedSpec().VIP.IP)
+				asrt.False(r.TypedSpec().VIP.GratuitousARP)
+				asrt.Equal(&network.VIPBGPSpec{
+					LocalASN: 65001,
+					Peers: []network.VIPBGPPeerSpec{
+						{
+							Address:  netip.MustParseAddr("10.5.0.1"),
+							ASN:      65000,
+							Password: "secret",
+						},
+					},
+				}, r.TypedSpec().VIP.BGP)
 			}
 		},
 	)
@@ -153,3 +153,19 @@ func (ctrl *ProbeController) reconcileOutputs(ctx context.Context, r controller.
 			return nil
 		})
 }
+
+// buildProbeStatuses returns the success status of the probes by probe ID.
+func buildProbeStatuses(ctx context.Context, r controller.Reader) (map[string]bool, error) {
+	statusList, err := safe.ReaderListAll[*network.ProbeStatus](ctx, r)
+	if err != nil {
+		return nil, fmt.Errorf("error listing probe statuses: %w", err)
+	}
+
+	probeStatuses := make(map[string]bool, statusList.Len())
+
+	for iter := safe.IteratorFromList(statusList); iter.Next(); {
+		probeStatuses[iter.Value().Metadata().ID()] = iter.Value().TypedSpec().Success
+	}
+
+	return probeStatuses, nil
+}
@@ -0,0 +1,191 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package network
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/cosi-project/runtime/pkg/controller"
+	"github.com/cosi-project/runtime/pkg/resource"
+	"github.com/cosi-project/runtime/pkg/state"
+	"go.uber.org/zap"
+
+	talosconfig "github.com/siderolabs/talos/pkg/machinery/config/config"
+	"github.com/siderolabs/talos/pkg/machinery/resources/network"
+)
+
+// ProbeConfigController manages network.ProbeSpec based on the probes referenced by VIPs and routes in machine configuration.
+type ProbeConfigController struct{}
+
+// Name implements controller.Controller interface.
+func (ctrl *ProbeConfigController) Name() string {
+	return "network.ProbeConfigController"
+}
+
+// Inputs implements controller.Controller interface.
+func (ctrl *ProbeConfigController) Inputs() []controller.Input {
+	return []controller.Input{
+		{
+			Namespace: network.NamespaceName,
+			Type:      network.DeviceConfigSpecType,
+			Kind:      controller.InputWeak,
+		},
+	}
+}
+
+// Outputs implements controller.Controller interface.
+func (ctrl *ProbeConfigController) Outputs() []controller.Output {
+	return []controller.Output{
+		{
+			Type: network.ProbeSpecType,
+			Kind: controller.OutputShared,
+		},
+	}
+}
+
+// Run implements controller.Controller interface.
+func (ctrl *ProbeConfigController) Run(ctx context.Context, r controller.Runtime, logger *zap.Logger) error {
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-r.EventCh():
+		}
+
+		touchedIDs := make(map[resource.ID]struct{})
+
+		items, err := r.List(ctx, resource.NewMetadata(network.NamespaceName, network.DeviceConfigSpecType, "", resource.VersionUndefined))
+		if err != nil {
+			if !state.IsNotFoundError(err) {
+				return fmt.Errorf("error getting config: %w", err)
+			}
+		}
+
+		devices := make([]talosconfig.Device, len(items.Items))
+
+		for i, item := range items.Items {
+			devices[i] = item.(*network.DeviceConfigSpec).TypedSpec().Device
+		}
+
+		for _, probe := range ctrl.processDevicesConfiguration(devices) {
+			probe := probe
+
+			id, err := probe.ID()
+			if err != nil {
+				logger.Info("skipping probe", zap.Error(err))
+
+				continue
+			}
+
+			if err = r.Modify(
+				ctx,
+				network.NewProbeSpec(network.NamespaceName, id),
+				func(r resource.Resource) error {
+					*r.(*network.ProbeSpec).TypedSpec() = probe
+
+					return nil
+				},
+			); err != nil {
+				return fmt.Errorf("error applying machine configuration probe: %w", err)
+			}
+
+			touchedIDs[id] = struct{}{}
+		}
+
+		// list probes for cleanup
+		list, err := r.List(ctx, resource.NewMetadata(network.NamespaceName, network.ProbeSpecType, "", resource.VersionUndefined))
+		if err != nil {
+			return fmt.Errorf("error listing resources: %w", err)
+		}
+
+		for _, res := range list.Items {
+			if res.Metadata().Owner() != ctrl.Name() {
+				// skip specs created by other controllers
+				continue
+			}
+
+			if _, ok := touchedIDs[res.Metadata().ID()]; !ok {
+				if err = r.Destroy(ctx, res.Metadata()); err != nil {
+					return fmt.Errorf("error cleaning up probes: %w", err)
+				}
+			}
+		}
+
+		r.ResetRestartBackoff()
+	}
+}
+
+func (ctrl *ProbeConfigController) processDevicesConfiguration(devices []talosconfig.Device) (probes []network.ProbeSpecSpec) {
+	appendVIPProbe := func(vipConfig talosconfig.VIPConfig) {
+		if vipConfig != nil && vipConfig.Probe() != nil {
+			probes = append(probes, probeSpecFromConfig(vipConfig.Probe()))
+		}
+	}
+
+	appendRouteProbes := func(routes []talosconfig.Route) {
+		for _, route := range routes {
+			if route.Probe() != nil {
+				probes = append(probes, probeSpecFromConfig(route.Probe()))
+			}
+		}
+	}
+
+	for _, device := range devices {
+		if device.Ignore() {
+			continue
+		}
+
+		appendVIPProbe(device.VIPConfig())
+		appendRouteProbes(device.Routes())
+
+		for _, vlan := range device.Vlans() {
+			appendVIPProbe(vlan.VIPConfig())
+			appendRouteProbes(vlan.Routes())
+		}
+	}
+
+	return probes
+}
+
+// probeSpecFromConfig converts the machine configuration network probe into the probe spec.
+//
+// The ID of the resulting spec is used by the operators and routes to reference the probe.
+func probeSpecFromConfig(in talosconfig.NetworkProbe) network.ProbeSpecSpec {
+	spec := network.ProbeSpecSpec{
+		Interval:         in.Interval(),
+		FailureThreshold: in.FailureThreshold(),
+		ConfigLayer:      network.ConfigMachineConfiguration,
+	}
+
+	timeout := in.Timeout()
+
+	switch {
+	case in.TCP() != "":
+		spec.TCP = network.TCPProbeSpec{
+			Endpoint: in.TCP(),
+			Timeout:  timeout,
+		}
+	case in.ICMP() != "":
+		spec.ICMP = network.ICMPProbeSpec{
+			Host:    in.ICMP(),
+			Timeout: timeout,
+		}
+	case in.HTTP() != nil:
+		spec.HTTP = network.HTTPProbeSpec{
+			URL:            in.HTTP().URL(),
+			ExpectedStatus: in.HTTP().ExpectedStatus(),
+			Timeout:        timeout,
+		}
+	case in.DNS() != nil:
+		spec.DNS = network.DNSProbeSpec{
+			Name:    in.DNS().Name(),
+			Server:  in.DNS().Server(),
+			Timeout: timeout,
+		}
+	}
+
+	return spec
+}
@@ -0,0 +1,133 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package network_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/cosi-project/runtime/pkg/resource"
+	"github.com/cosi-project/runtime/pkg/resource/rtestutils"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/siderolabs/talos/internal/app/machined/pkg/controllers/ctest"
+	netctrl "github.com/siderolabs/talos/internal/app/machined/pkg/controllers/network"
+	"github.com/siderolabs/talos/pkg/machinery/config/container"
+	"github.com/siderolabs/talos/pkg/machinery/config/types/v1alpha1"
+	"github.com/siderolabs/talos/pkg/machinery/resources/config"
+	"github.com/siderolabs/talos/pkg/machinery/resources/network"
+)
+
+type ProbeConfigSuite struct {
+	ctest.DefaultSuite
+}
+
+func (suite *ProbeConfigSuite) TestMachineConfiguration() {
+	cfg := config.NewMachineConfig(
+		container.NewV1Alpha1(
+			&v1alpha1.Config{
+				ConfigVersion: "v1alpha1",
+				MachineConfig: &v1alpha1.MachineConfig{
+					MachineNetwork: &v1alpha1.NetworkConfig{
+						NetworkInterfaces: []*v1alpha1.Device{
+							{
+								DeviceInterface: "eth0",
+								DeviceVIPConfig: &v1alpha1.DeviceVIPConfig{
+									SharedIP: "192.168.1.10",
+									VIPProbe: &v1alpha1.NetworkProbe{
+										ProbeICMP: "192.168.1.1",
+									},
+								},
+								DeviceRoutes: []*v1alpha1.Route{
+									{
+										RouteNetwork: "10.0.0.0/8",
+										RouteGateway: "192.168.1.2",
+										RouteProbe: &v1alpha1.NetworkProbe{
+											ProbeInterval:         10 * time.Second,
+											ProbeFailureThreshold: 5,
+											ProbeHTTP: &v1alpha1.NetworkProbeHTTP{
+												HTTPURL: "http://192.168.1.2/healthz",
+											},
+										},
+									},
+								},
+								DeviceVlans: []*v1alpha1.Vlan{
+									{
+										VlanID: 10,
+										VlanRoutes: []*v1alpha1.Route{
+											{
+												RouteNetwork: "10.1.0.0/16",
+												RouteGateway: "192.168.10.1",
+												RouteProbe: &v1alpha1.NetworkProbe{
+													ProbeTimeout: time.Second,
+													ProbeDNS: &v1alpha1.NetworkProbeDNS{
+														DNSName:   "example.com",
+														DNSServer: "192.168.10.1:53",
+													},
+												},
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+				ClusterConfig: &v1alpha1.ClusterConfig{},
+			},
+		),
+	)
+
+	suite.Require().NoError(suite.State().Create(suite.Ctx(), cfg))
+
+	rtestutils.AssertResources(suite.Ctx(), suite.T(), suite.State(),
+		[]resource.ID{
+			"icmp:192.168.1.1",
+			"http:http://192.168.1.2/healthz",
+			"dns:example.com@192.168.10.1:53",
+		},
+		func(r *network.ProbeSpec, asrt *assert.Assertions) {
+			spec := r.TypedSpec()
+
+			asrt.Equal(network.ConfigMachineConfiguration, spec.ConfigLayer)
+
+			switch r.Metadata().ID() {
+			case "icmp:192.168.1.1":
+				asrt.Equal(5*time.Second, spec.Interval)
+				asrt.Equal(3, spec.FailureThreshold)
+				asrt.Equal(network.ICMPProbeSpec{Host: "192.168.1.1", Timeout: 3 * time.Second}, spec.ICMP)
+			case "http:http://192.168.1.2/healthz":
+				asrt.Equal(10*time.Second, spec.Interval)
+				asrt.Equal(5, spec.FailureThreshold)
+				asrt.Equal(network.HTTPProbeSpec{URL: "http://192.168.1.2/healthz", ExpectedStatus: 200, Timeout: 3 * time.Second}, spec.HTTP)
+			case "dns:example.com@192.168.10.1:53":
+				asrt.Equal(network.DNSProbeSpec{Name: "example.com", Server: "192.168.10.1:53", Timeout: time.Second}, spec.DNS)
+			}
+		},
+	)
+
+	suite.Require().NoError(suite.State().Destroy(suite.Ctx(), cfg.Metadata()))
+
+	for _, id := range []resource.ID{
+		"icmp:192.168.1.1",
+		"http:http://192.168.1.2/healthz",
+		"dns:example.com@192.168.10.1:53",
+	} {
+		rtestutils.AssertNoResource[*network.ProbeSpec](suite.Ctx(), suite.T(), suite.State(), id)
+	}
+}
+
+func TestProbeConfigSuite(t *testing.T) {
+	suite.Run(t, &ProbeConfigSuite{
+		DefaultSuite: ctest.DefaultSuite{
+			Timeout: 5 * time.Second,
+			AfterSetup: func(s *ctest.DefaultSuite) {
+				s.Require().NoError(s.Runtime().RegisterController(&netctrl.DeviceConfigController{}))
+				s.Require().NoError(s.Runtime().RegisterController(&netctrl.ProbeConfigController{}))
+			},
+		},
+	})
+}
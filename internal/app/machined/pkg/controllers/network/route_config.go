@@ -236,6 +236,21 @@ func (ctrl *RouteConfigController) parseCmdline(logger *zap.Logger) (routes []ne
 
 //nolint:gocyclo,cyclop
 func (ctrl *RouteConfigController) processDevicesConfiguration(logger *zap.Logger, devices []talosconfig.Device) (routes []network.RouteSpecSpec) {
+	// routes of the VRF interface and the interfaces enslaved to the VRF are placed in the VRF table
+	vrfTables := map[string]nethelpers.RoutingTable{}
+
+	for _, device := range devices {
+		if device.Ignore() || device.VRF() == nil {
+			continue
+		}
+
+		vrfTables[device.Interface()] = nethelpers.RoutingTable(device.VRF().Table())
+
+		for _, linkName := range device.VRF().Interfaces() {
+			vrfTables[linkName] = nethelpers.RoutingTable(device.VRF().Table())
+		}
+	}
+
 	convert := func(linkName string, in talosconfig.Route) (route network.RouteSpecSpec, err error) {
 		if in.Network() != "" {
 			route.Destination, err = netip.ParsePrefix(in.Network())
@@ -277,6 +292,11 @@ func (ctrl *RouteConfigController) processDevicesConfiguration(logger *zap.Logge
 		}
 
 		route.Table = nethelpers.TableMain
+
+		if table, ok := vrfTables[linkName]; ok {
+			route.Table = table
+		}
+
 		route.Protocol = nethelpers.ProtocolStatic
 		route.OutLinkName = linkName
 		route.ConfigLayer = network.ConfigMachineConfiguration
@@ -287,6 +307,15 @@ func (ctrl *RouteConfigController) processDevicesConfiguration(logger *zap.Logge
 			route.Type = nethelpers.TypeMulticast
 		}
 
+		if in.Probe() != nil {
+			probe := probeSpecFromConfig(in.Probe())
+
+			route.RequireProbe, err = probe.ID()
+			if err != nil {
+				return route, fmt.Errorf("error building route probe: %w", err)
+			}
+		}
+
 		return route, nil
 	}
 
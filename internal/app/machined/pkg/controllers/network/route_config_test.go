@@ -213,6 +213,23 @@ func (suite *RouteConfigSuite) TestMachineConfiguration() {
 									},
 								},
 							},
+							{
+								DeviceInterface: "vrf0",
+								DeviceVRF: &v1alpha1.VRF{
+									VRFInterfaces: []string{"eth5"},
+									VRFTable:      10,
+								},
+							},
+							{
+								DeviceInterface: "eth5",
+								DeviceAddresses: []string{"172.16.0.2/24"},
+								DeviceRoutes: []*v1alpha1.Route{
+									{
+										RouteNetwork: "172.17.0.0/16",
+										RouteGateway: "172.16.0.1",
+									},
+								},
+							},
 						},
 					},
 				},
@@ -229,8 +246,13 @@ func (suite *RouteConfigSuite) TestMachineConfiguration() {
 
 	suite.Require().NoError(suite.state.Create(suite.ctx, cfg))
 
+	vrfRouteID := network.LayeredID(network.ConfigMachineConfiguration, network.RouteID(
+		10, nethelpers.FamilyInet4, netip.MustParsePrefix("172.17.0.0/16"), netip.MustParseAddr("172.16.0.1"), netctrl.DefaultRouteMetric,
+	))
+
 	suite.assertRoutes(
 		[]string{
+			vrfRouteID,
 			"configuration/inet6/2001:470:6d:30e:8ed2:b60c:9d2f:803b//1024",
 			"configuration/inet4/10.0.3.1/10.0.3.0/24/1024",
 			"configuration/inet4/192.168.0.25/192.168.0.0/18/25",
@@ -238,6 +260,9 @@ func (suite *RouteConfigSuite) TestMachineConfiguration() {
 			"configuration/inet4//169.254.254.254/32/1024",
 		}, func(r *network.RouteSpec, asrt *assert.Assertions) {
 			switch r.Metadata().ID() {
+			case vrfRouteID:
+				asrt.Equal("eth5", r.TypedSpec().OutLinkName)
+				asrt.Equal(nethelpers.RoutingTable(10), r.TypedSpec().Table)
 			case "configuration/inet6/2001:470:6d:30e:8ed2:b60c:9d2f:803b//1024":
 				asrt.Equal("eth2", r.TypedSpec().OutLinkName)
 				asrt.Equal(nethelpers.FamilyInet6, r.TypedSpec().Family)
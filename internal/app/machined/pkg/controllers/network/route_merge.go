@@ -38,6 +38,11 @@ func (ctrl *RouteMergeController) Inputs() []controller.Input {
 			Type:      network.RouteSpecType,
 			Kind:      controller.InputDestroyReady,
 		},
+		{
+			Namespace: network.NamespaceName,
+			Type:      network.ProbeStatusType,
+			Kind:      controller.InputWeak,
+		},
 	}
 }
 
@@ -68,11 +73,22 @@ func (ctrl *RouteMergeController) Run(ctx context.Context, r controller.Runtime,
 			return fmt.Errorf("error listing source network routes: %w", err)
 		}
 
+		probeStatuses, err := buildProbeStatuses(ctx, r)
+		if err != nil {
+			return err
+		}
+
 		// route is allowed as long as it's not duplicate, for duplicate higher layer takes precedence
 		routes := map[string]*network.RouteSpec{}
 
 		for _, res := range list.Items {
 			route := res.(*network.RouteSpec) //nolint:errcheck,forcetypeassert
+
+			if route.TypedSpec().RequireProbe != "" && !probeStatuses[route.TypedSpec().RequireProbe] {
+				// route is withdrawn while the probe it requires is not succeeding
+				continue
+			}
+
 			id := network.RouteID(route.TypedSpec().Table, route.TypedSpec().Family, route.TypedSpec().Destination, route.TypedSpec().Gateway, route.TypedSpec().Priority)
 
 			existing, ok := routes[id]
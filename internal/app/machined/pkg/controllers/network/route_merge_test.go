@@ -225,6 +225,63 @@ func (suite *RouteMergeSuite) TestMerge() {
 	)
 }
 
+func (suite *RouteMergeSuite) TestMergeProbe() {
+	static := network.NewRouteSpec(network.ConfigNamespaceName, "configuration/inet4/10.0.0.34/10.0.0.35/32/1024")
+	*static.TypedSpec() = network.RouteSpecSpec{
+		Destination:  netip.MustParsePrefix("10.0.0.35/32"),
+		Gateway:      netip.MustParseAddr("10.0.0.34"),
+		OutLinkName:  "eth0",
+		Family:       nethelpers.FamilyInet4,
+		Scope:        nethelpers.ScopeGlobal,
+		Type:         nethelpers.TypeUnicast,
+		Table:        nethelpers.TableMain,
+		Priority:     1024,
+		RequireProbe: "icmp:10.0.0.34",
+		ConfigLayer:  network.ConfigMachineConfiguration,
+	}
+
+	suite.Require().NoError(suite.state.Create(suite.ctx, static))
+
+	// no probe status yet, route is not installed
+	time.Sleep(500 * time.Millisecond)
+
+	suite.Assert().NoError(suite.assertNoRoute("inet4/10.0.0.34/10.0.0.35/32/1024"))
+
+	probeStatus := network.NewProbeStatus(network.NamespaceName, "icmp:10.0.0.34")
+	probeStatus.TypedSpec().Success = true
+
+	suite.Require().NoError(suite.state.Create(suite.ctx, probeStatus))
+
+	suite.Assert().NoError(
+		retry.Constant(3*time.Second, retry.WithUnits(100*time.Millisecond)).Retry(
+			func() error {
+				return suite.assertRoutes(
+					[]string{
+						"inet4/10.0.0.34/10.0.0.35/32/1024",
+					}, func(r *network.RouteSpec) error {
+						suite.Assert().Equal(*static.TypedSpec(), *r.TypedSpec())
+
+						return nil
+					},
+				)
+			},
+		),
+	)
+
+	// probe fails, route is withdrawn
+	probeStatus.TypedSpec().Success = false
+
+	suite.Require().NoError(suite.state.Update(suite.ctx, probeStatus))
+
+	suite.Assert().NoError(
+		retry.Constant(3*time.Second, retry.WithUnits(100*time.Millisecond)).Retry(
+			func() error {
+				return suite.assertNoRoute("inet4/10.0.0.34/10.0.0.35/32/1024")
+			},
+		),
+	)
+}
+
 //nolint:gocyclo
 func (suite *RouteMergeSuite) TestMergeFlapping() {
 	// simulate two conflicting default route definitions which are getting removed/added constantly
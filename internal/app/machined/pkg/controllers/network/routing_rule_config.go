@@ -0,0 +1,185 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package network
+
+import (
+	"context"
+	"fmt"
+	"net/netip"
+
+	"github.com/cosi-project/runtime/pkg/controller"
+	"github.com/cosi-project/runtime/pkg/resource"
+	"github.com/cosi-project/runtime/pkg/state"
+	"go.uber.org/zap"
+
+	talosconfig "github.com/siderolabs/talos/pkg/machinery/config/config"
+	"github.com/siderolabs/talos/pkg/machinery/nethelpers"
+	"github.com/siderolabs/talos/pkg/machinery/resources/network"
+)
+
+// RoutingRuleConfigController manages network.RoutingRuleSpec based on machine configuration.
+type RoutingRuleConfigController struct{}
+
+// Name implements controller.Controller interface.
+func (ctrl *RoutingRuleConfigController) Name() string {
+	return "network.RoutingRuleConfigController"
+}
+
+// Inputs implements controller.Controller interface.
+func (ctrl *RoutingRuleConfigController) Inputs() []controller.Input {
+	return []controller.Input{
+		{
+			Namespace: network.NamespaceName,
+			Type:      network.DeviceConfigSpecType,
+			Kind:      controller.InputWeak,
+		},
+	}
+}
+
+// Outputs implements controller.Controller interface.
+func (ctrl *RoutingRuleConfigController) Outputs() []controller.Output {
+	return []controller.Output{
+		{
+			Type: network.RoutingRuleSpecType,
+			Kind: controller.OutputShared,
+		},
+	}
+}
+
+// Run implements controller.Controller interface.
+func (ctrl *RoutingRuleConfigController) Run(ctx context.Context, r controller.Runtime, logger *zap.Logger) error {
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-r.EventCh():
+		}
+
+		touchedIDs := make(map[resource.ID]struct{})
+
+		items, err := r.List(ctx, resource.NewMetadata(network.NamespaceName, network.DeviceConfigSpecType, "", resource.VersionUndefined))
+		if err != nil {
+			if !state.IsNotFoundError(err) {
+				return fmt.Errorf("error getting config: %w", err)
+			}
+		}
+
+		devices := make([]talosconfig.Device, len(items.Items))
+
+		for i, item := range items.Items {
+			devices[i] = item.(*network.DeviceConfigSpec).TypedSpec().Device
+		}
+
+		for _, rule := range ctrl.processDevicesConfiguration(logger, devices) {
+			rule := rule
+			id := network.LayeredID(rule.ConfigLayer, network.RoutingRuleID(rule.Family, rule.Priority))
+
+			if err = r.Modify(
+				ctx,
+				network.NewRoutingRuleSpec(network.ConfigNamespaceName, id),
+				func(r resource.Resource) error {
+					*r.(*network.RoutingRuleSpec).TypedSpec() = rule
+
+					return nil
+				},
+			); err != nil {
+				return fmt.Errorf("error applying machine configuration routing rule: %w", err)
+			}
+
+			touchedIDs[id] = struct{}{}
+		}
+
+		// list rules for cleanup
+		list, err := r.List(ctx, resource.NewMetadata(network.ConfigNamespaceName, network.RoutingRuleSpecType, "", resource.VersionUndefined))
+		if err != nil {
+			return fmt.Errorf("error listing resources: %w", err)
+		}
+
+		for _, res := range list.Items {
+			if res.Metadata().Owner() != ctrl.Name() {
+				// skip specs created by other controllers
+				continue
+			}
+
+			if _, ok := touchedIDs[res.Metadata().ID()]; !ok {
+				if err = r.Destroy(ctx, res.Metadata()); err != nil {
+					return fmt.Errorf("error cleaning up routing rules: %w", err)
+				}
+			}
+		}
+
+		r.ResetRestartBackoff()
+	}
+}
+
+func (ctrl *RoutingRuleConfigController) processDevicesConfiguration(logger *zap.Logger, devices []talosconfig.Device) (rules []network.RoutingRuleSpecSpec) {
+	convert := func(in talosconfig.RoutingRule) (rule network.RoutingRuleSpecSpec, families []nethelpers.Family, err error) {
+		if in.From() != "" {
+			rule.Source, err = netip.ParsePrefix(in.From())
+			if err != nil {
+				return rule, nil, fmt.Errorf("error parsing rule source: %w", err)
+			}
+		}
+
+		if in.To() != "" {
+			rule.Destination, err = netip.ParsePrefix(in.To())
+			if err != nil {
+				return rule, nil, fmt.Errorf("error parsing rule destination: %w", err)
+			}
+		}
+
+		switch {
+		case rule.Source.IsValid():
+			families = []nethelpers.Family{prefixFamily(rule.Source)}
+		case rule.Destination.IsValid():
+			families = []nethelpers.Family{prefixFamily(rule.Destination)}
+		default:
+			// rule without prefixes applies to both address families
+			families = []nethelpers.Family{nethelpers.FamilyInet4, nethelpers.FamilyInet6}
+		}
+
+		rule.Priority = in.Priority()
+		rule.FwMark = in.FwMark()
+		rule.FwMask = in.FwMask()
+		rule.InLinkName = in.IIF()
+		rule.OutLinkName = in.OIF()
+		rule.Action = nethelpers.RoutingRuleActionTable
+		rule.Table = nethelpers.RoutingTable(in.Table())
+		rule.ConfigLayer = network.ConfigMachineConfiguration
+
+		return rule, families, nil
+	}
+
+	for _, device := range devices {
+		if device.Ignore() {
+			continue
+		}
+
+		for _, in := range device.Rules() {
+			rule, families, err := convert(in)
+			if err != nil {
+				logger.Sugar().Infof("skipping routing rule %d on interface %q: %s", in.Priority(), device.Interface(), err)
+
+				continue
+			}
+
+			for _, family := range families {
+				rule.Family = family
+
+				rules = append(rules, rule)
+			}
+		}
+	}
+
+	return rules
+}
+
+func prefixFamily(prefix netip.Prefix) nethelpers.Family {
+	if prefix.Addr().Is6() {
+		return nethelpers.FamilyInet6
+	}
+
+	return nethelpers.FamilyInet4
+}
@@ -0,0 +1,147 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package network_test
+
+import (
+	"net/netip"
+	"testing"
+	"time"
+
+	"github.com/cosi-project/runtime/pkg/resource"
+	"github.com/cosi-project/runtime/pkg/resource/rtestutils"
+	"github.com/siderolabs/go-pointer"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/siderolabs/talos/internal/app/machined/pkg/controllers/ctest"
+	netctrl "github.com/siderolabs/talos/internal/app/machined/pkg/controllers/network"
+	"github.com/siderolabs/talos/pkg/machinery/config/container"
+	"github.com/siderolabs/talos/pkg/machinery/config/types/v1alpha1"
+	"github.com/siderolabs/talos/pkg/machinery/nethelpers"
+	"github.com/siderolabs/talos/pkg/machinery/resources/config"
+	"github.com/siderolabs/talos/pkg/machinery/resources/network"
+)
+
+type RoutingRuleConfigSuite struct {
+	ctest.DefaultSuite
+}
+
+func (suite *RoutingRuleConfigSuite) TestMachineConfiguration() {
+	cfg := config.NewMachineConfig(
+		container.NewV1Alpha1(
+			&v1alpha1.Config{
+				ConfigVersion: "v1alpha1",
+				MachineConfig: &v1alpha1.MachineConfig{
+					MachineNetwork: &v1alpha1.NetworkConfig{
+						NetworkInterfaces: []*v1alpha1.Device{
+							{
+								DeviceInterface: "eth0",
+								DeviceRules: []*v1alpha1.RoutingRule{
+									{
+										RulePriority: 1000,
+										RuleFrom:     "10.0.0.0/24",
+										RuleTable:    100,
+									},
+									{
+										RulePriority: 1001,
+										RuleTo:       "2001:db8::/32",
+										RuleIIF:      "eth0",
+										RuleTable:    101,
+									},
+								},
+							},
+							{
+								DeviceInterface: "eth1",
+								DeviceRules: []*v1alpha1.RoutingRule{
+									{
+										RulePriority: 1002,
+										RuleFwMark:   0x20,
+										RuleFwMask:   0xff,
+										RuleOIF:      "eth1",
+										RuleTable:    102,
+									},
+								},
+							},
+							{
+								DeviceIgnore:    pointer.To(true),
+								DeviceInterface: "eth2",
+								DeviceRules: []*v1alpha1.RoutingRule{
+									{
+										RulePriority: 1003,
+										RuleTable:    103,
+									},
+								},
+							},
+						},
+					},
+				},
+				ClusterConfig: &v1alpha1.ClusterConfig{},
+			},
+		),
+	)
+
+	suite.Require().NoError(suite.State().Create(suite.Ctx(), cfg))
+
+	rtestutils.AssertResources(suite.Ctx(), suite.T(), suite.State(),
+		[]resource.ID{
+			"configuration/inet4/01000",
+			"configuration/inet6/01001",
+			"configuration/inet4/01002",
+			"configuration/inet6/01002",
+		},
+		func(r *network.RoutingRuleSpec, asrt *assert.Assertions) {
+			spec := r.TypedSpec()
+
+			asrt.Equal(nethelpers.RoutingRuleActionTable, spec.Action)
+			asrt.Equal(network.ConfigMachineConfiguration, spec.ConfigLayer)
+
+			switch r.Metadata().ID() {
+			case "configuration/inet4/01000":
+				asrt.Equal(nethelpers.FamilyInet4, spec.Family)
+				asrt.Equal(netip.MustParsePrefix("10.0.0.0/24"), spec.Source)
+				asrt.Equal(nethelpers.RoutingTable(100), spec.Table)
+			case "configuration/inet6/01001":
+				asrt.Equal(nethelpers.FamilyInet6, spec.Family)
+				asrt.Equal(netip.MustParsePrefix("2001:db8::/32"), spec.Destination)
+				asrt.Equal("eth0", spec.InLinkName)
+				asrt.Equal(nethelpers.RoutingTable(101), spec.Table)
+			case "configuration/inet4/01002", "configuration/inet6/01002":
+				asrt.Equal(uint32(0x20), spec.FwMark)
+				asrt.Equal(uint32(0xff), spec.FwMask)
+				asrt.Equal("eth1", spec.OutLinkName)
+				asrt.Equal(nethelpers.RoutingTable(102), spec.Table)
+			}
+		},
+		rtestutils.WithNamespace(network.ConfigNamespaceName),
+	)
+
+	rtestutils.AssertNoResource[*network.RoutingRuleSpec](suite.Ctx(), suite.T(), suite.State(), "configuration/inet4/01003",
+		rtestutils.WithNamespace(network.ConfigNamespaceName))
+
+	// remove the rules from the configuration
+	suite.Require().NoError(suite.State().Destroy(suite.Ctx(), cfg.Metadata()))
+
+	for _, id := range []resource.ID{
+		"configuration/inet4/01000",
+		"configuration/inet6/01001",
+		"configuration/inet4/01002",
+		"configuration/inet6/01002",
+	} {
+		rtestutils.AssertNoResource[*network.RoutingRuleSpec](suite.Ctx(), suite.T(), suite.State(), id,
+			rtestutils.WithNamespace(network.ConfigNamespaceName))
+	}
+}
+
+func TestRoutingRuleConfigSuite(t *testing.T) {
+	suite.Run(t, &RoutingRuleConfigSuite{
+		DefaultSuite: ctest.DefaultSuite{
+			Timeout: 5 * time.Second,
+			AfterSetup: func(s *ctest.DefaultSuite) {
+				s.Require().NoError(s.Runtime().RegisterController(&netctrl.DeviceConfigController{}))
+				s.Require().NoError(s.Runtime().RegisterController(&netctrl.RoutingRuleConfigController{}))
+			},
+		},
+	})
+}
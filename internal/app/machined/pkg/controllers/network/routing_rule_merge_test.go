@@ -0,0 +1,98 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package network_test
+
+import (
+	"net/netip"
+	"testing"
+	"time"
+
+	"github.com/cosi-project/runtime/pkg/resource"
+	"github.com/cosi-project/runtime/pkg/resource/rtestutils"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/siderolabs/talos/internal/app/machined/pkg/controllers/ctest"
+	netctrl "github.com/siderolabs/talos/internal/app/machined/pkg/controllers/network"
+	"github.com/siderolabs/talos/pkg/machinery/nethelpers"
+	"github.com/siderolabs/talos/pkg/machinery/resources/network"
+)
+
+type RoutingRuleMergeSuite struct {
+	ctest.DefaultSuite
+}
+
+func (suite *RoutingRuleMergeSuite) TestMerge() {
+	platform := network.NewRoutingRuleSpec(network.ConfigNamespaceName, "platform/inet4/01000")
+	*platform.TypedSpec() = network.RoutingRuleSpecSpec{
+		Family:      nethelpers.FamilyInet4,
+		Priority:    1000,
+		Source:      netip.MustParsePrefix("10.0.0.0/24"),
+		Action:      nethelpers.RoutingRuleActionTable,
+		Table:       nethelpers.RoutingTable(100),
+		ConfigLayer: network.ConfigPlatform,
+	}
+
+	static := network.NewRoutingRuleSpec(network.ConfigNamespaceName, "configuration/inet4/01000")
+	*static.TypedSpec() = network.RoutingRuleSpecSpec{
+		Family:      nethelpers.FamilyInet4,
+		Priority:    1000,
+		Source:      netip.MustParsePrefix("10.0.1.0/24"),
+		Action:      nethelpers.RoutingRuleActionTable,
+		Table:       nethelpers.RoutingTable(101),
+		ConfigLayer: network.ConfigMachineConfiguration,
+	}
+
+	other := network.NewRoutingRuleSpec(network.ConfigNamespaceName, "configuration/inet6/01001")
+	*other.TypedSpec() = network.RoutingRuleSpecSpec{
+		Family:      nethelpers.FamilyInet6,
+		Priority:    1001,
+		FwMark:      0x20,
+		Action:      nethelpers.RoutingRuleActionTable,
+		Table:       nethelpers.RoutingTable(102),
+		ConfigLayer: network.ConfigMachineConfiguration,
+	}
+
+	for _, res := range []resource.Resource{platform, static, other} {
+		suite.Require().NoError(suite.State().Create(suite.Ctx(), res), "%v", res.Spec())
+	}
+
+	rtestutils.AssertResources(suite.Ctx(), suite.T(), suite.State(), []resource.ID{"inet4/01000", "inet6/01001"},
+		func(r *network.RoutingRuleSpec, asrt *assert.Assertions) {
+			asrt.Equal(resource.PhaseRunning, r.Metadata().Phase())
+
+			switch r.Metadata().ID() {
+			case "inet4/01000":
+				asrt.Equal(*static.TypedSpec(), *r.TypedSpec())
+			case "inet6/01001":
+				asrt.Equal(*other.TypedSpec(), *r.TypedSpec())
+			}
+		},
+	)
+
+	// lower layer takes over once the higher layer is removed
+	suite.Require().NoError(suite.State().Destroy(suite.Ctx(), static.Metadata()))
+
+	rtestutils.AssertResources(suite.Ctx(), suite.T(), suite.State(), []resource.ID{"inet4/01000"},
+		func(r *network.RoutingRuleSpec, asrt *assert.Assertions) {
+			asrt.Equal(*platform.TypedSpec(), *r.TypedSpec())
+		},
+	)
+
+	suite.Require().NoError(suite.State().Destroy(suite.Ctx(), other.Metadata()))
+
+	rtestutils.AssertNoResource[*network.RoutingRuleSpec](suite.Ctx(), suite.T(), suite.State(), "inet6/01001")
+}
+
+func TestRoutingRuleMergeSuite(t *testing.T) {
+	suite.Run(t, &RoutingRuleMergeSuite{
+		DefaultSuite: ctest.DefaultSuite{
+			Timeout: 5 * time.Second,
+			AfterSetup: func(s *ctest.DefaultSuite) {
+				s.Require().NoError(s.Runtime().RegisterController(&netctrl.RoutingRuleMergeController{}))
+			},
+		},
+	})
+}
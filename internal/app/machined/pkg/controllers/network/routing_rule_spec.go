@@ -0,0 +1,266 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package network
+
+import (
+	"context"
+	"fmt"
+	"net"
+
+	"github.com/cosi-project/runtime/pkg/controller"
+	"github.com/cosi-project/runtime/pkg/resource"
+	"github.com/hashicorp/go-multierror"
+	"github.com/jsimonetti/rtnetlink"
+	"github.com/siderolabs/go-pointer"
+	"go.uber.org/zap"
+	"golang.org/x/sys/unix"
+
+	"github.com/siderolabs/talos/pkg/machinery/nethelpers"
+	"github.com/siderolabs/talos/pkg/machinery/resources/network"
+)
+
+// RoutingRuleSpecController applies network.RoutingRuleSpec to the kernel.
+type RoutingRuleSpecController struct{}
+
+// Name implements controller.Controller interface.
+func (ctrl *RoutingRuleSpecController) Name() string {
+	return "network.RoutingRuleSpecController"
+}
+
+// Inputs implements controller.Controller interface.
+func (ctrl *RoutingRuleSpecController) Inputs() []controller.Input {
+	return []controller.Input{
+		{
+			Namespace: network.NamespaceName,
+			Type:      network.RoutingRuleSpecType,
+			Kind:      controller.InputStrong,
+		},
+	}
+}
+
+// Outputs implements controller.Controller interface.
+func (ctrl *RoutingRuleSpecController) Outputs() []controller.Output {
+	return nil
+}
+
+// Run implements controller.Controller interface.
+func (ctrl *RoutingRuleSpecController) Run(ctx context.Context, r controller.Runtime, logger *zap.Logger) error {
+	conn, err := rtnetlink.Dial(nil)
+	if err != nil {
+		return fmt.Errorf("error dialing rtnetlink socket: %w", err)
+	}
+
+	defer conn.Close() //nolint:errcheck
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-r.EventCh():
+		}
+
+		// list source network configuration resources
+		list, err := r.List(ctx, resource.NewMetadata(network.NamespaceName, network.RoutingRuleSpecType, "", resource.VersionUndefined))
+		if err != nil {
+			return fmt.Errorf("error listing source routing rules: %w", err)
+		}
+
+		// add finalizers for all live resources
+		for _, res := range list.Items {
+			if res.Metadata().Phase() != resource.PhaseRunning {
+				continue
+			}
+
+			if err = r.AddFinalizer(ctx, res.Metadata(), ctrl.Name()); err != nil {
+				return fmt.Errorf("error adding finalizer: %w", err)
+			}
+		}
+
+		// list rtnetlink rules
+		rules, err := conn.Rule.List()
+		if err != nil {
+			return fmt.Errorf("error listing rules: %w", err)
+		}
+
+		var multiErr *multierror.Error
+
+		// loop over rules and make reconcile decision
+		for _, res := range list.Items {
+			rule := res.(*network.RoutingRuleSpec) //nolint:forcetypeassert,errcheck
+
+			if err = ctrl.syncRule(ctx, r, logger, conn, rules, rule); err != nil {
+				multiErr = multierror.Append(multiErr, err)
+			}
+		}
+
+		if err = multiErr.ErrorOrNil(); err != nil {
+			return err
+		}
+
+		r.ResetRestartBackoff()
+	}
+}
+
+// findRules returns the rules owned by Talos at the family and priority.
+//
+// Linux allows several rules at the same priority, so rules managed by others (e.g. KubeSpan or CNI)
+// might share the priority with the configured ones, they are told apart by the protocol.
+func findRules(rules []rtnetlink.RuleMessage, family nethelpers.Family, priority uint32) []*rtnetlink.RuleMessage {
+	var result []*rtnetlink.RuleMessage //nolint:prealloc
+
+	for i, rule := range rules {
+		if rule.Family != uint8(family) {
+			continue
+		}
+
+		if rule.Attributes == nil || pointer.SafeDeref(rule.Attributes.Priority) != priority {
+			continue
+		}
+
+		if pointer.SafeDeref(rule.Attributes.Protocol) != uint8(nethelpers.ProtocolStatic) {
+			continue
+		}
+
+		result = append(result, &rules[i])
+	}
+
+	return result
+}
+
+// ruleMatchesSpec checks whether the existing kernel rule matches the spec.
+func ruleMatchesSpec(existing *rtnetlink.RuleMessage, spec *network.RoutingRuleSpecSpec) bool {
+	status := decodeRule(existing)
+
+	fwMask := spec.FwMask
+	if spec.FwMark != 0 && fwMask == 0 {
+		// kernel defaults the mask to all ones if the mark is set
+		fwMask = 0xffffffff
+	}
+
+	return status.Source == spec.Source &&
+		status.Destination == spec.Destination &&
+		status.FwMark == spec.FwMark &&
+		status.FwMask == fwMask &&
+		status.InLinkName == spec.InLinkName &&
+		status.OutLinkName == spec.OutLinkName &&
+		status.Action == spec.Action &&
+		status.Table == spec.Table
+}
+
+func ruleMessage(spec *network.RoutingRuleSpecSpec) *rtnetlink.RuleMessage {
+	msg := &rtnetlink.RuleMessage{
+		Family: uint8(spec.Family),
+		Action: uint8(spec.Action),
+		Table:  unix.RT_TABLE_UNSPEC,
+		Attributes: &rtnetlink.RuleAttributes{
+			Priority: pointer.To(spec.Priority),
+			Table:    pointer.To(uint32(spec.Table)),
+			Protocol: pointer.To(uint8(nethelpers.ProtocolStatic)),
+		},
+	}
+
+	// table IDs which fit into the header are also set in the header, larger IDs are passed only as an attribute
+	if spec.Table < 256 {
+		msg.Table = uint8(spec.Table)
+	}
+
+	if spec.Source.IsValid() {
+		msg.SrcLength = uint8(spec.Source.Bits())
+		msg.Attributes.Src = pointer.To(net.IP(spec.Source.Addr().AsSlice()))
+	}
+
+	if spec.Destination.IsValid() {
+		msg.DstLength = uint8(spec.Destination.Bits())
+		msg.Attributes.Dst = pointer.To(net.IP(spec.Destination.Addr().AsSlice()))
+	}
+
+	if spec.FwMark != 0 {
+		msg.Attributes.FwMark = pointer.To(spec.FwMark)
+
+		if spec.FwMask != 0 {
+			msg.Attributes.FwMask = pointer.To(spec.FwMask)
+		}
+	}
+
+	if spec.InLinkName != "" {
+		msg.Attributes.IIFName = pointer.To(spec.InLinkName)
+	}
+
+	if spec.OutLinkName != "" {
+		msg.Attributes.OIFName = pointer.To(spec.OutLinkName)
+	}
+
+	return msg
+}
+
+func (ctrl *RoutingRuleSpecController) syncRule(ctx context.Context, r controller.Runtime, logger *zap.Logger, conn *rtnetlink.Conn,
+	rules []rtnetlink.RuleMessage, rule *network.RoutingRuleSpec,
+) error {
+	spec := rule.TypedSpec()
+
+	switch rule.Metadata().Phase() {
+	case resource.PhaseTearingDown:
+		for _, existing := range findRules(rules, spec.Family, spec.Priority) {
+			if err := conn.Rule.Delete(existing); err != nil {
+				return fmt.Errorf("error removing rule: %w", err)
+			}
+
+			logger.Info("deleted routing rule",
+				zap.Stringer("family", spec.Family),
+				zap.Uint32("priority", spec.Priority),
+				zap.Stringer("table", spec.Table),
+			)
+		}
+
+		// now remove finalizer as rule was deleted
+		if err := r.RemoveFinalizer(ctx, rule.Metadata(), ctrl.Name()); err != nil {
+			return fmt.Errorf("error removing finalizer: %w", err)
+		}
+	case resource.PhaseRunning:
+		matchFound := false
+
+		for _, existing := range findRules(rules, spec.Family, spec.Priority) {
+			if ruleMatchesSpec(existing, spec) {
+				matchFound = true
+
+				continue
+			}
+
+			// delete the rule, it doesn't match the spec
+			if err := conn.Rule.Delete(existing); err != nil {
+				return fmt.Errorf("error removing rule: %w", err)
+			}
+
+			logger.Debug("removed routing rule due to mismatch",
+				zap.Stringer("family", spec.Family),
+				zap.Uint32("priority", spec.Priority),
+				zap.Stringer("table", spec.Table),
+			)
+		}
+
+		if matchFound {
+			return nil
+		}
+
+		msg := ruleMessage(spec)
+
+		if err := conn.Rule.Add(msg); err != nil {
+			return fmt.Errorf("error adding rule: %w, message %+v", err, *msg)
+		}
+
+		logger.Info("created routing rule",
+			zap.Stringer("family", spec.Family),
+			zap.Uint32("priority", spec.Priority),
+			zap.Stringer("src", spec.Source),
+			zap.Stringer("dst", spec.Destination),
+			zap.Uint32("fwmark", spec.FwMark),
+			zap.String("iif", spec.InLinkName),
+			zap.String("oif", spec.OutLinkName),
+			zap.Stringer("table", spec.Table),
+		)
+	}
+
+	return nil
+}
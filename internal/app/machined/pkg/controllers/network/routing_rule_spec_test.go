@@ -0,0 +1,230 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package network_test
+
+import (
+	"fmt"
+	"net/netip"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/cosi-project/runtime/pkg/resource"
+	"github.com/cosi-project/runtime/pkg/resource/rtestutils"
+	"github.com/jsimonetti/rtnetlink"
+	"github.com/siderolabs/go-pointer"
+	"github.com/siderolabs/go-retry/retry"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/siderolabs/talos/internal/app/machined/pkg/controllers/ctest"
+	netctrl "github.com/siderolabs/talos/internal/app/machined/pkg/controllers/network"
+	"github.com/siderolabs/talos/pkg/machinery/nethelpers"
+	"github.com/siderolabs/talos/pkg/machinery/resources/network"
+)
+
+type RoutingRuleSpecSuite struct {
+	ctest.DefaultSuite
+}
+
+func (suite *RoutingRuleSpecSuite) findRule(priority uint32) (*rtnetlink.RuleMessage, error) {
+	conn, err := rtnetlink.Dial(nil)
+	suite.Require().NoError(err)
+
+	defer conn.Close() //nolint:errcheck
+
+	rules, err := conn.Rule.List()
+	suite.Require().NoError(err)
+
+	for i, rule := range rules {
+		if rule.Family == uint8(nethelpers.FamilyInet4) && rule.Attributes != nil && pointer.SafeDeref(rule.Attributes.Priority) == priority {
+			return &rules[i], nil
+		}
+	}
+
+	return nil, fmt.Errorf("rule with priority %d not found", priority)
+}
+
+// listRules returns the rules at the priority.
+func (suite *RoutingRuleSpecSuite) listRules(priority uint32) []rtnetlink.RuleMessage {
+	conn, err := rtnetlink.Dial(nil)
+	suite.Require().NoError(err)
+
+	defer conn.Close() //nolint:errcheck
+
+	rules, err := conn.Rule.List()
+	suite.Require().NoError(err)
+
+	var result []rtnetlink.RuleMessage
+
+	for _, rule := range rules {
+		if rule.Family == uint8(nethelpers.FamilyInet4) && rule.Attributes != nil && pointer.SafeDeref(rule.Attributes.Priority) == priority {
+			result = append(result, rule)
+		}
+	}
+
+	return result
+}
+
+func (suite *RoutingRuleSpecSuite) TestForeignRule() {
+	conn, err := rtnetlink.Dial(nil)
+	suite.Require().NoError(err)
+
+	defer conn.Close() //nolint:errcheck
+
+	// the rule added by someone else (e.g. KubeSpan or CNI) at the same priority, without the static protocol
+	foreign := &rtnetlink.RuleMessage{
+		Family: uint8(nethelpers.FamilyInet4),
+		Action: uint8(nethelpers.RoutingRuleActionTable),
+		Attributes: &rtnetlink.RuleAttributes{
+			Priority: pointer.To[uint32](31124),
+			Table:    pointer.To[uint32](1600),
+			FwMark:   pointer.To[uint32](0x43),
+		},
+	}
+
+	suite.Require().NoError(conn.Rule.Add(foreign))
+
+	defer conn.Rule.Delete(foreign) //nolint:errcheck
+
+	isForeign := func(rule rtnetlink.RuleMessage) bool {
+		return pointer.SafeDeref(rule.Attributes.Table) == 1600
+	}
+
+	rule := network.NewRoutingRuleSpec(network.NamespaceName, "inet4/31124")
+	*rule.TypedSpec() = network.RoutingRuleSpecSpec{
+		Family:      nethelpers.FamilyInet4,
+		Priority:    31124,
+		Source:      netip.MustParsePrefix("10.151.0.0/16"),
+		Action:      nethelpers.RoutingRuleActionTable,
+		Table:       nethelpers.RoutingTable(1500),
+		ConfigLayer: network.ConfigMachineConfiguration,
+	}
+
+	suite.Require().NoError(suite.State().Create(suite.Ctx(), rule))
+
+	suite.Assert().NoError(
+		retry.Constant(3*time.Second, retry.WithUnits(100*time.Millisecond)).Retry(
+			func() error {
+				rules := suite.listRules(31124)
+
+				if len(rules) != 2 {
+					return retry.ExpectedErrorf("expected 2 rules, got %d", len(rules))
+				}
+
+				return nil
+			},
+		),
+	)
+
+	// the spec rule is added next to the foreign rule, which is left intact
+	rules := suite.listRules(31124)
+	suite.Require().Len(rules, 2)
+
+	for _, existing := range rules {
+		if isForeign(existing) {
+			suite.Assert().EqualValues(0x43, pointer.SafeDeref(existing.Attributes.FwMark))
+		} else {
+			suite.Assert().EqualValues(1500, pointer.SafeDeref(existing.Attributes.Table))
+			suite.Assert().EqualValues(nethelpers.ProtocolStatic, pointer.SafeDeref(existing.Attributes.Protocol))
+		}
+	}
+
+	// teardown the rule
+	for {
+		ready, err := suite.State().Teardown(suite.Ctx(), rule.Metadata())
+		suite.Require().NoError(err)
+
+		if ready {
+			break
+		}
+
+		time.Sleep(100 * time.Millisecond)
+	}
+
+	// only the spec rule is removed
+	rules = suite.listRules(31124)
+	suite.Require().Len(rules, 1)
+	suite.Assert().True(isForeign(rules[0]))
+
+	suite.Require().NoError(suite.State().Destroy(suite.Ctx(), rule.Metadata()))
+}
+
+func (suite *RoutingRuleSpecSuite) TestRule() {
+	rule := network.NewRoutingRuleSpec(network.NamespaceName, "inet4/31123")
+	*rule.TypedSpec() = network.RoutingRuleSpecSpec{
+		Family:      nethelpers.FamilyInet4,
+		Priority:    31123,
+		Source:      netip.MustParsePrefix("10.150.0.0/16"),
+		FwMark:      0x42,
+		Action:      nethelpers.RoutingRuleActionTable,
+		Table:       nethelpers.RoutingTable(1500),
+		ConfigLayer: network.ConfigMachineConfiguration,
+	}
+
+	suite.Require().NoError(suite.State().Create(suite.Ctx(), rule))
+
+	suite.Assert().NoError(
+		retry.Constant(3*time.Second, retry.WithUnits(100*time.Millisecond)).Retry(
+			func() error {
+				existing, err := suite.findRule(31123)
+				if err != nil {
+					return retry.ExpectedError(err)
+				}
+
+				suite.Assert().EqualValues(16, existing.SrcLength)
+				suite.Assert().EqualValues(1500, pointer.SafeDeref(existing.Attributes.Table))
+				suite.Assert().EqualValues(0x42, pointer.SafeDeref(existing.Attributes.FwMark))
+
+				return nil
+			},
+		),
+	)
+
+	rtestutils.AssertResources(suite.Ctx(), suite.T(), suite.State(), []resource.ID{"inet4/31123"},
+		func(r *network.RoutingRuleStatus, asrt *assert.Assertions) {
+			asrt.Equal(netip.MustParsePrefix("10.150.0.0/16"), r.TypedSpec().Source)
+			asrt.Equal(nethelpers.RoutingTable(1500), r.TypedSpec().Table)
+			asrt.Equal(nethelpers.RoutingRuleActionTable, r.TypedSpec().Action)
+			asrt.Equal(nethelpers.ProtocolStatic, r.TypedSpec().Protocol)
+		},
+	)
+
+	// teardown the rule
+	for {
+		ready, err := suite.State().Teardown(suite.Ctx(), rule.Metadata())
+		suite.Require().NoError(err)
+
+		if ready {
+			break
+		}
+
+		time.Sleep(100 * time.Millisecond)
+	}
+
+	// torn down rule should be removed immediately
+	_, err := suite.findRule(31123)
+	suite.Assert().Error(err)
+
+	suite.Require().NoError(suite.State().Destroy(suite.Ctx(), rule.Metadata()))
+
+	rtestutils.AssertNoResource[*network.RoutingRuleStatus](suite.Ctx(), suite.T(), suite.State(), "inet4/31123")
+}
+
+func TestRoutingRuleSpecSuite(t *testing.T) {
+	if os.Geteuid() != 0 {
+		t.Skip("requires root")
+	}
+
+	suite.Run(t, &RoutingRuleSpecSuite{
+		DefaultSuite: ctest.DefaultSuite{
+			Timeout: 10 * time.Second,
+			AfterSetup: func(s *ctest.DefaultSuite) {
+				s.Require().NoError(s.Runtime().RegisterController(&netctrl.RoutingRuleSpecController{}))
+				s.Require().NoError(s.Runtime().RegisterController(&netctrl.RoutingRuleStatusController{}))
+			},
+		},
+	})
+}
@@ -0,0 +1,166 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package network
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"net/netip"
+
+	"github.com/cosi-project/runtime/pkg/controller"
+	"github.com/cosi-project/runtime/pkg/resource"
+	"github.com/jsimonetti/rtnetlink"
+	"github.com/siderolabs/go-pointer"
+	"go.uber.org/zap"
+	"golang.org/x/sys/unix"
+
+	"github.com/siderolabs/talos/internal/app/machined/pkg/controllers/network/watch"
+	"github.com/siderolabs/talos/pkg/machinery/nethelpers"
+	"github.com/siderolabs/talos/pkg/machinery/resources/network"
+)
+
+// RoutingRuleStatusController manages network.RoutingRuleStatus based on the kernel policy routing rules.
+type RoutingRuleStatusController struct{}
+
+// Name implements controller.Controller interface.
+func (ctrl *RoutingRuleStatusController) Name() string {
+	return "network.RoutingRuleStatusController"
+}
+
+// Inputs implements controller.Controller interface.
+func (ctrl *RoutingRuleStatusController) Inputs() []controller.Input {
+	return nil
+}
+
+// Outputs implements controller.Controller interface.
+func (ctrl *RoutingRuleStatusController) Outputs() []controller.Output {
+	return []controller.Output{
+		{
+			Type: network.RoutingRuleStatusType,
+			Kind: controller.OutputExclusive,
+		},
+	}
+}
+
+// Run implements controller.Controller interface.
+func (ctrl *RoutingRuleStatusController) Run(ctx context.Context, r controller.Runtime, logger *zap.Logger) error {
+	watcher, err := watch.NewRtNetlink(r, unix.RTMGRP_IPV4_RULE|1<<(unix.RTNLGRP_IPV6_RULE-1))
+	if err != nil {
+		return err
+	}
+
+	defer watcher.Done()
+
+	conn, err := rtnetlink.Dial(nil)
+	if err != nil {
+		return fmt.Errorf("error dialing rtnetlink socket: %w", err)
+	}
+
+	defer conn.Close() //nolint:errcheck
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-r.EventCh():
+		}
+
+		// list resources for cleanup
+		list, err := r.List(ctx, resource.NewMetadata(network.NamespaceName, network.RoutingRuleStatusType, "", resource.VersionUndefined))
+		if err != nil {
+			return fmt.Errorf("error listing resources: %w", err)
+		}
+
+		itemsToDelete := map[resource.ID]struct{}{}
+
+		for _, r := range list.Items {
+			itemsToDelete[r.Metadata().ID()] = struct{}{}
+		}
+
+		rules, err := conn.Rule.List()
+		if err != nil {
+			return fmt.Errorf("error listing rules: %w", err)
+		}
+
+		touchedIDs := map[resource.ID]struct{}{}
+
+		for i := range rules {
+			status := decodeRule(&rules[i])
+			id := network.RoutingRuleID(status.Family, status.Priority)
+
+			// the kernel allows several rules with the same priority, disambiguate them by the order
+			for n := 1; ; n++ {
+				if _, touched := touchedIDs[id]; !touched {
+					break
+				}
+
+				id = fmt.Sprintf("%s/%d", network.RoutingRuleID(status.Family, status.Priority), n)
+			}
+
+			if err = r.Modify(ctx, network.NewRoutingRuleStatus(network.NamespaceName, id), func(r resource.Resource) error {
+				*r.(*network.RoutingRuleStatus).TypedSpec() = status
+
+				return nil
+			}); err != nil {
+				return fmt.Errorf("error modifying resource: %w", err)
+			}
+
+			touchedIDs[id] = struct{}{}
+
+			delete(itemsToDelete, id)
+		}
+
+		for id := range itemsToDelete {
+			if err = r.Destroy(ctx, resource.NewMetadata(network.NamespaceName, network.RoutingRuleStatusType, id, resource.VersionUndefined)); err != nil {
+				return fmt.Errorf("error deleting routing rule status %q: %w", id, err)
+			}
+		}
+
+		r.ResetRestartBackoff()
+	}
+}
+
+func decodeRule(rule *rtnetlink.RuleMessage) network.RoutingRuleStatusSpec {
+	status := network.RoutingRuleStatusSpec{
+		Family: nethelpers.Family(rule.Family),
+		Action: nethelpers.RoutingRuleAction(rule.Action),
+		Table:  nethelpers.RoutingTable(rule.Table),
+	}
+
+	attrs := rule.Attributes
+	if attrs == nil {
+		return status
+	}
+
+	status.Priority = pointer.SafeDeref(attrs.Priority)
+	status.FwMark = pointer.SafeDeref(attrs.FwMark)
+	status.FwMask = pointer.SafeDeref(attrs.FwMask)
+	status.InLinkName = pointer.SafeDeref(attrs.IIFName)
+	status.OutLinkName = pointer.SafeDeref(attrs.OIFName)
+	status.Protocol = nethelpers.RouteProtocol(pointer.SafeDeref(attrs.Protocol))
+
+	if attrs.Table != nil {
+		status.Table = nethelpers.RoutingTable(*attrs.Table)
+	}
+
+	status.Source = rulePrefix(attrs.Src, rule.SrcLength)
+	status.Destination = rulePrefix(attrs.Dst, rule.DstLength)
+
+	return status
+}
+
+func rulePrefix(ip *net.IP, length uint8) netip.Prefix {
+	if ip == nil {
+		return netip.Prefix{}
+	}
+
+	addr, ok := netip.AddrFromSlice(*ip)
+	if !ok {
+		return netip.Prefix{}
+	}
+
+	return netip.PrefixFrom(addr.Unmap(), int(length))
+}
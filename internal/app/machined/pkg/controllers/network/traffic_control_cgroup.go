@@ -0,0 +1,67 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package network
+
+import (
+	"fmt"
+	"path/filepath"
+	"strings"
+
+	"github.com/cilium/ebpf"
+	"github.com/cilium/ebpf/asm"
+	"golang.org/x/sys/unix"
+)
+
+// loadCgroupClassifier loads the tc classifier which matches the packets sent by the sockets of the cgroup (v2)
+// or any of its descendants.
+//
+// The classifier compares the ancestor of the socket cgroup at the level of the configured cgroup with the cgroup ID,
+// see cgroupIDLevel. The ID is built into the program, so the filter is replaced if the cgroup is re-created.
+func loadCgroupClassifier(cgroupID uint64, level int) (*ebpf.Program, error) {
+	prog, err := ebpf.NewProgram(&ebpf.ProgramSpec{
+		Name: "talos_cgroup",
+		Type: ebpf.SchedCLS,
+		Instructions: asm.Instructions{
+			// r0 = bpf_skb_ancestor_cgroup_id(skb, level), skb is already in r1
+			asm.Mov.Imm(asm.R2, int32(level)),
+			asm.FnSkbAncestorCgroupId.Call(),
+			asm.LoadImm(asm.R1, int64(cgroupID), asm.DWord),
+			asm.JEq.Reg(asm.R0, asm.R1, "match"),
+			// no match, continue with the next filter
+			asm.Mov.Imm(asm.R0, 0),
+			asm.Return(),
+			// -1 picks the class of the filter
+			asm.Mov.Imm(asm.R0, -1).WithSymbol("match"),
+			asm.Return(),
+		},
+		License: "Dual MPL/GPL",
+	})
+	if err != nil {
+		return nil, fmt.Errorf("error loading cgroup classifier: %w", err)
+	}
+
+	return prog, nil
+}
+
+// cgroupIDLevel returns the ID of the cgroup and its level in the hierarchy (root cgroup is at level 0).
+//
+// The ID is the inode number of the cgroup directory, so it changes when the cgroup is re-created.
+func cgroupIDLevel(cgroupRoot, cgroupPath string) (uint64, int, error) {
+	cgroupPath = filepath.Clean("/" + cgroupPath)
+
+	var st unix.Stat_t
+
+	if err := unix.Stat(filepath.Join(cgroupRoot, cgroupPath), &st); err != nil {
+		return 0, 0, fmt.Errorf("error resolving cgroup %q: %w", cgroupPath, err)
+	}
+
+	level := 0
+
+	if cgroupPath != "/" {
+		level = strings.Count(cgroupPath, "/")
+	}
+
+	return st.Ino, level, nil
+}
@@ -0,0 +1,157 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package network
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/cosi-project/runtime/pkg/controller"
+	"github.com/cosi-project/runtime/pkg/resource"
+	"github.com/cosi-project/runtime/pkg/safe"
+	"github.com/cosi-project/runtime/pkg/state"
+	"github.com/siderolabs/go-pointer"
+	"go.uber.org/zap"
+
+	talosconfig "github.com/siderolabs/talos/pkg/machinery/config/config"
+	"github.com/siderolabs/talos/pkg/machinery/resources/config"
+	"github.com/siderolabs/talos/pkg/machinery/resources/network"
+)
+
+// TrafficControlConfigController manages network.TrafficControlSpec based on machine configuration.
+type TrafficControlConfigController struct{}
+
+// Name implements controller.Controller interface.
+func (ctrl *TrafficControlConfigController) Name() string {
+	return "network.TrafficControlConfigController"
+}
+
+// Inputs implements controller.Controller interface.
+func (ctrl *TrafficControlConfigController) Inputs() []controller.Input {
+	return []controller.Input{
+		{
+			Namespace: config.NamespaceName,
+			Type:      config.MachineConfigType,
+			ID:        pointer.To(config.V1Alpha1ID),
+			Kind:      controller.InputWeak,
+		},
+	}
+}
+
+// Outputs implements controller.Controller interface.
+func (ctrl *TrafficControlConfigController) Outputs() []controller.Output {
+	return []controller.Output{
+		{
+			Type: network.TrafficControlSpecType,
+			Kind: controller.OutputShared,
+		},
+	}
+}
+
+// Run implements controller.Controller interface.
+func (ctrl *TrafficControlConfigController) Run(ctx context.Context, r controller.Runtime, _ *zap.Logger) error {
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-r.EventCh():
+		}
+
+		cfg, err := safe.ReaderGetByID[*config.MachineConfig](ctx, r, config.V1Alpha1ID)
+		if err != nil && !state.IsNotFoundError(err) {
+			return fmt.Errorf("error getting machine config: %w", err)
+		}
+
+		touchedIDs := make(map[resource.ID]struct{})
+
+		if cfg != nil {
+			for _, tcConfig := range cfg.Config().TrafficControlConfigs() {
+				id := network.LayeredID(network.ConfigMachineConfiguration, tcConfig.Name())
+
+				if err = safe.WriterModify(ctx, r, network.NewTrafficControlSpec(network.ConfigNamespaceName, id),
+					func(spec *network.TrafficControlSpec) error {
+						*spec.TypedSpec() = buildTrafficControlSpec(tcConfig)
+
+						return nil
+					}); err != nil {
+					return fmt.Errorf("error updating traffic control spec: %w", err)
+				}
+
+				touchedIDs[id] = struct{}{}
+			}
+		}
+
+		// list specs for cleanup
+		list, err := safe.ReaderList[*network.TrafficControlSpec](ctx, r, network.NewTrafficControlSpec(network.ConfigNamespaceName, "").Metadata())
+		if err != nil {
+			return fmt.Errorf("error listing resources: %w", err)
+		}
+
+		for it := safe.IteratorFromList(list); it.Next(); {
+			if it.Value().Metadata().Owner() != ctrl.Name() {
+				// skip specs created by other controllers
+				continue
+			}
+
+			if _, ok := touchedIDs[it.Value().Metadata().ID()]; ok {
+				continue
+			}
+
+			if err = r.Destroy(ctx, it.Value().Metadata()); err != nil {
+				return fmt.Errorf("error cleaning up traffic control specs: %w", err)
+			}
+		}
+
+		r.ResetRestartBackoff()
+	}
+}
+
+func buildTrafficControlSpec(cfg talosconfig.TrafficControlConfig) network.TrafficControlSpecSpec {
+	fqCodel := cfg.FQCodel()
+	htb := cfg.HTB()
+	tbf := cfg.TBF()
+
+	spec := network.TrafficControlSpecSpec{
+		LinkName: cfg.Name(),
+		Qdisc:    cfg.Qdisc(),
+		FQCodel: network.TrafficControlFQCodelSpec{
+			Limit:    fqCodel.Limit,
+			Flows:    fqCodel.Flows,
+			Interval: fqCodel.Interval,
+			ECN:      fqCodel.ECN,
+		},
+		HTB: network.TrafficControlHTBSpec{
+			DefaultClass: htb.DefaultClass,
+		},
+		TBF: network.TrafficControlTBFSpec{
+			Rate:    tbf.Rate,
+			Burst:   tbf.Burst,
+			Latency: tbf.Latency,
+		},
+		ConfigLayer: network.ConfigMachineConfiguration,
+	}
+
+	for _, class := range htb.Classes {
+		spec.HTB.Classes = append(spec.HTB.Classes, network.TrafficControlHTBClassSpec{
+			ClassID:  class.ClassID,
+			Rate:     class.Rate,
+			Ceil:     class.Ceil,
+			Priority: class.Priority,
+		})
+	}
+
+	for _, filter := range cfg.Filters() {
+		spec.Filters = append(spec.Filters, network.TrafficControlFilterSpec{
+			Kind:       filter.Kind,
+			ClassID:    filter.ClassID,
+			FwMark:     filter.FwMark,
+			FwMask:     filter.FwMask,
+			DSCP:       filter.DSCP,
+			CgroupPath: filter.CgroupPath,
+		})
+	}
+
+	return spec
+}
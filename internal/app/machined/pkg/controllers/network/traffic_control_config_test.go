@@ -0,0 +1,149 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package network_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/cosi-project/runtime/pkg/resource"
+	"github.com/cosi-project/runtime/pkg/resource/rtestutils"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/siderolabs/talos/internal/app/machined/pkg/controllers/ctest"
+	netctrl "github.com/siderolabs/talos/internal/app/machined/pkg/controllers/network"
+	"github.com/siderolabs/talos/pkg/machinery/config/container"
+	networkcfg "github.com/siderolabs/talos/pkg/machinery/config/types/network"
+	"github.com/siderolabs/talos/pkg/machinery/config/types/v1alpha1"
+	"github.com/siderolabs/talos/pkg/machinery/nethelpers"
+	"github.com/siderolabs/talos/pkg/machinery/resources/config"
+	"github.com/siderolabs/talos/pkg/machinery/resources/network"
+)
+
+type TrafficControlConfigSuite struct {
+	ctest.DefaultSuite
+}
+
+func (suite *TrafficControlConfigSuite) TestConfig() {
+	eth0Cfg := networkcfg.NewTrafficControlConfigV1Alpha1("eth0")
+	eth0Cfg.QdiscKind = nethelpers.QdiscKindHTB
+	eth0Cfg.HTBConfig = networkcfg.TrafficControlHTBConfig{
+		DefaultClass: 20,
+		Classes: []networkcfg.TrafficControlHTBClassConfig{
+			{
+				ClassID:  10,
+				Rate:     125_000_000,
+				Ceil:     250_000_000,
+				Priority: 1,
+			},
+			{
+				ClassID: 20,
+				Rate:    12_500_000,
+			},
+		},
+	}
+	eth0Cfg.FilterConfigs = []networkcfg.TrafficControlFilterConfig{
+		{
+			Kind:    nethelpers.TrafficControlFilterKindDSCP,
+			ClassID: 10,
+			DSCP:    46,
+		},
+	}
+
+	eth1Cfg := networkcfg.NewTrafficControlConfigV1Alpha1("eth1")
+	eth1Cfg.QdiscKind = nethelpers.QdiscKindTBF
+	eth1Cfg.TBFConfig = networkcfg.TrafficControlTBFConfig{
+		Rate:    1_250_000,
+		Burst:   32768,
+		Latency: 50 * time.Millisecond,
+	}
+
+	cfg, err := container.New(
+		&v1alpha1.Config{
+			ConfigVersion: "v1alpha1",
+			MachineConfig: &v1alpha1.MachineConfig{},
+		},
+		eth0Cfg,
+		eth1Cfg,
+	)
+	suite.Require().NoError(err)
+
+	machineConfig := config.NewMachineConfig(cfg)
+	suite.Require().NoError(suite.State().Create(suite.Ctx(), machineConfig))
+
+	rtestutils.AssertResources(suite.Ctx(), suite.T(), suite.State(), []resource.ID{"configuration/eth0", "configuration/eth1"},
+		func(spec *network.TrafficControlSpec, asrt *assert.Assertions) {
+			switch spec.Metadata().ID() {
+			case "configuration/eth0":
+				asrt.Equal(network.TrafficControlSpecSpec{
+					LinkName: "eth0",
+					Qdisc:    nethelpers.QdiscKindHTB,
+					FQCodel: network.TrafficControlFQCodelSpec{
+						ECN: true,
+					},
+					HTB: network.TrafficControlHTBSpec{
+						DefaultClass: 20,
+						Classes: []network.TrafficControlHTBClassSpec{
+							{
+								ClassID:  10,
+								Rate:     125_000_000,
+								Ceil:     250_000_000,
+								Priority: 1,
+							},
+							{
+								ClassID: 20,
+								Rate:    12_500_000,
+								Ceil:    12_500_000,
+							},
+						},
+					},
+					Filters: []network.TrafficControlFilterSpec{
+						{
+							Kind:    nethelpers.TrafficControlFilterKindDSCP,
+							ClassID: 10,
+							DSCP:    46,
+						},
+					},
+					ConfigLayer: network.ConfigMachineConfiguration,
+				}, *spec.TypedSpec())
+			case "configuration/eth1":
+				asrt.Equal(network.TrafficControlSpecSpec{
+					LinkName: "eth1",
+					Qdisc:    nethelpers.QdiscKindTBF,
+					FQCodel: network.TrafficControlFQCodelSpec{
+						ECN: true,
+					},
+					TBF: network.TrafficControlTBFSpec{
+						Rate:    1_250_000,
+						Burst:   32768,
+						Latency: 50 * time.Millisecond,
+					},
+					ConfigLayer: network.ConfigMachineConfiguration,
+				}, *spec.TypedSpec())
+			}
+		},
+		rtestutils.WithNamespace(network.ConfigNamespaceName),
+	)
+
+	// remove the config
+	suite.Require().NoError(suite.State().Destroy(suite.Ctx(), machineConfig.Metadata()))
+
+	for _, id := range []resource.ID{"configuration/eth0", "configuration/eth1"} {
+		rtestutils.AssertNoResource[*network.TrafficControlSpec](suite.Ctx(), suite.T(), suite.State(), id,
+			rtestutils.WithNamespace(network.ConfigNamespaceName))
+	}
+}
+
+func TestTrafficControlConfigSuite(t *testing.T) {
+	suite.Run(t, &TrafficControlConfigSuite{
+		DefaultSuite: ctest.DefaultSuite{
+			Timeout: 5 * time.Second,
+			AfterSetup: func(s *ctest.DefaultSuite) {
+				s.Require().NoError(s.Runtime().RegisterController(&netctrl.TrafficControlConfigController{}))
+			},
+		},
+	})
+}
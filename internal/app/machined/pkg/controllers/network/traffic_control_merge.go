@@ -0,0 +1,139 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package network
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/cosi-project/runtime/pkg/controller"
+	"github.com/cosi-project/runtime/pkg/resource"
+	"github.com/cosi-project/runtime/pkg/state"
+	"go.uber.org/zap"
+
+	"github.com/siderolabs/talos/pkg/machinery/resources/network"
+)
+
+// TrafficControlMergeController merges network.TrafficControlSpec in network.ConfigNamespace and produces final network.TrafficControlSpec in network.Namespace.
+type TrafficControlMergeController struct{}
+
+// Name implements controller.Controller interface.
+func (ctrl *TrafficControlMergeController) Name() string {
+	return "network.TrafficControlMergeController"
+}
+
+// Inputs implements controller.Controller interface.
+func (ctrl *TrafficControlMergeController) Inputs() []controller.Input {
+	return []controller.Input{
+		{
+			Namespace: network.ConfigNamespaceName,
+			Type:      network.TrafficControlSpecType,
+			Kind:      controller.InputWeak,
+		},
+		{
+			Namespace: network.NamespaceName,
+			Type:      network.TrafficControlSpecType,
+			Kind:      controller.InputDestroyReady,
+		},
+	}
+}
+
+// Outputs implements controller.Controller interface.
+func (ctrl *TrafficControlMergeController) Outputs() []controller.Output {
+	return []controller.Output{
+		{
+			Type: network.TrafficControlSpecType,
+			Kind: controller.OutputShared,
+		},
+	}
+}
+
+// Run implements controller.Controller interface.
+//
+//nolint:gocyclo
+func (ctrl *TrafficControlMergeController) Run(ctx context.Context, r controller.Runtime, logger *zap.Logger) error {
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-r.EventCh():
+		}
+
+		// list source network configuration resources
+		list, err := r.List(ctx, resource.NewMetadata(network.ConfigNamespaceName, network.TrafficControlSpecType, "", resource.VersionUndefined))
+		if err != nil {
+			return fmt.Errorf("error listing source traffic control specs: %w", err)
+		}
+
+		// there is a single spec per link, for duplicate link name higher layer takes precedence
+		specs := map[string]*network.TrafficControlSpec{}
+
+		for _, res := range list.Items {
+			spec := res.(*network.TrafficControlSpec) //nolint:errcheck,forcetypeassert
+			id := spec.TypedSpec().LinkName
+
+			existing, ok := specs[id]
+			if ok && existing.TypedSpec().ConfigLayer > spec.TypedSpec().ConfigLayer {
+				// skip this spec, as existing one is higher layer
+				continue
+			}
+
+			specs[id] = spec
+		}
+
+		conflictsDetected := 0
+
+		for id, spec := range specs {
+			spec := spec
+
+			if err = r.Modify(ctx, network.NewTrafficControlSpec(network.NamespaceName, id), func(res resource.Resource) error {
+				tc := res.(*network.TrafficControlSpec) //nolint:errcheck,forcetypeassert
+
+				*tc.TypedSpec() = *spec.TypedSpec()
+
+				return nil
+			}); err != nil {
+				if state.IsPhaseConflictError(err) {
+					// phase conflict, resource is being torn down, skip updating it and trigger reconcile
+					// later by failing the
+					conflictsDetected++
+
+					delete(specs, id)
+				} else {
+					return fmt.Errorf("error updating resource: %w", err)
+				}
+			}
+		}
+
+		// list specs for cleanup
+		list, err = r.List(ctx, resource.NewMetadata(network.NamespaceName, network.TrafficControlSpecType, "", resource.VersionUndefined))
+		if err != nil {
+			return fmt.Errorf("error listing resources: %w", err)
+		}
+
+		for _, res := range list.Items {
+			if _, ok := specs[res.Metadata().ID()]; !ok {
+				var okToDestroy bool
+
+				okToDestroy, err = r.Teardown(ctx, res.Metadata())
+				if err != nil {
+					return fmt.Errorf("error cleaning up traffic control specs: %w", err)
+				}
+
+				if okToDestroy {
+					if err = r.Destroy(ctx, res.Metadata()); err != nil {
+						return fmt.Errorf("error cleaning up traffic control specs: %w", err)
+					}
+				}
+			}
+		}
+
+		if conflictsDetected > 0 {
+			return fmt.Errorf("%d conflict(s) detected", conflictsDetected)
+		}
+
+		r.ResetRestartBackoff()
+	}
+}
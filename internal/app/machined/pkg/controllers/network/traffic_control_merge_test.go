@@ -0,0 +1,93 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package network_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/cosi-project/runtime/pkg/resource"
+	"github.com/cosi-project/runtime/pkg/resource/rtestutils"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/siderolabs/talos/internal/app/machined/pkg/controllers/ctest"
+	netctrl "github.com/siderolabs/talos/internal/app/machined/pkg/controllers/network"
+	"github.com/siderolabs/talos/pkg/machinery/nethelpers"
+	"github.com/siderolabs/talos/pkg/machinery/resources/network"
+)
+
+type TrafficControlMergeSuite struct {
+	ctest.DefaultSuite
+}
+
+func (suite *TrafficControlMergeSuite) TestMerge() {
+	platform := network.NewTrafficControlSpec(network.ConfigNamespaceName, "platform/eth0")
+	*platform.TypedSpec() = network.TrafficControlSpecSpec{
+		LinkName:    "eth0",
+		Qdisc:       nethelpers.QdiscKindFQCodel,
+		ConfigLayer: network.ConfigPlatform,
+	}
+
+	static := network.NewTrafficControlSpec(network.ConfigNamespaceName, "configuration/eth0")
+	*static.TypedSpec() = network.TrafficControlSpecSpec{
+		LinkName: "eth0",
+		Qdisc:    nethelpers.QdiscKindTBF,
+		TBF: network.TrafficControlTBFSpec{
+			Rate:    1_250_000,
+			Burst:   32768,
+			Latency: 50 * time.Millisecond,
+		},
+		ConfigLayer: network.ConfigMachineConfiguration,
+	}
+
+	other := network.NewTrafficControlSpec(network.ConfigNamespaceName, "configuration/eth1")
+	*other.TypedSpec() = network.TrafficControlSpecSpec{
+		LinkName:    "eth1",
+		Qdisc:       nethelpers.QdiscKindFQCodel,
+		ConfigLayer: network.ConfigMachineConfiguration,
+	}
+
+	for _, res := range []resource.Resource{platform, static, other} {
+		suite.Require().NoError(suite.State().Create(suite.Ctx(), res), "%v", res.Spec())
+	}
+
+	rtestutils.AssertResources(suite.Ctx(), suite.T(), suite.State(), []resource.ID{"eth0", "eth1"},
+		func(r *network.TrafficControlSpec, asrt *assert.Assertions) {
+			asrt.Equal(resource.PhaseRunning, r.Metadata().Phase())
+
+			switch r.Metadata().ID() {
+			case "eth0":
+				asrt.Equal(*static.TypedSpec(), *r.TypedSpec())
+			case "eth1":
+				asrt.Equal(*other.TypedSpec(), *r.TypedSpec())
+			}
+		},
+	)
+
+	// lower layer takes over once the higher layer is removed
+	suite.Require().NoError(suite.State().Destroy(suite.Ctx(), static.Metadata()))
+
+	rtestutils.AssertResources(suite.Ctx(), suite.T(), suite.State(), []resource.ID{"eth0"},
+		func(r *network.TrafficControlSpec, asrt *assert.Assertions) {
+			asrt.Equal(*platform.TypedSpec(), *r.TypedSpec())
+		},
+	)
+
+	suite.Require().NoError(suite.State().Destroy(suite.Ctx(), other.Metadata()))
+
+	rtestutils.AssertNoResource[*network.TrafficControlSpec](suite.Ctx(), suite.T(), suite.State(), "eth1")
+}
+
+func TestTrafficControlMergeSuite(t *testing.T) {
+	suite.Run(t, &TrafficControlMergeSuite{
+		DefaultSuite: ctest.DefaultSuite{
+			Timeout: 5 * time.Second,
+			AfterSetup: func(s *ctest.DefaultSuite) {
+				s.Require().NoError(s.Runtime().RegisterController(&netctrl.TrafficControlMergeController{}))
+			},
+		},
+	})
+}
@@ -0,0 +1,611 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package network
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/cosi-project/runtime/pkg/controller"
+	"github.com/cosi-project/runtime/pkg/resource"
+	"github.com/cosi-project/runtime/pkg/safe"
+	"github.com/hashicorp/go-multierror"
+	"github.com/vishvananda/netlink"
+	"github.com/vishvananda/netlink/nl"
+	"go.uber.org/zap"
+	"golang.org/x/sys/unix"
+
+	"github.com/siderolabs/talos/pkg/machinery/constants"
+	"github.com/siderolabs/talos/pkg/machinery/nethelpers"
+	"github.com/siderolabs/talos/pkg/machinery/resources/network"
+)
+
+// trafficControlResyncInterval is the interval to check the traffic control settings of the links.
+//
+// The settings might be changed outside of Talos, or the cgroups the cgroup filters refer to might be re-created.
+const trafficControlResyncInterval = 30 * time.Second
+
+// TrafficControlSpecController applies network.TrafficControlSpec to the kernel.
+//
+// The root qdiscs managed by Talos have handles 1: or 2:, the current settings are always read back from the kernel.
+type TrafficControlSpecController struct {
+	// CgroupRoot is the mount point of the cgroup v2 hierarchy the cgroup filters are resolved against.
+	CgroupRoot string
+}
+
+// Name implements controller.Controller interface.
+func (ctrl *TrafficControlSpecController) Name() string {
+	return "network.TrafficControlSpecController"
+}
+
+// Inputs implements controller.Controller interface.
+func (ctrl *TrafficControlSpecController) Inputs() []controller.Input {
+	return []controller.Input{
+		{
+			Namespace: network.NamespaceName,
+			Type:      network.TrafficControlSpecType,
+			Kind:      controller.InputStrong,
+		},
+		{
+			Namespace: network.NamespaceName,
+			Type:      network.LinkStatusType,
+			Kind:      controller.InputWeak,
+		},
+	}
+}
+
+// Outputs implements controller.Controller interface.
+func (ctrl *TrafficControlSpecController) Outputs() []controller.Output {
+	return nil
+}
+
+// Run implements controller.Controller interface.
+func (ctrl *TrafficControlSpecController) Run(ctx context.Context, r controller.Runtime, logger *zap.Logger) error {
+	handle, err := netlink.NewHandle(unix.NETLINK_ROUTE)
+	if err != nil {
+		return fmt.Errorf("error dialing netlink socket: %w", err)
+	}
+
+	defer handle.Close()
+
+	if ctrl.CgroupRoot == "" {
+		ctrl.CgroupRoot = constants.CgroupMountPath
+	}
+
+	ticker := time.NewTicker(trafficControlResyncInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-r.EventCh():
+		case <-ticker.C:
+		}
+
+		list, err := safe.ReaderListAll[*network.TrafficControlSpec](ctx, r)
+		if err != nil {
+			return fmt.Errorf("error listing traffic control specs: %w", err)
+		}
+
+		var multiErr *multierror.Error
+
+		for it := safe.IteratorFromList(list); it.Next(); {
+			if err = ctrl.syncSpec(ctx, r, logger, handle, it.Value()); err != nil {
+				multiErr = multierror.Append(multiErr, err)
+			}
+		}
+
+		if err = multiErr.ErrorOrNil(); err != nil {
+			return err
+		}
+
+		r.ResetRestartBackoff()
+	}
+}
+
+func (ctrl *TrafficControlSpecController) syncSpec(ctx context.Context, r controller.Runtime, logger *zap.Logger, handle *netlink.Handle, res *network.TrafficControlSpec) error {
+	spec := res.TypedSpec()
+
+	link, err := handle.LinkByName(spec.LinkName)
+	if err != nil {
+		var notFoundErr netlink.LinkNotFoundError
+
+		if !errors.As(err, &notFoundErr) {
+			return fmt.Errorf("error getting link %q: %w", spec.LinkName, err)
+		}
+	}
+
+	switch res.Metadata().Phase() {
+	case resource.PhaseTearingDown:
+		if link != nil {
+			if err = ctrl.deleteRootQdisc(handle, link); err != nil {
+				return err
+			}
+
+			logger.Info("removed traffic control settings", zap.String("link", spec.LinkName))
+		}
+
+		if err = r.RemoveFinalizer(ctx, res.Metadata(), ctrl.Name()); err != nil {
+			return fmt.Errorf("error removing finalizer: %w", err)
+		}
+	case resource.PhaseRunning:
+		if err = r.AddFinalizer(ctx, res.Metadata(), ctrl.Name()); err != nil {
+			return fmt.Errorf("error adding finalizer: %w", err)
+		}
+
+		if link == nil {
+			// link doesn't exist (yet), the spec will be applied when the link shows up
+			return nil
+		}
+
+		var changed bool
+
+		changed, err = ctrl.apply(handle, link, spec)
+		if err != nil {
+			return fmt.Errorf("error applying traffic control settings to link %q: %w", spec.LinkName, err)
+		}
+
+		if changed {
+			logger.Info("applied traffic control settings", zap.String("link", spec.LinkName), zap.Stringer("qdisc", spec.Qdisc))
+		}
+	}
+
+	return nil
+}
+
+// isTrafficControlRoot checks whether the qdisc is the root qdisc managed by Talos.
+func isTrafficControlRoot(qdisc netlink.Qdisc) bool {
+	major, minor := netlink.MajorMinor(qdisc.Attrs().Handle)
+
+	return qdisc.Attrs().Parent == netlink.HANDLE_ROOT && minor == 0 && (major == 1 || major == 2)
+}
+
+func rootQdisc(handle *netlink.Handle, link netlink.Link) (netlink.Qdisc, error) {
+	qdiscs, err := handle.QdiscList(link)
+	if err != nil {
+		return nil, fmt.Errorf("error listing qdiscs: %w", err)
+	}
+
+	for _, qdisc := range qdiscs {
+		if qdisc.Attrs().Parent == netlink.HANDLE_ROOT {
+			return qdisc, nil
+		}
+	}
+
+	return nil, nil
+}
+
+func (ctrl *TrafficControlSpecController) deleteRootQdisc(handle *netlink.Handle, link netlink.Link) error {
+	qdisc, err := rootQdisc(handle, link)
+	if err != nil {
+		return err
+	}
+
+	if qdisc == nil || !isTrafficControlRoot(qdisc) {
+		return nil
+	}
+
+	// the kernel restores the default qdisc once the root qdisc is removed
+	if err = handle.QdiscDel(qdisc); err != nil && !errors.Is(err, unix.ENOENT) {
+		return fmt.Errorf("error removing root qdisc: %w", err)
+	}
+
+	return nil
+}
+
+// trafficControlTree is the root qdisc with its classes and filters which implement the spec.
+type trafficControlTree struct {
+	qdisc   netlink.Qdisc
+	classes []netlink.Class
+	filters []netlink.Filter
+
+	// cgroups of the cgroup filters, indexed by the filter priority
+	cgroups map[uint16]trafficControlCgroup
+}
+
+type trafficControlCgroup struct {
+	id    uint64
+	level int
+}
+
+// buildTree builds the kernel objects for the spec under the root qdisc with the specified handle major.
+//
+// The cgroups of the cgroup filters are resolved on every call, and their IDs are recorded in the filter names.
+func (ctrl *TrafficControlSpecController) buildTree(linkIndex int, major uint16, spec *network.TrafficControlSpecSpec) (*trafficControlTree, error) {
+	rootHandle := netlink.MakeHandle(major, 0)
+
+	attrs := netlink.QdiscAttrs{
+		LinkIndex: linkIndex,
+		Handle:    rootHandle,
+		Parent:    netlink.HANDLE_ROOT,
+	}
+
+	tree := &trafficControlTree{
+		cgroups: map[uint16]trafficControlCgroup{},
+	}
+
+	switch spec.Qdisc {
+	case nethelpers.QdiscKindFQCodel:
+		fqCodel := netlink.NewFqCodel(attrs)
+
+		fqCodel.Limit = spec.FQCodel.Limit
+		fqCodel.Flows = spec.FQCodel.Flows
+		fqCodel.Interval = uint32(spec.FQCodel.Interval.Microseconds())
+
+		if !spec.FQCodel.ECN {
+			fqCodel.ECN = 0
+		}
+
+		tree.qdisc = fqCodel
+	case nethelpers.QdiscKindHTB:
+		htb := netlink.NewHtb(attrs)
+		htb.Defcls = uint32(spec.HTB.DefaultClass)
+
+		tree.qdisc = htb
+	case nethelpers.QdiscKindTBF:
+		burst := spec.TBF.Burst
+
+		tree.qdisc = &netlink.Tbf{
+			QdiscAttrs: attrs,
+			Rate:       spec.TBF.Rate,
+			Buffer:     netlink.Xmittime(spec.TBF.Rate, burst),
+			// same as tc: the queue holds as many bytes as can be sent within the latency, plus the burst
+			Limit: uint32(float64(spec.TBF.Rate)*spec.TBF.Latency.Seconds()) + burst,
+		}
+	default:
+		return nil, fmt.Errorf("unsupported qdisc %s", spec.Qdisc)
+	}
+
+	for _, class := range spec.HTB.Classes {
+		tree.classes = append(tree.classes, netlink.NewHtbClass(
+			netlink.ClassAttrs{
+				LinkIndex: linkIndex,
+				Parent:    rootHandle,
+				Handle:    netlink.MakeHandle(major, uint16(class.ClassID)),
+			},
+			netlink.HtbClassAttrs{
+				// netlink library expects rates in bits per second
+				Rate: class.Rate * 8,
+				Ceil: class.Ceil * 8,
+				Prio: class.Priority,
+			},
+		))
+	}
+
+	for i, filter := range spec.Filters {
+		filters := trafficControlFilters(linkIndex, major, uint16(i+1), filter)
+
+		if filter.Kind == nethelpers.TrafficControlFilterKindCgroup {
+			id, level, err := cgroupIDLevel(ctrl.CgroupRoot, filter.CgroupPath)
+			if err != nil {
+				return nil, err
+			}
+
+			for _, f := range filters {
+				f.(*netlink.BpfFilter).Name = fmt.Sprintf("talos-cgroup-%d-%d", id, level)
+
+				tree.cgroups[f.Attrs().Priority] = trafficControlCgroup{id: id, level: level}
+			}
+		}
+
+		tree.filters = append(tree.filters, filters...)
+	}
+
+	return tree, nil
+}
+
+// apply brings the root qdisc of the link, its classes and filters in sync with the spec.
+//
+// The classes and filters are replaced in place if the root qdisc matches the spec.
+// Otherwise, a new root qdisc is grafted with the other handle, so that the link is never left without a root qdisc,
+// and the kernel drops the previous classes and filters with the previous root qdisc.
+func (ctrl *TrafficControlSpecController) apply(handle *netlink.Handle, link netlink.Link, spec *network.TrafficControlSpecSpec) (bool, error) {
+	root, err := rootQdisc(handle, link)
+	if err != nil {
+		return false, err
+	}
+
+	major := uint16(1)
+
+	if root != nil && isTrafficControlRoot(root) {
+		major, _ = netlink.MajorMinor(root.Attrs().Handle)
+	}
+
+	tree, err := ctrl.buildTree(link.Attrs().Index, major, spec)
+	if err != nil {
+		return false, err
+	}
+
+	if root != nil && qdiscMatches(root, tree.qdisc) {
+		return ctrl.syncTree(handle, link, tree)
+	}
+
+	if root != nil && isTrafficControlRoot(root) {
+		if tree, err = ctrl.buildTree(link.Attrs().Index, 3-major, spec); err != nil {
+			return false, err
+		}
+	}
+
+	if err = handle.QdiscReplace(tree.qdisc); err != nil {
+		return false, fmt.Errorf("error replacing root qdisc with %s: %w", spec.Qdisc, err)
+	}
+
+	if _, err = ctrl.syncTree(handle, link, tree); err != nil {
+		return false, err
+	}
+
+	return true, nil
+}
+
+// syncTree brings the classes and filters of the root qdisc in sync with the tree.
+//
+//nolint:gocyclo,cyclop
+func (ctrl *TrafficControlSpecController) syncTree(handle *netlink.Handle, link netlink.Link, tree *trafficControlTree) (bool, error) {
+	rootHandle := tree.qdisc.Attrs().Handle
+	changed := false
+
+	classes, err := handle.ClassList(link, rootHandle)
+	if err != nil {
+		return false, fmt.Errorf("error listing classes: %w", err)
+	}
+
+	currentClasses := map[uint32]netlink.Class{}
+
+	for _, class := range classes {
+		// classless qdiscs report the class of their child qdisc
+		if _, ok := class.(*netlink.HtbClass); ok {
+			currentClasses[class.Attrs().Handle] = class
+		}
+	}
+
+	for _, class := range tree.classes {
+		current, ok := currentClasses[class.Attrs().Handle]
+
+		delete(currentClasses, class.Attrs().Handle)
+
+		if ok && classMatches(current, class) {
+			continue
+		}
+
+		if err = handle.ClassReplace(class); err != nil {
+			return false, fmt.Errorf("error replacing class %s: %w", netlink.HandleStr(class.Attrs().Handle), err)
+		}
+
+		changed = true
+	}
+
+	filters, err := handle.FilterList(link, rootHandle)
+	if err != nil {
+		return false, fmt.Errorf("error listing filters: %w", err)
+	}
+
+	currentFilters := map[uint16][]netlink.Filter{}
+
+	for _, filter := range filters {
+		currentFilters[filter.Attrs().Priority] = append(currentFilters[filter.Attrs().Priority], filter)
+	}
+
+	for _, filter := range tree.filters {
+		priority := filter.Attrs().Priority
+		current := currentFilters[priority]
+
+		delete(currentFilters, priority)
+
+		if len(current) == 1 && filterMatches(current[0], filter) {
+			continue
+		}
+
+		// filters are replaced as a whole, as the kernel doesn't support changing the kind of the filter
+		if err = deleteFilters(handle, current); err != nil {
+			return false, err
+		}
+
+		if err = ctrl.addFilter(handle, filter, tree.cgroups[priority]); err != nil {
+			return false, fmt.Errorf("error adding %s filter: %w", filter.Type(), err)
+		}
+
+		changed = true
+	}
+
+	for _, current := range currentFilters {
+		if err = deleteFilters(handle, current); err != nil {
+			return false, err
+		}
+
+		changed = true
+	}
+
+	// classes are removed last, as the kernel doesn't remove the classes referenced by the filters
+	for _, class := range currentClasses {
+		if err = handle.ClassDel(class); err != nil && !errors.Is(err, unix.ENOENT) {
+			return false, fmt.Errorf("error removing class %s: %w", netlink.HandleStr(class.Attrs().Handle), err)
+		}
+
+		changed = true
+	}
+
+	return changed, nil
+}
+
+func (ctrl *TrafficControlSpecController) addFilter(handle *netlink.Handle, filter netlink.Filter, cgroup trafficControlCgroup) error {
+	if bpfFilter, ok := filter.(*netlink.BpfFilter); ok {
+		prog, err := loadCgroupClassifier(cgroup.id, cgroup.level)
+		if err != nil {
+			return err
+		}
+
+		// the attached filter holds a reference to the program
+		defer prog.Close() //nolint:errcheck
+
+		bpfFilter.Fd = prog.FD()
+	}
+
+	return handle.FilterAdd(filter)
+}
+
+// deleteFilters removes all filters with the priority and protocol of the filters.
+func deleteFilters(handle *netlink.Handle, filters []netlink.Filter) error {
+	for _, filter := range filters {
+		attrs := *filter.Attrs()
+		attrs.Handle = 0
+
+		if err := handle.FilterDel(&netlink.GenericFilter{FilterAttrs: attrs, FilterType: filter.Type()}); err != nil && !errors.Is(err, unix.ENOENT) {
+			return fmt.Errorf("error removing %s filter: %w", filter.Type(), err)
+		}
+	}
+
+	return nil
+}
+
+// qdiscMatches checks whether the current qdisc has the handle and the parameters of the desired one.
+func qdiscMatches(current, desired netlink.Qdisc) bool {
+	if current.Attrs().Handle != desired.Attrs().Handle || current.Type() != desired.Type() {
+		return false
+	}
+
+	switch desired := desired.(type) {
+	case *netlink.FqCodel:
+		current, ok := current.(*netlink.FqCodel)
+
+		// zero values are not sent to the kernel, so the kernel defaults are in effect
+		return ok && current.ECN == desired.ECN &&
+			(desired.Limit == 0 || current.Limit == desired.Limit) &&
+			(desired.Flows == 0 || current.Flows == desired.Flows) &&
+			(desired.Interval == 0 || current.Interval == desired.Interval)
+	case *netlink.Htb:
+		current, ok := current.(*netlink.Htb)
+
+		return ok && current.Defcls == desired.Defcls
+	case *netlink.Tbf:
+		current, ok := current.(*netlink.Tbf)
+
+		return ok && current.Rate == desired.Rate && current.Limit == desired.Limit && current.Buffer == desired.Buffer
+	default:
+		return false
+	}
+}
+
+// classMatches checks whether the current class has the parameters of the desired one.
+func classMatches(current, desired netlink.Class) bool {
+	currentHTB, desiredHTB := current.(*netlink.HtbClass), desired.(*netlink.HtbClass) //nolint:forcetypeassert
+
+	return currentHTB.Rate == desiredHTB.Rate && currentHTB.Ceil == desiredHTB.Ceil && currentHTB.Prio == desiredHTB.Prio
+}
+
+// filterMatches checks whether the current filter has the parameters of the desired one.
+func filterMatches(current, desired netlink.Filter) bool {
+	if current.Type() != desired.Type() || current.Attrs().Protocol != desired.Attrs().Protocol {
+		return false
+	}
+
+	switch desired := desired.(type) {
+	case *netlink.FwFilter:
+		current, ok := current.(*netlink.FwFilter)
+
+		return ok && current.Handle == desired.Handle && current.Mask == desired.Mask && current.ClassId == desired.ClassId
+	case *netlink.U32:
+		current, ok := current.(*netlink.U32)
+		if !ok || current.ClassId != desired.ClassId || current.Sel == nil || len(current.Sel.Keys) != len(desired.Sel.Keys) {
+			return false
+		}
+
+		for i := range desired.Sel.Keys {
+			if current.Sel.Keys[i] != desired.Sel.Keys[i] {
+				return false
+			}
+		}
+
+		return true
+	case *netlink.BpfFilter:
+		current, ok := current.(*netlink.BpfFilter)
+
+		// the name of the filter records the cgroup the classifier matches
+		return ok && current.Name == desired.Name && current.ClassId == desired.ClassId
+	default:
+		return false
+	}
+}
+
+// trafficControlFilters builds the kernel filters for the filter spec.
+//
+// Filters are attached to the root qdisc in the order of the spec, DSCP match requires a filter per IP protocol.
+// The cgroup filter is returned without the classifier program and the cgroup name.
+func trafficControlFilters(linkIndex int, major, index uint16, filter network.TrafficControlFilterSpec) []netlink.Filter {
+	classID := netlink.MakeHandle(major, uint16(filter.ClassID))
+
+	attrs := func(priority uint16, protocol uint16) netlink.FilterAttrs {
+		return netlink.FilterAttrs{
+			LinkIndex: linkIndex,
+			Parent:    netlink.MakeHandle(major, 0),
+			Priority:  priority,
+			Protocol:  protocol,
+		}
+	}
+
+	switch filter.Kind {
+	case nethelpers.TrafficControlFilterKindFwMark:
+		fwAttrs := attrs(2*index, unix.ETH_P_ALL)
+		fwAttrs.Handle = filter.FwMark
+
+		mask := filter.FwMask
+		if mask == 0 {
+			mask = 0xffffffff
+		}
+
+		return []netlink.Filter{
+			&netlink.FwFilter{
+				FilterAttrs: fwAttrs,
+				ClassId:     classID,
+				Mask:        mask,
+			},
+		}
+	case nethelpers.TrafficControlFilterKindDSCP:
+		return []netlink.Filter{
+			// IPv4: DSCP is the upper 6 bits of the TOS byte, which is the second byte of the header
+			&netlink.U32{
+				FilterAttrs: attrs(2*index, unix.ETH_P_IP),
+				ClassId:     classID,
+				Sel: &netlink.TcU32Sel{
+					Flags: nl.TC_U32_TERMINAL,
+					Nkeys: 1,
+					Keys: []netlink.TcU32Key{
+						{
+							Mask: 0x00fc0000,
+							Val:  uint32(filter.DSCP) << 18,
+						},
+					},
+				},
+			},
+			// IPv6: DSCP is the upper 6 bits of the traffic class, which follows the 4-bit version field
+			&netlink.U32{
+				FilterAttrs: attrs(2*index+1, unix.ETH_P_IPV6),
+				ClassId:     classID,
+				Sel: &netlink.TcU32Sel{
+					Flags: nl.TC_U32_TERMINAL,
+					Nkeys: 1,
+					Keys: []netlink.TcU32Key{
+						{
+							Mask: 0x0fc00000,
+							Val:  uint32(filter.DSCP) << 22,
+						},
+					},
+				},
+			},
+		}
+	case nethelpers.TrafficControlFilterKindCgroup:
+		// the program is loaded when the filter is added, see loadCgroupClassifier
+		return []netlink.Filter{
+			&netlink.BpfFilter{
+				FilterAttrs: attrs(2*index, unix.ETH_P_ALL),
+				ClassId:     classID,
+			},
+		}
+	default:
+		return nil
+	}
+}
@@ -0,0 +1,378 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package network_test
+
+import (
+	"fmt"
+	"net"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/cosi-project/runtime/pkg/resource"
+	"github.com/cosi-project/runtime/pkg/resource/rtestutils"
+	"github.com/jsimonetti/rtnetlink"
+	"github.com/mdlayher/netlink"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+	vnetlink "github.com/vishvananda/netlink"
+	"golang.org/x/sys/unix"
+
+	"github.com/siderolabs/talos/internal/app/machined/pkg/controllers/ctest"
+	netctrl "github.com/siderolabs/talos/internal/app/machined/pkg/controllers/network"
+	"github.com/siderolabs/talos/pkg/machinery/nethelpers"
+	"github.com/siderolabs/talos/pkg/machinery/resources/network"
+)
+
+type TrafficControlSpecSuite struct {
+	ctest.DefaultSuite
+
+	cgroupRoot string
+}
+
+// findCgroup2Root returns the mount point of the cgroup v2 hierarchy, either unified or hybrid.
+func findCgroup2Root() string {
+	for _, path := range []string{"/sys/fs/cgroup", "/sys/fs/cgroup/unified"} {
+		var st unix.Statfs_t
+
+		if err := unix.Statfs(path, &st); err == nil && st.Type == unix.CGROUP2_SUPER_MAGIC {
+			return path
+		}
+	}
+
+	return ""
+}
+
+func trafficControlRootQdisc(status *network.TrafficControlStatusSpec) network.TrafficControlQdiscStatus {
+	for _, qdisc := range status.Qdiscs {
+		if qdisc.Parent == "root" {
+			return qdisc
+		}
+	}
+
+	return network.TrafficControlQdiscStatus{}
+}
+
+// createVeth creates a veth pair which is removed when the test is done.
+func (suite *TrafficControlSpecSuite) createVeth(name, peerName string) *net.Interface {
+	conn, err := rtnetlink.Dial(nil)
+	suite.Require().NoError(err)
+
+	suite.T().Cleanup(func() {
+		conn.Close() //nolint:errcheck
+	})
+
+	peerMessage, err := (&rtnetlink.LinkMessage{
+		Attributes: &rtnetlink.LinkAttributes{
+			Name: peerName,
+		},
+	}).MarshalBinary()
+	suite.Require().NoError(err)
+
+	encoder := netlink.NewAttributeEncoder()
+	encoder.Bytes(vethInfoPeer, peerMessage)
+
+	vethData, err := encoder.Encode()
+	suite.Require().NoError(err)
+
+	suite.Require().NoError(conn.Link.New(&rtnetlink.LinkMessage{
+		Type: unix.ARPHRD_ETHER,
+		Attributes: &rtnetlink.LinkAttributes{
+			Name: name,
+			Info: &rtnetlink.LinkInfo{
+				Kind: "veth",
+				Data: vethData,
+			},
+		},
+	}))
+
+	iface, err := net.InterfaceByName(name)
+	suite.Require().NoError(err)
+
+	suite.T().Cleanup(func() {
+		conn.Link.Delete(uint32(iface.Index)) //nolint:errcheck
+	})
+
+	return iface
+}
+
+func (suite *TrafficControlSpecSuite) TestApply() {
+	if os.Geteuid() != 0 {
+		suite.T().Skip("requires root")
+	}
+
+	iface := suite.createVeth("tcspec0", "tcspec1")
+
+	linkStatus := network.NewLinkStatus(network.NamespaceName, iface.Name)
+	linkStatus.TypedSpec().Index = uint32(iface.Index)
+	linkStatus.TypedSpec().Type = nethelpers.LinkEther
+
+	suite.Require().NoError(suite.State().Create(suite.Ctx(), linkStatus))
+
+	spec := network.NewTrafficControlSpec(network.NamespaceName, iface.Name)
+	*spec.TypedSpec() = network.TrafficControlSpecSpec{
+		LinkName: iface.Name,
+		Qdisc:    nethelpers.QdiscKindHTB,
+		HTB: network.TrafficControlHTBSpec{
+			DefaultClass: 20,
+			Classes: []network.TrafficControlHTBClassSpec{
+				{
+					ClassID: 10,
+					Rate:    1_000_000,
+					Ceil:    2_000_000,
+				},
+				{
+					ClassID:  20,
+					Rate:     500_000,
+					Ceil:     500_000,
+					Priority: 1,
+				},
+			},
+		},
+		Filters: []network.TrafficControlFilterSpec{
+			{
+				Kind:    nethelpers.TrafficControlFilterKindDSCP,
+				ClassID: 10,
+				DSCP:    46,
+			},
+			{
+				Kind:    nethelpers.TrafficControlFilterKindDSCP,
+				ClassID: 20,
+				DSCP:    10,
+			},
+		},
+		ConfigLayer: network.ConfigMachineConfiguration,
+	}
+
+	suite.Require().NoError(suite.State().Create(suite.Ctx(), spec))
+
+	rtestutils.AssertResources(suite.Ctx(), suite.T(), suite.State(), []resource.ID{iface.Name},
+		func(status *network.TrafficControlStatus, asrt *assert.Assertions) {
+			asrt.Equal(network.TrafficControlQdiscStatus{Kind: "htb", Handle: "1:0", Parent: "root"}, trafficControlRootQdisc(status.TypedSpec()))
+
+			asrt.Contains(status.TypedSpec().Classes, network.TrafficControlClassStatus{
+				Kind:   "htb",
+				Handle: "1:a",
+				Parent: "root",
+				Rate:   1_000_000,
+				Ceil:   2_000_000,
+			})
+			asrt.Contains(status.TypedSpec().Classes, network.TrafficControlClassStatus{
+				Kind:   "htb",
+				Handle: "1:14",
+				Parent: "root",
+				Rate:   500_000,
+				Ceil:   500_000,
+			})
+
+			// DSCP match requires a filter per IP protocol
+			asrt.Equal([]network.TrafficControlFilterStatus{
+				{Kind: "u32", Priority: 2, ClassID: "1:a"},
+				{Kind: "u32", Priority: 3, ClassID: "1:a"},
+				{Kind: "u32", Priority: 4, ClassID: "1:14"},
+				{Kind: "u32", Priority: 5, ClassID: "1:14"},
+			}, status.TypedSpec().Filters)
+		})
+
+	// classes are updated in place
+	ctest.UpdateWithConflicts(suite, spec, func(r *network.TrafficControlSpec) error {
+		r.TypedSpec().HTB.Classes[1].Rate = 250_000
+
+		return nil
+	})
+
+	rtestutils.AssertResources(suite.Ctx(), suite.T(), suite.State(), []resource.ID{iface.Name},
+		func(status *network.TrafficControlStatus, asrt *assert.Assertions) {
+			asrt.Equal(network.TrafficControlQdiscStatus{Kind: "htb", Handle: "1:0", Parent: "root"}, trafficControlRootQdisc(status.TypedSpec()))
+
+			asrt.Contains(status.TypedSpec().Classes, network.TrafficControlClassStatus{
+				Kind:   "htb",
+				Handle: "1:14",
+				Parent: "root",
+				Rate:   250_000,
+				Ceil:   500_000,
+			})
+			asrt.Len(status.TypedSpec().Filters, 4)
+		})
+
+	// replace htb with tbf, the new root qdisc is grafted with the other handle
+	ctest.UpdateWithConflicts(suite, spec, func(r *network.TrafficControlSpec) error {
+		r.TypedSpec().Qdisc = nethelpers.QdiscKindTBF
+		r.TypedSpec().HTB = network.TrafficControlHTBSpec{}
+		r.TypedSpec().Filters = nil
+		r.TypedSpec().TBF = network.TrafficControlTBFSpec{
+			Rate:    1_000_000,
+			Burst:   32 * 1024,
+			Latency: 50 * time.Millisecond,
+		}
+
+		return nil
+	})
+
+	rtestutils.AssertResources(suite.Ctx(), suite.T(), suite.State(), []resource.ID{iface.Name},
+		func(status *network.TrafficControlStatus, asrt *assert.Assertions) {
+			asrt.Equal(network.TrafficControlQdiscStatus{Kind: "tbf", Handle: "2:0", Parent: "root"}, trafficControlRootQdisc(status.TypedSpec()))
+			asrt.Empty(status.TypedSpec().Filters)
+		})
+
+	// the settings are read back from the kernel, so the root qdisc is left as is if nothing changed
+	ctest.UpdateWithConflicts(suite, spec, func(r *network.TrafficControlSpec) error {
+		r.TypedSpec().ConfigLayer = network.ConfigOperator
+
+		return nil
+	})
+
+	time.Sleep(500 * time.Millisecond)
+
+	rtestutils.AssertResources(suite.Ctx(), suite.T(), suite.State(), []resource.ID{iface.Name},
+		func(status *network.TrafficControlStatus, asrt *assert.Assertions) {
+			asrt.Equal(network.TrafficControlQdiscStatus{Kind: "tbf", Handle: "2:0", Parent: "root"}, trafficControlRootQdisc(status.TypedSpec()))
+		})
+
+	// teardown restores the default qdisc
+	for {
+		ready, err := suite.State().Teardown(suite.Ctx(), spec.Metadata())
+		suite.Require().NoError(err)
+
+		if ready {
+			break
+		}
+
+		time.Sleep(100 * time.Millisecond)
+	}
+
+	suite.Require().NoError(suite.State().Destroy(suite.Ctx(), spec.Metadata()))
+
+	rtestutils.AssertResources(suite.Ctx(), suite.T(), suite.State(), []resource.ID{iface.Name},
+		func(status *network.TrafficControlStatus, asrt *assert.Assertions) {
+			asrt.NotEqual("tbf", trafficControlRootQdisc(status.TypedSpec()).Kind)
+		})
+
+	// status is removed once the link is gone
+	suite.Require().NoError(suite.State().Destroy(suite.Ctx(), linkStatus.Metadata()))
+
+	rtestutils.AssertNoResource[*network.TrafficControlStatus](suite.Ctx(), suite.T(), suite.State(), iface.Name)
+}
+
+// assertCgroupFilter waits for the cgroup filter of the link to match the current ID of the cgroup.
+func (suite *TrafficControlSpecSuite) assertCgroupFilter(linkName, cgroupPath string) {
+	var st unix.Stat_t
+
+	suite.Require().NoError(unix.Stat(cgroupPath, &st))
+
+	suite.Assert().EventuallyWithT(func(collect *assert.CollectT) {
+		link, err := vnetlink.LinkByName(linkName)
+		if !assert.NoError(collect, err) {
+			return
+		}
+
+		filters, err := vnetlink.FilterList(link, vnetlink.MakeHandle(1, 0))
+		if !assert.NoError(collect, err) || !assert.Len(collect, filters, 1) {
+			return
+		}
+
+		if assert.IsType(collect, &vnetlink.BpfFilter{}, filters[0]) {
+			assert.Equal(collect, fmt.Sprintf("talos-cgroup-%d-1", st.Ino), filters[0].(*vnetlink.BpfFilter).Name)
+		}
+	}, 5*time.Second, 100*time.Millisecond)
+}
+
+func (suite *TrafficControlSpecSuite) TestCgroupFilter() {
+	if os.Geteuid() != 0 {
+		suite.T().Skip("requires root")
+	}
+
+	if suite.cgroupRoot == "" {
+		suite.T().Skip("requires cgroup v2")
+	}
+
+	cgroupPath := filepath.Join(suite.cgroupRoot, "tcspec")
+
+	suite.Require().NoError(os.Mkdir(cgroupPath, 0o755))
+
+	defer os.Remove(cgroupPath) //nolint:errcheck
+
+	iface := suite.createVeth("tcspec2", "tcspec3")
+
+	suite.Require().NoError(suite.State().Create(suite.Ctx(), network.NewLinkStatus(network.NamespaceName, iface.Name)))
+
+	spec := network.NewTrafficControlSpec(network.NamespaceName, iface.Name)
+	*spec.TypedSpec() = network.TrafficControlSpecSpec{
+		LinkName: iface.Name,
+		Qdisc:    nethelpers.QdiscKindHTB,
+		HTB: network.TrafficControlHTBSpec{
+			Classes: []network.TrafficControlHTBClassSpec{
+				{
+					ClassID: 30,
+					Rate:    1_000_000,
+					Ceil:    1_000_000,
+				},
+			},
+		},
+		Filters: []network.TrafficControlFilterSpec{
+			{
+				Kind:       nethelpers.TrafficControlFilterKindCgroup,
+				ClassID:    30,
+				CgroupPath: "/tcspec",
+			},
+		},
+		ConfigLayer: network.ConfigMachineConfiguration,
+	}
+
+	suite.Require().NoError(suite.State().Create(suite.Ctx(), spec))
+
+	rtestutils.AssertResources(suite.Ctx(), suite.T(), suite.State(), []resource.ID{iface.Name},
+		func(status *network.TrafficControlStatus, asrt *assert.Assertions) {
+			asrt.Equal([]network.TrafficControlFilterStatus{
+				{Kind: "bpf", Priority: 2, ClassID: "1:1e"},
+			}, status.TypedSpec().Filters)
+		})
+
+	suite.assertCgroupFilter(iface.Name, cgroupPath)
+
+	// re-created cgroup gets a new ID, so the filter is replaced on the next reconcile
+	suite.Require().NoError(os.Remove(cgroupPath))
+	suite.Require().NoError(os.Mkdir(cgroupPath, 0o755))
+
+	ctest.UpdateWithConflicts(suite, spec, func(r *network.TrafficControlSpec) error {
+		r.TypedSpec().ConfigLayer = network.ConfigOperator
+
+		return nil
+	})
+
+	suite.assertCgroupFilter(iface.Name, cgroupPath)
+
+	for {
+		ready, err := suite.State().Teardown(suite.Ctx(), spec.Metadata())
+		suite.Require().NoError(err)
+
+		if ready {
+			break
+		}
+
+		time.Sleep(100 * time.Millisecond)
+	}
+
+	suite.Require().NoError(suite.State().Destroy(suite.Ctx(), spec.Metadata()))
+}
+
+func TestTrafficControlSpecSuite(t *testing.T) {
+	s := &TrafficControlSpecSuite{
+		cgroupRoot: findCgroup2Root(),
+	}
+
+	s.DefaultSuite = ctest.DefaultSuite{
+		Timeout: 10 * time.Second,
+		AfterSetup: func(*ctest.DefaultSuite) {
+			s.Require().NoError(s.Runtime().RegisterController(&netctrl.TrafficControlSpecController{
+				CgroupRoot: s.cgroupRoot,
+			}))
+			s.Require().NoError(s.Runtime().RegisterController(&netctrl.TrafficControlStatusController{}))
+		},
+	}
+
+	suite.Run(t, s)
+}
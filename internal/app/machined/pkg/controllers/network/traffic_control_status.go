@@ -0,0 +1,211 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package network
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/cosi-project/runtime/pkg/controller"
+	"github.com/cosi-project/runtime/pkg/resource"
+	"github.com/cosi-project/runtime/pkg/safe"
+	"github.com/vishvananda/netlink"
+	"go.uber.org/zap"
+	"golang.org/x/sys/unix"
+
+	"github.com/siderolabs/talos/internal/app/machined/pkg/controllers/network/watch"
+	"github.com/siderolabs/talos/pkg/machinery/resources/network"
+)
+
+// TrafficControlStatusController reports the qdiscs, classes and filters of the links as network.TrafficControlStatus.
+type TrafficControlStatusController struct{}
+
+// Name implements controller.Controller interface.
+func (ctrl *TrafficControlStatusController) Name() string {
+	return "network.TrafficControlStatusController"
+}
+
+// Inputs implements controller.Controller interface.
+func (ctrl *TrafficControlStatusController) Inputs() []controller.Input {
+	return []controller.Input{
+		{
+			Namespace: network.NamespaceName,
+			Type:      network.LinkStatusType,
+			Kind:      controller.InputWeak,
+		},
+	}
+}
+
+// Outputs implements controller.Controller interface.
+func (ctrl *TrafficControlStatusController) Outputs() []controller.Output {
+	return []controller.Output{
+		{
+			Type: network.TrafficControlStatusType,
+			Kind: controller.OutputExclusive,
+		},
+	}
+}
+
+// Run implements controller.Controller interface.
+func (ctrl *TrafficControlStatusController) Run(ctx context.Context, r controller.Runtime, logger *zap.Logger) error {
+	watcher, err := watch.NewRtNetlink(r, unix.RTMGRP_TC)
+	if err != nil {
+		return err
+	}
+
+	defer watcher.Done()
+
+	handle, err := netlink.NewHandle(unix.NETLINK_ROUTE)
+	if err != nil {
+		return fmt.Errorf("error dialing netlink socket: %w", err)
+	}
+
+	defer handle.Close()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-r.EventCh():
+		}
+
+		if err = ctrl.reconcile(ctx, r, logger, handle); err != nil {
+			return err
+		}
+
+		r.ResetRestartBackoff()
+	}
+}
+
+func (ctrl *TrafficControlStatusController) reconcile(ctx context.Context, r controller.Runtime, logger *zap.Logger, handle *netlink.Handle) error {
+	touchedIDs := make(map[resource.ID]struct{})
+
+	links, err := safe.ReaderListAll[*network.LinkStatus](ctx, r)
+	if err != nil {
+		return fmt.Errorf("error listing links: %w", err)
+	}
+
+	for it := safe.IteratorFromList(links); it.Next(); {
+		linkName := it.Value().Metadata().ID()
+
+		var status network.TrafficControlStatusSpec
+
+		status, err = ctrl.query(handle, linkName)
+		if err != nil {
+			var notFoundErr netlink.LinkNotFoundError
+
+			if !errors.As(err, &notFoundErr) {
+				logger.Warn("error querying traffic control settings", zap.String("link", linkName), zap.Error(err))
+			}
+
+			continue
+		}
+
+		if err = safe.WriterModify(ctx, r, network.NewTrafficControlStatus(network.NamespaceName, linkName),
+			func(res *network.TrafficControlStatus) error {
+				*res.TypedSpec() = status
+
+				return nil
+			}); err != nil {
+			return fmt.Errorf("error updating traffic control status: %w", err)
+		}
+
+		touchedIDs[linkName] = struct{}{}
+	}
+
+	list, err := safe.ReaderListAll[*network.TrafficControlStatus](ctx, r)
+	if err != nil {
+		return fmt.Errorf("error listing resources: %w", err)
+	}
+
+	for it := safe.IteratorFromList(list); it.Next(); {
+		if _, ok := touchedIDs[it.Value().Metadata().ID()]; ok {
+			continue
+		}
+
+		if err = r.Destroy(ctx, it.Value().Metadata()); err != nil {
+			return fmt.Errorf("error cleaning up traffic control status: %w", err)
+		}
+	}
+
+	return nil
+}
+
+func (ctrl *TrafficControlStatusController) query(handle *netlink.Handle, linkName string) (network.TrafficControlStatusSpec, error) {
+	var status network.TrafficControlStatusSpec
+
+	link, err := handle.LinkByName(linkName)
+	if err != nil {
+		return status, err
+	}
+
+	qdiscs, err := handle.QdiscList(link)
+	if err != nil {
+		return status, fmt.Errorf("error listing qdiscs: %w", err)
+	}
+
+	for _, qdisc := range qdiscs {
+		status.Qdiscs = append(status.Qdiscs, network.TrafficControlQdiscStatus{
+			Kind:   qdisc.Type(),
+			Handle: netlink.HandleStr(qdisc.Attrs().Handle),
+			Parent: netlink.HandleStr(qdisc.Attrs().Parent),
+		})
+
+		// only the classes and filters of the root qdisc are reported
+		if qdisc.Attrs().Parent != netlink.HANDLE_ROOT {
+			continue
+		}
+
+		var classes []netlink.Class
+
+		classes, err = handle.ClassList(link, qdisc.Attrs().Handle)
+		if err != nil {
+			return status, fmt.Errorf("error listing classes: %w", err)
+		}
+
+		for _, class := range classes {
+			classStatus := network.TrafficControlClassStatus{
+				Kind:   class.Type(),
+				Handle: netlink.HandleStr(class.Attrs().Handle),
+				Parent: netlink.HandleStr(class.Attrs().Parent),
+			}
+
+			if htbClass, ok := class.(*netlink.HtbClass); ok {
+				classStatus.Rate = htbClass.Rate
+				classStatus.Ceil = htbClass.Ceil
+			}
+
+			status.Classes = append(status.Classes, classStatus)
+		}
+
+		var filters []netlink.Filter
+
+		filters, err = handle.FilterList(link, qdisc.Attrs().Handle)
+		if err != nil {
+			return status, fmt.Errorf("error listing filters: %w", err)
+		}
+
+		for _, filter := range filters {
+			filterStatus := network.TrafficControlFilterStatus{
+				Kind:     filter.Type(),
+				Priority: uint32(filter.Attrs().Priority),
+			}
+
+			switch f := filter.(type) {
+			case *netlink.FwFilter:
+				filterStatus.ClassID = netlink.HandleStr(f.ClassId)
+			case *netlink.U32:
+				filterStatus.ClassID = netlink.HandleStr(f.ClassId)
+			case *netlink.BpfFilter:
+				filterStatus.ClassID = netlink.HandleStr(f.ClassId)
+			}
+
+			status.Filters = append(status.Filters, filterStatus)
+		}
+	}
+
+	return status, nil
+}
@@ -116,7 +116,7 @@ func (ctrl *KmsgLogDeliveryController) deliverLogs(ctx context.Context, r contro
 	}
 
 	// initialize all log senders
-	senders := slices.Map(destURLs, logging.NewJSONLines)
+	senders := slices.Map(destURLs, func(u *url.URL) machinedruntime.LogSender { return logging.NewJSONLines(u) })
 
 	defer func() {
 		closeCtx, closeCtxCancel := context.WithTimeout(context.Background(), logCloseTimeout)
@@ -0,0 +1,190 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package runtime
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/cosi-project/runtime/pkg/controller"
+	"github.com/cosi-project/runtime/pkg/safe"
+	"github.com/cosi-project/runtime/pkg/state"
+	"github.com/siderolabs/go-pointer"
+	"go.uber.org/zap"
+
+	machinedruntime "github.com/siderolabs/talos/internal/app/machined/pkg/runtime"
+	talosconfig "github.com/siderolabs/talos/pkg/machinery/config/config"
+	"github.com/siderolabs/talos/pkg/machinery/constants"
+	"github.com/siderolabs/talos/pkg/machinery/resources/config"
+	"github.com/siderolabs/talos/pkg/machinery/resources/runtime"
+	"github.com/siderolabs/talos/pkg/machinery/resources/v1alpha1"
+)
+
+// PersistentLogsController enables persisting service logs to disk once the filesystem for the logs is available.
+type PersistentLogsController struct {
+	// V1Alpha1Logging returns the current logging manager, as it is replaced once persistent logs are configured.
+	V1Alpha1Logging func() machinedruntime.LoggingManager
+}
+
+// Name implements controller.Controller interface.
+func (ctrl *PersistentLogsController) Name() string {
+	return "runtime.PersistentLogsController"
+}
+
+// Inputs implements controller.Controller interface.
+func (ctrl *PersistentLogsController) Inputs() []controller.Input {
+	return []controller.Input{
+		{
+			Namespace: config.NamespaceName,
+			Type:      config.MachineConfigType,
+			ID:        pointer.To(config.V1Alpha1ID),
+			Kind:      controller.InputWeak,
+		},
+		{
+			Namespace: v1alpha1.NamespaceName,
+			Type:      runtime.MountStatusType,
+			ID:        pointer.To(constants.EphemeralPartitionLabel),
+			Kind:      controller.InputWeak,
+		},
+		{
+			Namespace: runtime.NamespaceName,
+			Type:      runtime.MachineStatusType,
+			ID:        pointer.To(runtime.MachineStatusID),
+			Kind:      controller.InputWeak,
+		},
+	}
+}
+
+// Outputs implements controller.Controller interface.
+func (ctrl *PersistentLogsController) Outputs() []controller.Output {
+	return nil
+}
+
+// Run implements controller.Controller interface.
+//
+//nolint:gocyclo
+func (ctrl *PersistentLogsController) Run(ctx context.Context, r controller.Runtime, logger *zap.Logger) error {
+	var enabled bool
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-r.EventCh():
+		}
+
+		machineStatus, err := safe.ReaderGetByID[*runtime.MachineStatus](ctx, r, runtime.MachineStatusID)
+		if err != nil && !state.IsNotFoundError(err) {
+			return fmt.Errorf("error getting machine status: %w", err)
+		}
+
+		if machineStatus == nil {
+			continue
+		}
+
+		stage := machineStatus.TypedSpec().Stage
+
+		// when the machine is rebooting, upgrading, etc., persistence is stopped by the sequencer
+		// before the filesystems are unmounted, so don't touch it
+		if stage != runtime.MachineStageBooting && stage != runtime.MachineStageRunning {
+			continue
+		}
+
+		cfg, err := ctrl.persistenceConfig(ctx, r, stage)
+		if err != nil {
+			return err
+		}
+
+		// the logging manager supports persistence only when persistent logs are configured
+		persistence, ok := ctrl.V1Alpha1Logging().(machinedruntime.LogPersistence)
+
+		if cfg == nil {
+			if enabled && ok {
+				if err = persistence.DisablePersistence(); err != nil {
+					logger.Error("error disabling persistent logs", zap.Error(err))
+				}
+
+				logger.Info("persistent logs disabled")
+
+				enabled = false
+			}
+
+			continue
+		}
+
+		if !ok {
+			return fmt.Errorf("logging manager doesn't support persistent logs")
+		}
+
+		if err = persistence.EnablePersistence(*cfg); err != nil {
+			return fmt.Errorf("error enabling persistent logs: %w", err)
+		}
+
+		if !enabled {
+			logger.Info("persistent logs enabled", zap.String("path", cfg.Path))
+
+			enabled = true
+		}
+	}
+}
+
+// persistenceConfig returns the log persistence config if the persistence is configured and the filesystem is ready.
+func (ctrl *PersistentLogsController) persistenceConfig(ctx context.Context, r controller.Runtime, stage runtime.MachineStage) (*machinedruntime.LogPersistenceConfig, error) {
+	machineConfig, err := safe.ReaderGetByID[*config.MachineConfig](ctx, r, config.V1Alpha1ID)
+	if err != nil {
+		if state.IsNotFoundError(err) {
+			return nil, nil
+		}
+
+		return nil, fmt.Errorf("error getting machine config: %w", err)
+	}
+
+	logsConfig := machineConfig.Config().PersistentLogs()
+	if logsConfig == nil {
+		return nil, nil
+	}
+
+	if _, err = safe.ReaderGet[*runtime.MountStatus](ctx, r, runtime.NewMountStatus(v1alpha1.NamespaceName, constants.EphemeralPartitionLabel).Metadata()); err != nil {
+		if state.IsNotFoundError(err) {
+			return nil, nil
+		}
+
+		return nil, fmt.Errorf("error getting mount status: %w", err)
+	}
+
+	// user disks are mounted late in the boot sequence, so wait for the boot to finish
+	if stage != runtime.MachineStageRunning && onUserDisk(machineConfig.Config(), logsConfig.Path()) {
+		return nil, nil
+	}
+
+	return &machinedruntime.LogPersistenceConfig{
+		Path:        logsConfig.Path(),
+		MaxBoots:    logsConfig.MaxBoots(),
+		MaxSegments: logsConfig.MaxSegments(),
+		SegmentSize: constants.PersistentLogSegmentSize,
+	}, nil
+}
+
+func onUserDisk(cfg talosconfig.Config, path string) bool {
+	if cfg.Machine() == nil {
+		return false
+	}
+
+	for _, disk := range cfg.Machine().Disks() {
+		for _, partition := range disk.Partitions() {
+			mountPoint := strings.TrimSuffix(partition.MountPoint(), "/")
+			if mountPoint == "" {
+				continue
+			}
+
+			if path == mountPoint || strings.HasPrefix(path, mountPoint+"/") {
+				return true
+			}
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,141 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package runtime_test
+
+import (
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/siderolabs/go-retry/retry"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/siderolabs/talos/internal/app/machined/pkg/controllers/ctest"
+	runtimectrls "github.com/siderolabs/talos/internal/app/machined/pkg/controllers/runtime"
+	machinedruntime "github.com/siderolabs/talos/internal/app/machined/pkg/runtime"
+	"github.com/siderolabs/talos/internal/app/machined/pkg/runtime/logging"
+	"github.com/siderolabs/talos/pkg/machinery/config/container"
+	runtimecfg "github.com/siderolabs/talos/pkg/machinery/config/types/runtime"
+	"github.com/siderolabs/talos/pkg/machinery/constants"
+	"github.com/siderolabs/talos/pkg/machinery/resources/config"
+	"github.com/siderolabs/talos/pkg/machinery/resources/runtime"
+	"github.com/siderolabs/talos/pkg/machinery/resources/v1alpha1"
+)
+
+type fakeLogPersistence struct {
+	*logging.NullLoggingManager
+
+	mu  sync.Mutex
+	cfg *machinedruntime.LogPersistenceConfig
+}
+
+func (f *fakeLogPersistence) EnablePersistence(cfg machinedruntime.LogPersistenceConfig) error {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	f.cfg = &cfg
+
+	return nil
+}
+
+func (f *fakeLogPersistence) DisablePersistence() error {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	f.cfg = nil
+
+	return nil
+}
+
+func (f *fakeLogPersistence) config() *machinedruntime.LogPersistenceConfig {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	return f.cfg
+}
+
+type PersistentLogsSuite struct {
+	ctest.DefaultSuite
+
+	persistence *fakeLogPersistence
+}
+
+func TestPersistentLogsSuite(t *testing.T) {
+	s := &PersistentLogsSuite{}
+
+	s.DefaultSuite = ctest.DefaultSuite{
+		AfterSetup: func(suite *ctest.DefaultSuite) {
+			s.persistence = &fakeLogPersistence{
+				NullLoggingManager: logging.NewNullLoggingManager(),
+			}
+
+			suite.Require().NoError(suite.Runtime().RegisterController(&runtimectrls.PersistentLogsController{
+				V1Alpha1Logging: func() machinedruntime.LoggingManager { return s.persistence },
+			}))
+		},
+	}
+
+	suite.Run(t, s)
+}
+
+func (suite *PersistentLogsSuite) assertConfig(expected *machinedruntime.LogPersistenceConfig) {
+	suite.Require().NoError(retry.Constant(5*time.Second, retry.WithUnits(100*time.Millisecond)).Retry(func() error {
+		actual := suite.persistence.config()
+
+		switch {
+		case expected == nil && actual != nil:
+			return retry.ExpectedErrorf("persistence should be disabled")
+		case expected != nil && actual == nil:
+			return retry.ExpectedErrorf("persistence should be enabled")
+		case expected != nil && *expected != *actual:
+			return retry.ExpectedErrorf("unexpected persistence config %+v", *actual)
+		}
+
+		return nil
+	}))
+}
+
+func (suite *PersistentLogsSuite) TestEnableDisable() {
+	machineStatus := runtime.NewMachineStatus()
+	machineStatus.TypedSpec().Stage = runtime.MachineStageBooting
+	suite.Require().NoError(suite.State().Create(suite.Ctx(), machineStatus))
+
+	logsConfig := runtimecfg.NewPersistentLogsConfigV1Alpha1()
+	logsConfig.LogsMaxBoots = 3
+
+	cfg, err := container.New(logsConfig)
+	suite.Require().NoError(err)
+
+	machineConfig := config.NewMachineConfig(cfg)
+	suite.Require().NoError(suite.State().Create(suite.Ctx(), machineConfig))
+
+	// EPHEMERAL is not mounted yet
+	time.Sleep(500 * time.Millisecond)
+	suite.assertConfig(nil)
+
+	mountStatus := runtime.NewMountStatus(v1alpha1.NamespaceName, constants.EphemeralPartitionLabel)
+	suite.Require().NoError(suite.State().Create(suite.Ctx(), mountStatus))
+
+	suite.assertConfig(&machinedruntime.LogPersistenceConfig{
+		Path:        constants.PersistentLogPath,
+		MaxBoots:    3,
+		MaxSegments: constants.PersistentLogMaxSegments,
+		SegmentSize: constants.PersistentLogSegmentSize,
+	})
+
+	// persistence is left as is when the machine is rebooting (it is stopped by the sequencer)
+	machineStatus.TypedSpec().Stage = runtime.MachineStageRebooting
+	suite.Require().NoError(suite.State().Update(suite.Ctx(), machineStatus))
+
+	suite.Require().NoError(suite.State().Destroy(suite.Ctx(), mountStatus.Metadata()))
+
+	time.Sleep(500 * time.Millisecond)
+	suite.Assert().NotNil(suite.persistence.config())
+
+	machineStatus.TypedSpec().Stage = runtime.MachineStageRunning
+	suite.Require().NoError(suite.State().Update(suite.Ctx(), machineStatus))
+
+	suite.assertConfig(nil)
+}
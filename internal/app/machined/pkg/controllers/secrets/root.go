@@ -218,6 +218,16 @@ func (ctrl *RootController) updateK8sSecrets(cfgProvider talosconfig.Config, k8s
 	k8sSecrets.AESCBCEncryptionSecret = cfgProvider.Cluster().AESCBCEncryptionSecret()
 	k8sSecrets.SecretboxEncryptionSecret = cfgProvider.Cluster().SecretboxEncryptionSecret()
 
+	if kms := cfgProvider.Cluster().KMSEncryption(); kms.Enabled() {
+		k8sSecrets.KMSEncryptionName = kms.Name()
+		k8sSecrets.KMSEncryptionEndpoint = kms.Endpoint()
+		k8sSecrets.KMSEncryptionTimeout = kms.Timeout()
+	} else {
+		k8sSecrets.KMSEncryptionName = ""
+		k8sSecrets.KMSEncryptionEndpoint = ""
+		k8sSecrets.KMSEncryptionTimeout = 0
+	}
+
 	k8sSecrets.BootstrapTokenID = cfgProvider.Cluster().Token().ID()
 	k8sSecrets.BootstrapTokenSecret = cfgProvider.Cluster().Token().Secret()
 
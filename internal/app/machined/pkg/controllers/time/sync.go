@@ -7,6 +7,7 @@ package time
 import (
 	"context"
 	"fmt"
+	"reflect"
 	"sync"
 	stdtime "time"
 
@@ -18,6 +19,7 @@ import (
 
 	v1alpha1runtime "github.com/siderolabs/talos/internal/app/machined/pkg/runtime"
 	"github.com/siderolabs/talos/internal/pkg/ntp"
+	"github.com/siderolabs/talos/internal/pkg/ptp"
 	"github.com/siderolabs/talos/pkg/machinery/resources/config"
 	"github.com/siderolabs/talos/pkg/machinery/resources/network"
 	"github.com/siderolabs/talos/pkg/machinery/resources/time"
@@ -27,6 +29,8 @@ import (
 type SyncController struct {
 	V1Alpha1Mode v1alpha1runtime.Mode
 	NewNTPSyncer NewNTPSyncerFunc
+	NewNTPServer NewNTPServerFunc
+	NewPTPSyncer NewPTPSyncerFunc
 
 	bootTime stdtime.Time
 }
@@ -68,12 +72,52 @@ type NTPSyncer interface {
 	Run(ctx context.Context)
 	Synced() <-chan struct{}
 	EpochChange() <-chan struct{}
+	ServerChange() <-chan struct{}
+	SyncServer() (server string, authenticated bool)
 	SetTimeServers([]string)
+	SetNTSFallback(bool)
+	SetNTSNoCertTimeCheck(int)
+	SyncState() ntp.SyncState
 }
 
 // NewNTPSyncerFunc function allows to replace ntp.Syncer with the mock.
 type NewNTPSyncerFunc func(*zap.Logger, []string) NTPSyncer
 
+// PTPSyncer interface is implemented by ptp.Syncer, interface for mocking.
+type PTPSyncer interface {
+	Run(ctx context.Context)
+	Synced() <-chan struct{}
+	EpochChange() <-chan struct{}
+	StatusChange() <-chan struct{}
+	Status() ptp.Status
+	SyncState() ntp.SyncState
+}
+
+// NewPTPSyncerFunc function allows to replace ptp.Syncer with the mock.
+type NewPTPSyncerFunc func(logger *zap.Logger, device, iface string, pollInterval stdtime.Duration) PTPSyncer
+
+// timeSyncer is the common part of NTPSyncer and PTPSyncer.
+type timeSyncer interface {
+	Run(ctx context.Context)
+	Synced() <-chan struct{}
+	EpochChange() <-chan struct{}
+	SyncState() ntp.SyncState
+}
+
+type ptpSettings struct {
+	device       string
+	iface        string
+	pollInterval stdtime.Duration
+}
+
+// NTPServer interface is implemented by ntp.Server, interface for mocking.
+type NTPServer interface {
+	Run(ctx context.Context) error
+}
+
+// NewNTPServerFunc function allows to replace ntp.Server with the mock.
+type NewNTPServerFunc func(logger *zap.Logger, state ntp.SyncStateFunc, interfaces []string) NTPServer
+
 // Run implements controller.Controller interface.
 //
 //nolint:gocyclo,cyclop
@@ -88,23 +132,60 @@ func (ctrl *SyncController) Run(ctx context.Context, r controller.Runtime, logge
 		}
 	}
 
+	if ctrl.NewNTPServer == nil {
+		ctrl.NewNTPServer = func(logger *zap.Logger, state ntp.SyncStateFunc, interfaces []string) NTPServer {
+			return ntp.NewServer(logger, state, interfaces)
+		}
+	}
+
+	if ctrl.NewPTPSyncer == nil {
+		ctrl.NewPTPSyncer = func(logger *zap.Logger, device, iface string, pollInterval stdtime.Duration) PTPSyncer {
+			syncer := ptp.NewSyncer(logger, device, iface)
+			syncer.PollInterval = pollInterval
+
+			return syncer
+		}
+	}
+
 	var (
 		syncCtx       context.Context
 		syncCtxCancel context.CancelFunc
 		syncWg        sync.WaitGroup
 
-		syncCh  <-chan struct{}
-		epochCh <-chan struct{}
-		syncer  NTPSyncer
+		syncCh     <-chan struct{}
+		epochCh    <-chan struct{}
+		statusCh   <-chan struct{}
+		syncer     timeSyncer
+		ntpSyncer  NTPSyncer
+		ptpSyncer  PTPSyncer
+		runningPTP ptpSettings
 
 		timeSynced bool
 		epoch      int
 
 		timeSyncTimeoutTimer *stdtime.Timer
 		timeSyncTimeoutCh    <-chan stdtime.Time
+
+		serverCtxCancel  context.CancelFunc
+		serverWg         sync.WaitGroup
+		serverInterfaces []string
 	)
 
+	stopServer := func() {
+		if serverCtxCancel == nil {
+			return
+		}
+
+		serverCtxCancel()
+
+		serverWg.Wait()
+
+		serverCtxCancel = nil
+	}
+
 	defer func() {
+		stopServer()
+
 		if syncer != nil {
 			syncCtxCancel()
 
@@ -126,6 +207,7 @@ func (ctrl *SyncController) Run(ctx context.Context, r controller.Runtime, logge
 			timeSynced = true
 		case <-epochCh:
 			epoch++
+		case <-statusCh:
 		case <-timeSyncTimeoutCh:
 			timeSynced = true
 			timeSyncTimeoutTimer = nil
@@ -153,6 +235,15 @@ func (ctrl *SyncController) Run(ctx context.Context, r controller.Runtime, logge
 		var syncTimeout stdtime.Duration
 
 		syncDisabled := false
+		ntsFallback := false
+		ntsNoCertTimeCheck := 0
+		ntpServerEnabled := false
+		ptpEnabled := false
+
+		var (
+			ntpServerInterfaces []string
+			ptpConfig           ptpSettings
+		)
 
 		if ctrl.V1Alpha1Mode == v1alpha1runtime.ModeContainer {
 			syncDisabled = true
@@ -164,6 +255,17 @@ func (ctrl *SyncController) Run(ctx context.Context, r controller.Runtime, logge
 
 		if cfg != nil {
 			syncTimeout = cfg.(*config.MachineConfig).Config().Machine().Time().BootTimeout()
+			ntsFallback = cfg.(*config.MachineConfig).Config().Machine().Time().NTSFallback()
+			ntsNoCertTimeCheck = cfg.(*config.MachineConfig).Config().Machine().Time().NTSNoCertTimeCheck()
+			ntpServerEnabled = cfg.(*config.MachineConfig).Config().Machine().Time().NTPServer().Enabled()
+			ntpServerInterfaces = cfg.(*config.MachineConfig).Config().Machine().Time().NTPServer().Interfaces()
+
+			ptpEnabled = cfg.(*config.MachineConfig).Config().Machine().Time().PTP().Enabled()
+			ptpConfig = ptpSettings{
+				device:       cfg.(*config.MachineConfig).Config().Machine().Time().PTP().Device(),
+				iface:        cfg.(*config.MachineConfig).Config().Machine().Time().PTP().Interface(),
+				pollInterval: cfg.(*config.MachineConfig).Config().Machine().Time().PTP().PollInterval(),
+			}
 		}
 
 		if !timeSynced {
@@ -189,19 +291,35 @@ func (ctrl *SyncController) Run(ctx context.Context, r controller.Runtime, logge
 			}
 		}
 
-		switch {
-		case syncDisabled && syncer != nil:
-			// stop syncing
+		if syncer != nil && (syncDisabled || ptpEnabled != (ptpSyncer != nil) || (ptpSyncer != nil && runningPTP != ptpConfig)) {
+			// stop syncing (or restart it if the time source changed), NTP server can't run without the syncer
+			stopServer()
+
 			syncCtxCancel()
 
 			syncWg.Wait()
 
 			syncer = nil
+			ntpSyncer = nil
+			ptpSyncer = nil
 			syncCh = nil
 			epochCh = nil
-		case !syncDisabled && syncer == nil:
+			statusCh = nil
+		}
+
+		if !syncDisabled && syncer == nil {
 			// start syncing
-			syncer = ctrl.NewNTPSyncer(logger, timeServers)
+			if ptpEnabled {
+				ptpSyncer = ctrl.NewPTPSyncer(logger, ptpConfig.device, ptpConfig.iface, ptpConfig.pollInterval)
+				runningPTP = ptpConfig
+				syncer = ptpSyncer
+				statusCh = ptpSyncer.StatusChange()
+			} else {
+				ntpSyncer = ctrl.NewNTPSyncer(logger, timeServers)
+				syncer = ntpSyncer
+				statusCh = ntpSyncer.ServerChange()
+			}
+
 			syncCh = syncer.Synced()
 			epochCh = syncer.EpochChange()
 
@@ -218,8 +336,47 @@ func (ctrl *SyncController) Run(ctx context.Context, r controller.Runtime, logge
 			}()
 		}
 
-		if syncer != nil {
-			syncer.SetTimeServers(timeServers)
+		if serverCtxCancel != nil && (!ntpServerEnabled || syncer == nil || !reflect.DeepEqual(serverInterfaces, ntpServerInterfaces)) {
+			// stop NTP server (or restart it if the interfaces changed)
+			stopServer()
+		}
+
+		if serverCtxCancel == nil && ntpServerEnabled && syncer != nil {
+			// start NTP server
+			server := ctrl.NewNTPServer(logger, syncer.SyncState, ntpServerInterfaces)
+			serverInterfaces = ntpServerInterfaces
+
+			serverCtx, serverCancel := context.WithCancel(ctx)
+			serverCtxCancel = serverCancel
+
+			serverWg.Add(1)
+
+			go func() {
+				defer serverWg.Done()
+
+				if err := server.Run(serverCtx); err != nil {
+					logger.Error("NTP server failed", zap.Error(err))
+				}
+			}()
+		}
+
+		var (
+			syncServer    string
+			authenticated bool
+			ptpStatus     ptp.Status
+		)
+
+		if ntpSyncer != nil {
+			ntpSyncer.SetNTSFallback(ntsFallback)
+			ntpSyncer.SetNTSNoCertTimeCheck(ntsNoCertTimeCheck)
+			ntpSyncer.SetTimeServers(timeServers)
+
+			syncServer, authenticated = ntpSyncer.SyncServer()
+		}
+
+		if ptpSyncer != nil {
+			ptpStatus = ptpSyncer.Status()
+			syncServer = ptpStatus.Device
 		}
 
 		if syncDisabled {
@@ -228,9 +385,16 @@ func (ctrl *SyncController) Run(ctx context.Context, r controller.Runtime, logge
 
 		if err = r.Modify(ctx, time.NewStatus(), func(r resource.Resource) error {
 			*r.(*time.Status).TypedSpec() = time.StatusSpec{
-				Epoch:        epoch,
-				Synced:       timeSynced,
-				SyncDisabled: syncDisabled,
+				Epoch:         epoch,
+				Synced:        timeSynced,
+				SyncDisabled:  syncDisabled,
+				SyncServer:    syncServer,
+				Authenticated: authenticated,
+
+				PTPClockIdentity: ptpStatus.ClockIdentity,
+				PTPOffset:        ptpStatus.Offset,
+				PTPReadDelay:     ptpStatus.ReadDelay,
+				PTPPathDelay:     ptpStatus.PathDelay,
 			}
 
 			return nil
@@ -27,6 +27,8 @@ import (
 	"github.com/siderolabs/talos/internal/app/machined/pkg/controllers/ctest"
 	timectrl "github.com/siderolabs/talos/internal/app/machined/pkg/controllers/time"
 	v1alpha1runtime "github.com/siderolabs/talos/internal/app/machined/pkg/runtime"
+	"github.com/siderolabs/talos/internal/pkg/ntp"
+	"github.com/siderolabs/talos/internal/pkg/ptp"
 	"github.com/siderolabs/talos/pkg/logging"
 	"github.com/siderolabs/talos/pkg/machinery/config/container"
 	"github.com/siderolabs/talos/pkg/machinery/config/types/v1alpha1"
@@ -50,6 +52,12 @@ type SyncSuite struct {
 
 	syncerMu sync.Mutex
 	syncer   *mockSyncer
+
+	serversMu sync.Mutex
+	servers   []*mockServer
+
+	ptpSyncersMu sync.Mutex
+	ptpSyncers   []*mockPTPSyncer
 }
 
 func (suite *SyncSuite) State() state.State { return suite.state }
@@ -61,6 +69,10 @@ func (suite *SyncSuite) SetupTest() {
 
 	suite.state = state.WrapCore(namespaced.NewState(inmem.Build))
 
+	suite.syncer = nil
+	suite.servers = nil
+	suite.ptpSyncers = nil
+
 	var err error
 
 	logger := logging.Wrap(log.Writer())
@@ -449,6 +461,356 @@ func (suite *SyncSuite) TestReconcileSyncBootTimeout() {
 	)
 }
 
+func (suite *SyncSuite) TestReconcileSyncNTS() {
+	suite.Require().NoError(
+		suite.runtime.RegisterController(
+			&timectrl.SyncController{
+				V1Alpha1Mode: v1alpha1runtime.ModeMetal,
+				NewNTPSyncer: suite.newMockSyncer,
+			},
+		),
+	)
+
+	suite.startRuntime()
+
+	timeServers := network.NewTimeServerStatus(network.NamespaceName, network.TimeServerID)
+	timeServers.TypedSpec().NTPServers = []string{"nts://time.cloudflare.com"}
+	suite.Require().NoError(suite.state.Create(suite.ctx, timeServers))
+
+	cfg := config.NewMachineConfig(
+		container.NewV1Alpha1(
+			&v1alpha1.Config{
+				ConfigVersion: "v1alpha1",
+				MachineConfig: &v1alpha1.MachineConfig{
+					MachineTime: &v1alpha1.TimeConfig{
+						TimeServers:            []string{"nts://time.cloudflare.com"},
+						TimeNTSFallback:        pointer.To(true),
+						TimeNTSNoCertTimeCheck: 3,
+					},
+				},
+				ClusterConfig: &v1alpha1.ClusterConfig{},
+			},
+		),
+	)
+
+	suite.Require().NoError(suite.state.Create(suite.ctx, cfg))
+
+	var mockSyncer *mockSyncer
+
+	suite.Assert().NoError(
+		retry.Constant(10*time.Second, retry.WithUnits(100*time.Millisecond)).Retry(
+			func() error {
+				mockSyncer = suite.getMockSyncer()
+
+				if mockSyncer == nil {
+					return retry.ExpectedError(fmt.Errorf("syncer not created yet"))
+				}
+
+				if !mockSyncer.getNTSFallback() {
+					return retry.ExpectedError(fmt.Errorf("NTS fallback not set yet"))
+				}
+
+				if mockSyncer.getNTSNoCertTimeCheck() != 3 {
+					return retry.ExpectedError(fmt.Errorf("NTS certificate time check skips not set yet"))
+				}
+
+				return nil
+			},
+		),
+	)
+
+	mockSyncer.setSyncServer("nts://time.cloudflare.com", true)
+	close(mockSyncer.syncedCh)
+
+	suite.Assert().NoError(
+		retry.Constant(10*time.Second, retry.WithUnits(100*time.Millisecond)).Retry(
+			func() error {
+				return suite.assertTimeStatus(
+					timeresource.StatusSpec{
+						Synced:        true,
+						Epoch:         0,
+						SyncDisabled:  false,
+						SyncServer:    "nts://time.cloudflare.com",
+						Authenticated: true,
+					},
+				)
+			},
+		),
+	)
+
+	// fallback to unauthenticated NTP
+	mockSyncer.setSyncServer("nts://time.cloudflare.com", false)
+
+	suite.Assert().NoError(
+		retry.Constant(10*time.Second, retry.WithUnits(100*time.Millisecond)).Retry(
+			func() error {
+				return suite.assertTimeStatus(
+					timeresource.StatusSpec{
+						Synced:        true,
+						Epoch:         0,
+						SyncDisabled:  false,
+						SyncServer:    "nts://time.cloudflare.com",
+						Authenticated: false,
+					},
+				)
+			},
+		),
+	)
+}
+
+func (suite *SyncSuite) TestReconcileNTPServer() {
+	suite.Require().NoError(
+		suite.runtime.RegisterController(
+			&timectrl.SyncController{
+				V1Alpha1Mode: v1alpha1runtime.ModeMetal,
+				NewNTPSyncer: suite.newMockSyncer,
+				NewNTPServer: suite.newMockServer,
+			},
+		),
+	)
+
+	suite.startRuntime()
+
+	timeServers := network.NewTimeServerStatus(network.NamespaceName, network.TimeServerID)
+	timeServers.TypedSpec().NTPServers = []string{constants.DefaultNTPServer}
+	suite.Require().NoError(suite.state.Create(suite.ctx, timeServers))
+
+	cfg := config.NewMachineConfig(
+		container.NewV1Alpha1(
+			&v1alpha1.Config{
+				ConfigVersion: "v1alpha1",
+				MachineConfig: &v1alpha1.MachineConfig{
+					MachineTime: &v1alpha1.TimeConfig{
+						TimeNTPServer: &v1alpha1.TimeNTPServerConfig{
+							NTPServerEnabled:    pointer.To(true),
+							NTPServerInterfaces: []string{"eth0"},
+						},
+					},
+				},
+				ClusterConfig: &v1alpha1.ClusterConfig{},
+			},
+		),
+	)
+
+	suite.Require().NoError(suite.state.Create(suite.ctx, cfg))
+
+	suite.Assert().NoError(suite.assertServers([]string{"eth0"}))
+
+	// the server is restarted on interface change
+	ctest.UpdateWithConflicts(suite, cfg, func(r *config.MachineConfig) error {
+		r.Container().RawV1Alpha1().MachineConfig.MachineTime.TimeNTPServer.NTPServerInterfaces = []string{"eth1"}
+
+		return nil
+	})
+
+	suite.Assert().NoError(suite.assertServers([]string{"eth0"}, []string{"eth1"}))
+
+	// the server state comes from the syncer
+	mockSyncer := suite.getMockSyncer()
+	suite.Require().NotNil(mockSyncer)
+
+	mockSyncer.setSyncState(ntp.SyncState{Synced: true, Stratum: 2})
+
+	suite.Assert().Equal(ntp.SyncState{Synced: true, Stratum: 2}, suite.getMockServers()[1].state())
+
+	// the server is stopped when time sync is disabled
+	ctest.UpdateWithConflicts(suite, cfg, func(r *config.MachineConfig) error {
+		r.Container().RawV1Alpha1().MachineConfig.MachineTime.TimeDisabled = pointer.To(true)
+
+		return nil
+	})
+
+	suite.Assert().NoError(suite.assertServers([]string{"eth0"}, []string{"eth1"}))
+
+	suite.Assert().NoError(
+		retry.Constant(10*time.Second, retry.WithUnits(100*time.Millisecond)).Retry(
+			func() error {
+				if suite.getMockServers()[1].isRunning() {
+					return retry.ExpectedError(fmt.Errorf("NTP server is still running"))
+				}
+
+				return nil
+			},
+		),
+	)
+}
+
+func (suite *SyncSuite) TestReconcilePTP() {
+	suite.Require().NoError(
+		suite.runtime.RegisterController(
+			&timectrl.SyncController{
+				V1Alpha1Mode: v1alpha1runtime.ModeMetal,
+				NewNTPSyncer: suite.newMockSyncer,
+				NewPTPSyncer: suite.newMockPTPSyncer,
+			},
+		),
+	)
+
+	suite.startRuntime()
+
+	cfg := config.NewMachineConfig(
+		container.NewV1Alpha1(
+			&v1alpha1.Config{
+				ConfigVersion: "v1alpha1",
+				MachineConfig: &v1alpha1.MachineConfig{
+					MachineTime: &v1alpha1.TimeConfig{
+						TimePTP: &v1alpha1.TimePTPConfig{
+							PTPEnabled:   pointer.To(true),
+							PTPInterface: "eth0",
+						},
+					},
+				},
+				ClusterConfig: &v1alpha1.ClusterConfig{},
+			},
+		),
+	)
+
+	suite.Require().NoError(suite.state.Create(suite.ctx, cfg))
+
+	// time servers are created after the config, so that NTP syncer is not started
+	timeServers := network.NewTimeServerStatus(network.NamespaceName, network.TimeServerID)
+	timeServers.TypedSpec().NTPServers = []string{constants.DefaultNTPServer}
+	suite.Require().NoError(suite.state.Create(suite.ctx, timeServers))
+
+	var ptpSyncer *mockPTPSyncer
+
+	suite.Assert().NoError(
+		retry.Constant(10*time.Second, retry.WithUnits(100*time.Millisecond)).Retry(
+			func() error {
+				ptpSyncers := suite.getMockPTPSyncers()
+
+				if len(ptpSyncers) != 1 {
+					return retry.ExpectedError(fmt.Errorf("PTP syncer not created yet"))
+				}
+
+				ptpSyncer = ptpSyncers[0]
+
+				return nil
+			},
+		),
+	)
+
+	suite.Assert().Equal("eth0", ptpSyncer.iface)
+	suite.Assert().Equal(time.Second, ptpSyncer.pollInterval)
+
+	// NTP syncer is not used
+	suite.Assert().Nil(suite.getMockSyncer())
+
+	ptpSyncer.setStatus(ptp.Status{
+		Device:        "/dev/ptp0",
+		ClockIdentity: "b49691.fffe.0a0b0c",
+		Offset:        -50 * time.Nanosecond,
+		ReadDelay:     300 * time.Nanosecond,
+		PathDelay:     2 * time.Microsecond,
+	})
+	close(ptpSyncer.syncedCh)
+
+	suite.Assert().NoError(
+		retry.Constant(10*time.Second, retry.WithUnits(100*time.Millisecond)).Retry(
+			func() error {
+				return suite.assertTimeStatus(
+					timeresource.StatusSpec{
+						Synced:           true,
+						SyncServer:       "/dev/ptp0",
+						PTPClockIdentity: "b49691.fffe.0a0b0c",
+						PTPOffset:        -50 * time.Nanosecond,
+						PTPReadDelay:     300 * time.Nanosecond,
+						PTPPathDelay:     2 * time.Microsecond,
+					},
+				)
+			},
+		),
+	)
+
+	// changing the device restarts the PTP syncer
+	ctest.UpdateWithConflicts(suite, cfg, func(r *config.MachineConfig) error {
+		r.Container().RawV1Alpha1().MachineConfig.MachineTime.TimePTP.PTPInterface = ""
+		r.Container().RawV1Alpha1().MachineConfig.MachineTime.TimePTP.PTPDevice = "/dev/ptp1"
+
+		return nil
+	})
+
+	suite.Assert().NoError(
+		retry.Constant(10*time.Second, retry.WithUnits(100*time.Millisecond)).Retry(
+			func() error {
+				ptpSyncers := suite.getMockPTPSyncers()
+
+				if len(ptpSyncers) != 2 {
+					return retry.ExpectedError(fmt.Errorf("PTP syncer not restarted yet"))
+				}
+
+				if ptpSyncers[0].isRunning() {
+					return retry.ExpectedError(fmt.Errorf("old PTP syncer is still running"))
+				}
+
+				return nil
+			},
+		),
+	)
+
+	suite.Assert().Equal("/dev/ptp1", suite.getMockPTPSyncers()[1].device)
+
+	// disabling PTP switches back to NTP
+	ctest.UpdateWithConflicts(suite, cfg, func(r *config.MachineConfig) error {
+		r.Container().RawV1Alpha1().MachineConfig.MachineTime.TimePTP = nil
+
+		return nil
+	})
+
+	suite.Assert().NoError(
+		retry.Constant(10*time.Second, retry.WithUnits(100*time.Millisecond)).Retry(
+			func() error {
+				if suite.getMockSyncer() == nil {
+					return retry.ExpectedError(fmt.Errorf("NTP syncer not created yet"))
+				}
+
+				if suite.getMockPTPSyncers()[1].isRunning() {
+					return retry.ExpectedError(fmt.Errorf("PTP syncer is still running"))
+				}
+
+				return nil
+			},
+		),
+	)
+
+	suite.Assert().NoError(
+		retry.Constant(10*time.Second, retry.WithUnits(100*time.Millisecond)).Retry(
+			func() error {
+				return suite.assertTimeStatus(
+					timeresource.StatusSpec{
+						Synced: false,
+					},
+				)
+			},
+		),
+	)
+}
+
+// assertServers checks that the servers were created for the interfaces, and only the last one is running.
+func (suite *SyncSuite) assertServers(interfaces ...[]string) error {
+	return retry.Constant(10*time.Second, retry.WithUnits(100*time.Millisecond)).Retry(
+		func() error {
+			servers := suite.getMockServers()
+
+			if len(servers) != len(interfaces) {
+				return retry.ExpectedError(fmt.Errorf("expected %d servers, got %d", len(interfaces), len(servers)))
+			}
+
+			for i, server := range servers {
+				if !reflect.DeepEqual(server.interfaces, interfaces[i]) {
+					return fmt.Errorf("unexpected server interfaces: %v != %v", server.interfaces, interfaces[i])
+				}
+
+				if i < len(servers)-1 && server.isRunning() {
+					return retry.ExpectedError(fmt.Errorf("server %d is still running", i))
+				}
+			}
+
+			return nil
+		},
+	)
+}
+
 func (suite *SyncSuite) TearDownTest() {
 	suite.T().Log("tear down")
 
@@ -473,6 +835,52 @@ func (suite *SyncSuite) getMockSyncer() *mockSyncer {
 	return suite.syncer
 }
 
+func (suite *SyncSuite) newMockServer(_ *zap.Logger, state ntp.SyncStateFunc, interfaces []string) timectrl.NTPServer {
+	suite.serversMu.Lock()
+	defer suite.serversMu.Unlock()
+
+	server := &mockServer{
+		state:      state,
+		interfaces: interfaces,
+	}
+
+	suite.servers = append(suite.servers, server)
+
+	return server
+}
+
+func (suite *SyncSuite) getMockServers() []*mockServer {
+	suite.serversMu.Lock()
+	defer suite.serversMu.Unlock()
+
+	return slices.Clone(suite.servers)
+}
+
+func (suite *SyncSuite) newMockPTPSyncer(_ *zap.Logger, device, iface string, pollInterval time.Duration) timectrl.PTPSyncer {
+	suite.ptpSyncersMu.Lock()
+	defer suite.ptpSyncersMu.Unlock()
+
+	syncer := &mockPTPSyncer{
+		device:       device,
+		iface:        iface,
+		pollInterval: pollInterval,
+		syncedCh:     make(chan struct{}),
+		epochCh:      make(chan struct{}, 1),
+		statusCh:     make(chan struct{}, 1),
+	}
+
+	suite.ptpSyncers = append(suite.ptpSyncers, syncer)
+
+	return syncer
+}
+
+func (suite *SyncSuite) getMockPTPSyncers() []*mockPTPSyncer {
+	suite.ptpSyncersMu.Lock()
+	defer suite.ptpSyncersMu.Unlock()
+
+	return slices.Clone(suite.ptpSyncers)
+}
+
 func TestSyncSuite(t *testing.T) {
 	suite.Run(t, new(SyncSuite))
 }
@@ -480,9 +888,15 @@ func TestSyncSuite(t *testing.T) {
 type mockSyncer struct {
 	mu sync.Mutex
 
-	timeServers []string
-	syncedCh    chan struct{}
-	epochCh     chan struct{}
+	timeServers   []string
+	ntsFallback   bool
+	ntsNoCertTime int
+	syncServer    string
+	authenticated bool
+	syncState     ntp.SyncState
+	syncedCh      chan struct{}
+	epochCh       chan struct{}
+	serverCh      chan struct{}
 }
 
 func (mock *mockSyncer) Run(ctx context.Context) {
@@ -497,6 +911,67 @@ func (mock *mockSyncer) EpochChange() <-chan struct{} {
 	return mock.epochCh
 }
 
+func (mock *mockSyncer) ServerChange() <-chan struct{} {
+	return mock.serverCh
+}
+
+func (mock *mockSyncer) SyncServer() (server string, authenticated bool) {
+	mock.mu.Lock()
+	defer mock.mu.Unlock()
+
+	return mock.syncServer, mock.authenticated
+}
+
+func (mock *mockSyncer) setSyncServer(server string, authenticated bool) {
+	mock.mu.Lock()
+	mock.syncServer, mock.authenticated = server, authenticated
+	mock.mu.Unlock()
+
+	mock.serverCh <- struct{}{}
+}
+
+func (mock *mockSyncer) SyncState() ntp.SyncState {
+	mock.mu.Lock()
+	defer mock.mu.Unlock()
+
+	return mock.syncState
+}
+
+func (mock *mockSyncer) setSyncState(state ntp.SyncState) {
+	mock.mu.Lock()
+	defer mock.mu.Unlock()
+
+	mock.syncState = state
+}
+
+func (mock *mockSyncer) SetNTSFallback(fallback bool) {
+	mock.mu.Lock()
+	defer mock.mu.Unlock()
+
+	mock.ntsFallback = fallback
+}
+
+func (mock *mockSyncer) getNTSFallback() bool {
+	mock.mu.Lock()
+	defer mock.mu.Unlock()
+
+	return mock.ntsFallback
+}
+
+func (mock *mockSyncer) SetNTSNoCertTimeCheck(count int) {
+	mock.mu.Lock()
+	defer mock.mu.Unlock()
+
+	mock.ntsNoCertTime = count
+}
+
+func (mock *mockSyncer) getNTSNoCertTimeCheck() int {
+	mock.mu.Lock()
+	defer mock.mu.Unlock()
+
+	return mock.ntsNoCertTime
+}
+
 func (mock *mockSyncer) getTimeServers() (servers []string) {
 	mock.mu.Lock()
 	defer mock.mu.Unlock()
@@ -516,5 +991,99 @@ func newMockSyncer(_ *zap.Logger, servers []string) *mockSyncer {
 		timeServers: slices.Clone(servers),
 		syncedCh:    make(chan struct{}, 1),
 		epochCh:     make(chan struct{}, 1),
+		serverCh:    make(chan struct{}, 1),
 	}
 }
+
+type mockServer struct {
+	state      ntp.SyncStateFunc
+	interfaces []string
+
+	mu      sync.Mutex
+	running bool
+}
+
+func (mock *mockServer) Run(ctx context.Context) error {
+	mock.mu.Lock()
+	mock.running = true
+	mock.mu.Unlock()
+
+	<-ctx.Done()
+
+	mock.mu.Lock()
+	mock.running = false
+	mock.mu.Unlock()
+
+	return nil
+}
+
+func (mock *mockServer) isRunning() bool {
+	mock.mu.Lock()
+	defer mock.mu.Unlock()
+
+	return mock.running
+}
+
+type mockPTPSyncer struct {
+	device       string
+	iface        string
+	pollInterval time.Duration
+
+	syncedCh chan struct{}
+	epochCh  chan struct{}
+	statusCh chan struct{}
+
+	mu      sync.Mutex
+	status  ptp.Status
+	running bool
+}
+
+func (mock *mockPTPSyncer) Run(ctx context.Context) {
+	mock.mu.Lock()
+	mock.running = true
+	mock.mu.Unlock()
+
+	<-ctx.Done()
+
+	mock.mu.Lock()
+	mock.running = false
+	mock.mu.Unlock()
+}
+
+func (mock *mockPTPSyncer) isRunning() bool {
+	mock.mu.Lock()
+	defer mock.mu.Unlock()
+
+	return mock.running
+}
+
+func (mock *mockPTPSyncer) Synced() <-chan struct{} {
+	return mock.syncedCh
+}
+
+func (mock *mockPTPSyncer) EpochChange() <-chan struct{} {
+	return mock.epochCh
+}
+
+func (mock *mockPTPSyncer) StatusChange() <-chan struct{} {
+	return mock.statusCh
+}
+
+func (mock *mockPTPSyncer) Status() ptp.Status {
+	mock.mu.Lock()
+	defer mock.mu.Unlock()
+
+	return mock.status
+}
+
+func (mock *mockPTPSyncer) setStatus(status ptp.Status) {
+	mock.mu.Lock()
+	mock.status = status
+	mock.mu.Unlock()
+
+	mock.statusCh <- struct{}{}
+}
+
+func (mock *mockPTPSyncer) SyncState() ntp.SyncState {
+	return ntp.SyncState{}
+}
@@ -9,6 +9,8 @@ import (
 	"log"
 
 	"github.com/cosi-project/runtime/pkg/controller"
+
+	"github.com/siderolabs/talos/pkg/machinery/api/machine"
 )
 
 // TaskSetupFunc defines the function that a task will execute for a specific runtime
@@ -54,6 +56,7 @@ type Controller interface {
 	Runtime() Runtime
 	Sequencer() Sequencer
 	Run(context.Context, Sequence, interface{}, ...LockOption) error
+	Plan(Sequence, interface{}) (*machine.SequencePlan, error)
 	V1Alpha2() V1Alpha2Controller
 }
 
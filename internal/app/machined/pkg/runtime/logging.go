@@ -25,10 +25,33 @@ type LoggingManager interface {
 	SetSenders(senders []LogSender) []LogSender
 }
 
+// LogPersistenceConfig configures persisting service logs to disk.
+type LogPersistenceConfig struct {
+	// Path is the root directory for the persisted logs, each boot gets its own subdirectory.
+	Path string
+	// MaxBoots is the number of boots (including the current one) to keep the logs for.
+	MaxBoots int
+	// MaxSegments is the number of compressed log segments to keep for each service in each boot.
+	MaxSegments int
+	// SegmentSize is the size of the uncompressed log segment which triggers rotation.
+	SegmentSize int64
+}
+
+// LogPersistence is implemented by logging managers which can persist service logs across reboots.
+type LogPersistence interface {
+	// EnablePersistence starts (or reconfigures) persisting service logs to disk.
+	EnablePersistence(cfg LogPersistenceConfig) error
+	// DisablePersistence stops persisting service logs, flushing and closing all open files.
+	//
+	// DisablePersistence should be called before the filesystem holding the logs is unmounted.
+	DisablePersistence() error
+}
+
 // LogOptions for LogHandler.Reader.
 type LogOptions struct {
 	Follow    bool
 	TailLines *int
+	Boot      int
 }
 
 // LogOption provides functional options for LogHandler.Reader.
@@ -52,6 +75,19 @@ func WithTailLines(lines int) LogOption {
 	}
 }
 
+// WithBoot selects the boot to read the logs from: 0 is the current boot, -1 is the previous one, and so on.
+func WithBoot(boot int) LogOption {
+	return func(o *LogOptions) error {
+		if boot > 0 {
+			return fmt.Errorf("boot should be zero or negative, got %d", boot)
+		}
+
+		o.Boot = boot
+
+		return nil
+	}
+}
+
 // LogHandler provides interface to access particular log source.
 type LogHandler interface {
 	Writer() (io.WriteCloser, error)
@@ -179,6 +179,10 @@ func (handler *circularHandler) Reader(opts ...runtime.LogOption) (io.ReadCloser
 		}
 	}
 
+	if opt.Boot != 0 {
+		return nil, fmt.Errorf("logs of the previous boots are not available")
+	}
+
 	var r interface {
 		io.ReadCloser
 		io.Seeker
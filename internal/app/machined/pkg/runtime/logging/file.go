@@ -79,6 +79,10 @@ func (handler *fileLogHandler) Reader(opts ...runtime.LogOption) (io.ReadCloser,
 		}
 	}
 
+	if opt.Boot != 0 {
+		return nil, fmt.Errorf("logs of the previous boots are not available")
+	}
+
 	if err := handler.buildPath(); err != nil {
 		return nil, err
 	}
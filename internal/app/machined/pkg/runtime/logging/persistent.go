@@ -0,0 +1,308 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package logging
+
+import (
+	"bufio"
+	"bytes"
+	"fmt"
+	"io"
+	"path/filepath"
+	"strings"
+	"sync"
+
+	"github.com/siderolabs/go-tail"
+
+	"github.com/siderolabs/talos/internal/app/machined/pkg/runtime"
+)
+
+// PersistentLoggingManager wraps CircularBufferLoggingManager, and optionally persists service logs to disk,
+// so that the logs of the previous boots are available.
+//
+// Persistence is disabled by default, and it can be enabled once the filesystem for the logs is available.
+// Logs written before persistence is enabled are persisted from the circular buffers (as long as they still fit),
+// including the logs of the services which were started before the manager was created.
+type PersistentLoggingManager struct {
+	*CircularBufferLoggingManager
+
+	// persisters tracks services which have persistence goroutine running.
+	persisters sync.Map
+
+	mu       sync.Mutex
+	cfg      *runtime.LogPersistenceConfig
+	bootDir  string
+	enabled  chan struct{}
+	segments map[string]*segmentWriter
+}
+
+// Check interfaces.
+var (
+	_ runtime.LoggingManager = &PersistentLoggingManager{}
+	_ runtime.LogPersistence = &PersistentLoggingManager{}
+)
+
+// NewPersistentLoggingManager initializes new PersistentLoggingManager on top of the existing circular buffers.
+func NewPersistentLoggingManager(circular *CircularBufferLoggingManager) *PersistentLoggingManager {
+	return &PersistentLoggingManager{
+		CircularBufferLoggingManager: circular,
+		enabled:                      make(chan struct{}),
+		segments:                     map[string]*segmentWriter{},
+	}
+}
+
+// ServiceLog implements runtime.LoggingManager interface.
+func (manager *PersistentLoggingManager) ServiceLog(id string) runtime.LogHandler {
+	return &persistentHandler{
+		LogHandler: manager.CircularBufferLoggingManager.ServiceLog(id),
+		manager:    manager,
+		id:         id,
+	}
+}
+
+// EnablePersistence implements runtime.LogPersistence interface.
+func (manager *PersistentLoggingManager) EnablePersistence(cfg runtime.LogPersistenceConfig) error {
+	manager.mu.Lock()
+	defer manager.mu.Unlock()
+
+	if manager.cfg != nil && *manager.cfg == cfg {
+		return nil
+	}
+
+	if err := manager.closeSegments(); err != nil {
+		manager.fallbackLogger.Printf("error closing persistent logs: %s", err)
+	}
+
+	bootDir, err := prepareBootDir(cfg.Path, cfg.MaxBoots)
+	if err != nil {
+		return fmt.Errorf("error preparing persistent logs directory: %w", err)
+	}
+
+	wasEnabled := manager.cfg != nil
+
+	manager.cfg = &cfg
+	manager.bootDir = bootDir
+
+	if !wasEnabled {
+		close(manager.enabled)
+
+		// services which were writing logs before the manager was created are not tracked by the handlers
+		manager.buffers.Range(func(key, _ any) bool {
+			manager.startPersistence(key.(string)) //nolint:forcetypeassert
+
+			return true
+		})
+	}
+
+	return nil
+}
+
+// DisablePersistence implements runtime.LogPersistence interface.
+func (manager *PersistentLoggingManager) DisablePersistence() error {
+	manager.mu.Lock()
+	defer manager.mu.Unlock()
+
+	if manager.cfg == nil {
+		return nil
+	}
+
+	manager.cfg = nil
+	manager.bootDir = ""
+	manager.enabled = make(chan struct{})
+
+	return manager.closeSegments()
+}
+
+func (manager *PersistentLoggingManager) closeSegments() error {
+	var errs []string
+
+	for id, w := range manager.segments {
+		if err := w.Close(); err != nil {
+			errs = append(errs, fmt.Sprintf("%s: %s", id, err))
+		}
+
+		delete(manager.segments, id)
+	}
+
+	if len(errs) > 0 {
+		return fmt.Errorf("error closing log segments: %s", strings.Join(errs, "; "))
+	}
+
+	return nil
+}
+
+// waitEnabled blocks until persistence is enabled.
+func (manager *PersistentLoggingManager) waitEnabled() {
+	manager.mu.Lock()
+	enabled := manager.enabled
+	manager.mu.Unlock()
+
+	<-enabled
+}
+
+// persist writes a log line to the current segment of the service, dropping it if persistence is disabled.
+func (manager *PersistentLoggingManager) persist(id string, line []byte) {
+	manager.mu.Lock()
+	defer manager.mu.Unlock()
+
+	if manager.cfg == nil {
+		return
+	}
+
+	w, ok := manager.segments[id]
+	if !ok {
+		var err error
+
+		w, err = openSegmentWriter(manager.bootDir, id, manager.cfg.SegmentSize, manager.cfg.MaxSegments)
+		if err != nil {
+			manager.fallbackLogger.Printf("error opening persistent log for %q: %s", id, err)
+
+			return
+		}
+
+		manager.segments[id] = w
+	}
+
+	if err := w.Write(line); err != nil {
+		manager.fallbackLogger.Printf("error writing persistent log for %q: %s", id, err)
+
+		// the segment will be reopened on the next write
+		w.Close() //nolint:errcheck
+
+		delete(manager.segments, id)
+	}
+}
+
+// startPersistence starts copying the service log to disk, unless it is already running.
+func (manager *PersistentLoggingManager) startPersistence(id string) {
+	if _, running := manager.persisters.LoadOrStore(id, struct{}{}); running {
+		return
+	}
+
+	go func() {
+		if err := manager.runPersistence(id); err != nil {
+			manager.fallbackLogger.Printf("log persistence stopped: %s", err)
+		}
+	}()
+}
+
+// runPersistence copies the service log from the circular buffer to disk.
+func (manager *PersistentLoggingManager) runPersistence(id string) error {
+	manager.waitEnabled()
+
+	r, err := manager.CircularBufferLoggingManager.ServiceLog(id).Reader(runtime.WithFollow())
+	if err != nil {
+		return err
+	}
+
+	defer r.Close() //nolint:errcheck
+
+	br := bufio.NewReader(r)
+
+	for {
+		line, err := br.ReadBytes('\n')
+		if len(line) > 0 {
+			manager.persist(id, line)
+		}
+
+		if err != nil {
+			return fmt.Errorf("reader: %w", err)
+		}
+	}
+}
+
+// previousBootDir returns the log directory of one of the previous boots.
+func (manager *PersistentLoggingManager) previousBootDir(boot int) (string, error) {
+	manager.mu.Lock()
+	defer manager.mu.Unlock()
+
+	if manager.cfg == nil {
+		return "", fmt.Errorf("logs of the previous boots are not available: persistent logs are not enabled")
+	}
+
+	boots, err := listBoots(manager.cfg.Path)
+	if err != nil {
+		return "", err
+	}
+
+	current := -1
+
+	for i, dir := range boots {
+		if filepath.Join(manager.cfg.Path, dir) == manager.bootDir {
+			current = i
+		}
+	}
+
+	if current < 0 {
+		return "", fmt.Errorf("current boot directory %q is missing", manager.bootDir)
+	}
+
+	if current+boot < 0 {
+		return "", fmt.Errorf("logs for boot %d are not available", boot)
+	}
+
+	return filepath.Join(manager.cfg.Path, boots[current+boot]), nil
+}
+
+type persistentHandler struct {
+	runtime.LogHandler
+
+	manager *PersistentLoggingManager
+	id      string
+}
+
+// Writer implements runtime.LogHandler interface.
+func (handler *persistentHandler) Writer() (io.WriteCloser, error) {
+	w, err := handler.LogHandler.Writer()
+	if err != nil {
+		return nil, err
+	}
+
+	handler.manager.startPersistence(handler.id)
+
+	return w, nil
+}
+
+// Reader implements runtime.LogHandler interface.
+func (handler *persistentHandler) Reader(opts ...runtime.LogOption) (io.ReadCloser, error) {
+	var opt runtime.LogOptions
+
+	for _, o := range opts {
+		if err := o(&opt); err != nil {
+			return nil, err
+		}
+	}
+
+	if opt.Boot == 0 {
+		return handler.LogHandler.Reader(opts...)
+	}
+
+	if opt.Follow {
+		return nil, fmt.Errorf("following logs of the previous boots is not supported")
+	}
+
+	if err := validateServiceID(handler.id); err != nil {
+		return nil, err
+	}
+
+	dir, err := handler.manager.previousBootDir(opt.Boot)
+	if err != nil {
+		return nil, err
+	}
+
+	data, err := readSegments(dir, handler.id)
+	if err != nil {
+		return nil, err
+	}
+
+	r := bytes.NewReader(data)
+
+	if opt.TailLines != nil {
+		if err = tail.SeekLines(r, *opt.TailLines); err != nil {
+			return nil, fmt.Errorf("error tailing log: %w", err)
+		}
+	}
+
+	return io.NopCloser(r), nil
+}
@@ -0,0 +1,388 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package logging
+
+import (
+	"bytes"
+	"compress/gzip"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// Persistent logs are stored in the following layout:
+//
+//	<path>/<boot>/boot_id              - kernel boot ID of the boot
+//	<path>/<boot>/<service>.log        - current (uncompressed) segment
+//	<path>/<boot>/<service>.<n>.log.gz - rotated compressed segments, the higher n, the newer the segment
+//
+// Boot directories are named with a zero-padded sequence number which increases with every boot.
+const (
+	bootIDFile           = "boot_id"
+	currentSegmentSuffix = ".log"
+	rotatedSegmentSuffix = ".log.gz"
+)
+
+// bootIDPath is the kernel interface to read the current boot ID.
+var bootIDPath = "/proc/sys/kernel/random/boot_id"
+
+func validateServiceID(id string) error {
+	if id == "" || id == "." || id == ".." || strings.ContainsRune(id, os.PathSeparator) {
+		return fmt.Errorf("service ID is invalid")
+	}
+
+	return nil
+}
+
+// listBoots returns boot directory names sorted from the oldest to the newest.
+func listBoots(path string) ([]string, error) {
+	entries, err := os.ReadDir(path)
+	if err != nil {
+		return nil, err
+	}
+
+	var boots []string
+
+	for _, entry := range entries {
+		if !entry.IsDir() {
+			continue
+		}
+
+		if _, err := strconv.ParseUint(entry.Name(), 10, 64); err != nil {
+			continue
+		}
+
+		boots = append(boots, entry.Name())
+	}
+
+	// names are zero-padded, but the sequence might outgrow the padding
+	sort.Slice(boots, func(i, j int) bool {
+		if len(boots[i]) != len(boots[j]) {
+			return len(boots[i]) < len(boots[j])
+		}
+
+		return boots[i] < boots[j]
+	})
+
+	return boots, nil
+}
+
+// prepareBootDir returns the directory for the current boot logs, creating it if needed,
+// and removes the directories of the boots beyond maxBoots.
+func prepareBootDir(path string, maxBoots int) (string, error) {
+	bootID, err := os.ReadFile(bootIDPath)
+	if err != nil {
+		return "", fmt.Errorf("error reading boot ID: %w", err)
+	}
+
+	bootID = bytes.TrimSpace(bootID)
+
+	if err = os.MkdirAll(path, 0o700); err != nil {
+		return "", err
+	}
+
+	boots, err := listBoots(path)
+	if err != nil {
+		return "", err
+	}
+
+	var current string
+
+	if len(boots) > 0 {
+		last := boots[len(boots)-1]
+
+		lastBootID, err := os.ReadFile(filepath.Join(path, last, bootIDFile))
+		if err != nil && !errors.Is(err, fs.ErrNotExist) {
+			return "", err
+		}
+
+		if bytes.Equal(bytes.TrimSpace(lastBootID), bootID) {
+			current = last
+		}
+	}
+
+	if current == "" {
+		var seq uint64
+
+		if len(boots) > 0 {
+			seq, err = strconv.ParseUint(boots[len(boots)-1], 10, 64)
+			if err != nil {
+				return "", err
+			}
+
+			seq++
+		}
+
+		current = fmt.Sprintf("%08d", seq)
+
+		if err = os.Mkdir(filepath.Join(path, current), 0o700); err != nil {
+			return "", err
+		}
+
+		if err = os.WriteFile(filepath.Join(path, current, bootIDFile), append(bootID, '\n'), 0o600); err != nil {
+			return "", err
+		}
+
+		boots = append(boots, current)
+	}
+
+	if maxBoots > 0 && len(boots) > maxBoots {
+		for _, boot := range boots[:len(boots)-maxBoots] {
+			if err = os.RemoveAll(filepath.Join(path, boot)); err != nil {
+				return "", err
+			}
+		}
+	}
+
+	return filepath.Join(path, current), nil
+}
+
+// listSegments returns the sequence numbers of the rotated segments of the service sorted from the oldest to the newest.
+func listSegments(dir, id string) ([]uint64, error) {
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		return nil, err
+	}
+
+	var segments []uint64
+
+	for _, entry := range entries {
+		name := entry.Name()
+
+		if !strings.HasPrefix(name, id+".") || !strings.HasSuffix(name, rotatedSegmentSuffix) {
+			continue
+		}
+
+		seq, err := strconv.ParseUint(strings.TrimSuffix(strings.TrimPrefix(name, id+"."), rotatedSegmentSuffix), 10, 64)
+		if err != nil {
+			continue
+		}
+
+		segments = append(segments, seq)
+	}
+
+	sort.Slice(segments, func(i, j int) bool { return segments[i] < segments[j] })
+
+	return segments, nil
+}
+
+func rotatedSegmentPath(dir, id string, seq uint64) string {
+	return filepath.Join(dir, fmt.Sprintf("%s.%d%s", id, seq, rotatedSegmentSuffix))
+}
+
+// readSegments reads and decompresses all segments of the service log in the boot directory.
+func readSegments(dir, id string) ([]byte, error) {
+	segments, err := listSegments(dir, id)
+	if err != nil {
+		return nil, err
+	}
+
+	var buf bytes.Buffer
+
+	for _, seq := range segments {
+		if err = readCompressed(&buf, rotatedSegmentPath(dir, id, seq)); err != nil {
+			return nil, err
+		}
+	}
+
+	current, err := os.ReadFile(filepath.Join(dir, id+currentSegmentSuffix))
+	if err != nil && !errors.Is(err, fs.ErrNotExist) {
+		return nil, err
+	}
+
+	if len(segments) == 0 && current == nil {
+		return nil, fmt.Errorf("log %q was not found in %q", id, dir)
+	}
+
+	buf.Write(current)
+
+	return buf.Bytes(), nil
+}
+
+func readCompressed(w io.Writer, path string) error {
+	f, err := os.Open(path)
+	if err != nil {
+		return err
+	}
+
+	defer f.Close() //nolint:errcheck
+
+	zr, err := gzip.NewReader(f)
+	if err != nil {
+		return fmt.Errorf("error reading %q: %w", path, err)
+	}
+
+	if _, err = io.Copy(w, zr); err != nil {
+		return fmt.Errorf("error reading %q: %w", path, err)
+	}
+
+	return zr.Close()
+}
+
+// segmentWriter appends to the current segment of the service log, and rotates it
+// once it reaches the segment size.
+type segmentWriter struct {
+	dir         string
+	id          string
+	segmentSize int64
+	maxSegments int
+
+	f    *os.File
+	size int64
+}
+
+func openSegmentWriter(dir, id string, segmentSize int64, maxSegments int) (*segmentWriter, error) {
+	if err := validateServiceID(id); err != nil {
+		return nil, err
+	}
+
+	w := &segmentWriter{
+		dir:         dir,
+		id:          id,
+		segmentSize: segmentSize,
+		maxSegments: maxSegments,
+	}
+
+	if err := w.open(); err != nil {
+		return nil, err
+	}
+
+	return w, nil
+}
+
+func (w *segmentWriter) currentPath() string {
+	return filepath.Join(w.dir, w.id+currentSegmentSuffix)
+}
+
+func (w *segmentWriter) open() error {
+	f, err := os.OpenFile(w.currentPath(), os.O_CREATE|os.O_APPEND|os.O_WRONLY, 0o600)
+	if err != nil {
+		return err
+	}
+
+	st, err := f.Stat()
+	if err != nil {
+		f.Close() //nolint:errcheck
+
+		return err
+	}
+
+	w.f = f
+	w.size = st.Size()
+
+	return nil
+}
+
+// Write appends p to the current segment.
+func (w *segmentWriter) Write(p []byte) error {
+	n, err := w.f.Write(p)
+	w.size += int64(n)
+
+	if err != nil {
+		return err
+	}
+
+	if w.segmentSize > 0 && w.size >= w.segmentSize {
+		return w.rotate()
+	}
+
+	return nil
+}
+
+// rotate compresses the current segment and starts a new one.
+func (w *segmentWriter) rotate() error {
+	if err := w.f.Close(); err != nil {
+		return err
+	}
+
+	w.f = nil
+
+	segments, err := listSegments(w.dir, w.id)
+	if err != nil {
+		return err
+	}
+
+	var seq uint64
+
+	if len(segments) > 0 {
+		seq = segments[len(segments)-1] + 1
+	}
+
+	if err = compressFile(w.currentPath(), rotatedSegmentPath(w.dir, w.id, seq)); err != nil {
+		return err
+	}
+
+	if err = os.Remove(w.currentPath()); err != nil {
+		return err
+	}
+
+	segments = append(segments, seq)
+
+	if w.maxSegments > 0 && len(segments) > w.maxSegments {
+		for _, old := range segments[:len(segments)-w.maxSegments] {
+			if err = os.Remove(rotatedSegmentPath(w.dir, w.id, old)); err != nil {
+				return err
+			}
+		}
+	}
+
+	return w.open()
+}
+
+// Close closes the current segment.
+func (w *segmentWriter) Close() error {
+	if w.f == nil {
+		return nil
+	}
+
+	err := w.f.Close()
+	w.f = nil
+
+	return err
+}
+
+func compressFile(src, dst string) error {
+	in, err := os.Open(src)
+	if err != nil {
+		return err
+	}
+
+	defer in.Close() //nolint:errcheck
+
+	tmp := dst + ".part"
+
+	out, err := os.OpenFile(tmp, os.O_CREATE|os.O_TRUNC|os.O_WRONLY, 0o600)
+	if err != nil {
+		return err
+	}
+
+	defer os.Remove(tmp) //nolint:errcheck
+
+	zw := gzip.NewWriter(out)
+
+	if _, err = io.Copy(zw, in); err != nil {
+		out.Close() //nolint:errcheck
+
+		return err
+	}
+
+	if err = zw.Close(); err != nil {
+		out.Close() //nolint:errcheck
+
+		return err
+	}
+
+	if err = out.Close(); err != nil {
+		return err
+	}
+
+	return os.Rename(tmp, dst)
+}
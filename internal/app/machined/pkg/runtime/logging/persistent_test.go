@@ -0,0 +1,142 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package logging //nolint:testpackage
+
+import (
+	"fmt"
+	"io"
+	"log"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/siderolabs/go-retry/retry"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/siderolabs/talos/internal/app/machined/pkg/runtime"
+)
+
+func TestSegmentWriter(t *testing.T) {
+	t.Parallel()
+
+	dir := t.TempDir()
+
+	w, err := openSegmentWriter(dir, "kubelet", 100, 2)
+	require.NoError(t, err)
+
+	var lines []string
+
+	for i := 0; i < 20; i++ {
+		line := fmt.Sprintf("line %02d: %s\n", i, strings.Repeat("x", 20))
+		lines = append(lines, line)
+
+		require.NoError(t, w.Write([]byte(line)))
+	}
+
+	require.NoError(t, w.Close())
+
+	segments, err := listSegments(dir, "kubelet")
+	require.NoError(t, err)
+
+	// every segment holds 4 lines, 5 segments were rotated, only the last 2 are kept
+	assert.Equal(t, []uint64{3, 4}, segments)
+
+	data, err := readSegments(dir, "kubelet")
+	require.NoError(t, err)
+
+	assert.Equal(t, strings.Join(lines[12:], ""), string(data))
+
+	_, err = readSegments(dir, "etcd")
+	assert.Error(t, err)
+}
+
+func TestPersistentLoggingManager(t *testing.T) { //nolint:tparallel
+	dir := t.TempDir()
+
+	setBootID := func(id string) {
+		require.NoError(t, os.WriteFile(filepath.Join(dir, "boot_id"), []byte(id+"\n"), 0o600))
+	}
+
+	prevBootIDPath := bootIDPath
+	bootIDPath = filepath.Join(dir, "boot_id")
+
+	t.Cleanup(func() { bootIDPath = prevBootIDPath })
+
+	logsPath := filepath.Join(dir, "logs")
+	cfg := runtime.LogPersistenceConfig{
+		Path:        logsPath,
+		MaxBoots:    2,
+		MaxSegments: 10,
+		SegmentSize: 1024 * 1024,
+	}
+
+	for i, bootID := range []string{"boot-a", "boot-b", "boot-c"} {
+		setBootID(bootID)
+
+		circular := NewCircularBufferLoggingManager(log.New(io.Discard, "", 0))
+
+		// the service is started before the persistent manager is created
+		w, err := circular.ServiceLog("machined").Writer()
+		require.NoError(t, err)
+
+		_, err = fmt.Fprintf(w, "early log of %s\n", bootID)
+		require.NoError(t, err)
+
+		manager := NewPersistentLoggingManager(circular)
+
+		require.NoError(t, manager.EnablePersistence(cfg))
+
+		// enabling persistence again with the same config is a no-op
+		require.NoError(t, manager.EnablePersistence(cfg))
+
+		_, err = fmt.Fprintf(w, "log of %s\n", bootID)
+		require.NoError(t, err)
+
+		current := filepath.Join(logsPath, fmt.Sprintf("%08d", i), "machined.log")
+
+		require.NoError(t, retry.Constant(5*time.Second, retry.WithUnits(10*time.Millisecond)).Retry(func() error {
+			data, err := os.ReadFile(current)
+			if err != nil {
+				return retry.ExpectedError(err)
+			}
+
+			if string(data) != fmt.Sprintf("early log of %s\nlog of %s\n", bootID, bootID) {
+				return retry.ExpectedErrorf("unexpected log contents: %q", string(data))
+			}
+
+			return nil
+		}))
+
+		if i > 0 {
+			r, err := manager.ServiceLog("machined").Reader(runtime.WithBoot(-1), runtime.WithTailLines(1))
+			require.NoError(t, err)
+
+			data, err := io.ReadAll(r)
+			require.NoError(t, err)
+
+			assert.Equal(t, fmt.Sprintf("log of boot-%c\n", 'a'+i-1), string(data))
+
+			_, err = manager.ServiceLog("machined").Reader(runtime.WithBoot(-1), runtime.WithFollow())
+			assert.Error(t, err)
+		}
+
+		// only the current and the previous boot are kept
+		_, err = manager.ServiceLog("machined").Reader(runtime.WithBoot(-2))
+		assert.Error(t, err)
+
+		require.NoError(t, manager.DisablePersistence())
+
+		_, err = manager.ServiceLog("machined").Reader(runtime.WithBoot(-1))
+		assert.Error(t, err)
+	}
+
+	boots, err := listBoots(logsPath)
+	require.NoError(t, err)
+
+	assert.Equal(t, []string{"00000001", "00000002"}, boots)
+}
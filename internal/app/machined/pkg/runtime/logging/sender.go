@@ -0,0 +1,162 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package logging
+
+import (
+	"context"
+	"crypto/tls"
+	"fmt"
+	"net"
+	"net/url"
+
+	"github.com/siderolabs/talos/internal/app/machined/pkg/runtime"
+)
+
+// SenderOption configures network log senders.
+type SenderOption func(*netSender)
+
+// WithTLSConfig enables TLS for TCP endpoints.
+//
+// The option is ignored for UDP endpoints.
+func WithTLSConfig(cfg *tls.Config) SenderOption {
+	return func(s *netSender) {
+		s.tlsConfig = cfg
+	}
+}
+
+// netSender sends encoded log events over TCP or UDP.
+//
+// The connection is established lazily, and re-established on the next Send after a failure.
+type netSender struct {
+	endpoint  *url.URL
+	tlsConfig *tls.Config
+
+	// encode returns the data to be written for the event, each element is written with a separate Write call
+	// (for UDP, it means a separate datagram).
+	encode func(e *runtime.LogEvent) ([][]byte, error)
+
+	sema chan struct{}
+	conn net.Conn
+}
+
+func newNetSender(endpoint *url.URL, encode func(e *runtime.LogEvent) ([][]byte, error), opts ...SenderOption) *netSender {
+	sema := make(chan struct{}, 1)
+	sema <- struct{}{}
+
+	s := &netSender{
+		endpoint: endpoint,
+		encode:   encode,
+		sema:     sema,
+	}
+
+	for _, opt := range opts {
+		opt(s)
+	}
+
+	return s
+}
+
+func (s *netSender) tryLock(ctx context.Context) (unlock func()) {
+	select {
+	case <-s.sema:
+		unlock = func() { s.sema <- struct{}{} }
+	case <-ctx.Done():
+		unlock = nil
+	}
+
+	return
+}
+
+func (s *netSender) dial(ctx context.Context) (net.Conn, error) {
+	if s.tlsConfig != nil && s.endpoint.Scheme == "tcp" {
+		dialer := &tls.Dialer{
+			Config: s.tlsConfig,
+		}
+
+		return dialer.DialContext(ctx, s.endpoint.Scheme, s.endpoint.Host)
+	}
+
+	return new(net.Dialer).DialContext(ctx, s.endpoint.Scheme, s.endpoint.Host)
+}
+
+// Send implements LogSender interface.
+func (s *netSender) Send(ctx context.Context, e *runtime.LogEvent) error {
+	packets, err := s.encode(e)
+	if err != nil {
+		return fmt.Errorf("%w: %s", runtime.ErrDontRetry, err)
+	}
+
+	unlock := s.tryLock(ctx)
+	if unlock == nil {
+		return ctx.Err()
+	}
+
+	defer unlock()
+
+	// Connect (or "connect" for UDP) if no connection is established already.
+	if s.conn == nil {
+		conn, err := s.dial(ctx)
+		if err != nil {
+			return err
+		}
+
+		s.conn = conn
+	}
+
+	d, _ := ctx.Deadline()
+	s.conn.SetWriteDeadline(d) //nolint:errcheck
+
+	sent := 0
+
+	for _, b := range packets {
+		n, err := s.conn.Write(b)
+		sent += n
+
+		// Close connection on send error.
+		if err != nil {
+			s.conn.Close() //nolint:errcheck
+			s.conn = nil
+
+			// skip partially sent events to avoid partial duplicates in the receiver
+			if sent > 0 {
+				err = fmt.Errorf("%w: %s", runtime.ErrDontRetry, err)
+			}
+
+			return err
+		}
+	}
+
+	return nil
+}
+
+// Close implements LogSender interface.
+func (s *netSender) Close(ctx context.Context) error {
+	unlock := s.tryLock(ctx)
+	if unlock == nil {
+		return ctx.Err()
+	}
+
+	defer unlock()
+
+	if s.conn == nil {
+		return nil
+	}
+
+	conn := s.conn
+	s.conn = nil
+
+	closed := make(chan error, 1)
+
+	go func() {
+		closed <- conn.Close()
+	}()
+
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case err := <-closed:
+		return err
+	}
+}
@@ -0,0 +1,151 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package logging
+
+import (
+	"crypto/rand"
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"os"
+	"strings"
+	"unicode"
+
+	"github.com/siderolabs/talos/internal/app/machined/pkg/runtime"
+)
+
+const (
+	// gelfChunkSize is the maximum size of the UDP datagram payload.
+	gelfChunkSize = 1420
+
+	// gelfChunkHeaderSize is the size of the chunk header: magic bytes, message ID, sequence number and count.
+	gelfChunkHeaderSize = 2 + 8 + 1 + 1
+
+	// gelfMaxChunks is the maximum number of chunks per message.
+	gelfMaxChunks = 128
+)
+
+// NewGELF returns log sender that sends logs in GELF 1.1 format over TCP (null byte delimited)
+// or UDP (one message per packet, chunked if the message doesn't fit into a single packet).
+//
+// Service name and log level are sent as additional fields, log level is also mapped to the syslog severity.
+func NewGELF(endpoint *url.URL, opts ...SenderOption) runtime.LogSender {
+	return newNetSender(endpoint, func(e *runtime.LogEvent) ([][]byte, error) {
+		hostname, _ := os.Hostname() //nolint:errcheck
+
+		b, err := marshalGELF(e, hostname)
+		if err != nil {
+			return nil, err
+		}
+
+		if endpoint.Scheme == "tcp" {
+			return [][]byte{append(b, 0)}, nil
+		}
+
+		return chunkGELF(b)
+	}, opts...)
+}
+
+func marshalGELF(e *runtime.LogEvent, hostname string) ([]byte, error) {
+	m := make(map[string]interface{}, len(e.Fields)+7)
+
+	for k, v := range e.Fields {
+		m[gelfFieldName(k)] = gelfFieldValue(v)
+	}
+
+	if hostname == "" {
+		hostname = "talos"
+	}
+
+	shortMessage := e.Msg
+	if shortMessage == "" {
+		shortMessage = "-"
+	}
+
+	m["version"] = "1.1"
+	m["host"] = hostname
+	m["short_message"] = shortMessage
+	m["timestamp"] = float64(e.Time.UnixMilli()) / 1000
+	m["level"] = syslogSeverity(e.Level)
+	m["_talos-level"] = e.Level.String()
+
+	return json.Marshal(m)
+}
+
+// chunkGELF splits the message into GELF chunks if it doesn't fit into a single UDP packet.
+func chunkGELF(b []byte) ([][]byte, error) {
+	if len(b) <= gelfChunkSize {
+		return [][]byte{b}, nil
+	}
+
+	payloadSize := gelfChunkSize - gelfChunkHeaderSize
+	count := (len(b) + payloadSize - 1) / payloadSize
+
+	if count > gelfMaxChunks {
+		return nil, fmt.Errorf("message is too large: %d bytes", len(b))
+	}
+
+	var messageID [8]byte
+
+	if _, err := rand.Read(messageID[:]); err != nil {
+		return nil, err
+	}
+
+	chunks := make([][]byte, 0, count)
+
+	for seq := 0; seq < count; seq++ {
+		payload := b[seq*payloadSize:]
+		if len(payload) > payloadSize {
+			payload = payload[:payloadSize]
+		}
+
+		chunk := make([]byte, 0, gelfChunkHeaderSize+len(payload))
+		chunk = append(chunk, 0x1e, 0x0f)
+		chunk = append(chunk, messageID[:]...)
+		chunk = append(chunk, byte(seq), byte(count))
+		chunk = append(chunk, payload...)
+
+		chunks = append(chunks, chunk)
+	}
+
+	return chunks, nil
+}
+
+// gelfFieldName converts field name to the GELF additional field name.
+//
+// Additional field names are prefixed with an underscore, and may contain only letters, numbers, underscores,
+// dashes and dots; "_id" is reserved.
+func gelfFieldName(k string) string {
+	k = "_" + strings.Map(func(r rune) rune {
+		if r > unicode.MaxASCII || !(unicode.IsLetter(r) || unicode.IsDigit(r) || r == '_' || r == '-' || r == '.') {
+			return '_'
+		}
+
+		return r
+	}, k)
+
+	if k == "_id" {
+		k = "__id"
+	}
+
+	return k
+}
+
+// gelfFieldValue converts field value to the GELF additional field value: only strings and numbers are allowed.
+func gelfFieldValue(v interface{}) interface{} {
+	switch v := v.(type) {
+	case string, float64, float32, int, int8, int16, int32, int64, uint, uint8, uint16, uint32, uint64:
+		return v
+	case nil:
+		return ""
+	default:
+		b, err := json.Marshal(v)
+		if err != nil {
+			return fmt.Sprint(v)
+		}
+
+		return string(b)
+	}
+}
@@ -0,0 +1,86 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package logging //nolint:testpackage
+
+import (
+	"bytes"
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap/zapcore"
+
+	"github.com/siderolabs/talos/internal/app/machined/pkg/runtime"
+)
+
+func TestMarshalGELF(t *testing.T) {
+	t.Parallel()
+
+	b, err := marshalGELF(&runtime.LogEvent{
+		Msg:   "reconfigured wireguard link",
+		Time:  time.Date(2023, 5, 17, 12, 42, 37, 123456789, time.UTC),
+		Level: zapcore.ErrorLevel,
+		Fields: map[string]interface{}{
+			"talos-service": "machined",
+			"id":            "kubespan",
+			"peers":         4.0,
+			"up":            true,
+			"some field":    []interface{}{"a", "b"},
+		},
+	}, "node-1")
+	require.NoError(t, err)
+
+	var m map[string]interface{}
+
+	require.NoError(t, json.Unmarshal(b, &m))
+
+	assert.Equal(t, map[string]interface{}{
+		"version":        "1.1",
+		"host":           "node-1",
+		"short_message":  "reconfigured wireguard link",
+		"timestamp":      1684327357.123,
+		"level":          3.0,
+		"_talos-level":   "error",
+		"_talos-service": "machined",
+		"__id":           "kubespan",
+		"_peers":         4.0,
+		"_up":            "true",
+		"_some_field":    `["a","b"]`,
+	}, m)
+}
+
+func TestChunkGELF(t *testing.T) {
+	t.Parallel()
+
+	small := []byte(`{"short_message":"hello"}`)
+
+	chunks, err := chunkGELF(small)
+	require.NoError(t, err)
+	assert.Equal(t, [][]byte{small}, chunks)
+
+	large := bytes.Repeat([]byte("x"), 3*gelfChunkSize)
+
+	chunks, err = chunkGELF(large)
+	require.NoError(t, err)
+	require.Len(t, chunks, 4)
+
+	var reassembled []byte
+
+	for i, chunk := range chunks {
+		assert.LessOrEqual(t, len(chunk), gelfChunkSize)
+		assert.Equal(t, []byte{0x1e, 0x0f}, chunk[:2])
+		assert.Equal(t, chunks[0][2:10], chunk[2:10], "message ID should be the same for all chunks")
+		assert.Equal(t, []byte{byte(i), byte(len(chunks))}, chunk[10:12])
+
+		reassembled = append(reassembled, chunk[gelfChunkHeaderSize:]...)
+	}
+
+	assert.Equal(t, large, reassembled)
+
+	_, err = chunkGELF(bytes.Repeat([]byte("x"), gelfMaxChunks*gelfChunkSize))
+	assert.Error(t, err)
+}
@@ -0,0 +1,172 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package logging
+
+import (
+	"fmt"
+	"net/url"
+	"os"
+	"sort"
+	"strconv"
+	"strings"
+
+	"go.uber.org/zap/zapcore"
+
+	"github.com/siderolabs/talos/internal/app/machined/pkg/runtime"
+)
+
+const (
+	// syslogFacilityDaemon is the facility used for all messages (system daemons).
+	syslogFacilityDaemon = 3
+
+	// syslogSDID is the structured data element ID, 32473 is the IANA private enterprise number reserved for documentation.
+	syslogSDID = "talos@32473"
+
+	// syslogTimestampFormat is RFC 3339 with at most microsecond precision as required by RFC 5424.
+	syslogTimestampFormat = "2006-01-02T15:04:05.000000Z07:00"
+
+	syslogNilValue = "-"
+)
+
+// NewSyslog returns log sender that sends logs in RFC 5424 syslog format over TCP (octet-counting framing, RFC 6587)
+// or UDP (one message per packet, RFC 5426).
+//
+// Service name is sent as APP-NAME, log level is mapped to the severity, and remaining fields are sent as
+// structured data.
+func NewSyslog(endpoint *url.URL, opts ...SenderOption) runtime.LogSender {
+	return newNetSender(endpoint, func(e *runtime.LogEvent) ([][]byte, error) {
+		hostname, _ := os.Hostname() //nolint:errcheck
+
+		b := marshalSyslog(e, hostname)
+
+		if endpoint.Scheme == "tcp" {
+			b = append([]byte(strconv.Itoa(len(b))+" "), b...)
+		}
+
+		return [][]byte{b}, nil
+	}, opts...)
+}
+
+// syslogSeverity maps log level to syslog severity (as defined in RFC 5424).
+func syslogSeverity(level zapcore.Level) int {
+	switch level {
+	case zapcore.DebugLevel:
+		return 7
+	case zapcore.InfoLevel:
+		return 6
+	case zapcore.WarnLevel:
+		return 4
+	case zapcore.ErrorLevel:
+		return 3
+	case zapcore.DPanicLevel:
+		return 2
+	case zapcore.PanicLevel:
+		return 1
+	case zapcore.FatalLevel:
+		return 0
+	default:
+		return 5
+	}
+}
+
+func marshalSyslog(e *runtime.LogEvent, hostname string) []byte {
+	var sb strings.Builder
+
+	fmt.Fprintf(&sb, "<%d>1 ", syslogFacilityDaemon*8+syslogSeverity(e.Level))
+
+	if e.Time.IsZero() {
+		sb.WriteString(syslogNilValue)
+	} else {
+		sb.WriteString(e.Time.UTC().Format(syslogTimestampFormat))
+	}
+
+	sb.WriteByte(' ')
+	sb.WriteString(syslogHeaderValue(hostname, 255))
+	sb.WriteByte(' ')
+
+	appName := "talos"
+	if service, ok := e.Fields["talos-service"].(string); ok {
+		appName = service
+	}
+
+	sb.WriteString(syslogHeaderValue(appName, 48))
+
+	// PROCID and MSGID
+	sb.WriteString(" - - ")
+
+	keys := make([]string, 0, len(e.Fields))
+
+	for k := range e.Fields {
+		if k == "talos-service" {
+			continue
+		}
+
+		keys = append(keys, k)
+	}
+
+	if len(keys) == 0 {
+		sb.WriteString(syslogNilValue)
+	} else {
+		sort.Strings(keys)
+
+		sb.WriteString("[" + syslogSDID)
+
+		for _, k := range keys {
+			fmt.Fprintf(&sb, " %s=\"%s\"", syslogParamName(k), syslogParamValue(e.Fields[k]))
+		}
+
+		sb.WriteByte(']')
+	}
+
+	if e.Msg != "" {
+		sb.WriteByte(' ')
+		sb.WriteString(e.Msg)
+	}
+
+	return []byte(sb.String())
+}
+
+// syslogHeaderValue sanitizes header field value: only printable US-ASCII characters are allowed.
+func syslogHeaderValue(s string, maxLen int) string {
+	s = strings.Map(func(r rune) rune {
+		if r < 33 || r > 126 {
+			return '_'
+		}
+
+		return r
+	}, s)
+
+	if s == "" {
+		return syslogNilValue
+	}
+
+	if len(s) > maxLen {
+		s = s[:maxLen]
+	}
+
+	return s
+}
+
+// syslogParamName sanitizes structured data parameter name.
+func syslogParamName(s string) string {
+	s = strings.Map(func(r rune) rune {
+		if r < 33 || r > 126 || r == '=' || r == ']' || r == '"' {
+			return '_'
+		}
+
+		return r
+	}, s)
+
+	if len(s) > 32 {
+		s = s[:32]
+	}
+
+	return s
+}
+
+// syslogParamValue formats structured data parameter value escaping '"', '\' and ']'.
+func syslogParamValue(v interface{}) string {
+	return strings.NewReplacer(`\`, `\\`, `"`, `\"`, `]`, `\]`).Replace(fmt.Sprint(v))
+}
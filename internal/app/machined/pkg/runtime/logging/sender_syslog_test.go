@@ -0,0 +1,138 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package logging //nolint:testpackage
+
+import (
+	"bufio"
+	"context"
+	"fmt"
+	"io"
+	"net"
+	"net/url"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap/zapcore"
+
+	"github.com/siderolabs/talos/internal/app/machined/pkg/runtime"
+)
+
+func TestMarshalSyslog(t *testing.T) {
+	t.Parallel()
+
+	ts := time.Date(2023, 5, 17, 12, 42, 37, 123456789, time.UTC)
+
+	for name, tc := range map[string]struct {
+		e        *runtime.LogEvent
+		expected string
+	}{
+		"service": {
+			e: &runtime.LogEvent{
+				Msg:   "reconfigured wireguard link",
+				Time:  ts,
+				Level: zapcore.WarnLevel,
+				Fields: map[string]interface{}{
+					"talos-service": "machined",
+					"link":          "kubespan",
+					"peers":         4.0,
+				},
+			},
+			expected: `<28>1 2023-05-17T12:42:37.123456Z node-1 machined - - [talos@32473 link="kubespan" peers="4"] reconfigured wireguard link`,
+		},
+		"no fields": {
+			e: &runtime.LogEvent{
+				Msg:   "hello",
+				Time:  ts,
+				Level: zapcore.ErrorLevel,
+			},
+			expected: `<27>1 2023-05-17T12:42:37.123456Z node-1 talos - - - hello`,
+		},
+		"escaping": {
+			e: &runtime.LogEvent{
+				Time:  ts,
+				Level: zapcore.DebugLevel,
+				Fields: map[string]interface{}{
+					"talos-service": "my service",
+					"a=b":           `"quoted" [value]\`,
+				},
+			},
+			expected: `<31>1 2023-05-17T12:42:37.123456Z node-1 my_service - - [talos@32473 a_b="\"quoted\" [value\]\\"]`,
+		},
+	} {
+		tc := tc
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			assert.Equal(t, tc.expected, string(marshalSyslog(tc.e, "node-1")))
+		})
+	}
+}
+
+func TestSyslogSenderTCP(t *testing.T) {
+	t.Parallel()
+
+	l, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+
+	t.Cleanup(func() { l.Close() }) //nolint:errcheck
+
+	received := make(chan string, 2)
+
+	go func() {
+		conn, err := l.Accept()
+		if err != nil {
+			return
+		}
+
+		defer conn.Close() //nolint:errcheck
+
+		r := bufio.NewReader(conn)
+
+		for {
+			var n int
+
+			if _, err := fmt.Fscanf(r, "%d ", &n); err != nil {
+				return
+			}
+
+			buf := make([]byte, n)
+
+			if _, err := io.ReadFull(r, buf); err != nil {
+				return
+			}
+
+			received <- string(buf)
+		}
+	}()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	t.Cleanup(cancel)
+
+	sender := NewSyslog(&url.URL{Scheme: "tcp", Host: l.Addr().String()})
+
+	t.Cleanup(func() { sender.Close(ctx) }) //nolint:errcheck
+
+	for _, msg := range []string{"first\nline", "second"} {
+		require.NoError(t, sender.Send(ctx, &runtime.LogEvent{
+			Msg:   msg,
+			Time:  time.Now(),
+			Level: zapcore.InfoLevel,
+		}))
+	}
+
+	for _, msg := range []string{"first\nline", "second"} {
+		select {
+		case <-ctx.Done():
+			t.Fatal("timeout waiting for the message")
+		case m := <-received:
+			assert.Regexp(t, `^<30>1 \S+ \S+ talos - - - `, m)
+			assert.True(t, strings.HasSuffix(m, " "+msg), "unexpected message %q", m)
+		}
+	}
+}
@@ -11,19 +11,23 @@ import (
 	"log"
 	"os"
 	"os/signal"
+	"sort"
 	"strconv"
 	"strings"
 	"syscall"
 	"time"
 
+	"github.com/siderolabs/gen/slices"
 	"golang.org/x/sync/errgroup"
 
 	"github.com/siderolabs/talos/internal/app/machined/pkg/runtime"
 	"github.com/siderolabs/talos/internal/app/machined/pkg/runtime/logging"
 	"github.com/siderolabs/talos/internal/app/machined/pkg/runtime/v1alpha1/acpi"
 	"github.com/siderolabs/talos/internal/app/machined/pkg/runtime/v1alpha2"
+	"github.com/siderolabs/talos/internal/app/machined/pkg/system"
 	"github.com/siderolabs/talos/pkg/machinery/api/common"
 	"github.com/siderolabs/talos/pkg/machinery/api/machine"
+	"github.com/siderolabs/talos/pkg/machinery/constants"
 )
 
 // Controller represents the controller responsible for managing the execution
@@ -127,6 +131,104 @@ func (c *Controller) Run(ctx context.Context, seq runtime.Sequence, data interfa
 	return nil
 }
 
+// Plan resolves the phases and tasks of the sequence without running it.
+//
+// Tasks which would be skipped are not included in the plan.
+// Phases with deferred checks are included, as the checks are evaluated only when the sequence runs.
+func (c *Controller) Plan(seq runtime.Sequence, data interface{}) (*machine.SequencePlan, error) {
+	if c.r == nil {
+		return nil, runtime.ErrUndefinedRuntime
+	}
+
+	phases, err := c.phases(seq, data)
+	if err != nil {
+		return nil, err
+	}
+
+	plan := &machine.SequencePlan{
+		Sequence: seq.String(),
+	}
+
+	var stopServices []string
+
+	for _, phase := range phases {
+		planPhase := &machine.SequencePlanPhase{
+			Name: phase.Name,
+		}
+
+		for _, f := range phase.Tasks {
+			task, taskName := f(seq, data)
+			if task == nil {
+				continue
+			}
+
+			planPhase.Tasks = append(planPhase.Tasks, taskName)
+
+			plan.PartitionsToWipe = append(plan.PartitionsToWipe, c.taskPartitionsToWipe(taskName, data)...)
+			stopServices = append(stopServices, taskServicesToStop(taskName)...)
+		}
+
+		plan.Phases = append(plan.Phases, planPhase)
+	}
+
+	plan.PartitionsToWipe = dedup(plan.PartitionsToWipe)
+	plan.ServicesToStop = dedup(stopServices)
+
+	sort.Strings(plan.ServicesToStop)
+
+	return plan, nil
+}
+
+// taskPartitionsToWipe returns partition labels and disks wiped by the task.
+func (c *Controller) taskPartitionsToWipe(taskName string, data interface{}) []string {
+	switch taskName {
+	case taskNameUpgrade:
+		if in, ok := data.(*machine.UpgradeRequest); ok && !in.GetPreserve() {
+			return []string{constants.EphemeralPartitionLabel}
+		}
+	case taskNameResetSystemDisk:
+		if disk := c.r.State().Machine().Disk(); disk != nil {
+			return []string{disk.Device().Name()}
+		}
+	case taskNameResetSystemDiskSpec:
+		if in, ok := data.(runtime.ResetOptions); ok {
+			return slices.Map(in.GetSystemDiskTargets(), runtime.PartitionTarget.GetLabel)
+		}
+	case taskNameResetUserDisks:
+		if in, ok := data.(runtime.ResetOptions); ok {
+			return in.GetUserDisksToWipe()
+		}
+	}
+
+	return nil
+}
+
+// taskServicesToStop returns services stopped by the task.
+func taskServicesToStop(taskName string) []string {
+	switch taskName {
+	case taskNameStopServicesEphemeral:
+		return system.Services(nil).StopPlan(ephemeralServices...)
+	case taskNameStopAllServices:
+		return system.Services(nil).StopPlan()
+	}
+
+	return nil
+}
+
+func dedup(items []string) []string {
+	seen := make(map[string]struct{}, len(items))
+
+	return slices.Filter(items, func(item string) bool {
+		if _, ok := seen[item]; ok {
+			return false
+		}
+
+		seen[item] = struct{}{}
+
+		return true
+	})
+}
+
 // V1Alpha2 implements the controller interface.
 func (c *Controller) V1Alpha2() runtime.V1Alpha2Controller {
 	return c.v2
@@ -214,6 +316,12 @@ func (c *Controller) run(ctx context.Context, seq runtime.Sequence, phases []run
 
 	log.Printf("%s sequence: %d phase(s)", seq.String(), len(phases))
 
+	var checkpoint *upgradeCheckpointer
+
+	if in, ok := data.(*machine.UpgradeRequest); ok && isUpgradeSequence(seq) {
+		checkpoint = newUpgradeCheckpointer(c.Runtime().State().Machine().Meta(), seq, in)
+	}
+
 	defer func() {
 		if err != nil {
 			if !runtime.IsRebootError(err) {
@@ -236,16 +344,38 @@ func (c *Controller) run(ctx context.Context, seq runtime.Sequence, phases []run
 
 		progress := fmt.Sprintf("%d/%d", number, len(phases))
 
+		if checkpoint.skipPhase(phase.Name) {
+			log.Printf("phase %s (%s): skipped, completed by the previous attempt", phase.Name, progress)
+
+			continue
+		}
+
 		log.Printf("phase %s (%s): %d tasks(s)", phase.Name, progress, len(phase.Tasks))
 
-		if err = c.runPhase(ctx, phase, seq, data); err != nil {
-			if !runtime.IsRebootError(err) {
+		phaseCtx := ctx
+
+		if isUpgradeSequence(seq) {
+			phaseCtx = context.WithValue(ctx, upgradeProgressKey{}, upgradeProgress{
+				phaseIndex: number,
+				phaseCount: len(phases),
+				phase:      phase.Name,
+				checkpoint: checkpoint,
+			})
+		}
+
+		if err = c.runPhase(phaseCtx, phase, seq, data); err != nil {
+			if runtime.IsRebootError(err) {
+				// the sequence went through, the node is going to reboot
+				checkpoint.clear(ctx)
+			} else {
 				log.Printf("phase %s (%s): failed", phase.Name, progress)
 			}
 
 			return fmt.Errorf("error running phase %d in %s sequence: %w", number, seq.String(), err)
 		}
 
+		checkpoint.phaseDone(ctx, phase.Name)
+
 		log.Printf("phase %s (%s): done, %s", phase.Name, progress, time.Since(start))
 
 		select {
@@ -255,6 +385,8 @@ func (c *Controller) run(ctx context.Context, seq runtime.Sequence, phases []run
 		}
 	}
 
+	checkpoint.clear(ctx)
+
 	return nil
 }
 
@@ -299,6 +431,14 @@ func (c *Controller) runTask(ctx context.Context, progress string, f runtime.Tas
 		return nil
 	}
 
+	p, isUpgrade := ctx.Value(upgradeProgressKey{}).(upgradeProgress)
+
+	if isUpgrade && p.checkpoint.skipTask(p.phase, taskName) {
+		log.Printf("task %s (%s): skipped, completed by the previous attempt", taskName, progress)
+
+		return nil
+	}
+
 	start := time.Now()
 
 	c.Runtime().Events().Publish(ctx, &machine.TaskEvent{
@@ -308,6 +448,27 @@ func (c *Controller) runTask(ctx context.Context, progress string, f runtime.Tas
 
 	var err error
 
+	if isUpgrade {
+		p.task = taskName
+		ctx = context.WithValue(ctx, upgradeProgressKey{}, p)
+
+		c.Runtime().Events().Publish(ctx, p.event(machine.UpgradeProgressEvent_START))
+
+		defer func() {
+			switch {
+			case err == nil:
+				p.checkpoint.taskDone(ctx, p.phase, taskName)
+
+				c.Runtime().Events().Publish(ctx, p.event(machine.UpgradeProgressEvent_DONE))
+			case !runtime.IsRebootError(err):
+				ev := p.event(machine.UpgradeProgressEvent_FAILED)
+				ev.Error = err.Error()
+
+				c.Runtime().Events().Publish(ctx, ev)
+			}
+		}()
+	}
+
 	log.Printf("task %s (%s): starting", taskName, progress)
 
 	defer func() {
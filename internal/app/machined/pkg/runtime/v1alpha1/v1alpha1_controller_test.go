@@ -7,6 +7,7 @@ package v1alpha1
 
 import (
 	"context"
+	"encoding/json"
 	"errors"
 	"fmt"
 	"log"
@@ -17,10 +18,12 @@ import (
 
 	"github.com/stretchr/testify/require"
 	"golang.org/x/sync/errgroup"
+	"google.golang.org/protobuf/proto"
 
 	v1alpha1server "github.com/siderolabs/talos/internal/app/machined/internal/server/v1alpha1"
 	"github.com/siderolabs/talos/internal/app/machined/pkg/runtime"
 	"github.com/siderolabs/talos/internal/app/machined/pkg/runtime/logging"
+	"github.com/siderolabs/talos/internal/pkg/meta"
 	"github.com/siderolabs/talos/pkg/machinery/api/machine"
 )
 
@@ -201,6 +204,509 @@ func TestRun(t *testing.T) {
 	}
 }
 
+func TestPlan(t *testing.T) {
+	t.Setenv("PLATFORM", "container")
+
+	s, err := NewState()
+	require.NoError(t, err)
+
+	l := logging.NewCircularBufferLoggingManager(log.New(os.Stdout, "machined fallback logger: ", log.Flags()))
+
+	sequencer := &mockSequencer{
+		calls:  map[runtime.Sequence]int{},
+		phases: map[runtime.Sequence]PhaseList{},
+	}
+
+	sequencer.phases[runtime.SequenceUpgrade] = PhaseList{}.
+		Append("drain", sequencer.trackCall("cordonAndDrainNode", nil)).
+		Append("upgrade", sequencer.trackCall(taskNameUpgrade, nil), skip)
+
+	sequencer.phases[runtime.SequenceReset] = PhaseList{}.
+		Append("resetUserDisks", sequencer.trackCall(taskNameResetUserDisks, nil))
+
+	controller := Controller{
+		r:            NewRuntime(s, NewEvents(1000, 10), l),
+		s:            sequencer,
+		priorityLock: NewPriorityLock[runtime.Sequence](),
+	}
+
+	for _, tt := range []struct {
+		name     string
+		seq      runtime.Sequence
+		data     interface{}
+		expected *machine.SequencePlan
+	}{
+		{
+			name: "upgrade",
+			seq:  runtime.SequenceUpgrade,
+			data: &machine.UpgradeRequest{},
+			expected: &machine.SequencePlan{
+				Sequence: "upgrade",
+				Phases: []*machine.SequencePlanPhase{
+					{Name: "drain", Tasks: []string{"cordonAndDrainNode"}},
+					{Name: "upgrade", Tasks: []string{"upgrade"}},
+				},
+				PartitionsToWipe: []string{"EPHEMERAL"},
+			},
+		},
+		{
+			name: "upgrade preserve",
+			seq:  runtime.SequenceUpgrade,
+			data: &machine.UpgradeRequest{Preserve: true},
+			expected: &machine.SequencePlan{
+				Sequence: "upgrade",
+				Phases: []*machine.SequencePlanPhase{
+					{Name: "drain", Tasks: []string{"cordonAndDrainNode"}},
+					{Name: "upgrade", Tasks: []string{"upgrade"}},
+				},
+			},
+		},
+		{
+			name: "reset",
+			seq:  runtime.SequenceReset,
+			data: &v1alpha1server.ResetOptions{
+				ResetRequest: &machine.ResetRequest{UserDisksToWipe: []string{"/dev/sdb", "/dev/sdc"}},
+			},
+			expected: &machine.SequencePlan{
+				Sequence: "reset",
+				Phases: []*machine.SequencePlanPhase{
+					{Name: "resetUserDisks", Tasks: []string{"resetUserDisks"}},
+				},
+				PartitionsToWipe: []string{"/dev/sdb", "/dev/sdc"},
+			},
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			plan, err := controller.Plan(tt.seq, tt.data)
+			require.NoError(t, err)
+
+			require.True(t, proto.Equal(tt.expected, plan), "expected %v, got %v", tt.expected, plan)
+		})
+	}
+
+	_, err = controller.Plan(runtime.SequenceUpgrade, nil)
+	require.ErrorIs(t, err, runtime.ErrInvalidSequenceData)
+
+	// nothing should be run
+	require.Empty(t, sequencer.calls)
+}
+
+func TestUpgradeProgress(t *testing.T) {
+	t.Setenv("PLATFORM", "container")
+
+	s, err := NewState()
+	require.NoError(t, err)
+
+	l := logging.NewCircularBufferLoggingManager(log.New(os.Stdout, "machined fallback logger: ", log.Flags()))
+
+	sequencer := &mockSequencer{
+		calls:  map[runtime.Sequence]int{},
+		phases: map[runtime.Sequence]PhaseList{},
+	}
+
+	failingUpgrade := func(runtime.Sequence, interface{}) (runtime.TaskExecutionFunc, string) {
+		return func(ctx context.Context, logger *log.Logger, r runtime.Runtime) error {
+			reportUpgradeProgress(ctx, r, "writing installer image", 50)
+
+			return errors.New("installer failed")
+		}, "upgrade"
+	}
+
+	sequencer.phases[runtime.SequenceUpgrade] = PhaseList{}.
+		Append("drain", sequencer.trackCall("cordonAndDrainNode", nil)).
+		Append("upgrade", failingUpgrade)
+
+	sequencer.phases[runtime.SequenceReboot] = PhaseList{}.
+		Append("upgrade", failingUpgrade)
+
+	for _, tt := range []struct {
+		name     string
+		seq      runtime.Sequence
+		data     interface{}
+		expected []*machine.UpgradeProgressEvent
+	}{
+		{
+			name: "upgrade",
+			seq:  runtime.SequenceUpgrade,
+			data: &machine.UpgradeRequest{},
+			expected: []*machine.UpgradeProgressEvent{
+				{PhaseIndex: 1, PhaseCount: 2, Phase: "drain", Task: "cordonAndDrainNode", Action: machine.UpgradeProgressEvent_START},
+				{PhaseIndex: 1, PhaseCount: 2, Phase: "drain", Task: "cordonAndDrainNode", Action: machine.UpgradeProgressEvent_DONE},
+				{PhaseIndex: 2, PhaseCount: 2, Phase: "upgrade", Task: "upgrade", Action: machine.UpgradeProgressEvent_START},
+				{
+					PhaseIndex: 2, PhaseCount: 2, Phase: "upgrade", Task: "upgrade", Action: machine.UpgradeProgressEvent_PROGRESS,
+					Percent: 50, Message: "writing installer image",
+				},
+				{
+					PhaseIndex: 2, PhaseCount: 2, Phase: "upgrade", Task: "upgrade", Action: machine.UpgradeProgressEvent_FAILED,
+					Error: "installer failed",
+				},
+			},
+		},
+		{
+			name: "reboot",
+			seq:  runtime.SequenceReboot,
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			e := NewEvents(1000, 10)
+
+			controller := Controller{
+				r:            NewRuntime(s, e, l),
+				s:            sequencer,
+				priorityLock: NewPriorityLock[runtime.Sequence](),
+			}
+
+			require.Error(t, controller.Run(context.Background(), tt.seq, tt.data))
+
+			var (
+				progress []*machine.UpgradeProgressEvent
+				wg       sync.WaitGroup
+			)
+
+			wg.Add(1)
+
+			require.NoError(t, e.Watch(func(events <-chan runtime.EventInfo) {
+				defer wg.Done()
+
+				for {
+					select {
+					case event := <-events:
+						if ev, ok := event.Payload.(*machine.UpgradeProgressEvent); ok {
+							progress = append(progress, ev)
+						}
+					case <-time.After(50 * time.Millisecond):
+						return
+					}
+				}
+			}, runtime.WithTailEvents(-1)))
+
+			wg.Wait()
+
+			require.Len(t, progress, len(tt.expected))
+
+			for i := range tt.expected {
+				require.True(t, proto.Equal(tt.expected[i], progress[i]), "expected %v, got %v", tt.expected[i], progress[i])
+			}
+		})
+	}
+}
+
+type mockMeta struct {
+	mu   sync.Mutex
+	tags map[uint8][]byte
+	disk map[uint8][]byte
+}
+
+// writeDisk changes the tag on the disk bypassing the in-memory copy, as the installer does.
+func (m *mockMeta) writeDisk(t uint8, val []byte) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if m.disk == nil {
+		m.disk = map[uint8][]byte{}
+	}
+
+	m.disk[t] = val
+}
+
+func (m *mockMeta) readDisk(t uint8) ([]byte, bool) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	val, ok := m.disk[t]
+
+	return val, ok
+}
+
+func (m *mockMeta) ReadTag(t uint8) (string, bool) {
+	val, ok := m.ReadTagBytes(t)
+
+	return string(val), ok
+}
+
+func (m *mockMeta) ReadTagBytes(t uint8) ([]byte, bool) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	val, ok := m.tags[t]
+
+	return val, ok
+}
+
+func (m *mockMeta) SetTag(ctx context.Context, t uint8, val string) (bool, error) {
+	return m.SetTagBytes(ctx, t, []byte(val))
+}
+
+func (m *mockMeta) SetTagBytes(_ context.Context, t uint8, val []byte) (bool, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	m.tags[t] = val
+
+	return true, nil
+}
+
+func (m *mockMeta) DeleteTag(_ context.Context, t uint8) (bool, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	_, ok := m.tags[t]
+	delete(m.tags, t)
+
+	return ok, nil
+}
+
+// Reload merges the on-disk tags into the in-memory ones, in-memory values take precedence.
+func (m *mockMeta) Reload(context.Context) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	for t, val := range m.disk {
+		if _, ok := m.tags[t]; !ok {
+			m.tags[t] = val
+		}
+	}
+
+	return nil
+}
+
+// Flush replaces the on-disk tags with the in-memory ones.
+func (m *mockMeta) Flush() error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	m.disk = make(map[uint8][]byte, len(m.tags))
+
+	for t, val := range m.tags {
+		m.disk[t] = val
+	}
+
+	return nil
+}
+
+// metaState replaces META of the machine state.
+type metaState struct {
+	runtime.State
+
+	meta runtime.Meta
+}
+
+func (s metaState) Machine() runtime.MachineState {
+	return metaMachineState{MachineState: s.State.Machine(), meta: s.meta}
+}
+
+type metaMachineState struct {
+	runtime.MachineState
+
+	meta runtime.Meta
+}
+
+func (s metaMachineState) Meta() runtime.Meta {
+	return s.meta
+}
+
+func TestUpgradeResume(t *testing.T) {
+	t.Setenv("PLATFORM", "container")
+
+	s, err := NewState()
+	require.NoError(t, err)
+
+	m := &mockMeta{tags: map[uint8][]byte{}}
+
+	l := logging.NewCircularBufferLoggingManager(log.New(os.Stdout, "machined fallback logger: ", log.Flags()))
+
+	controller := Controller{
+		r:            NewRuntime(metaState{State: s, meta: m}, NewEvents(1000, 10), l),
+		priorityLock: NewPriorityLock[runtime.Sequence](),
+	}
+
+	var (
+		callsMu sync.Mutex
+		calls   map[string]int
+		failing map[string]bool
+	)
+
+	task := func(name string) runtime.TaskSetupFunc {
+		return func(runtime.Sequence, interface{}) (runtime.TaskExecutionFunc, string) {
+			return func(context.Context, *log.Logger, runtime.Runtime) error {
+				callsMu.Lock()
+				defer callsMu.Unlock()
+
+				calls[name]++
+
+				if failing[name] {
+					return fmt.Errorf("%s failed", name)
+				}
+
+				return nil
+			}, name
+		}
+	}
+
+	controller.s = &mockSequencer{
+		phases: map[runtime.Sequence]PhaseList{
+			runtime.SequenceUpgrade: PhaseList{}.
+				Append("drain", task("cordonAndDrainNode")).
+				Append("leave", task("leaveEtcd")).
+				Append("unmountSystem", task("unmountEphemeralPartition"), task("unmountStatePartition")).
+				Append("upgrade", task("upgrade")),
+		},
+	}
+
+	run := func(in *machine.UpgradeRequest, failingTasks ...string) (map[string]int, error) {
+		callsMu.Lock()
+		calls = map[string]int{}
+		failing = map[string]bool{}
+
+		for _, name := range failingTasks {
+			failing[name] = true
+		}
+		callsMu.Unlock()
+
+		err := controller.Run(context.Background(), runtime.SequenceUpgrade, in)
+
+		callsMu.Lock()
+		defer callsMu.Unlock()
+
+		return calls, err
+	}
+
+	readCheckpoint := func() (upgradeCheckpoint, bool) {
+		var checkpoint upgradeCheckpoint
+
+		data, ok := m.ReadTagBytes(meta.UpgradeCheckpoint)
+		if ok {
+			require.NoError(t, json.Unmarshal(data, &checkpoint))
+		}
+
+		return checkpoint, ok
+	}
+
+	in := &machine.UpgradeRequest{Image: "ghcr.io/siderolabs/installer:v1.5.0"}
+
+	// the first attempt fails on one of the unmount tasks
+	called, err := run(in, "unmountStatePartition")
+	require.Error(t, err)
+	require.Equal(t, map[string]int{
+		"cordonAndDrainNode":        1,
+		"leaveEtcd":                 1,
+		"unmountEphemeralPartition": 1,
+		"unmountStatePartition":     1,
+	}, called)
+
+	checkpoint, ok := readCheckpoint()
+	require.True(t, ok)
+	require.Equal(t, "upgrade", checkpoint.Sequence)
+	require.Equal(t, in.Image, checkpoint.Image)
+	require.Equal(t, []string{"drain", "leave"}, checkpoint.Phases)
+	require.Equal(t, "unmountSystem", checkpoint.Phase)
+	require.Equal(t, []string{"unmountEphemeralPartition"}, checkpoint.Tasks)
+
+	// the upgrade with another image doesn't resume
+	called, err = run(&machine.UpgradeRequest{Image: "ghcr.io/siderolabs/installer:v1.5.1"}, "cordonAndDrainNode")
+	require.Error(t, err)
+	require.Equal(t, map[string]int{"cordonAndDrainNode": 1}, called)
+
+	// restore the checkpoint of the first attempt
+	data, err := json.Marshal(checkpoint)
+	require.NoError(t, err)
+
+	_, err = m.SetTagBytes(context.Background(), meta.UpgradeCheckpoint, data)
+	require.NoError(t, err)
+
+	// the retry resumes from the failed task
+	called, err = run(in)
+	require.NoError(t, err)
+	require.Equal(t, map[string]int{
+		"unmountStatePartition": 1,
+		"upgrade":               1,
+	}, called)
+
+	// the checkpoint is removed once the upgrade went through
+	_, ok = readCheckpoint()
+	require.False(t, ok)
+
+	// the checkpoint of another boot is ignored
+	checkpoint.BootID = "00000000-0000-0000-0000-000000000000"
+
+	data, err = json.Marshal(checkpoint)
+	require.NoError(t, err)
+
+	_, err = m.SetTagBytes(context.Background(), meta.UpgradeCheckpoint, data)
+	require.NoError(t, err)
+
+	called, err = run(in)
+	require.NoError(t, err)
+	require.Equal(t, map[string]int{
+		"cordonAndDrainNode":        1,
+		"leaveEtcd":                 1,
+		"unmountEphemeralPartition": 1,
+		"unmountStatePartition":     1,
+		"upgrade":                   1,
+	}, called)
+}
+
+func TestUpgradeCheckpointKeepsInstallerMeta(t *testing.T) {
+	t.Setenv("PLATFORM", "container")
+
+	s, err := NewState()
+	require.NoError(t, err)
+
+	m := &mockMeta{tags: map[uint8][]byte{}}
+
+	l := logging.NewCircularBufferLoggingManager(log.New(os.Stdout, "machined fallback logger: ", log.Flags()))
+
+	controller := Controller{
+		r:            NewRuntime(metaState{State: s, meta: m}, NewEvents(1000, 10), l),
+		priorityLock: NewPriorityLock[runtime.Sequence](),
+	}
+
+	controller.s = &mockSequencer{
+		phases: map[runtime.Sequence]PhaseList{
+			runtime.SequenceUpgrade: PhaseList{}.
+				Append("unmountSystem", func(runtime.Sequence, interface{}) (runtime.TaskExecutionFunc, string) {
+					return func(context.Context, *log.Logger, runtime.Runtime) error {
+						return nil
+					}, "unmountStatePartition"
+				}).
+				Append("upgrade", func(runtime.Sequence, interface{}) (runtime.TaskExecutionFunc, string) {
+					return func(context.Context, *log.Logger, runtime.Runtime) error {
+						// the installer writes META directly to the disk
+						m.writeDisk(meta.Upgrade, []byte("A"))
+
+						return nil
+					}, "upgrade"
+				}).
+				Append("kexec", func(runtime.Sequence, interface{}) (runtime.TaskExecutionFunc, string) {
+					return func(context.Context, *log.Logger, runtime.Runtime) error {
+						return fmt.Errorf("kexec failed")
+					}, "kexecPrepare"
+				}),
+		},
+	}
+
+	err = controller.Run(context.Background(), runtime.SequenceUpgrade, &machine.UpgradeRequest{Image: "ghcr.io/siderolabs/installer:v1.5.0"})
+	require.Error(t, err)
+
+	// saving the checkpoint after the installer run doesn't overwrite the installer changes
+	val, ok := m.readDisk(meta.Upgrade)
+	require.True(t, ok)
+	require.Equal(t, "A", string(val))
+
+	data, ok := m.readDisk(meta.UpgradeCheckpoint)
+	require.True(t, ok)
+
+	var checkpoint upgradeCheckpoint
+
+	require.NoError(t, json.Unmarshal(data, &checkpoint))
+	require.Equal(t, []string{"unmountSystem", "upgrade"}, checkpoint.Phases)
+}
+
+func skip(seq runtime.Sequence, data interface{}) (runtime.TaskExecutionFunc, string) {
+	return nil, "skip"
+}
+
 func wait(seq runtime.Sequence, data interface{}) (runtime.TaskExecutionFunc, string) {
 	return func(ctx context.Context, logger *log.Logger, r runtime.Runtime) error {
 		select {
@@ -17,6 +17,7 @@ import (
 	"github.com/google/go-cmp/cmp"
 
 	"github.com/siderolabs/talos/internal/app/machined/pkg/runtime"
+	"github.com/siderolabs/talos/internal/app/machined/pkg/runtime/logging"
 	"github.com/siderolabs/talos/internal/app/machined/pkg/system"
 	"github.com/siderolabs/talos/internal/app/machined/pkg/system/services"
 	"github.com/siderolabs/talos/pkg/machinery/config"
@@ -30,7 +31,9 @@ type Runtime struct {
 	c atomicInterface[config.Provider]
 	s runtime.State
 	e runtime.EventStream
-	l runtime.LoggingManager
+	l atomicInterface[runtime.LoggingManager]
+
+	loggingMu sync.Mutex
 
 	rollbackTimerMu sync.Mutex
 	rollbackTimer   *time.Timer
@@ -38,11 +41,14 @@ type Runtime struct {
 
 // NewRuntime initializes and returns the v1alpha1 runtime.
 func NewRuntime(s runtime.State, e runtime.EventStream, l runtime.LoggingManager) *Runtime {
-	return &Runtime{
+	r := &Runtime{
 		s: s,
 		e: e,
-		l: l,
 	}
+
+	r.l.Store(l)
+
+	return r
 }
 
 // Config implements the Runtime interface.
@@ -104,6 +110,10 @@ func (r *Runtime) CancelConfigRollbackTimeout() {
 func (r *Runtime) SetConfig(cfg config.Provider) error {
 	r.c.Store(cfg)
 
+	if cfg.PersistentLogs() != nil {
+		r.enablePersistentLogging()
+	}
+
 	return r.s.V1Alpha2().SetConfig(cfg)
 }
 
@@ -196,7 +206,20 @@ func (r *Runtime) Events() runtime.EventStream {
 
 // Logging implements the Runtime interface.
 func (r *Runtime) Logging() runtime.LoggingManager {
-	return r.l
+	return r.l.Load()
+}
+
+// enablePersistentLogging switches the in-memory logging manager to the one which can persist logs.
+//
+// Service logs are kept only in memory unless the persistent logs are configured. Once switched, the manager
+// is kept, as the persistence is enabled and disabled by the controller following the config changes.
+func (r *Runtime) enablePersistentLogging() {
+	r.loggingMu.Lock()
+	defer r.loggingMu.Unlock()
+
+	if circular, ok := r.l.Load().(*logging.CircularBufferLoggingManager); ok {
+		r.l.Store(logging.NewPersistentLoggingManager(circular))
+	}
 }
 
 // NodeName implements the Runtime interface.
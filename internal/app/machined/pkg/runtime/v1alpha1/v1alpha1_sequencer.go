@@ -494,6 +494,9 @@ func (*Sequencer) Upgrade(r runtime.Runtime, in *machineapi.UpgradeRequest) []ru
 		).Append(
 			"stopServices",
 			StopServicesEphemeral,
+		).Append(
+			"stopLogs",
+			StopPersistentLogs,
 		).Append(
 			"unmountUser",
 			UnmountUserDisks,
@@ -545,6 +548,9 @@ func stopAllPhaselist(r runtime.Runtime, enableKexec bool) PhaseList {
 		phases = phases.Append(
 			"stopServices",
 			StopServicesEphemeral,
+		).Append(
+			"stopLogs",
+			StopPersistentLogs,
 		).Append(
 			"unmountUser",
 			UnmountUserDisks,
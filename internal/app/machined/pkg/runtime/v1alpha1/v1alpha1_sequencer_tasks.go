@@ -63,6 +63,7 @@ import (
 	"github.com/siderolabs/talos/internal/pkg/meta"
 	"github.com/siderolabs/talos/internal/pkg/mount"
 	"github.com/siderolabs/talos/internal/pkg/partition"
+	"github.com/siderolabs/talos/internal/pkg/uservolume"
 	"github.com/siderolabs/talos/pkg/conditions"
 	"github.com/siderolabs/talos/pkg/images"
 	krnl "github.com/siderolabs/talos/pkg/kernel"
@@ -75,6 +76,7 @@ import (
 	"github.com/siderolabs/talos/pkg/machinery/config/types/v1alpha1"
 	"github.com/siderolabs/talos/pkg/machinery/constants"
 	"github.com/siderolabs/talos/pkg/machinery/kernel"
+	"github.com/siderolabs/talos/pkg/machinery/resources/block"
 	resourcefiles "github.com/siderolabs/talos/pkg/machinery/resources/files"
 	"github.com/siderolabs/talos/pkg/machinery/resources/k8s"
 	resourceruntime "github.com/siderolabs/talos/pkg/machinery/resources/runtime"
@@ -82,6 +84,16 @@ import (
 	"github.com/siderolabs/talos/pkg/version"
 )
 
+// Names of the tasks which are inspected by the sequence plan, see Controller.Plan.
+const (
+	taskNameStopServicesEphemeral = "stopServicesForUpgrade"
+	taskNameStopAllServices       = "stopAllServices"
+	taskNameResetSystemDisk       = "resetSystemDisk"
+	taskNameResetUserDisks        = "resetUserDisks"
+	taskNameResetSystemDiskSpec   = "resetSystemDiskSpec"
+	taskNameUpgrade               = "upgrade"
+)
+
 // SetupLogger represents the SetupLogger task.
 func SetupLogger(runtime.Sequence, any) (runtime.TaskExecutionFunc, string) {
 	return func(ctx context.Context, logger *log.Logger, r runtime.Runtime) (err error) {
@@ -937,12 +949,16 @@ func StartAllServices(runtime.Sequence, any) (runtime.TaskExecutionFunc, string)
 	}, "startAllServices"
 }
 
+// ephemeralServices are stopped with their reverse dependencies to release the ephemeral partition.
+//
+// Stopping 'cri' service stops everything which depends on it (kubelet, etcd, ...).
+var ephemeralServices = []string{"cri", "udevd", "trustd"}
+
 // StopServicesEphemeral represents the StopServicesEphemeral task.
 func StopServicesEphemeral(runtime.Sequence, any) (runtime.TaskExecutionFunc, string) {
 	return func(ctx context.Context, logger *log.Logger, r runtime.Runtime) (err error) {
-		// stopping 'cri' service stops everything which depends on it (kubelet, etcd, ...)
-		return system.Services(nil).StopWithRevDepenencies(ctx, "cri", "udevd", "trustd")
-	}, "stopServicesForUpgrade"
+		return system.Services(nil).StopWithRevDepenencies(ctx, ephemeralServices...)
+	}, taskNameStopServicesEphemeral
 }
 
 // StopAllServices represents the StopAllServices task.
@@ -951,7 +967,7 @@ func StopAllServices(runtime.Sequence, any) (runtime.TaskExecutionFunc, string)
 		system.Services(nil).Shutdown(ctx)
 
 		return nil
-	}, "stopAllServices"
+	}, taskNameStopAllServices
 }
 
 // MountOverlayFilesystems represents the MountOverlayFilesystems task.
@@ -1013,10 +1029,50 @@ func MountUserDisks(runtime.Sequence, any) (runtime.TaskExecutionFunc, string) {
 			return err
 		}
 
-		return mountDisks(r)
+		if err = mountDisks(r); err != nil {
+			return err
+		}
+
+		return waitForUserVolumes(ctx, logger, r)
 	}, "mountUserDisks"
 }
 
+// waitForUserVolumes waits for the user volumes to be mounted by the controller before the workloads are started.
+func waitForUserVolumes(ctx context.Context, logger *log.Logger, r runtime.Runtime) error {
+	ctx, cancel := context.WithTimeout(ctx, 5*time.Minute)
+	defer cancel()
+
+	for _, volume := range r.Config().UserVolumes() {
+		logger.Printf("waiting for user volume %q to be mounted", volume.Name())
+
+		res, err := r.State().V1Alpha2().Resources().WatchFor(
+			ctx,
+			block.NewVolumeStatus(block.NamespaceName, volume.Name()).Metadata(),
+			state.WithCondition(func(r resource.Resource) (bool, error) {
+				if resource.IsTombstone(r) {
+					return false, nil
+				}
+
+				status, ok := r.(*block.VolumeStatus)
+				if !ok {
+					return false, nil
+				}
+
+				return status.TypedSpec().Phase == block.VolumePhaseReady || status.TypedSpec().Phase == block.VolumePhaseFailed, nil
+			}),
+		)
+		if err != nil {
+			return fmt.Errorf("error waiting for user volume %q: %w", volume.Name(), err)
+		}
+
+		if status := res.(*block.VolumeStatus).TypedSpec(); status.Phase == block.VolumePhaseFailed { //nolint:forcetypeassert
+			return fmt.Errorf("error mounting user volume %q: %s", volume.Name(), status.ErrorMessage)
+		}
+	}
+
+	return nil
+}
+
 // TODO(andrewrynhard): We shouldn't pull in the installer command package
 // here.
 func partitionAndFormatDisks(logger *log.Logger, r runtime.Runtime) error {
@@ -1323,6 +1379,20 @@ func UnmountOverlayFilesystems(runtime.Sequence, any) (runtime.TaskExecutionFunc
 	}, "unmountOverlayFilesystems"
 }
 
+// StopPersistentLogs represents the StopPersistentLogs task.
+//
+// Persistent logs should be closed before the filesystems are unmounted.
+func StopPersistentLogs(runtime.Sequence, any) (runtime.TaskExecutionFunc, string) {
+	return func(ctx context.Context, logger *log.Logger, r runtime.Runtime) (err error) {
+		persistence, ok := r.Logging().(runtime.LogPersistence)
+		if !ok {
+			return nil
+		}
+
+		return persistence.DisablePersistence()
+	}, "stopPersistentLogs"
+}
+
 // UnmountUserDisks represents the UnmountUserDisks task.
 func UnmountUserDisks(runtime.Sequence, any) (runtime.TaskExecutionFunc, string) {
 	return func(ctx context.Context, logger *log.Logger, r runtime.Runtime) (err error) {
@@ -1330,6 +1400,10 @@ func UnmountUserDisks(runtime.Sequence, any) (runtime.TaskExecutionFunc, string)
 			return nil
 		}
 
+		if err = uservolume.UnmountAll(); err != nil {
+			return err
+		}
+
 		mountpoints := mount.NewMountPoints()
 
 		for _, disk := range r.Config().Machine().Disks() {
@@ -1722,7 +1796,7 @@ func ResetSystemDisk(runtime.Sequence, any) (runtime.TaskExecutionFunc, string)
 		defer dev.Close() //nolint:errcheck
 
 		return dev.Reset()
-	}, "resetSystemDisk"
+	}, taskNameResetSystemDisk
 }
 
 // ResetUserDisks represents the task to reset the user disks.
@@ -1757,7 +1831,7 @@ func ResetUserDisks(_ runtime.Sequence, data any) (runtime.TaskExecutionFunc, st
 		}
 
 		return nil
-	}, "resetUserDisks"
+	}, taskNameResetUserDisks
 }
 
 type targets struct {
@@ -1827,7 +1901,7 @@ func ResetSystemDiskSpec(_ runtime.Sequence, data any) (runtime.TaskExecutionFun
 		logger.Printf("successfully reset system disk by the spec")
 
 		return nil
-	}, "resetSystemDiskSpec"
+	}, taskNameResetSystemDiskSpec
 }
 
 // VerifyDiskAvailability represents the task for verifying that the system
@@ -1921,7 +1995,12 @@ func Upgrade(_ runtime.Sequence, data any) (runtime.TaskExecutionFunc, string) {
 			in.GetImage(),
 			r.Config(),
 			r.ConfigContainer(),
-			install.OptionsFromUpgradeRequest(r, in)...,
+			append(
+				install.OptionsFromUpgradeRequest(r, in),
+				install.WithProgress(func(message string, percent int) {
+					reportUpgradeProgress(ctx, r, message, percent)
+				}),
+			)...,
 		)
 		if err != nil {
 			return err
@@ -1930,7 +2009,7 @@ func Upgrade(_ runtime.Sequence, data any) (runtime.TaskExecutionFunc, string) {
 		logger.Println("upgrade successful")
 
 		return nil
-	}, "upgrade"
+	}, taskNameUpgrade
 }
 
 // Reboot represents the Reboot task.
@@ -0,0 +1,245 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package v1alpha1
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"log"
+	"os"
+	"strings"
+	"sync"
+
+	"github.com/siderolabs/gen/slices"
+
+	"github.com/siderolabs/talos/internal/app/machined/pkg/runtime"
+	"github.com/siderolabs/talos/internal/pkg/meta"
+	"github.com/siderolabs/talos/pkg/machinery/api/machine"
+)
+
+var bootIDPath = "/proc/sys/kernel/random/boot_id"
+
+// upgradeCheckpoint is the progress of the upgrade sequence stored in META.
+//
+// The checkpoint is valid only for the same boot and the same upgrade request: once the node reboots,
+// the state changed by the completed phases (stopped services, unmounted partitions) is gone.
+type upgradeCheckpoint struct {
+	BootID   string `json:"bootID"`
+	Sequence string `json:"sequence"`
+	Image    string `json:"image"`
+	Preserve bool   `json:"preserve,omitempty"`
+
+	// Phases which completed, in the order they were run.
+	Phases []string `json:"phases,omitempty"`
+	// Phase which was running, and the tasks of the phase which completed.
+	Phase string   `json:"phase,omitempty"`
+	Tasks []string `json:"tasks,omitempty"`
+}
+
+func (checkpoint *upgradeCheckpoint) matches(other *upgradeCheckpoint) bool {
+	return checkpoint.BootID == other.BootID &&
+		checkpoint.Sequence == other.Sequence &&
+		checkpoint.Image == other.Image &&
+		checkpoint.Preserve == other.Preserve
+}
+
+// upgradeCheckpointer saves the progress of the upgrade sequence, and resumes the failed upgrade.
+//
+// When the same upgrade is retried after a failure, phases and tasks which already completed are skipped,
+// as running them again might fail (e.g. the node has already left etcd, or the partitions are unmounted).
+//
+// All methods are safe to call on a nil upgradeCheckpointer, which doesn't skip anything.
+type upgradeCheckpointer struct {
+	meta runtime.Meta
+
+	mu         sync.Mutex
+	checkpoint upgradeCheckpoint
+	resume     upgradeCheckpoint
+}
+
+// newUpgradeCheckpointer loads the checkpoint of the previous attempt of the upgrade.
+func newUpgradeCheckpointer(m runtime.Meta, seq runtime.Sequence, in *machine.UpgradeRequest) *upgradeCheckpointer {
+	bootID, err := os.ReadFile(bootIDPath)
+	if err != nil {
+		log.Printf("upgrade checkpoint: failed to read boot ID, upgrade can't be resumed: %s", err)
+
+		return nil
+	}
+
+	checkpointer := &upgradeCheckpointer{
+		meta: m,
+		checkpoint: upgradeCheckpoint{
+			BootID:   string(bytes.TrimSpace(bootID)),
+			Sequence: seq.String(),
+			Image:    in.GetImage(),
+			Preserve: in.GetPreserve(),
+		},
+	}
+
+	data, ok := m.ReadTagBytes(meta.UpgradeCheckpoint)
+	if !ok {
+		return checkpointer
+	}
+
+	var previous upgradeCheckpoint
+
+	if err = json.Unmarshal(data, &previous); err != nil {
+		log.Printf("upgrade checkpoint: ignoring invalid checkpoint: %s", err)
+
+		return checkpointer
+	}
+
+	if checkpointer.checkpoint.matches(&previous) && (len(previous.Phases) > 0 || len(previous.Tasks) > 0) {
+		log.Printf("upgrade checkpoint: resuming the previous attempt, completed phases: %s", strings.Join(previous.Phases, ", "))
+
+		checkpointer.resume = previous
+	}
+
+	return checkpointer
+}
+
+// skipPhase returns true if the phase was completed by the previous attempt.
+//
+// Phases are skipped only as long as the sequence follows the checkpoint.
+func (checkpointer *upgradeCheckpointer) skipPhase(name string) bool {
+	if checkpointer == nil {
+		return false
+	}
+
+	checkpointer.mu.Lock()
+	defer checkpointer.mu.Unlock()
+
+	if len(checkpointer.resume.Phases) > 0 && checkpointer.resume.Phases[0] == name {
+		checkpointer.resume.Phases = checkpointer.resume.Phases[1:]
+		checkpointer.checkpoint.Phases = append(checkpointer.checkpoint.Phases, name)
+
+		return true
+	}
+
+	// keep the completed tasks only if this is the phase which failed
+	if len(checkpointer.resume.Phases) > 0 || checkpointer.resume.Phase != name {
+		checkpointer.resume.Tasks = nil
+	}
+
+	checkpointer.resume.Phases = nil
+	checkpointer.resume.Phase = ""
+
+	return false
+}
+
+// skipTask returns true if the task of the failed phase was completed by the previous attempt.
+func (checkpointer *upgradeCheckpointer) skipTask(phase, task string) bool {
+	if checkpointer == nil {
+		return false
+	}
+
+	checkpointer.mu.Lock()
+	defer checkpointer.mu.Unlock()
+
+	if !slices.Contains(checkpointer.resume.Tasks, func(t string) bool { return t == task }) {
+		return false
+	}
+
+	checkpointer.checkpoint.Phase = phase
+	checkpointer.checkpoint.Tasks = append(checkpointer.checkpoint.Tasks, task)
+
+	return true
+}
+
+// taskDone records the completed task of the phase.
+func (checkpointer *upgradeCheckpointer) taskDone(ctx context.Context, phase, task string) {
+	if checkpointer == nil {
+		return
+	}
+
+	checkpointer.mu.Lock()
+	defer checkpointer.mu.Unlock()
+
+	checkpointer.checkpoint.Phase = phase
+	checkpointer.checkpoint.Tasks = append(checkpointer.checkpoint.Tasks, task)
+
+	checkpointer.save(ctx)
+}
+
+// phaseDone records the completed phase.
+func (checkpointer *upgradeCheckpointer) phaseDone(ctx context.Context, phase string) {
+	if checkpointer == nil {
+		return
+	}
+
+	checkpointer.mu.Lock()
+	defer checkpointer.mu.Unlock()
+
+	checkpointer.checkpoint.Phases = append(checkpointer.checkpoint.Phases, phase)
+	checkpointer.checkpoint.Phase = ""
+	checkpointer.checkpoint.Tasks = nil
+
+	checkpointer.save(ctx)
+}
+
+// clear removes the checkpoint once the upgrade went through.
+func (checkpointer *upgradeCheckpointer) clear(ctx context.Context) {
+	if checkpointer == nil {
+		return
+	}
+
+	checkpointer.mu.Lock()
+	defer checkpointer.mu.Unlock()
+
+	if !checkpointer.reload(ctx) {
+		return
+	}
+
+	if _, err := checkpointer.meta.DeleteTag(ctx, meta.UpgradeCheckpoint); err != nil {
+		log.Printf("upgrade checkpoint: failed to delete: %s", err)
+
+		return
+	}
+
+	if err := checkpointer.meta.Flush(); err != nil {
+		log.Printf("upgrade checkpoint: failed to write META: %s", err)
+	}
+}
+
+// reload refreshes META from the disk before it is written back.
+//
+// The installer run by the upgrade writes to META on its own (e.g. the rollback label), so flushing
+// the in-memory copy without reloading it first would overwrite these changes.
+func (checkpointer *upgradeCheckpointer) reload(ctx context.Context) bool {
+	if err := checkpointer.meta.Reload(ctx); err != nil && !os.IsNotExist(err) {
+		log.Printf("upgrade checkpoint: failed to reload META: %s", err)
+
+		return false
+	}
+
+	return true
+}
+
+// save writes the checkpoint to META.
+//
+// Failure to save the checkpoint is not fatal, the upgrade just can't be resumed from it.
+func (checkpointer *upgradeCheckpointer) save(ctx context.Context) {
+	if !checkpointer.reload(ctx) {
+		return
+	}
+
+	data, err := json.Marshal(checkpointer.checkpoint)
+	if err != nil {
+		log.Printf("upgrade checkpoint: failed to marshal: %s", err)
+
+		return
+	}
+
+	if _, err = checkpointer.meta.SetTagBytes(ctx, meta.UpgradeCheckpoint, data); err != nil {
+		log.Printf("upgrade checkpoint: failed to save: %s", err)
+
+		return
+	}
+
+	if err = checkpointer.meta.Flush(); err != nil {
+		log.Printf("upgrade checkpoint: failed to write META: %s", err)
+	}
+}
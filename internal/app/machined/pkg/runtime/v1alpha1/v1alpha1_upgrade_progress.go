@@ -0,0 +1,60 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package v1alpha1
+
+import (
+	"context"
+
+	"github.com/siderolabs/talos/internal/app/machined/pkg/runtime"
+	"github.com/siderolabs/talos/pkg/machinery/api/machine"
+)
+
+// upgradeProgressKey is the context key of the upgrade progress of the running task.
+type upgradeProgressKey struct{}
+
+// upgradeProgress describes the task of the upgrade sequence being run.
+type upgradeProgress struct {
+	phaseIndex int
+	phaseCount int
+	phase      string
+	task       string
+
+	checkpoint *upgradeCheckpointer
+}
+
+func (p upgradeProgress) event(action machine.UpgradeProgressEvent_Action) *machine.UpgradeProgressEvent {
+	return &machine.UpgradeProgressEvent{
+		PhaseIndex: int32(p.phaseIndex),
+		PhaseCount: int32(p.phaseCount),
+		Phase:      p.phase,
+		Task:       p.task,
+		Action:     action,
+	}
+}
+
+func isUpgradeSequence(seq runtime.Sequence) bool {
+	switch seq { //nolint:exhaustive
+	case runtime.SequenceUpgrade, runtime.SequenceStageUpgrade, runtime.SequenceMaintenanceUpgrade:
+		return true
+	default:
+		return false
+	}
+}
+
+// reportUpgradeProgress publishes the progress of the upgrade task.
+//
+// If the task is not run as part of the upgrade sequence, progress is not reported.
+func reportUpgradeProgress(ctx context.Context, r runtime.Runtime, message string, percent int) {
+	p, ok := ctx.Value(upgradeProgressKey{}).(upgradeProgress)
+	if !ok {
+		return
+	}
+
+	ev := p.event(machine.UpgradeProgressEvent_PROGRESS)
+	ev.Percent = int32(percent)
+	ev.Message = message
+
+	r.Events().Publish(ctx, ev)
+}
@@ -7,7 +7,7 @@ package v1alpha2
 import (
 	"context"
 	"fmt"
-	"net/url"
+	"reflect"
 	"sync"
 	"time"
 
@@ -15,11 +15,11 @@ import (
 	osruntime "github.com/cosi-project/runtime/pkg/controller/runtime"
 	"github.com/cosi-project/runtime/pkg/resource"
 	"github.com/cosi-project/runtime/pkg/state"
-	"github.com/siderolabs/gen/slices"
 	"github.com/siderolabs/go-procfs/procfs"
 	"go.uber.org/zap"
 	"go.uber.org/zap/zapcore"
 
+	"github.com/siderolabs/talos/internal/app/machined/pkg/controllers/block"
 	"github.com/siderolabs/talos/internal/app/machined/pkg/controllers/cluster"
 	"github.com/siderolabs/talos/internal/app/machined/pkg/controllers/config"
 	"github.com/siderolabs/talos/internal/app/machined/pkg/controllers/cri"
@@ -40,7 +40,7 @@ import (
 	runtimelogging "github.com/siderolabs/talos/internal/app/machined/pkg/runtime/logging"
 	"github.com/siderolabs/talos/internal/app/machined/pkg/system"
 	"github.com/siderolabs/talos/pkg/logging"
-	talosconfig "github.com/siderolabs/talos/pkg/machinery/config"
+	talosconfig "github.com/siderolabs/talos/pkg/machinery/config/config"
 	"github.com/siderolabs/talos/pkg/machinery/constants"
 	configresource "github.com/siderolabs/talos/pkg/machinery/resources/config"
 )
@@ -85,6 +85,13 @@ func (ctrl *Controller) Run(ctx context.Context, drainer *runtime.Drainer) error
 	go ctrl.watchMachineConfig(ctx)
 
 	for _, c := range []controller.Controller{
+		&block.DevicesController{
+			V1Alpha1Mode: ctrl.v1alpha1Runtime.State().Platform().Mode(),
+		},
+		&block.DiscoveryController{},
+		&block.UserVolumeController{
+			V1Alpha1Mode: ctrl.v1alpha1Runtime.State().Platform().Mode(),
+		},
 		&cluster.AffiliateMergeController{},
 		&cluster.ConfigController{},
 		&cluster.DiscoveryServiceController{},
@@ -105,6 +112,7 @@ func (ctrl *Controller) Run(ctx context.Context, drainer *runtime.Drainer) error
 			SeccompProfilesDirectory: constants.SeccompProfilesDirectory,
 		},
 		&etcd.AdvertisedPeerController{},
+		&etcd.BackupController{},
 		&etcd.ConfigController{},
 		&etcd.PKIController{},
 		&etcd.SpecController{},
@@ -168,8 +176,15 @@ func (ctrl *Controller) Run(ctx context.Context, drainer *runtime.Drainer) error
 		&network.AddressSpecController{},
 		&network.AddressStatusController{},
 		&network.DeviceConfigController{},
-		&network.EtcFileController{},
+		&network.DNSResolveCacheController{},
+		&network.EthernetConfigController{},
+		&network.EthernetSpecController{},
+		&network.EthernetStatusController{},
+		&network.EtcFileController{
+			PodResolvConfPath: constants.PodResolvConfPath,
+		},
 		&network.HardwareAddrController{},
+		&network.HostDNSConfigController{},
 		&network.HostnameConfigController{
 			Cmdline: procfs.ProcCmdline(),
 		},
@@ -183,6 +198,13 @@ func (ctrl *Controller) Run(ctx context.Context, drainer *runtime.Drainer) error
 		&network.LinkMergeController{},
 		&network.LinkSpecController{},
 		&network.LinkStatusController{},
+		&network.LLDPController{},
+		&network.NeighborConfigController{},
+		&network.NeighborMergeController{},
+		&network.NeighborSpecController{},
+		&network.NeighborStatusController{},
+		&network.NfTablesChainConfigController{},
+		&network.NfTablesChainController{},
 		&network.NodeAddressController{},
 		&network.OperatorConfigController{
 			Cmdline: procfs.ProcCmdline(),
@@ -199,6 +221,7 @@ func (ctrl *Controller) Run(ctx context.Context, drainer *runtime.Drainer) error
 			V1alpha1Platform: ctrl.v1alpha1Runtime.State().Platform(),
 			PlatformState:    ctrl.v1alpha1Runtime.State().V1Alpha2().Resources(),
 		},
+		&network.ProbeConfigController{},
 		&network.ProbeController{},
 		&network.ResolverConfigController{
 			Cmdline: procfs.ProcCmdline(),
@@ -211,12 +234,20 @@ func (ctrl *Controller) Run(ctx context.Context, drainer *runtime.Drainer) error
 		&network.RouteMergeController{},
 		&network.RouteSpecController{},
 		&network.RouteStatusController{},
+		&network.RoutingRuleConfigController{},
+		&network.RoutingRuleMergeController{},
+		&network.RoutingRuleSpecController{},
+		&network.RoutingRuleStatusController{},
 		&network.StatusController{},
 		&network.TimeServerConfigController{
 			Cmdline: procfs.ProcCmdline(),
 		},
 		&network.TimeServerMergeController{},
 		&network.TimeServerSpecController{},
+		&network.TrafficControlConfigController{},
+		&network.TrafficControlMergeController{},
+		&network.TrafficControlSpecController{},
+		&network.TrafficControlStatusController{},
 		&perf.StatsController{},
 		&runtimecontrollers.CRIImageGCController{},
 		&runtimecontrollers.DevicesStatusController{
@@ -258,6 +289,9 @@ func (ctrl *Controller) Run(ctx context.Context, drainer *runtime.Drainer) error
 		&runtimecontrollers.MachineStatusPublisherController{
 			V1Alpha1Events: ctrl.v1alpha1Runtime.Events(),
 		},
+		&runtimecontrollers.PersistentLogsController{
+			V1Alpha1Logging: ctrl.v1alpha1Runtime.Logging,
+		},
 		&secrets.APICertSANsController{},
 		&secrets.APIController{},
 		&secrets.EtcdController{},
@@ -304,7 +338,7 @@ func (ctrl *Controller) watchMachineConfig(ctx context.Context) {
 		return
 	}
 
-	var loggingEndpoints []*url.URL
+	var loggingDestinations []talosconfig.LoggingDestination
 
 	for {
 		var cfg talosconfig.Config
@@ -321,7 +355,7 @@ func (ctrl *Controller) watchMachineConfig(ctx context.Context) {
 		}
 
 		ctrl.updateConsoleLoggingConfig(cfg)
-		ctrl.updateLoggingConfig(ctx, cfg, &loggingEndpoints)
+		ctrl.updateLoggingConfig(ctx, cfg, &loggingDestinations)
 	}
 }
 
@@ -337,46 +371,52 @@ func (ctrl *Controller) updateConsoleLoggingConfig(cfg talosconfig.Config) {
 	}
 }
 
-func (ctrl *Controller) updateLoggingConfig(ctx context.Context, cfg talosconfig.Config, prevLoggingEndpoints *[]*url.URL) {
+func (ctrl *Controller) updateLoggingConfig(ctx context.Context, cfg talosconfig.Config, prevLoggingDestinations *[]talosconfig.LoggingDestination) {
 	dests := cfg.Machine().Logging().Destinations()
-	loggingEndpoints := make([]*url.URL, len(dests))
-
-	for i, dest := range dests {
-		switch f := dest.Format(); f {
-		case constants.LoggingFormatJSONLines:
-			loggingEndpoints[i] = dest.Endpoint()
-		default:
-			// should not be possible due to validation
-			panic(fmt.Sprintf("unhandled log destination format %q", f))
-		}
-	}
-
-	loggingChanged := len(*prevLoggingEndpoints) != len(loggingEndpoints)
-	if !loggingChanged {
-		for i, u := range *prevLoggingEndpoints {
-			if u.String() != loggingEndpoints[i].String() {
-				loggingChanged = true
 
-				break
-			}
-		}
-	}
-
-	if !loggingChanged {
+	if reflect.DeepEqual(*prevLoggingDestinations, dests) {
 		return
 	}
 
-	*prevLoggingEndpoints = loggingEndpoints
+	*prevLoggingDestinations = dests
 
 	var prevSenders []runtime.LogSender
 
-	if len(loggingEndpoints) > 0 {
-		senders := slices.Map(loggingEndpoints, runtimelogging.NewJSONLines)
+	if len(dests) > 0 {
+		senders := make([]runtime.LogSender, 0, len(dests))
+
+		for _, dest := range dests {
+			var opts []runtimelogging.SenderOption
+
+			if tlsCfg := dest.TLS(); tlsCfg != nil {
+				tlsConfig, err := tlsCfg.GetTLSConfig()
+				if err != nil {
+					// should not be possible due to validation
+					ctrl.logger.Error("invalid log destination TLS config", zap.Stringer("endpoint", dest.Endpoint()), zap.Error(err))
+
+					continue
+				}
+
+				opts = append(opts, runtimelogging.WithTLSConfig(tlsConfig))
+			}
+
+			switch f := dest.Format(); f {
+			case constants.LoggingFormatJSONLines:
+				senders = append(senders, runtimelogging.NewJSONLines(dest.Endpoint(), opts...))
+			case constants.LoggingFormatSyslog:
+				senders = append(senders, runtimelogging.NewSyslog(dest.Endpoint(), opts...))
+			case constants.LoggingFormatGELF:
+				senders = append(senders, runtimelogging.NewGELF(dest.Endpoint(), opts...))
+			default:
+				// should not be possible due to validation
+				panic(fmt.Sprintf("unhandled log destination format %q", f))
+			}
+		}
 
-		ctrl.logger.Info("enabling JSON logging")
+		ctrl.logger.Info("enabling remote logging")
 		prevSenders = ctrl.loggingManager.SetSenders(senders)
 	} else {
-		ctrl.logger.Info("disabling JSON logging")
+		ctrl.logger.Info("disabling remote logging")
 		prevSenders = ctrl.loggingManager.SetSenders(nil)
 	}
 
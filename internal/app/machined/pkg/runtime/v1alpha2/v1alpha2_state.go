@@ -14,6 +14,7 @@ import (
 	"github.com/cosi-project/runtime/pkg/state/registry"
 
 	talosconfig "github.com/siderolabs/talos/pkg/machinery/config"
+	"github.com/siderolabs/talos/pkg/machinery/resources/block"
 	"github.com/siderolabs/talos/pkg/machinery/resources/cluster"
 	"github.com/siderolabs/talos/pkg/machinery/resources/config"
 	"github.com/siderolabs/talos/pkg/machinery/resources/cri"
@@ -95,6 +96,9 @@ func NewState() (*State, error) {
 	// register Talos resources
 	for _, r := range []meta.ResourceWithRD{
 		&v1alpha1.Service{},
+		&block.BlockDevice{},
+		&block.DiscoveredVolume{},
+		&block.VolumeStatus{},
 		&cluster.Affiliate{},
 		&cluster.Config{},
 		&cluster.Identity{},
@@ -107,6 +111,7 @@ func NewState() (*State, error) {
 		&etcd.PKIStatus{},
 		&etcd.Spec{},
 		&etcd.Member{},
+		&etcd.Snapshot{},
 		&files.EtcFileSpec{},
 		&files.EtcFileStatus{},
 		&hardware.Processor{},
@@ -149,12 +154,20 @@ func NewState() (*State, error) {
 		&network.AddressStatus{},
 		&network.AddressSpec{},
 		&network.DeviceConfigSpec{},
+		&network.DNSResolveCache{},
+		&network.EthernetSpec{},
+		&network.EthernetStatus{},
 		&network.HardwareAddr{},
+		&network.HostDNSConfig{},
 		&network.HostnameStatus{},
 		&network.HostnameSpec{},
 		&network.LinkRefresh{},
 		&network.LinkStatus{},
 		&network.LinkSpec{},
+		&network.LLDPNeighbor{},
+		&network.NeighborSpec{},
+		&network.NeighborStatus{},
+		&network.NfTablesChain{},
 		&network.NodeAddress{},
 		&network.NodeAddressFilter{},
 		&network.OperatorSpec{},
@@ -164,9 +177,13 @@ func NewState() (*State, error) {
 		&network.ResolverSpec{},
 		&network.RouteStatus{},
 		&network.RouteSpec{},
+		&network.RoutingRuleStatus{},
+		&network.RoutingRuleSpec{},
 		&network.Status{},
 		&network.TimeServerStatus{},
 		&network.TimeServerSpec{},
+		&network.TrafficControlSpec{},
+		&network.TrafficControlStatus{},
 		&perf.CPU{},
 		&perf.Memory{},
 		&runtime.DevicesStatus{},
@@ -9,6 +9,7 @@ import (
 	"fmt"
 	"net/http"
 	"os"
+	"path/filepath"
 	"time"
 
 	containerdapi "github.com/containerd/containerd"
@@ -71,6 +72,11 @@ func (k *Kubelet) PreFunc(ctx context.Context, r runtime.Runtime) error {
 		return err
 	}
 
+	// Create the directory for the pod resolv.conf of the host DNS resolver, it is mounted into the kubelet.
+	if err = os.MkdirAll(filepath.Dir(constants.PodResolvConfPath), 0o755); err != nil {
+		return err
+	}
+
 	// Create lifecycle resource to signal that the kubelet is about to start.
 	err = r.State().V1Alpha2().Resources().Create(ctx, k8s.NewKubeletLifecycle(k8s.NamespaceName, k8s.KubeletLifecycleID))
 	if err != nil && !state.IsConflictError(err) { // ignore if the lifecycle resource already exists
@@ -129,6 +135,7 @@ func (k *Kubelet) Runner(r runtime.Runtime) (runner.Runner, error) {
 		{Type: "bind", Destination: "/var/lib/kubelet", Source: "/var/lib/kubelet", Options: []string{"rbind", "rshared", "rw"}},
 		{Type: "bind", Destination: "/var/log/containers", Source: "/var/log/containers", Options: []string{"rbind", "rshared", "rw"}},
 		{Type: "bind", Destination: "/var/log/pods", Source: "/var/log/pods", Options: []string{"rbind", "rshared", "rw"}},
+		{Type: "bind", Destination: filepath.Dir(constants.PodResolvConfPath), Source: filepath.Dir(constants.PodResolvConfPath), Options: []string{"bind", "ro"}},
 	}
 
 	// Add extra mounts.
@@ -264,67 +264,13 @@ func (s *singleton) StopWithRevDepenencies(ctx context.Context, serviceIDs ...st
 
 //nolint:gocyclo
 func (s *singleton) stopServices(ctx context.Context, services []string, waitForRevDependencies bool) error {
-	servicesToStop := make(map[string]*ServiceRunner)
-
-	if services == nil {
-		for name, svcrunner := range s.state {
-			servicesToStop[name] = svcrunner
-		}
-	} else {
-		for _, name := range services {
-			if _, ok := s.state[name]; !ok {
-				continue
-			}
+	// expand the list of services to stop with services which depend on the one being stopped
+	servicesToStop := s.selectServices(services, waitForRevDependencies)
 
-			servicesToStop[name] = s.state[name]
-		}
-	}
-
-	// build reverse dependencies, and expand the list of services to stop
-	// with services which depend on the one being stopped
+	// build reverse dependencies
 	reverseDependencies := make(map[string][]string)
 
 	if waitForRevDependencies {
-		// expand the list of services to stop with the list of services which depend
-		// on the ones being stopped
-		// the loop is run as long as more dependencies are added to the list
-		for {
-			expanded := false
-
-			for name, svcrunner := range s.state {
-				if _, scheduledToStop := servicesToStop[name]; scheduledToStop {
-					continue
-				}
-
-				dependencies := svcrunner.service.DependsOn(s.runtime)
-
-				shouldStopService := false
-
-				for _, dependency := range dependencies {
-					for scheduledService := range servicesToStop {
-						if scheduledService == dependency {
-							shouldStopService = true
-
-							break
-						}
-					}
-
-					if shouldStopService {
-						break
-					}
-				}
-
-				if shouldStopService {
-					servicesToStop[name] = svcrunner
-					expanded = true
-				}
-			}
-
-			if !expanded {
-				break
-			}
-		}
-
 		// build a list of dependencies to wait for before stopping each of the services
 		for name, svcrunner := range servicesToStop {
 			for _, dependency := range svcrunner.service.DependsOn(s.runtime) {
@@ -368,6 +314,98 @@ func (s *singleton) stopServices(ctx context.Context, services []string, waitFor
 	return conditions.WaitForAll(stoppedConds...).Wait(ctx)
 }
 
+// StopPlan returns the sorted list of service IDs which would be stopped by StopWithRevDepenencies.
+//
+// If no services are given, it returns the services which would be stopped by Shutdown.
+func (s *singleton) StopPlan(serviceIDs ...string) []string {
+	s.mu.Lock()
+	servicesToStop := s.selectServices(serviceIDs, true)
+	s.mu.Unlock()
+
+	s.runningMu.Lock()
+	defer s.runningMu.Unlock()
+
+	result := make([]string, 0, len(servicesToStop))
+
+	for name := range servicesToStop {
+		if _, running := s.running[name]; running {
+			result = append(result, name)
+		}
+	}
+
+	sort.Strings(result)
+
+	return result
+}
+
+// selectServices returns the services to stop by IDs (all services if nil).
+//
+// If withRevDependencies is set, the list is expanded with the services which depend
+// on the ones being stopped.
+//
+// s.mu should be held.
+func (s *singleton) selectServices(services []string, withRevDependencies bool) map[string]*ServiceRunner {
+	servicesToStop := make(map[string]*ServiceRunner)
+
+	if services == nil {
+		for name, svcrunner := range s.state {
+			servicesToStop[name] = svcrunner
+		}
+	} else {
+		for _, name := range services {
+			if _, ok := s.state[name]; !ok {
+				continue
+			}
+
+			servicesToStop[name] = s.state[name]
+		}
+	}
+
+	if !withRevDependencies {
+		return servicesToStop
+	}
+
+	// the loop is run as long as more dependencies are added to the list
+	for {
+		expanded := false
+
+		for name, svcrunner := range s.state {
+			if _, scheduledToStop := servicesToStop[name]; scheduledToStop {
+				continue
+			}
+
+			dependencies := svcrunner.service.DependsOn(s.runtime)
+
+			shouldStopService := false
+
+			for _, dependency := range dependencies {
+				for scheduledService := range servicesToStop {
+					if scheduledService == dependency {
+						shouldStopService = true
+
+						break
+					}
+				}
+
+				if shouldStopService {
+					break
+				}
+			}
+
+			if shouldStopService {
+				servicesToStop[name] = svcrunner
+				expanded = true
+			}
+		}
+
+		if !expanded {
+			break
+		}
+	}
+
+	return servicesToStop
+}
+
 // List returns snapshot of ServiceRunner instances.
 func (s *singleton) List() (result []*ServiceRunner) {
 	s.mu.Lock()
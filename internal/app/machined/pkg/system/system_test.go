@@ -42,6 +42,29 @@ func (suite *SystemServicesSuite) TestStartStop() {
 	suite.Assert().NoError(err)
 }
 
+func (suite *SystemServicesSuite) TestStopPlan() {
+	system.Services(nil).LoadAndStart(
+		&MockService{name: "plan-cri"},
+		&MockService{name: "plan-networkd", dependencies: []string{"plan-cri"}},
+		&MockService{name: "plan-vland", dependencies: []string{"plan-networkd"}},
+		&MockService{name: "plan-other"},
+	)
+	time.Sleep(10 * time.Millisecond)
+
+	suite.Assert().Equal([]string{"plan-cri", "plan-networkd", "plan-vland"}, system.Services(nil).StopPlan("plan-cri"))
+	suite.Assert().Equal([]string{"plan-vland"}, system.Services(nil).StopPlan("plan-vland"))
+	suite.Assert().Contains(system.Services(nil).StopPlan(), "plan-other")
+
+	// nothing should be stopped
+	for _, name := range []string{"plan-cri", "plan-networkd", "plan-vland", "plan-other"} {
+		_, running, err := system.Services(nil).IsRunning(name)
+		suite.Require().NoError(err)
+		suite.Assert().True(running)
+	}
+
+	suite.Require().NoError(system.Services(nil).Stop(context.Background(), "plan-cri", "plan-networkd", "plan-vland", "plan-other"))
+}
+
 func (suite *SystemServicesSuite) TestStopWithRevDeps() {
 	system.Services(nil).LoadAndStart(
 		&MockService{name: "cri"},
@@ -181,6 +181,24 @@ func (s *Server) Upgrade(ctx context.Context, in *machine.UpgradeRequest) (reply
 
 	log.Printf("upgrade request received: %q", in.GetImage())
 
+	if in.GetDryRun() {
+		var plan *machine.SequencePlan
+
+		plan, err = s.controller.Plan(runtime.SequenceMaintenanceUpgrade, in)
+		if err != nil {
+			return nil, fmt.Errorf("error planning upgrade: %w", err)
+		}
+
+		return &machine.UpgradeResponse{
+			Messages: []*machine.Upgrade{
+				{
+					Ack:  "Upgrade dry run",
+					Plan: plan,
+				},
+			},
+		}, nil
+	}
+
 	runCtx := context.WithValue(context.Background(), runtime.ActorIDCtxKey{}, actorID)
 
 	go func() {
@@ -223,6 +241,10 @@ func (s *Server) Reset(ctx context.Context, in *machine.ResetRequest) (reply *ma
 		return nil, fmt.Errorf("system partitions to wipe params is not supported in the maintenance mode")
 	}
 
+	if in.GetDryRun() {
+		return nil, status.Errorf(codes.Unimplemented, "reset dry run is not supported in maintenance mode")
+	}
+
 	var dev *blockdevice.BlockDevice
 
 	disk := s.controller.Runtime().State().Machine().Disk(disk.WithPartitionLabel(constants.BootPartitionLabel))
@@ -40,6 +40,7 @@ type PullOption func(*PullOptions)
 // PullOptions configure Pull function.
 type PullOptions struct {
 	SkipIfAlreadyPulled bool
+	Progress            ProgressFunc
 }
 
 // WithSkipIfAlreadyPulled skips pulling if image is already pulled and unpacked.
@@ -49,6 +50,13 @@ func WithSkipIfAlreadyPulled() PullOption {
 	}
 }
 
+// WithProgress reports the progress of fetching the image layers.
+func WithProgress(f ProgressFunc) PullOption {
+	return func(opts *PullOptions) {
+		opts.Progress = f
+	}
+}
+
 var unpackDuplicationSuppressor = kmutex.New()
 
 // Pull is a convenience function that wraps the containerd image pull func with
@@ -74,19 +82,41 @@ func Pull(ctx context.Context, reg config.Registries, client *containerd.Client,
 
 	resolver := NewResolver(reg)
 
+	remoteOpts := []containerd.RemoteOpt{
+		containerd.WithPullUnpack,
+		containerd.WithResolver(resolver),
+		containerd.WithChildLabelMap(images.ChildGCLabelsFilterLayers),
+		containerd.WithUnpackOpts(
+			[]containerd.UnpackOpt{
+				containerd.WithUnpackDuplicationSuppressor(unpackDuplicationSuppressor),
+			},
+		),
+	}
+
+	if opts.Progress != nil {
+		// progress is shared between retries, as already fetched layers are not fetched again
+		progress := newPullProgress(opts.Progress)
+
+		remoteOpts = append(remoteOpts, containerd.WithImageHandlerWrapper(progress.wrap))
+
+		watchCtx, watchCancel := context.WithCancel(ctx)
+		watchDone := make(chan struct{})
+
+		go func() {
+			defer close(watchDone)
+
+			progress.watch(watchCtx, client.ContentStore())
+		}()
+
+		// stop reporting the progress before returning, so that the progress function is not called after Pull returns
+		defer func() {
+			watchCancel()
+			<-watchDone
+		}()
+	}
+
 	err = retry.Exponential(PullTimeout, retry.WithUnits(PullRetryInterval), retry.WithErrorLogging(true)).Retry(func() error {
-		if img, err = client.Pull(
-			ctx,
-			ref,
-			containerd.WithPullUnpack,
-			containerd.WithResolver(resolver),
-			containerd.WithChildLabelMap(images.ChildGCLabelsFilterLayers),
-			containerd.WithUnpackOpts(
-				[]containerd.UnpackOpt{
-					containerd.WithUnpackDuplicationSuppressor(unpackDuplicationSuppressor),
-				},
-			),
-		); err != nil {
+		if img, err = client.Pull(ctx, ref, remoteOpts...); err != nil {
 			err = fmt.Errorf("failed to pull image %q: %w", ref, err)
 
 			if errdefs.IsNotFound(err) || errdefs.IsCanceled(err) {
@@ -0,0 +1,144 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package image
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/containerd/containerd/content"
+	"github.com/containerd/containerd/images"
+	"github.com/opencontainers/go-digest"
+	ocispec "github.com/opencontainers/image-spec/specs-go/v1"
+)
+
+// progressInterval is the interval to poll the content store for the progress of the layers being fetched.
+const progressInterval = 500 * time.Millisecond
+
+// ProgressFunc is called each time the fetched size of the image layers changes.
+//
+// LayerFetched is the size of the layer fetched so far, the layer is fetched completely once it reaches the layer size.
+// Fetched and total are the sizes of the fetched and all image layers.
+// Calls are serialized, so the function doesn't need to be safe for concurrent use.
+//
+// Total size is known only after the image manifest is fetched, so it might grow while the image is being pulled.
+type ProgressFunc func(layer ocispec.Descriptor, layerFetched, fetched, total int64)
+
+// pullProgress tracks the size of the image layers fetched.
+//
+// Completed layers are tracked by the image handler, and the layers being fetched are tracked by polling
+// the active ingests of the content store.
+type pullProgress struct {
+	report ProgressFunc
+
+	mu      sync.Mutex
+	layers  map[digest.Digest]ocispec.Descriptor
+	fetched map[digest.Digest]struct{}
+	active  map[digest.Digest]int64
+}
+
+func newPullProgress(report ProgressFunc) *pullProgress {
+	return &pullProgress{
+		report:  report,
+		layers:  map[digest.Digest]ocispec.Descriptor{},
+		fetched: map[digest.Digest]struct{}{},
+		active:  map[digest.Digest]int64{},
+	}
+}
+
+// wrap the image handler to track the layers of the manifest, and the layers which are fetched.
+func (p *pullProgress) wrap(h images.Handler) images.Handler {
+	return images.HandlerFunc(func(ctx context.Context, desc ocispec.Descriptor) ([]ocispec.Descriptor, error) {
+		children, err := h.Handle(ctx, desc)
+		if err != nil {
+			return children, err
+		}
+
+		p.mu.Lock()
+		defer p.mu.Unlock()
+
+		for _, child := range children {
+			if images.IsLayerType(child.MediaType) {
+				p.layers[child.Digest] = child
+			}
+		}
+
+		if _, isLayer := p.layers[desc.Digest]; isLayer {
+			p.fetched[desc.Digest] = struct{}{}
+			delete(p.active, desc.Digest)
+
+			fetched, total := p.progress()
+
+			// report under the lock, so that the progress is reported in order
+			p.report(desc, desc.Size, fetched, total)
+		}
+
+		return children, nil
+	})
+}
+
+// watch polls the content store for the progress of the layers being fetched until the context is canceled.
+func (p *pullProgress) watch(ctx context.Context, store content.Store) {
+	ticker := time.NewTicker(progressInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+
+		statuses, err := store.ListStatuses(ctx)
+		if err != nil {
+			// progress is best effort, the pull itself reports the errors
+			continue
+		}
+
+		p.update(statuses)
+	}
+}
+
+// update the progress of the layers being fetched from the content store ingests.
+func (p *pullProgress) update(statuses []content.Status) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	for _, status := range statuses {
+		layer, isLayer := p.layers[status.Expected]
+		if !isLayer {
+			continue
+		}
+
+		if _, done := p.fetched[status.Expected]; done {
+			continue
+		}
+
+		if p.active[status.Expected] == status.Offset {
+			continue
+		}
+
+		p.active[status.Expected] = status.Offset
+
+		fetched, total := p.progress()
+
+		p.report(layer, status.Offset, fetched, total)
+	}
+}
+
+func (p *pullProgress) progress() (fetched, total int64) {
+	for dgst, layer := range p.layers {
+		total += layer.Size
+
+		if _, ok := p.fetched[dgst]; ok {
+			fetched += layer.Size
+		} else {
+			fetched += p.active[dgst]
+		}
+	}
+
+	return fetched, total
+}
@@ -0,0 +1,80 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+//nolint:testpackage
+package image
+
+import (
+	"context"
+	"testing"
+
+	"github.com/containerd/containerd/content"
+	"github.com/containerd/containerd/images"
+	"github.com/opencontainers/go-digest"
+	ocispec "github.com/opencontainers/image-spec/specs-go/v1"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPullProgress(t *testing.T) {
+	manifest := ocispec.Descriptor{MediaType: ocispec.MediaTypeImageManifest, Digest: digest.FromString("manifest"), Size: 100}
+	config := ocispec.Descriptor{MediaType: ocispec.MediaTypeImageConfig, Digest: digest.FromString("config"), Size: 10}
+	layer1 := ocispec.Descriptor{MediaType: ocispec.MediaTypeImageLayerGzip, Digest: digest.FromString("layer1"), Size: 300}
+	layer2 := ocispec.Descriptor{MediaType: ocispec.MediaTypeImageLayerGzip, Digest: digest.FromString("layer2"), Size: 100}
+
+	handler := images.HandlerFunc(func(_ context.Context, desc ocispec.Descriptor) ([]ocispec.Descriptor, error) {
+		if desc.Digest == manifest.Digest {
+			return []ocispec.Descriptor{config, layer1, layer2}, nil
+		}
+
+		return nil, nil
+	})
+
+	type progress struct {
+		layer                        digest.Digest
+		layerFetched, fetched, total int64
+	}
+
+	var reported []progress
+
+	p := newPullProgress(func(layer ocispec.Descriptor, layerFetched, fetched, total int64) {
+		reported = append(reported, progress{layer.Digest, layerFetched, fetched, total})
+	})
+
+	h := p.wrap(handler)
+
+	handle := func(desc ocispec.Descriptor) {
+		_, err := h.Handle(context.Background(), desc)
+		require.NoError(t, err)
+	}
+
+	handle(manifest)
+	handle(config)
+
+	p.update([]content.Status{
+		{Ref: "layer-" + layer1.Digest.String(), Expected: layer1.Digest, Offset: 100, Total: 300},
+		{Ref: "config-" + config.Digest.String(), Expected: config.Digest, Offset: 5, Total: 10}, // not a layer
+	})
+	p.update([]content.Status{
+		{Ref: "layer-" + layer1.Digest.String(), Expected: layer1.Digest, Offset: 100, Total: 300}, // no progress
+		{Ref: "layer-" + layer2.Digest.String(), Expected: layer2.Digest, Offset: 50, Total: 100},
+	})
+
+	handle(layer1)
+	handle(layer1)
+
+	p.update([]content.Status{
+		{Ref: "layer-" + layer1.Digest.String(), Expected: layer1.Digest, Offset: 300, Total: 300}, // already fetched
+	})
+
+	handle(layer2)
+
+	assert.Equal(t, []progress{
+		{layer1.Digest, 100, 100, 400},
+		{layer2.Digest, 50, 150, 400},
+		{layer1.Digest, 300, 350, 400},
+		{layer1.Digest, 300, 350, 400}, // layer fetched again on retry
+		{layer2.Digest, 100, 400, 400},
+	}, reported)
+}
@@ -8,6 +8,7 @@ import (
 	"context"
 	"fmt"
 	"sort"
+	"strconv"
 	"strings"
 
 	"github.com/gdamore/tcell/v2"
@@ -38,6 +39,7 @@ type networkConfigData struct {
 	newConfig      *runtime.PlatformNetworkConfig
 	newConfigError error
 	linkSet        map[string]struct{}
+	lldpNeighbors  map[string]*network.LLDPNeighborSpec
 }
 
 // NetworkConfigGrid represents the network configuration widget.
@@ -58,6 +60,7 @@ type NetworkConfigGrid struct {
 	infoView           *tview.TextView
 	existingConfigView *tview.TextView
 	newConfigView      *tview.TextView
+	neighborsView      *tview.TextView
 
 	selectedNode string
 	nodeMap      map[string]*networkConfigData
@@ -73,12 +76,13 @@ func NewNetworkConfigGrid(ctx context.Context, dashboard *Dashboard) *NetworkCon
 		infoView:           tview.NewTextView(),
 		existingConfigView: tview.NewTextView(),
 		newConfigView:      tview.NewTextView(),
+		neighborsView:      tview.NewTextView(),
 		nodeMap:            make(map[string]*networkConfigData),
 		dashboard:          dashboard,
 	}
 
 	widget.configForm.SetBorder(true).SetTitle("Configure (Ctrl+Q)")
-	widget.SetRows(0, 3).SetColumns(0, 0, 0)
+	widget.SetRows(0, 3, 8).SetColumns(0, 0, 0)
 
 	widget.infoView.
 		SetDynamicColors(true).
@@ -96,11 +100,18 @@ func NewNetworkConfigGrid(ctx context.Context, dashboard *Dashboard) *NetworkCon
 		SetBorderPadding(0, 0, 1, 0).
 		SetBorder(true).
 		SetTitle("New Config (Ctrl+E)")
+	widget.neighborsView.
+		SetDynamicColors(true).
+		SetScrollable(true).
+		SetBorderPadding(0, 0, 1, 0).
+		SetBorder(true).
+		SetTitle("LLDP Neighbors")
 
 	widget.AddItem(widget.configForm, 0, 0, 1, 1, 0, 0, false)
 	widget.AddItem(widget.infoView, 1, 0, 1, 1, 0, 0, false)
 	widget.AddItem(widget.existingConfigView, 0, 1, 2, 1, 0, 0, false)
 	widget.AddItem(widget.newConfigView, 0, 2, 2, 1, 0, 0, false)
+	widget.AddItem(widget.neighborsView, 2, 0, 1, 3, 0, 0, false)
 
 	widget.hostnameField = tview.NewInputField().SetLabel(formItemHostname)
 	widget.hostnameField.SetBlurFunc(widget.formEdited)
@@ -316,6 +327,46 @@ func (widget *NetworkConfigGrid) redraw() {
 
 		widget.newConfigView.SetText(fmt.Sprintf("[green]%s[-]", tview.Escape(buf.String())))
 	}
+
+	widget.redrawNeighbors(data)
+}
+
+func (widget *NetworkConfigGrid) redrawNeighbors(data *networkConfigData) {
+	if len(data.lldpNeighbors) == 0 {
+		widget.neighborsView.SetText("[gray]No Neighbors[-]")
+
+		return
+	}
+
+	ids := maps.Keys(data.lldpNeighbors)
+
+	sort.Strings(ids)
+
+	var buf strings.Builder
+
+	for _, id := range ids {
+		neighbor := data.lldpNeighbors[id]
+
+		systemName := neighbor.SystemName
+		if systemName == "" {
+			systemName = "n/a"
+		}
+
+		vlan := "n/a"
+		if neighbor.VLANID != 0 {
+			vlan = strconv.Itoa(int(neighbor.VLANID))
+		}
+
+		fmt.Fprintf(&buf, "[::b]%s[::-] -> %s port %s (chassis %s, VLAN %s)\n",
+			tview.Escape(neighbor.LinkName),
+			tview.Escape(systemName),
+			tview.Escape(neighbor.PortID),
+			tview.Escape(neighbor.ChassisID),
+			vlan,
+		)
+	}
+
+	widget.neighborsView.SetText(buf.String())
 }
 
 func (widget *NetworkConfigGrid) clearForm() {
@@ -358,6 +409,12 @@ func (widget *NetworkConfigGrid) updateNodeData(data resourcedata.Data) {
 		widget.interfaceDropdown.SetOptions(allLinks, func(_ string, _ int) {
 			widget.formEdited()
 		})
+	case *network.LLDPNeighbor:
+		if data.Deleted {
+			delete(nodeData.lldpNeighbors, res.Metadata().ID())
+		} else {
+			nodeData.lldpNeighbors[res.Metadata().ID()] = res.TypedSpec()
+		}
 	case *runtimeres.MetaKey:
 		if res.Metadata().ID() == runtimeres.MetaKeyTagToID(meta.MetalNetworkPlatformConfig) {
 			if data.Deleted {
@@ -383,7 +440,8 @@ func (widget *NetworkConfigGrid) getOrCreateNodeData(node string) *networkConfig
 	nodeData, ok := widget.nodeMap[node]
 	if !ok {
 		nodeData = &networkConfigData{
-			linkSet: make(map[string]struct{}),
+			linkSet:       make(map[string]struct{}),
+			lldpNeighbors: make(map[string]*network.LLDPNeighborSpec),
 		}
 
 		widget.nodeMap[node] = nodeData
@@ -170,6 +170,10 @@ func (source *Source) runResourceWatch(ctx context.Context, node string) error {
 		return err
 	}
 
+	if err := source.COSI.WatchKind(ctx, network.NewLLDPNeighbor(network.NamespaceName, "").Metadata(), eventCh, state.WithBootstrapContents(true)); err != nil {
+		return err
+	}
+
 	for {
 		select {
 		case <-ctx.Done():
@@ -0,0 +1,220 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package dns
+
+import (
+	"sync"
+	"time"
+
+	"golang.org/x/net/dns/dnsmessage"
+)
+
+const (
+	// DefaultCacheSize is the default maximum number of cached responses.
+	DefaultCacheSize = 4096
+
+	// MaxCacheTTL caps the time responses are cached for.
+	MaxCacheTTL = time.Hour
+
+	// NegativeCacheTTL is used for negative responses without SOA record.
+	NegativeCacheTTL = 30 * time.Second
+)
+
+// CacheKey identifies the cached response.
+type CacheKey struct {
+	Name  string
+	Type  dnsmessage.Type
+	Class dnsmessage.Class
+}
+
+// NewCacheKey returns the key for the question of class IN.
+func NewCacheKey(name string, qtype dnsmessage.Type) CacheKey {
+	return CacheKey{Name: canonicalName(name), Type: qtype, Class: dnsmessage.ClassINET}
+}
+
+type cacheEntry struct {
+	msg      *dnsmessage.Message
+	storedAt time.Time
+	expires  time.Time
+}
+
+// Cache stores DNS responses until their TTL expires.
+type Cache struct {
+	mu      sync.Mutex
+	entries map[CacheKey]cacheEntry
+	size    int
+}
+
+// NewCache creates a new Cache which holds up to size entries.
+func NewCache(size int) *Cache {
+	return &Cache{
+		entries: make(map[CacheKey]cacheEntry),
+		size:    size,
+	}
+}
+
+// Get returns a copy of the cached response with TTLs adjusted by the time spent in the cache.
+func (c *Cache) Get(key CacheKey, now time.Time) *dnsmessage.Message {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	entry, ok := c.entries[key]
+	if !ok {
+		return nil
+	}
+
+	if !now.Before(entry.expires) {
+		delete(c.entries, key)
+
+		return nil
+	}
+
+	elapsed := uint32(now.Sub(entry.storedAt) / time.Second)
+
+	msg := *entry.msg
+	msg.Answers = adjustTTL(entry.msg.Answers, elapsed)
+	msg.Authorities = adjustTTL(entry.msg.Authorities, elapsed)
+	msg.Additionals = adjustTTL(entry.msg.Additionals, elapsed)
+
+	return &msg
+}
+
+// Put stores the response if it is cacheable.
+func (c *Cache) Put(key CacheKey, msg *dnsmessage.Message, now time.Time) {
+	ttl, ok := cacheTTL(msg)
+	if !ok {
+		return
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if len(c.entries) >= c.size {
+		c.evict(now)
+	}
+
+	c.entries[key] = cacheEntry{
+		msg:      msg,
+		storedAt: now,
+		expires:  now.Add(ttl),
+	}
+}
+
+// Flush removes all cached entries.
+func (c *Cache) Flush() {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.entries = make(map[CacheKey]cacheEntry)
+}
+
+// Len returns the number of cached entries.
+func (c *Cache) Len() int {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	return len(c.entries)
+}
+
+func (c *Cache) evict(now time.Time) {
+	for key, entry := range c.entries {
+		if !now.Before(entry.expires) {
+			delete(c.entries, key)
+		}
+	}
+
+	// drop arbitrary entries if the cache is still full
+	for key := range c.entries {
+		if len(c.entries) < c.size {
+			break
+		}
+
+		delete(c.entries, key)
+	}
+}
+
+// cacheTTL returns the time the response can be cached for.
+func cacheTTL(msg *dnsmessage.Message) (time.Duration, bool) {
+	var ttl uint32
+
+	switch msg.Header.RCode { //nolint:exhaustive
+	case dnsmessage.RCodeSuccess:
+		if len(msg.Answers) == 0 {
+			ttl = negativeTTL(msg)
+
+			break
+		}
+
+		ttl = minTTL(msg.Answers)
+	case dnsmessage.RCodeNameError:
+		ttl = negativeTTL(msg)
+	default:
+		return 0, false
+	}
+
+	if ttl == 0 {
+		return 0, false
+	}
+
+	duration := time.Duration(ttl) * time.Second
+
+	if duration > MaxCacheTTL {
+		duration = MaxCacheTTL
+	}
+
+	return duration, true
+}
+
+// negativeTTL follows RFC 2308: the minimum of the SOA record TTL and its MINIMUM field.
+func negativeTTL(msg *dnsmessage.Message) uint32 {
+	for _, rr := range msg.Authorities {
+		if soa, ok := rr.Body.(*dnsmessage.SOAResource); ok {
+			if soa.MinTTL < rr.Header.TTL {
+				return soa.MinTTL
+			}
+
+			return rr.Header.TTL
+		}
+	}
+
+	return uint32(NegativeCacheTTL / time.Second)
+}
+
+func minTTL(records []dnsmessage.Resource) uint32 {
+	ttl := records[0].Header.TTL
+
+	for _, rr := range records[1:] {
+		if rr.Header.TTL < ttl {
+			ttl = rr.Header.TTL
+		}
+	}
+
+	return ttl
+}
+
+func adjustTTL(records []dnsmessage.Resource, elapsed uint32) []dnsmessage.Resource {
+	if records == nil {
+		return nil
+	}
+
+	adjusted := make([]dnsmessage.Resource, len(records))
+
+	for i, rr := range records {
+		adjusted[i] = rr
+
+		// OPT pseudo-record uses TTL field for flags
+		if rr.Header.Type == dnsmessage.TypeOPT {
+			continue
+		}
+
+		if rr.Header.TTL > elapsed {
+			adjusted[i].Header.TTL -= elapsed
+		} else {
+			adjusted[i].Header.TTL = 0
+		}
+	}
+
+	return adjusted
+}
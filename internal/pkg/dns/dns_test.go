@@ -0,0 +1,287 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package dns_test
+
+import (
+	"context"
+	"net"
+	"net/netip"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap/zaptest"
+	"golang.org/x/net/dns/dnsmessage"
+
+	"github.com/siderolabs/talos/internal/pkg/dns"
+)
+
+func buildQuery(t *testing.T, name string, qtype dnsmessage.Type) []byte {
+	t.Helper()
+
+	msg := dnsmessage.Message{
+		Header: dnsmessage.Header{
+			ID:               42,
+			RecursionDesired: true,
+		},
+		Questions: []dnsmessage.Question{
+			{
+				Name:  dnsmessage.MustNewName(name),
+				Type:  qtype,
+				Class: dnsmessage.ClassINET,
+			},
+		},
+	}
+
+	query, err := msg.Pack()
+	require.NoError(t, err)
+
+	return query
+}
+
+func parseResponse(t *testing.T, response []byte) dnsmessage.Message {
+	t.Helper()
+
+	var msg dnsmessage.Message
+
+	require.NoError(t, msg.Unpack(response))
+
+	return msg
+}
+
+// mockUpstream answers all A queries with the address and counts the queries per server.
+type mockUpstream struct {
+	mu      sync.Mutex
+	queries map[netip.AddrPort]int
+	addr    [4]byte
+	ttl     uint32
+}
+
+func (mock *mockUpstream) exchange(_ context.Context, _ string, server netip.AddrPort, query []byte) ([]byte, error) {
+	mock.mu.Lock()
+	mock.queries[server]++
+	mock.mu.Unlock()
+
+	var msg dnsmessage.Message
+
+	if err := msg.Unpack(query); err != nil {
+		return nil, err
+	}
+
+	msg.Header.Response = true
+	msg.Answers = []dnsmessage.Resource{
+		{
+			Header: dnsmessage.ResourceHeader{
+				Name:  msg.Questions[0].Name,
+				Type:  dnsmessage.TypeA,
+				Class: dnsmessage.ClassINET,
+				TTL:   mock.ttl,
+			},
+			Body: &dnsmessage.AResource{A: mock.addr},
+		},
+	}
+
+	return msg.Pack()
+}
+
+func (mock *mockUpstream) count(server netip.AddrPort) int {
+	mock.mu.Lock()
+	defer mock.mu.Unlock()
+
+	return mock.queries[server]
+}
+
+func TestHandlerHosts(t *testing.T) {
+	handler := dns.NewHandler(zaptest.NewLogger(t))
+	handler.SetHosts(map[string][]netip.Addr{
+		"Registry.Local": {netip.MustParseAddr("10.5.0.1"), netip.MustParseAddr("fd00::1")},
+	})
+
+	response, err := handler.Handle(context.Background(), "udp", buildQuery(t, "registry.local.", dnsmessage.TypeA))
+	require.NoError(t, err)
+
+	msg := parseResponse(t, response)
+	assert.EqualValues(t, 42, msg.Header.ID)
+	assert.Equal(t, dnsmessage.RCodeSuccess, msg.Header.RCode)
+	require.Len(t, msg.Answers, 1)
+	assert.Equal(t, [4]byte{10, 5, 0, 1}, msg.Answers[0].Body.(*dnsmessage.AResource).A)
+
+	response, err = handler.Handle(context.Background(), "udp", buildQuery(t, "registry.local.", dnsmessage.TypeAAAA))
+	require.NoError(t, err)
+
+	msg = parseResponse(t, response)
+	require.Len(t, msg.Answers, 1)
+	assert.Equal(t, netip.MustParseAddr("fd00::1").As16(), msg.Answers[0].Body.(*dnsmessage.AAAAResource).AAAA)
+}
+
+func TestHandlerForward(t *testing.T) {
+	upstream := netip.MustParseAddrPort("1.1.1.1:53")
+	corp := netip.MustParseAddrPort("10.0.0.53:53")
+	lab := netip.MustParseAddrPort("10.0.1.53:5353")
+
+	mock := &mockUpstream{
+		queries: map[netip.AddrPort]int{},
+		addr:    [4]byte{192, 168, 0, 1},
+		ttl:     60,
+	}
+
+	handler := dns.NewHandler(zaptest.NewLogger(t))
+	handler.Exchange = mock.exchange
+	handler.SetUpstreams([]netip.AddrPort{upstream})
+	handler.SetForwardRules([]dns.ForwardRule{
+		{Domain: "corp.example", Servers: []netip.AddrPort{corp}},
+		{Domain: "lab.corp.example.", Servers: []netip.AddrPort{lab}},
+	})
+
+	for _, test := range []struct {
+		name   string
+		server netip.AddrPort
+	}{
+		{name: "www.example.com.", server: upstream},
+		{name: "corp.example.", server: corp},
+		{name: "git.corp.example.", server: corp},
+		{name: "ci.lab.corp.example.", server: lab},
+		{name: "notcorp.example.", server: upstream},
+	} {
+		t.Run(test.name, func(t *testing.T) {
+			before := mock.count(test.server)
+
+			response, err := handler.Handle(context.Background(), "udp", buildQuery(t, test.name, dnsmessage.TypeA))
+			require.NoError(t, err)
+
+			msg := parseResponse(t, response)
+			require.Len(t, msg.Answers, 1)
+			assert.Equal(t, before+1, mock.count(test.server))
+
+			// the second query is served from the cache
+			response, err = handler.Handle(context.Background(), "udp", buildQuery(t, test.name, dnsmessage.TypeA))
+			require.NoError(t, err)
+
+			msg = parseResponse(t, response)
+			assert.EqualValues(t, 42, msg.Header.ID)
+			require.Len(t, msg.Answers, 1)
+			assert.Equal(t, before+1, mock.count(test.server))
+		})
+	}
+}
+
+func TestHandlerNoUpstreams(t *testing.T) {
+	handler := dns.NewHandler(zaptest.NewLogger(t))
+
+	response, err := handler.Handle(context.Background(), "udp", buildQuery(t, "example.com.", dnsmessage.TypeA))
+	require.NoError(t, err)
+
+	msg := parseResponse(t, response)
+	assert.Equal(t, dnsmessage.RCodeServerFailure, msg.Header.RCode)
+}
+
+func TestCache(t *testing.T) {
+	cache := dns.NewCache(2)
+
+	now := time.Now()
+
+	response := func(ttl uint32) *dnsmessage.Message {
+		return &dnsmessage.Message{
+			Header: dnsmessage.Header{Response: true},
+			Answers: []dnsmessage.Resource{
+				{
+					Header: dnsmessage.ResourceHeader{
+						Name:  dnsmessage.MustNewName("example.com."),
+						Type:  dnsmessage.TypeA,
+						Class: dnsmessage.ClassINET,
+						TTL:   ttl,
+					},
+					Body: &dnsmessage.AResource{A: [4]byte{1, 2, 3, 4}},
+				},
+			},
+		}
+	}
+
+	key := dns.NewCacheKey("example.com.", dnsmessage.TypeA)
+
+	cache.Put(key, response(30), now)
+
+	cached := cache.Get(key, now.Add(10*time.Second))
+	require.NotNil(t, cached)
+	assert.EqualValues(t, 20, cached.Answers[0].Header.TTL)
+
+	assert.Nil(t, cache.Get(key, now.Add(30*time.Second)))
+
+	// zero TTL is not cached
+	cache.Put(key, response(0), now)
+	assert.Nil(t, cache.Get(key, now))
+
+	// the size of the cache is limited
+	for _, name := range []string{"a.", "b.", "c."} {
+		cache.Put(dns.NewCacheKey(name, dnsmessage.TypeA), response(30), now)
+	}
+
+	assert.Equal(t, 2, cache.Len())
+}
+
+func TestServer(t *testing.T) {
+	logger := zaptest.NewLogger(t)
+
+	// upstream server serves the static hosts
+	upstreamHandler := dns.NewHandler(logger)
+	upstreamHandler.SetHosts(map[string][]netip.Addr{
+		"talos.example": {netip.MustParseAddr("10.5.0.2")},
+	})
+
+	upstreamAddr := freeAddr(t)
+
+	upstream, err := dns.NewServer(upstreamAddr, upstreamHandler, logger)
+	require.NoError(t, err)
+
+	upstream.Start()
+	t.Cleanup(upstream.Stop)
+
+	handler := dns.NewHandler(logger)
+	handler.SetUpstreams([]netip.AddrPort{upstreamAddr})
+
+	serverAddr := freeAddr(t)
+
+	server, err := dns.NewServer(serverAddr, handler, logger)
+	require.NoError(t, err)
+
+	server.Start()
+	t.Cleanup(server.Stop)
+
+	for _, network := range []string{"udp", "tcp"} {
+		t.Run(network, func(t *testing.T) {
+			resolver := &net.Resolver{
+				PreferGo: true,
+				Dial: func(ctx context.Context, _, _ string) (net.Conn, error) {
+					var d net.Dialer
+
+					return d.DialContext(ctx, network, serverAddr.String())
+				},
+			}
+
+			ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+			defer cancel()
+
+			addrs, err := resolver.LookupNetIP(ctx, "ip4", "talos.example")
+			require.NoError(t, err)
+
+			assert.Equal(t, []netip.Addr{netip.MustParseAddr("10.5.0.2")}, addrs)
+		})
+	}
+}
+
+func freeAddr(t *testing.T) netip.AddrPort {
+	t.Helper()
+
+	conn, err := net.ListenPacket("udp", "127.0.0.1:0")
+	require.NoError(t, err)
+
+	addr := conn.LocalAddr().(*net.UDPAddr).AddrPort() //nolint:forcetypeassert
+
+	require.NoError(t, conn.Close())
+
+	return addr
+}
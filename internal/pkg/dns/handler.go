@@ -0,0 +1,256 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+// Package dns provides a small caching DNS forwarder.
+package dns
+
+import (
+	"context"
+	"fmt"
+	"net/netip"
+	"reflect"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/hashicorp/go-multierror"
+	"go.uber.org/zap"
+	"golang.org/x/net/dns/dnsmessage"
+)
+
+// ForwardRule sends the queries for the domain (and its subdomains) to the specific servers.
+type ForwardRule struct {
+	Domain  string
+	Servers []netip.AddrPort
+}
+
+// ExchangeFunc sends the query to the server and returns the response.
+type ExchangeFunc func(ctx context.Context, network string, server netip.AddrPort, query []byte) ([]byte, error)
+
+// Handler answers DNS queries from the static hosts, the cache or by forwarding them to the upstream servers.
+type Handler struct {
+	logger *zap.Logger
+	cache  *Cache
+
+	mu        sync.RWMutex
+	upstreams []netip.AddrPort
+	rules     []ForwardRule
+	hosts     map[string][]netip.Addr
+
+	// Exchange is overridden in tests.
+	Exchange ExchangeFunc
+}
+
+// HostsTTL is the TTL of the answers for the static hosts.
+const HostsTTL = 10 * time.Second
+
+// NewHandler creates a new Handler.
+func NewHandler(logger *zap.Logger) *Handler {
+	return &Handler{
+		logger:   logger,
+		cache:    NewCache(DefaultCacheSize),
+		Exchange: Exchange,
+	}
+}
+
+// SetUpstreams sets the default upstream servers.
+func (h *Handler) SetUpstreams(upstreams []netip.AddrPort) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	h.upstreams = append([]netip.AddrPort(nil), upstreams...)
+}
+
+// SetForwardRules sets the split-DNS forwarding rules.
+func (h *Handler) SetForwardRules(rules []ForwardRule) {
+	normalized := make([]ForwardRule, 0, len(rules))
+
+	for _, rule := range rules {
+		normalized = append(normalized, ForwardRule{
+			Domain:  canonicalName(rule.Domain),
+			Servers: append([]netip.AddrPort(nil), rule.Servers...),
+		})
+	}
+
+	// the most specific domain wins
+	sort.SliceStable(normalized, func(i, j int) bool {
+		return len(normalized[i].Domain) > len(normalized[j].Domain)
+	})
+
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	if reflect.DeepEqual(h.rules, normalized) {
+		return
+	}
+
+	h.rules = normalized
+
+	// cached answers might have come from different servers
+	h.cache.Flush()
+}
+
+// SetHosts sets the static host entries.
+func (h *Handler) SetHosts(hosts map[string][]netip.Addr) {
+	normalized := make(map[string][]netip.Addr, len(hosts))
+
+	for name, addrs := range hosts {
+		name = canonicalName(name)
+
+		normalized[name] = append(normalized[name], addrs...)
+	}
+
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	h.hosts = normalized
+}
+
+// Handle processes the query received over the network ("udp" or "tcp") and returns the response.
+func (h *Handler) Handle(ctx context.Context, network string, query []byte) ([]byte, error) {
+	var msg dnsmessage.Message
+
+	if err := msg.Unpack(query); err != nil {
+		return nil, fmt.Errorf("error parsing query: %w", err)
+	}
+
+	if msg.Header.Response || len(msg.Questions) != 1 {
+		return errorResponse(&msg, dnsmessage.RCodeFormatError)
+	}
+
+	question := msg.Questions[0]
+	name := canonicalName(question.Name.String())
+
+	if response, ok := h.answerFromHosts(&msg, name); ok {
+		return response.Pack()
+	}
+
+	key := CacheKey{Name: name, Type: question.Type, Class: question.Class}
+
+	if cached := h.cache.Get(key, time.Now()); cached != nil {
+		cached.Header.ID = msg.Header.ID
+
+		return cached.Pack()
+	}
+
+	servers := h.serversFor(name)
+	if len(servers) == 0 {
+		return errorResponse(&msg, dnsmessage.RCodeServerFailure)
+	}
+
+	var multiErr *multierror.Error
+
+	for _, server := range servers {
+		raw, err := h.Exchange(ctx, network, server, query)
+		if err != nil {
+			multiErr = multierror.Append(multiErr, err)
+
+			continue
+		}
+
+		var response dnsmessage.Message
+
+		if err = response.Unpack(raw); err != nil {
+			multiErr = multierror.Append(multiErr, fmt.Errorf("error parsing response from %s: %w", server, err))
+
+			continue
+		}
+
+		if response.Header.RCode == dnsmessage.RCodeServerFailure || response.Header.RCode == dnsmessage.RCodeRefused {
+			multiErr = multierror.Append(multiErr, fmt.Errorf("server %s responded with %s", server, response.Header.RCode))
+
+			continue
+		}
+
+		if !response.Header.Truncated {
+			h.cache.Put(key, &response, time.Now())
+		}
+
+		return raw, nil
+	}
+
+	h.logger.Debug("failed to forward the query", zap.String("name", name), zap.Stringer("type", question.Type), zap.Error(multiErr.ErrorOrNil()))
+
+	return errorResponse(&msg, dnsmessage.RCodeServerFailure)
+}
+
+func (h *Handler) answerFromHosts(query *dnsmessage.Message, name string) (*dnsmessage.Message, bool) {
+	question := query.Questions[0]
+
+	if question.Class != dnsmessage.ClassINET || (question.Type != dnsmessage.TypeA && question.Type != dnsmessage.TypeAAAA) {
+		return nil, false
+	}
+
+	h.mu.RLock()
+	addrs, ok := h.hosts[name]
+	h.mu.RUnlock()
+
+	if !ok {
+		return nil, false
+	}
+
+	response := newResponse(query, dnsmessage.RCodeSuccess)
+	response.Header.Authoritative = true
+
+	for _, addr := range addrs {
+		header := dnsmessage.ResourceHeader{
+			Name:  question.Name,
+			Type:  question.Type,
+			Class: dnsmessage.ClassINET,
+			TTL:   uint32(HostsTTL / time.Second),
+		}
+
+		switch {
+		case question.Type == dnsmessage.TypeA && addr.Is4():
+			response.Answers = append(response.Answers, dnsmessage.Resource{Header: header, Body: &dnsmessage.AResource{A: addr.As4()}})
+		case question.Type == dnsmessage.TypeAAAA && addr.Is6():
+			response.Answers = append(response.Answers, dnsmessage.Resource{Header: header, Body: &dnsmessage.AAAAResource{AAAA: addr.As16()}})
+		}
+	}
+
+	return response, true
+}
+
+func (h *Handler) serversFor(name string) []netip.AddrPort {
+	h.mu.RLock()
+	defer h.mu.RUnlock()
+
+	for _, rule := range h.rules {
+		if name == rule.Domain || strings.HasSuffix(name, "."+rule.Domain) {
+			return rule.Servers
+		}
+	}
+
+	return h.upstreams
+}
+
+func newResponse(query *dnsmessage.Message, rcode dnsmessage.RCode) *dnsmessage.Message {
+	return &dnsmessage.Message{
+		Header: dnsmessage.Header{
+			ID:                 query.Header.ID,
+			Response:           true,
+			OpCode:             query.Header.OpCode,
+			RecursionDesired:   query.Header.RecursionDesired,
+			RecursionAvailable: true,
+			RCode:              rcode,
+		},
+		Questions: query.Questions,
+	}
+}
+
+func errorResponse(query *dnsmessage.Message, rcode dnsmessage.RCode) ([]byte, error) {
+	return newResponse(query, rcode).Pack()
+}
+
+// canonicalName returns lowercase fully-qualified name.
+func canonicalName(name string) string {
+	name = strings.ToLower(name)
+
+	if !strings.HasSuffix(name, ".") {
+		name += "."
+	}
+
+	return name
+}
@@ -0,0 +1,345 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package dns
+
+import (
+	"context"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"net/netip"
+	"sync"
+	"syscall"
+	"time"
+
+	"go.uber.org/zap"
+	"golang.org/x/net/dns/dnsmessage"
+	"golang.org/x/sys/unix"
+)
+
+const (
+	// QueryTimeout is the timeout for a single query to the upstream server.
+	QueryTimeout = 5 * time.Second
+
+	// TCPIdleTimeout is the timeout for idle client TCP connections.
+	TCPIdleTimeout = 10 * time.Second
+
+	// maxUDPSize is the size of the buffer for UDP messages.
+	maxUDPSize = 65535
+
+	// minUDPSize is the maximum response size for the clients without EDNS0.
+	minUDPSize = 512
+)
+
+// Exchange sends the query to the server over the network ("udp" or "tcp") and returns the response.
+//
+// Truncated UDP responses are retried over TCP.
+func Exchange(ctx context.Context, network string, server netip.AddrPort, query []byte) ([]byte, error) {
+	ctx, cancel := context.WithTimeout(ctx, QueryTimeout)
+	defer cancel()
+
+	response, err := exchange(ctx, network, server, query)
+	if err != nil {
+		return nil, err
+	}
+
+	if network == "udp" && len(response) > 2 && response[2]&0x02 != 0 { // TC bit
+		return exchange(ctx, "tcp", server, query)
+	}
+
+	return response, nil
+}
+
+func exchange(ctx context.Context, network string, server netip.AddrPort, query []byte) ([]byte, error) {
+	var d net.Dialer
+
+	conn, err := d.DialContext(ctx, network, server.String())
+	if err != nil {
+		return nil, err
+	}
+
+	defer conn.Close() //nolint:errcheck
+
+	if deadline, ok := ctx.Deadline(); ok {
+		if err = conn.SetDeadline(deadline); err != nil {
+			return nil, err
+		}
+	}
+
+	if network == "tcp" {
+		if err = writeTCPMessage(conn, query); err != nil {
+			return nil, err
+		}
+
+		return readTCPMessage(conn)
+	}
+
+	if _, err = conn.Write(query); err != nil {
+		return nil, err
+	}
+
+	buf := make([]byte, maxUDPSize)
+
+	for {
+		n, err := conn.Read(buf)
+		if err != nil {
+			return nil, err
+		}
+
+		// skip stray responses which don't match the query ID
+		if n >= 2 && len(query) >= 2 && buf[0] == query[0] && buf[1] == query[1] {
+			return append([]byte(nil), buf[:n]...), nil
+		}
+	}
+}
+
+func writeTCPMessage(w io.Writer, msg []byte) error {
+	buf := make([]byte, 2+len(msg))
+	binary.BigEndian.PutUint16(buf, uint16(len(msg)))
+	copy(buf[2:], msg)
+
+	_, err := w.Write(buf)
+
+	return err
+}
+
+func readTCPMessage(r io.Reader) ([]byte, error) {
+	var length uint16
+
+	if err := binary.Read(r, binary.BigEndian, &length); err != nil {
+		return nil, err
+	}
+
+	msg := make([]byte, length)
+
+	if _, err := io.ReadFull(r, msg); err != nil {
+		return nil, err
+	}
+
+	return msg, nil
+}
+
+// Server listens for DNS queries on UDP and TCP and passes them to the Handler.
+type Server struct {
+	logger  *zap.Logger
+	handler *Handler
+
+	udpConn     net.PacketConn
+	tcpListener net.Listener
+
+	ctx    context.Context //nolint:containedctx
+	cancel context.CancelFunc
+	wg     sync.WaitGroup
+}
+
+// NewServer creates a new Server listening on the address.
+//
+// The address doesn't need to be assigned to the host yet.
+func NewServer(addr netip.AddrPort, handler *Handler, logger *zap.Logger) (*Server, error) {
+	lc := net.ListenConfig{
+		Control: freebind,
+	}
+
+	udpConn, err := lc.ListenPacket(context.Background(), "udp", addr.String())
+	if err != nil {
+		return nil, fmt.Errorf("error listening on udp %s: %w", addr, err)
+	}
+
+	tcpListener, err := lc.Listen(context.Background(), "tcp", addr.String())
+	if err != nil {
+		udpConn.Close() //nolint:errcheck
+
+		return nil, fmt.Errorf("error listening on tcp %s: %w", addr, err)
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+
+	return &Server{
+		logger:      logger,
+		handler:     handler,
+		udpConn:     udpConn,
+		tcpListener: tcpListener,
+		ctx:         ctx,
+		cancel:      cancel,
+	}, nil
+}
+
+func freebind(network, _ string, c syscall.RawConn) error {
+	var sockErr error
+
+	if err := c.Control(func(fd uintptr) {
+		switch network {
+		case "udp6", "tcp6":
+			sockErr = unix.SetsockoptInt(int(fd), unix.SOL_IPV6, unix.IPV6_FREEBIND, 1)
+		default:
+			sockErr = unix.SetsockoptInt(int(fd), unix.SOL_IP, unix.IP_FREEBIND, 1)
+		}
+	}); err != nil {
+		return err
+	}
+
+	return sockErr
+}
+
+// Start the server in the background.
+func (s *Server) Start() {
+	s.wg.Add(2)
+
+	go func() {
+		defer s.wg.Done()
+
+		s.serveUDP()
+	}()
+
+	go func() {
+		defer s.wg.Done()
+
+		s.serveTCP()
+	}()
+}
+
+// Stop the server and wait for the pending queries to finish.
+func (s *Server) Stop() {
+	s.cancel()
+
+	s.udpConn.Close()     //nolint:errcheck
+	s.tcpListener.Close() //nolint:errcheck
+
+	s.wg.Wait()
+}
+
+func (s *Server) serveUDP() {
+	buf := make([]byte, maxUDPSize)
+
+	for {
+		n, addr, err := s.udpConn.ReadFrom(buf)
+		if err != nil {
+			if !errors.Is(err, net.ErrClosed) {
+				s.logger.Error("error reading udp query", zap.Error(err))
+			}
+
+			return
+		}
+
+		query := append([]byte(nil), buf[:n]...)
+
+		s.wg.Add(1)
+
+		go func() {
+			defer s.wg.Done()
+
+			response, err := s.handler.Handle(s.ctx, "udp", query)
+			if err != nil {
+				s.logger.Debug("error handling udp query", zap.Stringer("client", addr), zap.Error(err))
+
+				return
+			}
+
+			response, err = truncate(query, response)
+			if err != nil {
+				s.logger.Debug("error truncating udp response", zap.Stringer("client", addr), zap.Error(err))
+
+				return
+			}
+
+			if _, err = s.udpConn.WriteTo(response, addr); err != nil && !errors.Is(err, net.ErrClosed) {
+				s.logger.Debug("error writing udp response", zap.Stringer("client", addr), zap.Error(err))
+			}
+		}()
+	}
+}
+
+func (s *Server) serveTCP() {
+	for {
+		conn, err := s.tcpListener.Accept()
+		if err != nil {
+			if !errors.Is(err, net.ErrClosed) {
+				s.logger.Error("error accepting tcp connection", zap.Error(err))
+			}
+
+			return
+		}
+
+		s.wg.Add(1)
+
+		go func() {
+			defer s.wg.Done()
+
+			s.serveTCPConn(conn)
+		}()
+	}
+}
+
+func (s *Server) serveTCPConn(conn net.Conn) {
+	defer conn.Close() //nolint:errcheck
+
+	done := make(chan struct{})
+	defer close(done)
+
+	// close the connection on server shutdown
+	go func() {
+		select {
+		case <-s.ctx.Done():
+			conn.Close() //nolint:errcheck
+		case <-done:
+		}
+	}()
+
+	for {
+		if err := conn.SetDeadline(time.Now().Add(TCPIdleTimeout)); err != nil {
+			return
+		}
+
+		query, err := readTCPMessage(conn)
+		if err != nil {
+			return
+		}
+
+		response, err := s.handler.Handle(s.ctx, "tcp", query)
+		if err != nil {
+			s.logger.Debug("error handling tcp query", zap.Stringer("client", conn.RemoteAddr()), zap.Error(err))
+
+			return
+		}
+
+		if err = writeTCPMessage(conn, response); err != nil {
+			return
+		}
+	}
+}
+
+// truncate the UDP response to the size the client accepts.
+func truncate(query, response []byte) ([]byte, error) {
+	size := minUDPSize
+
+	var msg dnsmessage.Message
+
+	if err := msg.Unpack(query); err == nil {
+		for _, rr := range msg.Additionals {
+			if rr.Header.Type == dnsmessage.TypeOPT && int(rr.Header.Class) > size {
+				size = int(rr.Header.Class)
+			}
+		}
+	}
+
+	if len(response) <= size {
+		return response, nil
+	}
+
+	var truncated dnsmessage.Message
+
+	if err := truncated.Unpack(response); err != nil {
+		return nil, err
+	}
+
+	truncated.Header.Truncated = true
+	truncated.Answers = nil
+	truncated.Authorities = nil
+	truncated.Additionals = nil
+
+	return truncated.Pack()
+}
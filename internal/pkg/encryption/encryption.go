@@ -6,6 +6,7 @@
 package encryption
 
 import (
+	"errors"
 	"fmt"
 	"log"
 	"sort"
@@ -22,7 +23,7 @@ import (
 
 // NewHandler creates new Handler.
 func NewHandler(device *blockdevice.BlockDevice, partition *gpt.Partition, encryptionConfig config.Encryption) (*Handler, error) {
-	keys, err := getKeys(encryptionConfig, partition)
+	keyHandlers, err := getKeyHandlers(encryptionConfig)
 	if err != nil {
 		return nil, err
 	}
@@ -67,8 +68,10 @@ func NewHandler(device *blockdevice.BlockDevice, partition *gpt.Partition, encry
 		device:             device,
 		partition:          partition,
 		encryptionConfig:   encryptionConfig,
-		keys:               keys,
+		keyHandlers:        keyHandlers,
 		encryptionProvider: provider,
+		readTokens:         readTokens,
+		writeToken:         writeToken,
 	}, nil
 }
 
@@ -78,14 +81,37 @@ type Handler struct {
 	device             *blockdevice.BlockDevice
 	partition          *gpt.Partition
 	encryptionConfig   config.Encryption
-	keys               []*encryption.Key
+	keyHandlers        []*keyHandler
 	encryptionProvider encryption.Provider
 	encryptedPath      string
+	openedKey          *encryption.Key
+
+	// these are overridden in tests
+	readTokens func(path string) (map[int]*keys.Token, error)
+	writeToken func(path string, slot int, token *keys.Token) error
 }
 
-// Open encrypted partition.
+// errSealedKeyMissing is returned when the sealed key is not stored in the partition header.
+var errSealedKeyMissing = errors.New("sealed key is not present")
+
+// keyHandler binds the key handler to the key slot.
+type keyHandler struct {
+	keys.Handler
+
+	slot int
+}
+
+// encryptionKey is the key resolved by the key handler.
 //
-//nolint:gocyclo
+// Token is set only for the freshly generated sealed keys, it should be stored
+// in the partition header once the key is added to the key slot.
+type encryptionKey struct {
+	*encryption.Key
+
+	token *keys.Token
+}
+
+// Open encrypted partition.
 func (h *Handler) Open() (string, error) {
 	partPath, err := h.partition.Path()
 	if err != nil {
@@ -100,43 +126,39 @@ func (h *Handler) Open() (string, error) {
 	var path string
 
 	// encrypt if partition is not encrypted and empty
-	if sb == nil {
-		err = h.formatAndEncrypt(partPath)
-		if err != nil {
-			return "", err
-		}
-	} else if sb.Type() != h.encryptionConfig.Kind() {
+	switch {
+	case sb == nil:
+		path, err = h.formatAndOpen(partPath)
+	case sb.Type() != h.encryptionConfig.Kind():
 		return "", fmt.Errorf("failed to encrypt the partition %s, because it is not empty", partPath)
+	default:
+		path, err = h.openEncrypted(partPath)
 	}
 
-	var k *encryption.Key
+	if err != nil {
+		return "", err
+	}
 
-	for _, k = range h.keys {
-		path, err = h.encryptionProvider.Open(partPath, k)
-		if err != nil {
-			if err == encryption.ErrEncryptionKeyRejected {
-				continue
-			}
+	log.Printf("mapped encrypted partition %s -> %s", partPath, path)
 
-			return "", err
-		}
+	h.encryptedPath = path
 
-		break
-	}
+	return path, nil
+}
 
-	if path == "" {
-		return "", fmt.Errorf("failed to open encrypted device %s, no key matched", partPath)
+// Resize grows the encrypted mapping to the size of the underlying partition.
+func (h *Handler) Resize() error {
+	if h.encryptedPath == "" || h.openedKey == nil {
+		return fmt.Errorf("encrypted partition %s is not open", h.partition.Name)
 	}
 
-	log.Printf("mapped encrypted partition %s -> %s", partPath, path)
-
-	if err = h.syncKeys(k, partPath); err != nil {
-		return "", err
+	if err := resizeMapping(h.encryptedPath, h.openedKey); err != nil {
+		return err
 	}
 
-	h.encryptedPath = path
+	log.Printf("resized encrypted partition %s", h.encryptedPath)
 
-	return path, nil
+	return nil
 }
 
 // Close encrypted partition.
@@ -154,31 +176,171 @@ func (h *Handler) Close() error {
 	return nil
 }
 
-func (h *Handler) formatAndEncrypt(path string) error {
+func (h *Handler) formatAndOpen(path string) (string, error) {
 	log.Printf("encrypting the partition %s (%s)", path, h.partition.Name)
 
-	if len(h.keys) == 0 {
-		return fmt.Errorf("no encryption keys found")
+	if len(h.keyHandlers) == 0 {
+		return "", fmt.Errorf("no encryption keys found")
 	}
 
-	key := h.keys[0]
+	encryptionKeys := make([]*encryptionKey, len(h.keyHandlers))
+
+	for i, handler := range h.keyHandlers {
+		key, err := h.newKey(handler)
+		if err != nil {
+			return "", err
+		}
 
-	err := h.encryptionProvider.Encrypt(path, key)
+		encryptionKeys[i] = key
+	}
+
+	key := encryptionKeys[0]
+
+	err := h.encryptionProvider.Encrypt(path, key.Key)
 	if err != nil {
-		return err
+		return "", err
 	}
 
-	for _, extraKey := range h.keys[1:] {
-		if err = h.encryptionProvider.AddKey(path, key, extraKey); err != nil {
-			return err
+	for _, extraKey := range encryptionKeys[1:] {
+		if err = h.encryptionProvider.AddKey(path, key.Key, extraKey.Key); err != nil {
+			return "", err
+		}
+	}
+
+	for _, k := range encryptionKeys {
+		if err = h.storeToken(path, k); err != nil {
+			return "", err
 		}
 	}
 
+	h.openedKey = key.Key
+
+	return h.encryptionProvider.Open(path, key.Key)
+}
+
+// openEncrypted opens the already encrypted partition, and syncs the key slots with the config.
+//
+// Every configured key is tried in turn until one of them opens the partition. Keys are never sealed
+// while opening, as sealing might require a remote service which is not reachable: missing or broken
+// sealed keys are re-enrolled only once the partition is open.
+func (h *Handler) openEncrypted(path string) (string, error) {
+	tokens, err := h.readTokens(path)
+	if err != nil {
+		return "", err
+	}
+
+	// keys resolved so far, and the errors of the keys which failed to resolve, by slot
+	var (
+		resolved  = map[int]*encryptionKey{}
+		failed    = map[int]error{}
+		mapped    string
+		openedKey *encryptionKey
+	)
+
+	for _, handler := range h.keyHandlers {
+		key, err := h.getKey(handler, tokens)
+		if err != nil {
+			log.Printf("failed to get encryption key at slot %d: %s", handler.slot, err)
+
+			failed[handler.slot] = err
+
+			continue
+		}
+
+		resolved[handler.slot] = key
+
+		mapped, err = h.encryptionProvider.Open(path, key.Key)
+		if err != nil {
+			if errors.Is(err, encryption.ErrEncryptionKeyRejected) {
+				continue
+			}
+
+			return "", err
+		}
+
+		openedKey = key
+
+		break
+	}
+
+	if openedKey == nil {
+		return "", fmt.Errorf("failed to open encrypted device %s, no key matched", path)
+	}
+
+	if err = h.syncKeys(openedKey, resolved, failed, tokens, path); err != nil {
+		return "", err
+	}
+
+	h.openedKey = openedKey.Key
+
+	return mapped, nil
+}
+
+// getKey resolves the key of the already enrolled key slot.
+func (h *Handler) getKey(handler *keyHandler, tokens map[int]*keys.Token) (*encryptionKey, error) {
+	options := []keys.KeyOption{keys.WithPartitionLabel(h.partition.Name)}
+
+	if _, ok := handler.Handler.(keys.SealingHandler); ok {
+		token, ok := tokens[handler.slot]
+		if !ok {
+			return nil, errSealedKeyMissing
+		}
+
+		options = append(options, keys.WithToken(token))
+	}
+
+	value, err := handler.GetKey(options...)
+	if err != nil {
+		return nil, err
+	}
+
+	return &encryptionKey{Key: encryption.NewKey(handler.slot, value)}, nil
+}
+
+// newKey generates a new key for the sealing key handlers, and fetches the key for other handlers.
+func (h *Handler) newKey(handler *keyHandler) (*encryptionKey, error) {
+	if sealingHandler, ok := handler.Handler.(keys.SealingHandler); ok {
+		value, token, err := sealingHandler.NewKey(keys.WithPartitionLabel(h.partition.Name))
+		if err != nil {
+			return nil, err
+		}
+
+		return &encryptionKey{
+			Key:   encryption.NewKey(handler.slot, value),
+			token: token,
+		}, nil
+	}
+
+	value, err := handler.GetKey(keys.WithPartitionLabel(h.partition.Name))
+	if err != nil {
+		return nil, err
+	}
+
+	return &encryptionKey{Key: encryption.NewKey(handler.slot, value)}, nil
+}
+
+func (h *Handler) storeToken(path string, key *encryptionKey) error {
+	if key.token == nil {
+		return nil
+	}
+
+	if err := h.writeToken(path, key.Slot, key.token); err != nil {
+		return err
+	}
+
+	key.token = nil
+
 	return nil
 }
 
-//nolint:gocyclo
-func (h *Handler) syncKeys(k *encryption.Key, path string) error {
+// syncKeys updates the key slots to match the configured keys once the partition is opened with the key k.
+//
+// Keys which were not resolved while opening the partition are resolved now. Sealed keys are re-enrolled
+// only if the sealed key is missing or can't be unsealed anymore, and re-enrolling is best-effort:
+// if it fails, the key slot is kept as is.
+//
+//nolint:gocyclo,cyclop
+func (h *Handler) syncKeys(k *encryptionKey, resolved map[int]*encryptionKey, failed map[int]error, tokens map[int]*keys.Token, path string) error {
 	keyslots, err := h.encryptionProvider.ReadKeyslots(path)
 	if err != nil {
 		return err
@@ -186,29 +348,64 @@ func (h *Handler) syncKeys(k *encryption.Key, path string) error {
 
 	visited := map[string]bool{}
 
-	for _, key := range h.keys {
-		slot := fmt.Sprintf("%d", key.Slot)
+	for _, handler := range h.keyHandlers {
+		slot := strconv.Itoa(handler.slot)
 		visited[slot] = true
 		// no need to update the key which we already detected as unchanged
-		if k.Slot == key.Slot {
+		if k.Slot == handler.slot {
 			continue
 		}
 
+		key := resolved[handler.slot]
+
+		if key == nil {
+			_, sealing := handler.Handler.(keys.SealingHandler)
+			_, enrolled := tokens[handler.slot]
+			resolveErr, tried := failed[handler.slot]
+
+			switch {
+			case sealing && enrolled && !tried:
+				// the sealed key can't be verified without unsealing it, keep it as is
+				continue
+			case sealing && tried && errors.Is(resolveErr, keys.ErrSealingUnavailable):
+				// the sealed key might be still valid, keep it as is
+				continue
+			case sealing:
+				log.Printf("re-enrolling sealed encryption key at slot %d", handler.slot)
+
+				key, err = h.newKey(handler)
+			case tried:
+				err = resolveErr
+			default:
+				key, err = h.getKey(handler, tokens)
+			}
+
+			if err != nil {
+				log.Printf("failed to sync encryption key at slot %d, keeping the slot as is: %s", handler.slot, err)
+
+				continue
+			}
+		}
+
 		// keyslot exists
 		if _, ok := keyslots.Keyslots[slot]; ok {
-			if err = h.updateKey(k, key, path); err != nil {
+			if err = h.updateKey(k.Key, key.Key, path); err != nil {
 				return err
 			}
 
 			log.Printf("updated encryption key at slot %d", key.Slot)
 		} else {
 			// keyslot does not exist so just add the key
-			if err = h.encryptionProvider.AddKey(path, k, key); err != nil {
+			if err = h.encryptionProvider.AddKey(path, k.Key, key.Key); err != nil {
 				return err
 			}
 
 			log.Printf("added encryption key to slot %d", key.Slot)
 		}
+
+		if err = h.storeToken(path, key); err != nil {
+			return err
+		}
 	}
 
 	// cleanup deleted key slots
@@ -219,11 +416,11 @@ func (h *Handler) syncKeys(k *encryption.Key, path string) error {
 				return err
 			}
 
-			if err = h.encryptionProvider.RemoveKey(path, int(s), k); err != nil {
+			if err = h.encryptionProvider.RemoveKey(path, int(s), k.Key); err != nil {
 				return err
 			}
 
-			log.Printf("removed key at slot %d", k.Slot)
+			log.Printf("removed key at slot %d", s)
 		}
 	}
 
@@ -251,8 +448,8 @@ func (h *Handler) updateKey(existingKey, newKey *encryption.Key, path string) er
 	return nil
 }
 
-func getKeys(encryptionConfig config.Encryption, partition *gpt.Partition) ([]*encryption.Key, error) {
-	encryptionKeys := make([]*encryption.Key, len(encryptionConfig.Keys()))
+func getKeyHandlers(encryptionConfig config.Encryption) ([]*keyHandler, error) {
+	keyHandlers := make([]*keyHandler, len(encryptionConfig.Keys()))
 
 	for i, cfg := range encryptionConfig.Keys() {
 		handler, err := keys.NewHandler(cfg)
@@ -260,16 +457,14 @@ func getKeys(encryptionConfig config.Encryption, partition *gpt.Partition) ([]*e
 			return nil, err
 		}
 
-		k, err := handler.GetKey(keys.WithPartitionLabel(partition.Name))
-		if err != nil {
-			return nil, err
+		keyHandlers[i] = &keyHandler{
+			Handler: handler,
+			slot:    cfg.Slot(),
 		}
-
-		encryptionKeys[i] = encryption.NewKey(cfg.Slot(), k)
 	}
 
 	//nolint:scopelint
-	sort.Slice(encryptionKeys, func(i, j int) bool { return encryptionKeys[i].Slot < encryptionKeys[j].Slot })
+	sort.Slice(keyHandlers, func(i, j int) bool { return keyHandlers[i].slot < keyHandlers[j].slot })
 
-	return encryptionKeys, nil
+	return keyHandlers, nil
 }
@@ -4,11 +4,205 @@
 
 package encryption_test
 
-import "testing"
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"strconv"
+	"testing"
 
-func TestEmpty(t *testing.T) {
-	// added for accurate coverage estimation
-	//
-	// please remove it once any unit-test is added
-	// for this package
+	"github.com/siderolabs/go-blockdevice/blockdevice/encryption"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	talosencryption "github.com/siderolabs/talos/internal/pkg/encryption"
+	"github.com/siderolabs/talos/internal/pkg/encryption/keys"
+)
+
+// mockProvider keeps the key slots in memory.
+type mockProvider struct {
+	slots map[int][]byte
+}
+
+func (p *mockProvider) Encrypt(_ string, key *encryption.Key) error {
+	p.slots = map[int][]byte{key.Slot: key.Value}
+
+	return nil
+}
+
+func (p *mockProvider) Open(devname string, key *encryption.Key) (string, error) {
+	if !p.valid(key) {
+		return "", encryption.ErrEncryptionKeyRejected
+	}
+
+	return devname + "-encrypted", nil
+}
+
+func (p *mockProvider) Close(string) error {
+	return nil
+}
+
+func (p *mockProvider) AddKey(_ string, key, newKey *encryption.Key) error {
+	if !p.valid(key) {
+		return encryption.ErrEncryptionKeyRejected
+	}
+
+	if _, ok := p.slots[newKey.Slot]; ok {
+		return fmt.Errorf("key slot %d is in use", newKey.Slot)
+	}
+
+	p.slots[newKey.Slot] = newKey.Value
+
+	return nil
+}
+
+func (p *mockProvider) SetKey(_ string, key, newKey *encryption.Key) error {
+	if !p.valid(key) {
+		return encryption.ErrEncryptionKeyRejected
+	}
+
+	p.slots[newKey.Slot] = newKey.Value
+
+	return nil
+}
+
+func (p *mockProvider) CheckKey(_ string, key *encryption.Key) (bool, error) {
+	return p.valid(key), nil
+}
+
+func (p *mockProvider) RemoveKey(_ string, slot int, key *encryption.Key) error {
+	if !p.valid(key) {
+		return encryption.ErrEncryptionKeyRejected
+	}
+
+	delete(p.slots, slot)
+
+	return nil
+}
+
+func (p *mockProvider) ReadKeyslots(string) (*encryption.Keyslots, error) {
+	keyslots := &encryption.Keyslots{Keyslots: map[string]*encryption.Keyslot{}}
+
+	for slot := range p.slots {
+		keyslots.Keyslots[strconv.Itoa(slot)] = &encryption.Keyslot{Type: "luks2"}
+	}
+
+	return keyslots, nil
+}
+
+func (p *mockProvider) valid(key *encryption.Key) bool {
+	value, ok := p.slots[key.Slot]
+
+	return ok && bytes.Equal(value, key.Value)
+}
+
+// mockSealingHandler unseals the key with the configured error, and records whether the key was sealed.
+type mockSealingHandler struct {
+	unsealErr error
+	newKey    []byte
+	sealed    bool
+}
+
+func (h *mockSealingHandler) GetKey(...keys.KeyOption) ([]byte, error) {
+	return nil, h.unsealErr
+}
+
+func (h *mockSealingHandler) NewKey(...keys.KeyOption) ([]byte, *keys.Token, error) {
+	h.sealed = true
+
+	token, err := keys.NewToken("mock", string(h.newKey))
+
+	return h.newKey, token, err
+}
+
+func TestOpenEncrypted(t *testing.T) {
+	t.Parallel()
+
+	staticKey := []byte("static")
+	sealedKey := []byte("sealed")
+
+	for _, test := range []struct {
+		name string
+
+		unsealErr error
+		enrolled  bool
+
+		expectSealed bool
+		expectSlot0  []byte
+	}{
+		{
+			name:      "sealing service down",
+			unsealErr: fmt.Errorf("%w: connection refused", keys.ErrSealingUnavailable),
+			enrolled:  true,
+
+			expectSlot0: sealedKey,
+		},
+		{
+			name:      "sealed key rejected",
+			unsealErr: errors.New("permission denied"),
+			enrolled:  true,
+
+			expectSealed: true,
+			expectSlot0:  []byte("resealed"),
+		},
+		{
+			name:      "sealed key missing",
+			unsealErr: errors.New("not expected to be called"),
+
+			expectSealed: true,
+			expectSlot0:  []byte("resealed"),
+		},
+	} {
+		test := test
+
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			provider := &mockProvider{
+				slots: map[int][]byte{
+					1: staticKey,
+				},
+			}
+
+			tokens := map[int]*keys.Token{}
+
+			if test.enrolled {
+				provider.slots[0] = sealedKey
+
+				token, err := keys.NewToken("mock", string(sealedKey))
+				require.NoError(t, err)
+
+				tokens[0] = token
+			}
+
+			sealing := &mockSealingHandler{
+				unsealErr: test.unsealErr,
+				newKey:    []byte("resealed"),
+			}
+
+			static, err := keys.NewStaticKeyHandler(staticKey)
+			require.NoError(t, err)
+
+			handler := talosencryption.NewHandlerForTest("STATE", map[int]keys.Handler{
+				0: sealing,
+				1: static,
+			}, provider, tokens)
+
+			path, err := handler.OpenEncrypted("/dev/sda5")
+			require.NoError(t, err)
+			assert.Equal(t, "/dev/sda5-encrypted", path)
+
+			assert.Equal(t, test.expectSealed, sealing.sealed)
+			assert.Equal(t, test.expectSlot0, provider.slots[0])
+			assert.Equal(t, staticKey, provider.slots[1])
+
+			if test.expectSealed {
+				var stored string
+
+				require.Contains(t, tokens, 0)
+				require.NoError(t, tokens[0].Decode("mock", &stored))
+				assert.Equal(t, "resealed", stored)
+			}
+		})
+	}
 }
@@ -0,0 +1,46 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package encryption
+
+import (
+	"sort"
+
+	"github.com/siderolabs/go-blockdevice/blockdevice/encryption"
+	"github.com/siderolabs/go-blockdevice/blockdevice/partition/gpt"
+
+	"github.com/siderolabs/talos/internal/pkg/encryption/keys"
+)
+
+func NewHandlerForTest(
+	partitionLabel string,
+	keyHandlers map[int]keys.Handler,
+	provider encryption.Provider,
+	tokens map[int]*keys.Token,
+) *Handler {
+	h := &Handler{
+		partition:          &gpt.Partition{Name: partitionLabel},
+		encryptionProvider: provider,
+		readTokens: func(string) (map[int]*keys.Token, error) {
+			return tokens, nil
+		},
+		writeToken: func(_ string, slot int, token *keys.Token) error {
+			tokens[slot] = token
+
+			return nil
+		},
+	}
+
+	for slot, handler := range keyHandlers {
+		h.keyHandlers = append(h.keyHandlers, &keyHandler{Handler: handler, slot: slot})
+	}
+
+	sort.Slice(h.keyHandlers, func(i, j int) bool { return h.keyHandlers[i].slot < h.keyHandlers[j].slot })
+
+	return h
+}
+
+func (h *Handler) OpenEncrypted(path string) (string, error) {
+	return h.openEncrypted(path)
+}
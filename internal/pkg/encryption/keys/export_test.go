@@ -0,0 +1,19 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package keys
+
+import "time"
+
+func NewKMSKeyHandlerForNode(kmsEndpoint, nodeUUID string, timeout time.Duration) *KMSKeyHandler {
+	return &KMSKeyHandler{
+		kmsEndpoint: kmsEndpoint,
+
+		getNodeUUID: func() (string, error) {
+			return nodeUUID, nil
+		},
+		timeout:       timeout,
+		retryInterval: timeout / 4,
+	}
+}
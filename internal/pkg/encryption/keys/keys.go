@@ -6,9 +6,11 @@
 package keys
 
 import (
+	"errors"
 	"fmt"
 
 	"github.com/siderolabs/talos/pkg/machinery/config/config"
+	"github.com/siderolabs/talos/pkg/machinery/constants"
 )
 
 // NewHandler creates a new key handler depending on key handler kind.
@@ -23,12 +25,30 @@ func NewHandler(key config.EncryptionKey) (Handler, error) {
 		return NewStaticKeyHandler(k)
 	case key.NodeID() != nil:
 		return NewNodeIDKeyHandler()
+	case key.TPM() != nil:
+		return NewTPMKeyHandler(constants.TPMDevicePath)
+	case key.KMS() != nil:
+		return NewKMSKeyHandler(key.KMS().Endpoint())
 	}
 
 	return nil, fmt.Errorf("failed to create key handler: malformed config")
 }
 
+// ErrSealingUnavailable is returned by the sealing key handlers when the sealing service can't be reached.
+//
+// The sealed key might be still valid in that case, so it shouldn't be re-enrolled.
+var ErrSealingUnavailable = errors.New("sealing service is not available")
+
 // Handler represents an interface for fetching encryption keys.
 type Handler interface {
 	GetKey(options ...KeyOption) ([]byte, error)
 }
+
+// SealingHandler represents a key handler which generates a random key on enrollment
+// and keeps it sealed in the LUKS2 token attached to the key slot.
+//
+// GetKey of the SealingHandler requires the token to be passed with the WithToken option.
+type SealingHandler interface {
+	Handler
+	NewKey(options ...KeyOption) ([]byte, *Token, error)
+}
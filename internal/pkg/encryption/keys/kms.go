@@ -0,0 +1,210 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package keys
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/tls"
+	"fmt"
+	"io"
+	"net/url"
+	"time"
+
+	"github.com/siderolabs/go-retry/retry"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/status"
+
+	"github.com/siderolabs/talos/internal/pkg/smbios"
+	"github.com/siderolabs/talos/pkg/httpdefaults"
+	"github.com/siderolabs/talos/pkg/machinery/api/kms"
+)
+
+// TokenTypeKMS is the LUKS2 token type which holds the key sealed by the KMS.
+const TokenTypeKMS = "talos-kms"
+
+const (
+	// kmsKeySize is the size of the random key generated for the KMS key handler.
+	kmsKeySize = 32
+
+	// kmsTimeout is the time to wait for the KMS endpoint to become reachable, as the network
+	// might be not ready yet when the partition is mounted.
+	kmsTimeout = 5 * time.Minute
+
+	// kmsRetryInterval is the interval between the KMS requests.
+	kmsRetryInterval = 5 * time.Second
+)
+
+// kmsSealedKey is the data stored in the LUKS2 token for the KMS sealed key.
+type kmsSealedKey struct {
+	SealedData []byte `json:"sealed_data"`
+}
+
+// KMSKeyHandler generates a random key and seals it with the remote KMS.
+//
+// The key can be unsealed only if the KMS endpoint is reachable, so the disk
+// can't be decrypted once the node leaves the network.
+type KMSKeyHandler struct {
+	kmsEndpoint string
+
+	// these are overridden in tests
+	getNodeUUID   func() (string, error)
+	timeout       time.Duration
+	retryInterval time.Duration
+}
+
+// NewKMSKeyHandler creates new KMSKeyHandler.
+func NewKMSKeyHandler(kmsEndpoint string) (*KMSKeyHandler, error) {
+	return &KMSKeyHandler{
+		kmsEndpoint: kmsEndpoint,
+
+		getNodeUUID:   smbiosNodeUUID,
+		timeout:       kmsTimeout,
+		retryInterval: kmsRetryInterval,
+	}, nil
+}
+
+// NewKey implements SealingHandler interface.
+func (h *KMSKeyHandler) NewKey(options ...KeyOption) ([]byte, *Token, error) {
+	key := make([]byte, kmsKeySize)
+
+	if _, err := io.ReadFull(rand.Reader, key); err != nil {
+		return nil, nil, err
+	}
+
+	sealedData, err := h.call(func(ctx context.Context, client kms.KMSServiceClient, nodeUUID string) ([]byte, error) {
+		resp, err := client.Seal(ctx, &kms.Request{
+			NodeUuid: nodeUUID,
+			Data:     key,
+		})
+		if err != nil {
+			return nil, err
+		}
+
+		return resp.Data, nil
+	})
+	if err != nil {
+		return nil, nil, fmt.Errorf("error sealing the key: %w", err)
+	}
+
+	token, err := NewToken(TokenTypeKMS, &kmsSealedKey{
+		SealedData: sealedData,
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return key, token, nil
+}
+
+// GetKey implements KeyHandler interface.
+func (h *KMSKeyHandler) GetKey(options ...KeyOption) ([]byte, error) {
+	opts, err := NewDefaultOptions(options)
+	if err != nil {
+		return nil, err
+	}
+
+	if opts.Token == nil {
+		return nil, fmt.Errorf("KMS sealed key is not present")
+	}
+
+	var sealed kmsSealedKey
+
+	if err = opts.Token.Decode(TokenTypeKMS, &sealed); err != nil {
+		return nil, err
+	}
+
+	key, err := h.call(func(ctx context.Context, client kms.KMSServiceClient, nodeUUID string) ([]byte, error) {
+		resp, err := client.Unseal(ctx, &kms.Request{
+			NodeUuid: nodeUUID,
+			Data:     sealed.SealedData,
+		})
+		if err != nil {
+			return nil, err
+		}
+
+		return resp.Data, nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("error unsealing the key: %w", err)
+	}
+
+	return key, nil
+}
+
+func (h *KMSKeyHandler) call(f func(ctx context.Context, client kms.KMSServiceClient, nodeUUID string) ([]byte, error)) ([]byte, error) {
+	nodeUUID, err := h.getNodeUUID()
+	if err != nil {
+		return nil, err
+	}
+
+	conn, err := h.dial()
+	if err != nil {
+		return nil, err
+	}
+
+	defer conn.Close() //nolint:errcheck
+
+	client := kms.NewKMSServiceClient(conn)
+
+	var (
+		result  []byte
+		refused bool
+	)
+
+	// the network might be not up yet, so keep retrying until the KMS endpoint responds
+	err = retry.Constant(h.timeout, retry.WithUnits(h.retryInterval), retry.WithErrorLogging(true)).Retry(func() error {
+		ctx, cancel := context.WithTimeout(context.Background(), h.retryInterval)
+		defer cancel()
+
+		result, err = f(ctx, client, nodeUUID)
+		if err != nil {
+			// KMS explicitly refused the request, no reason to retry
+			if code := status.Code(err); code == codes.PermissionDenied || code == codes.InvalidArgument {
+				refused = true
+
+				return err
+			}
+
+			return retry.ExpectedError(err)
+		}
+
+		return nil
+	})
+	if err != nil && !refused {
+		return nil, fmt.Errorf("%w: %w", ErrSealingUnavailable, err)
+	}
+
+	return result, err
+}
+
+func smbiosNodeUUID() (string, error) {
+	s, err := smbios.GetSMBIOSInfo()
+	if err != nil {
+		return "", err
+	}
+
+	return s.SystemInformation.UUID, nil
+}
+
+func (h *KMSKeyHandler) dial() (*grpc.ClientConn, error) {
+	endpoint, err := url.Parse(h.kmsEndpoint)
+	if err != nil {
+		return nil, err
+	}
+
+	transportCredentials := insecure.NewCredentials()
+
+	if endpoint.Scheme == "https" {
+		transportCredentials = credentials.NewTLS(&tls.Config{
+			RootCAs: httpdefaults.RootCAs(),
+		})
+	}
+
+	return grpc.Dial(endpoint.Host, grpc.WithTransportCredentials(transportCredentials))
+}
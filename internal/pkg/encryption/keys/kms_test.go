@@ -0,0 +1,79 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package keys_test
+
+import (
+	"net"
+	"net/netip"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+
+	"github.com/siderolabs/talos/cmd/talos-kms/pkg/server"
+	"github.com/siderolabs/talos/internal/pkg/encryption/keys"
+	"github.com/siderolabs/talos/pkg/machinery/api/kms"
+)
+
+// startKMS runs the reference KMS server and returns its endpoint.
+func startKMS(t *testing.T) string {
+	t.Helper()
+
+	srv, err := server.NewServer(make([]byte, 32), []netip.Prefix{netip.MustParsePrefix("127.0.0.0/8")})
+	require.NoError(t, err)
+
+	lis, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+
+	grpcServer := grpc.NewServer()
+	kms.RegisterKMSServiceServer(grpcServer, srv)
+
+	go grpcServer.Serve(lis) //nolint:errcheck
+
+	t.Cleanup(grpcServer.Stop)
+
+	return "grpc://" + lis.Addr().String()
+}
+
+func TestKMSKeyHandler(t *testing.T) {
+	endpoint := startKMS(t)
+
+	handler := keys.NewKMSKeyHandlerForNode(endpoint, "node-1", 10*time.Second)
+
+	key, token, err := handler.NewKey()
+	require.NoError(t, err)
+	assert.Len(t, key, 32)
+	assert.Equal(t, keys.TokenTypeKMS, token.Type)
+
+	unsealed, err := handler.GetKey(keys.WithToken(token))
+	require.NoError(t, err)
+	assert.Equal(t, key, unsealed)
+
+	t.Run("wrong node UUID", func(t *testing.T) {
+		start := time.Now()
+
+		_, err := keys.NewKMSKeyHandlerForNode(endpoint, "node-2", 10*time.Second).GetKey(keys.WithToken(token))
+		require.Error(t, err)
+		assert.NotErrorIs(t, err, keys.ErrSealingUnavailable)
+
+		// KMS refused the request explicitly, so it shouldn't be retried
+		assert.Less(t, time.Since(start), 5*time.Second)
+	})
+
+	t.Run("unreachable endpoint", func(t *testing.T) {
+		lis, err := net.Listen("tcp", "127.0.0.1:0")
+		require.NoError(t, err)
+
+		unreachable := "grpc://" + lis.Addr().String()
+
+		require.NoError(t, lis.Close())
+
+		_, err = keys.NewKMSKeyHandlerForNode(unreachable, "node-1", time.Second).GetKey(keys.WithToken(token))
+		require.Error(t, err)
+		assert.ErrorIs(t, err, keys.ErrSealingUnavailable)
+	})
+}
@@ -10,6 +10,7 @@ type KeyOption func(o *KeyOptions) error
 // KeyOptions set of options to be used in KeyHandler.GetKey func.
 type KeyOptions struct {
 	PartitionLabel string
+	Token          *Token
 }
 
 // WithPartitionLabel passes the partition label in to GetKey function.
@@ -21,6 +22,15 @@ func WithPartitionLabel(label string) KeyOption {
 	}
 }
 
+// WithToken passes the LUKS2 token read from the partition in to GetKey function.
+func WithToken(token *Token) KeyOption {
+	return func(o *KeyOptions) error {
+		o.Token = token
+
+		return nil
+	}
+}
+
 // NewDefaultOptions creates new KeyOptions.
 func NewDefaultOptions(options []KeyOption) (*KeyOptions, error) {
 	var opts KeyOptions
@@ -0,0 +1,41 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package keys
+
+import (
+	"encoding/json"
+	"fmt"
+)
+
+// Token represents LUKS2 token which keeps the sealed key metadata next to the key slot.
+type Token struct {
+	Type     string   `json:"type"`
+	Keyslots []string `json:"keyslots"`
+	// UserData is the key handler specific data, e.g. the sealed key.
+	UserData json.RawMessage `json:"user_data"`
+}
+
+// NewToken creates a new token of the specified type with the handler specific data.
+func NewToken(tokenType string, data any) (*Token, error) {
+	userData, err := json.Marshal(data)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Token{
+		Type:     tokenType,
+		Keyslots: []string{},
+		UserData: userData,
+	}, nil
+}
+
+// Decode the handler specific data from the token verifying the token type.
+func (t *Token) Decode(tokenType string, data any) error {
+	if t.Type != tokenType {
+		return fmt.Errorf("unexpected token type %q, expected %q", t.Type, tokenType)
+	}
+
+	return json.Unmarshal(t.UserData, data)
+}
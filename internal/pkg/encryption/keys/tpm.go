@@ -0,0 +1,217 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package keys
+
+import (
+	"crypto/rand"
+	"fmt"
+	"io"
+
+	"github.com/google/go-tpm/tpm2"
+	"github.com/google/go-tpm/tpmutil"
+
+	"github.com/siderolabs/talos/pkg/machinery/constants"
+)
+
+// TokenTypeTPM is the LUKS2 token type which holds the key sealed by the TPM.
+const TokenTypeTPM = "talos-tpm2"
+
+// tpmKeySize is the size of the random key generated for the TPM key handler.
+const tpmKeySize = 32
+
+// srkTemplate is the template of the storage root key, it is re-created deterministically
+// in the owner hierarchy on every seal/unseal.
+var srkTemplate = tpm2.Public{
+	Type:    tpm2.AlgECC,
+	NameAlg: tpm2.AlgSHA256,
+	Attributes: tpm2.FlagFixedTPM | tpm2.FlagFixedParent | tpm2.FlagSensitiveDataOrigin |
+		tpm2.FlagUserWithAuth | tpm2.FlagRestricted | tpm2.FlagDecrypt | tpm2.FlagNoDA,
+	ECCParameters: &tpm2.ECCParams{
+		Symmetric: &tpm2.SymScheme{
+			Alg:     tpm2.AlgAES,
+			KeyBits: 128,
+			Mode:    tpm2.AlgCFB,
+		},
+		CurveID: tpm2.CurveNISTP256,
+	},
+}
+
+// tpmSealedKey is the data stored in the LUKS2 token for the TPM sealed key.
+type tpmSealedKey struct {
+	SealedBlobPrivate []byte `json:"sealed_blob_private"`
+	SealedBlobPublic  []byte `json:"sealed_blob_public"`
+	PCRs              []int  `json:"pcrs"`
+}
+
+// TPMKeyHandler generates a random key and seals it by the TPM 2.0 device
+// against the Secure Boot state (PCR 7).
+//
+// The key can be unsealed only while the PCR values match the ones at the moment of sealing,
+// so the disk can't be decrypted if it's moved to another machine or the Secure Boot state changes.
+type TPMKeyHandler struct {
+	devicePath string
+}
+
+// NewTPMKeyHandler creates new TPMKeyHandler.
+func NewTPMKeyHandler(devicePath string) (*TPMKeyHandler, error) {
+	return &TPMKeyHandler{
+		devicePath: devicePath,
+	}, nil
+}
+
+// NewKey implements SealingHandler interface.
+func (h *TPMKeyHandler) NewKey(options ...KeyOption) ([]byte, *Token, error) {
+	key := make([]byte, tpmKeySize)
+
+	if _, err := io.ReadFull(rand.Reader, key); err != nil {
+		return nil, nil, err
+	}
+
+	rw, err := tpm2.OpenTPM(h.devicePath)
+	if err != nil {
+		return nil, nil, fmt.Errorf("error opening TPM device %q: %w", h.devicePath, err)
+	}
+
+	defer rw.Close() //nolint:errcheck
+
+	sealed, err := tpmSeal(rw, key, []int{constants.SecureBootStatePCR})
+	if err != nil {
+		return nil, nil, fmt.Errorf("error sealing the key: %w", err)
+	}
+
+	token, err := NewToken(TokenTypeTPM, sealed)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return key, token, nil
+}
+
+// GetKey implements KeyHandler interface.
+func (h *TPMKeyHandler) GetKey(options ...KeyOption) ([]byte, error) {
+	opts, err := NewDefaultOptions(options)
+	if err != nil {
+		return nil, err
+	}
+
+	if opts.Token == nil {
+		return nil, fmt.Errorf("TPM sealed key is not present")
+	}
+
+	var sealed tpmSealedKey
+
+	if err = opts.Token.Decode(TokenTypeTPM, &sealed); err != nil {
+		return nil, err
+	}
+
+	rw, err := tpm2.OpenTPM(h.devicePath)
+	if err != nil {
+		return nil, fmt.Errorf("error opening TPM device %q: %w", h.devicePath, err)
+	}
+
+	defer rw.Close() //nolint:errcheck
+
+	key, err := tpmUnseal(rw, &sealed)
+	if err != nil {
+		return nil, fmt.Errorf("error unsealing the key: %w", err)
+	}
+
+	return key, nil
+}
+
+func tpmSeal(rw io.ReadWriter, key []byte, pcrs []int) (*tpmSealedKey, error) {
+	srk, _, err := tpm2.CreatePrimary(rw, tpm2.HandleOwner, tpm2.PCRSelection{}, "", "", srkTemplate)
+	if err != nil {
+		return nil, fmt.Errorf("error creating SRK: %w", err)
+	}
+
+	defer tpm2.FlushContext(rw, srk) //nolint:errcheck
+
+	sel := tpm2.PCRSelection{
+		Hash: tpm2.AlgSHA256,
+		PCRs: pcrs,
+	}
+
+	policy, err := tpmPolicyDigest(rw, sel)
+	if err != nil {
+		return nil, err
+	}
+
+	private, public, err := tpm2.Seal(rw, srk, "", "", policy, key)
+	if err != nil {
+		return nil, err
+	}
+
+	return &tpmSealedKey{
+		SealedBlobPrivate: private,
+		SealedBlobPublic:  public,
+		PCRs:              pcrs,
+	}, nil
+}
+
+func tpmUnseal(rw io.ReadWriter, sealed *tpmSealedKey) ([]byte, error) {
+	srk, _, err := tpm2.CreatePrimary(rw, tpm2.HandleOwner, tpm2.PCRSelection{}, "", "", srkTemplate)
+	if err != nil {
+		return nil, fmt.Errorf("error creating SRK: %w", err)
+	}
+
+	defer tpm2.FlushContext(rw, srk) //nolint:errcheck
+
+	object, _, err := tpm2.Load(rw, srk, "", sealed.SealedBlobPublic, sealed.SealedBlobPrivate)
+	if err != nil {
+		return nil, fmt.Errorf("error loading sealed object: %w", err)
+	}
+
+	defer tpm2.FlushContext(rw, object) //nolint:errcheck
+
+	session, err := tpmPolicySession(rw, tpm2.SessionPolicy, tpm2.PCRSelection{
+		Hash: tpm2.AlgSHA256,
+		PCRs: sealed.PCRs,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	defer tpm2.FlushContext(rw, session) //nolint:errcheck
+
+	return tpm2.UnsealWithSession(rw, session, object, "")
+}
+
+// tpmPolicyDigest calculates the policy digest for the current values of the selected PCRs.
+func tpmPolicyDigest(rw io.ReadWriter, sel tpm2.PCRSelection) ([]byte, error) {
+	session, err := tpmPolicySession(rw, tpm2.SessionTrial, sel)
+	if err != nil {
+		return nil, err
+	}
+
+	defer tpm2.FlushContext(rw, session) //nolint:errcheck
+
+	return tpm2.PolicyGetDigest(rw, session)
+}
+
+func tpmPolicySession(rw io.ReadWriter, sessionType tpm2.SessionType, sel tpm2.PCRSelection) (tpmutil.Handle, error) {
+	session, _, err := tpm2.StartAuthSession(
+		rw,
+		tpm2.HandleNull,
+		tpm2.HandleNull,
+		make([]byte, 16),
+		nil,
+		sessionType,
+		tpm2.AlgNull,
+		tpm2.AlgSHA256,
+	)
+	if err != nil {
+		return tpm2.HandleNull, fmt.Errorf("error starting auth session: %w", err)
+	}
+
+	// empty digest makes TPM use current PCR values
+	if err = tpm2.PolicyPCR(rw, session, nil, sel); err != nil {
+		tpm2.FlushContext(rw, session) //nolint:errcheck
+
+		return tpm2.HandleNull, fmt.Errorf("error applying PCR policy: %w", err)
+	}
+
+	return session, nil
+}
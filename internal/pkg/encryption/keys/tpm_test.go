@@ -0,0 +1,99 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package keys_test
+
+import (
+	"crypto/sha256"
+	"encoding/json"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/google/go-tpm/tpm2"
+	"github.com/google/go-tpm/tpmutil"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/siderolabs/talos/internal/pkg/encryption/keys"
+	"github.com/siderolabs/talos/pkg/machinery/constants"
+)
+
+// startSWTPM runs swtpm in the socket mode and returns the path to the socket.
+func startSWTPM(t *testing.T) string {
+	t.Helper()
+
+	swtpm, err := exec.LookPath("swtpm")
+	if err != nil {
+		t.Skip("swtpm is not available")
+	}
+
+	stateDir := t.TempDir()
+	socketPath := filepath.Join(stateDir, "swtpm.sock")
+
+	cmd := exec.Command(swtpm,
+		"socket",
+		"--tpm2",
+		"--tpmstate", "dir="+stateDir,
+		"--server", "type=unixio,path="+socketPath,
+		"--ctrl", "type=unixio,path="+filepath.Join(stateDir, "swtpm.ctrl"),
+		"--flags", "not-need-init,startup-clear",
+	)
+
+	require.NoError(t, cmd.Start())
+
+	t.Cleanup(func() {
+		cmd.Process.Kill() //nolint:errcheck
+		cmd.Wait()         //nolint:errcheck
+	})
+
+	require.Eventually(t, func() bool {
+		_, err := os.Stat(socketPath)
+
+		return err == nil
+	}, 10*time.Second, 100*time.Millisecond)
+
+	return socketPath
+}
+
+func TestTPMKeyHandler(t *testing.T) {
+	socketPath := startSWTPM(t)
+
+	handler, err := keys.NewTPMKeyHandler(socketPath)
+	require.NoError(t, err)
+
+	key, token, err := handler.NewKey()
+	require.NoError(t, err)
+	assert.Len(t, key, 32)
+	assert.Equal(t, keys.TokenTypeTPM, token.Type)
+
+	// the token goes through the LUKS2 header as JSON
+	data, err := json.Marshal(token)
+	require.NoError(t, err)
+
+	var storedToken keys.Token
+
+	require.NoError(t, json.Unmarshal(data, &storedToken))
+
+	_, err = handler.GetKey()
+	require.Error(t, err)
+
+	unsealed, err := handler.GetKey(keys.WithToken(&storedToken))
+	require.NoError(t, err)
+	assert.Equal(t, key, unsealed)
+
+	// change the Secure Boot state PCR, the key should no longer be unsealed
+	rw, err := tpm2.OpenTPM(socketPath)
+	require.NoError(t, err)
+
+	digest := sha256.Sum256([]byte("secure boot state changed"))
+
+	require.NoError(t, tpm2.PCRExtend(rw, tpmutil.Handle(constants.SecureBootStatePCR), tpm2.AlgSHA256, digest[:], ""))
+	require.NoError(t, rw.Close())
+
+	_, err = handler.GetKey(keys.WithToken(&storedToken))
+	require.Error(t, err)
+}
@@ -0,0 +1,107 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package encryption
+
+import (
+	"bytes"
+	"context"
+	"encoding/binary"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strconv"
+
+	"github.com/siderolabs/go-blockdevice/blockdevice/encryption"
+	"github.com/siderolabs/go-blockdevice/blockdevice/filesystem/luks"
+	"github.com/siderolabs/go-cmd/pkg/cmd"
+	"golang.org/x/sys/unix"
+
+	"github.com/siderolabs/talos/internal/pkg/encryption/keys"
+)
+
+// readTokens reads LUKS2 tokens from the partition header.
+//
+// Tokens are keyed by the token ID, which is always equal to the key slot.
+func readTokens(path string) (map[int]*keys.Token, error) {
+	f, err := os.OpenFile(path, os.O_RDONLY|unix.O_CLOEXEC, os.ModeDevice)
+	if err != nil {
+		return nil, err
+	}
+
+	defer f.Close() //nolint:errcheck
+
+	sb := &luks.SuperBlock{}
+
+	if err = binary.Read(f, binary.BigEndian, sb); err != nil {
+		return nil, err
+	}
+
+	size := binary.Size(sb)
+
+	jsonArea := make([]byte, int(sb.HeaderSize)-size)
+
+	if _, err = f.ReadAt(jsonArea, int64(size)); err != nil {
+		return nil, err
+	}
+
+	jsonArea = bytes.Trim(bytes.TrimSpace(jsonArea), "\x00")
+
+	var metadata struct {
+		Tokens map[string]*keys.Token `json:"tokens"`
+	}
+
+	if err = json.Unmarshal(jsonArea, &metadata); err != nil {
+		return nil, err
+	}
+
+	tokens := make(map[int]*keys.Token, len(metadata.Tokens))
+
+	for id, token := range metadata.Tokens {
+		tokenID, err := strconv.Atoi(id)
+		if err != nil {
+			return nil, err
+		}
+
+		tokens[tokenID] = token
+	}
+
+	return tokens, nil
+}
+
+// writeToken imports the token into the partition header replacing the existing token at the slot.
+func writeToken(path string, slot int, token *keys.Token) error {
+	token.Keyslots = []string{strconv.Itoa(slot)}
+
+	data, err := json.Marshal(token)
+	if err != nil {
+		return err
+	}
+
+	_, err = cmd.RunContext(
+		cmd.WithStdin(context.Background(), bytes.NewReader(data)),
+		"cryptsetup", "token", "import", path, "--json-file=-", fmt.Sprintf("--token-id=%d", slot), "--token-replace",
+	)
+	if err != nil {
+		return fmt.Errorf("failed to import LUKS2 token: %w", err)
+	}
+
+	return nil
+}
+
+// resizeMapping grows the active LUKS2 mapping to the size of the underlying device.
+//
+// The volume key of LUKS2 devices is stored in the kernel keyring, so cryptsetup needs the passphrase to resize the mapping.
+func resizeMapping(mappedPath string, key *encryption.Key) error {
+	_, err := cmd.RunContext(
+		cmd.WithStdin(context.Background(), bytes.NewReader(key.Value)),
+		"cryptsetup", "resize", filepath.Base(mappedPath), "--key-file=-", fmt.Sprintf("--key-slot=%d", key.Slot),
+	)
+	if err != nil {
+		return fmt.Errorf("failed to resize LUKS2 mapping: %w", err)
+	}
+
+	return nil
+}
@@ -88,7 +88,13 @@ func RunInstallerContainer(disk, platform, ref string, cfg configcore.Config, cf
 	if img == nil || err != nil && errdefs.IsNotFound(err) {
 		log.Printf("pulling %q", ref)
 
-		img, err = image.Pull(ctx, registriesConfig, client, ref)
+		var pullOpts []image.PullOption
+
+		if options.Progress != nil {
+			pullOpts = append(pullOpts, image.WithProgress(pullProgress(ref, options.Progress)))
+		}
+
+		img, err = image.Pull(ctx, registriesConfig, client, ref, pullOpts...)
 	}
 
 	if err != nil {
@@ -228,7 +234,15 @@ func RunInstallerContainer(disk, platform, ref string, cfg configcore.Config, cf
 	//nolint:errcheck
 	defer f.Close()
 
-	w := &kmsg.Writer{KmsgWriter: f}
+	var (
+		w  io.Writer = &kmsg.Writer{KmsgWriter: f}
+		pw *progressWriter
+	)
+
+	if options.Progress != nil {
+		pw = &progressWriter{w: w, report: options.Progress}
+		w = pw
+	}
 
 	var r interface {
 		io.Reader
@@ -278,6 +292,10 @@ func RunInstallerContainer(disk, platform, ref string, cfg configcore.Config, cf
 		return fmt.Errorf("task %q failed: exit code %d", "upgrade", code)
 	}
 
+	if pw != nil {
+		pw.done()
+	}
+
 	return nil
 }
 
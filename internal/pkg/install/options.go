@@ -14,6 +14,9 @@ type Options struct {
 	Upgrade         bool
 	Zero            bool
 	ExtraKernelArgs []string
+
+	// Progress is not serialized, as options of the staged upgrade are stored as JSON.
+	Progress ProgressFunc `json:"-"`
 }
 
 // DefaultInstallOptions returns default options.
@@ -87,3 +90,12 @@ func WithExtraKernelArgs(s []string) Option {
 		return nil
 	}
 }
+
+// WithProgress sets the function to report the installation progress.
+func WithProgress(f ProgressFunc) Option {
+	return func(o *Options) error {
+		o.Progress = f
+
+		return nil
+	}
+}
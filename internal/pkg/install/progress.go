@@ -0,0 +1,103 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package install
+
+import (
+	"bytes"
+	"io"
+	"sync"
+
+	ocispec "github.com/opencontainers/image-spec/specs-go/v1"
+
+	"github.com/siderolabs/talos/internal/pkg/containers/image"
+	"github.com/siderolabs/talos/internal/pkg/install/progress"
+)
+
+// ProgressFunc is called to report the progress of the installation.
+type ProgressFunc func(message string, percent int)
+
+// maxLineLength limits the buffered installer output line.
+const maxLineLength = 4096
+
+// progressWriter passes the installer output through, and reports the progress written by the installer.
+//
+// The installer writes the progress as the lines with the progress.Prefix, see the progress package.
+type progressWriter struct {
+	w      io.Writer
+	report ProgressFunc
+
+	mu      sync.Mutex
+	line    []byte
+	percent int
+}
+
+func (pw *progressWriter) Write(p []byte) (int, error) {
+	pw.mu.Lock()
+	defer pw.mu.Unlock()
+
+	for data := p; len(data) > 0; {
+		idx := bytes.IndexByte(data, '\n')
+		if idx == -1 {
+			if len(pw.line)+len(data) <= maxLineLength {
+				pw.line = append(pw.line, data...)
+			}
+
+			break
+		}
+
+		pw.line = append(pw.line, data[:idx]...)
+		pw.progress(string(pw.line))
+
+		pw.line = pw.line[:0]
+		data = data[idx+1:]
+	}
+
+	return pw.w.Write(p)
+}
+
+func (pw *progressWriter) progress(line string) {
+	report, ok := progress.Parse(line)
+	if !ok || report.Percent <= pw.percent {
+		return
+	}
+
+	pw.percent = report.Percent
+	if pw.percent > 100 {
+		pw.percent = 100
+	}
+
+	pw.report("writing installer image", pw.percent)
+}
+
+// done reports the installation as complete, if the installer didn't report it (e.g. an older installer).
+func (pw *progressWriter) done() {
+	pw.mu.Lock()
+	defer pw.mu.Unlock()
+
+	if pw.percent < 100 {
+		pw.percent = 100
+		pw.report("writing installer image", pw.percent)
+	}
+}
+
+// pullProgress converts image pull progress into the percent done, reporting only when the percent changes.
+func pullProgress(ref string, report ProgressFunc) image.ProgressFunc {
+	last := -1
+
+	return func(_ ocispec.Descriptor, _, fetched, total int64) {
+		if total == 0 {
+			return
+		}
+
+		percent := int(fetched * 100 / total)
+		if percent == last {
+			return
+		}
+
+		last = percent
+
+		report("pulling "+ref, percent)
+	}
+}
@@ -0,0 +1,63 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+// Package progress implements the progress reporting of the installer.
+//
+// The installer writes the progress to its output as a line with the Prefix followed by the JSON-encoded Report,
+// and the host running the installer parses these lines out of the installer output.
+package progress
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"strings"
+)
+
+// Prefix marks the progress report lines in the installer output.
+const Prefix = "talos-installer-progress: "
+
+// Installation steps reported by the installer.
+const (
+	StepStarted      = "started"
+	StepPreflight    = "pre-flight checks"
+	StepPartitioning = "partitioning"
+	StepBootloader   = "installing bootloader"
+	StepFinalizing   = "finalizing"
+	StepComplete     = "complete"
+)
+
+// Report is the progress of the installation.
+type Report struct {
+	Step    string `json:"step"`
+	Percent int    `json:"percent"`
+}
+
+// Emit writes the progress report line to w.
+func Emit(w io.Writer, step string, percent int) {
+	b, err := json.Marshal(Report{Step: step, Percent: percent})
+	if err != nil {
+		return
+	}
+
+	fmt.Fprintf(w, "%s%s\n", Prefix, b) //nolint:errcheck
+}
+
+// Parse parses the progress report line.
+//
+// Parse returns false if the line is not a progress report.
+func Parse(line string) (Report, bool) {
+	data, ok := strings.CutPrefix(line, Prefix)
+	if !ok {
+		return Report{}, false
+	}
+
+	var report Report
+
+	if err := json.Unmarshal([]byte(data), &report); err != nil {
+		return Report{}, false
+	}
+
+	return report, true
+}
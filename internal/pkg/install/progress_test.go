@@ -0,0 +1,84 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package install //nolint:testpackage
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/siderolabs/talos/internal/pkg/install/progress"
+)
+
+func TestProgressWriter(t *testing.T) {
+	t.Parallel()
+
+	var installer bytes.Buffer
+
+	installer.WriteString("2023/06/01 10:00:00 running Talos installer v1.5.0\n")
+	progress.Emit(&installer, progress.StepStarted, 5)
+	progress.Emit(&installer, progress.StepPreflight, 10)
+	installer.WriteString("2023/06/01 10:00:01 creating new partition table on /dev/sda\n") // log lines are not parsed
+	progress.Emit(&installer, progress.StepPartitioning, 30)
+	installer.WriteString("Writing superblocks and filesystem accounting information: done\n") // tool output
+	installer.WriteString(progress.Prefix + "not a report\n")                                  // malformed report
+	progress.Emit(&installer, progress.StepBootloader, 60)
+	progress.Emit(&installer, progress.StepPartitioning, 30) // progress doesn't go back
+	installer.WriteString("Installing for x86_64-efi platform.\n")
+	progress.Emit(&installer, progress.StepFinalizing, 80)
+	installer.WriteString("2023/06/01 10:00:09 installation of v1.5.0 complete\n")
+	progress.Emit(&installer, progress.StepComplete, 100)
+
+	output := installer.String()
+
+	type report struct {
+		message string
+		percent int
+	}
+
+	var (
+		reports []report
+		out     bytes.Buffer
+	)
+
+	pw := &progressWriter{
+		w: &out,
+		report: func(message string, percent int) {
+			reports = append(reports, report{message, percent})
+		},
+	}
+
+	// write in small chunks to split the lines
+	for data := []byte(output); len(data) > 0; {
+		n := 7
+		if n > len(data) {
+			n = len(data)
+		}
+
+		_, err := pw.Write(data[:n])
+		require.NoError(t, err)
+
+		data = data[n:]
+	}
+
+	assert.Equal(t, output, out.String())
+
+	percents := make([]int, 0, len(reports))
+
+	for _, r := range reports {
+		assert.Equal(t, "writing installer image", r.message)
+
+		percents = append(percents, r.percent)
+	}
+
+	assert.Equal(t, []int{5, 10, 30, 60, 80, 100}, percents)
+
+	// the installation is already reported as complete
+	pw.done()
+
+	assert.Len(t, reports, 6)
+}
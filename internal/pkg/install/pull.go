@@ -19,10 +19,31 @@ import (
 	"github.com/siderolabs/talos/pkg/machinery/constants"
 )
 
+// ResolveInstallerImage checks that the installer image exists in the registry without pulling it.
+func ResolveInstallerImage(ctx context.Context, reg config.Registries, ref string) error {
+	if _, _, err := image.NewResolver(reg).Resolve(ctx, ref); err != nil {
+		return err
+	}
+
+	return nil
+}
+
 // PullAndValidateInstallerImage pulls down the installer and validates that it can run.
 //
 //nolint:gocyclo
-func PullAndValidateInstallerImage(ctx context.Context, reg config.Registries, ref string) error {
+func PullAndValidateInstallerImage(ctx context.Context, reg config.Registries, ref string, opts ...Option) error {
+	options := DefaultInstallOptions()
+
+	if err := options.Apply(opts...); err != nil {
+		return err
+	}
+
+	pullOpts := []image.PullOption{image.WithSkipIfAlreadyPulled()}
+
+	if options.Progress != nil {
+		pullOpts = append(pullOpts, image.WithProgress(pullProgress(ref, options.Progress)))
+	}
+
 	// Pull down specified installer image early so we can bail if it doesn't exist in the upstream registry
 	containerdctx := namespaces.WithNamespace(ctx, constants.SystemContainerdNamespace)
 
@@ -35,7 +56,7 @@ func PullAndValidateInstallerImage(ctx context.Context, reg config.Registries, r
 
 	defer client.Close() //nolint:errcheck
 
-	img, err := image.Pull(containerdctx, reg, client, ref, image.WithSkipIfAlreadyPulled())
+	img, err := image.Pull(containerdctx, reg, client, ref, pullOpts...)
 	if err != nil {
 		return err
 	}
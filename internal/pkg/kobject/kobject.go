@@ -0,0 +1,175 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+// Package kobject implements a watcher for the kernel uevents.
+package kobject
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"strings"
+
+	"go.uber.org/zap"
+	"golang.org/x/sys/unix"
+)
+
+// Kernel uevent actions.
+const (
+	ActionAdd    = "add"
+	ActionRemove = "remove"
+	ActionChange = "change"
+	ActionMove   = "move"
+	ActionOnline = "online"
+	// ActionOverflow is sent by the watcher when some events were lost due to the socket buffer overflow.
+	ActionOverflow = "overflow"
+)
+
+const (
+	// kernelGroup is the netlink multicast group of the kernel uevents (as opposed to udev events).
+	kernelGroup = 1
+	// receiveBufferSize is the socket buffer size which should be enough to handle bursts of the events.
+	receiveBufferSize = 2 * 1024 * 1024
+	// maxMessageSize is the maximum size of a single uevent message.
+	maxMessageSize = 8192
+)
+
+// Event is a kernel uevent.
+type Event struct {
+	Action    string
+	DevPath   string
+	Subsystem string
+	Values    map[string]string
+}
+
+// Watcher watches kernel uevents.
+type Watcher struct {
+	f *os.File
+}
+
+// NewWatcher creates a new uevent watcher.
+func NewWatcher() (*Watcher, error) {
+	fd, err := unix.Socket(unix.AF_NETLINK, unix.SOCK_DGRAM|unix.SOCK_CLOEXEC|unix.SOCK_NONBLOCK, unix.NETLINK_KOBJECT_UEVENT)
+	if err != nil {
+		return nil, fmt.Errorf("error creating uevent socket: %w", err)
+	}
+
+	if err = unix.Bind(fd, &unix.SockaddrNetlink{
+		Family: unix.AF_NETLINK,
+		Groups: kernelGroup,
+	}); err != nil {
+		unix.Close(fd) //nolint:errcheck
+
+		return nil, fmt.Errorf("error binding uevent socket: %w", err)
+	}
+
+	if err = unix.SetsockoptInt(fd, unix.SOL_SOCKET, unix.SO_RCVBUFFORCE, receiveBufferSize); err != nil {
+		// SO_RCVBUFFORCE requires CAP_NET_ADMIN, fall back to the limited SO_RCVBUF
+		unix.SetsockoptInt(fd, unix.SOL_SOCKET, unix.SO_RCVBUF, receiveBufferSize) //nolint:errcheck
+	}
+
+	// the socket is non-blocking, so os.File uses the runtime poller, and Close unblocks the reads
+	return &Watcher{
+		f: os.NewFile(uintptr(fd), "uevent"),
+	}, nil
+}
+
+// Run starts delivering the events to the returned channel.
+//
+// The channel is closed when the watcher is closed.
+func (w *Watcher) Run(ctx context.Context, logger *zap.Logger) <-chan *Event {
+	ch := make(chan *Event, 128)
+
+	send := func(ev *Event) bool {
+		select {
+		case ch <- ev:
+			return true
+		case <-ctx.Done():
+			return false
+		}
+	}
+
+	go func() {
+		defer close(ch)
+
+		buf := make([]byte, maxMessageSize)
+
+		for {
+			n, err := w.f.Read(buf)
+			if err != nil {
+				if errors.Is(err, os.ErrClosed) {
+					return
+				}
+
+				if errors.Is(err, unix.ENOBUFS) {
+					logger.Warn("uevent socket buffer overflow, some events were lost")
+
+					if !send(&Event{Action: ActionOverflow}) {
+						return
+					}
+
+					continue
+				}
+
+				logger.Error("error reading uevent", zap.Error(err))
+
+				return
+			}
+
+			ev, err := parse(buf[:n])
+			if err != nil {
+				logger.Debug("error parsing uevent", zap.Error(err))
+
+				continue
+			}
+
+			if !send(ev) {
+				return
+			}
+		}
+	}()
+
+	return ch
+}
+
+// Close stops the watcher.
+func (w *Watcher) Close() error {
+	return w.f.Close()
+}
+
+// parse parses the kernel uevent message.
+//
+// The message is a header in the form of 'action@devpath' followed by the 'KEY=VALUE' pairs, all separated with NUL bytes.
+func parse(msg []byte) (*Event, error) {
+	fields := bytes.Split(bytes.TrimRight(msg, "\x00"), []byte{0})
+
+	if len(fields) == 0 || !bytes.Contains(fields[0], []byte("@")) {
+		return nil, fmt.Errorf("invalid uevent header")
+	}
+
+	ev := &Event{
+		Values: make(map[string]string, len(fields)-1),
+	}
+
+	for _, field := range fields[1:] {
+		key, value, ok := strings.Cut(string(field), "=")
+		if !ok {
+			continue
+		}
+
+		ev.Values[key] = value
+	}
+
+	ev.Action = ev.Values["ACTION"]
+	ev.DevPath = ev.Values["DEVPATH"]
+	ev.Subsystem = ev.Values["SUBSYSTEM"]
+
+	if ev.Action == "" || ev.DevPath == "" {
+		return nil, fmt.Errorf("uevent is missing action or devpath")
+	}
+
+	return ev, nil
+}
@@ -0,0 +1,44 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package kobject //nolint:testpackage
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParse(t *testing.T) {
+	t.Parallel()
+
+	msg := strings.Join([]string{
+		"add@/devices/pci0000:00/0000:00:05.0/virtio2/block/vdb",
+		"ACTION=add",
+		"DEVPATH=/devices/pci0000:00/0000:00:05.0/virtio2/block/vdb",
+		"SUBSYSTEM=block",
+		"MAJOR=252",
+		"MINOR=16",
+		"DEVNAME=vdb",
+		"DEVTYPE=disk",
+		"SEQNUM=2143",
+	}, "\x00") + "\x00"
+
+	ev, err := parse([]byte(msg))
+	require.NoError(t, err)
+
+	assert.Equal(t, ActionAdd, ev.Action)
+	assert.Equal(t, "/devices/pci0000:00/0000:00:05.0/virtio2/block/vdb", ev.DevPath)
+	assert.Equal(t, "block", ev.Subsystem)
+	assert.Equal(t, "vdb", ev.Values["DEVNAME"])
+	assert.Equal(t, "disk", ev.Values["DEVTYPE"])
+
+	_, err = parse([]byte("libudev\x00\xfe\xed\xca\xfe"))
+	assert.Error(t, err)
+
+	_, err = parse([]byte("add@/devices/virtual/block/loop0\x00SUBSYSTEM=block\x00"))
+	assert.Error(t, err)
+}
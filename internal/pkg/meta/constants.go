@@ -23,4 +23,6 @@ const (
 	UserReserved2
 	// UserReserved3 is reserved for user-defined metadata.
 	UserReserved3
+	// UpgradeCheckpoint stores JSON-serialized progress of the upgrade sequence.
+	UpgradeCheckpoint
 )
@@ -398,6 +398,20 @@ func (p *Point) ResizePartition() (resized bool, err error) {
 // GrowFilesystem grows a partition's filesystem to the maximum size allowed.
 // NB: An XFS partition MUST be mounted, or this will fail.
 func (p *Point) GrowFilesystem() (err error) {
+	for _, hook := range p.Options.PreGrowHooks {
+		if err = hook(p); err != nil {
+			return err
+		}
+	}
+
+	if p.Fstype() == makefs.FilesystemTypeExt4 {
+		if err = makefs.Ext4Grow(p.Source()); err != nil {
+			return fmt.Errorf("resize2fs: %w", err)
+		}
+
+		return nil
+	}
+
 	if err = makefs.XFSGrow(p.Target()); err != nil {
 		return fmt.Errorf("xfs_growfs: %w", err)
 	}
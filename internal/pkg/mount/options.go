@@ -44,6 +44,7 @@ type Options struct {
 	MountFlags       Flags
 	PreMountHooks    []Hook
 	PostUnmountHooks []Hook
+	PreGrowHooks     []Hook
 	Encryption       config.Encryption
 	Logger           *log.Logger
 	ProjectQuota     bool
@@ -90,6 +91,13 @@ func WithPostUnmountHooks(hooks ...Hook) Option {
 	}
 }
 
+// WithPreGrowHooks adds functions to be called before growing the filesystem.
+func WithPreGrowHooks(hooks ...Hook) Option {
+	return func(args *Options) {
+		args.PreGrowHooks = append(args.PreGrowHooks, hooks...)
+	}
+}
+
 // WithEncryptionConfig partition encryption configuration.
 func WithEncryptionConfig(cfg config.Encryption) Option {
 	return func(args *Options) {
@@ -122,6 +130,7 @@ func NewDefaultOptions(setters ...Option) *Options {
 		MountFlags:       0,
 		PreMountHooks:    []Hook{},
 		PostUnmountHooks: []Hook{},
+		PreGrowHooks:     []Hook{},
 	}
 
 	for _, setter := range setters {
@@ -0,0 +1,95 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package mount
+
+import (
+	"github.com/siderolabs/go-blockdevice/blockdevice"
+	"github.com/siderolabs/go-blockdevice/blockdevice/filesystem"
+	"github.com/siderolabs/go-blockdevice/blockdevice/partition/gpt"
+	"golang.org/x/sys/unix"
+
+	"github.com/siderolabs/talos/internal/pkg/encryption"
+	"github.com/siderolabs/talos/internal/pkg/partition"
+)
+
+// UserVolumeMountPoint returns a mount point for the user volume partition.
+//
+// If the encryption is configured, the partition is encrypted (if empty) and opened before mounting.
+// If the partition doesn't have any filesystem, it is formatted with the specified filesystem type.
+func UserVolumeMountPoint(device *blockdevice.BlockDevice, part *gpt.Partition, target string, format *partition.FormatOptions, opts ...Option) (*Point, error) {
+	fsType, err := part.Filesystem()
+	if err != nil {
+		return nil, err
+	}
+
+	partPath, err := part.Path()
+	if err != nil {
+		return nil, err
+	}
+
+	o := NewDefaultOptions(opts...)
+
+	preMountHooks := []Hook{}
+
+	if o.Encryption != nil {
+		encryptionHandler, err := encryption.NewHandler(
+			device,
+			part,
+			o.Encryption,
+		)
+		if err != nil {
+			return nil, err
+		}
+
+		preMountHooks = append(preMountHooks,
+			func(p *Point) error {
+				path, err := encryptionHandler.Open()
+				if err != nil {
+					return err
+				}
+
+				p.source = path
+
+				return nil
+			},
+		)
+
+		opts = append(opts,
+			WithPostUnmountHooks(
+				func(p *Point) error {
+					return encryptionHandler.Close()
+				},
+			),
+			// the encrypted mapping should be grown to the new partition size before growing the filesystem
+			WithPreGrowHooks(
+				func(p *Point) error {
+					return encryptionHandler.Resize()
+				},
+			),
+		)
+	}
+
+	// Format the partition if it does not have any filesystem
+	preMountHooks = append(preMountHooks, func(p *Point) error {
+		sb, err := filesystem.Probe(p.source)
+		if err != nil {
+			return err
+		}
+
+		if sb != nil && sb.Type() != filesystem.Unknown {
+			p.fstype = sb.Type()
+
+			return nil
+		}
+
+		p.fstype = format.FileSystemType
+
+		return partition.Format(p.source, format)
+	})
+
+	opts = append(opts, WithPreMountHooks(preMountHooks...))
+
+	return NewMountPoint(partPath, target, fsType, unix.MS_NOATIME, "", opts...), nil
+}
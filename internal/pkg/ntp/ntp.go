@@ -13,6 +13,7 @@ import (
 	"math/bits"
 	"net"
 	"reflect"
+	"strings"
 	"sync"
 	"syscall"
 	"time"
@@ -23,6 +24,7 @@ import (
 	"go.uber.org/zap"
 	"go.uber.org/zap/zapcore"
 
+	"github.com/siderolabs/talos/internal/pkg/ntp/nts"
 	"github.com/siderolabs/talos/internal/pkg/timex"
 )
 
@@ -30,15 +32,23 @@ import (
 type Syncer struct {
 	logger *zap.Logger
 
-	timeServersMu  sync.Mutex
-	timeServers    []string
-	lastSyncServer string
+	timeServersMu         sync.Mutex
+	timeServers           []string
+	ntsFallback           bool
+	ntsNoCertTimeCheck    int
+	ntsCertTimeSkipped    int
+	lastSyncServer        string
+	lastSyncAuthenticated bool
 
 	timeSyncNotified bool
 	timeSynced       chan struct{}
 
-	restartSyncCh chan struct{}
-	epochChangeCh chan struct{}
+	syncStateMu sync.Mutex
+	syncState   SyncState
+
+	restartSyncCh  chan struct{}
+	epochChangeCh  chan struct{}
+	serverChangeCh chan struct{}
 
 	firstSync bool
 
@@ -52,6 +62,7 @@ type Syncer struct {
 	// these functions are overridden in tests for mocking support
 	CurrentTime CurrentTimeFunc
 	NTPQuery    QueryFunc
+	NTSQuery    QueryFunc
 	AdjustTime  AdjustTimeFunc
 }
 
@@ -69,8 +80,9 @@ func NewSyncer(logger *zap.Logger, timeServers []string) *Syncer {
 		timeServers: slices.Clone(timeServers),
 		timeSynced:  make(chan struct{}),
 
-		restartSyncCh: make(chan struct{}, 1),
-		epochChangeCh: make(chan struct{}, 1),
+		restartSyncCh:  make(chan struct{}, 1),
+		epochChangeCh:  make(chan struct{}, 1),
+		serverChangeCh: make(chan struct{}, 1),
 
 		firstSync: true,
 
@@ -85,6 +97,11 @@ func NewSyncer(logger *zap.Logger, timeServers []string) *Syncer {
 		AdjustTime:  timex.Adjtimex,
 	}
 
+	ntsClient := nts.NewClient()
+	ntsClient.CheckCertTime = syncer.CheckCertTime
+
+	syncer.NTSQuery = ntsClient.Query
+
 	return syncer
 }
 
@@ -98,6 +115,42 @@ func (syncer *Syncer) EpochChange() <-chan struct{} {
 	return syncer.epochChangeCh
 }
 
+// ServerChange returns a channel which receives a value each time the server used for sync changes.
+func (syncer *Syncer) ServerChange() <-chan struct{} {
+	return syncer.serverChangeCh
+}
+
+// SyncServer returns the server time was last synced with, and whether the server was authenticated via NTS.
+func (syncer *Syncer) SyncServer() (server string, authenticated bool) {
+	syncer.timeServersMu.Lock()
+	defer syncer.timeServersMu.Unlock()
+
+	return syncer.lastSyncServer, syncer.lastSyncAuthenticated
+}
+
+// SyncState returns the state of the last successful time sync.
+func (syncer *Syncer) SyncState() SyncState {
+	syncer.syncStateMu.Lock()
+	defer syncer.syncStateMu.Unlock()
+
+	return syncer.syncState
+}
+
+func (syncer *Syncer) setSyncState(resp *ntp.Response, server string) {
+	syncer.syncStateMu.Lock()
+	defer syncer.syncStateMu.Unlock()
+
+	syncer.syncState = SyncState{
+		Synced:         true,
+		Leap:           resp.Leap,
+		Stratum:        resp.Stratum,
+		ReferenceID:    referenceID(server),
+		ReferenceTime:  syncer.CurrentTime(),
+		RootDelay:      resp.RootDelay + resp.RTT,
+		RootDispersion: resp.RootDispersion + resp.MinError + time.Duration(syncer.samplesJitter*float64(time.Second)),
+	}
+}
+
 func (syncer *Syncer) getTimeServers() []string {
 	syncer.timeServersMu.Lock()
 	defer syncer.timeServersMu.Unlock()
@@ -112,11 +165,25 @@ func (syncer *Syncer) getLastSyncServer() string {
 	return syncer.lastSyncServer
 }
 
-func (syncer *Syncer) setLastSyncServer(lastSyncServer string) {
+func (syncer *Syncer) setLastSyncServer(lastSyncServer string, authenticated bool) {
 	syncer.timeServersMu.Lock()
 	defer syncer.timeServersMu.Unlock()
 
+	if syncer.lastSyncServer == lastSyncServer && syncer.lastSyncAuthenticated == authenticated {
+		return
+	}
+
 	syncer.lastSyncServer = lastSyncServer
+	syncer.lastSyncAuthenticated = authenticated
+
+	syncer.notifyServerChange()
+}
+
+func (syncer *Syncer) getNTSFallback() bool {
+	syncer.timeServersMu.Lock()
+	defer syncer.timeServersMu.Unlock()
+
+	return syncer.ntsFallback
 }
 
 // SetTimeServers sets the list of time servers to use.
@@ -130,10 +197,62 @@ func (syncer *Syncer) SetTimeServers(timeServers []string) {
 
 	syncer.timeServers = slices.Clone(timeServers)
 	syncer.lastSyncServer = ""
+	syncer.lastSyncAuthenticated = false
 
+	syncer.notifyServerChange()
 	syncer.restartSync()
 }
 
+// SetNTSFallback allows or forbids falling back to unauthenticated NTP for `nts://` servers.
+//
+// Fallback is used only if the NTS query fails (e.g. NTS-KE port is blocked).
+func (syncer *Syncer) SetNTSFallback(fallback bool) {
+	syncer.timeServersMu.Lock()
+	defer syncer.timeServersMu.Unlock()
+
+	syncer.ntsFallback = fallback
+}
+
+// SetNTSNoCertTimeCheck sets the number of NTS-KE exchanges before the first time sync
+// which skip the validity period check of the server certificate.
+func (syncer *Syncer) SetNTSNoCertTimeCheck(count int) {
+	syncer.timeServersMu.Lock()
+	defer syncer.timeServersMu.Unlock()
+
+	syncer.ntsNoCertTimeCheck = count
+}
+
+// CheckCertTime reports whether the validity period of the NTS-KE server certificate should be checked.
+//
+// The local clock might be too far off to check the validity period until the time is synced,
+// so the check is skipped for a limited number of exchanges, but only if explicitly configured:
+// an expired certificate might have a compromised key.
+func (syncer *Syncer) CheckCertTime() bool {
+	select {
+	case <-syncer.timeSynced:
+		return true
+	default:
+	}
+
+	syncer.timeServersMu.Lock()
+	defer syncer.timeServersMu.Unlock()
+
+	if syncer.ntsCertTimeSkipped >= syncer.ntsNoCertTimeCheck {
+		return true
+	}
+
+	syncer.ntsCertTimeSkipped++
+
+	return false
+}
+
+func (syncer *Syncer) notifyServerChange() {
+	select {
+	case syncer.serverChangeCh <- struct{}{}:
+	default:
+	}
+}
+
 func (syncer *Syncer) restartSync() {
 	select {
 	case syncer.restartSyncCh <- struct{}{}:
@@ -273,6 +392,8 @@ func (syncer *Syncer) Run(ctx context.Context) {
 			err = syncer.adjustTime(resp.ClockOffset, resp.Leap, lastSyncServer, pollInterval)
 
 			if err == nil {
+				syncer.setSyncState(resp, lastSyncServer)
+
 				if !syncer.timeSyncNotified {
 					// successful first time sync, notify about it
 					close(syncer.timeSynced)
@@ -299,13 +420,17 @@ func (syncer *Syncer) query(ctx context.Context) (lastSyncServer string, resp *n
 	failedServer := ""
 
 	if lastSyncServer != "" {
-		resp, err = syncer.queryServer(lastSyncServer)
+		var authenticated bool
+
+		resp, authenticated, err = syncer.queryServer(lastSyncServer)
 		if err != nil {
 			syncer.logger.Error(fmt.Sprintf("ntp query error with server %q", lastSyncServer), zap.Error(err))
 
 			failedServer = lastSyncServer
 			lastSyncServer = ""
 			err = nil
+		} else {
+			syncer.setLastSyncServer(lastSyncServer, authenticated)
 		}
 	}
 
@@ -331,12 +456,14 @@ func (syncer *Syncer) query(ctx context.Context) (lastSyncServer string, resp *n
 			default:
 			}
 
-			resp, err = syncer.queryServer(server)
+			var authenticated bool
+
+			resp, authenticated, err = syncer.queryServer(server)
 			if err != nil {
 				syncer.logger.Error(fmt.Sprintf("ntp query error with server %q", server), zap.Error(err))
 				err = nil
 			} else {
-				syncer.setLastSyncServer(server)
+				syncer.setLastSyncServer(server, authenticated)
 				lastSyncServer = server
 
 				break
@@ -351,6 +478,13 @@ func (syncer *Syncer) resolveServers(ctx context.Context) ([]string, error) {
 	var serverList []string
 
 	for _, server := range syncer.getTimeServers() {
+		if strings.HasPrefix(server, nts.SchemePrefix) {
+			// NTS servers are resolved during the key exchange, as TLS verification requires the hostname
+			serverList = append(serverList, server)
+
+			continue
+		}
+
 		ips, err := net.LookupIP(server)
 		if err != nil {
 			syncer.logger.Warn(fmt.Sprintf("failed looking up %q, ignored", server), zap.Error(err))
@@ -370,10 +504,27 @@ func (syncer *Syncer) resolveServers(ctx context.Context) ([]string, error) {
 	return serverList, nil
 }
 
-func (syncer *Syncer) queryServer(server string) (*ntp.Response, error) {
-	resp, err := syncer.NTPQuery(server)
+func (syncer *Syncer) queryServer(server string) (resp *ntp.Response, authenticated bool, err error) {
+	if strings.HasPrefix(server, nts.SchemePrefix) {
+		resp, err = syncer.NTSQuery(server)
+		authenticated = err == nil
+
+		if err != nil && syncer.getNTSFallback() {
+			syncer.logger.Warn(fmt.Sprintf("nts query error with server %q, falling back to unauthenticated ntp", server), zap.Error(err))
+
+			var host string
+
+			host, _, err = nts.ParseServer(server)
+			if err == nil {
+				resp, err = syncer.NTPQuery(host)
+			}
+		}
+	} else {
+		resp, err = syncer.NTPQuery(server)
+	}
+
 	if err != nil {
-		return nil, err
+		return nil, false, err
 	}
 
 	syncer.logger.Debug("NTP response",
@@ -385,13 +536,14 @@ func (syncer *Syncer) queryServer(server string) (*ntp.Response, error) {
 		zap.Duration("root_delay", resp.RootDelay),
 		zap.Duration("root_dispersion", resp.RootDispersion),
 		zap.Duration("root_distance", resp.RootDistance),
+		zap.Bool("authenticated", authenticated),
 	)
 
 	if err = resp.Validate(); err != nil {
-		return resp, err
+		return resp, authenticated, err
 	}
 
-	return resp, err
+	return resp, authenticated, err
 }
 
 // adjustTime adds an offset to the current time.
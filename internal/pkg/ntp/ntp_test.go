@@ -19,6 +19,8 @@ import (
 	"go.uber.org/zap"
 
 	"github.com/siderolabs/talos/internal/pkg/ntp"
+	"github.com/siderolabs/talos/internal/pkg/ntp/nts"
+	"github.com/siderolabs/talos/internal/pkg/ntp/nts/ntstest"
 	"github.com/siderolabs/talos/internal/pkg/timex"
 	"github.com/siderolabs/talos/pkg/logging"
 	"github.com/siderolabs/talos/pkg/machinery/constants"
@@ -165,6 +167,15 @@ func (suite *NTPSuite) fakeQuery(host string) (resp *beevikntp.Response, err err
 	}
 }
 
+func (suite *NTPSuite) fakeNTSQuery(server string) (resp *beevikntp.Response, err error) {
+	switch server {
+	case "nts://127.0.0.3": // adjust +1ms
+		return suite.fakeQuery("127.0.0.3")
+	default: // NTS-KE fails
+		return nil, fmt.Errorf("NTS-KE with %q failed", server)
+	}
+}
+
 func (suite *NTPSuite) TestSync() {
 	syncer := ntp.NewSyncer(logging.Wrap(log.Writer()).With(zap.String("controller", "ntp")), []string{constants.DefaultNTPServer})
 
@@ -476,3 +487,214 @@ func (suite *NTPSuite) TestSyncSwitchTimeservers() {
 		suite.Assert().Equal(2*time.Millisecond, suite.clockAdjustments[i])
 	}
 }
+
+func (suite *NTPSuite) TestSyncNTS() {
+	syncer := ntp.NewSyncer(logging.Wrap(log.Writer()).With(zap.String("controller", "ntp")), []string{"nts://127.0.0.1", "nts://127.0.0.3"})
+
+	syncer.AdjustTime = suite.adjustSystemClock
+	syncer.CurrentTime = suite.getSystemClock
+	syncer.NTPQuery = suite.fakeQuery
+	syncer.NTSQuery = suite.fakeNTSQuery
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	var wg sync.WaitGroup
+
+	wg.Add(1)
+
+	go func() {
+		defer wg.Done()
+
+		syncer.Run(ctx)
+	}()
+
+	select {
+	case <-syncer.Synced():
+	case <-time.After(10 * time.Second):
+		suite.Assert().Fail("time sync timeout")
+	}
+
+	select {
+	case <-syncer.ServerChange():
+	default:
+		suite.Assert().Fail("no server change notification")
+	}
+
+	server, authenticated := syncer.SyncServer()
+	suite.Assert().Equal("nts://127.0.0.3", server)
+	suite.Assert().True(authenticated)
+
+	state := syncer.SyncState()
+	suite.Assert().True(state.Synced)
+	suite.Assert().EqualValues(1, state.Stratum)
+	suite.Assert().EqualValues(0x7f000003, state.ReferenceID)
+	suite.Assert().Equal(time.Millisecond/2, state.RootDelay)
+
+	cancel()
+
+	wg.Wait()
+
+	suite.Assert().Equal(time.Millisecond, suite.clockAdjustments[0])
+}
+
+func (suite *NTPSuite) TestSyncNTSFallback() {
+	syncer := ntp.NewSyncer(logging.Wrap(log.Writer()).With(zap.String("controller", "ntp")), []string{"nts://127.0.0.4"})
+
+	syncer.AdjustTime = suite.adjustSystemClock
+	syncer.CurrentTime = suite.getSystemClock
+	syncer.NTPQuery = suite.fakeQuery
+	syncer.NTSQuery = suite.fakeNTSQuery
+
+	syncer.RetryPoll = 100 * time.Millisecond
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	var wg sync.WaitGroup
+
+	wg.Add(1)
+
+	go func() {
+		defer wg.Done()
+
+		syncer.Run(ctx)
+	}()
+
+	// NTS fails, and unauthenticated fallback is not allowed
+	select {
+	case <-syncer.Synced():
+		suite.Assert().Fail("unexpected sync")
+	case <-time.After(time.Second):
+	}
+
+	syncer.SetNTSFallback(true)
+
+	select {
+	case <-syncer.Synced():
+	case <-time.After(10 * time.Second):
+		suite.Assert().Fail("time sync timeout")
+	}
+
+	server, authenticated := syncer.SyncServer()
+	suite.Assert().Equal("nts://127.0.0.4", server)
+	suite.Assert().False(authenticated)
+
+	cancel()
+
+	wg.Wait()
+
+	suite.Assert().Equal(2*time.Millisecond, suite.clockAdjustments[0])
+}
+
+func (suite *NTPSuite) TestSyncNTSServer() {
+	ntsServer, err := ntstest.NewServer()
+	suite.Require().NoError(err)
+
+	defer ntsServer.Close()
+
+	ntsServer.SetOffset(time.Second)
+
+	ntsClient := nts.NewClient()
+	ntsClient.TLSConfig = ntsServer.TLSConfig
+
+	syncer := ntp.NewSyncer(logging.Wrap(log.Writer()).With(zap.String("controller", "ntp")), []string{ntsServer.URL})
+
+	syncer.AdjustTime = suite.adjustSystemClock
+	syncer.CurrentTime = suite.getSystemClock
+	syncer.NTSQuery = ntsClient.Query
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	var wg sync.WaitGroup
+
+	wg.Add(1)
+
+	go func() {
+		defer wg.Done()
+
+		syncer.Run(ctx)
+	}()
+
+	select {
+	case <-syncer.Synced():
+	case <-time.After(10 * time.Second):
+		suite.Assert().Fail("time sync timeout")
+	}
+
+	server, authenticated := syncer.SyncServer()
+	suite.Assert().Equal(ntsServer.URL, server)
+	suite.Assert().True(authenticated)
+
+	cancel()
+
+	wg.Wait()
+
+	suite.Assert().Equal(1, ntsServer.Requests())
+}
+
+func (suite *NTPSuite) TestSyncNTSExpiredCertificate() {
+	ntsServer, err := ntstest.NewServer()
+	suite.Require().NoError(err)
+
+	defer ntsServer.Close()
+
+	syncer := ntp.NewSyncer(logging.Wrap(log.Writer()).With(zap.String("controller", "ntp")), []string{ntsServer.URL})
+
+	// the server certificate has expired by the local clock
+	ntsClient := nts.NewClient()
+	ntsClient.TLSConfig = ntsServer.TLSConfig.Clone()
+	ntsClient.TLSConfig.Time = func() time.Time {
+		return time.Now().AddDate(1, 0, 0)
+	}
+	ntsClient.CheckCertTime = syncer.CheckCertTime
+
+	syncer.AdjustTime = suite.adjustSystemClock
+	syncer.CurrentTime = suite.getSystemClock
+	syncer.NTSQuery = ntsClient.Query
+
+	syncer.RetryPoll = 100 * time.Millisecond
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	var wg sync.WaitGroup
+
+	wg.Add(1)
+
+	go func() {
+		defer wg.Done()
+
+		syncer.Run(ctx)
+	}()
+
+	// the expired certificate is rejected by default
+	select {
+	case <-syncer.Synced():
+		suite.Assert().Fail("unexpected sync")
+	case <-time.After(time.Second):
+	}
+
+	suite.Assert().Zero(ntsServer.Requests())
+
+	// the validity period check is skipped only if explicitly allowed
+	syncer.SetNTSNoCertTimeCheck(1)
+
+	select {
+	case <-syncer.Synced():
+	case <-time.After(10 * time.Second):
+		suite.Assert().Fail("time sync timeout")
+	}
+
+	server, authenticated := syncer.SyncServer()
+	suite.Assert().Equal(ntsServer.URL, server)
+	suite.Assert().True(authenticated)
+
+	cancel()
+
+	wg.Wait()
+
+	// once the time is synced, the validity period is checked again
+	suite.Assert().True(syncer.CheckCertTime())
+}
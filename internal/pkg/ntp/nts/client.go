@@ -0,0 +1,119 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+// Package nts implements Network Time Security (RFC 8915) client for NTPv4.
+package nts
+
+import (
+	"context"
+	"crypto/tls"
+	"errors"
+	"fmt"
+	"net"
+	"net/url"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/beevik/ntp"
+)
+
+// SchemePrefix is the prefix of time servers which should be queried via NTS.
+const SchemePrefix = "nts://"
+
+const defaultTimeout = 5 * time.Second
+
+// ParseServer parses time server in `nts://host[:port]` format.
+//
+// It returns the host name and the NTS-KE server address, port defaults to DefaultKEPort.
+func ParseServer(server string) (host, keAddress string, err error) {
+	u, err := url.Parse(server)
+	if err != nil {
+		return "", "", err
+	}
+
+	if u.Scheme+"://" != SchemePrefix {
+		return "", "", fmt.Errorf("unsupported scheme %q", u.Scheme)
+	}
+
+	if u.Hostname() == "" || u.User != nil || (u.Path != "" && u.Path != "/") || u.RawQuery != "" {
+		return "", "", fmt.Errorf("invalid NTS server %q", server)
+	}
+
+	port := u.Port()
+	if port == "" {
+		port = strconv.Itoa(DefaultKEPort)
+	}
+
+	return u.Hostname(), net.JoinHostPort(u.Hostname(), port), nil
+}
+
+// Client performs NTS-authenticated NTP queries.
+//
+// Client keeps a session per server, NTS-KE is performed again when the session
+// runs out of cookies or the server rejects the cookie.
+type Client struct {
+	// TLSConfig is used for NTS-KE connections, system root CAs are used if nil.
+	TLSConfig *tls.Config
+	// Timeout for NTS-KE and NTP requests.
+	Timeout time.Duration
+	// CheckCertTime reports whether the validity period of NTS-KE server certificates should be verified
+	// against the local clock, it is always verified if nil.
+	CheckCertTime func() bool
+
+	mu       sync.Mutex
+	sessions map[string]*Session
+}
+
+// NewClient creates a new Client with default configuration.
+func NewClient() *Client {
+	return &Client{
+		Timeout:  defaultTimeout,
+		sessions: map[string]*Session{},
+	}
+}
+
+// Query performs authenticated NTP query to the server in `nts://host[:port]` format.
+func (client *Client) Query(server string) (*ntp.Response, error) {
+	_, keAddress, err := ParseServer(server)
+	if err != nil {
+		return nil, err
+	}
+
+	client.mu.Lock()
+	defer client.mu.Unlock()
+
+	session := client.sessions[keAddress]
+
+	if session == nil || session.Cookies() == 0 {
+		ctx, cancel := context.WithTimeout(context.Background(), client.Timeout)
+		defer cancel()
+
+		session, err = KeyExchange(ctx, keAddress, client.TLSConfig, client.CheckCertTime == nil || client.CheckCertTime())
+		if err != nil {
+			delete(client.sessions, keAddress)
+
+			return nil, fmt.Errorf("NTS-KE with %q failed: %w", keAddress, err)
+		}
+
+		client.sessions[keAddress] = session
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), client.Timeout)
+	defer cancel()
+
+	resp, err := session.Query(ctx)
+	if err != nil {
+		var netErr net.Error
+
+		if !errors.As(err, &netErr) {
+			// anything but network errors requires a new NTS-KE
+			delete(client.sessions, keAddress)
+		}
+
+		return nil, err
+	}
+
+	return resp, nil
+}
@@ -0,0 +1,216 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package nts_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/siderolabs/talos/internal/pkg/ntp/nts"
+	"github.com/siderolabs/talos/internal/pkg/ntp/nts/ntstest"
+)
+
+func TestParseServer(t *testing.T) {
+	t.Parallel()
+
+	for _, test := range []struct {
+		server string
+
+		expectedHost      string
+		expectedKEAddress string
+		expectedError     string
+	}{
+		{
+			server:            "nts://time.cloudflare.com",
+			expectedHost:      "time.cloudflare.com",
+			expectedKEAddress: "time.cloudflare.com:4460",
+		},
+		{
+			server:            "nts://10.0.0.1:1234",
+			expectedHost:      "10.0.0.1",
+			expectedKEAddress: "10.0.0.1:1234",
+		},
+		{
+			server:            "nts://[2001:db8::1]",
+			expectedHost:      "2001:db8::1",
+			expectedKEAddress: "[2001:db8::1]:4460",
+		},
+		{
+			server:        "time.cloudflare.com",
+			expectedError: "unsupported scheme \"\"",
+		},
+		{
+			server:        "nts://time.cloudflare.com/path",
+			expectedError: "invalid NTS server \"nts://time.cloudflare.com/path\"",
+		},
+	} {
+		test := test
+
+		t.Run(test.server, func(t *testing.T) {
+			t.Parallel()
+
+			host, keAddress, err := nts.ParseServer(test.server)
+
+			if test.expectedError != "" {
+				assert.EqualError(t, err, test.expectedError)
+
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, test.expectedHost, host)
+			assert.Equal(t, test.expectedKEAddress, keAddress)
+		})
+	}
+}
+
+func TestKeyExchange(t *testing.T) {
+	t.Parallel()
+
+	server, err := ntstest.NewServer()
+	require.NoError(t, err)
+
+	t.Cleanup(server.Close)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	t.Cleanup(cancel)
+
+	_, keAddress, err := nts.ParseServer(server.URL)
+	require.NoError(t, err)
+
+	session, err := nts.KeyExchange(ctx, keAddress, server.TLSConfig, true)
+	require.NoError(t, err)
+
+	assert.Equal(t, 8, session.Cookies())
+
+	for i := 0; i < 20; i++ {
+		resp, err := session.Query(ctx)
+		require.NoError(t, err)
+
+		assert.NoError(t, resp.Validate())
+
+		// the server returns a fresh cookie for each one used
+		assert.Equal(t, 8, session.Cookies())
+	}
+
+	// the session doesn't verify against the system roots
+	_, err = nts.KeyExchange(ctx, keAddress, nil, true)
+	assert.Error(t, err)
+}
+
+func TestKeyExchangeSkewedClock(t *testing.T) {
+	t.Parallel()
+
+	server, err := ntstest.NewServer()
+	require.NoError(t, err)
+
+	t.Cleanup(server.Close)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	t.Cleanup(cancel)
+
+	_, keAddress, err := nts.ParseServer(server.URL)
+	require.NoError(t, err)
+
+	// the local clock is years behind, e.g. RTC was reset
+	tlsConfig := server.TLSConfig.Clone()
+	tlsConfig.Time = func() time.Time {
+		return time.Now().AddDate(-10, 0, 0)
+	}
+
+	_, err = nts.KeyExchange(ctx, keAddress, tlsConfig, true)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "certificate has expired or is not yet valid")
+
+	// without the time check, the server certificate is accepted
+	session, err := nts.KeyExchange(ctx, keAddress, tlsConfig, false)
+	require.NoError(t, err)
+
+	assert.Equal(t, 8, session.Cookies())
+
+	// the chain is still verified
+	_, err = nts.KeyExchange(ctx, keAddress, nil, false)
+	assert.Error(t, err)
+
+	// and the server name
+	tlsConfig.ServerName = "time.example.com"
+
+	_, err = nts.KeyExchange(ctx, keAddress, tlsConfig, false)
+	assert.Error(t, err)
+
+	// the client skips the time check until it's told the clock is synced
+	synced := false
+
+	client := nts.NewClient()
+	client.TLSConfig = server.TLSConfig.Clone()
+	client.TLSConfig.Time = tlsConfig.Time
+	client.CheckCertTime = func() bool { return synced }
+
+	_, err = client.Query(server.URL)
+	require.NoError(t, err)
+
+	synced = true
+
+	// force a new NTS-KE
+	server.SetNAK(true)
+
+	_, err = client.Query(server.URL)
+	assert.ErrorIs(t, err, nts.ErrNAK)
+
+	server.SetNAK(false)
+
+	_, err = client.Query(server.URL)
+	assert.Error(t, err)
+}
+
+func TestClient(t *testing.T) {
+	t.Parallel()
+
+	server, err := ntstest.NewServer()
+	require.NoError(t, err)
+
+	t.Cleanup(server.Close)
+
+	server.SetOffset(time.Hour)
+
+	client := nts.NewClient()
+	client.TLSConfig = server.TLSConfig
+
+	resp, err := client.Query(server.URL)
+	require.NoError(t, err)
+
+	assert.NoError(t, resp.Validate())
+	assert.InDelta(t, time.Hour, resp.ClockOffset, float64(time.Second))
+	assert.Equal(t, 1, server.Requests())
+
+	// responses which fail authentication are rejected
+	server.SetCorrupt(true)
+
+	_, err = client.Query(server.URL)
+	assert.Error(t, err)
+
+	server.SetCorrupt(false)
+
+	// NTS-KE is performed again, so the query succeeds
+	resp, err = client.Query(server.URL)
+	require.NoError(t, err)
+
+	assert.NoError(t, resp.Validate())
+
+	// rejected cookies are reported as NAK
+	server.SetNAK(true)
+
+	_, err = client.Query(server.URL)
+	assert.ErrorIs(t, err, nts.ErrNAK)
+
+	server.SetNAK(false)
+
+	_, err = client.Query(server.URL)
+	assert.NoError(t, err)
+}
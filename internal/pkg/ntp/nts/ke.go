@@ -0,0 +1,292 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package nts
+
+import (
+	"context"
+	"crypto/cipher"
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"strconv"
+)
+
+// NTS-KE constants, RFC 8915.
+const (
+	// DefaultKEPort is the default NTS-KE TCP port.
+	DefaultKEPort = 4460
+	// ALPN is the TLS ALPN protocol ID for NTS-KE.
+	ALPN = "ntske/1"
+
+	// ProtocolNTPv4 is the NTS next protocol ID for NTPv4.
+	ProtocolNTPv4 uint16 = 0
+	// AEADAESSIVCMAC256 is the IANA AEAD algorithm ID for AEAD_AES_SIV_CMAC_256.
+	AEADAESSIVCMAC256 uint16 = 15
+
+	keyExporterLabel = "EXPORTER-network-time-security"
+	keySize          = 32
+)
+
+// NTS-KE record types.
+const (
+	RecordEndOfMessage  uint16 = 0
+	RecordNextProtocol  uint16 = 1
+	RecordError         uint16 = 2
+	RecordWarning       uint16 = 3
+	RecordAEADAlgorithm uint16 = 4
+	RecordNewCookie     uint16 = 5
+	RecordNTPv4Server   uint16 = 6
+	RecordNTPv4Port     uint16 = 7
+
+	recordCriticalBit uint16 = 0x8000
+	defaultNTPv4Port         = 123
+)
+
+// Record is a single NTS-KE protocol record.
+type Record struct {
+	Type     uint16
+	Critical bool
+	Body     []byte
+}
+
+// Uint16Record builds a record which body is a single 16-bit value.
+func Uint16Record(typ uint16, critical bool, value uint16) Record {
+	return Record{
+		Type:     typ,
+		Critical: critical,
+		Body:     binary.BigEndian.AppendUint16(nil, value),
+	}
+}
+
+// Append appends wire representation of the record to b.
+func (rec Record) Append(b []byte) []byte {
+	typ := rec.Type
+
+	if rec.Critical {
+		typ |= recordCriticalBit
+	}
+
+	b = binary.BigEndian.AppendUint16(b, typ)
+	b = binary.BigEndian.AppendUint16(b, uint16(len(rec.Body)))
+
+	return append(b, rec.Body...)
+}
+
+// ReadRecord reads a single record from r.
+func ReadRecord(r io.Reader) (Record, error) {
+	var hdr [4]byte
+
+	if _, err := io.ReadFull(r, hdr[:]); err != nil {
+		return Record{}, err
+	}
+
+	typ := binary.BigEndian.Uint16(hdr[:2])
+
+	rec := Record{
+		Type:     typ &^ recordCriticalBit,
+		Critical: typ&recordCriticalBit != 0,
+		Body:     make([]byte, binary.BigEndian.Uint16(hdr[2:])),
+	}
+
+	if _, err := io.ReadFull(r, rec.Body); err != nil {
+		return Record{}, err
+	}
+
+	return rec, nil
+}
+
+// ExportKeys derives client-to-server and server-to-client AEAD ciphers from the NTS-KE TLS session.
+func ExportKeys(state tls.ConnectionState) (c2s, s2c cipher.AEAD, err error) {
+	exportKey := func(direction byte) (cipher.AEAD, error) {
+		keyContext := binary.BigEndian.AppendUint16(nil, ProtocolNTPv4)
+		keyContext = binary.BigEndian.AppendUint16(keyContext, AEADAESSIVCMAC256)
+		keyContext = append(keyContext, direction)
+
+		key, err := state.ExportKeyingMaterial(keyExporterLabel, keyContext, keySize)
+		if err != nil {
+			return nil, fmt.Errorf("error exporting keys: %w", err)
+		}
+
+		return NewAESSIV(key)
+	}
+
+	if c2s, err = exportKey(0); err != nil {
+		return nil, nil, err
+	}
+
+	if s2c, err = exportKey(1); err != nil {
+		return nil, nil, err
+	}
+
+	return c2s, s2c, nil
+}
+
+// KeyExchange performs NTS-KE with the server, returning a new NTS session.
+//
+// If tlsConfig is nil, system root CAs are used to verify the server.
+// If checkCertTime is false, the validity period of the server certificate is not verified
+// against the local clock (see verifyIgnoringTime).
+func KeyExchange(ctx context.Context, address string, tlsConfig *tls.Config, checkCertTime bool) (*Session, error) {
+	host, _, err := net.SplitHostPort(address)
+	if err != nil {
+		return nil, err
+	}
+
+	if tlsConfig == nil {
+		tlsConfig = &tls.Config{}
+	} else {
+		tlsConfig = tlsConfig.Clone()
+	}
+
+	tlsConfig.MinVersion = tls.VersionTLS13
+	tlsConfig.NextProtos = []string{ALPN}
+
+	if tlsConfig.ServerName == "" {
+		tlsConfig.ServerName = host
+	}
+
+	if !checkCertTime && !tlsConfig.InsecureSkipVerify {
+		// the standard verification is replaced with the custom one
+		tlsConfig.InsecureSkipVerify = true
+		tlsConfig.VerifyConnection = verifyIgnoringTime(tlsConfig.RootCAs, tlsConfig.ServerName)
+	}
+
+	dialer := tls.Dialer{
+		Config: tlsConfig,
+	}
+
+	conn, err := dialer.DialContext(ctx, "tcp", address)
+	if err != nil {
+		return nil, fmt.Errorf("error connecting to NTS-KE server: %w", err)
+	}
+
+	defer conn.Close() //nolint:errcheck
+
+	if deadline, ok := ctx.Deadline(); ok {
+		if err = conn.SetDeadline(deadline); err != nil {
+			return nil, err
+		}
+	}
+
+	var req []byte
+
+	req = Uint16Record(RecordNextProtocol, true, ProtocolNTPv4).Append(req)
+	req = Uint16Record(RecordAEADAlgorithm, false, AEADAESSIVCMAC256).Append(req)
+	req = Record{Type: RecordEndOfMessage, Critical: true}.Append(req)
+
+	if _, err = conn.Write(req); err != nil {
+		return nil, fmt.Errorf("error sending NTS-KE request: %w", err)
+	}
+
+	session := &Session{}
+
+	var (
+		ntpHost        = host
+		ntpPort        = defaultNTPv4Port
+		protocolAgreed bool
+		aeadAgreed     bool
+	)
+
+	for {
+		var rec Record
+
+		rec, err = ReadRecord(conn)
+		if err != nil {
+			return nil, fmt.Errorf("error reading NTS-KE response: %w", err)
+		}
+
+		if rec.Type == RecordEndOfMessage {
+			break
+		}
+
+		switch rec.Type {
+		case RecordNextProtocol:
+			protocolAgreed = len(rec.Body) == 2 && binary.BigEndian.Uint16(rec.Body) == ProtocolNTPv4
+		case RecordAEADAlgorithm:
+			aeadAgreed = len(rec.Body) == 2 && binary.BigEndian.Uint16(rec.Body) == AEADAESSIVCMAC256
+		case RecordError:
+			if len(rec.Body) != 2 {
+				return nil, errors.New("NTS-KE server returned malformed error")
+			}
+
+			return nil, fmt.Errorf("NTS-KE server returned error code %d", binary.BigEndian.Uint16(rec.Body))
+		case RecordWarning:
+			// warnings are not fatal
+		case RecordNewCookie:
+			session.cookies = append(session.cookies, rec.Body)
+		case RecordNTPv4Server:
+			ntpHost = string(rec.Body)
+		case RecordNTPv4Port:
+			if len(rec.Body) != 2 {
+				return nil, errors.New("NTS-KE server returned malformed port")
+			}
+
+			ntpPort = int(binary.BigEndian.Uint16(rec.Body))
+		default:
+			if rec.Critical {
+				return nil, fmt.Errorf("NTS-KE server returned unsupported critical record %d", rec.Type)
+			}
+		}
+	}
+
+	if !protocolAgreed {
+		return nil, errors.New("NTS-KE server doesn't support NTPv4")
+	}
+
+	if !aeadAgreed {
+		return nil, errors.New("NTS-KE server doesn't support AEAD_AES_SIV_CMAC_256")
+	}
+
+	if len(session.cookies) == 0 {
+		return nil, errors.New("NTS-KE server returned no cookies")
+	}
+
+	session.address = net.JoinHostPort(ntpHost, strconv.Itoa(ntpPort))
+
+	if session.c2s, session.s2c, err = ExportKeys(conn.(*tls.Conn).ConnectionState()); err != nil { //nolint:forcetypeassert
+		return nil, err
+	}
+
+	return session, nil
+}
+
+// verifyIgnoringTime verifies the server certificate chain without relying on the local clock.
+//
+// The local clock might be way off before the time is synced for the first time (e.g. RTC is not set),
+// which would fail the standard certificate validity period check, and NTS would never get a chance to fix
+// the clock. Like chrony's nocerttimecheck, the chain is verified at the point in time which is within
+// the validity period of every presented certificate, all other checks (roots, hostname, key usage) still apply.
+func verifyIgnoringTime(roots *x509.CertPool, serverName string) func(tls.ConnectionState) error {
+	return func(cs tls.ConnectionState) error {
+		if len(cs.PeerCertificates) == 0 {
+			return errors.New("NTS-KE server didn't present a certificate")
+		}
+
+		opts := x509.VerifyOptions{
+			Roots:         roots,
+			DNSName:       serverName,
+			Intermediates: x509.NewCertPool(),
+		}
+
+		for _, cert := range cs.PeerCertificates[1:] {
+			opts.Intermediates.AddCert(cert)
+		}
+
+		// the latest NotBefore is within the validity period of all certificates, if the periods overlap
+		for _, cert := range cs.PeerCertificates {
+			if cert.NotBefore.After(opts.CurrentTime) {
+				opts.CurrentTime = cert.NotBefore
+			}
+		}
+
+		_, err := cs.PeerCertificates[0].Verify(opts)
+
+		return err
+	}
+}
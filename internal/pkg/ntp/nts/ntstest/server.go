@@ -0,0 +1,384 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+// Package ntstest provides a local NTS server for tests.
+package ntstest
+
+import (
+	"crypto/cipher"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/binary"
+	"errors"
+	"math/big"
+	"net"
+	"sync"
+	"time"
+
+	"github.com/siderolabs/talos/internal/pkg/ntp/nts"
+)
+
+// Server is a minimal NTS server (NTS-KE and NTPv4) listening on the loopback interface.
+type Server struct {
+	// URL of the server in `nts://host:port` format.
+	URL string
+	// TLSConfig trusts the server certificate.
+	TLSConfig *tls.Config
+
+	keListener net.Listener
+	ntpConn    net.PacketConn
+
+	wg sync.WaitGroup
+
+	mu       sync.Mutex
+	keys     map[string]sessionKeys
+	offset   time.Duration
+	corrupt  bool
+	nak      bool
+	requests int
+}
+
+type sessionKeys struct {
+	c2s, s2c cipher.AEAD
+}
+
+// NewServer starts a new test NTS server.
+func NewServer() (*Server, error) {
+	cert, pool, err := selfSignedCertificate()
+	if err != nil {
+		return nil, err
+	}
+
+	keListener, err := tls.Listen("tcp", "127.0.0.1:0", &tls.Config{
+		Certificates: []tls.Certificate{cert},
+		MinVersion:   tls.VersionTLS13,
+		NextProtos:   []string{nts.ALPN},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	ntpConn, err := net.ListenPacket("udp", "127.0.0.1:0")
+	if err != nil {
+		keListener.Close() //nolint:errcheck
+
+		return nil, err
+	}
+
+	server := &Server{
+		URL: nts.SchemePrefix + keListener.Addr().String(),
+		TLSConfig: &tls.Config{
+			RootCAs:    pool,
+			ServerName: "localhost",
+		},
+
+		keListener: keListener,
+		ntpConn:    ntpConn,
+
+		keys: map[string]sessionKeys{},
+	}
+
+	server.wg.Add(2)
+
+	go server.serveKE()
+	go server.serveNTP()
+
+	return server, nil
+}
+
+// Close stops the server.
+func (server *Server) Close() {
+	server.keListener.Close() //nolint:errcheck
+	server.ntpConn.Close()    //nolint:errcheck
+
+	server.wg.Wait()
+}
+
+// SetOffset sets the offset of the server clock relative to the local clock.
+func (server *Server) SetOffset(offset time.Duration) {
+	server.mu.Lock()
+	defer server.mu.Unlock()
+
+	server.offset = offset
+}
+
+// SetCorrupt makes the server send responses with an invalid authenticator.
+func (server *Server) SetCorrupt(corrupt bool) {
+	server.mu.Lock()
+	defer server.mu.Unlock()
+
+	server.corrupt = corrupt
+}
+
+// SetNAK makes the server reject all cookies.
+func (server *Server) SetNAK(nak bool) {
+	server.mu.Lock()
+	defer server.mu.Unlock()
+
+	server.nak = nak
+}
+
+// Requests returns the number of authenticated NTP requests served.
+func (server *Server) Requests() int {
+	server.mu.Lock()
+	defer server.mu.Unlock()
+
+	return server.requests
+}
+
+func (server *Server) serveKE() {
+	defer server.wg.Done()
+
+	for {
+		conn, err := server.keListener.Accept()
+		if err != nil {
+			return
+		}
+
+		server.handleKE(conn.(*tls.Conn)) //nolint:errcheck,forcetypeassert
+	}
+}
+
+func (server *Server) handleKE(conn *tls.Conn) error {
+	defer conn.Close() //nolint:errcheck
+
+	if err := conn.SetDeadline(time.Now().Add(5 * time.Second)); err != nil {
+		return err
+	}
+
+	for {
+		rec, err := nts.ReadRecord(conn)
+		if err != nil {
+			return err
+		}
+
+		if rec.Type == nts.RecordEndOfMessage {
+			break
+		}
+	}
+
+	c2s, s2c, err := nts.ExportKeys(conn.ConnectionState())
+	if err != nil {
+		return err
+	}
+
+	_, port, err := net.SplitHostPort(server.ntpConn.LocalAddr().String())
+	if err != nil {
+		return err
+	}
+
+	ntpPort, err := net.LookupPort("udp", port)
+	if err != nil {
+		return err
+	}
+
+	var resp []byte
+
+	resp = nts.Uint16Record(nts.RecordNextProtocol, true, nts.ProtocolNTPv4).Append(resp)
+	resp = nts.Uint16Record(nts.RecordAEADAlgorithm, false, nts.AEADAESSIVCMAC256).Append(resp)
+	resp = nts.Record{Type: nts.RecordNTPv4Server, Body: []byte("127.0.0.1")}.Append(resp)
+	resp = nts.Uint16Record(nts.RecordNTPv4Port, false, uint16(ntpPort)).Append(resp)
+
+	for i := 0; i < 8; i++ {
+		var cookie []byte
+
+		if cookie, err = server.newCookie(sessionKeys{c2s: c2s, s2c: s2c}); err != nil {
+			return err
+		}
+
+		resp = nts.Record{Type: nts.RecordNewCookie, Body: cookie}.Append(resp)
+	}
+
+	resp = nts.Record{Type: nts.RecordEndOfMessage, Critical: true}.Append(resp)
+
+	_, err = conn.Write(resp)
+
+	return err
+}
+
+// newCookie stores session keys and returns an opaque cookie referencing them.
+func (server *Server) newCookie(keys sessionKeys) ([]byte, error) {
+	cookie := make([]byte, 32)
+
+	if _, err := rand.Read(cookie); err != nil {
+		return nil, err
+	}
+
+	server.mu.Lock()
+	defer server.mu.Unlock()
+
+	server.keys[string(cookie)] = keys
+
+	return cookie, nil
+}
+
+func (server *Server) serveNTP() {
+	defer server.wg.Done()
+
+	buf := make([]byte, 2048)
+
+	for {
+		n, addr, err := server.ntpConn.ReadFrom(buf)
+		if err != nil {
+			return
+		}
+
+		resp, err := server.handleNTP(buf[:n], time.Now())
+		if err != nil {
+			continue
+		}
+
+		server.ntpConn.WriteTo(resp, addr) //nolint:errcheck
+	}
+}
+
+//nolint:gocyclo,cyclop
+func (server *Server) handleNTP(packet []byte, receiveTime time.Time) ([]byte, error) {
+	req, err := nts.ParseHeader(packet)
+	if err != nil {
+		return nil, err
+	}
+
+	fields, err := nts.ParseExtensionFields(packet)
+	if err != nil {
+		return nil, err
+	}
+
+	var (
+		uniqueID     []byte
+		keys         sessionKeys
+		keysFound    bool
+		placeholders int
+		verified     bool
+	)
+
+	server.mu.Lock()
+	offset, corrupt, nak := server.offset, server.corrupt, server.nak
+	server.mu.Unlock()
+
+	for _, field := range fields {
+		switch field.Type {
+		case nts.ExtUniqueIdentifier:
+			uniqueID = field.Body
+		case nts.ExtCookie:
+			server.mu.Lock()
+			keys, keysFound = server.keys[string(field.Body)]
+			delete(server.keys, string(field.Body))
+			server.mu.Unlock()
+		case nts.ExtCookiePlaceholder:
+			placeholders++
+		case nts.ExtAuthenticator:
+			if !keysFound {
+				break
+			}
+
+			if _, err = nts.OpenAuthenticator(packet, field, keys.c2s); err != nil {
+				return nil, err
+			}
+
+			verified = true
+		}
+	}
+
+	if uniqueID == nil {
+		return nil, errors.New("missing unique identifier")
+	}
+
+	resp := nts.Header{
+		Poll:         req.Poll,
+		OriginTime:   req.TransmitTime,
+		ReceiveTime:  nts.ToNTPTime(receiveTime.Add(offset)),
+		TransmitTime: nts.ToNTPTime(time.Now().Add(offset)),
+	}
+
+	if nak || !keysFound {
+		// kiss-o'-death, the client should perform NTS-KE again
+		resp.SetLiVnMode(nts.LeapNotInSync, nts.Version, nts.ModeServer)
+		resp.ReferenceID = binary.BigEndian.Uint32([]byte(nts.KissNTSNAK))
+
+		return nts.AppendExtensionField(resp.Append(nil), nts.ExtUniqueIdentifier, uniqueID), nil
+	}
+
+	if !verified {
+		return nil, errors.New("request is not authenticated")
+	}
+
+	resp.SetLiVnMode(0, nts.Version, nts.ModeServer)
+	resp.Stratum = 1
+	resp.Precision = -20
+	resp.ReferenceID = binary.BigEndian.Uint32([]byte("LOCL"))
+	resp.ReferenceTime = resp.ReceiveTime
+
+	var plaintext []byte
+
+	for i := 0; i < placeholders+1; i++ {
+		var cookie []byte
+
+		if cookie, err = server.newCookie(keys); err != nil {
+			return nil, err
+		}
+
+		plaintext = nts.AppendExtensionField(plaintext, nts.ExtCookie, cookie)
+	}
+
+	packet = nts.AppendExtensionField(resp.Append(nil), nts.ExtUniqueIdentifier, uniqueID)
+
+	packet, err = nts.AppendAuthenticator(packet, keys.s2c, plaintext)
+	if err != nil {
+		return nil, err
+	}
+
+	if corrupt {
+		packet[len(packet)-1] ^= 0xff
+	}
+
+	server.mu.Lock()
+	server.requests++
+	server.mu.Unlock()
+
+	return packet, nil
+}
+
+func selfSignedCertificate() (tls.Certificate, *x509.CertPool, error) {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		return tls.Certificate{}, nil, err
+	}
+
+	template := &x509.Certificate{
+		SerialNumber:          big.NewInt(1),
+		Subject:               pkix.Name{CommonName: "localhost"},
+		DNSNames:              []string{"localhost"},
+		IPAddresses:           []net.IP{net.IPv4(127, 0, 0, 1)},
+		NotBefore:             time.Now().Add(-time.Hour),
+		NotAfter:              time.Now().Add(time.Hour),
+		KeyUsage:              x509.KeyUsageDigitalSignature | x509.KeyUsageCertSign,
+		ExtKeyUsage:           []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth},
+		BasicConstraintsValid: true,
+		IsCA:                  true,
+	}
+
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	if err != nil {
+		return tls.Certificate{}, nil, err
+	}
+
+	cert, err := x509.ParseCertificate(der)
+	if err != nil {
+		return tls.Certificate{}, nil, err
+	}
+
+	pool := x509.NewCertPool()
+	pool.AddCert(cert)
+
+	return tls.Certificate{
+		Certificate: [][]byte{der},
+		PrivateKey:  key,
+		Leaf:        cert,
+	}, pool, nil
+}
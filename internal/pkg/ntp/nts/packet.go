@@ -0,0 +1,224 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package nts
+
+import (
+	"bytes"
+	"crypto/cipher"
+	"crypto/rand"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"time"
+)
+
+// NTP packet constants.
+const (
+	// HeaderSize is the size of the NTPv4 packet header.
+	HeaderSize = 48
+
+	// ModeClient is the NTP client mode.
+	ModeClient = 3
+	// ModeServer is the NTP server mode.
+	ModeServer = 4
+	// Version is the NTP version.
+	Version = 4
+
+	// LeapNotInSync is the leap indicator for an unsynchronized clock.
+	LeapNotInSync = 3
+)
+
+// NTS extension field types, RFC 8915 section 5.
+const (
+	ExtUniqueIdentifier  uint16 = 0x0104
+	ExtCookie            uint16 = 0x0204
+	ExtCookiePlaceholder uint16 = 0x0304
+	ExtAuthenticator     uint16 = 0x0404
+
+	// extension field header size (type and length).
+	extHeaderSize = 4
+	// minimum extension field size, RFC 7822.
+	extMinSize = 16
+)
+
+// KissNTSNAK is the kiss code sent by servers when the cookie can't be decrypted.
+const KissNTSNAK = "NTSN"
+
+var ntpEpoch = time.Date(1900, 1, 1, 0, 0, 0, 0, time.UTC)
+
+// Header is the NTPv4 packet header.
+type Header struct {
+	LiVnMode       uint8
+	Stratum        uint8
+	Poll           int8
+	Precision      int8
+	RootDelay      uint32
+	RootDispersion uint32
+	ReferenceID    uint32
+	ReferenceTime  uint64
+	OriginTime     uint64
+	ReceiveTime    uint64
+	TransmitTime   uint64
+}
+
+// ParseHeader parses NTP header from the packet.
+func ParseHeader(packet []byte) (Header, error) {
+	var hdr Header
+
+	if len(packet) < HeaderSize {
+		return hdr, errors.New("NTP packet is too short")
+	}
+
+	if err := binary.Read(bytes.NewReader(packet[:HeaderSize]), binary.BigEndian, &hdr); err != nil {
+		return hdr, err
+	}
+
+	return hdr, nil
+}
+
+// Append appends wire representation of the header to b.
+func (hdr *Header) Append(b []byte) []byte {
+	var buf bytes.Buffer
+
+	binary.Write(&buf, binary.BigEndian, hdr) //nolint:errcheck
+
+	return append(b, buf.Bytes()...)
+}
+
+// Mode returns NTP mode.
+func (hdr *Header) Mode() uint8 {
+	return hdr.LiVnMode & 0x07
+}
+
+// Leap returns NTP leap indicator.
+func (hdr *Header) Leap() uint8 {
+	return hdr.LiVnMode >> 6
+}
+
+// SetLiVnMode sets leap indicator, version and mode.
+func (hdr *Header) SetLiVnMode(leap, version, mode uint8) {
+	hdr.LiVnMode = leap<<6 | version<<3 | mode
+}
+
+// ToNTPTime converts time to the NTP 64-bit timestamp.
+func ToNTPTime(t time.Time) uint64 {
+	nsec := uint64(t.Sub(ntpEpoch))
+	sec := nsec / uint64(time.Second)
+	frac := (nsec - sec*uint64(time.Second)) << 32 / uint64(time.Second)
+
+	return sec<<32 | frac
+}
+
+// FromNTPTime converts NTP 64-bit timestamp to time.
+func FromNTPTime(ts uint64) time.Time {
+	return ntpEpoch.Add(time.Duration(ts>>32)*time.Second + time.Duration((ts&0xffffffff)*uint64(time.Second)>>32))
+}
+
+// ToNTPShortTime converts duration to the NTP 32-bit short format.
+func ToNTPShortTime(d time.Duration) uint32 {
+	return uint32(uint64(d) << 16 / uint64(time.Second))
+}
+
+// FromNTPShortTime converts NTP 32-bit short format to duration.
+func FromNTPShortTime(ts uint32) time.Duration {
+	return time.Duration(uint64(ts) * uint64(time.Second) >> 16)
+}
+
+// ExtensionField is a single NTP extension field.
+type ExtensionField struct {
+	Type uint16
+	Body []byte
+
+	// Offset of the extension field from the start of the packet.
+	Offset int
+}
+
+// AppendExtensionField appends an extension field to the packet, padding it as required.
+func AppendExtensionField(packet []byte, typ uint16, body []byte) []byte {
+	length := pad4(extHeaderSize + len(body))
+
+	if length < extMinSize {
+		length = extMinSize
+	}
+
+	packet = binary.BigEndian.AppendUint16(packet, typ)
+	packet = binary.BigEndian.AppendUint16(packet, uint16(length))
+	packet = append(packet, body...)
+
+	return append(packet, make([]byte, length-extHeaderSize-len(body))...)
+}
+
+// ParseExtensionFields parses extension fields following the NTP header.
+func ParseExtensionFields(packet []byte) ([]ExtensionField, error) {
+	var fields []ExtensionField
+
+	for offset := HeaderSize; offset < len(packet); {
+		if len(packet)-offset < extHeaderSize {
+			return nil, errors.New("truncated extension field")
+		}
+
+		typ := binary.BigEndian.Uint16(packet[offset:])
+		length := int(binary.BigEndian.Uint16(packet[offset+2:]))
+
+		if length < extHeaderSize || length%4 != 0 || offset+length > len(packet) {
+			return nil, fmt.Errorf("invalid extension field length %d", length)
+		}
+
+		fields = append(fields, ExtensionField{
+			Type:   typ,
+			Body:   packet[offset+extHeaderSize : offset+length],
+			Offset: offset,
+		})
+
+		offset += length
+	}
+
+	return fields, nil
+}
+
+// AppendAuthenticator appends NTS Authenticator and Encrypted Extension Fields extension field.
+//
+// The whole packet so far is authenticated as associated data, plaintext is encrypted.
+func AppendAuthenticator(packet []byte, aead cipher.AEAD, plaintext []byte) ([]byte, error) {
+	nonce := make([]byte, aead.NonceSize())
+
+	if _, err := rand.Read(nonce); err != nil {
+		return nil, err
+	}
+
+	ciphertext := aead.Seal(nil, nonce, plaintext, packet)
+
+	body := binary.BigEndian.AppendUint16(nil, uint16(len(nonce)))
+	body = binary.BigEndian.AppendUint16(body, uint16(len(ciphertext)))
+	body = append(body, nonce...)
+	body = append(body, make([]byte, pad4(len(nonce))-len(nonce))...)
+	body = append(body, ciphertext...)
+	body = append(body, make([]byte, pad4(len(ciphertext))-len(ciphertext))...)
+
+	return AppendExtensionField(packet, ExtAuthenticator, body), nil
+}
+
+// OpenAuthenticator verifies the NTS Authenticator extension field and returns decrypted plaintext.
+func OpenAuthenticator(packet []byte, field ExtensionField, aead cipher.AEAD) ([]byte, error) {
+	if field.Type != ExtAuthenticator || len(field.Body) < 4 {
+		return nil, errors.New("invalid authenticator extension field")
+	}
+
+	nonceLength := int(binary.BigEndian.Uint16(field.Body))
+	ciphertextLength := int(binary.BigEndian.Uint16(field.Body[2:]))
+
+	if 4+pad4(nonceLength)+pad4(ciphertextLength) > len(field.Body) {
+		return nil, errors.New("truncated authenticator extension field")
+	}
+
+	nonce := field.Body[4 : 4+nonceLength]
+	ciphertext := field.Body[4+pad4(nonceLength) : 4+pad4(nonceLength)+ciphertextLength]
+
+	return aead.Open(nil, nonce, ciphertext, packet[:field.Offset])
+}
+
+func pad4(n int) int {
+	return (n + 3) &^ 3
+}
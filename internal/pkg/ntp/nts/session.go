@@ -0,0 +1,270 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package nts
+
+import (
+	"bytes"
+	"context"
+	"crypto/cipher"
+	"crypto/rand"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"net"
+	"time"
+
+	"github.com/beevik/ntp"
+)
+
+// cookiesWanted is the number of cookies the client tries to keep in the session.
+const cookiesWanted = 8
+
+// ErrNAK is returned when the server doesn't accept the NTS cookie, and a new NTS-KE is required.
+var ErrNAK = errors.New("NTS NAK received")
+
+// ErrNoCookies is returned when the session has no cookies left, and a new NTS-KE is required.
+var ErrNoCookies = errors.New("NTS session has no cookies left")
+
+// Session holds the keys and cookies negotiated via NTS-KE.
+//
+// Session is not safe for concurrent use.
+type Session struct {
+	c2s, s2c cipher.AEAD
+
+	cookies [][]byte
+	address string
+}
+
+// Address returns the NTP server address (host:port) negotiated for the session.
+func (session *Session) Address() string {
+	return session.address
+}
+
+// Cookies returns the number of cookies left in the session.
+func (session *Session) Cookies() int {
+	return len(session.cookies)
+}
+
+// Query performs an authenticated NTP query.
+//
+//nolint:gocyclo,cyclop
+func (session *Session) Query(ctx context.Context) (*ntp.Response, error) {
+	if len(session.cookies) == 0 {
+		return nil, ErrNoCookies
+	}
+
+	// each cookie is used only once to prevent tracking
+	cookie := session.cookies[0]
+	session.cookies = session.cookies[1:]
+
+	uniqueID := make([]byte, 32)
+
+	if _, err := rand.Read(uniqueID); err != nil {
+		return nil, err
+	}
+
+	var transmitTime [8]byte
+
+	if _, err := rand.Read(transmitTime[:]); err != nil {
+		return nil, err
+	}
+
+	req := Header{
+		TransmitTime: binary.BigEndian.Uint64(transmitTime[:]),
+	}
+	req.SetLiVnMode(LeapNotInSync, Version, ModeClient)
+
+	packet := req.Append(nil)
+	packet = AppendExtensionField(packet, ExtUniqueIdentifier, uniqueID)
+	packet = AppendExtensionField(packet, ExtCookie, cookie)
+
+	// ask for more cookies to refill the session
+	for i := len(session.cookies) + 1; i < cookiesWanted; i++ {
+		packet = AppendExtensionField(packet, ExtCookiePlaceholder, make([]byte, len(cookie)))
+	}
+
+	packet, err := AppendAuthenticator(packet, session.c2s, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var dialer net.Dialer
+
+	conn, err := dialer.DialContext(ctx, "udp", session.address)
+	if err != nil {
+		return nil, err
+	}
+
+	defer conn.Close() //nolint:errcheck
+
+	if deadline, ok := ctx.Deadline(); ok {
+		if err = conn.SetDeadline(deadline); err != nil {
+			return nil, err
+		}
+	}
+
+	xmitTime := time.Now()
+
+	if _, err = conn.Write(packet); err != nil {
+		return nil, err
+	}
+
+	buf := make([]byte, 2048)
+
+	n, err := conn.Read(buf)
+	if err != nil {
+		return nil, err
+	}
+
+	recvTime := xmitTime.Add(time.Since(xmitTime))
+
+	packet = buf[:n]
+
+	resp, err := ParseHeader(packet)
+	if err != nil {
+		return nil, err
+	}
+
+	if resp.Mode() != ModeServer {
+		return nil, errors.New("invalid mode in response")
+	}
+
+	if resp.OriginTime != req.TransmitTime {
+		return nil, errors.New("server response mismatch")
+	}
+
+	fields, err := ParseExtensionFields(packet)
+	if err != nil {
+		return nil, err
+	}
+
+	var (
+		uniqueIDMatches bool
+		authenticated   bool
+		newCookies      [][]byte
+	)
+
+	for _, field := range fields {
+		switch field.Type {
+		case ExtUniqueIdentifier:
+			uniqueIDMatches = bytes.Equal(field.Body, uniqueID)
+		case ExtAuthenticator:
+			if !uniqueIDMatches {
+				return nil, errors.New("unique identifier mismatch")
+			}
+
+			var plaintext []byte
+
+			plaintext, err = OpenAuthenticator(packet, field, session.s2c)
+			if err != nil {
+				return nil, err
+			}
+
+			var encryptedFields []ExtensionField
+
+			// encrypted extension fields are parsed as if they follow the header
+			encryptedFields, err = ParseExtensionFields(append(make([]byte, HeaderSize), plaintext...))
+			if err != nil {
+				return nil, fmt.Errorf("error parsing encrypted extension fields: %w", err)
+			}
+
+			for _, encryptedField := range encryptedFields {
+				if encryptedField.Type == ExtCookie {
+					newCookies = append(newCookies, encryptedField.Body)
+				}
+			}
+
+			authenticated = true
+		}
+
+		if authenticated {
+			// anything after the authenticator is not authenticated, so it is ignored
+			break
+		}
+	}
+
+	if resp.Stratum == 0 && kissCode(resp.ReferenceID) == KissNTSNAK && uniqueIDMatches {
+		return nil, ErrNAK
+	}
+
+	if !authenticated {
+		return nil, errors.New("NTP response is not authenticated")
+	}
+
+	session.cookies = append(session.cookies, newCookies...)
+
+	return parseResponse(&resp, xmitTime, recvTime), nil
+}
+
+// parseResponse builds ntp.Response the same way github.com/beevik/ntp does it.
+func parseResponse(hdr *Header, org, dst time.Time) *ntp.Response {
+	rec := FromNTPTime(hdr.ReceiveTime)
+	xmt := FromNTPTime(hdr.TransmitTime)
+
+	r := &ntp.Response{
+		Time:           xmt,
+		ClockOffset:    (rec.Sub(org) + xmt.Sub(dst)) / 2,
+		RTT:            dst.Sub(org) - xmt.Sub(rec),
+		Precision:      toInterval(hdr.Precision),
+		Stratum:        hdr.Stratum,
+		ReferenceID:    hdr.ReferenceID,
+		ReferenceTime:  FromNTPTime(hdr.ReferenceTime),
+		RootDelay:      FromNTPShortTime(hdr.RootDelay),
+		RootDispersion: FromNTPShortTime(hdr.RootDispersion),
+		Leap:           ntp.LeapIndicator(hdr.Leap()),
+		Poll:           toInterval(hdr.Poll),
+	}
+
+	if r.RTT < 0 {
+		r.RTT = 0
+	}
+
+	var error0, error1 time.Duration
+
+	if !org.Before(rec) {
+		error0 = org.Sub(rec)
+	}
+
+	if !xmt.Before(dst) {
+		error1 = xmt.Sub(dst)
+	}
+
+	r.MinError = error0
+
+	if error1 > error0 {
+		r.MinError = error1
+	}
+
+	r.RootDistance = (r.RTT+r.RootDelay)/2 + r.RootDispersion
+
+	if r.Stratum == 0 {
+		r.KissCode = kissCode(r.ReferenceID)
+	}
+
+	return r
+}
+
+func toInterval(t int8) time.Duration {
+	switch {
+	case t > 0:
+		return time.Duration(uint64(time.Second) << uint(t))
+	case t < 0:
+		return time.Duration(uint64(time.Second) >> uint(-t))
+	default:
+		return time.Second
+	}
+}
+
+func kissCode(id uint32) string {
+	b := binary.BigEndian.AppendUint32(nil, id)
+
+	for _, ch := range b {
+		if ch < 32 || ch > 126 {
+			return ""
+		}
+	}
+
+	return string(b)
+}
@@ -0,0 +1,236 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package nts
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/subtle"
+	"errors"
+	"fmt"
+)
+
+// sivNonceSize is the size of the nonce used in NTS authenticators.
+//
+// AES-SIV accepts nonces of any length, but NTS recommends (and this package always uses) 16 bytes.
+const sivNonceSize = 16
+
+var errOpen = errors.New("nts: message authentication failed")
+
+// siv implements AES-SIV-CMAC as defined in RFC 5297.
+type siv struct {
+	mac cipher.Block
+	ctr cipher.Block
+
+	k1, k2 [aes.BlockSize]byte
+}
+
+// NewAESSIV returns AEAD_AES_SIV_CMAC_256 (or _384, _512 depending on the key size) cipher.
+//
+// The nonce is processed as the last associated data component, as specified by RFC 5297 section 6.
+func NewAESSIV(key []byte) (cipher.AEAD, error) {
+	switch len(key) {
+	case 32, 48, 64:
+	default:
+		return nil, fmt.Errorf("nts: invalid AES-SIV key size %d", len(key))
+	}
+
+	mac, err := aes.NewCipher(key[:len(key)/2])
+	if err != nil {
+		return nil, err
+	}
+
+	ctr, err := aes.NewCipher(key[len(key)/2:])
+	if err != nil {
+		return nil, err
+	}
+
+	s := &siv{
+		mac: mac,
+		ctr: ctr,
+	}
+
+	// CMAC subkeys, RFC 4493 section 2.3
+	mac.Encrypt(s.k1[:], s.k1[:])
+	dbl(&s.k1)
+
+	s.k2 = s.k1
+	dbl(&s.k2)
+
+	return s, nil
+}
+
+// NonceSize implements cipher.AEAD.
+func (s *siv) NonceSize() int {
+	return sivNonceSize
+}
+
+// Overhead implements cipher.AEAD.
+func (s *siv) Overhead() int {
+	return aes.BlockSize
+}
+
+// Seal implements cipher.AEAD.
+func (s *siv) Seal(dst, nonce, plaintext, additionalData []byte) []byte {
+	return s.seal(dst, plaintext, additionalData, nonce)
+}
+
+// Open implements cipher.AEAD.
+func (s *siv) Open(dst, nonce, ciphertext, additionalData []byte) ([]byte, error) {
+	return s.open(dst, ciphertext, additionalData, nonce)
+}
+
+// seal encrypts plaintext authenticating the vector of associated data components.
+func (s *siv) seal(dst, plaintext []byte, ad ...[]byte) []byte {
+	v := s.s2v(plaintext, ad...)
+
+	ret, out := sliceForAppend(dst, len(v)+len(plaintext))
+	copy(out, v[:])
+
+	s.xorCTR(out[len(v):], plaintext, v)
+
+	return ret
+}
+
+// open decrypts and verifies ciphertext produced by seal.
+func (s *siv) open(dst, ciphertext []byte, ad ...[]byte) ([]byte, error) {
+	if len(ciphertext) < aes.BlockSize {
+		return nil, errOpen
+	}
+
+	var v [aes.BlockSize]byte
+
+	copy(v[:], ciphertext)
+
+	ret, out := sliceForAppend(dst, len(ciphertext)-aes.BlockSize)
+
+	s.xorCTR(out, ciphertext[aes.BlockSize:], v)
+
+	expected := s.s2v(out, ad...)
+
+	if subtle.ConstantTimeCompare(expected[:], v[:]) != 1 {
+		for i := range out {
+			out[i] = 0
+		}
+
+		return nil, errOpen
+	}
+
+	return ret, nil
+}
+
+// s2v implements the S2V construction, RFC 5297 section 2.4.
+func (s *siv) s2v(plaintext []byte, ad ...[]byte) [aes.BlockSize]byte {
+	var d [aes.BlockSize]byte
+
+	s.cmac(d[:], d[:])
+
+	var mac [aes.BlockSize]byte
+
+	for _, component := range ad {
+		dbl(&d)
+
+		s.cmac(mac[:], component)
+		xorBlock(d[:], mac[:])
+	}
+
+	var t []byte
+
+	if len(plaintext) >= aes.BlockSize {
+		t = append([]byte(nil), plaintext...)
+
+		xorBlock(t[len(t)-aes.BlockSize:], d[:])
+	} else {
+		dbl(&d)
+
+		var padded [aes.BlockSize]byte
+
+		copy(padded[:], plaintext)
+		padded[len(plaintext)] = 0x80
+
+		xorBlock(d[:], padded[:])
+
+		t = d[:]
+	}
+
+	var v [aes.BlockSize]byte
+
+	s.cmac(v[:], t)
+
+	return v
+}
+
+// cmac implements AES-CMAC, RFC 4493.
+func (s *siv) cmac(out, msg []byte) {
+	var x, last [aes.BlockSize]byte
+
+	n := (len(msg) + aes.BlockSize - 1) / aes.BlockSize
+
+	complete := n > 0 && len(msg)%aes.BlockSize == 0
+
+	if n == 0 {
+		n = 1
+	}
+
+	for i := 0; i < n-1; i++ {
+		xorBlock(x[:], msg[i*aes.BlockSize:(i+1)*aes.BlockSize])
+		s.mac.Encrypt(x[:], x[:])
+	}
+
+	tail := msg[(n-1)*aes.BlockSize:]
+
+	copy(last[:], tail)
+
+	if complete {
+		xorBlock(last[:], s.k1[:])
+	} else {
+		last[len(tail)] = 0x80
+
+		xorBlock(last[:], s.k2[:])
+	}
+
+	xorBlock(x[:], last[:])
+	s.mac.Encrypt(out, x[:])
+}
+
+// xorCTR encrypts or decrypts src into dst using AES-CTR with the IV derived from the synthetic IV.
+func (s *siv) xorCTR(dst, src []byte, v [aes.BlockSize]byte) {
+	// clear out the 31st and 63rd bits (counting from the right), RFC 5297 section 2.6
+	v[8] &= 0x7f
+	v[12] &= 0x7f
+
+	cipher.NewCTR(s.ctr, v[:]).XORKeyStream(dst, src)
+}
+
+// dbl implements multiplication by x in GF(2^128).
+func dbl(b *[aes.BlockSize]byte) {
+	carry := b[0] >> 7
+
+	for i := 0; i < aes.BlockSize-1; i++ {
+		b[i] = b[i]<<1 | b[i+1]>>7
+	}
+
+	b[aes.BlockSize-1] = b[aes.BlockSize-1]<<1 ^ (carry * 0x87)
+}
+
+func xorBlock(dst, src []byte) {
+	for i := range src {
+		dst[i] ^= src[i]
+	}
+}
+
+// sliceForAppend extends the input slice by n bytes, returning the whole slice and the newly appended part.
+func sliceForAppend(in []byte, n int) (head, tail []byte) {
+	if total := len(in) + n; cap(in) >= total {
+		head = in[:total]
+	} else {
+		head = make([]byte, total)
+		copy(head, in)
+	}
+
+	tail = head[len(in):]
+
+	return
+}
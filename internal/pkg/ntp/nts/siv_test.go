@@ -0,0 +1,110 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package nts
+
+import (
+	"encoding/hex"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func unhex(t *testing.T, s string) []byte {
+	t.Helper()
+
+	b, err := hex.DecodeString(strings.ReplaceAll(s, " ", ""))
+	require.NoError(t, err)
+
+	return b
+}
+
+// Test vectors from RFC 5297 appendix A.
+func TestSIVVectors(t *testing.T) {
+	t.Parallel()
+
+	for _, test := range []struct {
+		name      string
+		key       string
+		ad        []string
+		plaintext string
+		expected  string
+	}{
+		{
+			name:      "deterministic",
+			key:       "fffefdfc fbfaf9f8 f7f6f5f4 f3f2f1f0 f0f1f2f3 f4f5f6f7 f8f9fafb fcfdfeff",
+			ad:        []string{"10111213 14151617 18191a1b 1c1d1e1f 20212223 24252627"},
+			plaintext: "11223344 55667788 99aabbcc ddee",
+			expected:  "85632d07 c6e8f37f 950acd32 0a2ecc93 40c02b96 90c4dc04 daef7f6a fe5c",
+		},
+		{
+			name: "nonce",
+			key:  "7f7e7d7c 7b7a7978 77767574 73727170 40414243 44454647 48494a4b 4c4d4e4f",
+			ad: []string{
+				"00112233 44556677 8899aabb ccddeeff deaddada deaddada ffeeddcc bbaa9988 77665544 33221100",
+				"10203040 50607080 90a0",
+				"09f91102 9d74e35b d84156c5 635688c0",
+			},
+			plaintext: "74686973 20697320 736f6d65 20706c61 696e7465 78742074 6f20656e 63727970 74207573 696e6720 5349562d 414553",
+			expected: "7bdb6e3b 432667eb 06f4d14b ff2fbd0f cb900f2f ddbe4043 26601965 c889bf17" +
+				"dba77ceb 094fa663 b7a3f748 ba8af829 ea64ad54 4a272e9c 485b62a3 fd5c0d",
+		},
+	} {
+		test := test
+
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			aead, err := NewAESSIV(unhex(t, test.key))
+			require.NoError(t, err)
+
+			s := aead.(*siv) //nolint:errcheck,forcetypeassert
+
+			ad := make([][]byte, 0, len(test.ad))
+
+			for _, component := range test.ad {
+				ad = append(ad, unhex(t, component))
+			}
+
+			ciphertext := s.seal(nil, unhex(t, test.plaintext), ad...)
+			assert.Equal(t, unhex(t, test.expected), ciphertext)
+
+			plaintext, err := s.open(nil, ciphertext, ad...)
+			require.NoError(t, err)
+			assert.Equal(t, unhex(t, test.plaintext), plaintext)
+
+			ciphertext[len(ciphertext)-1] ^= 1
+
+			_, err = s.open(nil, ciphertext, ad...)
+			assert.Error(t, err)
+		})
+	}
+}
+
+func TestSIVAEAD(t *testing.T) {
+	t.Parallel()
+
+	aead, err := NewAESSIV(make([]byte, 32))
+	require.NoError(t, err)
+
+	nonce := make([]byte, aead.NonceSize())
+	ad := []byte("associated data")
+
+	for _, plaintext := range [][]byte{nil, []byte("short"), []byte("exactly 16 bytes"), []byte("somewhat longer than a single block")} {
+		ciphertext := aead.Seal([]byte("prefix"), nonce, plaintext, ad)
+		assert.Len(t, ciphertext, len("prefix")+len(plaintext)+aead.Overhead())
+
+		decrypted, err := aead.Open(nil, nonce, ciphertext[len("prefix"):], ad)
+		require.NoError(t, err)
+		assert.Equal(t, string(plaintext), string(decrypted))
+
+		_, err = aead.Open(nil, nonce, ciphertext[len("prefix"):], []byte("other data"))
+		assert.Error(t, err)
+	}
+
+	_, err = NewAESSIV(make([]byte, 16))
+	assert.Error(t, err)
+}
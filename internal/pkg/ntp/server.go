@@ -0,0 +1,338 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package ntp
+
+import (
+	"context"
+	"crypto/md5" //nolint:gosec
+	"encoding/binary"
+	"fmt"
+	"net"
+	"strings"
+	"time"
+
+	"github.com/beevik/ntp"
+	"github.com/siderolabs/gen/slices"
+	"go.uber.org/zap"
+	"golang.org/x/net/ipv4"
+	"golang.org/x/net/ipv6"
+	"golang.org/x/sync/errgroup"
+
+	"github.com/siderolabs/talos/internal/pkg/ntp/nts"
+)
+
+// SyncState describes the last successful time sync, it is used to serve time to other hosts.
+type SyncState struct {
+	// Synced is false if the time was never synced.
+	Synced bool
+
+	Leap           ntp.LeapIndicator
+	Stratum        uint8
+	ReferenceID    uint32
+	ReferenceTime  time.Time
+	RootDelay      time.Duration
+	RootDispersion time.Duration
+}
+
+// SyncStateFunc provides current time sync state.
+type SyncStateFunc func() SyncState
+
+// referenceID builds NTP reference ID for the upstream server, RFC 5905 section 7.3.
+func referenceID(server string) uint32 {
+	if strings.HasPrefix(server, nts.SchemePrefix) {
+		if host, _, err := nts.ParseServer(server); err == nil {
+			server = host
+		}
+	}
+
+	ip := net.ParseIP(server)
+
+	if ip != nil && ip.To4() != nil {
+		return binary.BigEndian.Uint32(ip.To4())
+	}
+
+	var sum [md5.Size]byte
+
+	if ip != nil {
+		sum = md5.Sum(ip.To16()) //nolint:gosec
+	} else {
+		sum = md5.Sum([]byte(server)) //nolint:gosec
+	}
+
+	return binary.BigEndian.Uint32(sum[:4])
+}
+
+const (
+	// DefaultServerAddress is the default listen address of the NTP server.
+	DefaultServerAddress = ":123"
+
+	// maxStratum indicates unsynchronized clock.
+	maxStratum = 16
+	// serverPrecision is log2 of the server clock precision in seconds (~1µs).
+	serverPrecision = -20
+	// dispersionRate is the rate the dispersion grows since the last sync (PHI), RFC 5905.
+	dispersionRate = 15e-6
+)
+
+// Server answers NTP client requests (SNTP server) based on the time sync state.
+type Server struct {
+	logger     *zap.Logger
+	state      SyncStateFunc
+	interfaces []string
+
+	// ListenAddress defaults to DefaultServerAddress.
+	ListenAddress string
+
+	// overridden in tests for mocking support
+	CurrentTime CurrentTimeFunc
+}
+
+// NewServer creates new Server.
+//
+// If the list of interfaces is empty, the server answers on all interfaces.
+func NewServer(logger *zap.Logger, state SyncStateFunc, interfaces []string) *Server {
+	return &Server{
+		logger:     logger,
+		state:      state,
+		interfaces: slices.Clone(interfaces),
+
+		ListenAddress: DefaultServerAddress,
+
+		CurrentTime: time.Now,
+	}
+}
+
+// Run serves NTP requests until the context is canceled.
+//
+// If the listen address host is empty, the server listens on both IPv4 and IPv6 (if available).
+func (server *Server) Run(ctx context.Context) error {
+	host, port, err := net.SplitHostPort(server.ListenAddress)
+	if err != nil {
+		return err
+	}
+
+	var networks []string
+
+	switch ip := net.ParseIP(host); {
+	case host == "":
+		networks = []string{"udp4", "udp6"}
+	case ip != nil && ip.To4() != nil:
+		networks = []string{"udp4"}
+	default:
+		networks = []string{"udp6"}
+	}
+
+	eg, ctx := errgroup.WithContext(ctx)
+
+	for _, network := range networks {
+		var conn net.PacketConn
+
+		conn, err = net.ListenPacket(network, net.JoinHostPort(host, port))
+		if err != nil {
+			if host == "" && network == "udp6" {
+				server.logger.Warn("failed to listen for NTP requests over IPv6", zap.Error(err))
+
+				continue
+			}
+
+			return fmt.Errorf("error listening for NTP requests: %w", err)
+		}
+
+		var pc serverConn
+
+		if network == "udp4" {
+			pc, err = newServerConn4(conn)
+		} else {
+			pc, err = newServerConn6(conn)
+		}
+
+		if err != nil {
+			conn.Close() //nolint:errcheck
+
+			return err
+		}
+
+		server.logger.Info("serving NTP requests", zap.Stringer("address", conn.LocalAddr()), zap.Strings("interfaces", server.interfaces))
+
+		eg.Go(func() error {
+			<-ctx.Done()
+
+			return conn.Close()
+		})
+
+		eg.Go(func() error {
+			return server.serve(ctx, pc)
+		})
+	}
+
+	return eg.Wait()
+}
+
+func (server *Server) serve(ctx context.Context, pc serverConn) error {
+	buf := make([]byte, 1024)
+
+	for {
+		n, ifIndex, dst, addr, err := pc.readFrom(buf)
+		if err != nil {
+			if ctx.Err() != nil {
+				return nil //nolint:nilerr
+			}
+
+			return fmt.Errorf("error reading NTP request: %w", err)
+		}
+
+		receiveTime := server.CurrentTime()
+
+		if !server.interfaceAllowed(ifIndex) {
+			continue
+		}
+
+		resp, err := server.response(buf[:n], receiveTime)
+		if err != nil {
+			server.logger.Debug("ignored NTP request", zap.Stringer("remote", addr), zap.Error(err))
+
+			continue
+		}
+
+		if err = pc.writeTo(resp, ifIndex, dst, addr); err != nil {
+			server.logger.Debug("error sending NTP response", zap.Stringer("remote", addr), zap.Error(err))
+		}
+	}
+}
+
+func (server *Server) interfaceAllowed(ifIndex int) bool {
+	if len(server.interfaces) == 0 {
+		return true
+	}
+
+	iface, err := net.InterfaceByIndex(ifIndex)
+	if err != nil {
+		return false
+	}
+
+	return slices.Contains(server.interfaces, func(name string) bool { return name == iface.Name })
+}
+
+// response builds the response to the NTP request.
+func (server *Server) response(packet []byte, receiveTime time.Time) ([]byte, error) {
+	req, err := nts.ParseHeader(packet)
+	if err != nil {
+		return nil, err
+	}
+
+	if req.Mode() != nts.ModeClient {
+		return nil, fmt.Errorf("unexpected mode %d", req.Mode())
+	}
+
+	version := (req.LiVnMode >> 3) & 0x07
+	if version < 1 || version > nts.Version {
+		return nil, fmt.Errorf("unsupported version %d", version)
+	}
+
+	state := server.state()
+
+	resp := nts.Header{
+		Poll:        req.Poll,
+		Precision:   serverPrecision,
+		OriginTime:  req.TransmitTime,
+		ReceiveTime: nts.ToNTPTime(receiveTime),
+	}
+
+	if state.Synced {
+		sinceSync := receiveTime.Sub(state.ReferenceTime)
+		if sinceSync < 0 {
+			sinceSync = 0
+		}
+
+		resp.SetLiVnMode(uint8(state.Leap), version, nts.ModeServer)
+		resp.Stratum = state.Stratum + 1
+		resp.ReferenceID = state.ReferenceID
+		resp.ReferenceTime = nts.ToNTPTime(state.ReferenceTime)
+		resp.RootDelay = nts.ToNTPShortTime(state.RootDelay)
+		resp.RootDispersion = nts.ToNTPShortTime(state.RootDispersion + time.Duration(float64(sinceSync)*dispersionRate))
+	}
+
+	if !state.Synced || resp.Stratum >= maxStratum {
+		// clients should not sync to this server
+		resp.SetLiVnMode(uint8(ntp.LeapNotInSync), version, nts.ModeServer)
+		resp.Stratum = maxStratum
+		resp.ReferenceID = 0
+		resp.ReferenceTime = 0
+	}
+
+	resp.TransmitTime = nts.ToNTPTime(server.CurrentTime())
+
+	return resp.Append(nil), nil
+}
+
+// serverConn abstracts reading and writing packets with the control messages.
+//
+// Control messages are used to find the incoming interface and to send the reply from the address the request was sent to.
+type serverConn interface {
+	readFrom(b []byte) (n, ifIndex int, dst net.IP, addr net.Addr, err error)
+	writeTo(b []byte, ifIndex int, src net.IP, addr net.Addr) error
+}
+
+type serverConn4 struct {
+	*ipv4.PacketConn
+}
+
+func newServerConn4(conn net.PacketConn) (serverConn, error) {
+	pc := ipv4.NewPacketConn(conn)
+
+	if err := pc.SetControlMessage(ipv4.FlagInterface|ipv4.FlagDst, true); err != nil {
+		return nil, fmt.Errorf("error enabling control messages: %w", err)
+	}
+
+	return serverConn4{pc}, nil
+}
+
+func (conn serverConn4) readFrom(b []byte) (n, ifIndex int, dst net.IP, addr net.Addr, err error) {
+	var cm *ipv4.ControlMessage
+
+	n, cm, addr, err = conn.ReadFrom(b)
+	if cm != nil {
+		ifIndex, dst = cm.IfIndex, cm.Dst
+	}
+
+	return n, ifIndex, dst, addr, err
+}
+
+func (conn serverConn4) writeTo(b []byte, ifIndex int, src net.IP, addr net.Addr) error {
+	_, err := conn.WriteTo(b, &ipv4.ControlMessage{IfIndex: ifIndex, Src: src}, addr)
+
+	return err
+}
+
+type serverConn6 struct {
+	*ipv6.PacketConn
+}
+
+func newServerConn6(conn net.PacketConn) (serverConn, error) {
+	pc := ipv6.NewPacketConn(conn)
+
+	if err := pc.SetControlMessage(ipv6.FlagInterface|ipv6.FlagDst, true); err != nil {
+		return nil, fmt.Errorf("error enabling control messages: %w", err)
+	}
+
+	return serverConn6{pc}, nil
+}
+
+func (conn serverConn6) readFrom(b []byte) (n, ifIndex int, dst net.IP, addr net.Addr, err error) {
+	var cm *ipv6.ControlMessage
+
+	n, cm, addr, err = conn.ReadFrom(b)
+	if cm != nil {
+		ifIndex, dst = cm.IfIndex, cm.Dst
+	}
+
+	return n, ifIndex, dst, addr, err
+}
+
+func (conn serverConn6) writeTo(b []byte, ifIndex int, src net.IP, addr net.Addr) error {
+	_, err := conn.WriteTo(b, &ipv6.ControlMessage{IfIndex: ifIndex, Src: src}, addr)
+
+	return err
+}
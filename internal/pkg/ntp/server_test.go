@@ -0,0 +1,158 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package ntp_test
+
+import (
+	"context"
+	"net"
+	"strconv"
+	"sync"
+	"testing"
+	"time"
+
+	beevikntp "github.com/beevik/ntp"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap/zaptest"
+
+	"github.com/siderolabs/talos/internal/pkg/ntp"
+)
+
+func freeUDPPort(t *testing.T) int {
+	t.Helper()
+
+	conn, err := net.ListenPacket("udp", "127.0.0.1:0")
+	require.NoError(t, err)
+
+	port := conn.LocalAddr().(*net.UDPAddr).Port //nolint:forcetypeassert
+
+	require.NoError(t, conn.Close())
+
+	return port
+}
+
+//nolint:gocyclo
+func TestServer(t *testing.T) {
+	t.Parallel()
+
+	for _, test := range []struct {
+		name          string
+		listenAddress string
+		interfaces    []string
+
+		expectNoResponse bool
+	}{
+		{
+			name:          "IPv4",
+			listenAddress: "127.0.0.1",
+		},
+		{
+			name:          "dual-stack",
+			listenAddress: "",
+			interfaces:    []string{"lo"},
+		},
+		{
+			name:             "interface filter",
+			listenAddress:    "",
+			interfaces:       []string{"eth-missing"},
+			expectNoResponse: true,
+		},
+	} {
+		test := test
+
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			var (
+				stateMu sync.Mutex
+				state   ntp.SyncState
+			)
+
+			port := freeUDPPort(t)
+
+			server := ntp.NewServer(zaptest.NewLogger(t), func() ntp.SyncState {
+				stateMu.Lock()
+				defer stateMu.Unlock()
+
+				return state
+			}, test.interfaces)
+
+			server.ListenAddress = net.JoinHostPort(test.listenAddress, strconv.Itoa(port))
+
+			ctx, cancel := context.WithCancel(context.Background())
+
+			var wg sync.WaitGroup
+
+			wg.Add(1)
+
+			go func() {
+				defer wg.Done()
+
+				assert.NoError(t, server.Run(ctx))
+			}()
+
+			t.Cleanup(func() {
+				cancel()
+				wg.Wait()
+			})
+
+			query := func() (*beevikntp.Response, error) {
+				var (
+					resp *beevikntp.Response
+					err  error
+				)
+
+				// the server might not be listening yet
+				for i := 0; i < 10; i++ {
+					resp, err = beevikntp.QueryWithOptions("127.0.0.1", beevikntp.QueryOptions{Port: port, Timeout: 100 * time.Millisecond})
+					if err == nil {
+						break
+					}
+
+					time.Sleep(100 * time.Millisecond)
+				}
+
+				return resp, err
+			}
+
+			if test.expectNoResponse {
+				_, err := query()
+				assert.Error(t, err)
+
+				return
+			}
+
+			// not synced yet
+			resp, err := query()
+			require.NoError(t, err)
+
+			assert.EqualValues(t, 16, resp.Stratum)
+			assert.EqualValues(t, beevikntp.LeapNotInSync, resp.Leap)
+			assert.Error(t, resp.Validate())
+
+			stateMu.Lock()
+			state = ntp.SyncState{
+				Synced:         true,
+				Stratum:        2,
+				ReferenceID:    0x0a000001,
+				ReferenceTime:  time.Now(),
+				RootDelay:      10 * time.Millisecond,
+				RootDispersion: 5 * time.Millisecond,
+			}
+			stateMu.Unlock()
+
+			resp, err = query()
+			require.NoError(t, err)
+
+			assert.NoError(t, resp.Validate())
+			assert.EqualValues(t, 3, resp.Stratum)
+			assert.EqualValues(t, 0x0a000001, resp.ReferenceID)
+			assert.Equal(t, beevikntp.LeapNoWarning, resp.Leap)
+			assert.InDelta(t, 10*time.Millisecond, resp.RootDelay, float64(time.Millisecond))
+			assert.InDelta(t, 5*time.Millisecond, resp.RootDispersion, float64(time.Millisecond))
+			assert.InDelta(t, 0, resp.ClockOffset, float64(50*time.Millisecond))
+		})
+	}
+}
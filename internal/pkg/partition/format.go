@@ -41,6 +41,8 @@ func Format(devname string, t *FormatOptions) error {
 		return makefs.VFAT(devname, opts...)
 	case FilesystemTypeXFS:
 		return makefs.XFS(devname, opts...)
+	case FilesystemTypeExt4:
+		return makefs.Ext4(devname, opts...)
 	default:
 		return fmt.Errorf("unsupported filesystem type: %q", t.FileSystemType)
 	}
@@ -0,0 +1,215 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package ptp
+
+import (
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"net"
+	"os"
+	"time"
+)
+
+// DefaultManagementSocket is the default path of the management socket of the PTP daemon (ptp4l).
+const DefaultManagementSocket = "/var/run/ptp4l"
+
+// PTP management message constants (IEEE 1588-2008, 15.4 and 15.5).
+const (
+	messageTypeManagement  = 0xd
+	ptpVersion             = 2
+	controlFieldManagement = 4
+	logMessageIntervalNone = 0x7f
+
+	headerLength           = 34
+	managementHeaderLength = 14
+
+	actionGet      = 0
+	actionResponse = 2
+
+	tlvTypeManagement            = 0x0001
+	tlvTypeManagementErrorStatus = 0x0002
+
+	managementIDCurrentDataSet        = 0x2001
+	managementIDTimePropertiesDataSet = 0x2004
+
+	// TIME_PROPERTIES_DATA_SET flags.
+	flagCurrentUTCOffsetValid = 1 << 2
+	flagPTPTimescale          = 1 << 3
+)
+
+// TimeProperties is the TIME_PROPERTIES_DATA_SET of the PTP daemon.
+type TimeProperties struct {
+	// CurrentUTCOffset is the offset between TAI and UTC as announced by the PTP master.
+	CurrentUTCOffset time.Duration
+	// CurrentUTCOffsetValid is true if the CurrentUTCOffset is known to be correct.
+	CurrentUTCOffsetValid bool
+	// PTPTimescale is true if the PTP master uses the PTP (TAI) timescale.
+	PTPTimescale bool
+}
+
+// UTCOffset returns the offset of the PTP timescale from UTC.
+//
+// The PHC synced by the PTP daemon runs on the timescale of the PTP master, which is TAI for the PTP timescale
+// (same as phc2sys -w), while the arbitrary timescale is expected to be UTC already.
+func (props TimeProperties) UTCOffset() time.Duration {
+	if !props.PTPTimescale {
+		return 0
+	}
+
+	return props.CurrentUTCOffset
+}
+
+// MeanPathDelay queries the PTP daemon over its management socket for the mean path delay to the master.
+//
+// The delay is the meanPathDelay of the CURRENT_DATA_SET, as measured by the daemon which syncs the PHC
+// of the NIC with the grandmaster, so it is only available if such daemon (e.g. ptp4l) is running.
+func MeanPathDelay(socketPath string, timeout time.Duration) (time.Duration, error) {
+	data, err := managementGet(socketPath, timeout, managementIDCurrentDataSet)
+	if err != nil {
+		return 0, err
+	}
+
+	// CURRENT_DATA_SET: stepsRemoved (2 bytes), offsetFromMaster (8 bytes), meanPathDelay (8 bytes)
+	if len(data) < 18 {
+		return 0, errors.New("truncated CURRENT_DATA_SET")
+	}
+
+	return timeInterval(data[10:18]), nil
+}
+
+// QueryTimeProperties queries the PTP daemon over its management socket for the TIME_PROPERTIES_DATA_SET.
+func QueryTimeProperties(socketPath string, timeout time.Duration) (TimeProperties, error) {
+	data, err := managementGet(socketPath, timeout, managementIDTimePropertiesDataSet)
+	if err != nil {
+		return TimeProperties{}, err
+	}
+
+	// TIME_PROPERTIES_DATA_SET: currentUtcOffset (2 bytes), flags (1 byte), timeSource (1 byte)
+	if len(data) < 4 {
+		return TimeProperties{}, errors.New("truncated TIME_PROPERTIES_DATA_SET")
+	}
+
+	return TimeProperties{
+		CurrentUTCOffset:      time.Duration(int16(binary.BigEndian.Uint16(data[0:2]))) * time.Second,
+		CurrentUTCOffsetValid: data[2]&flagCurrentUTCOffsetValid != 0,
+		PTPTimescale:          data[2]&flagPTPTimescale != 0,
+	}, nil
+}
+
+// managementGet sends the management GET request for the managementID to the PTP daemon, and returns the response data.
+//
+// The query is sent to the default PTP domain.
+func managementGet(socketPath string, timeout time.Duration, managementID uint16) ([]byte, error) {
+	// empty local address makes the kernel bind the socket to an abstract address, so that the daemon can reply
+	conn, err := net.ListenUnixgram("unixgram", &net.UnixAddr{Net: "unixgram"})
+	if err != nil {
+		return nil, fmt.Errorf("error creating management socket: %w", err)
+	}
+
+	defer conn.Close() //nolint:errcheck
+
+	if err = conn.SetDeadline(time.Now().Add(timeout)); err != nil {
+		return nil, err
+	}
+
+	sequenceID := uint16(time.Now().UnixNano())
+
+	if _, err = conn.WriteToUnix(managementGetRequest(sequenceID, managementID), &net.UnixAddr{Net: "unixgram", Name: socketPath}); err != nil {
+		return nil, fmt.Errorf("error sending management request to %q: %w", socketPath, err)
+	}
+
+	buf := make([]byte, 1500)
+
+	for {
+		n, _, err := conn.ReadFromUnix(buf)
+		if err != nil {
+			return nil, fmt.Errorf("error reading management response from %q: %w", socketPath, err)
+		}
+
+		data, err := parseManagementResponse(buf[:n], sequenceID, managementID)
+		if err != nil {
+			return nil, err
+		}
+
+		if data == nil {
+			// not a response to our request
+			continue
+		}
+
+		return data, nil
+	}
+}
+
+// managementGetRequest builds the management message with the GET action for the managementID.
+func managementGetRequest(sequenceID, managementID uint16) []byte {
+	msg := make([]byte, headerLength+managementHeaderLength+6)
+
+	msg[0] = messageTypeManagement
+	msg[1] = ptpVersion
+	binary.BigEndian.PutUint16(msg[2:], uint16(len(msg)))
+	// domainNumber, flags and correction are zero, sourcePortIdentity uses the pid as the port number (same as pmc)
+	binary.BigEndian.PutUint16(msg[28:], uint16(os.Getpid()))
+	binary.BigEndian.PutUint16(msg[30:], sequenceID)
+	msg[32] = controlFieldManagement
+	msg[33] = logMessageIntervalNone
+
+	// targetPortIdentity: all clocks, all ports
+	for i := headerLength; i < headerLength+10; i++ {
+		msg[i] = 0xff
+	}
+
+	msg[headerLength+10] = 0 // startingBoundaryHops
+	msg[headerLength+11] = 0 // boundaryHops
+	msg[headerLength+12] = actionGet
+
+	tlv := msg[headerLength+managementHeaderLength:]
+	binary.BigEndian.PutUint16(tlv[0:], tlvTypeManagement)
+	binary.BigEndian.PutUint16(tlv[2:], 2) // GET carries only the managementId
+	binary.BigEndian.PutUint16(tlv[4:], managementID)
+
+	return msg
+}
+
+// parseManagementResponse returns the data of the management TLV in the response to the request.
+//
+// If the message is not a response to the request, nil data is returned.
+func parseManagementResponse(msg []byte, sequenceID, managementID uint16) ([]byte, error) {
+	if len(msg) < headerLength+managementHeaderLength+6 {
+		return nil, nil
+	}
+
+	if msg[0]&0xf != messageTypeManagement ||
+		binary.BigEndian.Uint16(msg[30:]) != sequenceID ||
+		msg[headerLength+12]&0xf != actionResponse {
+		return nil, nil
+	}
+
+	tlv := msg[headerLength+managementHeaderLength:]
+	tlvType := binary.BigEndian.Uint16(tlv[0:])
+	tlvLength := int(binary.BigEndian.Uint16(tlv[2:]))
+
+	if tlvLength < 2 || len(tlv) < 4+tlvLength {
+		return nil, errors.New("truncated management TLV")
+	}
+
+	switch tlvType {
+	case tlvTypeManagement:
+		if binary.BigEndian.Uint16(tlv[4:]) != managementID {
+			return nil, nil
+		}
+
+		return tlv[6 : 4+tlvLength], nil
+	case tlvTypeManagementErrorStatus:
+		return nil, fmt.Errorf("management error status 0x%04x", binary.BigEndian.Uint16(tlv[4:]))
+	default:
+		return nil, fmt.Errorf("unexpected TLV type 0x%04x", tlvType)
+	}
+}
+
+// timeInterval decodes PTP TimeInterval: nanoseconds multiplied by 2^16.
+func timeInterval(b []byte) time.Duration {
+	return time.Duration(int64(binary.BigEndian.Uint64(b)) >> 16)
+}
@@ -0,0 +1,152 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package ptp_test
+
+import (
+	"encoding/binary"
+	"net"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/siderolabs/talos/internal/pkg/ptp"
+)
+
+// runFakeDaemon answers management requests on the socket the same way ptp4l does.
+func runFakeDaemon(t *testing.T, respond func(req []byte) [][]byte) string {
+	t.Helper()
+
+	socketPath := filepath.Join(t.TempDir(), "ptp4l")
+
+	conn, err := net.ListenUnixgram("unixgram", &net.UnixAddr{Net: "unixgram", Name: socketPath})
+	require.NoError(t, err)
+
+	t.Cleanup(func() {
+		conn.Close() //nolint:errcheck
+	})
+
+	go func() {
+		buf := make([]byte, 1500)
+
+		for {
+			n, addr, err := conn.ReadFromUnix(buf)
+			if err != nil {
+				return
+			}
+
+			for _, resp := range respond(append([]byte(nil), buf[:n]...)) {
+				conn.WriteToUnix(resp, addr) //nolint:errcheck
+			}
+		}
+	}()
+
+	return socketPath
+}
+
+// response builds the response to the request with the TLV.
+func response(req []byte, tlvType uint16, tlvData []byte) []byte {
+	resp := append([]byte(nil), req[:48]...)
+	resp[46] = 2 // RESPONSE
+
+	tlv := make([]byte, 4+len(tlvData))
+	binary.BigEndian.PutUint16(tlv[0:], tlvType)
+	binary.BigEndian.PutUint16(tlv[2:], uint16(len(tlvData)))
+	copy(tlv[4:], tlvData)
+
+	resp = append(resp, tlv...)
+	binary.BigEndian.PutUint16(resp[2:], uint16(len(resp)))
+
+	return resp
+}
+
+func currentDataSet(meanPathDelay time.Duration) []byte {
+	offsetFromMaster := -50 * time.Nanosecond
+
+	data := make([]byte, 2+18)
+	binary.BigEndian.PutUint16(data[0:], 0x2001)
+	binary.BigEndian.PutUint16(data[2:], 1)                                   // stepsRemoved
+	binary.BigEndian.PutUint64(data[4:], uint64(int64(offsetFromMaster)<<16)) // offsetFromMaster
+	binary.BigEndian.PutUint64(data[12:], uint64(int64(meanPathDelay)<<16))
+
+	return data
+}
+
+func TestMeanPathDelay(t *testing.T) {
+	t.Parallel()
+
+	socketPath := runFakeDaemon(t, func(req []byte) [][]byte {
+		// GET CURRENT_DATA_SET
+		assert.Len(t, req, 54)
+		assert.EqualValues(t, 0xd, req[0]&0xf)
+		assert.EqualValues(t, len(req), binary.BigEndian.Uint16(req[2:]))
+		assert.EqualValues(t, 0, req[46])
+		assert.Equal(t, []byte{0x00, 0x01, 0x00, 0x02, 0x20, 0x01}, req[48:])
+
+		// response to another request is ignored
+		other := response(req, 0x0001, currentDataSet(time.Second))
+		other[31]++
+
+		return [][]byte{other, response(req, 0x0001, currentDataSet(1234*time.Nanosecond))}
+	})
+
+	delay, err := ptp.MeanPathDelay(socketPath, time.Second)
+	require.NoError(t, err)
+
+	assert.Equal(t, 1234*time.Nanosecond, delay)
+}
+
+func TestMeanPathDelayErrorStatus(t *testing.T) {
+	t.Parallel()
+
+	socketPath := runFakeDaemon(t, func(req []byte) [][]byte {
+		// NOT_SUPPORTED
+		return [][]byte{response(req, 0x0002, []byte{0x00, 0x06, 0x20, 0x01, 0x00, 0x00, 0x00, 0x00})}
+	})
+
+	_, err := ptp.MeanPathDelay(socketPath, time.Second)
+	require.EqualError(t, err, "management error status 0x0006")
+}
+
+func TestMeanPathDelayNoDaemon(t *testing.T) {
+	t.Parallel()
+
+	_, err := ptp.MeanPathDelay(filepath.Join(t.TempDir(), "ptp4l"), time.Second)
+	require.Error(t, err)
+
+	socketPath := runFakeDaemon(t, func([]byte) [][]byte { return nil })
+
+	_, err = ptp.MeanPathDelay(socketPath, 100*time.Millisecond)
+	require.Error(t, err)
+}
+
+func TestQueryTimeProperties(t *testing.T) {
+	t.Parallel()
+
+	socketPath := runFakeDaemon(t, func(req []byte) [][]byte {
+		// GET TIME_PROPERTIES_DATA_SET
+		assert.Equal(t, []byte{0x00, 0x01, 0x00, 0x02, 0x20, 0x04}, req[48:])
+
+		// currentUtcOffset 37, currentUtcOffsetValid and ptpTimescale flags, timeSource GPS
+		return [][]byte{response(req, 0x0001, []byte{0x20, 0x04, 0x00, 0x25, 0x0c, 0x20})}
+	})
+
+	props, err := ptp.QueryTimeProperties(socketPath, time.Second)
+	require.NoError(t, err)
+
+	assert.Equal(t, ptp.TimeProperties{
+		CurrentUTCOffset:      37 * time.Second,
+		CurrentUTCOffsetValid: true,
+		PTPTimescale:          true,
+	}, props)
+	assert.Equal(t, 37*time.Second, props.UTCOffset())
+
+	// arbitrary timescale is not corrected
+	props.PTPTimescale = false
+
+	assert.Zero(t, props.UTCOffset())
+}
@@ -0,0 +1,223 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package ptp
+
+import (
+	"errors"
+	"fmt"
+	"net"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+	"unsafe"
+
+	"golang.org/x/sys/unix"
+)
+
+// KVMClockName is the name of the PTP clock exposed by the ptp_kvm kernel module.
+const KVMClockName = "KVM virtual PTP"
+
+// maxSamples is PTP_MAX_SAMPLES from linux/ptp_clock.h.
+const maxSamples = 25
+
+// ptpClockTime is struct ptp_clock_time from linux/ptp_clock.h.
+type ptpClockTime struct {
+	Sec      int64
+	Nsec     uint32
+	Reserved uint32
+}
+
+func (t ptpClockTime) time() time.Time {
+	return time.Unix(t.Sec, int64(t.Nsec))
+}
+
+// ptpSysOffset is struct ptp_sys_offset from linux/ptp_clock.h.
+//
+// Timestamps are interleaved: system time, PHC time, system time, ..., system time.
+type ptpSysOffset struct {
+	NSamples uint32
+	Rsv      [3]uint32
+	TS       [2*maxSamples + 1]ptpClockTime
+}
+
+// ptpSysOffsetIoctl is PTP_SYS_OFFSET, _IOW('=', 5, struct ptp_sys_offset).
+const ptpSysOffsetIoctl = 0x40000000 | uintptr(unsafe.Sizeof(ptpSysOffset{}))<<16 | '='<<8 | 5
+
+// sysfsRoot is overridden in tests.
+var sysfsRoot = "/sys"
+
+// Clock measures the offset of the reference clock relative to the system clock.
+type Clock interface {
+	// Offset returns the offset of the reference clock relative to the system clock, and the delay of the measurement.
+	Offset(samples int) (offset, delay time.Duration, err error)
+	// Identity returns the identity of the reference clock.
+	Identity() string
+	// UTC returns true if the reference clock runs on UTC, otherwise it runs on the timescale of the PTP master.
+	UTC() bool
+	Close() error
+}
+
+// PHC is a PTP hardware clock device (/dev/ptpN).
+type PHC struct {
+	f      *os.File
+	device string
+	utc    bool
+}
+
+// OpenPHC opens the PTP hardware clock device.
+func OpenPHC(path string) (*PHC, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+
+	// the device might be a symlink (e.g. /dev/ptp_kvm), sysfs uses the kernel name
+	resolved, err := filepath.EvalSymlinks(path)
+	if err != nil {
+		resolved = path
+	}
+
+	device := filepath.Base(resolved)
+
+	return &PHC{
+		f:      f,
+		device: device,
+		// ptp_kvm clock follows the system clock of the host, which is UTC
+		utc: clockName(device) == KVMClockName,
+	}, nil
+}
+
+// Close implements Clock interface.
+func (phc *PHC) Close() error {
+	return phc.f.Close()
+}
+
+// Offset implements Clock interface.
+//
+// The sample with the lowest delay (the interval between the system clock readings around the PHC reading) is used.
+func (phc *PHC) Offset(samples int) (offset, delay time.Duration, err error) {
+	if samples < 1 || samples > maxSamples {
+		return 0, 0, fmt.Errorf("number of samples should be in range [1, %d]", maxSamples)
+	}
+
+	req := ptpSysOffset{
+		NSamples: uint32(samples),
+	}
+
+	conn, err := phc.f.SyscallConn()
+	if err != nil {
+		return 0, 0, err
+	}
+
+	var errno unix.Errno
+
+	if err = conn.Control(func(fd uintptr) {
+		_, _, errno = unix.Syscall(unix.SYS_IOCTL, fd, ptpSysOffsetIoctl, uintptr(unsafe.Pointer(&req)))
+	}); err != nil {
+		return 0, 0, err
+	}
+
+	if errno != 0 {
+		return 0, 0, fmt.Errorf("PTP_SYS_OFFSET failed: %w", errno)
+	}
+
+	delay = -1
+
+	for i := 0; i < samples; i++ {
+		before, phcTime, after := req.TS[2*i].time(), req.TS[2*i+1].time(), req.TS[2*i+2].time()
+
+		sampleDelay := after.Sub(before)
+		if sampleDelay < 0 {
+			continue
+		}
+
+		if delay < 0 || sampleDelay < delay {
+			delay = sampleDelay
+			offset = phcTime.Sub(before.Add(sampleDelay / 2))
+		}
+	}
+
+	if delay < 0 {
+		return 0, 0, errors.New("no valid samples")
+	}
+
+	return offset, delay, nil
+}
+
+// Identity implements Clock interface.
+func (phc *PHC) Identity() string {
+	return ClockIdentity(phc.device)
+}
+
+// UTC implements Clock interface.
+func (phc *PHC) UTC() bool {
+	return phc.utc
+}
+
+// ClockIdentity returns the IEEE 1588 clock identity of the PHC (EUI-64 of the NIC MAC address).
+//
+// Virtual clocks (e.g. ptp_kvm) don't have a NIC, so the clock name is returned instead.
+func ClockIdentity(device string) string {
+	ptpPath := filepath.Join(sysfsRoot, "class", "ptp", device)
+
+	links, _ := filepath.Glob(filepath.Join(ptpPath, "device", "net", "*", "address")) //nolint:errcheck
+
+	for _, link := range links {
+		contents, err := os.ReadFile(link)
+		if err != nil {
+			continue
+		}
+
+		mac, err := net.ParseMAC(strings.TrimSpace(string(contents)))
+		if err != nil || len(mac) != 6 {
+			continue
+		}
+
+		return fmt.Sprintf("%02x%02x%02x.fffe.%02x%02x%02x", mac[0], mac[1], mac[2], mac[3], mac[4], mac[5])
+	}
+
+	return clockName(device)
+}
+
+func clockName(device string) string {
+	contents, err := os.ReadFile(filepath.Join(sysfsRoot, "class", "ptp", device, "clock_name"))
+	if err != nil {
+		return ""
+	}
+
+	return strings.TrimSpace(string(contents))
+}
+
+// FindDevice finds the PHC device path.
+//
+// If the interface is set, PHC of the interface is returned, otherwise ptp_kvm clock is looked up.
+func FindDevice(iface string) (string, error) {
+	if iface != "" {
+		devices, err := filepath.Glob(filepath.Join(sysfsRoot, "class", "net", iface, "device", "ptp", "ptp*"))
+		if err != nil {
+			return "", err
+		}
+
+		if len(devices) == 0 {
+			return "", fmt.Errorf("no PTP hardware clock found for interface %q", iface)
+		}
+
+		return filepath.Join("/dev", filepath.Base(devices[0])), nil
+	}
+
+	devices, err := filepath.Glob(filepath.Join(sysfsRoot, "class", "ptp", "ptp*"))
+	if err != nil {
+		return "", err
+	}
+
+	for _, device := range devices {
+		if clockName(filepath.Base(device)) == KVMClockName {
+			return filepath.Join("/dev", filepath.Base(device)), nil
+		}
+	}
+
+	return "", errors.New("no ptp_kvm clock found, make sure ptp_kvm kernel module is loaded")
+}
@@ -0,0 +1,91 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package ptp
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+	"unsafe"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPTPSysOffsetSize(t *testing.T) {
+	t.Parallel()
+
+	// sizeof(struct ptp_sys_offset)
+	assert.EqualValues(t, 832, unsafe.Sizeof(ptpSysOffset{}))
+	assert.EqualValues(t, 0x43403d05, ptpSysOffsetIoctl)
+}
+
+//nolint:paralleltest
+func TestFindDevice(t *testing.T) {
+	root := t.TempDir()
+
+	for path, contents := range map[string]string{
+		"class/ptp/ptp0/clock_name":                 "ice-0000:17:00.0-clk",
+		"class/ptp/ptp0/device/net/eth0/address":    "b4:96:91:0a:0b:0c\n",
+		"class/ptp/ptp1/clock_name":                 "KVM virtual PTP\n",
+		"class/net/eth0/device/ptp/ptp0/clock_name": "ice-0000:17:00.0-clk",
+		"class/net/eth1/device/uevent":              "",
+		"class/ptp/ptp2/device/net/eth9/address":    "invalid",
+		"class/ptp/ptp2/clock_name":                 "phc-2",
+	} {
+		require.NoError(t, os.MkdirAll(filepath.Join(root, filepath.Dir(path)), 0o755))
+		require.NoError(t, os.WriteFile(filepath.Join(root, path), []byte(contents), 0o644))
+	}
+
+	oldRoot := sysfsRoot
+	sysfsRoot = root
+
+	t.Cleanup(func() { sysfsRoot = oldRoot })
+
+	device, err := FindDevice("eth0")
+	require.NoError(t, err)
+	assert.Equal(t, "/dev/ptp0", device)
+
+	_, err = FindDevice("eth1")
+	assert.EqualError(t, err, "no PTP hardware clock found for interface \"eth1\"")
+
+	device, err = FindDevice("")
+	require.NoError(t, err)
+	assert.Equal(t, "/dev/ptp1", device)
+
+	assert.Equal(t, "b49691.fffe.0a0b0c", ClockIdentity("ptp0"))
+	assert.Equal(t, "KVM virtual PTP", ClockIdentity("ptp1"))
+	assert.Equal(t, "phc-2", ClockIdentity("ptp2"))
+	assert.Equal(t, "", ClockIdentity("ptp3"))
+}
+
+func TestServo(t *testing.T) {
+	t.Parallel()
+
+	var s servo
+
+	// system clock is behind, frequency is increased
+	assert.Greater(t, s.sample(time.Microsecond, time.Second), 0.0)
+	assert.Greater(t, s.drift, 0.0)
+
+	// the adjustment is limited
+	assert.EqualValues(t, maxFrequency, s.sample(time.Second, time.Second))
+	assert.EqualValues(t, -maxFrequency, s.sample(-time.Second, time.Second))
+
+	// simulate the system clock running 10 ppm slow, the servo should converge to +10 ppm
+	s = servo{}
+
+	var offset float64 // ns
+
+	for i := 0; i < 100; i++ {
+		ppb := s.sample(time.Duration(offset), time.Second)
+
+		offset += 10_000 - ppb
+	}
+
+	assert.InDelta(t, 0, offset, 1)
+	assert.InDelta(t, 10_000, s.drift, 1)
+}
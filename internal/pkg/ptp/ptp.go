@@ -0,0 +1,513 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+// Package ptp provides time sync from PTP hardware clocks (PHC).
+//
+// The PHC is either disciplined by the NIC (via IEEE 1588 PTP), or it is a virtual clock of the hypervisor (ptp_kvm).
+package ptp
+
+import (
+	"context"
+	"encoding/binary"
+	"fmt"
+	"math"
+	"sync"
+	"syscall"
+	"time"
+
+	"github.com/u-root/u-root/pkg/rtc"
+	"go.uber.org/zap"
+
+	"github.com/siderolabs/talos/internal/pkg/ntp"
+	"github.com/siderolabs/talos/internal/pkg/timex"
+)
+
+const (
+	// DefaultPollInterval is the default interval between PHC offset measurements.
+	DefaultPollInterval = time.Second
+	// FirstStepThreshold is the offset above which the clock is stepped on the first sync.
+	//
+	// After the first sync, the clock is stepped only if the offset is above ntp.AdjustTimeLimit.
+	FirstStepThreshold = 20 * time.Microsecond
+
+	// retryInterval is the interval between attempts to open the PHC.
+	retryInterval = 5 * time.Second
+	// statusInterval is the minimum interval between status change notifications.
+	statusInterval = 10 * time.Second
+	// numSamples is the number of PHC readings per measurement.
+	numSamples = 5
+	// managementTimeout is the timeout of the management query to the PTP daemon.
+	managementTimeout = 100 * time.Millisecond
+	// minPathDelayBackoff and maxPathDelayBackoff bound the interval between the queries to the PTP daemon after a failure.
+	minPathDelayBackoff = 10 * time.Second
+	maxPathDelayBackoff = 5 * time.Minute
+	// timePropertiesInterval is the interval between the UTC offset queries to the PTP daemon (same as phc2sys).
+	timePropertiesInterval = time.Minute
+)
+
+// referenceID is the NTP reference ID reported when serving time synced from PHC.
+var referenceID = binary.BigEndian.Uint32([]byte("PHC\x00"))
+
+// Status describes the last PHC measurement.
+type Status struct {
+	// Device is the PHC device path.
+	Device string
+	// ClockIdentity is the clock identity of the PHC.
+	ClockIdentity string
+	// Offset of the PHC relative to the system clock before the adjustment.
+	Offset time.Duration
+	// ReadDelay is the delay of reading the PHC, it is not the PTP path delay to the grandmaster.
+	ReadDelay time.Duration
+	// PathDelay is the mean path delay to the PTP master as measured by the PTP daemon, zero if not available.
+	PathDelay time.Duration
+	// PathDelayAvailable is true if the PTP daemon reported the path delay.
+	//
+	// Talos doesn't run the PTP daemon (ptp4l) itself, so the path delay is unknown unless the daemon is run e.g. as an extension.
+	PathDelayAvailable bool
+	// UTCOffset is the offset of the PHC timescale from UTC, it is subtracted from the PHC time.
+	//
+	// The offset is zero for the PHCs running on UTC (ptp_kvm), and the TAI-UTC offset for the PTP timescale.
+	UTCOffset time.Duration
+}
+
+// Syncer disciplines the system clock from the PTP hardware clock.
+type Syncer struct {
+	logger *zap.Logger
+	device string
+	iface  string
+
+	timeSyncNotified bool
+	timeSynced       chan struct{}
+
+	epochChangeCh  chan struct{}
+	statusChangeCh chan struct{}
+
+	statusMu         sync.Mutex
+	status           Status
+	syncState        ntp.SyncState
+	lastStatusNotify time.Time
+
+	servo     servo
+	firstSync bool
+
+	pathDelayBackoff   time.Duration
+	pathDelayNextQuery time.Time
+
+	utcOffset          time.Duration
+	utcOffsetNextQuery time.Time
+
+	PollInterval time.Duration
+
+	// these functions are overridden in tests for mocking support
+	CurrentTime         ntp.CurrentTimeFunc
+	AdjustTime          ntp.AdjustTimeFunc
+	FindDevice          func(iface string) (string, error)
+	OpenClock           func(path string) (Clock, error)
+	QueryPathDelay      func() (time.Duration, error)
+	QueryTimeProperties func() (TimeProperties, error)
+}
+
+// NewSyncer creates new Syncer with default configuration.
+//
+// If the device is empty, PHC of the interface is used, and if the interface is empty too, ptp_kvm clock is used.
+func NewSyncer(logger *zap.Logger, device, iface string) *Syncer {
+	return &Syncer{
+		logger: logger,
+		device: device,
+		iface:  iface,
+
+		timeSynced: make(chan struct{}),
+
+		epochChangeCh:  make(chan struct{}, 1),
+		statusChangeCh: make(chan struct{}, 1),
+
+		firstSync: true,
+
+		PollInterval: DefaultPollInterval,
+
+		CurrentTime: time.Now,
+		AdjustTime:  timex.Adjtimex,
+		FindDevice:  FindDevice,
+		OpenClock: func(path string) (Clock, error) {
+			return OpenPHC(path)
+		},
+		QueryPathDelay: func() (time.Duration, error) {
+			return MeanPathDelay(DefaultManagementSocket, managementTimeout)
+		},
+		QueryTimeProperties: func() (TimeProperties, error) {
+			return QueryTimeProperties(DefaultManagementSocket, managementTimeout)
+		},
+	}
+}
+
+// Synced returns a channel which is closed when time is in sync.
+func (syncer *Syncer) Synced() <-chan struct{} {
+	return syncer.timeSynced
+}
+
+// EpochChange returns a channel which receives a value each time jumps more than ntp.EpochLimit.
+func (syncer *Syncer) EpochChange() <-chan struct{} {
+	return syncer.epochChangeCh
+}
+
+// StatusChange returns a channel which receives a value when the status is updated.
+//
+// Status updates are rate-limited, as the PHC is polled often.
+func (syncer *Syncer) StatusChange() <-chan struct{} {
+	return syncer.statusChangeCh
+}
+
+// Status returns the last PHC measurement.
+func (syncer *Syncer) Status() Status {
+	syncer.statusMu.Lock()
+	defer syncer.statusMu.Unlock()
+
+	return syncer.status
+}
+
+// SyncState returns the state of the last successful time sync.
+func (syncer *Syncer) SyncState() ntp.SyncState {
+	syncer.statusMu.Lock()
+	defer syncer.statusMu.Unlock()
+
+	return syncer.syncState
+}
+
+func (syncer *Syncer) setStatus(status Status) {
+	syncer.statusMu.Lock()
+	defer syncer.statusMu.Unlock()
+
+	changed := syncer.status.Device != status.Device || syncer.status.ClockIdentity != status.ClockIdentity
+
+	// the root delay is the round trip to the PTP master; if the PTP daemon doesn't report the path delay,
+	// it is unknown, and only the delay of reading the PHC is accounted for instead of advertising zero delay
+	rootDelay := status.ReadDelay
+
+	if status.PathDelayAvailable {
+		rootDelay = 2 * status.PathDelay
+	}
+
+	syncer.status = status
+	syncer.syncState = ntp.SyncState{
+		Synced:         true,
+		Stratum:        0,
+		ReferenceID:    referenceID,
+		ReferenceTime:  syncer.CurrentTime(),
+		RootDelay:      rootDelay,
+		RootDispersion: absDuration(status.Offset),
+	}
+
+	if !changed && syncer.CurrentTime().Sub(syncer.lastStatusNotify) < statusInterval {
+		return
+	}
+
+	syncer.lastStatusNotify = syncer.CurrentTime()
+
+	select {
+	case syncer.statusChangeCh <- struct{}{}:
+	default:
+	}
+}
+
+// Run runs the sync process.
+//
+// Run is usually run in a goroutine.
+// When context is canceled, sync process aborts.
+func (syncer *Syncer) Run(ctx context.Context) {
+	ntp.RTCClockInitialize.Do(func() {
+		var err error
+
+		ntp.RTCClock, err = rtc.OpenRTC()
+		if err != nil {
+			syncer.logger.Error("failure opening RTC, ignored", zap.Error(err))
+		}
+	})
+
+	var (
+		clock   Clock
+		lastErr string
+	)
+
+	defer func() {
+		if clock != nil {
+			clock.Close() //nolint:errcheck
+		}
+	}()
+
+	for {
+		interval := syncer.PollInterval
+
+		err := func() error {
+			if clock == nil {
+				var err error
+
+				if clock, err = syncer.open(); err != nil {
+					return err
+				}
+			}
+
+			if err := syncer.sync(clock); err != nil {
+				clock.Close() //nolint:errcheck
+				clock = nil
+
+				return err
+			}
+
+			return nil
+		}()
+
+		switch {
+		case err != nil:
+			interval = retryInterval
+
+			// avoid logging the same error on every retry
+			if err.Error() != lastErr {
+				syncer.logger.Warn("PTP hardware clock sync failed", zap.Error(err))
+			}
+
+			lastErr = err.Error()
+		case lastErr != "":
+			syncer.logger.Info("PTP hardware clock sync recovered")
+
+			lastErr = ""
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(interval):
+		}
+	}
+}
+
+func (syncer *Syncer) open() (Clock, error) {
+	device := syncer.device
+
+	if device == "" {
+		var err error
+
+		if device, err = syncer.FindDevice(syncer.iface); err != nil {
+			return nil, err
+		}
+	}
+
+	clock, err := syncer.OpenClock(device)
+	if err != nil {
+		return nil, fmt.Errorf("error opening PTP hardware clock %q: %w", device, err)
+	}
+
+	syncer.utcOffset = 0
+
+	if !clock.UTC() {
+		// the PHC of the NIC might run on TAI, so it can't be used until the UTC offset is known (same as phc2sys -w)
+		props, err := syncer.QueryTimeProperties()
+		if err != nil {
+			clock.Close() //nolint:errcheck
+
+			return nil, fmt.Errorf("error querying PTP daemon for the UTC offset of PTP hardware clock %q: %w", device, err)
+		}
+
+		syncer.utcOffset = props.UTCOffset()
+		syncer.utcOffsetNextQuery = syncer.CurrentTime().Add(timePropertiesInterval)
+	}
+
+	syncer.logger.Info("using PTP hardware clock",
+		zap.String("device", device),
+		zap.String("clock_identity", clock.Identity()),
+		zap.Duration("utc_offset", syncer.utcOffset),
+	)
+
+	syncer.statusMu.Lock()
+	syncer.status.Device = device
+	syncer.statusMu.Unlock()
+
+	return clock, nil
+}
+
+func (syncer *Syncer) sync(clock Clock) error {
+	offset, delay, err := clock.Offset(numSamples)
+	if err != nil {
+		return fmt.Errorf("error measuring PTP hardware clock offset: %w", err)
+	}
+
+	if !clock.UTC() {
+		syncer.refreshUTCOffset()
+	}
+
+	// the system clock is UTC
+	offset -= syncer.utcOffset
+
+	syncer.logger.Debug("PHC offset", zap.Duration("offset", offset), zap.Duration("delay", delay))
+
+	if (syncer.firstSync && absDuration(offset) > FirstStepThreshold) || absDuration(offset) > ntp.AdjustTimeLimit {
+		err = syncer.step(offset)
+	} else {
+		err = syncer.slew(offset, delay)
+	}
+
+	if err != nil {
+		return err
+	}
+
+	syncer.firstSync = false
+
+	pathDelay, pathDelayAvailable := syncer.queryPathDelay()
+
+	syncer.setStatus(Status{
+		Device:             syncer.Status().Device,
+		ClockIdentity:      clock.Identity(),
+		Offset:             offset,
+		ReadDelay:          delay,
+		PathDelay:          pathDelay,
+		PathDelayAvailable: pathDelayAvailable,
+		UTCOffset:          syncer.utcOffset,
+	})
+
+	if !syncer.timeSyncNotified {
+		// successful first time sync, notify about it
+		close(syncer.timeSynced)
+
+		syncer.timeSyncNotified = true
+	}
+
+	return nil
+}
+
+// queryPathDelay queries the PTP daemon for the path delay to the PTP master.
+//
+// The PTP daemon is optional (it is not running for the virtual clocks, and Talos doesn't ship it),
+// so after a failure the daemon is not queried again until the backoff interval passes.
+func (syncer *Syncer) queryPathDelay() (time.Duration, bool) {
+	now := syncer.CurrentTime()
+
+	if now.Before(syncer.pathDelayNextQuery) {
+		return 0, false
+	}
+
+	pathDelay, err := syncer.QueryPathDelay()
+	if err != nil {
+		syncer.pathDelayBackoff *= 2
+
+		switch {
+		case syncer.pathDelayBackoff < minPathDelayBackoff:
+			syncer.pathDelayBackoff = minPathDelayBackoff
+		case syncer.pathDelayBackoff > maxPathDelayBackoff:
+			syncer.pathDelayBackoff = maxPathDelayBackoff
+		}
+
+		syncer.pathDelayNextQuery = now.Add(syncer.pathDelayBackoff)
+
+		syncer.logger.Debug("PTP path delay is not available", zap.Error(err), zap.Duration("next_query_in", syncer.pathDelayBackoff))
+
+		return 0, false
+	}
+
+	syncer.pathDelayBackoff = 0
+
+	return pathDelay, true
+}
+
+// refreshUTCOffset queries the PTP daemon for the UTC offset, as it changes with leap seconds.
+//
+// If the query fails, the last known UTC offset is used.
+func (syncer *Syncer) refreshUTCOffset() {
+	now := syncer.CurrentTime()
+
+	if now.Before(syncer.utcOffsetNextQuery) {
+		return
+	}
+
+	syncer.utcOffsetNextQuery = now.Add(timePropertiesInterval)
+
+	props, err := syncer.QueryTimeProperties()
+	if err != nil {
+		syncer.logger.Warn("failed to query PTP daemon for the UTC offset, using the last known one", zap.Error(err), zap.Duration("utc_offset", syncer.utcOffset))
+
+		return
+	}
+
+	if props.UTCOffset() != syncer.utcOffset {
+		syncer.logger.Info("PTP UTC offset changed", zap.Duration("old", syncer.utcOffset), zap.Duration("new", props.UTCOffset()))
+
+		syncer.utcOffset = props.UTCOffset()
+	}
+}
+
+// step adds an offset to the current time (jump).
+func (syncer *Syncer) step(offset time.Duration) error {
+	req := syscall.Timex{
+		Modes: timex.ADJ_SETOFFSET | timex.ADJ_NANO,
+		Time: syscall.Timeval{
+			Sec:  int64(offset / time.Second),
+			Usec: int64(offset / time.Nanosecond % time.Second),
+		},
+	}
+
+	// kernel wants tv_usec to be positive
+	if req.Time.Usec < 0 {
+		req.Time.Sec--
+		req.Time.Usec += int64(time.Second / time.Nanosecond)
+	}
+
+	state, err := syncer.AdjustTime(&req)
+	if err != nil {
+		return fmt.Errorf("error adjusting time (jump) by %s: %w", offset, err)
+	}
+
+	syncer.logger.Info(fmt.Sprintf("adjusting time (jump) by %s via PTP hardware clock, state %s", offset, state))
+
+	if offset < -ntp.EpochLimit || offset > ntp.EpochLimit {
+		// notify about epoch change
+		select {
+		case syncer.epochChangeCh <- struct{}{}:
+		default:
+		}
+	}
+
+	if ntp.RTCClock != nil {
+		if rtcErr := ntp.RTCClock.Set(time.Now()); rtcErr != nil {
+			syncer.logger.Error("error syncing RTC", zap.Error(rtcErr))
+		} else {
+			syncer.logger.Info("synchronized RTC with system clock")
+		}
+	}
+
+	return nil
+}
+
+// slew adjusts the frequency of the system clock to approach the PHC time.
+//
+// Kernel PLL is disabled, as the frequency is controlled by the PI servo directly.
+func (syncer *Syncer) slew(offset, delay time.Duration) error {
+	ppb := syncer.servo.sample(offset, syncer.PollInterval)
+
+	req := syscall.Timex{
+		Modes:    timex.ADJ_FREQUENCY | timex.ADJ_STATUS | timex.ADJ_MAXERROR | timex.ADJ_ESTERROR,
+		Freq:     int64(math.Round(ppb * 65.536)), // ppm with 16-bit fractional part
+		Status:   0,
+		Maxerror: (absDuration(offset) + delay).Microseconds(),
+		Esterror: delay.Microseconds(),
+	}
+
+	state, err := syncer.AdjustTime(&req)
+	if err != nil {
+		return fmt.Errorf("error adjusting frequency by %.3f ppm: %w", ppb/1000, err)
+	}
+
+	syncer.logger.Debug("adjusting frequency via PTP hardware clock",
+		zap.Duration("offset", offset),
+		zap.Float64("freq_offset_ppm", ppb/1000),
+		zap.Stringer("state", state),
+	)
+
+	return nil
+}
+
+func absDuration(d time.Duration) time.Duration {
+	if d < 0 {
+		return -d
+	}
+
+	return d
+}
@@ -0,0 +1,319 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package ptp_test
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"sync/atomic"
+	"syscall"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap/zaptest"
+
+	"github.com/siderolabs/talos/internal/pkg/ptp"
+	"github.com/siderolabs/talos/internal/pkg/timex"
+)
+
+type mockClock struct {
+	mu      sync.Mutex
+	offsets []time.Duration
+	utc     bool
+	closed  bool
+}
+
+func (clock *mockClock) Offset(int) (offset, delay time.Duration, err error) {
+	clock.mu.Lock()
+	defer clock.mu.Unlock()
+
+	if len(clock.offsets) == 0 {
+		return 0, 0, errors.New("no more samples")
+	}
+
+	offset, clock.offsets = clock.offsets[0], clock.offsets[1:]
+
+	return offset, 100 * time.Nanosecond, nil
+}
+
+func (clock *mockClock) Identity() string {
+	return "b49691.fffe.0a0b0c"
+}
+
+func (clock *mockClock) UTC() bool {
+	return clock.utc
+}
+
+func (clock *mockClock) Close() error {
+	clock.mu.Lock()
+	defer clock.mu.Unlock()
+
+	clock.closed = true
+
+	return nil
+}
+
+//nolint:gocyclo
+func TestSyncer(t *testing.T) {
+	t.Parallel()
+
+	var (
+		adjustMu    sync.Mutex
+		adjustments []syscall.Timex
+	)
+
+	// the PHC of the NIC runs on TAI
+	const utcOffset = 37 * time.Second
+
+	clock := &mockClock{
+		offsets: []time.Duration{utcOffset + time.Millisecond, utcOffset + 500*time.Nanosecond, utcOffset - 200*time.Nanosecond},
+	}
+
+	syncer := ptp.NewSyncer(zaptest.NewLogger(t), "", "eth0")
+	syncer.PollInterval = 10 * time.Millisecond
+	syncer.FindDevice = func(iface string) (string, error) {
+		assert.Equal(t, "eth0", iface)
+
+		return "/dev/ptp0", nil
+	}
+	syncer.OpenClock = func(path string) (ptp.Clock, error) {
+		assert.Equal(t, "/dev/ptp0", path)
+
+		return clock, nil
+	}
+	syncer.QueryPathDelay = func() (time.Duration, error) {
+		return 1500 * time.Nanosecond, nil
+	}
+	syncer.QueryTimeProperties = func() (ptp.TimeProperties, error) {
+		return ptp.TimeProperties{
+			CurrentUTCOffset:      utcOffset,
+			CurrentUTCOffsetValid: true,
+			PTPTimescale:          true,
+		}, nil
+	}
+	syncer.AdjustTime = func(req *syscall.Timex) (timex.State, error) {
+		adjustMu.Lock()
+		defer adjustMu.Unlock()
+
+		adjustments = append(adjustments, *req)
+
+		return timex.TIME_OK, nil
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+
+	var wg sync.WaitGroup
+
+	wg.Add(1)
+
+	go func() {
+		defer wg.Done()
+
+		syncer.Run(ctx)
+	}()
+
+	select {
+	case <-syncer.Synced():
+	case <-time.After(10 * time.Second):
+		require.Fail(t, "time sync timeout")
+	}
+
+	select {
+	case <-syncer.StatusChange():
+	case <-time.After(10 * time.Second):
+		require.Fail(t, "status change timeout")
+	}
+
+	assert.Eventually(t, func() bool {
+		adjustMu.Lock()
+		defer adjustMu.Unlock()
+
+		return len(adjustments) == 3
+	}, 10*time.Second, 10*time.Millisecond)
+
+	cancel()
+	wg.Wait()
+
+	// first sync steps the clock, the UTC offset is subtracted from the PHC time
+	assert.EqualValues(t, timex.ADJ_SETOFFSET|timex.ADJ_NANO, adjustments[0].Modes)
+	assert.EqualValues(t, time.Millisecond, adjustments[0].Time.Usec)
+
+	// next ones adjust the frequency
+	assert.EqualValues(t, timex.ADJ_FREQUENCY|timex.ADJ_STATUS|timex.ADJ_MAXERROR|timex.ADJ_ESTERROR, adjustments[1].Modes)
+	assert.Positive(t, adjustments[1].Freq)
+	assert.EqualValues(t, timex.ADJ_FREQUENCY|timex.ADJ_STATUS|timex.ADJ_MAXERROR|timex.ADJ_ESTERROR, adjustments[2].Modes)
+	assert.Less(t, adjustments[2].Freq, adjustments[1].Freq)
+
+	assert.Equal(t, ptp.Status{
+		Device:             "/dev/ptp0",
+		ClockIdentity:      "b49691.fffe.0a0b0c",
+		Offset:             -200 * time.Nanosecond,
+		ReadDelay:          100 * time.Nanosecond,
+		PathDelay:          1500 * time.Nanosecond,
+		PathDelayAvailable: true,
+		UTCOffset:          utcOffset,
+	}, syncer.Status())
+
+	state := syncer.SyncState()
+	assert.True(t, state.Synced)
+	assert.EqualValues(t, 0, state.Stratum)
+	assert.Equal(t, 3*time.Microsecond, state.RootDelay)
+
+	// the clock is closed after a failure
+	assert.True(t, clock.closed)
+}
+
+func TestSyncerNoPTPDaemon(t *testing.T) {
+	t.Parallel()
+
+	var (
+		adjustMu    sync.Mutex
+		adjustments int
+		queries     atomic.Int32
+	)
+
+	// ptp_kvm clock doesn't have the PTP daemon
+	clock := &mockClock{
+		offsets: []time.Duration{time.Millisecond, 500 * time.Nanosecond, -200 * time.Nanosecond, 100 * time.Nanosecond},
+		utc:     true,
+	}
+
+	syncer := ptp.NewSyncer(zaptest.NewLogger(t), "/dev/ptp0", "")
+	syncer.PollInterval = 10 * time.Millisecond
+	syncer.OpenClock = func(string) (ptp.Clock, error) {
+		return clock, nil
+	}
+	syncer.QueryPathDelay = func() (time.Duration, error) {
+		queries.Add(1)
+
+		return 0, errors.New("no PTP daemon")
+	}
+	syncer.QueryTimeProperties = func() (ptp.TimeProperties, error) {
+		assert.Fail(t, "UTC offset is not queried for the clock on UTC")
+
+		return ptp.TimeProperties{}, errors.New("no PTP daemon")
+	}
+	syncer.AdjustTime = func(req *syscall.Timex) (timex.State, error) {
+		adjustMu.Lock()
+		defer adjustMu.Unlock()
+
+		adjustments++
+
+		return timex.TIME_OK, nil
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+
+	var wg sync.WaitGroup
+
+	wg.Add(1)
+
+	go func() {
+		defer wg.Done()
+
+		syncer.Run(ctx)
+	}()
+
+	assert.Eventually(t, func() bool {
+		adjustMu.Lock()
+		defer adjustMu.Unlock()
+
+		return adjustments == 4
+	}, 10*time.Second, 10*time.Millisecond)
+
+	cancel()
+	wg.Wait()
+
+	// the daemon is not queried again on every poll after a failure
+	assert.EqualValues(t, 1, queries.Load())
+
+	assert.False(t, syncer.Status().PathDelayAvailable)
+
+	// the path delay is unknown, so only the PHC read delay is advertised as the root delay
+	assert.Equal(t, 100*time.Nanosecond, syncer.SyncState().RootDelay)
+}
+
+func TestSyncerUTCOffsetUnknown(t *testing.T) {
+	t.Parallel()
+
+	var (
+		adjustMu    sync.Mutex
+		adjustments []syscall.Timex
+		daemonUp    atomic.Bool
+	)
+
+	clock := &mockClock{
+		offsets: []time.Duration{37*time.Second + time.Millisecond},
+	}
+
+	syncer := ptp.NewSyncer(zaptest.NewLogger(t), "/dev/ptp0", "")
+	syncer.PollInterval = 10 * time.Millisecond
+	syncer.OpenClock = func(string) (ptp.Clock, error) {
+		return clock, nil
+	}
+	syncer.QueryPathDelay = func() (time.Duration, error) {
+		return 0, errors.New("no PTP daemon")
+	}
+	syncer.QueryTimeProperties = func() (ptp.TimeProperties, error) {
+		if !daemonUp.Load() {
+			return ptp.TimeProperties{}, errors.New("no PTP daemon")
+		}
+
+		return ptp.TimeProperties{
+			CurrentUTCOffset: 37 * time.Second,
+			PTPTimescale:     true,
+		}, nil
+	}
+	syncer.AdjustTime = func(req *syscall.Timex) (timex.State, error) {
+		adjustMu.Lock()
+		defer adjustMu.Unlock()
+
+		adjustments = append(adjustments, *req)
+
+		return timex.TIME_OK, nil
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+
+	var wg sync.WaitGroup
+
+	wg.Add(1)
+
+	go func() {
+		defer wg.Done()
+
+		syncer.Run(ctx)
+	}()
+
+	// the PHC might run on TAI, so it is not used until the UTC offset is known
+	select {
+	case <-syncer.Synced():
+		assert.Fail(t, "unexpected sync")
+	case <-time.After(100 * time.Millisecond):
+	}
+
+	adjustMu.Lock()
+	assert.Empty(t, adjustments)
+	adjustMu.Unlock()
+
+	daemonUp.Store(true)
+
+	select {
+	case <-syncer.Synced():
+	case <-time.After(10 * time.Second):
+		require.Fail(t, "time sync timeout")
+	}
+
+	cancel()
+	wg.Wait()
+
+	require.NotEmpty(t, adjustments)
+	assert.EqualValues(t, timex.ADJ_SETOFFSET|timex.ADJ_NANO, adjustments[0].Modes)
+	assert.EqualValues(t, 0, adjustments[0].Time.Sec)
+	assert.EqualValues(t, time.Millisecond, adjustments[0].Time.Usec)
+}
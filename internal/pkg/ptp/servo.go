@@ -0,0 +1,54 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package ptp
+
+import (
+	"math"
+	"time"
+)
+
+// PI servo constants for hardware clocks (same as linuxptp phc2sys defaults).
+const (
+	servoKP = 0.7
+	servoKI = 0.3
+
+	// maxFrequency is the maximum frequency adjustment supported by the kernel (500 ppm), in ppb.
+	maxFrequency = 500_000
+)
+
+// servo is a PI controller which converts clock offset samples into frequency adjustments.
+type servo struct {
+	// drift is the integral term, in ppb
+	drift float64
+}
+
+// sample returns the frequency adjustment in ppb for the offset measured after the interval.
+//
+// Positive offset means the system clock is behind the reference clock, so the frequency should be increased.
+func (s *servo) sample(offset, interval time.Duration) float64 {
+	offsetNs := float64(offset.Nanoseconds())
+	intervalSec := interval.Seconds()
+
+	if intervalSec <= 0 {
+		intervalSec = 1
+	}
+
+	kiTerm := servoKI * offsetNs / intervalSec
+	ppb := servoKP*offsetNs/intervalSec + s.drift + kiTerm
+
+	switch {
+	case ppb > maxFrequency:
+		ppb = maxFrequency
+	case ppb < -maxFrequency:
+		ppb = -maxFrequency
+	default:
+		// integrate only when not saturated (anti-windup)
+		s.drift += kiTerm
+	}
+
+	s.drift = math.Max(math.Min(s.drift, maxFrequency), -maxFrequency)
+
+	return ppb
+}
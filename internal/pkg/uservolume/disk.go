@@ -0,0 +1,287 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package uservolume
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"sort"
+
+	"github.com/cosi-project/runtime/pkg/controller"
+	"github.com/cosi-project/runtime/pkg/safe"
+	"github.com/siderolabs/go-blockdevice/blockdevice"
+	"github.com/siderolabs/go-blockdevice/blockdevice/partition/gpt"
+
+	"github.com/siderolabs/talos/internal/pkg/partition"
+	"github.com/siderolabs/talos/pkg/machinery/config/config"
+	"github.com/siderolabs/talos/pkg/machinery/constants"
+	"github.com/siderolabs/talos/pkg/machinery/resources/block"
+)
+
+// diskInfo is the discovered disk with its partitions.
+type diskInfo struct {
+	id         string
+	device     block.BlockDeviceSpec
+	volume     block.DiscoveredVolumeSpec
+	partitions []block.DiscoveredVolumeSpec
+}
+
+// systemDisk returns true if the disk has Talos system partitions.
+func (d *diskInfo) systemDisk() bool {
+	for _, part := range d.partitions {
+		switch part.PartitionLabel {
+		case constants.StatePartitionLabel, constants.EphemeralPartitionLabel:
+			return true
+		}
+	}
+
+	return false
+}
+
+// ErrDiscoveryIncomplete is returned when the block devices are not discovered yet.
+var ErrDiscoveryIncomplete = errors.New("block device discovery is not complete")
+
+// discoverDisks lists the disks from the block device resources.
+//
+// It returns ErrDiscoveryIncomplete if the discovery is not complete yet.
+func discoverDisks(ctx context.Context, r controller.Reader) ([]diskInfo, error) {
+	devices, err := safe.ReaderListAll[*block.BlockDevice](ctx, r)
+	if err != nil {
+		return nil, err
+	}
+
+	volumes, err := safe.ReaderListAll[*block.DiscoveredVolume](ctx, r)
+	if err != nil {
+		return nil, err
+	}
+
+	if devices.Len() == 0 {
+		return nil, fmt.Errorf("%w: no block devices discovered yet", ErrDiscoveryIncomplete)
+	}
+
+	discovered := make(map[string]block.DiscoveredVolumeSpec, volumes.Len())
+
+	for iter := safe.IteratorFromList(volumes); iter.Next(); {
+		discovered[iter.Value().Metadata().ID()] = *iter.Value().TypedSpec()
+	}
+
+	disks := map[string]*diskInfo{}
+
+	for iter := safe.IteratorFromList(devices); iter.Next(); {
+		device := iter.Value()
+
+		volume, ok := discovered[device.Metadata().ID()]
+		if !ok {
+			return nil, fmt.Errorf("%w: block device %q is not probed yet", ErrDiscoveryIncomplete, device.Metadata().ID())
+		}
+
+		if device.TypedSpec().Type == block.DeviceTypeDisk {
+			disks[device.Metadata().ID()] = &diskInfo{
+				id:     device.Metadata().ID(),
+				device: *device.TypedSpec(),
+				volume: volume,
+			}
+		}
+	}
+
+	for iter := safe.IteratorFromList(volumes); iter.Next(); {
+		volume := iter.Value().TypedSpec()
+
+		if disk, ok := disks[volume.Parent]; ok && volume.Type == block.DeviceTypePartition {
+			disk.partitions = append(disk.partitions, *volume)
+		}
+	}
+
+	result := make([]diskInfo, 0, len(disks))
+
+	for _, disk := range disks {
+		result = append(result, *disk)
+	}
+
+	sort.Slice(result, func(i, j int) bool { return result[i].id < result[j].id })
+
+	return result, nil
+}
+
+// locateVolume finds the disk which has the partition with the specified label.
+func locateVolume(disks []diskInfo, label string) *diskInfo {
+	for i := range disks {
+		for _, part := range disks[i].partitions {
+			if part.PartitionLabel == label {
+				return &disks[i]
+			}
+		}
+	}
+
+	return nil
+}
+
+// selectDisk picks the first disk which matches the disk selector and has enough free space.
+func selectDisk(provisioning config.VolumeProvisioningConfig, disks []diskInfo) (*diskInfo, error) {
+	for i := range disks {
+		disk := &disks[i]
+
+		if disk.device.ReadOnly {
+			continue
+		}
+
+		if !provisioning.DiskSelector().Match(config.VolumeDisk{
+			Size:       disk.device.Size,
+			Model:      disk.device.Model,
+			Transport:  disk.device.Transport,
+			WWID:       disk.device.WWID,
+			SystemDisk: disk.systemDisk(),
+		}) {
+			continue
+		}
+
+		// never touch the disks with the data outside of the GPT partitions
+		if disk.volume.FilesystemType != "" || (disk.volume.PartitionTable != "" && disk.volume.PartitionTable != "gpt") {
+			continue
+		}
+
+		free, err := diskFreeSpace(disk.device.DevPath)
+		if err != nil {
+			return nil, fmt.Errorf("error reading free space on %q: %w", disk.device.DevPath, err)
+		}
+
+		if free >= provisioning.MinSize() {
+			return disk, nil
+		}
+	}
+
+	return nil, errors.New("no disk matching the disk selector with enough free space found")
+}
+
+// diskFreeSpace returns the free space at the end of the disk.
+func diskFreeSpace(devPath string) (uint64, error) {
+	f, err := os.Open(devPath)
+	if err != nil {
+		return 0, err
+	}
+
+	defer f.Close() //nolint:errcheck
+
+	pt, err := gpt.Open(f)
+	if err != nil {
+		if !errors.Is(err, gpt.ErrPartitionTableDoesNotExist) {
+			return 0, err
+		}
+
+		// the partition table will be created, so build it in memory to calculate the usable space
+		if pt, err = gpt.New(f); err != nil {
+			return 0, err
+		}
+	} else if err = pt.Read(); err != nil {
+		return 0, err
+	}
+
+	return freeSpace(pt), nil
+}
+
+func freeSpace(pt *gpt.GPT) uint64 {
+	lastLBA := pt.Header().FirstUsableLBA - 1
+
+	for _, part := range pt.Partitions().Items() {
+		if part != nil && part.LastLBA > lastLBA {
+			lastLBA = part.LastLBA
+		}
+	}
+
+	if lastLBA >= pt.Header().LastUsableLBA {
+		return 0
+	}
+
+	return (pt.Header().LastUsableLBA - lastLBA) * uint64(pt.Header().LogicalBlockSize)
+}
+
+// createPartition creates the volume partition at the end of the disk.
+func createPartition(devPath, label string, provisioning config.VolumeProvisioningConfig) error {
+	bd, err := blockdevice.Open(devPath, blockdevice.WithExclusiveLock(true))
+	if err != nil {
+		return err
+	}
+
+	defer bd.Close() //nolint:errcheck
+
+	pt, err := bd.PartitionTable()
+	if err != nil {
+		if !errors.Is(err, blockdevice.ErrMissingPartitionTable) {
+			return err
+		}
+
+		if pt, err = gpt.New(bd.Device()); err != nil {
+			return err
+		}
+	}
+
+	free := freeSpace(pt)
+	if free < provisioning.MinSize() {
+		return fmt.Errorf("not enough free space on %q: %d < %d", devPath, free, provisioning.MinSize())
+	}
+
+	opts := partition.Options{
+		PartitionLabel: label,
+		PartitionType:  partition.LinuxFilesystemData,
+	}
+
+	// zero size means the partition takes all available space
+	if provisioning.MaxSize() < free {
+		opts.Size = provisioning.MaxSize()
+	}
+
+	if _, err = partition.Partition(pt, len(pt.Partitions().Items()), devPath, opts); err != nil {
+		return err
+	}
+
+	return pt.Write()
+}
+
+// growPartition grows the volume partition up to the next partition or the end of the disk, limited by the maximum size.
+func growPartition(devPath, label string, maxSize uint64) (bool, error) {
+	bd, err := blockdevice.Open(devPath, blockdevice.WithExclusiveLock(true))
+	if err != nil {
+		return false, err
+	}
+
+	defer bd.Close() //nolint:errcheck
+
+	pt, err := bd.PartitionTable()
+	if err != nil {
+		return false, err
+	}
+
+	part, err := partition.Locate(pt, label)
+	if err != nil {
+		return false, err
+	}
+
+	if part == nil {
+		return false, fmt.Errorf("partition %q not found", label)
+	}
+
+	lastLBA := part.LastLBA
+
+	resized, err := pt.Resize(part)
+	if err != nil || !resized {
+		return false, err
+	}
+
+	if maxSize > 0 {
+		if maxLBA := part.FirstLBA + maxSize/uint64(pt.Header().LogicalBlockSize) - 1; part.LastLBA > maxLBA {
+			part.LastLBA = maxLBA
+		}
+	}
+
+	if part.LastLBA <= lastLBA {
+		part.LastLBA = lastLBA
+
+		return false, nil
+	}
+
+	return true, pt.Write()
+}
@@ -0,0 +1,172 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package uservolume //nolint:testpackage
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/siderolabs/go-blockdevice/blockdevice/partition/gpt"
+	"github.com/siderolabs/go-pointer"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	blockcfg "github.com/siderolabs/talos/pkg/machinery/config/types/block"
+	"github.com/siderolabs/talos/pkg/machinery/constants"
+	"github.com/siderolabs/talos/pkg/machinery/resources/block"
+)
+
+const mib = 1024 * 1024
+
+func createImage(t *testing.T, name string, size int64, partitions ...uint64) string {
+	t.Helper()
+
+	path := filepath.Join(t.TempDir(), name)
+
+	f, err := os.Create(path)
+	require.NoError(t, err)
+
+	require.NoError(t, f.Truncate(size))
+
+	if len(partitions) > 0 {
+		pt, err := gpt.New(f)
+		require.NoError(t, err)
+
+		for _, size := range partitions {
+			_, err = pt.Add(size)
+			require.NoError(t, err)
+		}
+
+		// syncing the kernel partitions fails for a regular file, but the partition table is written at that point
+		pt.Write() //nolint:errcheck
+	}
+
+	require.NoError(t, f.Close())
+
+	return path
+}
+
+func TestDiskFreeSpace(t *testing.T) {
+	t.Parallel()
+
+	empty := createImage(t, "empty", 64*mib)
+
+	free, err := diskFreeSpace(empty)
+	require.NoError(t, err)
+
+	// GPT headers and entries take 17 KiB at the beginning and at the end of the disk
+	assert.InDelta(t, 64*mib, free, 64*1024)
+
+	partitioned := createImage(t, "partitioned", 64*mib, 16*mib, 8*mib)
+
+	free, err = diskFreeSpace(partitioned)
+	require.NoError(t, err)
+
+	assert.InDelta(t, 40*mib, free, 2*mib)
+}
+
+func TestSelectDisk(t *testing.T) {
+	t.Parallel()
+
+	small := createImage(t, "small", 64*mib)
+	full := createImage(t, "full", 256*mib, 250*mib)
+	large := createImage(t, "large", 256*mib)
+
+	disks := []diskInfo{
+		{
+			id:     "sda",
+			device: block.BlockDeviceSpec{DevPath: small, Size: 64 * mib, Model: "QEMU HARDDISK"},
+		},
+		{
+			id:     "sdb",
+			device: block.BlockDeviceSpec{DevPath: full, Size: 256 * mib, Model: "QEMU HARDDISK"},
+			volume: block.DiscoveredVolumeSpec{PartitionTable: "gpt"},
+			partitions: []block.DiscoveredVolumeSpec{
+				{PartitionLabel: constants.EphemeralPartitionLabel},
+			},
+		},
+		{
+			id:     "sdc",
+			device: block.BlockDeviceSpec{DevPath: large, Size: 256 * mib, Model: "Samsung SSD", ReadOnly: true},
+		},
+		{
+			id:     "sdd",
+			device: block.BlockDeviceSpec{DevPath: large, Size: 256 * mib, Model: "Samsung SSD"},
+			volume: block.DiscoveredVolumeSpec{FilesystemType: "xfs"},
+		},
+		{
+			id:     "sde",
+			device: block.BlockDeviceSpec{DevPath: large, Size: 256 * mib, Model: "Samsung SSD"},
+		},
+	}
+
+	for _, test := range []struct {
+		name string
+
+		selector blockcfg.DiskSelector
+		minSize  uint64
+
+		expected      string
+		expectedError string
+	}{
+		{
+			name:     "first fit",
+			minSize:  32 * mib,
+			expected: "sda",
+		},
+		{
+			name:     "not enough space",
+			minSize:  128 * mib,
+			expected: "sde",
+		},
+		{
+			name: "system disk without free space",
+			selector: blockcfg.DiskSelector{
+				SystemDisk: pointer.To(true),
+			},
+			minSize:       32 * mib,
+			expectedError: "no disk matching the disk selector with enough free space found",
+		},
+		{
+			name: "non-system disk",
+			selector: blockcfg.DiskSelector{
+				SystemDisk: pointer.To(false),
+			},
+			minSize:  32 * mib,
+			expected: "sda",
+		},
+		{
+			name: "model",
+			selector: blockcfg.DiskSelector{
+				Model: "Samsung*",
+			},
+			minSize:  32 * mib,
+			expected: "sde",
+		},
+	} {
+		test := test
+
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			provisioning := blockcfg.ProvisioningSpec{
+				DiskSelectorSpec:    test.selector,
+				ProvisioningMinSize: blockcfg.NewByteSize(test.minSize),
+			}
+
+			disk, err := selectDisk(provisioning, disks)
+
+			if test.expectedError != "" {
+				assert.EqualError(t, err, test.expectedError)
+
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, test.expected, disk.id)
+		})
+	}
+}
@@ -0,0 +1,202 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+// Package uservolume implements provisioning and mounting of the user volumes.
+package uservolume
+
+import (
+	"context"
+	"fmt"
+	"path/filepath"
+	"sync"
+
+	"github.com/cosi-project/runtime/pkg/controller"
+	"github.com/dustin/go-humanize"
+	"github.com/siderolabs/go-blockdevice/blockdevice"
+	"go.uber.org/zap"
+
+	"github.com/siderolabs/talos/internal/pkg/mount"
+	"github.com/siderolabs/talos/internal/pkg/partition"
+	"github.com/siderolabs/talos/pkg/machinery/config/config"
+	"github.com/siderolabs/talos/pkg/machinery/constants"
+	"github.com/siderolabs/talos/pkg/machinery/resources/block"
+)
+
+var (
+	mountpoints      = map[string]*mount.Point{}
+	mountpointsMutex sync.Mutex
+)
+
+// Mount provisions and mounts the user volume, and returns its status.
+//
+// If the block devices are not discovered yet, Mount returns ErrDiscoveryIncomplete.
+func Mount(ctx context.Context, logger *zap.Logger, r controller.Reader, volume config.UserVolumeConfig) (*block.VolumeStatusSpec, error) {
+	disks, err := discoverDisks(ctx, r)
+	if err != nil {
+		return nil, err
+	}
+
+	return mountVolume(logger, disks, volume)
+}
+
+// Mounted returns true if the user volume is mounted.
+func Mounted(name string) bool {
+	mountpointsMutex.Lock()
+	defer mountpointsMutex.Unlock()
+
+	_, ok := mountpoints[name]
+
+	return ok
+}
+
+// Unmount unmounts the user volume mounted with Mount.
+func Unmount(name string) error {
+	mountpointsMutex.Lock()
+	defer mountpointsMutex.Unlock()
+
+	return unmount(name)
+}
+
+// UnmountAll unmounts all user volumes mounted with Mount.
+func UnmountAll() error {
+	mountpointsMutex.Lock()
+	defer mountpointsMutex.Unlock()
+
+	for name := range mountpoints {
+		if err := unmount(name); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func unmount(name string) error {
+	mountpoint, ok := mountpoints[name]
+	if !ok {
+		return nil
+	}
+
+	if err := mountpoint.Unmount(); err != nil {
+		return fmt.Errorf("error unmounting user volume %q: %w", name, err)
+	}
+
+	delete(mountpoints, name)
+
+	return nil
+}
+
+//nolint:gocyclo,cyclop
+func mountVolume(logger *zap.Logger, disks []diskInfo, volume config.UserVolumeConfig) (*block.VolumeStatusSpec, error) {
+	label := constants.UserVolumePartitionLabelPrefix + volume.Name()
+	target := filepath.Join(constants.UserVolumeMountPoint, volume.Name())
+
+	if Mounted(volume.Name()) {
+		return nil, fmt.Errorf("user volume %q is already mounted", volume.Name())
+	}
+
+	var (
+		devPath string
+		err     error
+	)
+
+	if disk := locateVolume(disks, label); disk != nil {
+		devPath = disk.device.DevPath
+
+		if volume.Provisioning().Grow() {
+			var resized bool
+
+			if resized, err = growPartition(devPath, label, volume.Provisioning().MaxSize()); err != nil {
+				return nil, fmt.Errorf("error growing the partition: %w", err)
+			}
+
+			if resized {
+				logger.Info("grown the partition of user volume", zap.String("volume", volume.Name()), zap.String("device", devPath))
+			}
+		}
+	} else {
+		disk, err := selectDisk(volume.Provisioning(), disks)
+		if err != nil {
+			return nil, err
+		}
+
+		devPath = disk.device.DevPath
+
+		logger.Info("provisioning user volume", zap.String("volume", volume.Name()), zap.String("device", devPath))
+
+		if err = createPartition(devPath, label, volume.Provisioning()); err != nil {
+			return nil, fmt.Errorf("error creating the partition: %w", err)
+		}
+	}
+
+	bd, err := blockdevice.Open(devPath)
+	if err != nil {
+		return nil, fmt.Errorf("error opening block device %q: %w", devPath, err)
+	}
+
+	defer bd.Close() //nolint:errcheck
+
+	pt, err := bd.PartitionTable()
+	if err != nil {
+		return nil, fmt.Errorf("error reading partition table: %w", err)
+	}
+
+	part, err := partition.Locate(pt, label)
+	if err != nil {
+		return nil, err
+	}
+
+	if part == nil {
+		return nil, fmt.Errorf("partition %q not found on %q", label, devPath)
+	}
+
+	partPath, err := part.Path()
+	if err != nil {
+		return nil, err
+	}
+
+	opts := []mount.Option{mount.WithLogger(zap.NewStdLog(logger))}
+
+	if volume.Encryption() != nil {
+		opts = append(opts, mount.WithEncryptionConfig(volume.Encryption()))
+	}
+
+	mountpoint, err := mount.UserVolumeMountPoint(bd, part, target, &partition.FormatOptions{
+		FileSystemType: volume.Filesystem().Type(),
+		Force:          true,
+	}, opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	if err = mountpoint.Mount(); err != nil {
+		return nil, err
+	}
+
+	if volume.Provisioning().Grow() {
+		if err = mountpoint.GrowFilesystem(); err != nil {
+			mountpoint.Unmount() //nolint:errcheck
+
+			return nil, err
+		}
+	}
+
+	mountpointsMutex.Lock()
+	mountpoints[volume.Name()] = mountpoint
+	mountpointsMutex.Unlock()
+
+	size := part.Length() * uint64(pt.Header().LogicalBlockSize)
+
+	logger.Info("mounted user volume", zap.String("volume", volume.Name()), zap.String("size", humanize.IBytes(size)), zap.String("target", target))
+
+	return &block.VolumeStatusSpec{
+		Phase:          block.VolumePhaseReady,
+		Location:       partPath,
+		ParentLocation: devPath,
+		Size:           size,
+		Filesystem:     mountpoint.Fstype(),
+		Encrypted:      volume.Encryption() != nil,
+		MountLocation:  target,
+	}, nil
+}
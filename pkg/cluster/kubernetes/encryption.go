@@ -0,0 +1,147 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package kubernetes
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"time"
+
+	"github.com/cosi-project/runtime/pkg/safe"
+	"github.com/siderolabs/go-retry/retry"
+	v1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/siderolabs/talos/pkg/machinery/client"
+	machinetype "github.com/siderolabs/talos/pkg/machinery/config/machine"
+	"github.com/siderolabs/talos/pkg/machinery/resources/k8s"
+)
+
+// ReencryptOptions represents Kubernetes secrets re-encryption settings.
+type ReencryptOptions struct {
+	ControlPlaneEndpoint string
+	LogOutput            io.Writer
+	DryRun               bool
+}
+
+// Log writes the line to logger or to stdout if no logger was provided.
+func (options *ReencryptOptions) Log(line string, args ...interface{}) {
+	if options.LogOutput != nil {
+		options.LogOutput.Write([]byte(fmt.Sprintf(line, args...))) //nolint:errcheck
+
+		return
+	}
+
+	fmt.Printf(line+"\n", args...)
+}
+
+// ReencryptSecrets rewrites all Kubernetes secrets, so that they get encrypted with the current encryption key.
+//
+// It should be run after the encryption configuration is changed (e.g. KMS encryption is enabled),
+// or the KMS plugin key is rotated.
+// Before rewriting the secrets, it waits for kube-apiserver on each controlplane node to run with the current encryption configuration.
+func ReencryptSecrets(ctx context.Context, cluster UpgradeProvider, options ReencryptOptions) error {
+	k8sClient, err := cluster.K8sHelper(ctx)
+	if err != nil {
+		return fmt.Errorf("error building kubernetes client: %w", err)
+	}
+
+	controlPlaneNodes, err := k8sClient.NodeIPs(ctx, machinetype.TypeControlPlane)
+	if err != nil {
+		return fmt.Errorf("error fetching controlplane nodes: %w", err)
+	}
+
+	if len(controlPlaneNodes) == 0 {
+		return fmt.Errorf("no controlplane nodes discovered")
+	}
+
+	options.Log("discovered controlplane nodes %q", controlPlaneNodes)
+
+	for _, node := range controlPlaneNodes {
+		options.Log(" > %q: waiting for %s to pick up encryption configuration", node, kubeAPIServer)
+
+		if err = retry.Constant(3*time.Minute, retry.WithUnits(10*time.Second)).Retry(func() error {
+			return checkAPIServerSecretsVersion(ctx, cluster, node)
+		}); err != nil {
+			return fmt.Errorf("error waiting for %s on node %q: %w", kubeAPIServer, node, err)
+		}
+
+		options.Log(" < %q: %s is up to date", node, kubeAPIServer)
+	}
+
+	var (
+		secrets       *v1.SecretList
+		continueToken string
+		rewritten     int
+	)
+
+	for {
+		secrets, err = k8sClient.CoreV1().Secrets(metav1.NamespaceAll).List(ctx, metav1.ListOptions{
+			Limit:    100,
+			Continue: continueToken,
+		})
+		if err != nil {
+			return fmt.Errorf("error listing secrets: %w", err)
+		}
+
+		for i := range secrets.Items {
+			secret := &secrets.Items[i]
+
+			if options.DryRun {
+				options.Log(" > secret %s/%s: skipped in dry-run", secret.Namespace, secret.Name)
+
+				continue
+			}
+
+			// writing the secret back as is makes kube-apiserver encrypt it with the current key
+			if _, err = k8sClient.CoreV1().Secrets(secret.Namespace).Update(ctx, secret, metav1.UpdateOptions{}); err != nil {
+				switch {
+				case apierrors.IsConflict(err):
+					// the secret was updated concurrently, so it is already encrypted with the current key
+				case apierrors.IsNotFound(err):
+					// the secret was removed
+				default:
+					return fmt.Errorf("error rewriting secret %s/%s: %w", secret.Namespace, secret.Name, err)
+				}
+
+				continue
+			}
+
+			rewritten++
+		}
+
+		continueToken = secrets.Continue
+
+		if continueToken == "" {
+			break
+		}
+	}
+
+	if !options.DryRun {
+		options.Log("rewritten %d secrets", rewritten)
+	}
+
+	return nil
+}
+
+func checkAPIServerSecretsVersion(ctx context.Context, cluster UpgradeProvider, node string) error {
+	c, err := cluster.Client()
+	if err != nil {
+		return fmt.Errorf("error building Talos API client: %w", err)
+	}
+
+	secretsStatus, err := safe.StateGet[*k8s.SecretsStatus](
+		client.WithNode(ctx, node),
+		c.COSI,
+		k8s.NewSecretsStatus(k8s.ControlPlaneNamespaceName, k8s.StaticPodSecretsStaticPodID).Metadata(),
+	)
+	if err != nil {
+		return fmt.Errorf("error getting secrets status: %w", err)
+	}
+
+	return checkPodStatus(ctx, cluster, kubeAPIServer, node, "", secretsStatus.TypedSpec().Version)
+}
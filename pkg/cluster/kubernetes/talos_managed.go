@@ -240,7 +240,7 @@ func upgradeStaticPodOnNode(ctx context.Context, cluster UpgradeProvider, option
 	}
 
 	if err = retry.Constant(3*time.Minute, retry.WithUnits(10*time.Second)).Retry(func() error {
-		return checkPodStatus(ctx, cluster, service, node, expectedConfigVersion)
+		return checkPodStatus(ctx, cluster, service, node, expectedConfigVersion, "")
 	}); err != nil {
 		return err
 	}
@@ -382,7 +382,7 @@ func syncManifests(ctx context.Context, objects []*unstructured.Unstructured, cl
 }
 
 //nolint:gocyclo
-func checkPodStatus(ctx context.Context, cluster UpgradeProvider, service, node, configVersion string) error {
+func checkPodStatus(ctx context.Context, cluster UpgradeProvider, service, node, configVersion, secretsVersion string) error {
 	k8sClient, err := cluster.K8sHelper(ctx)
 	if err != nil {
 		return fmt.Errorf("error building kubernetes client: %w", err)
@@ -408,10 +408,14 @@ func checkPodStatus(ctx context.Context, cluster UpgradeProvider, service, node,
 
 		podFound = true
 
-		if pod.Annotations[constants.AnnotationStaticPodConfigVersion] != configVersion {
+		if configVersion != "" && pod.Annotations[constants.AnnotationStaticPodConfigVersion] != configVersion {
 			return retry.ExpectedError(fmt.Errorf("config version mismatch: got %q, expected %q", pod.Annotations[constants.AnnotationStaticPodConfigVersion], configVersion))
 		}
 
+		if secretsVersion != "" && pod.Annotations[constants.AnnotationStaticPodSecretsVersion] != secretsVersion {
+			return retry.ExpectedError(fmt.Errorf("secrets version mismatch: got %q, expected %q", pod.Annotations[constants.AnnotationStaticPodSecretsVersion], secretsVersion))
+		}
+
 		ready := false
 
 		for _, condition := range pod.Status.Conditions {
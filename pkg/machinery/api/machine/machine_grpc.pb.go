@@ -72,6 +72,9 @@ const (
 	MachineService_Netstat_FullMethodName                     = "/machine.MachineService/Netstat"
 	MachineService_MetaWrite_FullMethodName                   = "/machine.MachineService/MetaWrite"
 	MachineService_MetaDelete_FullMethodName                  = "/machine.MachineService/MetaDelete"
+	MachineService_ImageList_FullMethodName                   = "/machine.MachineService/ImageList"
+	MachineService_ImagePull_FullMethodName                   = "/machine.MachineService/ImagePull"
+	MachineService_ImageRemove_FullMethodName                 = "/machine.MachineService/ImageRemove"
 )
 
 // MachineServiceClient is the client API for MachineService service.
@@ -169,6 +172,16 @@ type MachineServiceClient interface {
 	MetaWrite(ctx context.Context, in *MetaWriteRequest, opts ...grpc.CallOption) (*MetaWriteResponse, error)
 	// MetaDelete deletes a META key.
 	MetaDelete(ctx context.Context, in *MetaDeleteRequest, opts ...grpc.CallOption) (*MetaDeleteResponse, error)
+	// ImageList lists images in the CRI.
+	ImageList(ctx context.Context, in *ImageListRequest, opts ...grpc.CallOption) (MachineService_ImageListClient, error)
+	// ImagePull pulls an image into the CRI.
+	//
+	// Progress is streamed back as each image layer is fetched.
+	ImagePull(ctx context.Context, in *ImagePullRequest, opts ...grpc.CallOption) (MachineService_ImagePullClient, error)
+	// ImageRemove removes images from the CRI.
+	//
+	// A message is sent for each removed image.
+	ImageRemove(ctx context.Context, in *ImageRemoveRequest, opts ...grpc.CallOption) (MachineService_ImageRemoveClient, error)
 }
 
 type machineServiceClient struct {
@@ -876,6 +889,102 @@ func (c *machineServiceClient) MetaDelete(ctx context.Context, in *MetaDeleteReq
 	return out, nil
 }
 
+func (c *machineServiceClient) ImageList(ctx context.Context, in *ImageListRequest, opts ...grpc.CallOption) (MachineService_ImageListClient, error) {
+	stream, err := c.cc.NewStream(ctx, &MachineService_ServiceDesc.Streams[11], MachineService_ImageList_FullMethodName, opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &machineServiceImageListClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type MachineService_ImageListClient interface {
+	Recv() (*ImageListResponse, error)
+	grpc.ClientStream
+}
+
+type machineServiceImageListClient struct {
+	grpc.ClientStream
+}
+
+func (x *machineServiceImageListClient) Recv() (*ImageListResponse, error) {
+	m := new(ImageListResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *machineServiceClient) ImagePull(ctx context.Context, in *ImagePullRequest, opts ...grpc.CallOption) (MachineService_ImagePullClient, error) {
+	stream, err := c.cc.NewStream(ctx, &MachineService_ServiceDesc.Streams[12], MachineService_ImagePull_FullMethodName, opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &machineServiceImagePullClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type MachineService_ImagePullClient interface {
+	Recv() (*ImagePullResponse, error)
+	grpc.ClientStream
+}
+
+type machineServiceImagePullClient struct {
+	grpc.ClientStream
+}
+
+func (x *machineServiceImagePullClient) Recv() (*ImagePullResponse, error) {
+	m := new(ImagePullResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *machineServiceClient) ImageRemove(ctx context.Context, in *ImageRemoveRequest, opts ...grpc.CallOption) (MachineService_ImageRemoveClient, error) {
+	stream, err := c.cc.NewStream(ctx, &MachineService_ServiceDesc.Streams[13], MachineService_ImageRemove_FullMethodName, opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &machineServiceImageRemoveClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type MachineService_ImageRemoveClient interface {
+	Recv() (*ImageRemoveResponse, error)
+	grpc.ClientStream
+}
+
+type machineServiceImageRemoveClient struct {
+	grpc.ClientStream
+}
+
+func (x *machineServiceImageRemoveClient) Recv() (*ImageRemoveResponse, error) {
+	m := new(ImageRemoveResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
 // MachineServiceServer is the server API for MachineService service.
 // All implementations must embed UnimplementedMachineServiceServer
 // for forward compatibility
@@ -971,6 +1080,16 @@ type MachineServiceServer interface {
 	MetaWrite(context.Context, *MetaWriteRequest) (*MetaWriteResponse, error)
 	// MetaDelete deletes a META key.
 	MetaDelete(context.Context, *MetaDeleteRequest) (*MetaDeleteResponse, error)
+	// ImageList lists images in the CRI.
+	ImageList(*ImageListRequest, MachineService_ImageListServer) error
+	// ImagePull pulls an image into the CRI.
+	//
+	// Progress is streamed back as each image layer is fetched.
+	ImagePull(*ImagePullRequest, MachineService_ImagePullServer) error
+	// ImageRemove removes images from the CRI.
+	//
+	// A message is sent for each removed image.
+	ImageRemove(*ImageRemoveRequest, MachineService_ImageRemoveServer) error
 	mustEmbedUnimplementedMachineServiceServer()
 }
 
@@ -1125,6 +1244,15 @@ func (UnimplementedMachineServiceServer) MetaWrite(context.Context, *MetaWriteRe
 func (UnimplementedMachineServiceServer) MetaDelete(context.Context, *MetaDeleteRequest) (*MetaDeleteResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method MetaDelete not implemented")
 }
+func (UnimplementedMachineServiceServer) ImageList(*ImageListRequest, MachineService_ImageListServer) error {
+	return status.Errorf(codes.Unimplemented, "method ImageList not implemented")
+}
+func (UnimplementedMachineServiceServer) ImagePull(*ImagePullRequest, MachineService_ImagePullServer) error {
+	return status.Errorf(codes.Unimplemented, "method ImagePull not implemented")
+}
+func (UnimplementedMachineServiceServer) ImageRemove(*ImageRemoveRequest, MachineService_ImageRemoveServer) error {
+	return status.Errorf(codes.Unimplemented, "method ImageRemove not implemented")
+}
 func (UnimplementedMachineServiceServer) mustEmbedUnimplementedMachineServiceServer() {}
 
 // UnsafeMachineServiceServer may be embedded to opt out of forward compatibility for this service.
@@ -2058,6 +2186,69 @@ func _MachineService_MetaDelete_Handler(srv interface{}, ctx context.Context, de
 	return interceptor(ctx, in, info, handler)
 }
 
+func _MachineService_ImageList_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(ImageListRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(MachineServiceServer).ImageList(m, &machineServiceImageListServer{stream})
+}
+
+type MachineService_ImageListServer interface {
+	Send(*ImageListResponse) error
+	grpc.ServerStream
+}
+
+type machineServiceImageListServer struct {
+	grpc.ServerStream
+}
+
+func (x *machineServiceImageListServer) Send(m *ImageListResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _MachineService_ImagePull_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(ImagePullRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(MachineServiceServer).ImagePull(m, &machineServiceImagePullServer{stream})
+}
+
+type MachineService_ImagePullServer interface {
+	Send(*ImagePullResponse) error
+	grpc.ServerStream
+}
+
+type machineServiceImagePullServer struct {
+	grpc.ServerStream
+}
+
+func (x *machineServiceImagePullServer) Send(m *ImagePullResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _MachineService_ImageRemove_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(ImageRemoveRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(MachineServiceServer).ImageRemove(m, &machineServiceImageRemoveServer{stream})
+}
+
+type MachineService_ImageRemoveServer interface {
+	Send(*ImageRemoveResponse) error
+	grpc.ServerStream
+}
+
+type machineServiceImageRemoveServer struct {
+	grpc.ServerStream
+}
+
+func (x *machineServiceImageRemoveServer) Send(m *ImageRemoveResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
 // MachineService_ServiceDesc is the grpc.ServiceDesc for MachineService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -2274,6 +2465,21 @@ var MachineService_ServiceDesc = grpc.ServiceDesc{
 			Handler:       _MachineService_PacketCapture_Handler,
 			ServerStreams: true,
 		},
+		{
+			StreamName:    "ImageList",
+			Handler:       _MachineService_ImageList_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "ImagePull",
+			Handler:       _MachineService_ImagePull_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "ImageRemove",
+			Handler:       _MachineService_ImageRemove_Handler,
+			ServerStreams: true,
+		},
 	},
 	Metadata: "machine/machine.proto",
 }
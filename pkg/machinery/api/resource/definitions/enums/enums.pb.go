@@ -161,6 +161,59 @@ func (NethelpersAddressFlag) EnumDescriptor() ([]byte, []int) {
 	return file_resource_definitions_enums_enums_proto_rawDescGZIP(), []int{1}
 }
 
+// NethelpersAddrGenMode is an IPv6 address generation mode.
+type NethelpersAddrGenMode int32
+
+const (
+	NethelpersAddrGenMode_ADDR_GEN_MODE_EUI64          NethelpersAddrGenMode = 0
+	NethelpersAddrGenMode_ADDR_GEN_MODE_NONE           NethelpersAddrGenMode = 1
+	NethelpersAddrGenMode_ADDR_GEN_MODE_STABLE_PRIVACY NethelpersAddrGenMode = 2
+	NethelpersAddrGenMode_ADDR_GEN_MODE_RANDOM         NethelpersAddrGenMode = 3
+)
+
+// Enum value maps for NethelpersAddrGenMode.
+var (
+	NethelpersAddrGenMode_name = map[int32]string{
+		0: "ADDR_GEN_MODE_EUI64",
+		1: "ADDR_GEN_MODE_NONE",
+		2: "ADDR_GEN_MODE_STABLE_PRIVACY",
+		3: "ADDR_GEN_MODE_RANDOM",
+	}
+	NethelpersAddrGenMode_value = map[string]int32{
+		"ADDR_GEN_MODE_EUI64":          0,
+		"ADDR_GEN_MODE_NONE":           1,
+		"ADDR_GEN_MODE_STABLE_PRIVACY": 2,
+		"ADDR_GEN_MODE_RANDOM":         3,
+	}
+)
+
+func (x NethelpersAddrGenMode) Enum() *NethelpersAddrGenMode {
+	p := new(NethelpersAddrGenMode)
+	*p = x
+	return p
+}
+
+func (x NethelpersAddrGenMode) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (NethelpersAddrGenMode) Descriptor() protoreflect.EnumDescriptor {
+	return file_resource_definitions_enums_enums_proto_enumTypes[2].Descriptor()
+}
+
+func (NethelpersAddrGenMode) Type() protoreflect.EnumType {
+	return &file_resource_definitions_enums_enums_proto_enumTypes[2]
+}
+
+func (x NethelpersAddrGenMode) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use NethelpersAddrGenMode.Descriptor instead.
+func (NethelpersAddrGenMode) EnumDescriptor() ([]byte, []int) {
+	return file_resource_definitions_enums_enums_proto_rawDescGZIP(), []int{2}
+}
+
 // NethelpersADSelect is ADSelect.
 type NethelpersADSelect int32
 
@@ -195,11 +248,11 @@ func (x NethelpersADSelect) String() string {
 }
 
 func (NethelpersADSelect) Descriptor() protoreflect.EnumDescriptor {
-	return file_resource_definitions_enums_enums_proto_enumTypes[2].Descriptor()
+	return file_resource_definitions_enums_enums_proto_enumTypes[3].Descriptor()
 }
 
 func (NethelpersADSelect) Type() protoreflect.EnumType {
-	return &file_resource_definitions_enums_enums_proto_enumTypes[2]
+	return &file_resource_definitions_enums_enums_proto_enumTypes[3]
 }
 
 func (x NethelpersADSelect) Number() protoreflect.EnumNumber {
@@ -208,7 +261,7 @@ func (x NethelpersADSelect) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use NethelpersADSelect.Descriptor instead.
 func (NethelpersADSelect) EnumDescriptor() ([]byte, []int) {
-	return file_resource_definitions_enums_enums_proto_rawDescGZIP(), []int{2}
+	return file_resource_definitions_enums_enums_proto_rawDescGZIP(), []int{3}
 }
 
 // NethelpersARPAllTargets is an ARP targets mode.
@@ -242,11 +295,11 @@ func (x NethelpersARPAllTargets) String() string {
 }
 
 func (NethelpersARPAllTargets) Descriptor() protoreflect.EnumDescriptor {
-	return file_resource_definitions_enums_enums_proto_enumTypes[3].Descriptor()
+	return file_resource_definitions_enums_enums_proto_enumTypes[4].Descriptor()
 }
 
 func (NethelpersARPAllTargets) Type() protoreflect.EnumType {
-	return &file_resource_definitions_enums_enums_proto_enumTypes[3]
+	return &file_resource_definitions_enums_enums_proto_enumTypes[4]
 }
 
 func (x NethelpersARPAllTargets) Number() protoreflect.EnumNumber {
@@ -255,7 +308,7 @@ func (x NethelpersARPAllTargets) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use NethelpersARPAllTargets.Descriptor instead.
 func (NethelpersARPAllTargets) EnumDescriptor() ([]byte, []int) {
-	return file_resource_definitions_enums_enums_proto_rawDescGZIP(), []int{3}
+	return file_resource_definitions_enums_enums_proto_rawDescGZIP(), []int{4}
 }
 
 // NethelpersARPValidate is an ARP Validation mode.
@@ -295,11 +348,11 @@ func (x NethelpersARPValidate) String() string {
 }
 
 func (NethelpersARPValidate) Descriptor() protoreflect.EnumDescriptor {
-	return file_resource_definitions_enums_enums_proto_enumTypes[4].Descriptor()
+	return file_resource_definitions_enums_enums_proto_enumTypes[5].Descriptor()
 }
 
 func (NethelpersARPValidate) Type() protoreflect.EnumType {
-	return &file_resource_definitions_enums_enums_proto_enumTypes[4]
+	return &file_resource_definitions_enums_enums_proto_enumTypes[5]
 }
 
 func (x NethelpersARPValidate) Number() protoreflect.EnumNumber {
@@ -308,7 +361,7 @@ func (x NethelpersARPValidate) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use NethelpersARPValidate.Descriptor instead.
 func (NethelpersARPValidate) EnumDescriptor() ([]byte, []int) {
-	return file_resource_definitions_enums_enums_proto_rawDescGZIP(), []int{4}
+	return file_resource_definitions_enums_enums_proto_rawDescGZIP(), []int{5}
 }
 
 // NethelpersBondMode is a bond mode.
@@ -357,11 +410,11 @@ func (x NethelpersBondMode) String() string {
 }
 
 func (NethelpersBondMode) Descriptor() protoreflect.EnumDescriptor {
-	return file_resource_definitions_enums_enums_proto_enumTypes[5].Descriptor()
+	return file_resource_definitions_enums_enums_proto_enumTypes[6].Descriptor()
 }
 
 func (NethelpersBondMode) Type() protoreflect.EnumType {
-	return &file_resource_definitions_enums_enums_proto_enumTypes[5]
+	return &file_resource_definitions_enums_enums_proto_enumTypes[6]
 }
 
 func (x NethelpersBondMode) Number() protoreflect.EnumNumber {
@@ -370,7 +423,7 @@ func (x NethelpersBondMode) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use NethelpersBondMode.Descriptor instead.
 func (NethelpersBondMode) EnumDescriptor() ([]byte, []int) {
-	return file_resource_definitions_enums_enums_proto_rawDescGZIP(), []int{5}
+	return file_resource_definitions_enums_enums_proto_rawDescGZIP(), []int{6}
 }
 
 // NethelpersBondXmitHashPolicy is a bond hash policy.
@@ -413,11 +466,11 @@ func (x NethelpersBondXmitHashPolicy) String() string {
 }
 
 func (NethelpersBondXmitHashPolicy) Descriptor() protoreflect.EnumDescriptor {
-	return file_resource_definitions_enums_enums_proto_enumTypes[6].Descriptor()
+	return file_resource_definitions_enums_enums_proto_enumTypes[7].Descriptor()
 }
 
 func (NethelpersBondXmitHashPolicy) Type() protoreflect.EnumType {
-	return &file_resource_definitions_enums_enums_proto_enumTypes[6]
+	return &file_resource_definitions_enums_enums_proto_enumTypes[7]
 }
 
 func (x NethelpersBondXmitHashPolicy) Number() protoreflect.EnumNumber {
@@ -426,7 +479,110 @@ func (x NethelpersBondXmitHashPolicy) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use NethelpersBondXmitHashPolicy.Descriptor instead.
 func (NethelpersBondXmitHashPolicy) EnumDescriptor() ([]byte, []int) {
-	return file_resource_definitions_enums_enums_proto_rawDescGZIP(), []int{6}
+	return file_resource_definitions_enums_enums_proto_rawDescGZIP(), []int{7}
+}
+
+// NethelpersConntrackState is a conntrack state.
+type NethelpersConntrackState int32
+
+const (
+	NethelpersConntrackState_NETHELPERS_CONNTRACKSTATE_UNSPECIFIED NethelpersConntrackState = 0
+	NethelpersConntrackState_CONNTRACK_STATE_NEW                   NethelpersConntrackState = 8
+	NethelpersConntrackState_CONNTRACK_STATE_RELATED               NethelpersConntrackState = 4
+	NethelpersConntrackState_CONNTRACK_STATE_ESTABLISHED           NethelpersConntrackState = 2
+	NethelpersConntrackState_CONNTRACK_STATE_INVALID               NethelpersConntrackState = 1
+)
+
+// Enum value maps for NethelpersConntrackState.
+var (
+	NethelpersConntrackState_name = map[int32]string{
+		0: "NETHELPERS_CONNTRACKSTATE_UNSPECIFIED",
+		8: "CONNTRACK_STATE_NEW",
+		4: "CONNTRACK_STATE_RELATED",
+		2: "CONNTRACK_STATE_ESTABLISHED",
+		1: "CONNTRACK_STATE_INVALID",
+	}
+	NethelpersConntrackState_value = map[string]int32{
+		"NETHELPERS_CONNTRACKSTATE_UNSPECIFIED": 0,
+		"CONNTRACK_STATE_NEW":                   8,
+		"CONNTRACK_STATE_RELATED":               4,
+		"CONNTRACK_STATE_ESTABLISHED":           2,
+		"CONNTRACK_STATE_INVALID":               1,
+	}
+)
+
+func (x NethelpersConntrackState) Enum() *NethelpersConntrackState {
+	p := new(NethelpersConntrackState)
+	*p = x
+	return p
+}
+
+func (x NethelpersConntrackState) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (NethelpersConntrackState) Descriptor() protoreflect.EnumDescriptor {
+	return file_resource_definitions_enums_enums_proto_enumTypes[8].Descriptor()
+}
+
+func (NethelpersConntrackState) Type() protoreflect.EnumType {
+	return &file_resource_definitions_enums_enums_proto_enumTypes[8]
+}
+
+func (x NethelpersConntrackState) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use NethelpersConntrackState.Descriptor instead.
+func (NethelpersConntrackState) EnumDescriptor() ([]byte, []int) {
+	return file_resource_definitions_enums_enums_proto_rawDescGZIP(), []int{8}
+}
+
+// NethelpersDefaultAction is a default firewall action.
+type NethelpersDefaultAction int32
+
+const (
+	NethelpersDefaultAction_DEFAULT_ACTION_ACCEPT NethelpersDefaultAction = 0
+	NethelpersDefaultAction_DEFAULT_ACTION_BLOCK  NethelpersDefaultAction = 1
+)
+
+// Enum value maps for NethelpersDefaultAction.
+var (
+	NethelpersDefaultAction_name = map[int32]string{
+		0: "DEFAULT_ACTION_ACCEPT",
+		1: "DEFAULT_ACTION_BLOCK",
+	}
+	NethelpersDefaultAction_value = map[string]int32{
+		"DEFAULT_ACTION_ACCEPT": 0,
+		"DEFAULT_ACTION_BLOCK":  1,
+	}
+)
+
+func (x NethelpersDefaultAction) Enum() *NethelpersDefaultAction {
+	p := new(NethelpersDefaultAction)
+	*p = x
+	return p
+}
+
+func (x NethelpersDefaultAction) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (NethelpersDefaultAction) Descriptor() protoreflect.EnumDescriptor {
+	return file_resource_definitions_enums_enums_proto_enumTypes[9].Descriptor()
+}
+
+func (NethelpersDefaultAction) Type() protoreflect.EnumType {
+	return &file_resource_definitions_enums_enums_proto_enumTypes[9]
+}
+
+func (x NethelpersDefaultAction) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use NethelpersDefaultAction.Descriptor instead.
+func (NethelpersDefaultAction) EnumDescriptor() ([]byte, []int) {
+	return file_resource_definitions_enums_enums_proto_rawDescGZIP(), []int{9}
 }
 
 // NethelpersDuplex wraps ethtool.Duplex for YAML marshaling.
@@ -463,11 +619,11 @@ func (x NethelpersDuplex) String() string {
 }
 
 func (NethelpersDuplex) Descriptor() protoreflect.EnumDescriptor {
-	return file_resource_definitions_enums_enums_proto_enumTypes[7].Descriptor()
+	return file_resource_definitions_enums_enums_proto_enumTypes[10].Descriptor()
 }
 
 func (NethelpersDuplex) Type() protoreflect.EnumType {
-	return &file_resource_definitions_enums_enums_proto_enumTypes[7]
+	return &file_resource_definitions_enums_enums_proto_enumTypes[10]
 }
 
 func (x NethelpersDuplex) Number() protoreflect.EnumNumber {
@@ -476,7 +632,7 @@ func (x NethelpersDuplex) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use NethelpersDuplex.Descriptor instead.
 func (NethelpersDuplex) EnumDescriptor() ([]byte, []int) {
-	return file_resource_definitions_enums_enums_proto_rawDescGZIP(), []int{7}
+	return file_resource_definitions_enums_enums_proto_rawDescGZIP(), []int{10}
 }
 
 // NethelpersFailOverMAC is a MAC failover mode.
@@ -513,11 +669,11 @@ func (x NethelpersFailOverMAC) String() string {
 }
 
 func (NethelpersFailOverMAC) Descriptor() protoreflect.EnumDescriptor {
-	return file_resource_definitions_enums_enums_proto_enumTypes[8].Descriptor()
+	return file_resource_definitions_enums_enums_proto_enumTypes[11].Descriptor()
 }
 
 func (NethelpersFailOverMAC) Type() protoreflect.EnumType {
-	return &file_resource_definitions_enums_enums_proto_enumTypes[8]
+	return &file_resource_definitions_enums_enums_proto_enumTypes[11]
 }
 
 func (x NethelpersFailOverMAC) Number() protoreflect.EnumNumber {
@@ -526,7 +682,7 @@ func (x NethelpersFailOverMAC) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use NethelpersFailOverMAC.Descriptor instead.
 func (NethelpersFailOverMAC) EnumDescriptor() ([]byte, []int) {
-	return file_resource_definitions_enums_enums_proto_rawDescGZIP(), []int{8}
+	return file_resource_definitions_enums_enums_proto_rawDescGZIP(), []int{11}
 }
 
 // NethelpersFamily is a network family.
@@ -563,11 +719,11 @@ func (x NethelpersFamily) String() string {
 }
 
 func (NethelpersFamily) Descriptor() protoreflect.EnumDescriptor {
-	return file_resource_definitions_enums_enums_proto_enumTypes[9].Descriptor()
+	return file_resource_definitions_enums_enums_proto_enumTypes[12].Descriptor()
 }
 
 func (NethelpersFamily) Type() protoreflect.EnumType {
-	return &file_resource_definitions_enums_enums_proto_enumTypes[9]
+	return &file_resource_definitions_enums_enums_proto_enumTypes[12]
 }
 
 func (x NethelpersFamily) Number() protoreflect.EnumNumber {
@@ -576,7 +732,57 @@ func (x NethelpersFamily) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use NethelpersFamily.Descriptor instead.
 func (NethelpersFamily) EnumDescriptor() ([]byte, []int) {
-	return file_resource_definitions_enums_enums_proto_rawDescGZIP(), []int{9}
+	return file_resource_definitions_enums_enums_proto_rawDescGZIP(), []int{12}
+}
+
+// NethelpersIPVLANMode is an IPVLAN mode.
+type NethelpersIPVLANMode int32
+
+const (
+	NethelpersIPVLANMode_IPVLAN_MODE_L2   NethelpersIPVLANMode = 0
+	NethelpersIPVLANMode_IPVLAN_MODE_L3   NethelpersIPVLANMode = 1
+	NethelpersIPVLANMode_IPVLAN_MODE_L3_S NethelpersIPVLANMode = 2
+)
+
+// Enum value maps for NethelpersIPVLANMode.
+var (
+	NethelpersIPVLANMode_name = map[int32]string{
+		0: "IPVLAN_MODE_L2",
+		1: "IPVLAN_MODE_L3",
+		2: "IPVLAN_MODE_L3_S",
+	}
+	NethelpersIPVLANMode_value = map[string]int32{
+		"IPVLAN_MODE_L2":   0,
+		"IPVLAN_MODE_L3":   1,
+		"IPVLAN_MODE_L3_S": 2,
+	}
+)
+
+func (x NethelpersIPVLANMode) Enum() *NethelpersIPVLANMode {
+	p := new(NethelpersIPVLANMode)
+	*p = x
+	return p
+}
+
+func (x NethelpersIPVLANMode) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (NethelpersIPVLANMode) Descriptor() protoreflect.EnumDescriptor {
+	return file_resource_definitions_enums_enums_proto_enumTypes[13].Descriptor()
+}
+
+func (NethelpersIPVLANMode) Type() protoreflect.EnumType {
+	return &file_resource_definitions_enums_enums_proto_enumTypes[13]
+}
+
+func (x NethelpersIPVLANMode) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use NethelpersIPVLANMode.Descriptor instead.
+func (NethelpersIPVLANMode) EnumDescriptor() ([]byte, []int) {
+	return file_resource_definitions_enums_enums_proto_rawDescGZIP(), []int{13}
 }
 
 // NethelpersLACPRate is a LACP rate.
@@ -610,11 +816,11 @@ func (x NethelpersLACPRate) String() string {
 }
 
 func (NethelpersLACPRate) Descriptor() protoreflect.EnumDescriptor {
-	return file_resource_definitions_enums_enums_proto_enumTypes[10].Descriptor()
+	return file_resource_definitions_enums_enums_proto_enumTypes[14].Descriptor()
 }
 
 func (NethelpersLACPRate) Type() protoreflect.EnumType {
-	return &file_resource_definitions_enums_enums_proto_enumTypes[10]
+	return &file_resource_definitions_enums_enums_proto_enumTypes[14]
 }
 
 func (x NethelpersLACPRate) Number() protoreflect.EnumNumber {
@@ -623,7 +829,7 @@ func (x NethelpersLACPRate) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use NethelpersLACPRate.Descriptor instead.
 func (NethelpersLACPRate) EnumDescriptor() ([]byte, []int) {
-	return file_resource_definitions_enums_enums_proto_rawDescGZIP(), []int{10}
+	return file_resource_definitions_enums_enums_proto_rawDescGZIP(), []int{14}
 }
 
 // NethelpersLinkType is a link type.
@@ -882,11 +1088,11 @@ func (x NethelpersLinkType) String() string {
 }
 
 func (NethelpersLinkType) Descriptor() protoreflect.EnumDescriptor {
-	return file_resource_definitions_enums_enums_proto_enumTypes[11].Descriptor()
+	return file_resource_definitions_enums_enums_proto_enumTypes[15].Descriptor()
 }
 
 func (NethelpersLinkType) Type() protoreflect.EnumType {
-	return &file_resource_definitions_enums_enums_proto_enumTypes[11]
+	return &file_resource_definitions_enums_enums_proto_enumTypes[15]
 }
 
 func (x NethelpersLinkType) Number() protoreflect.EnumNumber {
@@ -895,7 +1101,320 @@ func (x NethelpersLinkType) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use NethelpersLinkType.Descriptor instead.
 func (NethelpersLinkType) EnumDescriptor() ([]byte, []int) {
-	return file_resource_definitions_enums_enums_proto_rawDescGZIP(), []int{11}
+	return file_resource_definitions_enums_enums_proto_rawDescGZIP(), []int{15}
+}
+
+// NethelpersMACVLANMode is a MACVLAN mode.
+type NethelpersMACVLANMode int32
+
+const (
+	NethelpersMACVLANMode_NETHELPERS_MACVLANMODE_UNSPECIFIED NethelpersMACVLANMode = 0
+	NethelpersMACVLANMode_MACVLAN_MODE_PRIVATE               NethelpersMACVLANMode = 1
+	NethelpersMACVLANMode_MACVLAN_MODE_VEPA                  NethelpersMACVLANMode = 2
+	NethelpersMACVLANMode_MACVLAN_MODE_BRIDGE                NethelpersMACVLANMode = 4
+	NethelpersMACVLANMode_MACVLAN_MODE_PASSTHRU              NethelpersMACVLANMode = 8
+	NethelpersMACVLANMode_MACVLAN_MODE_SOURCE                NethelpersMACVLANMode = 16
+)
+
+// Enum value maps for NethelpersMACVLANMode.
+var (
+	NethelpersMACVLANMode_name = map[int32]string{
+		0:  "NETHELPERS_MACVLANMODE_UNSPECIFIED",
+		1:  "MACVLAN_MODE_PRIVATE",
+		2:  "MACVLAN_MODE_VEPA",
+		4:  "MACVLAN_MODE_BRIDGE",
+		8:  "MACVLAN_MODE_PASSTHRU",
+		16: "MACVLAN_MODE_SOURCE",
+	}
+	NethelpersMACVLANMode_value = map[string]int32{
+		"NETHELPERS_MACVLANMODE_UNSPECIFIED": 0,
+		"MACVLAN_MODE_PRIVATE":               1,
+		"MACVLAN_MODE_VEPA":                  2,
+		"MACVLAN_MODE_BRIDGE":                4,
+		"MACVLAN_MODE_PASSTHRU":              8,
+		"MACVLAN_MODE_SOURCE":                16,
+	}
+)
+
+func (x NethelpersMACVLANMode) Enum() *NethelpersMACVLANMode {
+	p := new(NethelpersMACVLANMode)
+	*p = x
+	return p
+}
+
+func (x NethelpersMACVLANMode) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (NethelpersMACVLANMode) Descriptor() protoreflect.EnumDescriptor {
+	return file_resource_definitions_enums_enums_proto_enumTypes[16].Descriptor()
+}
+
+func (NethelpersMACVLANMode) Type() protoreflect.EnumType {
+	return &file_resource_definitions_enums_enums_proto_enumTypes[16]
+}
+
+func (x NethelpersMACVLANMode) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use NethelpersMACVLANMode.Descriptor instead.
+func (NethelpersMACVLANMode) EnumDescriptor() ([]byte, []int) {
+	return file_resource_definitions_enums_enums_proto_rawDescGZIP(), []int{16}
+}
+
+// NethelpersNeighborState wraps NUD_* constants.
+type NethelpersNeighborState int32
+
+const (
+	NethelpersNeighborState_NEIGHBOR_STATE_NONE       NethelpersNeighborState = 0
+	NethelpersNeighborState_NEIGHBOR_STATE_INCOMPLETE NethelpersNeighborState = 1
+	NethelpersNeighborState_NEIGHBOR_STATE_REACHABLE  NethelpersNeighborState = 2
+	NethelpersNeighborState_NEIGHBOR_STATE_STALE      NethelpersNeighborState = 4
+	NethelpersNeighborState_NEIGHBOR_STATE_DELAY      NethelpersNeighborState = 8
+	NethelpersNeighborState_NEIGHBOR_STATE_PROBE      NethelpersNeighborState = 16
+	NethelpersNeighborState_NEIGHBOR_STATE_FAILED     NethelpersNeighborState = 32
+	NethelpersNeighborState_NEIGHBOR_STATE_NO_ARP     NethelpersNeighborState = 64
+	NethelpersNeighborState_NEIGHBOR_STATE_PERMANENT  NethelpersNeighborState = 128
+)
+
+// Enum value maps for NethelpersNeighborState.
+var (
+	NethelpersNeighborState_name = map[int32]string{
+		0:   "NEIGHBOR_STATE_NONE",
+		1:   "NEIGHBOR_STATE_INCOMPLETE",
+		2:   "NEIGHBOR_STATE_REACHABLE",
+		4:   "NEIGHBOR_STATE_STALE",
+		8:   "NEIGHBOR_STATE_DELAY",
+		16:  "NEIGHBOR_STATE_PROBE",
+		32:  "NEIGHBOR_STATE_FAILED",
+		64:  "NEIGHBOR_STATE_NO_ARP",
+		128: "NEIGHBOR_STATE_PERMANENT",
+	}
+	NethelpersNeighborState_value = map[string]int32{
+		"NEIGHBOR_STATE_NONE":       0,
+		"NEIGHBOR_STATE_INCOMPLETE": 1,
+		"NEIGHBOR_STATE_REACHABLE":  2,
+		"NEIGHBOR_STATE_STALE":      4,
+		"NEIGHBOR_STATE_DELAY":      8,
+		"NEIGHBOR_STATE_PROBE":      16,
+		"NEIGHBOR_STATE_FAILED":     32,
+		"NEIGHBOR_STATE_NO_ARP":     64,
+		"NEIGHBOR_STATE_PERMANENT":  128,
+	}
+)
+
+func (x NethelpersNeighborState) Enum() *NethelpersNeighborState {
+	p := new(NethelpersNeighborState)
+	*p = x
+	return p
+}
+
+func (x NethelpersNeighborState) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (NethelpersNeighborState) Descriptor() protoreflect.EnumDescriptor {
+	return file_resource_definitions_enums_enums_proto_enumTypes[17].Descriptor()
+}
+
+func (NethelpersNeighborState) Type() protoreflect.EnumType {
+	return &file_resource_definitions_enums_enums_proto_enumTypes[17]
+}
+
+func (x NethelpersNeighborState) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use NethelpersNeighborState.Descriptor instead.
+func (NethelpersNeighborState) EnumDescriptor() ([]byte, []int) {
+	return file_resource_definitions_enums_enums_proto_rawDescGZIP(), []int{17}
+}
+
+// NethelpersNfTablesChainHook wraps nftables.ChainHook for YAML marshaling.
+type NethelpersNfTablesChainHook int32
+
+const (
+	NethelpersNfTablesChainHook_CHAIN_HOOK_PREROUTING  NethelpersNfTablesChainHook = 0
+	NethelpersNfTablesChainHook_CHAIN_HOOK_INPUT       NethelpersNfTablesChainHook = 1
+	NethelpersNfTablesChainHook_CHAIN_HOOK_FORWARD     NethelpersNfTablesChainHook = 2
+	NethelpersNfTablesChainHook_CHAIN_HOOK_OUTPUT      NethelpersNfTablesChainHook = 3
+	NethelpersNfTablesChainHook_CHAIN_HOOK_POSTROUTING NethelpersNfTablesChainHook = 4
+)
+
+// Enum value maps for NethelpersNfTablesChainHook.
+var (
+	NethelpersNfTablesChainHook_name = map[int32]string{
+		0: "CHAIN_HOOK_PREROUTING",
+		1: "CHAIN_HOOK_INPUT",
+		2: "CHAIN_HOOK_FORWARD",
+		3: "CHAIN_HOOK_OUTPUT",
+		4: "CHAIN_HOOK_POSTROUTING",
+	}
+	NethelpersNfTablesChainHook_value = map[string]int32{
+		"CHAIN_HOOK_PREROUTING":  0,
+		"CHAIN_HOOK_INPUT":       1,
+		"CHAIN_HOOK_FORWARD":     2,
+		"CHAIN_HOOK_OUTPUT":      3,
+		"CHAIN_HOOK_POSTROUTING": 4,
+	}
+)
+
+func (x NethelpersNfTablesChainHook) Enum() *NethelpersNfTablesChainHook {
+	p := new(NethelpersNfTablesChainHook)
+	*p = x
+	return p
+}
+
+func (x NethelpersNfTablesChainHook) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (NethelpersNfTablesChainHook) Descriptor() protoreflect.EnumDescriptor {
+	return file_resource_definitions_enums_enums_proto_enumTypes[18].Descriptor()
+}
+
+func (NethelpersNfTablesChainHook) Type() protoreflect.EnumType {
+	return &file_resource_definitions_enums_enums_proto_enumTypes[18]
+}
+
+func (x NethelpersNfTablesChainHook) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use NethelpersNfTablesChainHook.Descriptor instead.
+func (NethelpersNfTablesChainHook) EnumDescriptor() ([]byte, []int) {
+	return file_resource_definitions_enums_enums_proto_rawDescGZIP(), []int{18}
+}
+
+// NethelpersNfTablesChainPriority wraps nftables.ChainPriority for YAML marshaling.
+type NethelpersNfTablesChainPriority int32
+
+const (
+	NethelpersNfTablesChainPriority_NETHELPERS_NFTABLESCHAINPRIORITY_UNSPECIFIED NethelpersNfTablesChainPriority = 0
+	NethelpersNfTablesChainPriority_CHAIN_PRIORITY_FIRST                         NethelpersNfTablesChainPriority = -2147483648
+	NethelpersNfTablesChainPriority_CHAIN_PRIORITY_CONNTRACK_DEFRAG              NethelpersNfTablesChainPriority = -400
+	NethelpersNfTablesChainPriority_CHAIN_PRIORITY_RAW                           NethelpersNfTablesChainPriority = -300
+	NethelpersNfTablesChainPriority_CHAIN_PRIORITY_SE_LINUX_FIRST                NethelpersNfTablesChainPriority = -225
+	NethelpersNfTablesChainPriority_CHAIN_PRIORITY_CONNTRACK                     NethelpersNfTablesChainPriority = -200
+	NethelpersNfTablesChainPriority_CHAIN_PRIORITY_MANGLE                        NethelpersNfTablesChainPriority = -150
+	NethelpersNfTablesChainPriority_CHAIN_PRIORITY_NAT_DEST                      NethelpersNfTablesChainPriority = -100
+	NethelpersNfTablesChainPriority_CHAIN_PRIORITY_FILTER                        NethelpersNfTablesChainPriority = 0
+	NethelpersNfTablesChainPriority_CHAIN_PRIORITY_SECURITY                      NethelpersNfTablesChainPriority = 50
+	NethelpersNfTablesChainPriority_CHAIN_PRIORITY_NAT_SOURCE                    NethelpersNfTablesChainPriority = 100
+	NethelpersNfTablesChainPriority_CHAIN_PRIORITY_SE_LINUX_LAST                 NethelpersNfTablesChainPriority = 225
+	NethelpersNfTablesChainPriority_CHAIN_PRIORITY_CONNTRACK_HELPER              NethelpersNfTablesChainPriority = 300
+	NethelpersNfTablesChainPriority_CHAIN_PRIORITY_LAST                          NethelpersNfTablesChainPriority = 2147483647
+)
+
+// Enum value maps for NethelpersNfTablesChainPriority.
+var (
+	NethelpersNfTablesChainPriority_name = map[int32]string{
+		0:           "NETHELPERS_NFTABLESCHAINPRIORITY_UNSPECIFIED",
+		-2147483648: "CHAIN_PRIORITY_FIRST",
+		-400:        "CHAIN_PRIORITY_CONNTRACK_DEFRAG",
+		-300:        "CHAIN_PRIORITY_RAW",
+		-225:        "CHAIN_PRIORITY_SE_LINUX_FIRST",
+		-200:        "CHAIN_PRIORITY_CONNTRACK",
+		-150:        "CHAIN_PRIORITY_MANGLE",
+		-100:        "CHAIN_PRIORITY_NAT_DEST",
+		// Duplicate value: 0: "CHAIN_PRIORITY_FILTER",
+		50:         "CHAIN_PRIORITY_SECURITY",
+		100:        "CHAIN_PRIORITY_NAT_SOURCE",
+		225:        "CHAIN_PRIORITY_SE_LINUX_LAST",
+		300:        "CHAIN_PRIORITY_CONNTRACK_HELPER",
+		2147483647: "CHAIN_PRIORITY_LAST",
+	}
+	NethelpersNfTablesChainPriority_value = map[string]int32{
+		"NETHELPERS_NFTABLESCHAINPRIORITY_UNSPECIFIED": 0,
+		"CHAIN_PRIORITY_FIRST":                         -2147483648,
+		"CHAIN_PRIORITY_CONNTRACK_DEFRAG":              -400,
+		"CHAIN_PRIORITY_RAW":                           -300,
+		"CHAIN_PRIORITY_SE_LINUX_FIRST":                -225,
+		"CHAIN_PRIORITY_CONNTRACK":                     -200,
+		"CHAIN_PRIORITY_MANGLE":                        -150,
+		"CHAIN_PRIORITY_NAT_DEST":                      -100,
+		"CHAIN_PRIORITY_FILTER":                        0,
+		"CHAIN_PRIORITY_SECURITY":                      50,
+		"CHAIN_PRIORITY_NAT_SOURCE":                    100,
+		"CHAIN_PRIORITY_SE_LINUX_LAST":                 225,
+		"CHAIN_PRIORITY_CONNTRACK_HELPER":              300,
+		"CHAIN_PRIORITY_LAST":                          2147483647,
+	}
+)
+
+func (x NethelpersNfTablesChainPriority) Enum() *NethelpersNfTablesChainPriority {
+	p := new(NethelpersNfTablesChainPriority)
+	*p = x
+	return p
+}
+
+func (x NethelpersNfTablesChainPriority) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (NethelpersNfTablesChainPriority) Descriptor() protoreflect.EnumDescriptor {
+	return file_resource_definitions_enums_enums_proto_enumTypes[19].Descriptor()
+}
+
+func (NethelpersNfTablesChainPriority) Type() protoreflect.EnumType {
+	return &file_resource_definitions_enums_enums_proto_enumTypes[19]
+}
+
+func (x NethelpersNfTablesChainPriority) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use NethelpersNfTablesChainPriority.Descriptor instead.
+func (NethelpersNfTablesChainPriority) EnumDescriptor() ([]byte, []int) {
+	return file_resource_definitions_enums_enums_proto_rawDescGZIP(), []int{19}
+}
+
+// NethelpersNfTablesVerdict is a verdict for the nftables rule.
+type NethelpersNfTablesVerdict int32
+
+const (
+	NethelpersNfTablesVerdict_VERDICT_DROP   NethelpersNfTablesVerdict = 0
+	NethelpersNfTablesVerdict_VERDICT_ACCEPT NethelpersNfTablesVerdict = 1
+)
+
+// Enum value maps for NethelpersNfTablesVerdict.
+var (
+	NethelpersNfTablesVerdict_name = map[int32]string{
+		0: "VERDICT_DROP",
+		1: "VERDICT_ACCEPT",
+	}
+	NethelpersNfTablesVerdict_value = map[string]int32{
+		"VERDICT_DROP":   0,
+		"VERDICT_ACCEPT": 1,
+	}
+)
+
+func (x NethelpersNfTablesVerdict) Enum() *NethelpersNfTablesVerdict {
+	p := new(NethelpersNfTablesVerdict)
+	*p = x
+	return p
+}
+
+func (x NethelpersNfTablesVerdict) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (NethelpersNfTablesVerdict) Descriptor() protoreflect.EnumDescriptor {
+	return file_resource_definitions_enums_enums_proto_enumTypes[20].Descriptor()
+}
+
+func (NethelpersNfTablesVerdict) Type() protoreflect.EnumType {
+	return &file_resource_definitions_enums_enums_proto_enumTypes[20]
+}
+
+func (x NethelpersNfTablesVerdict) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use NethelpersNfTablesVerdict.Descriptor instead.
+func (NethelpersNfTablesVerdict) EnumDescriptor() ([]byte, []int) {
+	return file_resource_definitions_enums_enums_proto_rawDescGZIP(), []int{20}
 }
 
 // NethelpersOperationalState wraps rtnetlink.OperationalState for YAML marshaling.
@@ -944,11 +1463,11 @@ func (x NethelpersOperationalState) String() string {
 }
 
 func (NethelpersOperationalState) Descriptor() protoreflect.EnumDescriptor {
-	return file_resource_definitions_enums_enums_proto_enumTypes[12].Descriptor()
+	return file_resource_definitions_enums_enums_proto_enumTypes[21].Descriptor()
 }
 
 func (NethelpersOperationalState) Type() protoreflect.EnumType {
-	return &file_resource_definitions_enums_enums_proto_enumTypes[12]
+	return &file_resource_definitions_enums_enums_proto_enumTypes[21]
 }
 
 func (x NethelpersOperationalState) Number() protoreflect.EnumNumber {
@@ -957,7 +1476,7 @@ func (x NethelpersOperationalState) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use NethelpersOperationalState.Descriptor instead.
 func (NethelpersOperationalState) EnumDescriptor() ([]byte, []int) {
-	return file_resource_definitions_enums_enums_proto_rawDescGZIP(), []int{12}
+	return file_resource_definitions_enums_enums_proto_rawDescGZIP(), []int{21}
 }
 
 // NethelpersPort wraps ethtool.Port for YAML marshaling.
@@ -1009,11 +1528,11 @@ func (x NethelpersPort) String() string {
 }
 
 func (NethelpersPort) Descriptor() protoreflect.EnumDescriptor {
-	return file_resource_definitions_enums_enums_proto_enumTypes[13].Descriptor()
+	return file_resource_definitions_enums_enums_proto_enumTypes[22].Descriptor()
 }
 
 func (NethelpersPort) Type() protoreflect.EnumType {
-	return &file_resource_definitions_enums_enums_proto_enumTypes[13]
+	return &file_resource_definitions_enums_enums_proto_enumTypes[22]
 }
 
 func (x NethelpersPort) Number() protoreflect.EnumNumber {
@@ -1022,7 +1541,7 @@ func (x NethelpersPort) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use NethelpersPort.Descriptor instead.
 func (NethelpersPort) EnumDescriptor() ([]byte, []int) {
-	return file_resource_definitions_enums_enums_proto_rawDescGZIP(), []int{13}
+	return file_resource_definitions_enums_enums_proto_rawDescGZIP(), []int{22}
 }
 
 // NethelpersPrimaryReselect is an ARP targets mode.
@@ -1059,11 +1578,11 @@ func (x NethelpersPrimaryReselect) String() string {
 }
 
 func (NethelpersPrimaryReselect) Descriptor() protoreflect.EnumDescriptor {
-	return file_resource_definitions_enums_enums_proto_enumTypes[14].Descriptor()
+	return file_resource_definitions_enums_enums_proto_enumTypes[23].Descriptor()
 }
 
 func (NethelpersPrimaryReselect) Type() protoreflect.EnumType {
-	return &file_resource_definitions_enums_enums_proto_enumTypes[14]
+	return &file_resource_definitions_enums_enums_proto_enumTypes[23]
 }
 
 func (x NethelpersPrimaryReselect) Number() protoreflect.EnumNumber {
@@ -1072,7 +1591,113 @@ func (x NethelpersPrimaryReselect) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use NethelpersPrimaryReselect.Descriptor instead.
 func (NethelpersPrimaryReselect) EnumDescriptor() ([]byte, []int) {
-	return file_resource_definitions_enums_enums_proto_rawDescGZIP(), []int{14}
+	return file_resource_definitions_enums_enums_proto_rawDescGZIP(), []int{23}
+}
+
+// NethelpersProtocol is a inet protocol.
+type NethelpersProtocol int32
+
+const (
+	NethelpersProtocol_NETHELPERS_PROTOCOL_UNSPECIFIED NethelpersProtocol = 0
+	NethelpersProtocol_PROTOCOL_ICMP                   NethelpersProtocol = 1
+	NethelpersProtocol_PROTOCOL_TCP                    NethelpersProtocol = 6
+	NethelpersProtocol_PROTOCOL_UDP                    NethelpersProtocol = 17
+	NethelpersProtocol_PROTOCOL_ICM_PV6                NethelpersProtocol = 58
+)
+
+// Enum value maps for NethelpersProtocol.
+var (
+	NethelpersProtocol_name = map[int32]string{
+		0:  "NETHELPERS_PROTOCOL_UNSPECIFIED",
+		1:  "PROTOCOL_ICMP",
+		6:  "PROTOCOL_TCP",
+		17: "PROTOCOL_UDP",
+		58: "PROTOCOL_ICM_PV6",
+	}
+	NethelpersProtocol_value = map[string]int32{
+		"NETHELPERS_PROTOCOL_UNSPECIFIED": 0,
+		"PROTOCOL_ICMP":                   1,
+		"PROTOCOL_TCP":                    6,
+		"PROTOCOL_UDP":                    17,
+		"PROTOCOL_ICM_PV6":                58,
+	}
+)
+
+func (x NethelpersProtocol) Enum() *NethelpersProtocol {
+	p := new(NethelpersProtocol)
+	*p = x
+	return p
+}
+
+func (x NethelpersProtocol) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (NethelpersProtocol) Descriptor() protoreflect.EnumDescriptor {
+	return file_resource_definitions_enums_enums_proto_enumTypes[24].Descriptor()
+}
+
+func (NethelpersProtocol) Type() protoreflect.EnumType {
+	return &file_resource_definitions_enums_enums_proto_enumTypes[24]
+}
+
+func (x NethelpersProtocol) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use NethelpersProtocol.Descriptor instead.
+func (NethelpersProtocol) EnumDescriptor() ([]byte, []int) {
+	return file_resource_definitions_enums_enums_proto_rawDescGZIP(), []int{24}
+}
+
+// NethelpersQdiscKind is a kind of the traffic control queueing discipline.
+type NethelpersQdiscKind int32
+
+const (
+	NethelpersQdiscKind_QDISC_KIND_FQ_CODEL NethelpersQdiscKind = 0
+	NethelpersQdiscKind_QDISC_KIND_HTB      NethelpersQdiscKind = 1
+	NethelpersQdiscKind_QDISC_KIND_TBF      NethelpersQdiscKind = 2
+)
+
+// Enum value maps for NethelpersQdiscKind.
+var (
+	NethelpersQdiscKind_name = map[int32]string{
+		0: "QDISC_KIND_FQ_CODEL",
+		1: "QDISC_KIND_HTB",
+		2: "QDISC_KIND_TBF",
+	}
+	NethelpersQdiscKind_value = map[string]int32{
+		"QDISC_KIND_FQ_CODEL": 0,
+		"QDISC_KIND_HTB":      1,
+		"QDISC_KIND_TBF":      2,
+	}
+)
+
+func (x NethelpersQdiscKind) Enum() *NethelpersQdiscKind {
+	p := new(NethelpersQdiscKind)
+	*p = x
+	return p
+}
+
+func (x NethelpersQdiscKind) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (NethelpersQdiscKind) Descriptor() protoreflect.EnumDescriptor {
+	return file_resource_definitions_enums_enums_proto_enumTypes[25].Descriptor()
+}
+
+func (NethelpersQdiscKind) Type() protoreflect.EnumType {
+	return &file_resource_definitions_enums_enums_proto_enumTypes[25]
+}
+
+func (x NethelpersQdiscKind) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use NethelpersQdiscKind.Descriptor instead.
+func (NethelpersQdiscKind) EnumDescriptor() ([]byte, []int) {
+	return file_resource_definitions_enums_enums_proto_rawDescGZIP(), []int{25}
 }
 
 // NethelpersRouteFlag wraps RTM_F_* constants.
@@ -1127,11 +1752,11 @@ func (x NethelpersRouteFlag) String() string {
 }
 
 func (NethelpersRouteFlag) Descriptor() protoreflect.EnumDescriptor {
-	return file_resource_definitions_enums_enums_proto_enumTypes[15].Descriptor()
+	return file_resource_definitions_enums_enums_proto_enumTypes[26].Descriptor()
 }
 
 func (NethelpersRouteFlag) Type() protoreflect.EnumType {
-	return &file_resource_definitions_enums_enums_proto_enumTypes[15]
+	return &file_resource_definitions_enums_enums_proto_enumTypes[26]
 }
 
 func (x NethelpersRouteFlag) Number() protoreflect.EnumNumber {
@@ -1140,7 +1765,7 @@ func (x NethelpersRouteFlag) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use NethelpersRouteFlag.Descriptor instead.
 func (NethelpersRouteFlag) EnumDescriptor() ([]byte, []int) {
-	return file_resource_definitions_enums_enums_proto_rawDescGZIP(), []int{15}
+	return file_resource_definitions_enums_enums_proto_rawDescGZIP(), []int{26}
 }
 
 // NethelpersRouteProtocol is a routing protocol.
@@ -1234,11 +1859,11 @@ func (x NethelpersRouteProtocol) String() string {
 }
 
 func (NethelpersRouteProtocol) Descriptor() protoreflect.EnumDescriptor {
-	return file_resource_definitions_enums_enums_proto_enumTypes[16].Descriptor()
+	return file_resource_definitions_enums_enums_proto_enumTypes[27].Descriptor()
 }
 
 func (NethelpersRouteProtocol) Type() protoreflect.EnumType {
-	return &file_resource_definitions_enums_enums_proto_enumTypes[16]
+	return &file_resource_definitions_enums_enums_proto_enumTypes[27]
 }
 
 func (x NethelpersRouteProtocol) Number() protoreflect.EnumNumber {
@@ -1247,7 +1872,7 @@ func (x NethelpersRouteProtocol) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use NethelpersRouteProtocol.Descriptor instead.
 func (NethelpersRouteProtocol) EnumDescriptor() ([]byte, []int) {
-	return file_resource_definitions_enums_enums_proto_rawDescGZIP(), []int{16}
+	return file_resource_definitions_enums_enums_proto_rawDescGZIP(), []int{27}
 }
 
 // NethelpersRouteType is a route type.
@@ -1311,11 +1936,11 @@ func (x NethelpersRouteType) String() string {
 }
 
 func (NethelpersRouteType) Descriptor() protoreflect.EnumDescriptor {
-	return file_resource_definitions_enums_enums_proto_enumTypes[17].Descriptor()
+	return file_resource_definitions_enums_enums_proto_enumTypes[28].Descriptor()
 }
 
 func (NethelpersRouteType) Type() protoreflect.EnumType {
-	return &file_resource_definitions_enums_enums_proto_enumTypes[17]
+	return &file_resource_definitions_enums_enums_proto_enumTypes[28]
 }
 
 func (x NethelpersRouteType) Number() protoreflect.EnumNumber {
@@ -1324,7 +1949,69 @@ func (x NethelpersRouteType) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use NethelpersRouteType.Descriptor instead.
 func (NethelpersRouteType) EnumDescriptor() ([]byte, []int) {
-	return file_resource_definitions_enums_enums_proto_rawDescGZIP(), []int{17}
+	return file_resource_definitions_enums_enums_proto_rawDescGZIP(), []int{28}
+}
+
+// NethelpersRoutingRuleAction is a routing rule action.
+type NethelpersRoutingRuleAction int32
+
+const (
+	NethelpersRoutingRuleAction_ROUTING_RULE_ACTION_UNSPEC      NethelpersRoutingRuleAction = 0
+	NethelpersRoutingRuleAction_ROUTING_RULE_ACTION_TABLE       NethelpersRoutingRuleAction = 1
+	NethelpersRoutingRuleAction_ROUTING_RULE_ACTION_GOTO        NethelpersRoutingRuleAction = 2
+	NethelpersRoutingRuleAction_ROUTING_RULE_ACTION_NOP         NethelpersRoutingRuleAction = 3
+	NethelpersRoutingRuleAction_ROUTING_RULE_ACTION_BLACKHOLE   NethelpersRoutingRuleAction = 6
+	NethelpersRoutingRuleAction_ROUTING_RULE_ACTION_UNREACHABLE NethelpersRoutingRuleAction = 7
+	NethelpersRoutingRuleAction_ROUTING_RULE_ACTION_PROHIBIT    NethelpersRoutingRuleAction = 8
+)
+
+// Enum value maps for NethelpersRoutingRuleAction.
+var (
+	NethelpersRoutingRuleAction_name = map[int32]string{
+		0: "ROUTING_RULE_ACTION_UNSPEC",
+		1: "ROUTING_RULE_ACTION_TABLE",
+		2: "ROUTING_RULE_ACTION_GOTO",
+		3: "ROUTING_RULE_ACTION_NOP",
+		6: "ROUTING_RULE_ACTION_BLACKHOLE",
+		7: "ROUTING_RULE_ACTION_UNREACHABLE",
+		8: "ROUTING_RULE_ACTION_PROHIBIT",
+	}
+	NethelpersRoutingRuleAction_value = map[string]int32{
+		"ROUTING_RULE_ACTION_UNSPEC":      0,
+		"ROUTING_RULE_ACTION_TABLE":       1,
+		"ROUTING_RULE_ACTION_GOTO":        2,
+		"ROUTING_RULE_ACTION_NOP":         3,
+		"ROUTING_RULE_ACTION_BLACKHOLE":   6,
+		"ROUTING_RULE_ACTION_UNREACHABLE": 7,
+		"ROUTING_RULE_ACTION_PROHIBIT":    8,
+	}
+)
+
+func (x NethelpersRoutingRuleAction) Enum() *NethelpersRoutingRuleAction {
+	p := new(NethelpersRoutingRuleAction)
+	*p = x
+	return p
+}
+
+func (x NethelpersRoutingRuleAction) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (NethelpersRoutingRuleAction) Descriptor() protoreflect.EnumDescriptor {
+	return file_resource_definitions_enums_enums_proto_enumTypes[29].Descriptor()
+}
+
+func (NethelpersRoutingRuleAction) Type() protoreflect.EnumType {
+	return &file_resource_definitions_enums_enums_proto_enumTypes[29]
+}
+
+func (x NethelpersRoutingRuleAction) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use NethelpersRoutingRuleAction.Descriptor instead.
+func (NethelpersRoutingRuleAction) EnumDescriptor() ([]byte, []int) {
+	return file_resource_definitions_enums_enums_proto_rawDescGZIP(), []int{29}
 }
 
 // NethelpersRoutingTable is a routing table ID.
@@ -1364,11 +2051,11 @@ func (x NethelpersRoutingTable) String() string {
 }
 
 func (NethelpersRoutingTable) Descriptor() protoreflect.EnumDescriptor {
-	return file_resource_definitions_enums_enums_proto_enumTypes[18].Descriptor()
+	return file_resource_definitions_enums_enums_proto_enumTypes[30].Descriptor()
 }
 
 func (NethelpersRoutingTable) Type() protoreflect.EnumType {
-	return &file_resource_definitions_enums_enums_proto_enumTypes[18]
+	return &file_resource_definitions_enums_enums_proto_enumTypes[30]
 }
 
 func (x NethelpersRoutingTable) Number() protoreflect.EnumNumber {
@@ -1377,7 +2064,7 @@ func (x NethelpersRoutingTable) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use NethelpersRoutingTable.Descriptor instead.
 func (NethelpersRoutingTable) EnumDescriptor() ([]byte, []int) {
-	return file_resource_definitions_enums_enums_proto_rawDescGZIP(), []int{18}
+	return file_resource_definitions_enums_enums_proto_rawDescGZIP(), []int{30}
 }
 
 // NethelpersScope is an address scope.
@@ -1420,11 +2107,11 @@ func (x NethelpersScope) String() string {
 }
 
 func (NethelpersScope) Descriptor() protoreflect.EnumDescriptor {
-	return file_resource_definitions_enums_enums_proto_enumTypes[19].Descriptor()
+	return file_resource_definitions_enums_enums_proto_enumTypes[31].Descriptor()
 }
 
 func (NethelpersScope) Type() protoreflect.EnumType {
-	return &file_resource_definitions_enums_enums_proto_enumTypes[19]
+	return &file_resource_definitions_enums_enums_proto_enumTypes[31]
 }
 
 func (x NethelpersScope) Number() protoreflect.EnumNumber {
@@ -1433,7 +2120,57 @@ func (x NethelpersScope) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use NethelpersScope.Descriptor instead.
 func (NethelpersScope) EnumDescriptor() ([]byte, []int) {
-	return file_resource_definitions_enums_enums_proto_rawDescGZIP(), []int{19}
+	return file_resource_definitions_enums_enums_proto_rawDescGZIP(), []int{31}
+}
+
+// NethelpersTrafficControlFilterKind is a kind of the traffic control filter match.
+type NethelpersTrafficControlFilterKind int32
+
+const (
+	NethelpersTrafficControlFilterKind_TRAFFIC_CONTROL_FILTER_KIND_FW_MARK NethelpersTrafficControlFilterKind = 0
+	NethelpersTrafficControlFilterKind_TRAFFIC_CONTROL_FILTER_KIND_DSCP    NethelpersTrafficControlFilterKind = 1
+	NethelpersTrafficControlFilterKind_TRAFFIC_CONTROL_FILTER_KIND_CGROUP  NethelpersTrafficControlFilterKind = 2
+)
+
+// Enum value maps for NethelpersTrafficControlFilterKind.
+var (
+	NethelpersTrafficControlFilterKind_name = map[int32]string{
+		0: "TRAFFIC_CONTROL_FILTER_KIND_FW_MARK",
+		1: "TRAFFIC_CONTROL_FILTER_KIND_DSCP",
+		2: "TRAFFIC_CONTROL_FILTER_KIND_CGROUP",
+	}
+	NethelpersTrafficControlFilterKind_value = map[string]int32{
+		"TRAFFIC_CONTROL_FILTER_KIND_FW_MARK": 0,
+		"TRAFFIC_CONTROL_FILTER_KIND_DSCP":    1,
+		"TRAFFIC_CONTROL_FILTER_KIND_CGROUP":  2,
+	}
+)
+
+func (x NethelpersTrafficControlFilterKind) Enum() *NethelpersTrafficControlFilterKind {
+	p := new(NethelpersTrafficControlFilterKind)
+	*p = x
+	return p
+}
+
+func (x NethelpersTrafficControlFilterKind) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (NethelpersTrafficControlFilterKind) Descriptor() protoreflect.EnumDescriptor {
+	return file_resource_definitions_enums_enums_proto_enumTypes[32].Descriptor()
+}
+
+func (NethelpersTrafficControlFilterKind) Type() protoreflect.EnumType {
+	return &file_resource_definitions_enums_enums_proto_enumTypes[32]
+}
+
+func (x NethelpersTrafficControlFilterKind) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use NethelpersTrafficControlFilterKind.Descriptor instead.
+func (NethelpersTrafficControlFilterKind) EnumDescriptor() ([]byte, []int) {
+	return file_resource_definitions_enums_enums_proto_rawDescGZIP(), []int{32}
 }
 
 // NethelpersVLANProtocol is a VLAN protocol.
@@ -1470,11 +2207,11 @@ func (x NethelpersVLANProtocol) String() string {
 }
 
 func (NethelpersVLANProtocol) Descriptor() protoreflect.EnumDescriptor {
-	return file_resource_definitions_enums_enums_proto_enumTypes[20].Descriptor()
+	return file_resource_definitions_enums_enums_proto_enumTypes[33].Descriptor()
 }
 
 func (NethelpersVLANProtocol) Type() protoreflect.EnumType {
-	return &file_resource_definitions_enums_enums_proto_enumTypes[20]
+	return &file_resource_definitions_enums_enums_proto_enumTypes[33]
 }
 
 func (x NethelpersVLANProtocol) Number() protoreflect.EnumNumber {
@@ -1483,7 +2220,7 @@ func (x NethelpersVLANProtocol) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use NethelpersVLANProtocol.Descriptor instead.
 func (NethelpersVLANProtocol) EnumDescriptor() ([]byte, []int) {
-	return file_resource_definitions_enums_enums_proto_rawDescGZIP(), []int{20}
+	return file_resource_definitions_enums_enums_proto_rawDescGZIP(), []int{33}
 }
 
 // KubespanPeerState is KubeSpan peer current state.
@@ -1520,11 +2257,11 @@ func (x KubespanPeerState) String() string {
 }
 
 func (KubespanPeerState) Descriptor() protoreflect.EnumDescriptor {
-	return file_resource_definitions_enums_enums_proto_enumTypes[21].Descriptor()
+	return file_resource_definitions_enums_enums_proto_enumTypes[34].Descriptor()
 }
 
 func (KubespanPeerState) Type() protoreflect.EnumType {
-	return &file_resource_definitions_enums_enums_proto_enumTypes[21]
+	return &file_resource_definitions_enums_enums_proto_enumTypes[34]
 }
 
 func (x KubespanPeerState) Number() protoreflect.EnumNumber {
@@ -1533,7 +2270,7 @@ func (x KubespanPeerState) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use KubespanPeerState.Descriptor instead.
 func (KubespanPeerState) EnumDescriptor() ([]byte, []int) {
-	return file_resource_definitions_enums_enums_proto_rawDescGZIP(), []int{21}
+	return file_resource_definitions_enums_enums_proto_rawDescGZIP(), []int{34}
 }
 
 // NetworkConfigLayer describes network configuration layers, with lowest priority first.
@@ -1576,11 +2313,11 @@ func (x NetworkConfigLayer) String() string {
 }
 
 func (NetworkConfigLayer) Descriptor() protoreflect.EnumDescriptor {
-	return file_resource_definitions_enums_enums_proto_enumTypes[22].Descriptor()
+	return file_resource_definitions_enums_enums_proto_enumTypes[35].Descriptor()
 }
 
 func (NetworkConfigLayer) Type() protoreflect.EnumType {
-	return &file_resource_definitions_enums_enums_proto_enumTypes[22]
+	return &file_resource_definitions_enums_enums_proto_enumTypes[35]
 }
 
 func (x NetworkConfigLayer) Number() protoreflect.EnumNumber {
@@ -1589,7 +2326,7 @@ func (x NetworkConfigLayer) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use NetworkConfigLayer.Descriptor instead.
 func (NetworkConfigLayer) EnumDescriptor() ([]byte, []int) {
-	return file_resource_definitions_enums_enums_proto_rawDescGZIP(), []int{22}
+	return file_resource_definitions_enums_enums_proto_rawDescGZIP(), []int{35}
 }
 
 // NetworkOperator enumerates Talos network operators.
@@ -1599,6 +2336,7 @@ const (
 	NetworkOperator_OPERATOR_DHCP4 NetworkOperator = 0
 	NetworkOperator_OPERATOR_DHCP6 NetworkOperator = 1
 	NetworkOperator_OPERATOR_VIP   NetworkOperator = 2
+	NetworkOperator_OPERATOR_RA    NetworkOperator = 3
 )
 
 // Enum value maps for NetworkOperator.
@@ -1607,11 +2345,13 @@ var (
 		0: "OPERATOR_DHCP4",
 		1: "OPERATOR_DHCP6",
 		2: "OPERATOR_VIP",
+		3: "OPERATOR_RA",
 	}
 	NetworkOperator_value = map[string]int32{
 		"OPERATOR_DHCP4": 0,
 		"OPERATOR_DHCP6": 1,
 		"OPERATOR_VIP":   2,
+		"OPERATOR_RA":    3,
 	}
 )
 
@@ -1626,11 +2366,11 @@ func (x NetworkOperator) String() string {
 }
 
 func (NetworkOperator) Descriptor() protoreflect.EnumDescriptor {
-	return file_resource_definitions_enums_enums_proto_enumTypes[23].Descriptor()
+	return file_resource_definitions_enums_enums_proto_enumTypes[36].Descriptor()
 }
 
 func (NetworkOperator) Type() protoreflect.EnumType {
-	return &file_resource_definitions_enums_enums_proto_enumTypes[23]
+	return &file_resource_definitions_enums_enums_proto_enumTypes[36]
 }
 
 func (x NetworkOperator) Number() protoreflect.EnumNumber {
@@ -1639,7 +2379,7 @@ func (x NetworkOperator) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use NetworkOperator.Descriptor instead.
 func (NetworkOperator) EnumDescriptor() ([]byte, []int) {
-	return file_resource_definitions_enums_enums_proto_rawDescGZIP(), []int{23}
+	return file_resource_definitions_enums_enums_proto_rawDescGZIP(), []int{36}
 }
 
 // RuntimeMachineStage describes the stage of the machine boot/run process.
@@ -1694,11 +2434,11 @@ func (x RuntimeMachineStage) String() string {
 }
 
 func (RuntimeMachineStage) Descriptor() protoreflect.EnumDescriptor {
-	return file_resource_definitions_enums_enums_proto_enumTypes[24].Descriptor()
+	return file_resource_definitions_enums_enums_proto_enumTypes[37].Descriptor()
 }
 
 func (RuntimeMachineStage) Type() protoreflect.EnumType {
-	return &file_resource_definitions_enums_enums_proto_enumTypes[24]
+	return &file_resource_definitions_enums_enums_proto_enumTypes[37]
 }
 
 func (x RuntimeMachineStage) Number() protoreflect.EnumNumber {
@@ -1707,7 +2447,7 @@ func (x RuntimeMachineStage) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use RuntimeMachineStage.Descriptor instead.
 func (RuntimeMachineStage) EnumDescriptor() ([]byte, []int) {
-	return file_resource_definitions_enums_enums_proto_rawDescGZIP(), []int{24}
+	return file_resource_definitions_enums_enums_proto_rawDescGZIP(), []int{37}
 }
 
 var File_resource_definitions_enums_enums_proto protoreflect.FileDescriptor
@@ -1745,305 +2485,455 @@ var file_resource_definitions_enums_enums_proto_rawDesc = []byte{
 	0x54, 0x45, 0x10, 0x80, 0x04, 0x12, 0x19, 0x0a, 0x14, 0x41, 0x44, 0x44, 0x52, 0x45, 0x53, 0x53,
 	0x5f, 0x4d, 0x43, 0x5f, 0x41, 0x55, 0x54, 0x4f, 0x5f, 0x4a, 0x4f, 0x49, 0x4e, 0x10, 0x80, 0x08,
 	0x12, 0x1b, 0x0a, 0x16, 0x41, 0x44, 0x44, 0x52, 0x45, 0x53, 0x53, 0x5f, 0x53, 0x54, 0x41, 0x42,
-	0x4c, 0x45, 0x5f, 0x50, 0x52, 0x49, 0x56, 0x41, 0x43, 0x59, 0x10, 0x80, 0x10, 0x2a, 0x58, 0x0a,
-	0x12, 0x4e, 0x65, 0x74, 0x68, 0x65, 0x6c, 0x70, 0x65, 0x72, 0x73, 0x41, 0x44, 0x53, 0x65, 0x6c,
-	0x65, 0x63, 0x74, 0x12, 0x14, 0x0a, 0x10, 0x41, 0x44, 0x5f, 0x53, 0x45, 0x4c, 0x45, 0x43, 0x54,
-	0x5f, 0x53, 0x54, 0x41, 0x42, 0x4c, 0x45, 0x10, 0x00, 0x12, 0x17, 0x0a, 0x13, 0x41, 0x44, 0x5f,
-	0x53, 0x45, 0x4c, 0x45, 0x43, 0x54, 0x5f, 0x42, 0x41, 0x4e, 0x44, 0x57, 0x49, 0x44, 0x54, 0x48,
-	0x10, 0x01, 0x12, 0x13, 0x0a, 0x0f, 0x41, 0x44, 0x5f, 0x53, 0x45, 0x4c, 0x45, 0x43, 0x54, 0x5f,
-	0x43, 0x4f, 0x55, 0x4e, 0x54, 0x10, 0x02, 0x2a, 0x4b, 0x0a, 0x17, 0x4e, 0x65, 0x74, 0x68, 0x65,
-	0x6c, 0x70, 0x65, 0x72, 0x73, 0x41, 0x52, 0x50, 0x41, 0x6c, 0x6c, 0x54, 0x61, 0x72, 0x67, 0x65,
-	0x74, 0x73, 0x12, 0x17, 0x0a, 0x13, 0x41, 0x52, 0x50, 0x5f, 0x41, 0x4c, 0x4c, 0x5f, 0x54, 0x41,
-	0x52, 0x47, 0x45, 0x54, 0x53, 0x5f, 0x41, 0x4e, 0x59, 0x10, 0x00, 0x12, 0x17, 0x0a, 0x13, 0x41,
-	0x52, 0x50, 0x5f, 0x41, 0x4c, 0x4c, 0x5f, 0x54, 0x41, 0x52, 0x47, 0x45, 0x54, 0x53, 0x5f, 0x41,
-	0x4c, 0x4c, 0x10, 0x01, 0x2a, 0x76, 0x0a, 0x15, 0x4e, 0x65, 0x74, 0x68, 0x65, 0x6c, 0x70, 0x65,
-	0x72, 0x73, 0x41, 0x52, 0x50, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x12, 0x15, 0x0a,
-	0x11, 0x41, 0x52, 0x50, 0x5f, 0x56, 0x41, 0x4c, 0x49, 0x44, 0x41, 0x54, 0x45, 0x5f, 0x4e, 0x4f,
-	0x4e, 0x45, 0x10, 0x00, 0x12, 0x17, 0x0a, 0x13, 0x41, 0x52, 0x50, 0x5f, 0x56, 0x41, 0x4c, 0x49,
-	0x44, 0x41, 0x54, 0x45, 0x5f, 0x41, 0x43, 0x54, 0x49, 0x56, 0x45, 0x10, 0x01, 0x12, 0x17, 0x0a,
-	0x13, 0x41, 0x52, 0x50, 0x5f, 0x56, 0x41, 0x4c, 0x49, 0x44, 0x41, 0x54, 0x45, 0x5f, 0x42, 0x41,
-	0x43, 0x4b, 0x55, 0x50, 0x10, 0x02, 0x12, 0x14, 0x0a, 0x10, 0x41, 0x52, 0x50, 0x5f, 0x56, 0x41,
-	0x4c, 0x49, 0x44, 0x41, 0x54, 0x45, 0x5f, 0x41, 0x4c, 0x4c, 0x10, 0x03, 0x2a, 0xb3, 0x01, 0x0a,
-	0x12, 0x4e, 0x65, 0x74, 0x68, 0x65, 0x6c, 0x70, 0x65, 0x72, 0x73, 0x42, 0x6f, 0x6e, 0x64, 0x4d,
-	0x6f, 0x64, 0x65, 0x12, 0x18, 0x0a, 0x14, 0x42, 0x4f, 0x4e, 0x44, 0x5f, 0x4d, 0x4f, 0x44, 0x45,
-	0x5f, 0x52, 0x4f, 0x55, 0x4e, 0x44, 0x52, 0x4f, 0x42, 0x49, 0x4e, 0x10, 0x00, 0x12, 0x1b, 0x0a,
-	0x17, 0x42, 0x4f, 0x4e, 0x44, 0x5f, 0x4d, 0x4f, 0x44, 0x45, 0x5f, 0x41, 0x43, 0x54, 0x49, 0x56,
-	0x45, 0x5f, 0x42, 0x41, 0x43, 0x4b, 0x55, 0x50, 0x10, 0x01, 0x12, 0x11, 0x0a, 0x0d, 0x42, 0x4f,
-	0x4e, 0x44, 0x5f, 0x4d, 0x4f, 0x44, 0x45, 0x5f, 0x58, 0x4f, 0x52, 0x10, 0x02, 0x12, 0x17, 0x0a,
-	0x13, 0x42, 0x4f, 0x4e, 0x44, 0x5f, 0x4d, 0x4f, 0x44, 0x45, 0x5f, 0x42, 0x52, 0x4f, 0x41, 0x44,
-	0x43, 0x41, 0x53, 0x54, 0x10, 0x03, 0x12, 0x14, 0x0a, 0x10, 0x42, 0x4f, 0x4e, 0x44, 0x5f, 0x4d,
-	0x4f, 0x44, 0x45, 0x38, 0x30, 0x32, 0x33, 0x5f, 0x41, 0x44, 0x10, 0x04, 0x12, 0x11, 0x0a, 0x0d,
-	0x42, 0x4f, 0x4e, 0x44, 0x5f, 0x4d, 0x4f, 0x44, 0x45, 0x5f, 0x54, 0x4c, 0x42, 0x10, 0x05, 0x12,
-	0x11, 0x0a, 0x0d, 0x42, 0x4f, 0x4e, 0x44, 0x5f, 0x4d, 0x4f, 0x44, 0x45, 0x5f, 0x41, 0x4c, 0x42,
-	0x10, 0x06, 0x2a, 0xb3, 0x01, 0x0a, 0x1c, 0x4e, 0x65, 0x74, 0x68, 0x65, 0x6c, 0x70, 0x65, 0x72,
-	0x73, 0x42, 0x6f, 0x6e, 0x64, 0x58, 0x6d, 0x69, 0x74, 0x48, 0x61, 0x73, 0x68, 0x50, 0x6f, 0x6c,
-	0x69, 0x63, 0x79, 0x12, 0x1b, 0x0a, 0x17, 0x42, 0x4f, 0x4e, 0x44, 0x5f, 0x58, 0x4d, 0x49, 0x54,
-	0x5f, 0x50, 0x4f, 0x4c, 0x49, 0x43, 0x59, 0x5f, 0x4c, 0x41, 0x59, 0x45, 0x52, 0x32, 0x10, 0x00,
-	0x12, 0x1c, 0x0a, 0x18, 0x42, 0x4f, 0x4e, 0x44, 0x5f, 0x58, 0x4d, 0x49, 0x54, 0x5f, 0x50, 0x4f,
-	0x4c, 0x49, 0x43, 0x59, 0x5f, 0x4c, 0x41, 0x59, 0x45, 0x52, 0x33, 0x34, 0x10, 0x01, 0x12, 0x1c,
-	0x0a, 0x18, 0x42, 0x4f, 0x4e, 0x44, 0x5f, 0x58, 0x4d, 0x49, 0x54, 0x5f, 0x50, 0x4f, 0x4c, 0x49,
-	0x43, 0x59, 0x5f, 0x4c, 0x41, 0x59, 0x45, 0x52, 0x32, 0x33, 0x10, 0x02, 0x12, 0x1c, 0x0a, 0x18,
-	0x42, 0x4f, 0x4e, 0x44, 0x5f, 0x58, 0x4d, 0x49, 0x54, 0x5f, 0x50, 0x4f, 0x4c, 0x49, 0x43, 0x59,
-	0x5f, 0x45, 0x4e, 0x43, 0x41, 0x50, 0x32, 0x33, 0x10, 0x03, 0x12, 0x1c, 0x0a, 0x18, 0x42, 0x4f,
-	0x4e, 0x44, 0x5f, 0x58, 0x4d, 0x49, 0x54, 0x5f, 0x50, 0x4f, 0x4c, 0x49, 0x43, 0x59, 0x5f, 0x45,
-	0x4e, 0x43, 0x41, 0x50, 0x33, 0x34, 0x10, 0x04, 0x2a, 0x34, 0x0a, 0x10, 0x4e, 0x65, 0x74, 0x68,
-	0x65, 0x6c, 0x70, 0x65, 0x72, 0x73, 0x44, 0x75, 0x70, 0x6c, 0x65, 0x78, 0x12, 0x08, 0x0a, 0x04,
-	0x48, 0x41, 0x4c, 0x46, 0x10, 0x00, 0x12, 0x08, 0x0a, 0x04, 0x46, 0x55, 0x4c, 0x4c, 0x10, 0x01,
-	0x12, 0x0c, 0x0a, 0x07, 0x55, 0x4e, 0x4b, 0x4e, 0x4f, 0x57, 0x4e, 0x10, 0xff, 0x01, 0x2a, 0x63,
-	0x0a, 0x15, 0x4e, 0x65, 0x74, 0x68, 0x65, 0x6c, 0x70, 0x65, 0x72, 0x73, 0x46, 0x61, 0x69, 0x6c,
-	0x4f, 0x76, 0x65, 0x72, 0x4d, 0x41, 0x43, 0x12, 0x16, 0x0a, 0x12, 0x46, 0x41, 0x49, 0x4c, 0x5f,
-	0x4f, 0x56, 0x45, 0x52, 0x5f, 0x4d, 0x41, 0x43, 0x5f, 0x4e, 0x4f, 0x4e, 0x45, 0x10, 0x00, 0x12,
-	0x18, 0x0a, 0x14, 0x46, 0x41, 0x49, 0x4c, 0x5f, 0x4f, 0x56, 0x45, 0x52, 0x5f, 0x4d, 0x41, 0x43,
-	0x5f, 0x41, 0x43, 0x54, 0x49, 0x56, 0x45, 0x10, 0x01, 0x12, 0x18, 0x0a, 0x14, 0x46, 0x41, 0x49,
-	0x4c, 0x5f, 0x4f, 0x56, 0x45, 0x52, 0x5f, 0x4d, 0x41, 0x43, 0x5f, 0x46, 0x4f, 0x4c, 0x4c, 0x4f,
-	0x57, 0x10, 0x02, 0x2a, 0x59, 0x0a, 0x10, 0x4e, 0x65, 0x74, 0x68, 0x65, 0x6c, 0x70, 0x65, 0x72,
-	0x73, 0x46, 0x61, 0x6d, 0x69, 0x6c, 0x79, 0x12, 0x21, 0x0a, 0x1d, 0x4e, 0x45, 0x54, 0x48, 0x45,
-	0x4c, 0x50, 0x45, 0x52, 0x53, 0x5f, 0x46, 0x41, 0x4d, 0x49, 0x4c, 0x59, 0x5f, 0x55, 0x4e, 0x53,
-	0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x10, 0x0a, 0x0c, 0x46, 0x41,
-	0x4d, 0x49, 0x4c, 0x59, 0x5f, 0x49, 0x4e, 0x45, 0x54, 0x34, 0x10, 0x02, 0x12, 0x10, 0x0a, 0x0c,
-	0x46, 0x41, 0x4d, 0x49, 0x4c, 0x59, 0x5f, 0x49, 0x4e, 0x45, 0x54, 0x36, 0x10, 0x0a, 0x2a, 0x3c,
-	0x0a, 0x12, 0x4e, 0x65, 0x74, 0x68, 0x65, 0x6c, 0x70, 0x65, 0x72, 0x73, 0x4c, 0x41, 0x43, 0x50,
-	0x52, 0x61, 0x74, 0x65, 0x12, 0x12, 0x0a, 0x0e, 0x4c, 0x41, 0x43, 0x50, 0x5f, 0x52, 0x41, 0x54,
-	0x45, 0x5f, 0x53, 0x4c, 0x4f, 0x57, 0x10, 0x00, 0x12, 0x12, 0x0a, 0x0e, 0x4c, 0x41, 0x43, 0x50,
-	0x5f, 0x52, 0x41, 0x54, 0x45, 0x5f, 0x46, 0x41, 0x53, 0x54, 0x10, 0x01, 0x2a, 0x93, 0x0b, 0x0a,
-	0x12, 0x4e, 0x65, 0x74, 0x68, 0x65, 0x6c, 0x70, 0x65, 0x72, 0x73, 0x4c, 0x69, 0x6e, 0x6b, 0x54,
-	0x79, 0x70, 0x65, 0x12, 0x0f, 0x0a, 0x0b, 0x4c, 0x49, 0x4e, 0x4b, 0x5f, 0x4e, 0x45, 0x54, 0x52,
-	0x4f, 0x4d, 0x10, 0x00, 0x12, 0x0e, 0x0a, 0x0a, 0x4c, 0x49, 0x4e, 0x4b, 0x5f, 0x45, 0x54, 0x48,
-	0x45, 0x52, 0x10, 0x01, 0x12, 0x0f, 0x0a, 0x0b, 0x4c, 0x49, 0x4e, 0x4b, 0x5f, 0x45, 0x45, 0x54,
-	0x48, 0x45, 0x52, 0x10, 0x02, 0x12, 0x0d, 0x0a, 0x09, 0x4c, 0x49, 0x4e, 0x4b, 0x5f, 0x41, 0x58,
-	0x32, 0x35, 0x10, 0x03, 0x12, 0x0f, 0x0a, 0x0b, 0x4c, 0x49, 0x4e, 0x4b, 0x5f, 0x50, 0x52, 0x4f,
-	0x4e, 0x45, 0x54, 0x10, 0x04, 0x12, 0x0e, 0x0a, 0x0a, 0x4c, 0x49, 0x4e, 0x4b, 0x5f, 0x43, 0x48,
-	0x41, 0x4f, 0x53, 0x10, 0x05, 0x12, 0x0f, 0x0a, 0x0b, 0x4c, 0x49, 0x4e, 0x4b, 0x5f, 0x49, 0x45,
-	0x45, 0x38, 0x30, 0x32, 0x10, 0x06, 0x12, 0x0f, 0x0a, 0x0b, 0x4c, 0x49, 0x4e, 0x4b, 0x5f, 0x41,
-	0x52, 0x43, 0x4e, 0x45, 0x54, 0x10, 0x07, 0x12, 0x0e, 0x0a, 0x0a, 0x4c, 0x49, 0x4e, 0x4b, 0x5f,
-	0x41, 0x54, 0x41, 0x4c, 0x4b, 0x10, 0x08, 0x12, 0x0d, 0x0a, 0x09, 0x4c, 0x49, 0x4e, 0x4b, 0x5f,
-	0x44, 0x4c, 0x43, 0x49, 0x10, 0x0f, 0x12, 0x0c, 0x0a, 0x08, 0x4c, 0x49, 0x4e, 0x4b, 0x5f, 0x41,
-	0x54, 0x4d, 0x10, 0x13, 0x12, 0x11, 0x0a, 0x0d, 0x4c, 0x49, 0x4e, 0x4b, 0x5f, 0x4d, 0x45, 0x54,
-	0x52, 0x49, 0x43, 0x4f, 0x4d, 0x10, 0x17, 0x12, 0x11, 0x0a, 0x0d, 0x4c, 0x49, 0x4e, 0x4b, 0x5f,
-	0x49, 0x45, 0x45, 0x45, 0x31, 0x33, 0x39, 0x34, 0x10, 0x18, 0x12, 0x0e, 0x0a, 0x0a, 0x4c, 0x49,
-	0x4e, 0x4b, 0x5f, 0x45, 0x55, 0x49, 0x36, 0x34, 0x10, 0x1b, 0x12, 0x13, 0x0a, 0x0f, 0x4c, 0x49,
-	0x4e, 0x4b, 0x5f, 0x49, 0x4e, 0x46, 0x49, 0x4e, 0x49, 0x42, 0x41, 0x4e, 0x44, 0x10, 0x20, 0x12,
-	0x0e, 0x0a, 0x09, 0x4c, 0x49, 0x4e, 0x4b, 0x5f, 0x53, 0x4c, 0x49, 0x50, 0x10, 0x80, 0x02, 0x12,
-	0x0f, 0x0a, 0x0a, 0x4c, 0x49, 0x4e, 0x4b, 0x5f, 0x43, 0x53, 0x4c, 0x49, 0x50, 0x10, 0x81, 0x02,
-	0x12, 0x0f, 0x0a, 0x0a, 0x4c, 0x49, 0x4e, 0x4b, 0x5f, 0x53, 0x4c, 0x49, 0x50, 0x36, 0x10, 0x82,
-	0x02, 0x12, 0x10, 0x0a, 0x0b, 0x4c, 0x49, 0x4e, 0x4b, 0x5f, 0x43, 0x53, 0x4c, 0x49, 0x50, 0x36,
-	0x10, 0x83, 0x02, 0x12, 0x0f, 0x0a, 0x0a, 0x4c, 0x49, 0x4e, 0x4b, 0x5f, 0x52, 0x53, 0x52, 0x56,
-	0x44, 0x10, 0x84, 0x02, 0x12, 0x0f, 0x0a, 0x0a, 0x4c, 0x49, 0x4e, 0x4b, 0x5f, 0x41, 0x44, 0x41,
-	0x50, 0x54, 0x10, 0x88, 0x02, 0x12, 0x0e, 0x0a, 0x09, 0x4c, 0x49, 0x4e, 0x4b, 0x5f, 0x52, 0x4f,
-	0x53, 0x45, 0x10, 0x8e, 0x02, 0x12, 0x0d, 0x0a, 0x08, 0x4c, 0x49, 0x4e, 0x4b, 0x5f, 0x58, 0x32,
-	0x35, 0x10, 0x8f, 0x02, 0x12, 0x0f, 0x0a, 0x0a, 0x4c, 0x49, 0x4e, 0x4b, 0x5f, 0x48, 0x57, 0x58,
-	0x32, 0x35, 0x10, 0x90, 0x02, 0x12, 0x0d, 0x0a, 0x08, 0x4c, 0x49, 0x4e, 0x4b, 0x5f, 0x43, 0x41,
-	0x4e, 0x10, 0x98, 0x02, 0x12, 0x0d, 0x0a, 0x08, 0x4c, 0x49, 0x4e, 0x4b, 0x5f, 0x50, 0x50, 0x50,
-	0x10, 0x80, 0x04, 0x12, 0x0f, 0x0a, 0x0a, 0x4c, 0x49, 0x4e, 0x4b, 0x5f, 0x43, 0x49, 0x53, 0x43,
-	0x4f, 0x10, 0x81, 0x04, 0x12, 0x0e, 0x0a, 0x09, 0x4c, 0x49, 0x4e, 0x4b, 0x5f, 0x48, 0x44, 0x4c,
-	0x43, 0x10, 0x81, 0x04, 0x12, 0x0e, 0x0a, 0x09, 0x4c, 0x49, 0x4e, 0x4b, 0x5f, 0x4c, 0x41, 0x50,
-	0x42, 0x10, 0x84, 0x04, 0x12, 0x0f, 0x0a, 0x0a, 0x4c, 0x49, 0x4e, 0x4b, 0x5f, 0x44, 0x44, 0x43,
-	0x4d, 0x50, 0x10, 0x85, 0x04, 0x12, 0x11, 0x0a, 0x0c, 0x4c, 0x49, 0x4e, 0x4b, 0x5f, 0x52, 0x41,
-	0x57, 0x48, 0x44, 0x4c, 0x43, 0x10, 0x86, 0x04, 0x12, 0x10, 0x0a, 0x0b, 0x4c, 0x49, 0x4e, 0x4b,
-	0x5f, 0x54, 0x55, 0x4e, 0x4e, 0x45, 0x4c, 0x10, 0x80, 0x06, 0x12, 0x11, 0x0a, 0x0c, 0x4c, 0x49,
-	0x4e, 0x4b, 0x5f, 0x54, 0x55, 0x4e, 0x4e, 0x45, 0x4c, 0x36, 0x10, 0x81, 0x06, 0x12, 0x0e, 0x0a,
-	0x09, 0x4c, 0x49, 0x4e, 0x4b, 0x5f, 0x46, 0x52, 0x41, 0x44, 0x10, 0x82, 0x06, 0x12, 0x0e, 0x0a,
-	0x09, 0x4c, 0x49, 0x4e, 0x4b, 0x5f, 0x53, 0x4b, 0x49, 0x50, 0x10, 0x83, 0x06, 0x12, 0x11, 0x0a,
-	0x0c, 0x4c, 0x49, 0x4e, 0x4b, 0x5f, 0x4c, 0x4f, 0x4f, 0x50, 0x42, 0x43, 0x4b, 0x10, 0x84, 0x06,
-	0x12, 0x12, 0x0a, 0x0d, 0x4c, 0x49, 0x4e, 0x4b, 0x5f, 0x4c, 0x4f, 0x43, 0x41, 0x4c, 0x54, 0x4c,
-	0x4b, 0x10, 0x85, 0x06, 0x12, 0x0e, 0x0a, 0x09, 0x4c, 0x49, 0x4e, 0x4b, 0x5f, 0x46, 0x44, 0x44,
-	0x49, 0x10, 0x86, 0x06, 0x12, 0x0d, 0x0a, 0x08, 0x4c, 0x49, 0x4e, 0x4b, 0x5f, 0x42, 0x49, 0x46,
-	0x10, 0x87, 0x06, 0x12, 0x0d, 0x0a, 0x08, 0x4c, 0x49, 0x4e, 0x4b, 0x5f, 0x53, 0x49, 0x54, 0x10,
-	0x88, 0x06, 0x12, 0x0f, 0x0a, 0x0a, 0x4c, 0x49, 0x4e, 0x4b, 0x5f, 0x49, 0x50, 0x44, 0x44, 0x50,
-	0x10, 0x89, 0x06, 0x12, 0x0f, 0x0a, 0x0a, 0x4c, 0x49, 0x4e, 0x4b, 0x5f, 0x49, 0x50, 0x47, 0x52,
-	0x45, 0x10, 0x8a, 0x06, 0x12, 0x10, 0x0a, 0x0b, 0x4c, 0x49, 0x4e, 0x4b, 0x5f, 0x50, 0x49, 0x4d,
-	0x52, 0x45, 0x47, 0x10, 0x8b, 0x06, 0x12, 0x0f, 0x0a, 0x0a, 0x4c, 0x49, 0x4e, 0x4b, 0x5f, 0x48,
-	0x49, 0x50, 0x50, 0x49, 0x10, 0x8c, 0x06, 0x12, 0x0d, 0x0a, 0x08, 0x4c, 0x49, 0x4e, 0x4b, 0x5f,
-	0x41, 0x53, 0x48, 0x10, 0x8d, 0x06, 0x12, 0x10, 0x0a, 0x0b, 0x4c, 0x49, 0x4e, 0x4b, 0x5f, 0x45,
-	0x43, 0x4f, 0x4e, 0x45, 0x54, 0x10, 0x8e, 0x06, 0x12, 0x0e, 0x0a, 0x09, 0x4c, 0x49, 0x4e, 0x4b,
-	0x5f, 0x49, 0x52, 0x44, 0x41, 0x10, 0x8f, 0x06, 0x12, 0x0e, 0x0a, 0x09, 0x4c, 0x49, 0x4e, 0x4b,
-	0x5f, 0x46, 0x43, 0x50, 0x50, 0x10, 0x90, 0x06, 0x12, 0x0e, 0x0a, 0x09, 0x4c, 0x49, 0x4e, 0x4b,
-	0x5f, 0x46, 0x43, 0x41, 0x4c, 0x10, 0x91, 0x06, 0x12, 0x0e, 0x0a, 0x09, 0x4c, 0x49, 0x4e, 0x4b,
-	0x5f, 0x46, 0x43, 0x50, 0x4c, 0x10, 0x92, 0x06, 0x12, 0x12, 0x0a, 0x0d, 0x4c, 0x49, 0x4e, 0x4b,
-	0x5f, 0x46, 0x43, 0x46, 0x41, 0x42, 0x52, 0x49, 0x43, 0x10, 0x93, 0x06, 0x12, 0x13, 0x0a, 0x0e,
-	0x4c, 0x49, 0x4e, 0x4b, 0x5f, 0x46, 0x43, 0x46, 0x41, 0x42, 0x52, 0x49, 0x43, 0x31, 0x10, 0x94,
-	0x06, 0x12, 0x13, 0x0a, 0x0e, 0x4c, 0x49, 0x4e, 0x4b, 0x5f, 0x46, 0x43, 0x46, 0x41, 0x42, 0x52,
-	0x49, 0x43, 0x32, 0x10, 0x95, 0x06, 0x12, 0x13, 0x0a, 0x0e, 0x4c, 0x49, 0x4e, 0x4b, 0x5f, 0x46,
-	0x43, 0x46, 0x41, 0x42, 0x52, 0x49, 0x43, 0x33, 0x10, 0x96, 0x06, 0x12, 0x13, 0x0a, 0x0e, 0x4c,
-	0x49, 0x4e, 0x4b, 0x5f, 0x46, 0x43, 0x46, 0x41, 0x42, 0x52, 0x49, 0x43, 0x34, 0x10, 0x97, 0x06,
-	0x12, 0x13, 0x0a, 0x0e, 0x4c, 0x49, 0x4e, 0x4b, 0x5f, 0x46, 0x43, 0x46, 0x41, 0x42, 0x52, 0x49,
-	0x43, 0x35, 0x10, 0x98, 0x06, 0x12, 0x13, 0x0a, 0x0e, 0x4c, 0x49, 0x4e, 0x4b, 0x5f, 0x46, 0x43,
-	0x46, 0x41, 0x42, 0x52, 0x49, 0x43, 0x36, 0x10, 0x99, 0x06, 0x12, 0x13, 0x0a, 0x0e, 0x4c, 0x49,
-	0x4e, 0x4b, 0x5f, 0x46, 0x43, 0x46, 0x41, 0x42, 0x52, 0x49, 0x43, 0x37, 0x10, 0x9a, 0x06, 0x12,
+	0x4c, 0x45, 0x5f, 0x50, 0x52, 0x49, 0x56, 0x41, 0x43, 0x59, 0x10, 0x80, 0x10, 0x2a, 0x84, 0x01,
+	0x0a, 0x15, 0x4e, 0x65, 0x74, 0x68, 0x65, 0x6c, 0x70, 0x65, 0x72, 0x73, 0x41, 0x64, 0x64, 0x72,
+	0x47, 0x65, 0x6e, 0x4d, 0x6f, 0x64, 0x65, 0x12, 0x17, 0x0a, 0x13, 0x41, 0x44, 0x44, 0x52, 0x5f,
+	0x47, 0x45, 0x4e, 0x5f, 0x4d, 0x4f, 0x44, 0x45, 0x5f, 0x45, 0x55, 0x49, 0x36, 0x34, 0x10, 0x00,
+	0x12, 0x16, 0x0a, 0x12, 0x41, 0x44, 0x44, 0x52, 0x5f, 0x47, 0x45, 0x4e, 0x5f, 0x4d, 0x4f, 0x44,
+	0x45, 0x5f, 0x4e, 0x4f, 0x4e, 0x45, 0x10, 0x01, 0x12, 0x20, 0x0a, 0x1c, 0x41, 0x44, 0x44, 0x52,
+	0x5f, 0x47, 0x45, 0x4e, 0x5f, 0x4d, 0x4f, 0x44, 0x45, 0x5f, 0x53, 0x54, 0x41, 0x42, 0x4c, 0x45,
+	0x5f, 0x50, 0x52, 0x49, 0x56, 0x41, 0x43, 0x59, 0x10, 0x02, 0x12, 0x18, 0x0a, 0x14, 0x41, 0x44,
+	0x44, 0x52, 0x5f, 0x47, 0x45, 0x4e, 0x5f, 0x4d, 0x4f, 0x44, 0x45, 0x5f, 0x52, 0x41, 0x4e, 0x44,
+	0x4f, 0x4d, 0x10, 0x03, 0x2a, 0x58, 0x0a, 0x12, 0x4e, 0x65, 0x74, 0x68, 0x65, 0x6c, 0x70, 0x65,
+	0x72, 0x73, 0x41, 0x44, 0x53, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x12, 0x14, 0x0a, 0x10, 0x41, 0x44,
+	0x5f, 0x53, 0x45, 0x4c, 0x45, 0x43, 0x54, 0x5f, 0x53, 0x54, 0x41, 0x42, 0x4c, 0x45, 0x10, 0x00,
+	0x12, 0x17, 0x0a, 0x13, 0x41, 0x44, 0x5f, 0x53, 0x45, 0x4c, 0x45, 0x43, 0x54, 0x5f, 0x42, 0x41,
+	0x4e, 0x44, 0x57, 0x49, 0x44, 0x54, 0x48, 0x10, 0x01, 0x12, 0x13, 0x0a, 0x0f, 0x41, 0x44, 0x5f,
+	0x53, 0x45, 0x4c, 0x45, 0x43, 0x54, 0x5f, 0x43, 0x4f, 0x55, 0x4e, 0x54, 0x10, 0x02, 0x2a, 0x4b,
+	0x0a, 0x17, 0x4e, 0x65, 0x74, 0x68, 0x65, 0x6c, 0x70, 0x65, 0x72, 0x73, 0x41, 0x52, 0x50, 0x41,
+	0x6c, 0x6c, 0x54, 0x61, 0x72, 0x67, 0x65, 0x74, 0x73, 0x12, 0x17, 0x0a, 0x13, 0x41, 0x52, 0x50,
+	0x5f, 0x41, 0x4c, 0x4c, 0x5f, 0x54, 0x41, 0x52, 0x47, 0x45, 0x54, 0x53, 0x5f, 0x41, 0x4e, 0x59,
+	0x10, 0x00, 0x12, 0x17, 0x0a, 0x13, 0x41, 0x52, 0x50, 0x5f, 0x41, 0x4c, 0x4c, 0x5f, 0x54, 0x41,
+	0x52, 0x47, 0x45, 0x54, 0x53, 0x5f, 0x41, 0x4c, 0x4c, 0x10, 0x01, 0x2a, 0x76, 0x0a, 0x15, 0x4e,
+	0x65, 0x74, 0x68, 0x65, 0x6c, 0x70, 0x65, 0x72, 0x73, 0x41, 0x52, 0x50, 0x56, 0x61, 0x6c, 0x69,
+	0x64, 0x61, 0x74, 0x65, 0x12, 0x15, 0x0a, 0x11, 0x41, 0x52, 0x50, 0x5f, 0x56, 0x41, 0x4c, 0x49,
+	0x44, 0x41, 0x54, 0x45, 0x5f, 0x4e, 0x4f, 0x4e, 0x45, 0x10, 0x00, 0x12, 0x17, 0x0a, 0x13, 0x41,
+	0x52, 0x50, 0x5f, 0x56, 0x41, 0x4c, 0x49, 0x44, 0x41, 0x54, 0x45, 0x5f, 0x41, 0x43, 0x54, 0x49,
+	0x56, 0x45, 0x10, 0x01, 0x12, 0x17, 0x0a, 0x13, 0x41, 0x52, 0x50, 0x5f, 0x56, 0x41, 0x4c, 0x49,
+	0x44, 0x41, 0x54, 0x45, 0x5f, 0x42, 0x41, 0x43, 0x4b, 0x55, 0x50, 0x10, 0x02, 0x12, 0x14, 0x0a,
+	0x10, 0x41, 0x52, 0x50, 0x5f, 0x56, 0x41, 0x4c, 0x49, 0x44, 0x41, 0x54, 0x45, 0x5f, 0x41, 0x4c,
+	0x4c, 0x10, 0x03, 0x2a, 0xb3, 0x01, 0x0a, 0x12, 0x4e, 0x65, 0x74, 0x68, 0x65, 0x6c, 0x70, 0x65,
+	0x72, 0x73, 0x42, 0x6f, 0x6e, 0x64, 0x4d, 0x6f, 0x64, 0x65, 0x12, 0x18, 0x0a, 0x14, 0x42, 0x4f,
+	0x4e, 0x44, 0x5f, 0x4d, 0x4f, 0x44, 0x45, 0x5f, 0x52, 0x4f, 0x55, 0x4e, 0x44, 0x52, 0x4f, 0x42,
+	0x49, 0x4e, 0x10, 0x00, 0x12, 0x1b, 0x0a, 0x17, 0x42, 0x4f, 0x4e, 0x44, 0x5f, 0x4d, 0x4f, 0x44,
+	0x45, 0x5f, 0x41, 0x43, 0x54, 0x49, 0x56, 0x45, 0x5f, 0x42, 0x41, 0x43, 0x4b, 0x55, 0x50, 0x10,
+	0x01, 0x12, 0x11, 0x0a, 0x0d, 0x42, 0x4f, 0x4e, 0x44, 0x5f, 0x4d, 0x4f, 0x44, 0x45, 0x5f, 0x58,
+	0x4f, 0x52, 0x10, 0x02, 0x12, 0x17, 0x0a, 0x13, 0x42, 0x4f, 0x4e, 0x44, 0x5f, 0x4d, 0x4f, 0x44,
+	0x45, 0x5f, 0x42, 0x52, 0x4f, 0x41, 0x44, 0x43, 0x41, 0x53, 0x54, 0x10, 0x03, 0x12, 0x14, 0x0a,
+	0x10, 0x42, 0x4f, 0x4e, 0x44, 0x5f, 0x4d, 0x4f, 0x44, 0x45, 0x38, 0x30, 0x32, 0x33, 0x5f, 0x41,
+	0x44, 0x10, 0x04, 0x12, 0x11, 0x0a, 0x0d, 0x42, 0x4f, 0x4e, 0x44, 0x5f, 0x4d, 0x4f, 0x44, 0x45,
+	0x5f, 0x54, 0x4c, 0x42, 0x10, 0x05, 0x12, 0x11, 0x0a, 0x0d, 0x42, 0x4f, 0x4e, 0x44, 0x5f, 0x4d,
+	0x4f, 0x44, 0x45, 0x5f, 0x41, 0x4c, 0x42, 0x10, 0x06, 0x2a, 0xb3, 0x01, 0x0a, 0x1c, 0x4e, 0x65,
+	0x74, 0x68, 0x65, 0x6c, 0x70, 0x65, 0x72, 0x73, 0x42, 0x6f, 0x6e, 0x64, 0x58, 0x6d, 0x69, 0x74,
+	0x48, 0x61, 0x73, 0x68, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x12, 0x1b, 0x0a, 0x17, 0x42, 0x4f,
+	0x4e, 0x44, 0x5f, 0x58, 0x4d, 0x49, 0x54, 0x5f, 0x50, 0x4f, 0x4c, 0x49, 0x43, 0x59, 0x5f, 0x4c,
+	0x41, 0x59, 0x45, 0x52, 0x32, 0x10, 0x00, 0x12, 0x1c, 0x0a, 0x18, 0x42, 0x4f, 0x4e, 0x44, 0x5f,
+	0x58, 0x4d, 0x49, 0x54, 0x5f, 0x50, 0x4f, 0x4c, 0x49, 0x43, 0x59, 0x5f, 0x4c, 0x41, 0x59, 0x45,
+	0x52, 0x33, 0x34, 0x10, 0x01, 0x12, 0x1c, 0x0a, 0x18, 0x42, 0x4f, 0x4e, 0x44, 0x5f, 0x58, 0x4d,
+	0x49, 0x54, 0x5f, 0x50, 0x4f, 0x4c, 0x49, 0x43, 0x59, 0x5f, 0x4c, 0x41, 0x59, 0x45, 0x52, 0x32,
+	0x33, 0x10, 0x02, 0x12, 0x1c, 0x0a, 0x18, 0x42, 0x4f, 0x4e, 0x44, 0x5f, 0x58, 0x4d, 0x49, 0x54,
+	0x5f, 0x50, 0x4f, 0x4c, 0x49, 0x43, 0x59, 0x5f, 0x45, 0x4e, 0x43, 0x41, 0x50, 0x32, 0x33, 0x10,
+	0x03, 0x12, 0x1c, 0x0a, 0x18, 0x42, 0x4f, 0x4e, 0x44, 0x5f, 0x58, 0x4d, 0x49, 0x54, 0x5f, 0x50,
+	0x4f, 0x4c, 0x49, 0x43, 0x59, 0x5f, 0x45, 0x4e, 0x43, 0x41, 0x50, 0x33, 0x34, 0x10, 0x04, 0x2a,
+	0xb9, 0x01, 0x0a, 0x18, 0x4e, 0x65, 0x74, 0x68, 0x65, 0x6c, 0x70, 0x65, 0x72, 0x73, 0x43, 0x6f,
+	0x6e, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x6b, 0x53, 0x74, 0x61, 0x74, 0x65, 0x12, 0x29, 0x0a, 0x25,
+	0x4e, 0x45, 0x54, 0x48, 0x45, 0x4c, 0x50, 0x45, 0x52, 0x53, 0x5f, 0x43, 0x4f, 0x4e, 0x4e, 0x54,
+	0x52, 0x41, 0x43, 0x4b, 0x53, 0x54, 0x41, 0x54, 0x45, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43,
+	0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x17, 0x0a, 0x13, 0x43, 0x4f, 0x4e, 0x4e, 0x54,
+	0x52, 0x41, 0x43, 0x4b, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x45, 0x5f, 0x4e, 0x45, 0x57, 0x10, 0x08,
+	0x12, 0x1b, 0x0a, 0x17, 0x43, 0x4f, 0x4e, 0x4e, 0x54, 0x52, 0x41, 0x43, 0x4b, 0x5f, 0x53, 0x54,
+	0x41, 0x54, 0x45, 0x5f, 0x52, 0x45, 0x4c, 0x41, 0x54, 0x45, 0x44, 0x10, 0x04, 0x12, 0x1f, 0x0a,
+	0x1b, 0x43, 0x4f, 0x4e, 0x4e, 0x54, 0x52, 0x41, 0x43, 0x4b, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x45,
+	0x5f, 0x45, 0x53, 0x54, 0x41, 0x42, 0x4c, 0x49, 0x53, 0x48, 0x45, 0x44, 0x10, 0x02, 0x12, 0x1b,
+	0x0a, 0x17, 0x43, 0x4f, 0x4e, 0x4e, 0x54, 0x52, 0x41, 0x43, 0x4b, 0x5f, 0x53, 0x54, 0x41, 0x54,
+	0x45, 0x5f, 0x49, 0x4e, 0x56, 0x41, 0x4c, 0x49, 0x44, 0x10, 0x01, 0x2a, 0x4e, 0x0a, 0x17, 0x4e,
+	0x65, 0x74, 0x68, 0x65, 0x6c, 0x70, 0x65, 0x72, 0x73, 0x44, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74,
+	0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x19, 0x0a, 0x15, 0x44, 0x45, 0x46, 0x41, 0x55, 0x4c,
+	0x54, 0x5f, 0x41, 0x43, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x41, 0x43, 0x43, 0x45, 0x50, 0x54, 0x10,
+	0x00, 0x12, 0x18, 0x0a, 0x14, 0x44, 0x45, 0x46, 0x41, 0x55, 0x4c, 0x54, 0x5f, 0x41, 0x43, 0x54,
+	0x49, 0x4f, 0x4e, 0x5f, 0x42, 0x4c, 0x4f, 0x43, 0x4b, 0x10, 0x01, 0x2a, 0x34, 0x0a, 0x10, 0x4e,
+	0x65, 0x74, 0x68, 0x65, 0x6c, 0x70, 0x65, 0x72, 0x73, 0x44, 0x75, 0x70, 0x6c, 0x65, 0x78, 0x12,
+	0x08, 0x0a, 0x04, 0x48, 0x41, 0x4c, 0x46, 0x10, 0x00, 0x12, 0x08, 0x0a, 0x04, 0x46, 0x55, 0x4c,
+	0x4c, 0x10, 0x01, 0x12, 0x0c, 0x0a, 0x07, 0x55, 0x4e, 0x4b, 0x4e, 0x4f, 0x57, 0x4e, 0x10, 0xff,
+	0x01, 0x2a, 0x63, 0x0a, 0x15, 0x4e, 0x65, 0x74, 0x68, 0x65, 0x6c, 0x70, 0x65, 0x72, 0x73, 0x46,
+	0x61, 0x69, 0x6c, 0x4f, 0x76, 0x65, 0x72, 0x4d, 0x41, 0x43, 0x12, 0x16, 0x0a, 0x12, 0x46, 0x41,
+	0x49, 0x4c, 0x5f, 0x4f, 0x56, 0x45, 0x52, 0x5f, 0x4d, 0x41, 0x43, 0x5f, 0x4e, 0x4f, 0x4e, 0x45,
+	0x10, 0x00, 0x12, 0x18, 0x0a, 0x14, 0x46, 0x41, 0x49, 0x4c, 0x5f, 0x4f, 0x56, 0x45, 0x52, 0x5f,
+	0x4d, 0x41, 0x43, 0x5f, 0x41, 0x43, 0x54, 0x49, 0x56, 0x45, 0x10, 0x01, 0x12, 0x18, 0x0a, 0x14,
+	0x46, 0x41, 0x49, 0x4c, 0x5f, 0x4f, 0x56, 0x45, 0x52, 0x5f, 0x4d, 0x41, 0x43, 0x5f, 0x46, 0x4f,
+	0x4c, 0x4c, 0x4f, 0x57, 0x10, 0x02, 0x2a, 0x59, 0x0a, 0x10, 0x4e, 0x65, 0x74, 0x68, 0x65, 0x6c,
+	0x70, 0x65, 0x72, 0x73, 0x46, 0x61, 0x6d, 0x69, 0x6c, 0x79, 0x12, 0x21, 0x0a, 0x1d, 0x4e, 0x45,
+	0x54, 0x48, 0x45, 0x4c, 0x50, 0x45, 0x52, 0x53, 0x5f, 0x46, 0x41, 0x4d, 0x49, 0x4c, 0x59, 0x5f,
+	0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x10, 0x0a,
+	0x0c, 0x46, 0x41, 0x4d, 0x49, 0x4c, 0x59, 0x5f, 0x49, 0x4e, 0x45, 0x54, 0x34, 0x10, 0x02, 0x12,
+	0x10, 0x0a, 0x0c, 0x46, 0x41, 0x4d, 0x49, 0x4c, 0x59, 0x5f, 0x49, 0x4e, 0x45, 0x54, 0x36, 0x10,
+	0x0a, 0x2a, 0x54, 0x0a, 0x14, 0x4e, 0x65, 0x74, 0x68, 0x65, 0x6c, 0x70, 0x65, 0x72, 0x73, 0x49,
+	0x50, 0x56, 0x4c, 0x41, 0x4e, 0x4d, 0x6f, 0x64, 0x65, 0x12, 0x12, 0x0a, 0x0e, 0x49, 0x50, 0x56,
+	0x4c, 0x41, 0x4e, 0x5f, 0x4d, 0x4f, 0x44, 0x45, 0x5f, 0x4c, 0x32, 0x10, 0x00, 0x12, 0x12, 0x0a,
+	0x0e, 0x49, 0x50, 0x56, 0x4c, 0x41, 0x4e, 0x5f, 0x4d, 0x4f, 0x44, 0x45, 0x5f, 0x4c, 0x33, 0x10,
+	0x01, 0x12, 0x14, 0x0a, 0x10, 0x49, 0x50, 0x56, 0x4c, 0x41, 0x4e, 0x5f, 0x4d, 0x4f, 0x44, 0x45,
+	0x5f, 0x4c, 0x33, 0x5f, 0x53, 0x10, 0x02, 0x2a, 0x3c, 0x0a, 0x12, 0x4e, 0x65, 0x74, 0x68, 0x65,
+	0x6c, 0x70, 0x65, 0x72, 0x73, 0x4c, 0x41, 0x43, 0x50, 0x52, 0x61, 0x74, 0x65, 0x12, 0x12, 0x0a,
+	0x0e, 0x4c, 0x41, 0x43, 0x50, 0x5f, 0x52, 0x41, 0x54, 0x45, 0x5f, 0x53, 0x4c, 0x4f, 0x57, 0x10,
+	0x00, 0x12, 0x12, 0x0a, 0x0e, 0x4c, 0x41, 0x43, 0x50, 0x5f, 0x52, 0x41, 0x54, 0x45, 0x5f, 0x46,
+	0x41, 0x53, 0x54, 0x10, 0x01, 0x2a, 0x93, 0x0b, 0x0a, 0x12, 0x4e, 0x65, 0x74, 0x68, 0x65, 0x6c,
+	0x70, 0x65, 0x72, 0x73, 0x4c, 0x69, 0x6e, 0x6b, 0x54, 0x79, 0x70, 0x65, 0x12, 0x0f, 0x0a, 0x0b,
+	0x4c, 0x49, 0x4e, 0x4b, 0x5f, 0x4e, 0x45, 0x54, 0x52, 0x4f, 0x4d, 0x10, 0x00, 0x12, 0x0e, 0x0a,
+	0x0a, 0x4c, 0x49, 0x4e, 0x4b, 0x5f, 0x45, 0x54, 0x48, 0x45, 0x52, 0x10, 0x01, 0x12, 0x0f, 0x0a,
+	0x0b, 0x4c, 0x49, 0x4e, 0x4b, 0x5f, 0x45, 0x45, 0x54, 0x48, 0x45, 0x52, 0x10, 0x02, 0x12, 0x0d,
+	0x0a, 0x09, 0x4c, 0x49, 0x4e, 0x4b, 0x5f, 0x41, 0x58, 0x32, 0x35, 0x10, 0x03, 0x12, 0x0f, 0x0a,
+	0x0b, 0x4c, 0x49, 0x4e, 0x4b, 0x5f, 0x50, 0x52, 0x4f, 0x4e, 0x45, 0x54, 0x10, 0x04, 0x12, 0x0e,
+	0x0a, 0x0a, 0x4c, 0x49, 0x4e, 0x4b, 0x5f, 0x43, 0x48, 0x41, 0x4f, 0x53, 0x10, 0x05, 0x12, 0x0f,
+	0x0a, 0x0b, 0x4c, 0x49, 0x4e, 0x4b, 0x5f, 0x49, 0x45, 0x45, 0x38, 0x30, 0x32, 0x10, 0x06, 0x12,
+	0x0f, 0x0a, 0x0b, 0x4c, 0x49, 0x4e, 0x4b, 0x5f, 0x41, 0x52, 0x43, 0x4e, 0x45, 0x54, 0x10, 0x07,
+	0x12, 0x0e, 0x0a, 0x0a, 0x4c, 0x49, 0x4e, 0x4b, 0x5f, 0x41, 0x54, 0x41, 0x4c, 0x4b, 0x10, 0x08,
+	0x12, 0x0d, 0x0a, 0x09, 0x4c, 0x49, 0x4e, 0x4b, 0x5f, 0x44, 0x4c, 0x43, 0x49, 0x10, 0x0f, 0x12,
+	0x0c, 0x0a, 0x08, 0x4c, 0x49, 0x4e, 0x4b, 0x5f, 0x41, 0x54, 0x4d, 0x10, 0x13, 0x12, 0x11, 0x0a,
+	0x0d, 0x4c, 0x49, 0x4e, 0x4b, 0x5f, 0x4d, 0x45, 0x54, 0x52, 0x49, 0x43, 0x4f, 0x4d, 0x10, 0x17,
+	0x12, 0x11, 0x0a, 0x0d, 0x4c, 0x49, 0x4e, 0x4b, 0x5f, 0x49, 0x45, 0x45, 0x45, 0x31, 0x33, 0x39,
+	0x34, 0x10, 0x18, 0x12, 0x0e, 0x0a, 0x0a, 0x4c, 0x49, 0x4e, 0x4b, 0x5f, 0x45, 0x55, 0x49, 0x36,
+	0x34, 0x10, 0x1b, 0x12, 0x13, 0x0a, 0x0f, 0x4c, 0x49, 0x4e, 0x4b, 0x5f, 0x49, 0x4e, 0x46, 0x49,
+	0x4e, 0x49, 0x42, 0x41, 0x4e, 0x44, 0x10, 0x20, 0x12, 0x0e, 0x0a, 0x09, 0x4c, 0x49, 0x4e, 0x4b,
+	0x5f, 0x53, 0x4c, 0x49, 0x50, 0x10, 0x80, 0x02, 0x12, 0x0f, 0x0a, 0x0a, 0x4c, 0x49, 0x4e, 0x4b,
+	0x5f, 0x43, 0x53, 0x4c, 0x49, 0x50, 0x10, 0x81, 0x02, 0x12, 0x0f, 0x0a, 0x0a, 0x4c, 0x49, 0x4e,
+	0x4b, 0x5f, 0x53, 0x4c, 0x49, 0x50, 0x36, 0x10, 0x82, 0x02, 0x12, 0x10, 0x0a, 0x0b, 0x4c, 0x49,
+	0x4e, 0x4b, 0x5f, 0x43, 0x53, 0x4c, 0x49, 0x50, 0x36, 0x10, 0x83, 0x02, 0x12, 0x0f, 0x0a, 0x0a,
+	0x4c, 0x49, 0x4e, 0x4b, 0x5f, 0x52, 0x53, 0x52, 0x56, 0x44, 0x10, 0x84, 0x02, 0x12, 0x0f, 0x0a,
+	0x0a, 0x4c, 0x49, 0x4e, 0x4b, 0x5f, 0x41, 0x44, 0x41, 0x50, 0x54, 0x10, 0x88, 0x02, 0x12, 0x0e,
+	0x0a, 0x09, 0x4c, 0x49, 0x4e, 0x4b, 0x5f, 0x52, 0x4f, 0x53, 0x45, 0x10, 0x8e, 0x02, 0x12, 0x0d,
+	0x0a, 0x08, 0x4c, 0x49, 0x4e, 0x4b, 0x5f, 0x58, 0x32, 0x35, 0x10, 0x8f, 0x02, 0x12, 0x0f, 0x0a,
+	0x0a, 0x4c, 0x49, 0x4e, 0x4b, 0x5f, 0x48, 0x57, 0x58, 0x32, 0x35, 0x10, 0x90, 0x02, 0x12, 0x0d,
+	0x0a, 0x08, 0x4c, 0x49, 0x4e, 0x4b, 0x5f, 0x43, 0x41, 0x4e, 0x10, 0x98, 0x02, 0x12, 0x0d, 0x0a,
+	0x08, 0x4c, 0x49, 0x4e, 0x4b, 0x5f, 0x50, 0x50, 0x50, 0x10, 0x80, 0x04, 0x12, 0x0f, 0x0a, 0x0a,
+	0x4c, 0x49, 0x4e, 0x4b, 0x5f, 0x43, 0x49, 0x53, 0x43, 0x4f, 0x10, 0x81, 0x04, 0x12, 0x0e, 0x0a,
+	0x09, 0x4c, 0x49, 0x4e, 0x4b, 0x5f, 0x48, 0x44, 0x4c, 0x43, 0x10, 0x81, 0x04, 0x12, 0x0e, 0x0a,
+	0x09, 0x4c, 0x49, 0x4e, 0x4b, 0x5f, 0x4c, 0x41, 0x50, 0x42, 0x10, 0x84, 0x04, 0x12, 0x0f, 0x0a,
+	0x0a, 0x4c, 0x49, 0x4e, 0x4b, 0x5f, 0x44, 0x44, 0x43, 0x4d, 0x50, 0x10, 0x85, 0x04, 0x12, 0x11,
+	0x0a, 0x0c, 0x4c, 0x49, 0x4e, 0x4b, 0x5f, 0x52, 0x41, 0x57, 0x48, 0x44, 0x4c, 0x43, 0x10, 0x86,
+	0x04, 0x12, 0x10, 0x0a, 0x0b, 0x4c, 0x49, 0x4e, 0x4b, 0x5f, 0x54, 0x55, 0x4e, 0x4e, 0x45, 0x4c,
+	0x10, 0x80, 0x06, 0x12, 0x11, 0x0a, 0x0c, 0x4c, 0x49, 0x4e, 0x4b, 0x5f, 0x54, 0x55, 0x4e, 0x4e,
+	0x45, 0x4c, 0x36, 0x10, 0x81, 0x06, 0x12, 0x0e, 0x0a, 0x09, 0x4c, 0x49, 0x4e, 0x4b, 0x5f, 0x46,
+	0x52, 0x41, 0x44, 0x10, 0x82, 0x06, 0x12, 0x0e, 0x0a, 0x09, 0x4c, 0x49, 0x4e, 0x4b, 0x5f, 0x53,
+	0x4b, 0x49, 0x50, 0x10, 0x83, 0x06, 0x12, 0x11, 0x0a, 0x0c, 0x4c, 0x49, 0x4e, 0x4b, 0x5f, 0x4c,
+	0x4f, 0x4f, 0x50, 0x42, 0x43, 0x4b, 0x10, 0x84, 0x06, 0x12, 0x12, 0x0a, 0x0d, 0x4c, 0x49, 0x4e,
+	0x4b, 0x5f, 0x4c, 0x4f, 0x43, 0x41, 0x4c, 0x54, 0x4c, 0x4b, 0x10, 0x85, 0x06, 0x12, 0x0e, 0x0a,
+	0x09, 0x4c, 0x49, 0x4e, 0x4b, 0x5f, 0x46, 0x44, 0x44, 0x49, 0x10, 0x86, 0x06, 0x12, 0x0d, 0x0a,
+	0x08, 0x4c, 0x49, 0x4e, 0x4b, 0x5f, 0x42, 0x49, 0x46, 0x10, 0x87, 0x06, 0x12, 0x0d, 0x0a, 0x08,
+	0x4c, 0x49, 0x4e, 0x4b, 0x5f, 0x53, 0x49, 0x54, 0x10, 0x88, 0x06, 0x12, 0x0f, 0x0a, 0x0a, 0x4c,
+	0x49, 0x4e, 0x4b, 0x5f, 0x49, 0x50, 0x44, 0x44, 0x50, 0x10, 0x89, 0x06, 0x12, 0x0f, 0x0a, 0x0a,
+	0x4c, 0x49, 0x4e, 0x4b, 0x5f, 0x49, 0x50, 0x47, 0x52, 0x45, 0x10, 0x8a, 0x06, 0x12, 0x10, 0x0a,
+	0x0b, 0x4c, 0x49, 0x4e, 0x4b, 0x5f, 0x50, 0x49, 0x4d, 0x52, 0x45, 0x47, 0x10, 0x8b, 0x06, 0x12,
+	0x0f, 0x0a, 0x0a, 0x4c, 0x49, 0x4e, 0x4b, 0x5f, 0x48, 0x49, 0x50, 0x50, 0x49, 0x10, 0x8c, 0x06,
+	0x12, 0x0d, 0x0a, 0x08, 0x4c, 0x49, 0x4e, 0x4b, 0x5f, 0x41, 0x53, 0x48, 0x10, 0x8d, 0x06, 0x12,
+	0x10, 0x0a, 0x0b, 0x4c, 0x49, 0x4e, 0x4b, 0x5f, 0x45, 0x43, 0x4f, 0x4e, 0x45, 0x54, 0x10, 0x8e,
+	0x06, 0x12, 0x0e, 0x0a, 0x09, 0x4c, 0x49, 0x4e, 0x4b, 0x5f, 0x49, 0x52, 0x44, 0x41, 0x10, 0x8f,
+	0x06, 0x12, 0x0e, 0x0a, 0x09, 0x4c, 0x49, 0x4e, 0x4b, 0x5f, 0x46, 0x43, 0x50, 0x50, 0x10, 0x90,
+	0x06, 0x12, 0x0e, 0x0a, 0x09, 0x4c, 0x49, 0x4e, 0x4b, 0x5f, 0x46, 0x43, 0x41, 0x4c, 0x10, 0x91,
+	0x06, 0x12, 0x0e, 0x0a, 0x09, 0x4c, 0x49, 0x4e, 0x4b, 0x5f, 0x46, 0x43, 0x50, 0x4c, 0x10, 0x92,
+	0x06, 0x12, 0x12, 0x0a, 0x0d, 0x4c, 0x49, 0x4e, 0x4b, 0x5f, 0x46, 0x43, 0x46, 0x41, 0x42, 0x52,
+	0x49, 0x43, 0x10, 0x93, 0x06, 0x12, 0x13, 0x0a, 0x0e, 0x4c, 0x49, 0x4e, 0x4b, 0x5f, 0x46, 0x43,
+	0x46, 0x41, 0x42, 0x52, 0x49, 0x43, 0x31, 0x10, 0x94, 0x06, 0x12, 0x13, 0x0a, 0x0e, 0x4c, 0x49,
+	0x4e, 0x4b, 0x5f, 0x46, 0x43, 0x46, 0x41, 0x42, 0x52, 0x49, 0x43, 0x32, 0x10, 0x95, 0x06, 0x12,
 	0x13, 0x0a, 0x0e, 0x4c, 0x49, 0x4e, 0x4b, 0x5f, 0x46, 0x43, 0x46, 0x41, 0x42, 0x52, 0x49, 0x43,
-	0x38, 0x10, 0x9b, 0x06, 0x12, 0x13, 0x0a, 0x0e, 0x4c, 0x49, 0x4e, 0x4b, 0x5f, 0x46, 0x43, 0x46,
-	0x41, 0x42, 0x52, 0x49, 0x43, 0x39, 0x10, 0x9c, 0x06, 0x12, 0x14, 0x0a, 0x0f, 0x4c, 0x49, 0x4e,
-	0x4b, 0x5f, 0x46, 0x43, 0x46, 0x41, 0x42, 0x52, 0x49, 0x43, 0x31, 0x30, 0x10, 0x9d, 0x06, 0x12,
-	0x14, 0x0a, 0x0f, 0x4c, 0x49, 0x4e, 0x4b, 0x5f, 0x46, 0x43, 0x46, 0x41, 0x42, 0x52, 0x49, 0x43,
-	0x31, 0x31, 0x10, 0x9e, 0x06, 0x12, 0x14, 0x0a, 0x0f, 0x4c, 0x49, 0x4e, 0x4b, 0x5f, 0x46, 0x43,
-	0x46, 0x41, 0x42, 0x52, 0x49, 0x43, 0x31, 0x32, 0x10, 0x9f, 0x06, 0x12, 0x12, 0x0a, 0x0d, 0x4c,
-	0x49, 0x4e, 0x4b, 0x5f, 0x49, 0x45, 0x45, 0x38, 0x30, 0x32, 0x54, 0x52, 0x10, 0xa0, 0x06, 0x12,
-	0x12, 0x0a, 0x0d, 0x4c, 0x49, 0x4e, 0x4b, 0x5f, 0x49, 0x45, 0x45, 0x38, 0x30, 0x32, 0x31, 0x31,
-	0x10, 0xa1, 0x06, 0x12, 0x17, 0x0a, 0x12, 0x4c, 0x49, 0x4e, 0x4b, 0x5f, 0x49, 0x45, 0x45, 0x38,
-	0x30, 0x32, 0x31, 0x31, 0x50, 0x52, 0x49, 0x53, 0x4d, 0x10, 0xa2, 0x06, 0x12, 0x1b, 0x0a, 0x16,
-	0x4c, 0x49, 0x4e, 0x4b, 0x5f, 0x49, 0x45, 0x45, 0x38, 0x30, 0x32, 0x31, 0x31, 0x5f, 0x52, 0x41,
-	0x44, 0x49, 0x4f, 0x54, 0x41, 0x50, 0x10, 0xa3, 0x06, 0x12, 0x14, 0x0a, 0x0f, 0x4c, 0x49, 0x4e,
-	0x4b, 0x5f, 0x49, 0x45, 0x45, 0x38, 0x30, 0x32, 0x31, 0x31, 0x35, 0x34, 0x10, 0xa4, 0x06, 0x12,
-	0x1b, 0x0a, 0x16, 0x4c, 0x49, 0x4e, 0x4b, 0x5f, 0x49, 0x45, 0x45, 0x38, 0x30, 0x32, 0x31, 0x31,
-	0x35, 0x34, 0x4d, 0x4f, 0x4e, 0x49, 0x54, 0x4f, 0x52, 0x10, 0xa5, 0x06, 0x12, 0x10, 0x0a, 0x0b,
-	0x4c, 0x49, 0x4e, 0x4b, 0x5f, 0x50, 0x48, 0x4f, 0x4e, 0x45, 0x54, 0x10, 0xb4, 0x06, 0x12, 0x14,
-	0x0a, 0x0f, 0x4c, 0x49, 0x4e, 0x4b, 0x5f, 0x50, 0x48, 0x4f, 0x4e, 0x45, 0x54, 0x50, 0x49, 0x50,
-	0x45, 0x10, 0xb5, 0x06, 0x12, 0x0e, 0x0a, 0x09, 0x4c, 0x49, 0x4e, 0x4b, 0x5f, 0x43, 0x41, 0x49,
-	0x46, 0x10, 0xb6, 0x06, 0x12, 0x10, 0x0a, 0x0b, 0x4c, 0x49, 0x4e, 0x4b, 0x5f, 0x49, 0x50, 0x36,
-	0x47, 0x52, 0x45, 0x10, 0xb7, 0x06, 0x12, 0x11, 0x0a, 0x0c, 0x4c, 0x49, 0x4e, 0x4b, 0x5f, 0x4e,
-	0x45, 0x54, 0x4c, 0x49, 0x4e, 0x4b, 0x10, 0xb8, 0x06, 0x12, 0x11, 0x0a, 0x0c, 0x4c, 0x49, 0x4e,
-	0x4b, 0x36, 0x5f, 0x4c, 0x4f, 0x57, 0x50, 0x41, 0x4e, 0x10, 0xb9, 0x06, 0x12, 0x0f, 0x0a, 0x09,
-	0x4c, 0x49, 0x4e, 0x4b, 0x5f, 0x56, 0x4f, 0x49, 0x44, 0x10, 0xff, 0xff, 0x03, 0x12, 0x0f, 0x0a,
-	0x09, 0x4c, 0x49, 0x4e, 0x4b, 0x5f, 0x4e, 0x4f, 0x4e, 0x45, 0x10, 0xfe, 0xff, 0x03, 0x1a, 0x02,
-	0x10, 0x01, 0x2a, 0xc9, 0x01, 0x0a, 0x1a, 0x4e, 0x65, 0x74, 0x68, 0x65, 0x6c, 0x70, 0x65, 0x72,
-	0x73, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x61, 0x6c, 0x53, 0x74, 0x61, 0x74,
-	0x65, 0x12, 0x16, 0x0a, 0x12, 0x4f, 0x50, 0x45, 0x52, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x45, 0x5f,
-	0x55, 0x4e, 0x4b, 0x4e, 0x4f, 0x57, 0x4e, 0x10, 0x00, 0x12, 0x1a, 0x0a, 0x16, 0x4f, 0x50, 0x45,
-	0x52, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x45, 0x5f, 0x4e, 0x4f, 0x54, 0x5f, 0x50, 0x52, 0x45, 0x53,
-	0x45, 0x4e, 0x54, 0x10, 0x01, 0x12, 0x13, 0x0a, 0x0f, 0x4f, 0x50, 0x45, 0x52, 0x5f, 0x53, 0x54,
-	0x41, 0x54, 0x45, 0x5f, 0x44, 0x4f, 0x57, 0x4e, 0x10, 0x02, 0x12, 0x1f, 0x0a, 0x1b, 0x4f, 0x50,
-	0x45, 0x52, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x45, 0x5f, 0x4c, 0x4f, 0x57, 0x45, 0x52, 0x5f, 0x4c,
-	0x41, 0x59, 0x45, 0x52, 0x5f, 0x44, 0x4f, 0x57, 0x4e, 0x10, 0x03, 0x12, 0x16, 0x0a, 0x12, 0x4f,
-	0x50, 0x45, 0x52, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x45, 0x5f, 0x54, 0x45, 0x53, 0x54, 0x49, 0x4e,
-	0x47, 0x10, 0x04, 0x12, 0x16, 0x0a, 0x12, 0x4f, 0x50, 0x45, 0x52, 0x5f, 0x53, 0x54, 0x41, 0x54,
-	0x45, 0x5f, 0x44, 0x4f, 0x52, 0x4d, 0x41, 0x4e, 0x54, 0x10, 0x05, 0x12, 0x11, 0x0a, 0x0d, 0x4f,
-	0x50, 0x45, 0x52, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x45, 0x5f, 0x55, 0x50, 0x10, 0x06, 0x2a, 0x72,
-	0x0a, 0x0e, 0x4e, 0x65, 0x74, 0x68, 0x65, 0x6c, 0x70, 0x65, 0x72, 0x73, 0x50, 0x6f, 0x72, 0x74,
-	0x12, 0x10, 0x0a, 0x0c, 0x54, 0x57, 0x49, 0x53, 0x54, 0x45, 0x44, 0x5f, 0x50, 0x41, 0x49, 0x52,
-	0x10, 0x00, 0x12, 0x07, 0x0a, 0x03, 0x41, 0x55, 0x49, 0x10, 0x01, 0x12, 0x07, 0x0a, 0x03, 0x4d,
-	0x49, 0x49, 0x10, 0x02, 0x12, 0x09, 0x0a, 0x05, 0x46, 0x49, 0x42, 0x52, 0x45, 0x10, 0x03, 0x12,
-	0x07, 0x0a, 0x03, 0x42, 0x4e, 0x43, 0x10, 0x04, 0x12, 0x11, 0x0a, 0x0d, 0x44, 0x49, 0x52, 0x45,
-	0x43, 0x54, 0x5f, 0x41, 0x54, 0x54, 0x41, 0x43, 0x48, 0x10, 0x05, 0x12, 0x09, 0x0a, 0x04, 0x4e,
-	0x4f, 0x4e, 0x45, 0x10, 0xef, 0x01, 0x12, 0x0a, 0x0a, 0x05, 0x4f, 0x54, 0x48, 0x45, 0x52, 0x10,
-	0xff, 0x01, 0x2a, 0x73, 0x0a, 0x19, 0x4e, 0x65, 0x74, 0x68, 0x65, 0x6c, 0x70, 0x65, 0x72, 0x73,
-	0x50, 0x72, 0x69, 0x6d, 0x61, 0x72, 0x79, 0x52, 0x65, 0x73, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x12,
+	0x33, 0x10, 0x96, 0x06, 0x12, 0x13, 0x0a, 0x0e, 0x4c, 0x49, 0x4e, 0x4b, 0x5f, 0x46, 0x43, 0x46,
+	0x41, 0x42, 0x52, 0x49, 0x43, 0x34, 0x10, 0x97, 0x06, 0x12, 0x13, 0x0a, 0x0e, 0x4c, 0x49, 0x4e,
+	0x4b, 0x5f, 0x46, 0x43, 0x46, 0x41, 0x42, 0x52, 0x49, 0x43, 0x35, 0x10, 0x98, 0x06, 0x12, 0x13,
+	0x0a, 0x0e, 0x4c, 0x49, 0x4e, 0x4b, 0x5f, 0x46, 0x43, 0x46, 0x41, 0x42, 0x52, 0x49, 0x43, 0x36,
+	0x10, 0x99, 0x06, 0x12, 0x13, 0x0a, 0x0e, 0x4c, 0x49, 0x4e, 0x4b, 0x5f, 0x46, 0x43, 0x46, 0x41,
+	0x42, 0x52, 0x49, 0x43, 0x37, 0x10, 0x9a, 0x06, 0x12, 0x13, 0x0a, 0x0e, 0x4c, 0x49, 0x4e, 0x4b,
+	0x5f, 0x46, 0x43, 0x46, 0x41, 0x42, 0x52, 0x49, 0x43, 0x38, 0x10, 0x9b, 0x06, 0x12, 0x13, 0x0a,
+	0x0e, 0x4c, 0x49, 0x4e, 0x4b, 0x5f, 0x46, 0x43, 0x46, 0x41, 0x42, 0x52, 0x49, 0x43, 0x39, 0x10,
+	0x9c, 0x06, 0x12, 0x14, 0x0a, 0x0f, 0x4c, 0x49, 0x4e, 0x4b, 0x5f, 0x46, 0x43, 0x46, 0x41, 0x42,
+	0x52, 0x49, 0x43, 0x31, 0x30, 0x10, 0x9d, 0x06, 0x12, 0x14, 0x0a, 0x0f, 0x4c, 0x49, 0x4e, 0x4b,
+	0x5f, 0x46, 0x43, 0x46, 0x41, 0x42, 0x52, 0x49, 0x43, 0x31, 0x31, 0x10, 0x9e, 0x06, 0x12, 0x14,
+	0x0a, 0x0f, 0x4c, 0x49, 0x4e, 0x4b, 0x5f, 0x46, 0x43, 0x46, 0x41, 0x42, 0x52, 0x49, 0x43, 0x31,
+	0x32, 0x10, 0x9f, 0x06, 0x12, 0x12, 0x0a, 0x0d, 0x4c, 0x49, 0x4e, 0x4b, 0x5f, 0x49, 0x45, 0x45,
+	0x38, 0x30, 0x32, 0x54, 0x52, 0x10, 0xa0, 0x06, 0x12, 0x12, 0x0a, 0x0d, 0x4c, 0x49, 0x4e, 0x4b,
+	0x5f, 0x49, 0x45, 0x45, 0x38, 0x30, 0x32, 0x31, 0x31, 0x10, 0xa1, 0x06, 0x12, 0x17, 0x0a, 0x12,
+	0x4c, 0x49, 0x4e, 0x4b, 0x5f, 0x49, 0x45, 0x45, 0x38, 0x30, 0x32, 0x31, 0x31, 0x50, 0x52, 0x49,
+	0x53, 0x4d, 0x10, 0xa2, 0x06, 0x12, 0x1b, 0x0a, 0x16, 0x4c, 0x49, 0x4e, 0x4b, 0x5f, 0x49, 0x45,
+	0x45, 0x38, 0x30, 0x32, 0x31, 0x31, 0x5f, 0x52, 0x41, 0x44, 0x49, 0x4f, 0x54, 0x41, 0x50, 0x10,
+	0xa3, 0x06, 0x12, 0x14, 0x0a, 0x0f, 0x4c, 0x49, 0x4e, 0x4b, 0x5f, 0x49, 0x45, 0x45, 0x38, 0x30,
+	0x32, 0x31, 0x31, 0x35, 0x34, 0x10, 0xa4, 0x06, 0x12, 0x1b, 0x0a, 0x16, 0x4c, 0x49, 0x4e, 0x4b,
+	0x5f, 0x49, 0x45, 0x45, 0x38, 0x30, 0x32, 0x31, 0x31, 0x35, 0x34, 0x4d, 0x4f, 0x4e, 0x49, 0x54,
+	0x4f, 0x52, 0x10, 0xa5, 0x06, 0x12, 0x10, 0x0a, 0x0b, 0x4c, 0x49, 0x4e, 0x4b, 0x5f, 0x50, 0x48,
+	0x4f, 0x4e, 0x45, 0x54, 0x10, 0xb4, 0x06, 0x12, 0x14, 0x0a, 0x0f, 0x4c, 0x49, 0x4e, 0x4b, 0x5f,
+	0x50, 0x48, 0x4f, 0x4e, 0x45, 0x54, 0x50, 0x49, 0x50, 0x45, 0x10, 0xb5, 0x06, 0x12, 0x0e, 0x0a,
+	0x09, 0x4c, 0x49, 0x4e, 0x4b, 0x5f, 0x43, 0x41, 0x49, 0x46, 0x10, 0xb6, 0x06, 0x12, 0x10, 0x0a,
+	0x0b, 0x4c, 0x49, 0x4e, 0x4b, 0x5f, 0x49, 0x50, 0x36, 0x47, 0x52, 0x45, 0x10, 0xb7, 0x06, 0x12,
+	0x11, 0x0a, 0x0c, 0x4c, 0x49, 0x4e, 0x4b, 0x5f, 0x4e, 0x45, 0x54, 0x4c, 0x49, 0x4e, 0x4b, 0x10,
+	0xb8, 0x06, 0x12, 0x11, 0x0a, 0x0c, 0x4c, 0x49, 0x4e, 0x4b, 0x36, 0x5f, 0x4c, 0x4f, 0x57, 0x50,
+	0x41, 0x4e, 0x10, 0xb9, 0x06, 0x12, 0x0f, 0x0a, 0x09, 0x4c, 0x49, 0x4e, 0x4b, 0x5f, 0x56, 0x4f,
+	0x49, 0x44, 0x10, 0xff, 0xff, 0x03, 0x12, 0x0f, 0x0a, 0x09, 0x4c, 0x49, 0x4e, 0x4b, 0x5f, 0x4e,
+	0x4f, 0x4e, 0x45, 0x10, 0xfe, 0xff, 0x03, 0x1a, 0x02, 0x10, 0x01, 0x2a, 0xbd, 0x01, 0x0a, 0x15,
+	0x4e, 0x65, 0x74, 0x68, 0x65, 0x6c, 0x70, 0x65, 0x72, 0x73, 0x4d, 0x41, 0x43, 0x56, 0x4c, 0x41,
+	0x4e, 0x4d, 0x6f, 0x64, 0x65, 0x12, 0x26, 0x0a, 0x22, 0x4e, 0x45, 0x54, 0x48, 0x45, 0x4c, 0x50,
+	0x45, 0x52, 0x53, 0x5f, 0x4d, 0x41, 0x43, 0x56, 0x4c, 0x41, 0x4e, 0x4d, 0x4f, 0x44, 0x45, 0x5f,
+	0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x18, 0x0a,
+	0x14, 0x4d, 0x41, 0x43, 0x56, 0x4c, 0x41, 0x4e, 0x5f, 0x4d, 0x4f, 0x44, 0x45, 0x5f, 0x50, 0x52,
+	0x49, 0x56, 0x41, 0x54, 0x45, 0x10, 0x01, 0x12, 0x15, 0x0a, 0x11, 0x4d, 0x41, 0x43, 0x56, 0x4c,
+	0x41, 0x4e, 0x5f, 0x4d, 0x4f, 0x44, 0x45, 0x5f, 0x56, 0x45, 0x50, 0x41, 0x10, 0x02, 0x12, 0x17,
+	0x0a, 0x13, 0x4d, 0x41, 0x43, 0x56, 0x4c, 0x41, 0x4e, 0x5f, 0x4d, 0x4f, 0x44, 0x45, 0x5f, 0x42,
+	0x52, 0x49, 0x44, 0x47, 0x45, 0x10, 0x04, 0x12, 0x19, 0x0a, 0x15, 0x4d, 0x41, 0x43, 0x56, 0x4c,
+	0x41, 0x4e, 0x5f, 0x4d, 0x4f, 0x44, 0x45, 0x5f, 0x50, 0x41, 0x53, 0x53, 0x54, 0x48, 0x52, 0x55,
+	0x10, 0x08, 0x12, 0x17, 0x0a, 0x13, 0x4d, 0x41, 0x43, 0x56, 0x4c, 0x41, 0x4e, 0x5f, 0x4d, 0x4f,
+	0x44, 0x45, 0x5f, 0x53, 0x4f, 0x55, 0x52, 0x43, 0x45, 0x10, 0x10, 0x2a, 0x92, 0x02, 0x0a, 0x17,
+	0x4e, 0x65, 0x74, 0x68, 0x65, 0x6c, 0x70, 0x65, 0x72, 0x73, 0x4e, 0x65, 0x69, 0x67, 0x68, 0x62,
+	0x6f, 0x72, 0x53, 0x74, 0x61, 0x74, 0x65, 0x12, 0x17, 0x0a, 0x13, 0x4e, 0x45, 0x49, 0x47, 0x48,
+	0x42, 0x4f, 0x52, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x45, 0x5f, 0x4e, 0x4f, 0x4e, 0x45, 0x10, 0x00,
+	0x12, 0x1d, 0x0a, 0x19, 0x4e, 0x45, 0x49, 0x47, 0x48, 0x42, 0x4f, 0x52, 0x5f, 0x53, 0x54, 0x41,
+	0x54, 0x45, 0x5f, 0x49, 0x4e, 0x43, 0x4f, 0x4d, 0x50, 0x4c, 0x45, 0x54, 0x45, 0x10, 0x01, 0x12,
+	0x1c, 0x0a, 0x18, 0x4e, 0x45, 0x49, 0x47, 0x48, 0x42, 0x4f, 0x52, 0x5f, 0x53, 0x54, 0x41, 0x54,
+	0x45, 0x5f, 0x52, 0x45, 0x41, 0x43, 0x48, 0x41, 0x42, 0x4c, 0x45, 0x10, 0x02, 0x12, 0x18, 0x0a,
+	0x14, 0x4e, 0x45, 0x49, 0x47, 0x48, 0x42, 0x4f, 0x52, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x45, 0x5f,
+	0x53, 0x54, 0x41, 0x4c, 0x45, 0x10, 0x04, 0x12, 0x18, 0x0a, 0x14, 0x4e, 0x45, 0x49, 0x47, 0x48,
+	0x42, 0x4f, 0x52, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x45, 0x5f, 0x44, 0x45, 0x4c, 0x41, 0x59, 0x10,
+	0x08, 0x12, 0x18, 0x0a, 0x14, 0x4e, 0x45, 0x49, 0x47, 0x48, 0x42, 0x4f, 0x52, 0x5f, 0x53, 0x54,
+	0x41, 0x54, 0x45, 0x5f, 0x50, 0x52, 0x4f, 0x42, 0x45, 0x10, 0x10, 0x12, 0x19, 0x0a, 0x15, 0x4e,
+	0x45, 0x49, 0x47, 0x48, 0x42, 0x4f, 0x52, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x45, 0x5f, 0x46, 0x41,
+	0x49, 0x4c, 0x45, 0x44, 0x10, 0x20, 0x12, 0x19, 0x0a, 0x15, 0x4e, 0x45, 0x49, 0x47, 0x48, 0x42,
+	0x4f, 0x52, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x45, 0x5f, 0x4e, 0x4f, 0x5f, 0x41, 0x52, 0x50, 0x10,
+	0x40, 0x12, 0x1d, 0x0a, 0x18, 0x4e, 0x45, 0x49, 0x47, 0x48, 0x42, 0x4f, 0x52, 0x5f, 0x53, 0x54,
+	0x41, 0x54, 0x45, 0x5f, 0x50, 0x45, 0x52, 0x4d, 0x41, 0x4e, 0x45, 0x4e, 0x54, 0x10, 0x80, 0x01,
+	0x2a, 0x99, 0x01, 0x0a, 0x1b, 0x4e, 0x65, 0x74, 0x68, 0x65, 0x6c, 0x70, 0x65, 0x72, 0x73, 0x4e,
+	0x66, 0x54, 0x61, 0x62, 0x6c, 0x65, 0x73, 0x43, 0x68, 0x61, 0x69, 0x6e, 0x48, 0x6f, 0x6f, 0x6b,
+	0x12, 0x19, 0x0a, 0x15, 0x43, 0x48, 0x41, 0x49, 0x4e, 0x5f, 0x48, 0x4f, 0x4f, 0x4b, 0x5f, 0x50,
+	0x52, 0x45, 0x52, 0x4f, 0x55, 0x54, 0x49, 0x4e, 0x47, 0x10, 0x00, 0x12, 0x14, 0x0a, 0x10, 0x43,
+	0x48, 0x41, 0x49, 0x4e, 0x5f, 0x48, 0x4f, 0x4f, 0x4b, 0x5f, 0x49, 0x4e, 0x50, 0x55, 0x54, 0x10,
+	0x01, 0x12, 0x16, 0x0a, 0x12, 0x43, 0x48, 0x41, 0x49, 0x4e, 0x5f, 0x48, 0x4f, 0x4f, 0x4b, 0x5f,
+	0x46, 0x4f, 0x52, 0x57, 0x41, 0x52, 0x44, 0x10, 0x02, 0x12, 0x15, 0x0a, 0x11, 0x43, 0x48, 0x41,
+	0x49, 0x4e, 0x5f, 0x48, 0x4f, 0x4f, 0x4b, 0x5f, 0x4f, 0x55, 0x54, 0x50, 0x55, 0x54, 0x10, 0x03,
+	0x12, 0x1a, 0x0a, 0x16, 0x43, 0x48, 0x41, 0x49, 0x4e, 0x5f, 0x48, 0x4f, 0x4f, 0x4b, 0x5f, 0x50,
+	0x4f, 0x53, 0x54, 0x52, 0x4f, 0x55, 0x54, 0x49, 0x4e, 0x47, 0x10, 0x04, 0x2a, 0xa3, 0x04, 0x0a,
+	0x1f, 0x4e, 0x65, 0x74, 0x68, 0x65, 0x6c, 0x70, 0x65, 0x72, 0x73, 0x4e, 0x66, 0x54, 0x61, 0x62,
+	0x6c, 0x65, 0x73, 0x43, 0x68, 0x61, 0x69, 0x6e, 0x50, 0x72, 0x69, 0x6f, 0x72, 0x69, 0x74, 0x79,
+	0x12, 0x30, 0x0a, 0x2c, 0x4e, 0x45, 0x54, 0x48, 0x45, 0x4c, 0x50, 0x45, 0x52, 0x53, 0x5f, 0x4e,
+	0x46, 0x54, 0x41, 0x42, 0x4c, 0x45, 0x53, 0x43, 0x48, 0x41, 0x49, 0x4e, 0x50, 0x52, 0x49, 0x4f,
+	0x52, 0x49, 0x54, 0x59, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44,
+	0x10, 0x00, 0x12, 0x21, 0x0a, 0x14, 0x43, 0x48, 0x41, 0x49, 0x4e, 0x5f, 0x50, 0x52, 0x49, 0x4f,
+	0x52, 0x49, 0x54, 0x59, 0x5f, 0x46, 0x49, 0x52, 0x53, 0x54, 0x10, 0x80, 0x80, 0x80, 0x80, 0xf8,
+	0xff, 0xff, 0xff, 0xff, 0x01, 0x12, 0x2c, 0x0a, 0x1f, 0x43, 0x48, 0x41, 0x49, 0x4e, 0x5f, 0x50,
+	0x52, 0x49, 0x4f, 0x52, 0x49, 0x54, 0x59, 0x5f, 0x43, 0x4f, 0x4e, 0x4e, 0x54, 0x52, 0x41, 0x43,
+	0x4b, 0x5f, 0x44, 0x45, 0x46, 0x52, 0x41, 0x47, 0x10, 0xf0, 0xfc, 0xff, 0xff, 0xff, 0xff, 0xff,
+	0xff, 0xff, 0x01, 0x12, 0x1f, 0x0a, 0x12, 0x43, 0x48, 0x41, 0x49, 0x4e, 0x5f, 0x50, 0x52, 0x49,
+	0x4f, 0x52, 0x49, 0x54, 0x59, 0x5f, 0x52, 0x41, 0x57, 0x10, 0xd4, 0xfd, 0xff, 0xff, 0xff, 0xff,
+	0xff, 0xff, 0xff, 0x01, 0x12, 0x2a, 0x0a, 0x1d, 0x43, 0x48, 0x41, 0x49, 0x4e, 0x5f, 0x50, 0x52,
+	0x49, 0x4f, 0x52, 0x49, 0x54, 0x59, 0x5f, 0x53, 0x45, 0x5f, 0x4c, 0x49, 0x4e, 0x55, 0x58, 0x5f,
+	0x46, 0x49, 0x52, 0x53, 0x54, 0x10, 0x9f, 0xfe, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0x01,
+	0x12, 0x25, 0x0a, 0x18, 0x43, 0x48, 0x41, 0x49, 0x4e, 0x5f, 0x50, 0x52, 0x49, 0x4f, 0x52, 0x49,
+	0x54, 0x59, 0x5f, 0x43, 0x4f, 0x4e, 0x4e, 0x54, 0x52, 0x41, 0x43, 0x4b, 0x10, 0xb8, 0xfe, 0xff,
+	0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0x01, 0x12, 0x22, 0x0a, 0x15, 0x43, 0x48, 0x41, 0x49, 0x4e,
+	0x5f, 0x50, 0x52, 0x49, 0x4f, 0x52, 0x49, 0x54, 0x59, 0x5f, 0x4d, 0x41, 0x4e, 0x47, 0x4c, 0x45,
+	0x10, 0xea, 0xfe, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0x01, 0x12, 0x24, 0x0a, 0x17, 0x43,
+	0x48, 0x41, 0x49, 0x4e, 0x5f, 0x50, 0x52, 0x49, 0x4f, 0x52, 0x49, 0x54, 0x59, 0x5f, 0x4e, 0x41,
+	0x54, 0x5f, 0x44, 0x45, 0x53, 0x54, 0x10, 0x9c, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff,
+	0x01, 0x12, 0x19, 0x0a, 0x15, 0x43, 0x48, 0x41, 0x49, 0x4e, 0x5f, 0x50, 0x52, 0x49, 0x4f, 0x52,
+	0x49, 0x54, 0x59, 0x5f, 0x46, 0x49, 0x4c, 0x54, 0x45, 0x52, 0x10, 0x00, 0x12, 0x1b, 0x0a, 0x17,
+	0x43, 0x48, 0x41, 0x49, 0x4e, 0x5f, 0x50, 0x52, 0x49, 0x4f, 0x52, 0x49, 0x54, 0x59, 0x5f, 0x53,
+	0x45, 0x43, 0x55, 0x52, 0x49, 0x54, 0x59, 0x10, 0x32, 0x12, 0x1d, 0x0a, 0x19, 0x43, 0x48, 0x41,
+	0x49, 0x4e, 0x5f, 0x50, 0x52, 0x49, 0x4f, 0x52, 0x49, 0x54, 0x59, 0x5f, 0x4e, 0x41, 0x54, 0x5f,
+	0x53, 0x4f, 0x55, 0x52, 0x43, 0x45, 0x10, 0x64, 0x12, 0x21, 0x0a, 0x1c, 0x43, 0x48, 0x41, 0x49,
+	0x4e, 0x5f, 0x50, 0x52, 0x49, 0x4f, 0x52, 0x49, 0x54, 0x59, 0x5f, 0x53, 0x45, 0x5f, 0x4c, 0x49,
+	0x4e, 0x55, 0x58, 0x5f, 0x4c, 0x41, 0x53, 0x54, 0x10, 0xe1, 0x01, 0x12, 0x24, 0x0a, 0x1f, 0x43,
+	0x48, 0x41, 0x49, 0x4e, 0x5f, 0x50, 0x52, 0x49, 0x4f, 0x52, 0x49, 0x54, 0x59, 0x5f, 0x43, 0x4f,
+	0x4e, 0x4e, 0x54, 0x52, 0x41, 0x43, 0x4b, 0x5f, 0x48, 0x45, 0x4c, 0x50, 0x45, 0x52, 0x10, 0xac,
+	0x02, 0x12, 0x1b, 0x0a, 0x13, 0x43, 0x48, 0x41, 0x49, 0x4e, 0x5f, 0x50, 0x52, 0x49, 0x4f, 0x52,
+	0x49, 0x54, 0x59, 0x5f, 0x4c, 0x41, 0x53, 0x54, 0x10, 0xff, 0xff, 0xff, 0xff, 0x07, 0x1a, 0x02,
+	0x10, 0x01, 0x2a, 0x41, 0x0a, 0x19, 0x4e, 0x65, 0x74, 0x68, 0x65, 0x6c, 0x70, 0x65, 0x72, 0x73,
+	0x4e, 0x66, 0x54, 0x61, 0x62, 0x6c, 0x65, 0x73, 0x56, 0x65, 0x72, 0x64, 0x69, 0x63, 0x74, 0x12,
+	0x10, 0x0a, 0x0c, 0x56, 0x45, 0x52, 0x44, 0x49, 0x43, 0x54, 0x5f, 0x44, 0x52, 0x4f, 0x50, 0x10,
+	0x00, 0x12, 0x12, 0x0a, 0x0e, 0x56, 0x45, 0x52, 0x44, 0x49, 0x43, 0x54, 0x5f, 0x41, 0x43, 0x43,
+	0x45, 0x50, 0x54, 0x10, 0x01, 0x2a, 0xc9, 0x01, 0x0a, 0x1a, 0x4e, 0x65, 0x74, 0x68, 0x65, 0x6c,
+	0x70, 0x65, 0x72, 0x73, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x61, 0x6c, 0x53,
+	0x74, 0x61, 0x74, 0x65, 0x12, 0x16, 0x0a, 0x12, 0x4f, 0x50, 0x45, 0x52, 0x5f, 0x53, 0x54, 0x41,
+	0x54, 0x45, 0x5f, 0x55, 0x4e, 0x4b, 0x4e, 0x4f, 0x57, 0x4e, 0x10, 0x00, 0x12, 0x1a, 0x0a, 0x16,
+	0x4f, 0x50, 0x45, 0x52, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x45, 0x5f, 0x4e, 0x4f, 0x54, 0x5f, 0x50,
+	0x52, 0x45, 0x53, 0x45, 0x4e, 0x54, 0x10, 0x01, 0x12, 0x13, 0x0a, 0x0f, 0x4f, 0x50, 0x45, 0x52,
+	0x5f, 0x53, 0x54, 0x41, 0x54, 0x45, 0x5f, 0x44, 0x4f, 0x57, 0x4e, 0x10, 0x02, 0x12, 0x1f, 0x0a,
+	0x1b, 0x4f, 0x50, 0x45, 0x52, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x45, 0x5f, 0x4c, 0x4f, 0x57, 0x45,
+	0x52, 0x5f, 0x4c, 0x41, 0x59, 0x45, 0x52, 0x5f, 0x44, 0x4f, 0x57, 0x4e, 0x10, 0x03, 0x12, 0x16,
+	0x0a, 0x12, 0x4f, 0x50, 0x45, 0x52, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x45, 0x5f, 0x54, 0x45, 0x53,
+	0x54, 0x49, 0x4e, 0x47, 0x10, 0x04, 0x12, 0x16, 0x0a, 0x12, 0x4f, 0x50, 0x45, 0x52, 0x5f, 0x53,
+	0x54, 0x41, 0x54, 0x45, 0x5f, 0x44, 0x4f, 0x52, 0x4d, 0x41, 0x4e, 0x54, 0x10, 0x05, 0x12, 0x11,
+	0x0a, 0x0d, 0x4f, 0x50, 0x45, 0x52, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x45, 0x5f, 0x55, 0x50, 0x10,
+	0x06, 0x2a, 0x72, 0x0a, 0x0e, 0x4e, 0x65, 0x74, 0x68, 0x65, 0x6c, 0x70, 0x65, 0x72, 0x73, 0x50,
+	0x6f, 0x72, 0x74, 0x12, 0x10, 0x0a, 0x0c, 0x54, 0x57, 0x49, 0x53, 0x54, 0x45, 0x44, 0x5f, 0x50,
+	0x41, 0x49, 0x52, 0x10, 0x00, 0x12, 0x07, 0x0a, 0x03, 0x41, 0x55, 0x49, 0x10, 0x01, 0x12, 0x07,
+	0x0a, 0x03, 0x4d, 0x49, 0x49, 0x10, 0x02, 0x12, 0x09, 0x0a, 0x05, 0x46, 0x49, 0x42, 0x52, 0x45,
+	0x10, 0x03, 0x12, 0x07, 0x0a, 0x03, 0x42, 0x4e, 0x43, 0x10, 0x04, 0x12, 0x11, 0x0a, 0x0d, 0x44,
+	0x49, 0x52, 0x45, 0x43, 0x54, 0x5f, 0x41, 0x54, 0x54, 0x41, 0x43, 0x48, 0x10, 0x05, 0x12, 0x09,
+	0x0a, 0x04, 0x4e, 0x4f, 0x4e, 0x45, 0x10, 0xef, 0x01, 0x12, 0x0a, 0x0a, 0x05, 0x4f, 0x54, 0x48,
+	0x45, 0x52, 0x10, 0xff, 0x01, 0x2a, 0x73, 0x0a, 0x19, 0x4e, 0x65, 0x74, 0x68, 0x65, 0x6c, 0x70,
+	0x65, 0x72, 0x73, 0x50, 0x72, 0x69, 0x6d, 0x61, 0x72, 0x79, 0x52, 0x65, 0x73, 0x65, 0x6c, 0x65,
+	0x63, 0x74, 0x12, 0x1b, 0x0a, 0x17, 0x50, 0x52, 0x49, 0x4d, 0x41, 0x52, 0x59, 0x5f, 0x52, 0x45,
+	0x53, 0x45, 0x4c, 0x45, 0x43, 0x54, 0x5f, 0x41, 0x4c, 0x57, 0x41, 0x59, 0x53, 0x10, 0x00, 0x12,
 	0x1b, 0x0a, 0x17, 0x50, 0x52, 0x49, 0x4d, 0x41, 0x52, 0x59, 0x5f, 0x52, 0x45, 0x53, 0x45, 0x4c,
-	0x45, 0x43, 0x54, 0x5f, 0x41, 0x4c, 0x57, 0x41, 0x59, 0x53, 0x10, 0x00, 0x12, 0x1b, 0x0a, 0x17,
+	0x45, 0x43, 0x54, 0x5f, 0x42, 0x45, 0x54, 0x54, 0x45, 0x52, 0x10, 0x01, 0x12, 0x1c, 0x0a, 0x18,
 	0x50, 0x52, 0x49, 0x4d, 0x41, 0x52, 0x59, 0x5f, 0x52, 0x45, 0x53, 0x45, 0x4c, 0x45, 0x43, 0x54,
-	0x5f, 0x42, 0x45, 0x54, 0x54, 0x45, 0x52, 0x10, 0x01, 0x12, 0x1c, 0x0a, 0x18, 0x50, 0x52, 0x49,
-	0x4d, 0x41, 0x52, 0x59, 0x5f, 0x52, 0x45, 0x53, 0x45, 0x4c, 0x45, 0x43, 0x54, 0x5f, 0x46, 0x41,
-	0x49, 0x4c, 0x55, 0x52, 0x45, 0x10, 0x02, 0x2a, 0xdf, 0x01, 0x0a, 0x13, 0x4e, 0x65, 0x74, 0x68,
-	0x65, 0x6c, 0x70, 0x65, 0x72, 0x73, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x46, 0x6c, 0x61, 0x67, 0x12,
-	0x24, 0x0a, 0x20, 0x4e, 0x45, 0x54, 0x48, 0x45, 0x4c, 0x50, 0x45, 0x52, 0x53, 0x5f, 0x52, 0x4f,
-	0x55, 0x54, 0x45, 0x46, 0x4c, 0x41, 0x47, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46,
-	0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x11, 0x0a, 0x0c, 0x52, 0x4f, 0x55, 0x54, 0x45, 0x5f, 0x4e,
-	0x4f, 0x54, 0x49, 0x46, 0x59, 0x10, 0x80, 0x02, 0x12, 0x11, 0x0a, 0x0c, 0x52, 0x4f, 0x55, 0x54,
-	0x45, 0x5f, 0x43, 0x4c, 0x4f, 0x4e, 0x45, 0x44, 0x10, 0x80, 0x04, 0x12, 0x13, 0x0a, 0x0e, 0x52,
-	0x4f, 0x55, 0x54, 0x45, 0x5f, 0x45, 0x51, 0x55, 0x41, 0x4c, 0x49, 0x5a, 0x45, 0x10, 0x80, 0x08,
-	0x12, 0x11, 0x0a, 0x0c, 0x52, 0x4f, 0x55, 0x54, 0x45, 0x5f, 0x50, 0x52, 0x45, 0x46, 0x49, 0x58,
-	0x10, 0x80, 0x10, 0x12, 0x17, 0x0a, 0x12, 0x52, 0x4f, 0x55, 0x54, 0x45, 0x5f, 0x4c, 0x4f, 0x4f,
-	0x4b, 0x55, 0x50, 0x5f, 0x54, 0x41, 0x42, 0x4c, 0x45, 0x10, 0x80, 0x20, 0x12, 0x14, 0x0a, 0x0f,
-	0x52, 0x4f, 0x55, 0x54, 0x45, 0x5f, 0x46, 0x49, 0x42, 0x5f, 0x4d, 0x41, 0x54, 0x43, 0x48, 0x10,
-	0x80, 0x40, 0x12, 0x13, 0x0a, 0x0d, 0x52, 0x4f, 0x55, 0x54, 0x45, 0x5f, 0x4f, 0x46, 0x46, 0x4c,
-	0x4f, 0x41, 0x44, 0x10, 0x80, 0x80, 0x01, 0x12, 0x10, 0x0a, 0x0a, 0x52, 0x4f, 0x55, 0x54, 0x45,
-	0x5f, 0x54, 0x52, 0x41, 0x50, 0x10, 0x80, 0x80, 0x02, 0x2a, 0xd2, 0x03, 0x0a, 0x17, 0x4e, 0x65,
-	0x74, 0x68, 0x65, 0x6c, 0x70, 0x65, 0x72, 0x73, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x50, 0x72, 0x6f,
-	0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x12, 0x13, 0x0a, 0x0f, 0x50, 0x52, 0x4f, 0x54, 0x4f, 0x43, 0x4f,
-	0x4c, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x10, 0x00, 0x12, 0x15, 0x0a, 0x11, 0x50, 0x52,
-	0x4f, 0x54, 0x4f, 0x43, 0x4f, 0x4c, 0x5f, 0x52, 0x45, 0x44, 0x49, 0x52, 0x45, 0x43, 0x54, 0x10,
-	0x01, 0x12, 0x13, 0x0a, 0x0f, 0x50, 0x52, 0x4f, 0x54, 0x4f, 0x43, 0x4f, 0x4c, 0x5f, 0x4b, 0x45,
-	0x52, 0x4e, 0x45, 0x4c, 0x10, 0x02, 0x12, 0x11, 0x0a, 0x0d, 0x50, 0x52, 0x4f, 0x54, 0x4f, 0x43,
-	0x4f, 0x4c, 0x5f, 0x42, 0x4f, 0x4f, 0x54, 0x10, 0x03, 0x12, 0x13, 0x0a, 0x0f, 0x50, 0x52, 0x4f,
-	0x54, 0x4f, 0x43, 0x4f, 0x4c, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x49, 0x43, 0x10, 0x04, 0x12, 0x0f,
-	0x0a, 0x0b, 0x50, 0x52, 0x4f, 0x54, 0x4f, 0x43, 0x4f, 0x4c, 0x5f, 0x52, 0x41, 0x10, 0x09, 0x12,
-	0x10, 0x0a, 0x0c, 0x50, 0x52, 0x4f, 0x54, 0x4f, 0x43, 0x4f, 0x4c, 0x5f, 0x4d, 0x52, 0x54, 0x10,
-	0x0a, 0x12, 0x12, 0x0a, 0x0e, 0x50, 0x52, 0x4f, 0x54, 0x4f, 0x43, 0x4f, 0x4c, 0x5f, 0x5a, 0x45,
-	0x42, 0x52, 0x41, 0x10, 0x0b, 0x12, 0x11, 0x0a, 0x0d, 0x50, 0x52, 0x4f, 0x54, 0x4f, 0x43, 0x4f,
-	0x4c, 0x5f, 0x42, 0x49, 0x52, 0x44, 0x10, 0x0c, 0x12, 0x15, 0x0a, 0x11, 0x50, 0x52, 0x4f, 0x54,
-	0x4f, 0x43, 0x4f, 0x4c, 0x5f, 0x44, 0x4e, 0x52, 0x4f, 0x55, 0x54, 0x45, 0x44, 0x10, 0x0d, 0x12,
-	0x11, 0x0a, 0x0d, 0x50, 0x52, 0x4f, 0x54, 0x4f, 0x43, 0x4f, 0x4c, 0x5f, 0x58, 0x4f, 0x52, 0x50,
-	0x10, 0x0e, 0x12, 0x10, 0x0a, 0x0c, 0x50, 0x52, 0x4f, 0x54, 0x4f, 0x43, 0x4f, 0x4c, 0x5f, 0x4e,
-	0x54, 0x4b, 0x10, 0x0f, 0x12, 0x11, 0x0a, 0x0d, 0x50, 0x52, 0x4f, 0x54, 0x4f, 0x43, 0x4f, 0x4c,
-	0x5f, 0x44, 0x48, 0x43, 0x50, 0x10, 0x10, 0x12, 0x11, 0x0a, 0x0d, 0x50, 0x52, 0x4f, 0x54, 0x4f,
-	0x43, 0x4f, 0x4c, 0x5f, 0x4d, 0x52, 0x54, 0x44, 0x10, 0x11, 0x12, 0x17, 0x0a, 0x13, 0x50, 0x52,
-	0x4f, 0x54, 0x4f, 0x43, 0x4f, 0x4c, 0x5f, 0x4b, 0x45, 0x45, 0x50, 0x41, 0x4c, 0x49, 0x56, 0x45,
-	0x44, 0x10, 0x12, 0x12, 0x12, 0x0a, 0x0e, 0x50, 0x52, 0x4f, 0x54, 0x4f, 0x43, 0x4f, 0x4c, 0x5f,
-	0x42, 0x41, 0x42, 0x45, 0x4c, 0x10, 0x2a, 0x12, 0x12, 0x0a, 0x0e, 0x50, 0x52, 0x4f, 0x54, 0x4f,
-	0x43, 0x4f, 0x4c, 0x5f, 0x4f, 0x50, 0x45, 0x4e, 0x52, 0x10, 0x63, 0x12, 0x11, 0x0a, 0x0c, 0x50,
-	0x52, 0x4f, 0x54, 0x4f, 0x43, 0x4f, 0x4c, 0x5f, 0x42, 0x47, 0x50, 0x10, 0xba, 0x01, 0x12, 0x12,
-	0x0a, 0x0d, 0x50, 0x52, 0x4f, 0x54, 0x4f, 0x43, 0x4f, 0x4c, 0x5f, 0x49, 0x53, 0x49, 0x53, 0x10,
-	0xbb, 0x01, 0x12, 0x12, 0x0a, 0x0d, 0x50, 0x52, 0x4f, 0x54, 0x4f, 0x43, 0x4f, 0x4c, 0x5f, 0x4f,
-	0x53, 0x50, 0x46, 0x10, 0xbc, 0x01, 0x12, 0x11, 0x0a, 0x0c, 0x50, 0x52, 0x4f, 0x54, 0x4f, 0x43,
-	0x4f, 0x4c, 0x5f, 0x52, 0x49, 0x50, 0x10, 0xbd, 0x01, 0x12, 0x13, 0x0a, 0x0e, 0x50, 0x52, 0x4f,
-	0x54, 0x4f, 0x43, 0x4f, 0x4c, 0x5f, 0x45, 0x49, 0x47, 0x52, 0x50, 0x10, 0xc0, 0x01, 0x2a, 0xf1,
-	0x01, 0x0a, 0x13, 0x4e, 0x65, 0x74, 0x68, 0x65, 0x6c, 0x70, 0x65, 0x72, 0x73, 0x52, 0x6f, 0x75,
-	0x74, 0x65, 0x54, 0x79, 0x70, 0x65, 0x12, 0x0f, 0x0a, 0x0b, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x55,
-	0x4e, 0x53, 0x50, 0x45, 0x43, 0x10, 0x00, 0x12, 0x10, 0x0a, 0x0c, 0x54, 0x59, 0x50, 0x45, 0x5f,
-	0x55, 0x4e, 0x49, 0x43, 0x41, 0x53, 0x54, 0x10, 0x01, 0x12, 0x0e, 0x0a, 0x0a, 0x54, 0x59, 0x50,
-	0x45, 0x5f, 0x4c, 0x4f, 0x43, 0x41, 0x4c, 0x10, 0x02, 0x12, 0x12, 0x0a, 0x0e, 0x54, 0x59, 0x50,
-	0x45, 0x5f, 0x42, 0x52, 0x4f, 0x41, 0x44, 0x43, 0x41, 0x53, 0x54, 0x10, 0x03, 0x12, 0x10, 0x0a,
-	0x0c, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x41, 0x4e, 0x59, 0x43, 0x41, 0x53, 0x54, 0x10, 0x04, 0x12,
-	0x12, 0x0a, 0x0e, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x4d, 0x55, 0x4c, 0x54, 0x49, 0x43, 0x41, 0x53,
-	0x54, 0x10, 0x05, 0x12, 0x12, 0x0a, 0x0e, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x42, 0x4c, 0x41, 0x43,
-	0x4b, 0x48, 0x4f, 0x4c, 0x45, 0x10, 0x06, 0x12, 0x14, 0x0a, 0x10, 0x54, 0x59, 0x50, 0x45, 0x5f,
-	0x55, 0x4e, 0x52, 0x45, 0x41, 0x43, 0x48, 0x41, 0x42, 0x4c, 0x45, 0x10, 0x07, 0x12, 0x11, 0x0a,
-	0x0d, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x50, 0x52, 0x4f, 0x48, 0x49, 0x42, 0x49, 0x54, 0x10, 0x08,
-	0x12, 0x0e, 0x0a, 0x0a, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x54, 0x48, 0x52, 0x4f, 0x57, 0x10, 0x09,
-	0x12, 0x0c, 0x0a, 0x08, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x4e, 0x41, 0x54, 0x10, 0x0a, 0x12, 0x12,
-	0x0a, 0x0e, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x58, 0x5f, 0x52, 0x45, 0x53, 0x4f, 0x4c, 0x56, 0x45,
-	0x10, 0x0b, 0x2a, 0x61, 0x0a, 0x16, 0x4e, 0x65, 0x74, 0x68, 0x65, 0x6c, 0x70, 0x65, 0x72, 0x73,
-	0x52, 0x6f, 0x75, 0x74, 0x69, 0x6e, 0x67, 0x54, 0x61, 0x62, 0x6c, 0x65, 0x12, 0x10, 0x0a, 0x0c,
-	0x54, 0x41, 0x42, 0x4c, 0x45, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x10, 0x00, 0x12, 0x12,
-	0x0a, 0x0d, 0x54, 0x41, 0x42, 0x4c, 0x45, 0x5f, 0x44, 0x45, 0x46, 0x41, 0x55, 0x4c, 0x54, 0x10,
-	0xfd, 0x01, 0x12, 0x0f, 0x0a, 0x0a, 0x54, 0x41, 0x42, 0x4c, 0x45, 0x5f, 0x4d, 0x41, 0x49, 0x4e,
-	0x10, 0xfe, 0x01, 0x12, 0x10, 0x0a, 0x0b, 0x54, 0x41, 0x42, 0x4c, 0x45, 0x5f, 0x4c, 0x4f, 0x43,
-	0x41, 0x4c, 0x10, 0xff, 0x01, 0x2a, 0x6a, 0x0a, 0x0f, 0x4e, 0x65, 0x74, 0x68, 0x65, 0x6c, 0x70,
-	0x65, 0x72, 0x73, 0x53, 0x63, 0x6f, 0x70, 0x65, 0x12, 0x10, 0x0a, 0x0c, 0x53, 0x43, 0x4f, 0x50,
-	0x45, 0x5f, 0x47, 0x4c, 0x4f, 0x42, 0x41, 0x4c, 0x10, 0x00, 0x12, 0x0f, 0x0a, 0x0a, 0x53, 0x43,
-	0x4f, 0x50, 0x45, 0x5f, 0x53, 0x49, 0x54, 0x45, 0x10, 0xc8, 0x01, 0x12, 0x0f, 0x0a, 0x0a, 0x53,
-	0x43, 0x4f, 0x50, 0x45, 0x5f, 0x4c, 0x49, 0x4e, 0x4b, 0x10, 0xfd, 0x01, 0x12, 0x0f, 0x0a, 0x0a,
-	0x53, 0x43, 0x4f, 0x50, 0x45, 0x5f, 0x48, 0x4f, 0x53, 0x54, 0x10, 0xfe, 0x01, 0x12, 0x12, 0x0a,
-	0x0d, 0x53, 0x43, 0x4f, 0x50, 0x45, 0x5f, 0x4e, 0x4f, 0x57, 0x48, 0x45, 0x52, 0x45, 0x10, 0xff,
-	0x01, 0x2a, 0x78, 0x0a, 0x16, 0x4e, 0x65, 0x74, 0x68, 0x65, 0x6c, 0x70, 0x65, 0x72, 0x73, 0x56,
-	0x4c, 0x41, 0x4e, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x12, 0x27, 0x0a, 0x23, 0x4e,
-	0x45, 0x54, 0x48, 0x45, 0x4c, 0x50, 0x45, 0x52, 0x53, 0x5f, 0x56, 0x4c, 0x41, 0x4e, 0x50, 0x52,
-	0x4f, 0x54, 0x4f, 0x43, 0x4f, 0x4c, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49,
-	0x45, 0x44, 0x10, 0x00, 0x12, 0x19, 0x0a, 0x13, 0x56, 0x4c, 0x41, 0x4e, 0x5f, 0x50, 0x52, 0x4f,
-	0x54, 0x4f, 0x43, 0x4f, 0x4c, 0x38, 0x30, 0x32, 0x31, 0x5f, 0x51, 0x10, 0x80, 0x82, 0x02, 0x12,
-	0x1a, 0x0a, 0x14, 0x56, 0x4c, 0x41, 0x4e, 0x5f, 0x50, 0x52, 0x4f, 0x54, 0x4f, 0x43, 0x4f, 0x4c,
-	0x38, 0x30, 0x32, 0x31, 0x5f, 0x41, 0x44, 0x10, 0xa8, 0x91, 0x02, 0x2a, 0x53, 0x0a, 0x11, 0x4b,
-	0x75, 0x62, 0x65, 0x73, 0x70, 0x61, 0x6e, 0x50, 0x65, 0x65, 0x72, 0x53, 0x74, 0x61, 0x74, 0x65,
-	0x12, 0x16, 0x0a, 0x12, 0x50, 0x45, 0x45, 0x52, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x45, 0x5f, 0x55,
-	0x4e, 0x4b, 0x4e, 0x4f, 0x57, 0x4e, 0x10, 0x00, 0x12, 0x11, 0x0a, 0x0d, 0x50, 0x45, 0x45, 0x52,
-	0x5f, 0x53, 0x54, 0x41, 0x54, 0x45, 0x5f, 0x55, 0x50, 0x10, 0x01, 0x12, 0x13, 0x0a, 0x0f, 0x50,
-	0x45, 0x45, 0x52, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x45, 0x5f, 0x44, 0x4f, 0x57, 0x4e, 0x10, 0x02,
-	0x2a, 0x88, 0x01, 0x0a, 0x12, 0x4e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x43, 0x6f, 0x6e, 0x66,
-	0x69, 0x67, 0x4c, 0x61, 0x79, 0x65, 0x72, 0x12, 0x12, 0x0a, 0x0e, 0x43, 0x4f, 0x4e, 0x46, 0x49,
-	0x47, 0x5f, 0x44, 0x45, 0x46, 0x41, 0x55, 0x4c, 0x54, 0x10, 0x00, 0x12, 0x12, 0x0a, 0x0e, 0x43,
-	0x4f, 0x4e, 0x46, 0x49, 0x47, 0x5f, 0x43, 0x4d, 0x44, 0x4c, 0x49, 0x4e, 0x45, 0x10, 0x01, 0x12,
-	0x13, 0x0a, 0x0f, 0x43, 0x4f, 0x4e, 0x46, 0x49, 0x47, 0x5f, 0x50, 0x4c, 0x41, 0x54, 0x46, 0x4f,
-	0x52, 0x4d, 0x10, 0x02, 0x12, 0x13, 0x0a, 0x0f, 0x43, 0x4f, 0x4e, 0x46, 0x49, 0x47, 0x5f, 0x4f,
-	0x50, 0x45, 0x52, 0x41, 0x54, 0x4f, 0x52, 0x10, 0x03, 0x12, 0x20, 0x0a, 0x1c, 0x43, 0x4f, 0x4e,
-	0x46, 0x49, 0x47, 0x5f, 0x4d, 0x41, 0x43, 0x48, 0x49, 0x4e, 0x45, 0x5f, 0x43, 0x4f, 0x4e, 0x46,
-	0x49, 0x47, 0x55, 0x52, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x10, 0x04, 0x2a, 0x4b, 0x0a, 0x0f, 0x4e,
-	0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x12, 0x12,
-	0x0a, 0x0e, 0x4f, 0x50, 0x45, 0x52, 0x41, 0x54, 0x4f, 0x52, 0x5f, 0x44, 0x48, 0x43, 0x50, 0x34,
-	0x10, 0x00, 0x12, 0x12, 0x0a, 0x0e, 0x4f, 0x50, 0x45, 0x52, 0x41, 0x54, 0x4f, 0x52, 0x5f, 0x44,
-	0x48, 0x43, 0x50, 0x36, 0x10, 0x01, 0x12, 0x10, 0x0a, 0x0c, 0x4f, 0x50, 0x45, 0x52, 0x41, 0x54,
-	0x4f, 0x52, 0x5f, 0x56, 0x49, 0x50, 0x10, 0x02, 0x2a, 0x9b, 0x02, 0x0a, 0x13, 0x52, 0x75, 0x6e,
-	0x74, 0x69, 0x6d, 0x65, 0x4d, 0x61, 0x63, 0x68, 0x69, 0x6e, 0x65, 0x53, 0x74, 0x61, 0x67, 0x65,
-	0x12, 0x19, 0x0a, 0x15, 0x4d, 0x41, 0x43, 0x48, 0x49, 0x4e, 0x45, 0x5f, 0x53, 0x54, 0x41, 0x47,
-	0x45, 0x5f, 0x55, 0x4e, 0x4b, 0x4e, 0x4f, 0x57, 0x4e, 0x10, 0x00, 0x12, 0x19, 0x0a, 0x15, 0x4d,
-	0x41, 0x43, 0x48, 0x49, 0x4e, 0x45, 0x5f, 0x53, 0x54, 0x41, 0x47, 0x45, 0x5f, 0x42, 0x4f, 0x4f,
-	0x54, 0x49, 0x4e, 0x47, 0x10, 0x01, 0x12, 0x1c, 0x0a, 0x18, 0x4d, 0x41, 0x43, 0x48, 0x49, 0x4e,
-	0x45, 0x5f, 0x53, 0x54, 0x41, 0x47, 0x45, 0x5f, 0x49, 0x4e, 0x53, 0x54, 0x41, 0x4c, 0x4c, 0x49,
-	0x4e, 0x47, 0x10, 0x02, 0x12, 0x1d, 0x0a, 0x19, 0x4d, 0x41, 0x43, 0x48, 0x49, 0x4e, 0x45, 0x5f,
-	0x53, 0x54, 0x41, 0x47, 0x45, 0x5f, 0x4d, 0x41, 0x49, 0x4e, 0x54, 0x45, 0x4e, 0x41, 0x4e, 0x43,
-	0x45, 0x10, 0x03, 0x12, 0x19, 0x0a, 0x15, 0x4d, 0x41, 0x43, 0x48, 0x49, 0x4e, 0x45, 0x5f, 0x53,
-	0x54, 0x41, 0x47, 0x45, 0x5f, 0x52, 0x55, 0x4e, 0x4e, 0x49, 0x4e, 0x47, 0x10, 0x04, 0x12, 0x1b,
-	0x0a, 0x17, 0x4d, 0x41, 0x43, 0x48, 0x49, 0x4e, 0x45, 0x5f, 0x53, 0x54, 0x41, 0x47, 0x45, 0x5f,
-	0x52, 0x45, 0x42, 0x4f, 0x4f, 0x54, 0x49, 0x4e, 0x47, 0x10, 0x05, 0x12, 0x1f, 0x0a, 0x1b, 0x4d,
-	0x41, 0x43, 0x48, 0x49, 0x4e, 0x45, 0x5f, 0x53, 0x54, 0x41, 0x47, 0x45, 0x5f, 0x53, 0x48, 0x55,
-	0x54, 0x54, 0x49, 0x4e, 0x47, 0x5f, 0x44, 0x4f, 0x57, 0x4e, 0x10, 0x06, 0x12, 0x1b, 0x0a, 0x17,
-	0x4d, 0x41, 0x43, 0x48, 0x49, 0x4e, 0x45, 0x5f, 0x53, 0x54, 0x41, 0x47, 0x45, 0x5f, 0x52, 0x45,
-	0x53, 0x45, 0x54, 0x54, 0x49, 0x4e, 0x47, 0x10, 0x07, 0x12, 0x1b, 0x0a, 0x17, 0x4d, 0x41, 0x43,
-	0x48, 0x49, 0x4e, 0x45, 0x5f, 0x53, 0x54, 0x41, 0x47, 0x45, 0x5f, 0x55, 0x50, 0x47, 0x52, 0x41,
-	0x44, 0x49, 0x4e, 0x47, 0x10, 0x08, 0x42, 0x4a, 0x5a, 0x48, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62,
-	0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x73, 0x69, 0x64, 0x65, 0x72, 0x6f, 0x6c, 0x61, 0x62, 0x73, 0x2f,
-	0x74, 0x61, 0x6c, 0x6f, 0x73, 0x2f, 0x70, 0x6b, 0x67, 0x2f, 0x6d, 0x61, 0x63, 0x68, 0x69, 0x6e,
-	0x65, 0x72, 0x79, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65,
-	0x2f, 0x64, 0x65, 0x66, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2f, 0x65, 0x6e, 0x75,
-	0x6d, 0x73, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x5f, 0x46, 0x41, 0x49, 0x4c, 0x55, 0x52, 0x45, 0x10, 0x02, 0x2a, 0x86, 0x01, 0x0a, 0x12, 0x4e,
+	0x65, 0x74, 0x68, 0x65, 0x6c, 0x70, 0x65, 0x72, 0x73, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f,
+	0x6c, 0x12, 0x23, 0x0a, 0x1f, 0x4e, 0x45, 0x54, 0x48, 0x45, 0x4c, 0x50, 0x45, 0x52, 0x53, 0x5f,
+	0x50, 0x52, 0x4f, 0x54, 0x4f, 0x43, 0x4f, 0x4c, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49,
+	0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x11, 0x0a, 0x0d, 0x50, 0x52, 0x4f, 0x54, 0x4f, 0x43,
+	0x4f, 0x4c, 0x5f, 0x49, 0x43, 0x4d, 0x50, 0x10, 0x01, 0x12, 0x10, 0x0a, 0x0c, 0x50, 0x52, 0x4f,
+	0x54, 0x4f, 0x43, 0x4f, 0x4c, 0x5f, 0x54, 0x43, 0x50, 0x10, 0x06, 0x12, 0x10, 0x0a, 0x0c, 0x50,
+	0x52, 0x4f, 0x54, 0x4f, 0x43, 0x4f, 0x4c, 0x5f, 0x55, 0x44, 0x50, 0x10, 0x11, 0x12, 0x14, 0x0a,
+	0x10, 0x50, 0x52, 0x4f, 0x54, 0x4f, 0x43, 0x4f, 0x4c, 0x5f, 0x49, 0x43, 0x4d, 0x5f, 0x50, 0x56,
+	0x36, 0x10, 0x3a, 0x2a, 0x56, 0x0a, 0x13, 0x4e, 0x65, 0x74, 0x68, 0x65, 0x6c, 0x70, 0x65, 0x72,
+	0x73, 0x51, 0x64, 0x69, 0x73, 0x63, 0x4b, 0x69, 0x6e, 0x64, 0x12, 0x17, 0x0a, 0x13, 0x51, 0x44,
+	0x49, 0x53, 0x43, 0x5f, 0x4b, 0x49, 0x4e, 0x44, 0x5f, 0x46, 0x51, 0x5f, 0x43, 0x4f, 0x44, 0x45,
+	0x4c, 0x10, 0x00, 0x12, 0x12, 0x0a, 0x0e, 0x51, 0x44, 0x49, 0x53, 0x43, 0x5f, 0x4b, 0x49, 0x4e,
+	0x44, 0x5f, 0x48, 0x54, 0x42, 0x10, 0x01, 0x12, 0x12, 0x0a, 0x0e, 0x51, 0x44, 0x49, 0x53, 0x43,
+	0x5f, 0x4b, 0x49, 0x4e, 0x44, 0x5f, 0x54, 0x42, 0x46, 0x10, 0x02, 0x2a, 0xdf, 0x01, 0x0a, 0x13,
+	0x4e, 0x65, 0x74, 0x68, 0x65, 0x6c, 0x70, 0x65, 0x72, 0x73, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x46,
+	0x6c, 0x61, 0x67, 0x12, 0x24, 0x0a, 0x20, 0x4e, 0x45, 0x54, 0x48, 0x45, 0x4c, 0x50, 0x45, 0x52,
+	0x53, 0x5f, 0x52, 0x4f, 0x55, 0x54, 0x45, 0x46, 0x4c, 0x41, 0x47, 0x5f, 0x55, 0x4e, 0x53, 0x50,
+	0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x11, 0x0a, 0x0c, 0x52, 0x4f, 0x55,
+	0x54, 0x45, 0x5f, 0x4e, 0x4f, 0x54, 0x49, 0x46, 0x59, 0x10, 0x80, 0x02, 0x12, 0x11, 0x0a, 0x0c,
+	0x52, 0x4f, 0x55, 0x54, 0x45, 0x5f, 0x43, 0x4c, 0x4f, 0x4e, 0x45, 0x44, 0x10, 0x80, 0x04, 0x12,
+	0x13, 0x0a, 0x0e, 0x52, 0x4f, 0x55, 0x54, 0x45, 0x5f, 0x45, 0x51, 0x55, 0x41, 0x4c, 0x49, 0x5a,
+	0x45, 0x10, 0x80, 0x08, 0x12, 0x11, 0x0a, 0x0c, 0x52, 0x4f, 0x55, 0x54, 0x45, 0x5f, 0x50, 0x52,
+	0x45, 0x46, 0x49, 0x58, 0x10, 0x80, 0x10, 0x12, 0x17, 0x0a, 0x12, 0x52, 0x4f, 0x55, 0x54, 0x45,
+	0x5f, 0x4c, 0x4f, 0x4f, 0x4b, 0x55, 0x50, 0x5f, 0x54, 0x41, 0x42, 0x4c, 0x45, 0x10, 0x80, 0x20,
+	0x12, 0x14, 0x0a, 0x0f, 0x52, 0x4f, 0x55, 0x54, 0x45, 0x5f, 0x46, 0x49, 0x42, 0x5f, 0x4d, 0x41,
+	0x54, 0x43, 0x48, 0x10, 0x80, 0x40, 0x12, 0x13, 0x0a, 0x0d, 0x52, 0x4f, 0x55, 0x54, 0x45, 0x5f,
+	0x4f, 0x46, 0x46, 0x4c, 0x4f, 0x41, 0x44, 0x10, 0x80, 0x80, 0x01, 0x12, 0x10, 0x0a, 0x0a, 0x52,
+	0x4f, 0x55, 0x54, 0x45, 0x5f, 0x54, 0x52, 0x41, 0x50, 0x10, 0x80, 0x80, 0x02, 0x2a, 0xd2, 0x03,
+	0x0a, 0x17, 0x4e, 0x65, 0x74, 0x68, 0x65, 0x6c, 0x70, 0x65, 0x72, 0x73, 0x52, 0x6f, 0x75, 0x74,
+	0x65, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x12, 0x13, 0x0a, 0x0f, 0x50, 0x52, 0x4f,
+	0x54, 0x4f, 0x43, 0x4f, 0x4c, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x10, 0x00, 0x12, 0x15,
+	0x0a, 0x11, 0x50, 0x52, 0x4f, 0x54, 0x4f, 0x43, 0x4f, 0x4c, 0x5f, 0x52, 0x45, 0x44, 0x49, 0x52,
+	0x45, 0x43, 0x54, 0x10, 0x01, 0x12, 0x13, 0x0a, 0x0f, 0x50, 0x52, 0x4f, 0x54, 0x4f, 0x43, 0x4f,
+	0x4c, 0x5f, 0x4b, 0x45, 0x52, 0x4e, 0x45, 0x4c, 0x10, 0x02, 0x12, 0x11, 0x0a, 0x0d, 0x50, 0x52,
+	0x4f, 0x54, 0x4f, 0x43, 0x4f, 0x4c, 0x5f, 0x42, 0x4f, 0x4f, 0x54, 0x10, 0x03, 0x12, 0x13, 0x0a,
+	0x0f, 0x50, 0x52, 0x4f, 0x54, 0x4f, 0x43, 0x4f, 0x4c, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x49, 0x43,
+	0x10, 0x04, 0x12, 0x0f, 0x0a, 0x0b, 0x50, 0x52, 0x4f, 0x54, 0x4f, 0x43, 0x4f, 0x4c, 0x5f, 0x52,
+	0x41, 0x10, 0x09, 0x12, 0x10, 0x0a, 0x0c, 0x50, 0x52, 0x4f, 0x54, 0x4f, 0x43, 0x4f, 0x4c, 0x5f,
+	0x4d, 0x52, 0x54, 0x10, 0x0a, 0x12, 0x12, 0x0a, 0x0e, 0x50, 0x52, 0x4f, 0x54, 0x4f, 0x43, 0x4f,
+	0x4c, 0x5f, 0x5a, 0x45, 0x42, 0x52, 0x41, 0x10, 0x0b, 0x12, 0x11, 0x0a, 0x0d, 0x50, 0x52, 0x4f,
+	0x54, 0x4f, 0x43, 0x4f, 0x4c, 0x5f, 0x42, 0x49, 0x52, 0x44, 0x10, 0x0c, 0x12, 0x15, 0x0a, 0x11,
+	0x50, 0x52, 0x4f, 0x54, 0x4f, 0x43, 0x4f, 0x4c, 0x5f, 0x44, 0x4e, 0x52, 0x4f, 0x55, 0x54, 0x45,
+	0x44, 0x10, 0x0d, 0x12, 0x11, 0x0a, 0x0d, 0x50, 0x52, 0x4f, 0x54, 0x4f, 0x43, 0x4f, 0x4c, 0x5f,
+	0x58, 0x4f, 0x52, 0x50, 0x10, 0x0e, 0x12, 0x10, 0x0a, 0x0c, 0x50, 0x52, 0x4f, 0x54, 0x4f, 0x43,
+	0x4f, 0x4c, 0x5f, 0x4e, 0x54, 0x4b, 0x10, 0x0f, 0x12, 0x11, 0x0a, 0x0d, 0x50, 0x52, 0x4f, 0x54,
+	0x4f, 0x43, 0x4f, 0x4c, 0x5f, 0x44, 0x48, 0x43, 0x50, 0x10, 0x10, 0x12, 0x11, 0x0a, 0x0d, 0x50,
+	0x52, 0x4f, 0x54, 0x4f, 0x43, 0x4f, 0x4c, 0x5f, 0x4d, 0x52, 0x54, 0x44, 0x10, 0x11, 0x12, 0x17,
+	0x0a, 0x13, 0x50, 0x52, 0x4f, 0x54, 0x4f, 0x43, 0x4f, 0x4c, 0x5f, 0x4b, 0x45, 0x45, 0x50, 0x41,
+	0x4c, 0x49, 0x56, 0x45, 0x44, 0x10, 0x12, 0x12, 0x12, 0x0a, 0x0e, 0x50, 0x52, 0x4f, 0x54, 0x4f,
+	0x43, 0x4f, 0x4c, 0x5f, 0x42, 0x41, 0x42, 0x45, 0x4c, 0x10, 0x2a, 0x12, 0x12, 0x0a, 0x0e, 0x50,
+	0x52, 0x4f, 0x54, 0x4f, 0x43, 0x4f, 0x4c, 0x5f, 0x4f, 0x50, 0x45, 0x4e, 0x52, 0x10, 0x63, 0x12,
+	0x11, 0x0a, 0x0c, 0x50, 0x52, 0x4f, 0x54, 0x4f, 0x43, 0x4f, 0x4c, 0x5f, 0x42, 0x47, 0x50, 0x10,
+	0xba, 0x01, 0x12, 0x12, 0x0a, 0x0d, 0x50, 0x52, 0x4f, 0x54, 0x4f, 0x43, 0x4f, 0x4c, 0x5f, 0x49,
+	0x53, 0x49, 0x53, 0x10, 0xbb, 0x01, 0x12, 0x12, 0x0a, 0x0d, 0x50, 0x52, 0x4f, 0x54, 0x4f, 0x43,
+	0x4f, 0x4c, 0x5f, 0x4f, 0x53, 0x50, 0x46, 0x10, 0xbc, 0x01, 0x12, 0x11, 0x0a, 0x0c, 0x50, 0x52,
+	0x4f, 0x54, 0x4f, 0x43, 0x4f, 0x4c, 0x5f, 0x52, 0x49, 0x50, 0x10, 0xbd, 0x01, 0x12, 0x13, 0x0a,
+	0x0e, 0x50, 0x52, 0x4f, 0x54, 0x4f, 0x43, 0x4f, 0x4c, 0x5f, 0x45, 0x49, 0x47, 0x52, 0x50, 0x10,
+	0xc0, 0x01, 0x2a, 0xf1, 0x01, 0x0a, 0x13, 0x4e, 0x65, 0x74, 0x68, 0x65, 0x6c, 0x70, 0x65, 0x72,
+	0x73, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x54, 0x79, 0x70, 0x65, 0x12, 0x0f, 0x0a, 0x0b, 0x54, 0x59,
+	0x50, 0x45, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x10, 0x00, 0x12, 0x10, 0x0a, 0x0c, 0x54,
+	0x59, 0x50, 0x45, 0x5f, 0x55, 0x4e, 0x49, 0x43, 0x41, 0x53, 0x54, 0x10, 0x01, 0x12, 0x0e, 0x0a,
+	0x0a, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x4c, 0x4f, 0x43, 0x41, 0x4c, 0x10, 0x02, 0x12, 0x12, 0x0a,
+	0x0e, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x42, 0x52, 0x4f, 0x41, 0x44, 0x43, 0x41, 0x53, 0x54, 0x10,
+	0x03, 0x12, 0x10, 0x0a, 0x0c, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x41, 0x4e, 0x59, 0x43, 0x41, 0x53,
+	0x54, 0x10, 0x04, 0x12, 0x12, 0x0a, 0x0e, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x4d, 0x55, 0x4c, 0x54,
+	0x49, 0x43, 0x41, 0x53, 0x54, 0x10, 0x05, 0x12, 0x12, 0x0a, 0x0e, 0x54, 0x59, 0x50, 0x45, 0x5f,
+	0x42, 0x4c, 0x41, 0x43, 0x4b, 0x48, 0x4f, 0x4c, 0x45, 0x10, 0x06, 0x12, 0x14, 0x0a, 0x10, 0x54,
+	0x59, 0x50, 0x45, 0x5f, 0x55, 0x4e, 0x52, 0x45, 0x41, 0x43, 0x48, 0x41, 0x42, 0x4c, 0x45, 0x10,
+	0x07, 0x12, 0x11, 0x0a, 0x0d, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x50, 0x52, 0x4f, 0x48, 0x49, 0x42,
+	0x49, 0x54, 0x10, 0x08, 0x12, 0x0e, 0x0a, 0x0a, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x54, 0x48, 0x52,
+	0x4f, 0x57, 0x10, 0x09, 0x12, 0x0c, 0x0a, 0x08, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x4e, 0x41, 0x54,
+	0x10, 0x0a, 0x12, 0x12, 0x0a, 0x0e, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x58, 0x5f, 0x52, 0x45, 0x53,
+	0x4f, 0x4c, 0x56, 0x45, 0x10, 0x0b, 0x2a, 0x81, 0x02, 0x0a, 0x1b, 0x4e, 0x65, 0x74, 0x68, 0x65,
+	0x6c, 0x70, 0x65, 0x72, 0x73, 0x52, 0x6f, 0x75, 0x74, 0x69, 0x6e, 0x67, 0x52, 0x75, 0x6c, 0x65,
+	0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1e, 0x0a, 0x1a, 0x52, 0x4f, 0x55, 0x54, 0x49, 0x4e,
+	0x47, 0x5f, 0x52, 0x55, 0x4c, 0x45, 0x5f, 0x41, 0x43, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x55, 0x4e,
+	0x53, 0x50, 0x45, 0x43, 0x10, 0x00, 0x12, 0x1d, 0x0a, 0x19, 0x52, 0x4f, 0x55, 0x54, 0x49, 0x4e,
+	0x47, 0x5f, 0x52, 0x55, 0x4c, 0x45, 0x5f, 0x41, 0x43, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x54, 0x41,
+	0x42, 0x4c, 0x45, 0x10, 0x01, 0x12, 0x1c, 0x0a, 0x18, 0x52, 0x4f, 0x55, 0x54, 0x49, 0x4e, 0x47,
+	0x5f, 0x52, 0x55, 0x4c, 0x45, 0x5f, 0x41, 0x43, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x47, 0x4f, 0x54,
+	0x4f, 0x10, 0x02, 0x12, 0x1b, 0x0a, 0x17, 0x52, 0x4f, 0x55, 0x54, 0x49, 0x4e, 0x47, 0x5f, 0x52,
+	0x55, 0x4c, 0x45, 0x5f, 0x41, 0x43, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x4e, 0x4f, 0x50, 0x10, 0x03,
+	0x12, 0x21, 0x0a, 0x1d, 0x52, 0x4f, 0x55, 0x54, 0x49, 0x4e, 0x47, 0x5f, 0x52, 0x55, 0x4c, 0x45,
+	0x5f, 0x41, 0x43, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x42, 0x4c, 0x41, 0x43, 0x4b, 0x48, 0x4f, 0x4c,
+	0x45, 0x10, 0x06, 0x12, 0x23, 0x0a, 0x1f, 0x52, 0x4f, 0x55, 0x54, 0x49, 0x4e, 0x47, 0x5f, 0x52,
+	0x55, 0x4c, 0x45, 0x5f, 0x41, 0x43, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x55, 0x4e, 0x52, 0x45, 0x41,
+	0x43, 0x48, 0x41, 0x42, 0x4c, 0x45, 0x10, 0x07, 0x12, 0x20, 0x0a, 0x1c, 0x52, 0x4f, 0x55, 0x54,
+	0x49, 0x4e, 0x47, 0x5f, 0x52, 0x55, 0x4c, 0x45, 0x5f, 0x41, 0x43, 0x54, 0x49, 0x4f, 0x4e, 0x5f,
+	0x50, 0x52, 0x4f, 0x48, 0x49, 0x42, 0x49, 0x54, 0x10, 0x08, 0x2a, 0x61, 0x0a, 0x16, 0x4e, 0x65,
+	0x74, 0x68, 0x65, 0x6c, 0x70, 0x65, 0x72, 0x73, 0x52, 0x6f, 0x75, 0x74, 0x69, 0x6e, 0x67, 0x54,
+	0x61, 0x62, 0x6c, 0x65, 0x12, 0x10, 0x0a, 0x0c, 0x54, 0x41, 0x42, 0x4c, 0x45, 0x5f, 0x55, 0x4e,
+	0x53, 0x50, 0x45, 0x43, 0x10, 0x00, 0x12, 0x12, 0x0a, 0x0d, 0x54, 0x41, 0x42, 0x4c, 0x45, 0x5f,
+	0x44, 0x45, 0x46, 0x41, 0x55, 0x4c, 0x54, 0x10, 0xfd, 0x01, 0x12, 0x0f, 0x0a, 0x0a, 0x54, 0x41,
+	0x42, 0x4c, 0x45, 0x5f, 0x4d, 0x41, 0x49, 0x4e, 0x10, 0xfe, 0x01, 0x12, 0x10, 0x0a, 0x0b, 0x54,
+	0x41, 0x42, 0x4c, 0x45, 0x5f, 0x4c, 0x4f, 0x43, 0x41, 0x4c, 0x10, 0xff, 0x01, 0x2a, 0x6a, 0x0a,
+	0x0f, 0x4e, 0x65, 0x74, 0x68, 0x65, 0x6c, 0x70, 0x65, 0x72, 0x73, 0x53, 0x63, 0x6f, 0x70, 0x65,
+	0x12, 0x10, 0x0a, 0x0c, 0x53, 0x43, 0x4f, 0x50, 0x45, 0x5f, 0x47, 0x4c, 0x4f, 0x42, 0x41, 0x4c,
+	0x10, 0x00, 0x12, 0x0f, 0x0a, 0x0a, 0x53, 0x43, 0x4f, 0x50, 0x45, 0x5f, 0x53, 0x49, 0x54, 0x45,
+	0x10, 0xc8, 0x01, 0x12, 0x0f, 0x0a, 0x0a, 0x53, 0x43, 0x4f, 0x50, 0x45, 0x5f, 0x4c, 0x49, 0x4e,
+	0x4b, 0x10, 0xfd, 0x01, 0x12, 0x0f, 0x0a, 0x0a, 0x53, 0x43, 0x4f, 0x50, 0x45, 0x5f, 0x48, 0x4f,
+	0x53, 0x54, 0x10, 0xfe, 0x01, 0x12, 0x12, 0x0a, 0x0d, 0x53, 0x43, 0x4f, 0x50, 0x45, 0x5f, 0x4e,
+	0x4f, 0x57, 0x48, 0x45, 0x52, 0x45, 0x10, 0xff, 0x01, 0x2a, 0x9b, 0x01, 0x0a, 0x22, 0x4e, 0x65,
+	0x74, 0x68, 0x65, 0x6c, 0x70, 0x65, 0x72, 0x73, 0x54, 0x72, 0x61, 0x66, 0x66, 0x69, 0x63, 0x43,
+	0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x4b, 0x69, 0x6e, 0x64,
+	0x12, 0x27, 0x0a, 0x23, 0x54, 0x52, 0x41, 0x46, 0x46, 0x49, 0x43, 0x5f, 0x43, 0x4f, 0x4e, 0x54,
+	0x52, 0x4f, 0x4c, 0x5f, 0x46, 0x49, 0x4c, 0x54, 0x45, 0x52, 0x5f, 0x4b, 0x49, 0x4e, 0x44, 0x5f,
+	0x46, 0x57, 0x5f, 0x4d, 0x41, 0x52, 0x4b, 0x10, 0x00, 0x12, 0x24, 0x0a, 0x20, 0x54, 0x52, 0x41,
+	0x46, 0x46, 0x49, 0x43, 0x5f, 0x43, 0x4f, 0x4e, 0x54, 0x52, 0x4f, 0x4c, 0x5f, 0x46, 0x49, 0x4c,
+	0x54, 0x45, 0x52, 0x5f, 0x4b, 0x49, 0x4e, 0x44, 0x5f, 0x44, 0x53, 0x43, 0x50, 0x10, 0x01, 0x12,
+	0x26, 0x0a, 0x22, 0x54, 0x52, 0x41, 0x46, 0x46, 0x49, 0x43, 0x5f, 0x43, 0x4f, 0x4e, 0x54, 0x52,
+	0x4f, 0x4c, 0x5f, 0x46, 0x49, 0x4c, 0x54, 0x45, 0x52, 0x5f, 0x4b, 0x49, 0x4e, 0x44, 0x5f, 0x43,
+	0x47, 0x52, 0x4f, 0x55, 0x50, 0x10, 0x02, 0x2a, 0x78, 0x0a, 0x16, 0x4e, 0x65, 0x74, 0x68, 0x65,
+	0x6c, 0x70, 0x65, 0x72, 0x73, 0x56, 0x4c, 0x41, 0x4e, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f,
+	0x6c, 0x12, 0x27, 0x0a, 0x23, 0x4e, 0x45, 0x54, 0x48, 0x45, 0x4c, 0x50, 0x45, 0x52, 0x53, 0x5f,
+	0x56, 0x4c, 0x41, 0x4e, 0x50, 0x52, 0x4f, 0x54, 0x4f, 0x43, 0x4f, 0x4c, 0x5f, 0x55, 0x4e, 0x53,
+	0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x19, 0x0a, 0x13, 0x56, 0x4c,
+	0x41, 0x4e, 0x5f, 0x50, 0x52, 0x4f, 0x54, 0x4f, 0x43, 0x4f, 0x4c, 0x38, 0x30, 0x32, 0x31, 0x5f,
+	0x51, 0x10, 0x80, 0x82, 0x02, 0x12, 0x1a, 0x0a, 0x14, 0x56, 0x4c, 0x41, 0x4e, 0x5f, 0x50, 0x52,
+	0x4f, 0x54, 0x4f, 0x43, 0x4f, 0x4c, 0x38, 0x30, 0x32, 0x31, 0x5f, 0x41, 0x44, 0x10, 0xa8, 0x91,
+	0x02, 0x2a, 0x53, 0x0a, 0x11, 0x4b, 0x75, 0x62, 0x65, 0x73, 0x70, 0x61, 0x6e, 0x50, 0x65, 0x65,
+	0x72, 0x53, 0x74, 0x61, 0x74, 0x65, 0x12, 0x16, 0x0a, 0x12, 0x50, 0x45, 0x45, 0x52, 0x5f, 0x53,
+	0x54, 0x41, 0x54, 0x45, 0x5f, 0x55, 0x4e, 0x4b, 0x4e, 0x4f, 0x57, 0x4e, 0x10, 0x00, 0x12, 0x11,
+	0x0a, 0x0d, 0x50, 0x45, 0x45, 0x52, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x45, 0x5f, 0x55, 0x50, 0x10,
+	0x01, 0x12, 0x13, 0x0a, 0x0f, 0x50, 0x45, 0x45, 0x52, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x45, 0x5f,
+	0x44, 0x4f, 0x57, 0x4e, 0x10, 0x02, 0x2a, 0x88, 0x01, 0x0a, 0x12, 0x4e, 0x65, 0x74, 0x77, 0x6f,
+	0x72, 0x6b, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x4c, 0x61, 0x79, 0x65, 0x72, 0x12, 0x12, 0x0a,
+	0x0e, 0x43, 0x4f, 0x4e, 0x46, 0x49, 0x47, 0x5f, 0x44, 0x45, 0x46, 0x41, 0x55, 0x4c, 0x54, 0x10,
+	0x00, 0x12, 0x12, 0x0a, 0x0e, 0x43, 0x4f, 0x4e, 0x46, 0x49, 0x47, 0x5f, 0x43, 0x4d, 0x44, 0x4c,
+	0x49, 0x4e, 0x45, 0x10, 0x01, 0x12, 0x13, 0x0a, 0x0f, 0x43, 0x4f, 0x4e, 0x46, 0x49, 0x47, 0x5f,
+	0x50, 0x4c, 0x41, 0x54, 0x46, 0x4f, 0x52, 0x4d, 0x10, 0x02, 0x12, 0x13, 0x0a, 0x0f, 0x43, 0x4f,
+	0x4e, 0x46, 0x49, 0x47, 0x5f, 0x4f, 0x50, 0x45, 0x52, 0x41, 0x54, 0x4f, 0x52, 0x10, 0x03, 0x12,
+	0x20, 0x0a, 0x1c, 0x43, 0x4f, 0x4e, 0x46, 0x49, 0x47, 0x5f, 0x4d, 0x41, 0x43, 0x48, 0x49, 0x4e,
+	0x45, 0x5f, 0x43, 0x4f, 0x4e, 0x46, 0x49, 0x47, 0x55, 0x52, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x10,
+	0x04, 0x2a, 0x5c, 0x0a, 0x0f, 0x4e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x4f, 0x70, 0x65, 0x72,
+	0x61, 0x74, 0x6f, 0x72, 0x12, 0x12, 0x0a, 0x0e, 0x4f, 0x50, 0x45, 0x52, 0x41, 0x54, 0x4f, 0x52,
+	0x5f, 0x44, 0x48, 0x43, 0x50, 0x34, 0x10, 0x00, 0x12, 0x12, 0x0a, 0x0e, 0x4f, 0x50, 0x45, 0x52,
+	0x41, 0x54, 0x4f, 0x52, 0x5f, 0x44, 0x48, 0x43, 0x50, 0x36, 0x10, 0x01, 0x12, 0x10, 0x0a, 0x0c,
+	0x4f, 0x50, 0x45, 0x52, 0x41, 0x54, 0x4f, 0x52, 0x5f, 0x56, 0x49, 0x50, 0x10, 0x02, 0x12, 0x0f,
+	0x0a, 0x0b, 0x4f, 0x50, 0x45, 0x52, 0x41, 0x54, 0x4f, 0x52, 0x5f, 0x52, 0x41, 0x10, 0x03, 0x2a,
+	0x9b, 0x02, 0x0a, 0x13, 0x52, 0x75, 0x6e, 0x74, 0x69, 0x6d, 0x65, 0x4d, 0x61, 0x63, 0x68, 0x69,
+	0x6e, 0x65, 0x53, 0x74, 0x61, 0x67, 0x65, 0x12, 0x19, 0x0a, 0x15, 0x4d, 0x41, 0x43, 0x48, 0x49,
+	0x4e, 0x45, 0x5f, 0x53, 0x54, 0x41, 0x47, 0x45, 0x5f, 0x55, 0x4e, 0x4b, 0x4e, 0x4f, 0x57, 0x4e,
+	0x10, 0x00, 0x12, 0x19, 0x0a, 0x15, 0x4d, 0x41, 0x43, 0x48, 0x49, 0x4e, 0x45, 0x5f, 0x53, 0x54,
+	0x41, 0x47, 0x45, 0x5f, 0x42, 0x4f, 0x4f, 0x54, 0x49, 0x4e, 0x47, 0x10, 0x01, 0x12, 0x1c, 0x0a,
+	0x18, 0x4d, 0x41, 0x43, 0x48, 0x49, 0x4e, 0x45, 0x5f, 0x53, 0x54, 0x41, 0x47, 0x45, 0x5f, 0x49,
+	0x4e, 0x53, 0x54, 0x41, 0x4c, 0x4c, 0x49, 0x4e, 0x47, 0x10, 0x02, 0x12, 0x1d, 0x0a, 0x19, 0x4d,
+	0x41, 0x43, 0x48, 0x49, 0x4e, 0x45, 0x5f, 0x53, 0x54, 0x41, 0x47, 0x45, 0x5f, 0x4d, 0x41, 0x49,
+	0x4e, 0x54, 0x45, 0x4e, 0x41, 0x4e, 0x43, 0x45, 0x10, 0x03, 0x12, 0x19, 0x0a, 0x15, 0x4d, 0x41,
+	0x43, 0x48, 0x49, 0x4e, 0x45, 0x5f, 0x53, 0x54, 0x41, 0x47, 0x45, 0x5f, 0x52, 0x55, 0x4e, 0x4e,
+	0x49, 0x4e, 0x47, 0x10, 0x04, 0x12, 0x1b, 0x0a, 0x17, 0x4d, 0x41, 0x43, 0x48, 0x49, 0x4e, 0x45,
+	0x5f, 0x53, 0x54, 0x41, 0x47, 0x45, 0x5f, 0x52, 0x45, 0x42, 0x4f, 0x4f, 0x54, 0x49, 0x4e, 0x47,
+	0x10, 0x05, 0x12, 0x1f, 0x0a, 0x1b, 0x4d, 0x41, 0x43, 0x48, 0x49, 0x4e, 0x45, 0x5f, 0x53, 0x54,
+	0x41, 0x47, 0x45, 0x5f, 0x53, 0x48, 0x55, 0x54, 0x54, 0x49, 0x4e, 0x47, 0x5f, 0x44, 0x4f, 0x57,
+	0x4e, 0x10, 0x06, 0x12, 0x1b, 0x0a, 0x17, 0x4d, 0x41, 0x43, 0x48, 0x49, 0x4e, 0x45, 0x5f, 0x53,
+	0x54, 0x41, 0x47, 0x45, 0x5f, 0x52, 0x45, 0x53, 0x45, 0x54, 0x54, 0x49, 0x4e, 0x47, 0x10, 0x07,
+	0x12, 0x1b, 0x0a, 0x17, 0x4d, 0x41, 0x43, 0x48, 0x49, 0x4e, 0x45, 0x5f, 0x53, 0x54, 0x41, 0x47,
+	0x45, 0x5f, 0x55, 0x50, 0x47, 0x52, 0x41, 0x44, 0x49, 0x4e, 0x47, 0x10, 0x08, 0x42, 0x4a, 0x5a,
+	0x48, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x73, 0x69, 0x64, 0x65,
+	0x72, 0x6f, 0x6c, 0x61, 0x62, 0x73, 0x2f, 0x74, 0x61, 0x6c, 0x6f, 0x73, 0x2f, 0x70, 0x6b, 0x67,
+	0x2f, 0x6d, 0x61, 0x63, 0x68, 0x69, 0x6e, 0x65, 0x72, 0x79, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x72,
+	0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x2f, 0x64, 0x65, 0x66, 0x69, 0x6e, 0x69, 0x74, 0x69,
+	0x6f, 0x6e, 0x73, 0x2f, 0x65, 0x6e, 0x75, 0x6d, 0x73, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x33,
 }
 
 var (
@@ -2058,33 +2948,46 @@ func file_resource_definitions_enums_enums_proto_rawDescGZIP() []byte {
 	return file_resource_definitions_enums_enums_proto_rawDescData
 }
 
-var file_resource_definitions_enums_enums_proto_enumTypes = make([]protoimpl.EnumInfo, 25)
+var file_resource_definitions_enums_enums_proto_enumTypes = make([]protoimpl.EnumInfo, 38)
 var file_resource_definitions_enums_enums_proto_goTypes = []interface{}{
-	(MachineType)(0),                  // 0: talos.resource.definitions.enums.MachineType
-	(NethelpersAddressFlag)(0),        // 1: talos.resource.definitions.enums.NethelpersAddressFlag
-	(NethelpersADSelect)(0),           // 2: talos.resource.definitions.enums.NethelpersADSelect
-	(NethelpersARPAllTargets)(0),      // 3: talos.resource.definitions.enums.NethelpersARPAllTargets
-	(NethelpersARPValidate)(0),        // 4: talos.resource.definitions.enums.NethelpersARPValidate
-	(NethelpersBondMode)(0),           // 5: talos.resource.definitions.enums.NethelpersBondMode
-	(NethelpersBondXmitHashPolicy)(0), // 6: talos.resource.definitions.enums.NethelpersBondXmitHashPolicy
-	(NethelpersDuplex)(0),             // 7: talos.resource.definitions.enums.NethelpersDuplex
-	(NethelpersFailOverMAC)(0),        // 8: talos.resource.definitions.enums.NethelpersFailOverMAC
-	(NethelpersFamily)(0),             // 9: talos.resource.definitions.enums.NethelpersFamily
-	(NethelpersLACPRate)(0),           // 10: talos.resource.definitions.enums.NethelpersLACPRate
-	(NethelpersLinkType)(0),           // 11: talos.resource.definitions.enums.NethelpersLinkType
-	(NethelpersOperationalState)(0),   // 12: talos.resource.definitions.enums.NethelpersOperationalState
-	(NethelpersPort)(0),               // 13: talos.resource.definitions.enums.NethelpersPort
-	(NethelpersPrimaryReselect)(0),    // 14: talos.resource.definitions.enums.NethelpersPrimaryReselect
-	(NethelpersRouteFlag)(0),          // 15: talos.resource.definitions.enums.NethelpersRouteFlag
-	(NethelpersRouteProtocol)(0),      // 16: talos.resource.definitions.enums.NethelpersRouteProtocol
-	(NethelpersRouteType)(0),          // 17: talos.resource.definitions.enums.NethelpersRouteType
-	(NethelpersRoutingTable)(0),       // 18: talos.resource.definitions.enums.NethelpersRoutingTable
-	(NethelpersScope)(0),              // 19: talos.resource.definitions.enums.NethelpersScope
-	(NethelpersVLANProtocol)(0),       // 20: talos.resource.definitions.enums.NethelpersVLANProtocol
-	(KubespanPeerState)(0),            // 21: talos.resource.definitions.enums.KubespanPeerState
-	(NetworkConfigLayer)(0),           // 22: talos.resource.definitions.enums.NetworkConfigLayer
-	(NetworkOperator)(0),              // 23: talos.resource.definitions.enums.NetworkOperator
-	(RuntimeMachineStage)(0),          // 24: talos.resource.definitions.enums.RuntimeMachineStage
+	(MachineType)(0),                        // 0: talos.resource.definitions.enums.MachineType
+	(NethelpersAddressFlag)(0),              // 1: talos.resource.definitions.enums.NethelpersAddressFlag
+	(NethelpersAddrGenMode)(0),              // 2: talos.resource.definitions.enums.NethelpersAddrGenMode
+	(NethelpersADSelect)(0),                 // 3: talos.resource.definitions.enums.NethelpersADSelect
+	(NethelpersARPAllTargets)(0),            // 4: talos.resource.definitions.enums.NethelpersARPAllTargets
+	(NethelpersARPValidate)(0),              // 5: talos.resource.definitions.enums.NethelpersARPValidate
+	(NethelpersBondMode)(0),                 // 6: talos.resource.definitions.enums.NethelpersBondMode
+	(NethelpersBondXmitHashPolicy)(0),       // 7: talos.resource.definitions.enums.NethelpersBondXmitHashPolicy
+	(NethelpersConntrackState)(0),           // 8: talos.resource.definitions.enums.NethelpersConntrackState
+	(NethelpersDefaultAction)(0),            // 9: talos.resource.definitions.enums.NethelpersDefaultAction
+	(NethelpersDuplex)(0),                   // 10: talos.resource.definitions.enums.NethelpersDuplex
+	(NethelpersFailOverMAC)(0),              // 11: talos.resource.definitions.enums.NethelpersFailOverMAC
+	(NethelpersFamily)(0),                   // 12: talos.resource.definitions.enums.NethelpersFamily
+	(NethelpersIPVLANMode)(0),               // 13: talos.resource.definitions.enums.NethelpersIPVLANMode
+	(NethelpersLACPRate)(0),                 // 14: talos.resource.definitions.enums.NethelpersLACPRate
+	(NethelpersLinkType)(0),                 // 15: talos.resource.definitions.enums.NethelpersLinkType
+	(NethelpersMACVLANMode)(0),              // 16: talos.resource.definitions.enums.NethelpersMACVLANMode
+	(NethelpersNeighborState)(0),            // 17: talos.resource.definitions.enums.NethelpersNeighborState
+	(NethelpersNfTablesChainHook)(0),        // 18: talos.resource.definitions.enums.NethelpersNfTablesChainHook
+	(NethelpersNfTablesChainPriority)(0),    // 19: talos.resource.definitions.enums.NethelpersNfTablesChainPriority
+	(NethelpersNfTablesVerdict)(0),          // 20: talos.resource.definitions.enums.NethelpersNfTablesVerdict
+	(NethelpersOperationalState)(0),         // 21: talos.resource.definitions.enums.NethelpersOperationalState
+	(NethelpersPort)(0),                     // 22: talos.resource.definitions.enums.NethelpersPort
+	(NethelpersPrimaryReselect)(0),          // 23: talos.resource.definitions.enums.NethelpersPrimaryReselect
+	(NethelpersProtocol)(0),                 // 24: talos.resource.definitions.enums.NethelpersProtocol
+	(NethelpersQdiscKind)(0),                // 25: talos.resource.definitions.enums.NethelpersQdiscKind
+	(NethelpersRouteFlag)(0),                // 26: talos.resource.definitions.enums.NethelpersRouteFlag
+	(NethelpersRouteProtocol)(0),            // 27: talos.resource.definitions.enums.NethelpersRouteProtocol
+	(NethelpersRouteType)(0),                // 28: talos.resource.definitions.enums.NethelpersRouteType
+	(NethelpersRoutingRuleAction)(0),        // 29: talos.resource.definitions.enums.NethelpersRoutingRuleAction
+	(NethelpersRoutingTable)(0),             // 30: talos.resource.definitions.enums.NethelpersRoutingTable
+	(NethelpersScope)(0),                    // 31: talos.resource.definitions.enums.NethelpersScope
+	(NethelpersTrafficControlFilterKind)(0), // 32: talos.resource.definitions.enums.NethelpersTrafficControlFilterKind
+	(NethelpersVLANProtocol)(0),             // 33: talos.resource.definitions.enums.NethelpersVLANProtocol
+	(KubespanPeerState)(0),                  // 34: talos.resource.definitions.enums.KubespanPeerState
+	(NetworkConfigLayer)(0),                 // 35: talos.resource.definitions.enums.NetworkConfigLayer
+	(NetworkOperator)(0),                    // 36: talos.resource.definitions.enums.NetworkOperator
+	(RuntimeMachineStage)(0),                // 37: talos.resource.definitions.enums.RuntimeMachineStage
 }
 var file_resource_definitions_enums_enums_proto_depIdxs = []int32{
 	0, // [0:0] is the sub-list for method output_type
@@ -2104,7 +3007,7 @@ func file_resource_definitions_enums_enums_proto_init() {
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_resource_definitions_enums_enums_proto_rawDesc,
-			NumEnums:      25,
+			NumEnums:      38,
 			NumMessages:   0,
 			NumExtensions: 0,
 			NumServices:   0,
@@ -343,8 +343,8 @@ func (c *Client) ResetGeneric(ctx context.Context, req *machineapi.ResetRequest)
 }
 
 // ResetGenericWithResponse resets the machine and returns the response.
-func (c *Client) ResetGenericWithResponse(ctx context.Context, req *machineapi.ResetRequest) (*machineapi.ResetResponse, error) {
-	resp, err := c.MachineClient.Reset(ctx, req)
+func (c *Client) ResetGenericWithResponse(ctx context.Context, req *machineapi.ResetRequest, callOptions ...grpc.CallOption) (*machineapi.ResetResponse, error) {
+	resp, err := c.MachineClient.Reset(ctx, req, callOptions...)
 
 	if err == nil {
 		_, err = FilterMessages(resp, err)
@@ -361,6 +361,11 @@ func WithPowerCycle(req *machineapi.RebootRequest) {
 	req.Mode = machineapi.RebootRequest_POWERCYCLE
 }
 
+// WithRebootDryRun option returns the reboot plan without rebooting.
+func WithRebootDryRun(req *machineapi.RebootRequest) {
+	req.DryRun = true
+}
+
 // Reboot implements the proto.MachineServiceClient interface.
 func (c *Client) Reboot(ctx context.Context, opts ...RebootMode) error {
 	_, err := c.RebootWithResponse(ctx, opts...)
@@ -545,7 +550,7 @@ func (c *Client) Copy(ctx context.Context, rootPath string) (io.ReadCloser, <-ch
 
 // Upgrade initiates a Talos upgrade and implements the proto.MachineServiceClient interface.
 func (c *Client) Upgrade(ctx context.Context, image string, preserve, stage, force bool, callOptions ...grpc.CallOption) (resp *machineapi.UpgradeResponse, err error) {
-	resp, err = c.MachineClient.Upgrade(
+	return c.UpgradeGeneric(
 		ctx,
 		&machineapi.UpgradeRequest{
 			Image:    image,
@@ -555,6 +560,11 @@ func (c *Client) Upgrade(ctx context.Context, image string, preserve, stage, for
 		},
 		callOptions...,
 	)
+}
+
+// UpgradeGeneric upgrades the machine with the request and returns the response.
+func (c *Client) UpgradeGeneric(ctx context.Context, req *machineapi.UpgradeRequest, callOptions ...grpc.CallOption) (resp *machineapi.UpgradeResponse, err error) {
+	resp, err = c.MachineClient.Upgrade(ctx, req, callOptions...)
 
 	var filtered interface{}
 	filtered, err = FilterMessages(resp, err)
@@ -1003,3 +1013,42 @@ func (c *Client) MetaDelete(ctx context.Context, key uint8, callOptions ...grpc.
 
 	return err
 }
+
+// ImageList lists images in the CRI.
+func (c *Client) ImageList(ctx context.Context, namespace common.ContainerdNamespace, callOptions ...grpc.CallOption) (machineapi.MachineService_ImageListClient, error) {
+	return c.MachineClient.ImageList(
+		ctx,
+		&machineapi.ImageListRequest{
+			Namespace: namespace,
+		},
+		callOptions...,
+	)
+}
+
+// ImagePull pulls an image into the CRI.
+//
+// The progress of the pull is streamed back for each fetched layer.
+func (c *Client) ImagePull(ctx context.Context, namespace common.ContainerdNamespace, reference string, callOptions ...grpc.CallOption) (machineapi.MachineService_ImagePullClient, error) {
+	return c.MachineClient.ImagePull(
+		ctx,
+		&machineapi.ImagePullRequest{
+			Namespace: namespace,
+			Reference: reference,
+		},
+		callOptions...,
+	)
+}
+
+// ImageRemove removes images from the CRI.
+//
+// A message is streamed back for each removed image.
+func (c *Client) ImageRemove(ctx context.Context, namespace common.ContainerdNamespace, references []string, callOptions ...grpc.CallOption) (machineapi.MachineService_ImageRemoveClient, error) {
+	return c.MachineClient.ImageRemove(
+		ctx,
+		&machineapi.ImageRemoveRequest{
+			Namespace:  namespace,
+			References: references,
+		},
+		callOptions...,
+	)
+}
@@ -239,6 +239,7 @@ func UnmarshalEvent(event *machineapi.Event) (*Event, error) {
 		&machineapi.ConfigValidationErrorEvent{},
 		&machineapi.AddressEvent{},
 		&machineapi.MachineStatusEvent{},
+		&machineapi.UpgradeProgressEvent{},
 	} {
 		if typeURL == "talos/runtime/"+string(eventType.ProtoReflect().Descriptor().FullName()) {
 			msg = eventType
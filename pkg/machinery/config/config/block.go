@@ -0,0 +1,41 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package config
+
+// UserVolumeConfig defines the interface to access user volume configuration.
+type UserVolumeConfig interface {
+	NamedDocument
+	Provisioning() VolumeProvisioningConfig
+	Filesystem() FilesystemConfig
+	// Encryption returns nil if the volume is not encrypted.
+	Encryption() Encryption
+}
+
+// VolumeProvisioningConfig defines the interface to access volume provisioning configuration.
+type VolumeProvisioningConfig interface {
+	DiskSelector() VolumeDiskSelector
+	MinSize() uint64
+	MaxSize() uint64
+	Grow() bool
+}
+
+// VolumeDiskSelector defines the interface to select the disk to provision the volume on.
+type VolumeDiskSelector interface {
+	Match(disk VolumeDisk) bool
+}
+
+// VolumeDisk describes the disk properties used by the disk selector.
+type VolumeDisk struct {
+	Size       uint64
+	Model      string
+	Transport  string
+	WWID       string
+	SystemDisk bool
+}
+
+// FilesystemConfig defines the interface to access volume filesystem configuration.
+type FilesystemConfig interface {
+	Type() string
+}
@@ -32,6 +32,8 @@ type ClusterConfig interface {
 	ServiceAccount() *x509.PEMEncodedKey
 	AESCBCEncryptionSecret() string
 	SecretboxEncryptionSecret() string
+	// KMSEncryption returns settings for the encryption of secret data at rest with a KMS plugin.
+	KMSEncryption() KMSEncryption
 	Etcd() Etcd
 	Network() ClusterNetwork
 	LocalAPIServerPort() int
@@ -148,6 +150,22 @@ type ExternalCloudProvider interface {
 	ManifestURLs() []string
 }
 
+// KMSEncryption defines settings for the encryption of secret data at rest with a KMSv2 plugin.
+type KMSEncryption interface {
+	// Enabled returns true if the KMS plugin is used for encryption.
+	Enabled() bool
+	// Name returns the name of the KMS provider.
+	Name() string
+	// Endpoint returns the gRPC endpoint of the KMS plugin.
+	Endpoint() string
+	// Timeout returns the timeout for the calls to the KMS plugin.
+	Timeout() time.Duration
+	// PluginImage returns the image of the KMS plugin managed by Talos, if empty, the plugin is run by the user.
+	PluginImage() string
+	// PluginExtraArgs returns the extra arguments of the KMS plugin managed by Talos.
+	PluginExtraArgs() map[string]string
+}
+
 // AdminKubeconfig defines settings for admin kubeconfig.
 type AdminKubeconfig interface {
 	CommonName() string
@@ -12,4 +12,10 @@ type Config interface {
 	Machine() MachineConfig
 	Cluster() ClusterConfig
 	SideroLink() SideroLinkConfig
+	NetworkRules() NetworkRuleConfig
+	EtcdBackup() EtcdBackupConfig
+	PersistentLogs() PersistentLogsConfig
+	UserVolumes() []UserVolumeConfig
+	EthernetConfigs() []EthernetConfig
+	TrafficControlConfigs() []TrafficControlConfig
 }
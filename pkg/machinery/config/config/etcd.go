@@ -0,0 +1,14 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package config
+
+import "time"
+
+// EtcdBackupConfig defines the interface to access scheduled etcd backup configuration.
+type EtcdBackupConfig interface {
+	Interval() time.Duration
+	RetentionCount() int
+	RetentionMaxAge() time.Duration
+}
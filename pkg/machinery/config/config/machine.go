@@ -131,6 +131,7 @@ type MachineNetwork interface {
 	ExtraHosts() []ExtraHost
 	KubeSpan() KubeSpan
 	DisableSearchDomain() bool
+	LLDP() LLDP
 }
 
 // ExtraHost represents a host entry in /etc/hosts.
@@ -146,6 +147,8 @@ type Device interface {
 	Interface() string
 	Addresses() []string
 	Routes() []Route
+	Rules() []RoutingRule
+	Neighbors() []Neighbor
 	Bond() Bond
 	Bridge() Bridge
 	Vlans() []Vlan
@@ -154,8 +157,13 @@ type Device interface {
 	Ignore() bool
 	Dummy() bool
 	DHCPOptions() DHCPOptions
+	IPv6Config() IPv6Config
 	VIPConfig() VIPConfig
 	WireguardConfig() WireguardConfig
+	VRF() VRF
+	VXLAN() VXLAN
+	MACVLAN() MACVLAN
+	IPVLAN() IPVLAN
 	Selector() NetworkDeviceSelector
 }
 
@@ -167,11 +175,22 @@ type DHCPOptions interface {
 	DUIDv6() string
 }
 
+// IPv6Config contains IPv6 router advertisement and address autoconfiguration settings.
+type IPv6Config interface {
+	AcceptRA() bool
+	Autoconf() bool
+	PrivacyExtensions() bool
+	AddrGenMode() string
+	RouteMetric() uint32
+}
+
 // VIPConfig contains settings for the Virtual (shared) IP setup.
 type VIPConfig interface {
 	IP() string
 	EquinixMetal() VIPEquinixMetal
 	HCloud() VIPHCloud
+	BGP() VIPBGP
+	Probe() NetworkProbe
 }
 
 // VIPEquinixMetal contains Equinix Metal API VIP settings.
@@ -184,6 +203,20 @@ type VIPHCloud interface {
 	APIToken() string
 }
 
+// VIPBGP contains settings for announcing the VIP over BGP.
+type VIPBGP interface {
+	LocalASN() uint32
+	RouterID() string
+	Peers() []VIPBGPPeer
+}
+
+// VIPBGPPeer is a BGP peer the VIP is announced to.
+type VIPBGPPeer interface {
+	Address() string
+	ASN() uint32
+	Password() string
+}
+
 // WireguardConfig contains settings for configuring Wireguard network interface.
 type WireguardConfig interface {
 	PrivateKey() string
@@ -246,6 +279,39 @@ type Bridge interface {
 	STP() STP
 }
 
+// VRF contains the options for configuring a VRF interface.
+type VRF interface {
+	Interfaces() []string
+	Table() uint32
+}
+
+// Neighbor represents a permanent neighbor (ARP/NDP) entry.
+type Neighbor interface {
+	IP() string
+	LinkAddr() string
+	Proxy() bool
+}
+
+// VXLAN contains the options for configuring a VXLAN interface.
+type VXLAN interface {
+	ID() uint32
+	Local() string
+	Remote() string
+	Port() uint16
+}
+
+// MACVLAN contains the options for configuring a MACVLAN interface.
+type MACVLAN interface {
+	Parent() string
+	Mode() string
+}
+
+// IPVLAN contains the options for configuring an IPVLAN interface.
+type IPVLAN interface {
+	Parent() string
+	Mode() string
+}
+
 // Vlan represents vlan settings for a device.
 type Vlan interface {
 	Addresses() []string
@@ -264,6 +330,42 @@ type Route interface {
 	Source() string
 	Metric() uint32
 	MTU() uint32
+	Probe() NetworkProbe
+}
+
+// NetworkProbe represents a network probe which gates a VIP or a route.
+type NetworkProbe interface {
+	Interval() time.Duration
+	FailureThreshold() int
+	Timeout() time.Duration
+	TCP() string
+	ICMP() string
+	HTTP() NetworkProbeHTTP
+	DNS() NetworkProbeDNS
+}
+
+// NetworkProbeHTTP represents HTTP(S) GET network probe settings.
+type NetworkProbeHTTP interface {
+	URL() string
+	ExpectedStatus() int
+}
+
+// NetworkProbeDNS represents DNS resolution network probe settings.
+type NetworkProbeDNS interface {
+	Name() string
+	Server() string
+}
+
+// RoutingRule represents a policy routing rule.
+type RoutingRule interface {
+	Priority() uint32
+	From() string
+	To() string
+	FwMark() uint32
+	FwMask() uint32
+	IIF() string
+	OIF() string
+	Table() uint32
 }
 
 // KubeSpan configures KubeSpan feature.
@@ -280,6 +382,12 @@ type KubeSpanFilters interface {
 	Endpoints() []string
 }
 
+// LLDP configures LLDP advertisements.
+type LLDP interface {
+	Transmit() bool
+	TransmitInterval() time.Duration
+}
+
 // NetworkDeviceSelector defines the set of fields that can be used to pick network a device.
 type NetworkDeviceSelector interface {
 	Bus() string
@@ -294,6 +402,24 @@ type Time interface {
 	Disabled() bool
 	Servers() []string
 	BootTimeout() time.Duration
+	NTSFallback() bool
+	NTSNoCertTimeCheck() int
+	NTPServer() NTPServer
+	PTP() PTP
+}
+
+// PTP configures time sync from the PTP hardware clock.
+type PTP interface {
+	Enabled() bool
+	Device() string
+	Interface() string
+	PollInterval() time.Duration
+}
+
+// NTPServer configures the NTP server running on the node.
+type NTPServer interface {
+	Enabled() bool
+	Interfaces() []string
 }
 
 // Kubelet defines the requirements for a config that pertains to kubelet
@@ -356,6 +482,8 @@ type RegistryTLSConfig interface {
 type EncryptionKey interface {
 	Static() EncryptionKeyStatic
 	NodeID() EncryptionKeyNodeID
+	TPM() EncryptionKeyTPM
+	KMS() EncryptionKeyKMS
 	Slot() int
 }
 
@@ -367,6 +495,14 @@ type EncryptionKeyStatic interface {
 // EncryptionKeyNodeID deterministically generated encryption key.
 type EncryptionKeyNodeID interface{}
 
+// EncryptionKeyTPM encryption key sealed by the TPM 2.0 device.
+type EncryptionKeyTPM interface{}
+
+// EncryptionKeyKMS encryption key sealed by the remote KMS.
+type EncryptionKeyKMS interface {
+	Endpoint() string
+}
+
 // Encryption defines settings for the partition encryption.
 type Encryption interface {
 	Kind() string
@@ -390,6 +526,7 @@ type Features interface {
 	ApidCheckExtKeyUsageEnabled() bool
 	DiskQuotaSupportEnabled() bool
 	APIServerBalancer() APIServerBalancer
+	HostDNS() HostDNS
 }
 
 // KubernetesTalosAPIAccess describes the Kubernetes Talos API access features.
@@ -405,6 +542,19 @@ type APIServerBalancer interface {
 	Port() int
 }
 
+// HostDNS describes the host caching DNS resolver features.
+type HostDNS interface {
+	Enabled() bool
+	ForwardKubeDNSToHost() bool
+	ForwardDomains() []HostDNSForwardDomain
+}
+
+// HostDNSForwardDomain describes forwarding of a domain to specific DNS servers.
+type HostDNSForwardDomain interface {
+	Domain() string
+	Servers() []string
+}
+
 // UdevConfig describes configuration for udev.
 type UdevConfig interface {
 	Rules() []string
@@ -419,6 +569,15 @@ type Logging interface {
 type LoggingDestination interface {
 	Endpoint() *url.URL
 	Format() string
+	TLS() LoggingTLSConfig
+}
+
+// LoggingTLSConfig describes TLS configuration of the logging destination.
+type LoggingTLSConfig interface {
+	ClientIdentity() *x509.PEMEncodedCertificateAndKey
+	CA() []byte
+	InsecureSkipVerify() bool
+	GetTLSConfig() (*tls.Config, error)
 }
 
 // Kernel describes Talos Linux kernel configuration.
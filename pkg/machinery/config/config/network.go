@@ -0,0 +1,139 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package config
+
+import (
+	"net/netip"
+	"time"
+
+	"github.com/siderolabs/talos/pkg/machinery/nethelpers"
+)
+
+// NetworkRuleConfig defines the interface to access the host firewall configuration.
+type NetworkRuleConfig interface {
+	NetworkRuleConfigRules
+	NetworkRuleConfigDefaultAction
+}
+
+// NetworkRuleConfigRules defines the interface to access the host firewall ingress rules.
+type NetworkRuleConfigRules interface {
+	Rules() []NetworkRule
+}
+
+// NetworkRuleConfigDefaultAction defines the interface to access the host firewall default ingress action.
+type NetworkRuleConfigDefaultAction interface {
+	DefaultAction() nethelpers.DefaultAction
+}
+
+// NetworkRule defines a single ingress firewall rule.
+type NetworkRule interface {
+	Name() string
+	Protocol() nethelpers.Protocol
+	PortRanges() [][2]uint16
+	IngressSubnets() []NetworkRuleIngress
+}
+
+// NetworkRuleIngress defines a subnet allowed by the ingress firewall rule.
+//
+// Except (if valid) is a part of the subnet which is not allowed.
+type NetworkRuleIngress struct {
+	Subnet netip.Prefix
+	Except netip.Prefix
+}
+
+// EthernetConfig defines the interface to access the ethtool settings of a link.
+//
+// The document name is the link name.
+type EthernetConfig interface {
+	NamedDocument
+	Rings() EthernetRingsConfig
+	Features() map[string]bool
+	Channels() EthernetChannelsConfig
+	Pause() EthernetPauseConfig
+}
+
+// EthernetRingsConfig describes the ring buffer sizes of a link.
+//
+// Fields which are not set are left unchanged.
+type EthernetRingsConfig struct {
+	RX *uint32
+	TX *uint32
+}
+
+// EthernetChannelsConfig describes the channel counts of a link.
+//
+// Fields which are not set are left unchanged.
+type EthernetChannelsConfig struct {
+	RX       *uint32
+	TX       *uint32
+	Other    *uint32
+	Combined *uint32
+}
+
+// EthernetPauseConfig describes the pause frame settings of a link.
+//
+// Fields which are not set are left unchanged.
+type EthernetPauseConfig struct {
+	Autonegotiate *bool
+	RX            *bool
+	TX            *bool
+}
+
+// TrafficControlConfig defines the interface to access the traffic control (tc) settings of a link.
+//
+// The document name is the link name.
+type TrafficControlConfig interface {
+	NamedDocument
+	Qdisc() nethelpers.QdiscKind
+	FQCodel() TrafficControlFQCodelConfig
+	HTB() TrafficControlHTBConfig
+	TBF() TrafficControlTBFConfig
+	Filters() []TrafficControlFilterConfig
+}
+
+// TrafficControlFQCodelConfig describes the fq_codel qdisc settings.
+//
+// Zero values keep the kernel defaults.
+type TrafficControlFQCodelConfig struct {
+	Limit    uint32
+	Flows    uint32
+	Interval time.Duration
+	ECN      bool
+}
+
+// TrafficControlHTBConfig describes the htb qdisc settings.
+type TrafficControlHTBConfig struct {
+	DefaultClass uint16
+	Classes      []TrafficControlHTBClassConfig
+}
+
+// TrafficControlHTBClassConfig describes a class of the htb qdisc.
+//
+// Rates are in bytes per second.
+type TrafficControlHTBClassConfig struct {
+	ClassID  uint16
+	Rate     uint64
+	Ceil     uint64
+	Priority uint32
+}
+
+// TrafficControlTBFConfig describes the tbf qdisc settings.
+//
+// Rate is in bytes per second, burst is in bytes.
+type TrafficControlTBFConfig struct {
+	Rate    uint64
+	Burst   uint32
+	Latency time.Duration
+}
+
+// TrafficControlFilterConfig describes a filter which classifies the traffic into a class of the root qdisc.
+type TrafficControlFilterConfig struct {
+	Kind       nethelpers.TrafficControlFilterKind
+	ClassID    uint16
+	FwMark     uint32
+	FwMask     uint32
+	DSCP       uint8
+	CgroupPath string
+}
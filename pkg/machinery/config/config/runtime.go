@@ -0,0 +1,12 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package config
+
+// PersistentLogsConfig defines the interface to access persistent service logs configuration.
+type PersistentLogsConfig interface {
+	Path() string
+	MaxBoots() int
+	MaxSegments() int
+}
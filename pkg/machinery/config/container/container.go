@@ -17,6 +17,7 @@ import (
 	"github.com/siderolabs/talos/pkg/machinery/config/encoder"
 	"github.com/siderolabs/talos/pkg/machinery/config/types/v1alpha1"
 	"github.com/siderolabs/talos/pkg/machinery/config/validation"
+	"github.com/siderolabs/talos/pkg/machinery/nethelpers"
 )
 
 // Container wraps all configuration documents into a single container.
@@ -147,6 +148,114 @@ func (container *Container) SideroLink() config.SideroLinkConfig {
 	return nil
 }
 
+// EtcdBackup implements config.Config interface.
+func (container *Container) EtcdBackup() config.EtcdBackupConfig {
+	for _, doc := range container.documents {
+		if c, ok := doc.(config.EtcdBackupConfig); ok {
+			return c
+		}
+	}
+
+	return nil
+}
+
+// PersistentLogs implements config.Config interface.
+func (container *Container) PersistentLogs() config.PersistentLogsConfig {
+	for _, doc := range container.documents {
+		if c, ok := doc.(config.PersistentLogsConfig); ok {
+			return c
+		}
+	}
+
+	return nil
+}
+
+// UserVolumes implements config.Config interface.
+func (container *Container) UserVolumes() []config.UserVolumeConfig {
+	var volumes []config.UserVolumeConfig
+
+	for _, doc := range container.documents {
+		if c, ok := doc.(config.UserVolumeConfig); ok {
+			volumes = append(volumes, c)
+		}
+	}
+
+	return volumes
+}
+
+// EthernetConfigs implements config.Config interface.
+func (container *Container) EthernetConfigs() []config.EthernetConfig {
+	var configs []config.EthernetConfig
+
+	for _, doc := range container.documents {
+		if c, ok := doc.(config.EthernetConfig); ok {
+			configs = append(configs, c)
+		}
+	}
+
+	return configs
+}
+
+// TrafficControlConfigs implements config.Config interface.
+func (container *Container) TrafficControlConfigs() []config.TrafficControlConfig {
+	var configs []config.TrafficControlConfig
+
+	for _, doc := range container.documents {
+		if c, ok := doc.(config.TrafficControlConfig); ok {
+			configs = append(configs, c)
+		}
+	}
+
+	return configs
+}
+
+// NetworkRules implements config.Config interface.
+//
+// NetworkRules aggregates the default action and all network rules documents,
+// it returns nil if the host firewall is not configured.
+func (container *Container) NetworkRules() config.NetworkRuleConfig {
+	var (
+		defaultAction config.NetworkRuleConfigDefaultAction
+		rules         []config.NetworkRule
+	)
+
+	for _, doc := range container.documents {
+		if c, ok := doc.(config.NetworkRuleConfigDefaultAction); ok {
+			defaultAction = c
+		}
+
+		if c, ok := doc.(config.NetworkRuleConfigRules); ok {
+			rules = append(rules, c.Rules()...)
+		}
+	}
+
+	if defaultAction == nil && len(rules) == 0 {
+		return nil
+	}
+
+	return &networkRuleConfig{
+		defaultAction: defaultAction,
+		rules:         rules,
+	}
+}
+
+type networkRuleConfig struct {
+	defaultAction config.NetworkRuleConfigDefaultAction
+	rules         []config.NetworkRule
+}
+
+func (c *networkRuleConfig) DefaultAction() nethelpers.DefaultAction {
+	if c.defaultAction == nil {
+		return nethelpers.DefaultActionAccept
+	}
+
+	return c.defaultAction.DefaultAction()
+}
+
+func (c *networkRuleConfig) Rules() []config.NetworkRule {
+	return c.rules
+}
+
 // Bytes returns source YAML representation (if available) or does default encoding.
 func (container *Container) Bytes() ([]byte, error) {
 	if !container.readonly {
@@ -0,0 +1,68 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+// Package block provides block device and volume machine configuration documents.
+package block
+
+import (
+	"fmt"
+
+	"github.com/dustin/go-humanize"
+)
+
+//go:generate deep-copy -type UserVolumeConfigV1Alpha1 -pointer-receiver -header-file ../../../../../hack/boilerplate.txt -o deep_copy.generated.go .
+
+// ByteSize is a byte size which can be specified in human readable units (e.g. `10GB`, `512MiB`).
+type ByteSize struct {
+	value uint64
+	raw   string
+}
+
+// NewByteSize creates a new ByteSize from the value in bytes.
+func NewByteSize(value uint64) ByteSize {
+	return ByteSize{
+		value: value,
+		raw:   humanize.IBytes(value),
+	}
+}
+
+// Value returns the size in bytes.
+func (s ByteSize) Value() uint64 {
+	return s.value
+}
+
+// IsZero returns true if the size is not set.
+func (s ByteSize) IsZero() bool {
+	return s.raw == ""
+}
+
+// UnmarshalYAML is a custom unmarshaller for `ByteSize`.
+func (s *ByteSize) UnmarshalYAML(unmarshal func(any) error) error {
+	var raw string
+
+	if err := unmarshal(&raw); err != nil {
+		return err
+	}
+
+	if raw == "" {
+		return nil
+	}
+
+	value, err := humanize.ParseBytes(raw)
+	if err != nil {
+		return fmt.Errorf("failed to parse size %q: %w", raw, err)
+	}
+
+	*s = ByteSize{
+		value: value,
+		raw:   raw,
+	}
+
+	return nil
+}
+
+// MarshalYAML is a custom marshaller for `ByteSize`.
+func (s ByteSize) MarshalYAML() (any, error) {
+	return s.raw, nil
+}
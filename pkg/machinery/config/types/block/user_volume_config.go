@@ -0,0 +1,236 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package block
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/go-multierror"
+	glob "github.com/ryanuber/go-glob"
+	"github.com/siderolabs/go-blockdevice/blockdevice/util/disk"
+
+	"github.com/siderolabs/talos/pkg/machinery/config/config"
+	"github.com/siderolabs/talos/pkg/machinery/config/internal/registry"
+	"github.com/siderolabs/talos/pkg/machinery/config/types/meta"
+	"github.com/siderolabs/talos/pkg/machinery/config/types/v1alpha1"
+	"github.com/siderolabs/talos/pkg/machinery/config/validation"
+	"github.com/siderolabs/talos/pkg/machinery/constants"
+)
+
+// UserVolumeConfigKind is a user volume config document kind.
+const UserVolumeConfigKind = "UserVolumeConfig"
+
+func init() {
+	registry.Register(UserVolumeConfigKind, func(version string) config.Document {
+		switch version {
+		case "v1alpha1":
+			return &UserVolumeConfigV1Alpha1{}
+		default:
+			return nil
+		}
+	})
+}
+
+// Check interfaces.
+var (
+	_ config.UserVolumeConfig = &UserVolumeConfigV1Alpha1{}
+	_ config.NamedDocument    = &UserVolumeConfigV1Alpha1{}
+	_ config.Validator        = &UserVolumeConfigV1Alpha1{}
+)
+
+// Supported user volume filesystems.
+const (
+	FilesystemTypeXFS  = "xfs"
+	FilesystemTypeExt4 = "ext4"
+)
+
+// maxNameLength is limited by the GPT partition name length (36 UTF-16 characters) minus the label prefix.
+const maxNameLength = 36 - len(constants.UserVolumePartitionLabelPrefix)
+
+// UserVolumeConfigV1Alpha1 is a user volume configuration document.
+//
+// The user volume is a partition which is created on the disk matching the disk selector,
+// formatted and mounted under /var/mnt/<name>.
+type UserVolumeConfigV1Alpha1 struct {
+	meta.Meta        `yaml:",inline"`
+	MetaName         string                     `yaml:"name"`
+	ProvisioningSpec ProvisioningSpec           `yaml:"provisioning"`
+	FilesystemSpec   FilesystemSpec             `yaml:"filesystem,omitempty"`
+	EncryptionSpec   *v1alpha1.EncryptionConfig `yaml:"encryption,omitempty"`
+}
+
+// ProvisioningSpec describes how the volume is provisioned.
+type ProvisioningSpec struct {
+	DiskSelectorSpec    DiskSelector `yaml:"diskSelector"`
+	ProvisioningMinSize ByteSize     `yaml:"minSize,omitempty"`
+	ProvisioningMaxSize ByteSize     `yaml:"maxSize,omitempty"`
+	ProvisioningGrow    bool         `yaml:"grow,omitempty"`
+}
+
+// DiskSelector selects the disk for the volume, all specified conditions should match.
+//
+// Model and WWID support glob patterns.
+// If SystemDisk is not set, both the system disk and other disks are matched.
+type DiskSelector struct {
+	Size       *v1alpha1.InstallDiskSizeMatcher `yaml:"size,omitempty"`
+	Model      string                           `yaml:"model,omitempty"`
+	Transport  string                           `yaml:"transport,omitempty"`
+	WWID       string                           `yaml:"wwid,omitempty"`
+	SystemDisk *bool                            `yaml:"systemDisk,omitempty"`
+}
+
+// FilesystemSpec describes the filesystem of the volume.
+type FilesystemSpec struct {
+	FilesystemType string `yaml:"type,omitempty"`
+}
+
+// NewUserVolumeConfigV1Alpha1 creates a new UserVolumeConfig config document.
+func NewUserVolumeConfigV1Alpha1() *UserVolumeConfigV1Alpha1 {
+	return &UserVolumeConfigV1Alpha1{
+		Meta: meta.Meta{
+			MetaKind:       UserVolumeConfigKind,
+			MetaAPIVersion: "v1alpha1",
+		},
+	}
+}
+
+// Name implements config.NamedDocument interface.
+func (s *UserVolumeConfigV1Alpha1) Name() string {
+	return s.MetaName
+}
+
+// Clone implements config.Document interface.
+func (s *UserVolumeConfigV1Alpha1) Clone() config.Document {
+	return s.DeepCopy()
+}
+
+// Provisioning implements config.UserVolumeConfig interface.
+func (s *UserVolumeConfigV1Alpha1) Provisioning() config.VolumeProvisioningConfig {
+	return s.ProvisioningSpec
+}
+
+// Filesystem implements config.UserVolumeConfig interface.
+func (s *UserVolumeConfigV1Alpha1) Filesystem() config.FilesystemConfig {
+	return s.FilesystemSpec
+}
+
+// Encryption implements config.UserVolumeConfig interface.
+func (s *UserVolumeConfigV1Alpha1) Encryption() config.Encryption {
+	if s.EncryptionSpec == nil {
+		return nil
+	}
+
+	return s.EncryptionSpec
+}
+
+// DiskSelector implements config.VolumeProvisioningConfig interface.
+func (p ProvisioningSpec) DiskSelector() config.VolumeDiskSelector {
+	return p.DiskSelectorSpec
+}
+
+// MinSize implements config.VolumeProvisioningConfig interface.
+func (p ProvisioningSpec) MinSize() uint64 {
+	if p.ProvisioningMinSize.IsZero() {
+		return constants.UserVolumeDefaultMinSize
+	}
+
+	return p.ProvisioningMinSize.Value()
+}
+
+// MaxSize implements config.VolumeProvisioningConfig interface.
+//
+// Zero value means the volume takes all available space.
+func (p ProvisioningSpec) MaxSize() uint64 {
+	return p.ProvisioningMaxSize.Value()
+}
+
+// Grow implements config.VolumeProvisioningConfig interface.
+func (p ProvisioningSpec) Grow() bool {
+	return p.ProvisioningGrow
+}
+
+// Match implements config.VolumeDiskSelector interface.
+func (d DiskSelector) Match(dsk config.VolumeDisk) bool {
+	if d.SystemDisk != nil && *d.SystemDisk != dsk.SystemDisk {
+		return false
+	}
+
+	if d.Size != nil && !d.Size.MatchData.Compare(&disk.Disk{Size: dsk.Size}) {
+		return false
+	}
+
+	if d.Model != "" && !glob.Glob(d.Model, dsk.Model) {
+		return false
+	}
+
+	if d.Transport != "" && d.Transport != dsk.Transport {
+		return false
+	}
+
+	if d.WWID != "" && !glob.Glob(d.WWID, dsk.WWID) {
+		return false
+	}
+
+	return true
+}
+
+// Type implements config.FilesystemConfig interface.
+func (f FilesystemSpec) Type() string {
+	if f.FilesystemType == "" {
+		return FilesystemTypeXFS
+	}
+
+	return f.FilesystemType
+}
+
+// Validate implements config.Validator interface.
+//
+//nolint:gocyclo
+func (s *UserVolumeConfigV1Alpha1) Validate(validation.RuntimeMode, ...validation.Option) ([]string, error) {
+	var errs error
+
+	switch {
+	case s.MetaName == "":
+		errs = multierror.Append(errs, errors.New("name is required"))
+	case len(s.MetaName) > maxNameLength:
+		errs = multierror.Append(errs, fmt.Errorf("name %q is too long, maximum length is %d", s.MetaName, maxNameLength))
+	case strings.ContainsAny(s.MetaName, "/\\") || s.MetaName == "." || s.MetaName == "..":
+		errs = multierror.Append(errs, fmt.Errorf("name %q should be a valid directory name", s.MetaName))
+	}
+
+	if !s.ProvisioningSpec.ProvisioningMaxSize.IsZero() && s.ProvisioningSpec.MaxSize() < s.ProvisioningSpec.MinSize() {
+		errs = multierror.Append(errs, errors.New("maxSize should be greater or equal to minSize"))
+	}
+
+	switch s.FilesystemSpec.Type() {
+	case FilesystemTypeXFS, FilesystemTypeExt4:
+	default:
+		errs = multierror.Append(errs, fmt.Errorf("unsupported filesystem type %q", s.FilesystemSpec.FilesystemType))
+	}
+
+	if s.EncryptionSpec != nil {
+		if len(s.EncryptionSpec.Keys()) == 0 {
+			errs = multierror.Append(errs, errors.New("no encryption keys provided for the volume encryption"))
+		}
+
+		slotsInUse := map[int]bool{}
+
+		for _, key := range s.EncryptionSpec.Keys() {
+			if slotsInUse[key.Slot()] {
+				errs = multierror.Append(errs, fmt.Errorf("encryption key slot %d is already in use", key.Slot()))
+			}
+
+			slotsInUse[key.Slot()] = true
+
+			if key.NodeID() == nil && key.Static() == nil && key.TPM() == nil && key.KMS() == nil {
+				errs = multierror.Append(errs, fmt.Errorf("encryption key at slot %d doesn't have any settings", key.Slot()))
+			}
+		}
+	}
+
+	return nil, errs
+}
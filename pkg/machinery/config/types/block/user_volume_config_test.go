@@ -0,0 +1,256 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package block_test
+
+import (
+	_ "embed"
+	"testing"
+
+	"github.com/siderolabs/go-pointer"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gopkg.in/yaml.v3"
+
+	"github.com/siderolabs/talos/pkg/machinery/config/config"
+	"github.com/siderolabs/talos/pkg/machinery/config/configloader"
+	"github.com/siderolabs/talos/pkg/machinery/config/encoder"
+	"github.com/siderolabs/talos/pkg/machinery/config/types/block"
+	"github.com/siderolabs/talos/pkg/machinery/config/types/v1alpha1"
+	"github.com/siderolabs/talos/pkg/machinery/constants"
+)
+
+//go:embed testdata/uservolumeconfig.yaml
+var expectedUserVolumeConfigDocument []byte
+
+func newUserVolumeConfig(t *testing.T) *block.UserVolumeConfigV1Alpha1 {
+	t.Helper()
+
+	cfg := block.NewUserVolumeConfigV1Alpha1()
+	cfg.MetaName = "ceph-data"
+
+	var sizeMatcher v1alpha1.InstallDiskSizeMatcher
+
+	require.NoError(t, yaml.Unmarshal([]byte(`">= 100GB"`), &sizeMatcher))
+
+	cfg.ProvisioningSpec.DiskSelectorSpec.Size = &sizeMatcher
+	cfg.ProvisioningSpec.DiskSelectorSpec.Model = "Samsung SSD*"
+	cfg.ProvisioningSpec.DiskSelectorSpec.Transport = "nvme"
+
+	require.NoError(t, yaml.Unmarshal([]byte(`10GB`), &cfg.ProvisioningSpec.ProvisioningMinSize))
+	require.NoError(t, yaml.Unmarshal([]byte(`50GB`), &cfg.ProvisioningSpec.ProvisioningMaxSize))
+
+	cfg.ProvisioningSpec.ProvisioningGrow = true
+	cfg.FilesystemSpec.FilesystemType = block.FilesystemTypeExt4
+
+	return cfg
+}
+
+func TestUserVolumeConfigMarshalStability(t *testing.T) {
+	marshaled, err := encoder.NewEncoder(newUserVolumeConfig(t)).Encode()
+	require.NoError(t, err)
+
+	assert.Equal(t, expectedUserVolumeConfigDocument, marshaled)
+}
+
+func TestUserVolumeConfigUnmarshal(t *testing.T) {
+	provider, err := configloader.NewFromBytes(expectedUserVolumeConfigDocument)
+	require.NoError(t, err)
+
+	docs := provider.Documents()
+	require.Len(t, docs, 1)
+
+	assert.Equal(t, newUserVolumeConfig(t), docs[0])
+
+	volumes := provider.UserVolumes()
+	require.Len(t, volumes, 1)
+
+	assert.Equal(t, "ceph-data", volumes[0].Name())
+	assert.EqualValues(t, 10_000_000_000, volumes[0].Provisioning().MinSize())
+	assert.EqualValues(t, 50_000_000_000, volumes[0].Provisioning().MaxSize())
+	assert.True(t, volumes[0].Provisioning().Grow())
+	assert.Equal(t, "ext4", volumes[0].Filesystem().Type())
+	assert.Nil(t, volumes[0].Encryption())
+}
+
+func TestUserVolumeConfigDefaults(t *testing.T) {
+	cfg := block.NewUserVolumeConfigV1Alpha1()
+
+	assert.EqualValues(t, constants.UserVolumeDefaultMinSize, cfg.Provisioning().MinSize())
+	assert.Zero(t, cfg.Provisioning().MaxSize())
+	assert.False(t, cfg.Provisioning().Grow())
+	assert.Equal(t, block.FilesystemTypeXFS, cfg.Filesystem().Type())
+}
+
+func TestDiskSelectorMatch(t *testing.T) {
+	t.Parallel()
+
+	selector := newUserVolumeConfig(t).ProvisioningSpec.DiskSelectorSpec
+
+	systemDiskSelector := selector
+	systemDiskSelector.SystemDisk = pointer.To(true)
+
+	nonSystemDiskSelector := selector
+	nonSystemDiskSelector.SystemDisk = pointer.To(false)
+
+	disk := config.VolumeDisk{
+		Size:      500_000_000_000,
+		Model:     "Samsung SSD 980",
+		Transport: "nvme",
+	}
+
+	systemDisk := disk
+	systemDisk.SystemDisk = true
+
+	for _, test := range []struct {
+		name     string
+		selector block.DiskSelector
+		disk     config.VolumeDisk
+
+		expected bool
+	}{
+		{
+			name:     "match",
+			selector: selector,
+			disk:     disk,
+			expected: true,
+		},
+		{
+			name:     "too small",
+			selector: selector,
+			disk: config.VolumeDisk{
+				Size:      50_000_000_000,
+				Model:     "Samsung SSD 980",
+				Transport: "nvme",
+			},
+		},
+		{
+			name:     "model mismatch",
+			selector: selector,
+			disk: config.VolumeDisk{
+				Size:      500_000_000_000,
+				Model:     "WDC WDS500G",
+				Transport: "nvme",
+			},
+		},
+		{
+			name:     "transport mismatch",
+			selector: selector,
+			disk: config.VolumeDisk{
+				Size:      500_000_000_000,
+				Model:     "Samsung SSD 980",
+				Transport: "usb",
+			},
+		},
+		{
+			name:     "system disk any",
+			selector: selector,
+			disk:     systemDisk,
+			expected: true,
+		},
+		{
+			name:     "system disk only",
+			selector: systemDiskSelector,
+			disk:     systemDisk,
+			expected: true,
+		},
+		{
+			name:     "system disk only mismatch",
+			selector: systemDiskSelector,
+			disk:     disk,
+		},
+		{
+			name:     "non-system disk only",
+			selector: nonSystemDiskSelector,
+			disk:     disk,
+			expected: true,
+		},
+		{
+			name:     "non-system disk only mismatch",
+			selector: nonSystemDiskSelector,
+			disk:     systemDisk,
+		},
+	} {
+		test := test
+
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			assert.Equal(t, test.expected, test.selector.Match(test.disk))
+		})
+	}
+}
+
+func TestUserVolumeConfigValidate(t *testing.T) {
+	t.Parallel()
+
+	for _, test := range []struct {
+		name string
+		cfg  func(t *testing.T) *block.UserVolumeConfigV1Alpha1
+
+		expectedError string
+	}{
+		{
+			name: "valid",
+			cfg:  newUserVolumeConfig,
+		},
+		{
+			name: "no name",
+			cfg: func(t *testing.T) *block.UserVolumeConfigV1Alpha1 {
+				return block.NewUserVolumeConfigV1Alpha1()
+			},
+
+			expectedError: "1 error occurred:\n\t* name is required\n\n",
+		},
+		{
+			name: "invalid",
+			cfg: func(t *testing.T) *block.UserVolumeConfigV1Alpha1 {
+				cfg := newUserVolumeConfig(t)
+				cfg.MetaName = "../data"
+				cfg.ProvisioningSpec.ProvisioningMaxSize = block.NewByteSize(1024)
+				cfg.FilesystemSpec.FilesystemType = "btrfs"
+				cfg.EncryptionSpec = &v1alpha1.EncryptionConfig{
+					EncryptionProvider: "luks2",
+					EncryptionKeys: []*v1alpha1.EncryptionKey{
+						{
+							KeySlot: 0,
+						},
+					},
+				}
+
+				return cfg
+			},
+
+			expectedError: "4 errors occurred:\n\t* name \"../data\" should be a valid directory name\n\t* maxSize should be greater or equal to minSize\n\t* unsupported filesystem type \"btrfs\"\n\t* encryption key at slot 0 doesn't have any settings\n\n", //nolint:lll
+		},
+	} {
+		test := test
+
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			_, err := test.cfg(t).Validate(validationMode{})
+
+			if test.expectedError == "" {
+				assert.NoError(t, err)
+			} else {
+				assert.EqualError(t, err, test.expectedError)
+			}
+		})
+	}
+}
+
+type validationMode struct{}
+
+func (validationMode) String() string {
+	return ""
+}
+
+func (validationMode) RequiresInstall() bool {
+	return false
+}
+
+func (validationMode) InContainer() bool {
+	return false
+}
@@ -0,0 +1,108 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package etcd
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/go-multierror"
+
+	"github.com/siderolabs/talos/pkg/machinery/config/config"
+	"github.com/siderolabs/talos/pkg/machinery/config/internal/registry"
+	"github.com/siderolabs/talos/pkg/machinery/config/types/meta"
+	"github.com/siderolabs/talos/pkg/machinery/config/validation"
+)
+
+// BackupConfigKind is an etcd backup config document kind.
+const BackupConfigKind = "EtcdBackupConfig"
+
+// MinBackupInterval is the minimum allowed interval between scheduled etcd snapshots.
+const MinBackupInterval = time.Minute
+
+func init() {
+	registry.Register(BackupConfigKind, func(version string) config.Document {
+		switch version {
+		case "v1alpha1":
+			return &BackupConfigV1Alpha1{}
+		default:
+			return nil
+		}
+	})
+}
+
+// Check interfaces.
+var (
+	_ config.EtcdBackupConfig = &BackupConfigV1Alpha1{}
+	_ config.Validator        = &BackupConfigV1Alpha1{}
+)
+
+// BackupConfigV1Alpha1 is a scheduled etcd backup configuration document.
+//
+// When present, the current etcd leader takes a snapshot every interval and stores
+// it on the local disk, old snapshots are pruned according to the retention policy.
+type BackupConfigV1Alpha1 struct {
+	meta.Meta      `yaml:",inline"`
+	BackupInterval time.Duration   `yaml:"interval"`
+	Retention      BackupRetention `yaml:"retention,omitempty"`
+}
+
+// BackupRetention configures pruning of the old etcd snapshots.
+//
+// Zero values disable the respective limit.
+type BackupRetention struct {
+	Count  int           `yaml:"count,omitempty"`
+	MaxAge time.Duration `yaml:"maxAge,omitempty"`
+}
+
+// NewBackupConfigV1Alpha1 creates a new EtcdBackupConfig config document.
+func NewBackupConfigV1Alpha1() *BackupConfigV1Alpha1 {
+	return &BackupConfigV1Alpha1{
+		Meta: meta.Meta{
+			MetaKind:       BackupConfigKind,
+			MetaAPIVersion: "v1alpha1",
+		},
+	}
+}
+
+// Clone implements config.Document interface.
+func (s *BackupConfigV1Alpha1) Clone() config.Document {
+	return s.DeepCopy()
+}
+
+// Interval implements config.EtcdBackupConfig interface.
+func (s *BackupConfigV1Alpha1) Interval() time.Duration {
+	return s.BackupInterval
+}
+
+// RetentionCount implements config.EtcdBackupConfig interface.
+func (s *BackupConfigV1Alpha1) RetentionCount() int {
+	return s.Retention.Count
+}
+
+// RetentionMaxAge implements config.EtcdBackupConfig interface.
+func (s *BackupConfigV1Alpha1) RetentionMaxAge() time.Duration {
+	return s.Retention.MaxAge
+}
+
+// Validate implements config.Validator interface.
+func (s *BackupConfigV1Alpha1) Validate(validation.RuntimeMode, ...validation.Option) ([]string, error) {
+	var errs error
+
+	if s.BackupInterval < MinBackupInterval {
+		errs = multierror.Append(errs, fmt.Errorf("interval should be at least %s", MinBackupInterval))
+	}
+
+	if s.Retention.Count < 0 {
+		errs = multierror.Append(errs, errors.New("retention.count should not be negative"))
+	}
+
+	if s.Retention.MaxAge < 0 {
+		errs = multierror.Append(errs, errors.New("retention.maxAge should not be negative"))
+	}
+
+	return nil, errs
+}
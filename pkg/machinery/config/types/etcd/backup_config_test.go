@@ -0,0 +1,125 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package etcd_test
+
+import (
+	_ "embed"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/siderolabs/talos/pkg/machinery/config/configloader"
+	"github.com/siderolabs/talos/pkg/machinery/config/encoder"
+	"github.com/siderolabs/talos/pkg/machinery/config/types/etcd"
+)
+
+//go:embed testdata/backupconfig.yaml
+var expectedBackupConfigDocument []byte
+
+func newBackupConfig() *etcd.BackupConfigV1Alpha1 {
+	cfg := etcd.NewBackupConfigV1Alpha1()
+	cfg.BackupInterval = time.Hour
+	cfg.Retention = etcd.BackupRetention{
+		Count:  24,
+		MaxAge: 7 * 24 * time.Hour,
+	}
+
+	return cfg
+}
+
+func TestBackupConfigMarshalStability(t *testing.T) {
+	marshaled, err := encoder.NewEncoder(newBackupConfig()).Encode()
+	require.NoError(t, err)
+
+	assert.Equal(t, expectedBackupConfigDocument, marshaled)
+}
+
+func TestBackupConfigUnmarshal(t *testing.T) {
+	provider, err := configloader.NewFromBytes(expectedBackupConfigDocument)
+	require.NoError(t, err)
+
+	docs := provider.Documents()
+	require.Len(t, docs, 1)
+
+	assert.Equal(t, newBackupConfig(), docs[0])
+
+	backup := provider.EtcdBackup()
+	require.NotNil(t, backup)
+
+	assert.Equal(t, time.Hour, backup.Interval())
+	assert.Equal(t, 24, backup.RetentionCount())
+	assert.Equal(t, 7*24*time.Hour, backup.RetentionMaxAge())
+}
+
+func TestBackupConfigValidate(t *testing.T) {
+	t.Parallel()
+
+	for _, test := range []struct {
+		name string
+		cfg  func() *etcd.BackupConfigV1Alpha1
+
+		expectedError string
+	}{
+		{
+			name: "valid",
+			cfg:  newBackupConfig,
+		},
+		{
+			name: "no retention",
+			cfg: func() *etcd.BackupConfigV1Alpha1 {
+				cfg := newBackupConfig()
+				cfg.Retention = etcd.BackupRetention{}
+
+				return cfg
+			},
+		},
+		{
+			name: "empty",
+			cfg:  etcd.NewBackupConfigV1Alpha1,
+
+			expectedError: "1 error occurred:\n\t* interval should be at least 1m0s\n\n",
+		},
+		{
+			name: "negative retention",
+			cfg: func() *etcd.BackupConfigV1Alpha1 {
+				cfg := newBackupConfig()
+				cfg.Retention = etcd.BackupRetention{
+					Count:  -1,
+					MaxAge: -time.Hour,
+				}
+
+				return cfg
+			},
+
+			expectedError: "2 errors occurred:\n\t* retention.count should not be negative\n\t* retention.maxAge should not be negative\n\n",
+		},
+	} {
+		test := test
+
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			_, err := test.cfg().Validate(validationMode{})
+
+			if test.expectedError == "" {
+				require.NoError(t, err)
+			} else {
+				require.EqualError(t, err, test.expectedError)
+			}
+		})
+	}
+}
+
+type validationMode struct{}
+
+func (validationMode) String() string {
+	return ""
+}
+
+func (validationMode) RequiresInstall() bool {
+	return false
+}
@@ -0,0 +1,93 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+// Code generated by "deep-copy -type DefaultActionConfigV1Alpha1 -type EthernetConfigV1Alpha1 -type RuleConfigV1Alpha1 -type TrafficControlConfigV1Alpha1 -pointer-receiver -header-file ../../../../../hack/boilerplate.txt -o deep_copy.generated.go ."; DO NOT EDIT.
+
+package network
+
+// DeepCopy generates a deep copy of *DefaultActionConfigV1Alpha1.
+func (o *DefaultActionConfigV1Alpha1) DeepCopy() *DefaultActionConfigV1Alpha1 {
+	var cp DefaultActionConfigV1Alpha1 = *o
+	return &cp
+}
+
+// DeepCopy generates a deep copy of *EthernetConfigV1Alpha1.
+func (o *EthernetConfigV1Alpha1) DeepCopy() *EthernetConfigV1Alpha1 {
+	var cp EthernetConfigV1Alpha1 = *o
+	if o.RingsConfig.RX != nil {
+		cp.RingsConfig.RX = new(uint32)
+		*cp.RingsConfig.RX = *o.RingsConfig.RX
+	}
+	if o.RingsConfig.TX != nil {
+		cp.RingsConfig.TX = new(uint32)
+		*cp.RingsConfig.TX = *o.RingsConfig.TX
+	}
+	if o.FeaturesConfig != nil {
+		cp.FeaturesConfig = make(map[string]bool, len(o.FeaturesConfig))
+		for k2, v2 := range o.FeaturesConfig {
+			cp.FeaturesConfig[k2] = v2
+		}
+	}
+	if o.ChannelsConfig.RX != nil {
+		cp.ChannelsConfig.RX = new(uint32)
+		*cp.ChannelsConfig.RX = *o.ChannelsConfig.RX
+	}
+	if o.ChannelsConfig.TX != nil {
+		cp.ChannelsConfig.TX = new(uint32)
+		*cp.ChannelsConfig.TX = *o.ChannelsConfig.TX
+	}
+	if o.ChannelsConfig.Other != nil {
+		cp.ChannelsConfig.Other = new(uint32)
+		*cp.ChannelsConfig.Other = *o.ChannelsConfig.Other
+	}
+	if o.ChannelsConfig.Combined != nil {
+		cp.ChannelsConfig.Combined = new(uint32)
+		*cp.ChannelsConfig.Combined = *o.ChannelsConfig.Combined
+	}
+	if o.PauseConfig.Autonegotiate != nil {
+		cp.PauseConfig.Autonegotiate = new(bool)
+		*cp.PauseConfig.Autonegotiate = *o.PauseConfig.Autonegotiate
+	}
+	if o.PauseConfig.RX != nil {
+		cp.PauseConfig.RX = new(bool)
+		*cp.PauseConfig.RX = *o.PauseConfig.RX
+	}
+	if o.PauseConfig.TX != nil {
+		cp.PauseConfig.TX = new(bool)
+		*cp.PauseConfig.TX = *o.PauseConfig.TX
+	}
+	return &cp
+}
+
+// DeepCopy generates a deep copy of *RuleConfigV1Alpha1.
+func (o *RuleConfigV1Alpha1) DeepCopy() *RuleConfigV1Alpha1 {
+	var cp RuleConfigV1Alpha1 = *o
+	if o.PortSelector.Ports != nil {
+		cp.PortSelector.Ports = make([]PortRange, len(o.PortSelector.Ports))
+		copy(cp.PortSelector.Ports, o.PortSelector.Ports)
+	}
+	if o.Ingress != nil {
+		cp.Ingress = make([]IngressRule, len(o.Ingress))
+		copy(cp.Ingress, o.Ingress)
+	}
+	return &cp
+}
+
+// DeepCopy generates a deep copy of *TrafficControlConfigV1Alpha1.
+func (o *TrafficControlConfigV1Alpha1) DeepCopy() *TrafficControlConfigV1Alpha1 {
+	var cp TrafficControlConfigV1Alpha1 = *o
+	if o.FQCodelConfig.ECN != nil {
+		cp.FQCodelConfig.ECN = new(bool)
+		*cp.FQCodelConfig.ECN = *o.FQCodelConfig.ECN
+	}
+	if o.HTBConfig.Classes != nil {
+		cp.HTBConfig.Classes = make([]TrafficControlHTBClassConfig, len(o.HTBConfig.Classes))
+		copy(cp.HTBConfig.Classes, o.HTBConfig.Classes)
+	}
+	if o.FilterConfigs != nil {
+		cp.FilterConfigs = make([]TrafficControlFilterConfig, len(o.FilterConfigs))
+		copy(cp.FilterConfigs, o.FilterConfigs)
+	}
+	return &cp
+}
@@ -0,0 +1,58 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package network
+
+import (
+	"github.com/siderolabs/talos/pkg/machinery/config/config"
+	"github.com/siderolabs/talos/pkg/machinery/config/internal/registry"
+	"github.com/siderolabs/talos/pkg/machinery/config/types/meta"
+	"github.com/siderolabs/talos/pkg/machinery/nethelpers"
+)
+
+// DefaultActionConfigKind is a default action config document kind.
+const DefaultActionConfigKind = "NetworkDefaultActionConfig"
+
+func init() {
+	registry.Register(DefaultActionConfigKind, func(version string) config.Document {
+		switch version {
+		case "v1alpha1":
+			return &DefaultActionConfigV1Alpha1{}
+		default:
+			return nil
+		}
+	})
+}
+
+// Check interfaces.
+var _ config.NetworkRuleConfigDefaultAction = &DefaultActionConfigV1Alpha1{}
+
+// DefaultActionConfigV1Alpha1 is a ingress firewall default action configuration document.
+//
+// It enables the host firewall: traffic which doesn't match any NetworkRuleConfig
+// is handled according to the default action.
+type DefaultActionConfigV1Alpha1 struct {
+	meta.Meta `yaml:",inline"`
+	Ingress   nethelpers.DefaultAction `yaml:"ingress"`
+}
+
+// NewDefaultActionConfigV1Alpha1 creates a new DefaultActionConfig config document.
+func NewDefaultActionConfigV1Alpha1() *DefaultActionConfigV1Alpha1 {
+	return &DefaultActionConfigV1Alpha1{
+		Meta: meta.Meta{
+			MetaKind:       DefaultActionConfigKind,
+			MetaAPIVersion: "v1alpha1",
+		},
+	}
+}
+
+// Clone implements config.Document interface.
+func (s *DefaultActionConfigV1Alpha1) Clone() config.Document {
+	return s.DeepCopy()
+}
+
+// DefaultAction implements config.NetworkRuleConfigDefaultAction interface.
+func (s *DefaultActionConfigV1Alpha1) DefaultAction() nethelpers.DefaultAction {
+	return s.Ingress
+}
@@ -0,0 +1,151 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package network
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/hashicorp/go-multierror"
+
+	"github.com/siderolabs/talos/pkg/machinery/config/config"
+	"github.com/siderolabs/talos/pkg/machinery/config/internal/registry"
+	"github.com/siderolabs/talos/pkg/machinery/config/types/meta"
+	"github.com/siderolabs/talos/pkg/machinery/config/validation"
+)
+
+// EthernetConfigKind is a Ethernet config document kind.
+const EthernetConfigKind = "EthernetConfig"
+
+func init() {
+	registry.Register(EthernetConfigKind, func(version string) config.Document {
+		switch version {
+		case "v1alpha1":
+			return &EthernetConfigV1Alpha1{}
+		default:
+			return nil
+		}
+	})
+}
+
+// Check interfaces.
+var (
+	_ config.EthernetConfig = &EthernetConfigV1Alpha1{}
+	_ config.NamedDocument  = &EthernetConfigV1Alpha1{}
+	_ config.Validator      = &EthernetConfigV1Alpha1{}
+)
+
+// EthernetConfigV1Alpha1 is a config document to configure the Ethernet link settings via ethtool.
+//
+// The document name is the name of the link, settings which are not specified are left unchanged.
+type EthernetConfigV1Alpha1 struct {
+	meta.Meta      `yaml:",inline"`
+	MetaName       string                 `yaml:"name"`
+	RingsConfig    EthernetRingsConfig    `yaml:"rings,omitempty"`
+	FeaturesConfig map[string]bool        `yaml:"features,omitempty"`
+	ChannelsConfig EthernetChannelsConfig `yaml:"channels,omitempty"`
+	PauseConfig    EthernetPauseConfig    `yaml:"pause,omitempty"`
+}
+
+// EthernetRingsConfig is a configuration for the ring buffer sizes (ethtool -G).
+type EthernetRingsConfig struct {
+	RX *uint32 `yaml:"rx,omitempty"`
+	TX *uint32 `yaml:"tx,omitempty"`
+}
+
+// EthernetChannelsConfig is a configuration for the channel counts (ethtool -L).
+type EthernetChannelsConfig struct {
+	RX       *uint32 `yaml:"rx,omitempty"`
+	TX       *uint32 `yaml:"tx,omitempty"`
+	Other    *uint32 `yaml:"other,omitempty"`
+	Combined *uint32 `yaml:"combined,omitempty"`
+}
+
+// EthernetPauseConfig is a configuration for the pause frames (ethtool -A).
+type EthernetPauseConfig struct {
+	Autonegotiate *bool `yaml:"autonegotiate,omitempty"`
+	RX            *bool `yaml:"rx,omitempty"`
+	TX            *bool `yaml:"tx,omitempty"`
+}
+
+// NewEthernetConfigV1Alpha1 creates a new EthernetConfig config document.
+func NewEthernetConfigV1Alpha1(name string) *EthernetConfigV1Alpha1 {
+	return &EthernetConfigV1Alpha1{
+		Meta: meta.Meta{
+			MetaKind:       EthernetConfigKind,
+			MetaAPIVersion: "v1alpha1",
+		},
+		MetaName: name,
+	}
+}
+
+// Name implements config.NamedDocument interface.
+func (s *EthernetConfigV1Alpha1) Name() string {
+	return s.MetaName
+}
+
+// Clone implements config.Document interface.
+func (s *EthernetConfigV1Alpha1) Clone() config.Document {
+	return s.DeepCopy()
+}
+
+// Rings implements config.EthernetConfig interface.
+func (s *EthernetConfigV1Alpha1) Rings() config.EthernetRingsConfig {
+	return config.EthernetRingsConfig{
+		RX: s.RingsConfig.RX,
+		TX: s.RingsConfig.TX,
+	}
+}
+
+// Features implements config.EthernetConfig interface.
+func (s *EthernetConfigV1Alpha1) Features() map[string]bool {
+	return s.FeaturesConfig
+}
+
+// Channels implements config.EthernetConfig interface.
+func (s *EthernetConfigV1Alpha1) Channels() config.EthernetChannelsConfig {
+	return config.EthernetChannelsConfig{
+		RX:       s.ChannelsConfig.RX,
+		TX:       s.ChannelsConfig.TX,
+		Other:    s.ChannelsConfig.Other,
+		Combined: s.ChannelsConfig.Combined,
+	}
+}
+
+// Pause implements config.EthernetConfig interface.
+func (s *EthernetConfigV1Alpha1) Pause() config.EthernetPauseConfig {
+	return config.EthernetPauseConfig{
+		Autonegotiate: s.PauseConfig.Autonegotiate,
+		RX:            s.PauseConfig.RX,
+		TX:            s.PauseConfig.TX,
+	}
+}
+
+// Validate implements config.Validator interface.
+func (s *EthernetConfigV1Alpha1) Validate(validation.RuntimeMode, ...validation.Option) ([]string, error) {
+	var errs error
+
+	if s.MetaName == "" {
+		errs = multierror.Append(errs, errors.New("name is required"))
+	}
+
+	for _, ring := range []struct {
+		name string
+		size *uint32
+	}{
+		{"rx", s.RingsConfig.RX},
+		{"tx", s.RingsConfig.TX},
+	} {
+		if ring.size != nil && *ring.size == 0 {
+			errs = multierror.Append(errs, fmt.Errorf("rings.%s should be greater than zero", ring.name))
+		}
+	}
+
+	if _, ok := s.FeaturesConfig[""]; ok {
+		errs = multierror.Append(errs, errors.New("feature name can't be empty"))
+	}
+
+	return nil, errs
+}
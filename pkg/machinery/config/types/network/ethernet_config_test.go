@@ -0,0 +1,120 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package network_test
+
+import (
+	_ "embed"
+	"testing"
+
+	"github.com/siderolabs/go-pointer"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/siderolabs/talos/pkg/machinery/config/config"
+	"github.com/siderolabs/talos/pkg/machinery/config/configloader"
+	"github.com/siderolabs/talos/pkg/machinery/config/encoder"
+	"github.com/siderolabs/talos/pkg/machinery/config/types/network"
+)
+
+//go:embed testdata/ethernetconfig.yaml
+var expectedEthernetConfigDocument []byte
+
+func newEthernetConfig() *network.EthernetConfigV1Alpha1 {
+	cfg := network.NewEthernetConfigV1Alpha1("enp0s2")
+	cfg.RingsConfig = network.EthernetRingsConfig{
+		RX: pointer.To[uint32](4096),
+		TX: pointer.To[uint32](4096),
+	}
+	cfg.FeaturesConfig = map[string]bool{
+		"rx-gro":              true,
+		"tx-tcp-segmentation": false,
+	}
+	cfg.ChannelsConfig = network.EthernetChannelsConfig{
+		Combined: pointer.To[uint32](8),
+	}
+	cfg.PauseConfig = network.EthernetPauseConfig{
+		Autonegotiate: pointer.To(false),
+		RX:            pointer.To(true),
+		TX:            pointer.To(true),
+	}
+
+	return cfg
+}
+
+func TestEthernetConfigMarshalStability(t *testing.T) {
+	marshaled, err := encoder.NewEncoder(newEthernetConfig()).Encode()
+	require.NoError(t, err)
+
+	assert.Equal(t, expectedEthernetConfigDocument, marshaled)
+}
+
+func TestEthernetConfigUnmarshal(t *testing.T) {
+	provider, err := configloader.NewFromBytes(expectedEthernetConfigDocument)
+	require.NoError(t, err)
+
+	docs := provider.Documents()
+	require.Len(t, docs, 1)
+
+	assert.Equal(t, newEthernetConfig(), docs[0])
+
+	configs := provider.EthernetConfigs()
+	require.Len(t, configs, 1)
+
+	assert.Equal(t, "enp0s2", configs[0].Name())
+	assert.Equal(t, config.EthernetRingsConfig{RX: pointer.To[uint32](4096), TX: pointer.To[uint32](4096)}, configs[0].Rings())
+	assert.Equal(t, config.EthernetChannelsConfig{Combined: pointer.To[uint32](8)}, configs[0].Channels())
+	assert.Equal(t, map[string]bool{"rx-gro": true, "tx-tcp-segmentation": false}, configs[0].Features())
+	assert.Equal(t, config.EthernetPauseConfig{Autonegotiate: pointer.To(false), RX: pointer.To(true), TX: pointer.To(true)}, configs[0].Pause())
+}
+
+func TestEthernetConfigValidate(t *testing.T) {
+	t.Parallel()
+
+	for _, test := range []struct {
+		name string
+		cfg  func() *network.EthernetConfigV1Alpha1
+
+		expectedError string
+	}{
+		{
+			name: "valid",
+			cfg:  newEthernetConfig,
+		},
+		{
+			name: "empty",
+			cfg: func() *network.EthernetConfigV1Alpha1 {
+				return network.NewEthernetConfigV1Alpha1("")
+			},
+
+			expectedError: "1 error occurred:\n\t* name is required\n\n",
+		},
+		{
+			name: "invalid",
+			cfg: func() *network.EthernetConfigV1Alpha1 {
+				cfg := newEthernetConfig()
+				cfg.RingsConfig.TX = pointer.To[uint32](0)
+				cfg.FeaturesConfig[""] = true
+
+				return cfg
+			},
+
+			expectedError: "2 errors occurred:\n\t* rings.tx should be greater than zero\n\t* feature name can't be empty\n\n",
+		},
+	} {
+		test := test
+
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			_, err := test.cfg().Validate(validationMode{})
+
+			if test.expectedError == "" {
+				require.NoError(t, err)
+			} else {
+				require.EqualError(t, err, test.expectedError)
+			}
+		})
+	}
+}
@@ -0,0 +1,46 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+// Package network provides network machine configuration documents.
+package network
+
+import "net/netip"
+
+//go:generate deep-copy -type DefaultActionConfigV1Alpha1 -type EthernetConfigV1Alpha1 -type RuleConfigV1Alpha1 -type TrafficControlConfigV1Alpha1 -pointer-receiver -header-file ../../../../../hack/boilerplate.txt -o deep_copy.generated.go .
+
+// Prefix wraps netip.Prefix with proper YAML marshal/unmarshal.
+type Prefix struct {
+	netip.Prefix
+}
+
+// UnmarshalYAML is a custom unmarshaller for `Prefix`.
+func (p *Prefix) UnmarshalYAML(unmarshal func(any) error) error {
+	var prefix string
+
+	if err := unmarshal(&prefix); err != nil {
+		return err
+	}
+
+	if prefix == "" {
+		return nil
+	}
+
+	parsed, err := netip.ParsePrefix(prefix)
+	if err != nil {
+		return err
+	}
+
+	*p = Prefix{parsed}
+
+	return nil
+}
+
+// MarshalYAML is a custom marshaller for `Prefix`.
+func (p Prefix) MarshalYAML() (any, error) {
+	if !p.IsValid() {
+		return "", nil
+	}
+
+	return p.String(), nil
+}
@@ -0,0 +1,257 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package network
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/go-multierror"
+
+	"github.com/siderolabs/talos/pkg/machinery/config/config"
+	"github.com/siderolabs/talos/pkg/machinery/config/internal/registry"
+	"github.com/siderolabs/talos/pkg/machinery/config/types/meta"
+	"github.com/siderolabs/talos/pkg/machinery/config/validation"
+	"github.com/siderolabs/talos/pkg/machinery/nethelpers"
+)
+
+// RuleConfigKind is a rule config document kind.
+const RuleConfigKind = "NetworkRuleConfig"
+
+func init() {
+	registry.Register(RuleConfigKind, func(version string) config.Document {
+		switch version {
+		case "v1alpha1":
+			return &RuleConfigV1Alpha1{}
+		default:
+			return nil
+		}
+	})
+}
+
+// Check interfaces.
+var (
+	_ config.NetworkRuleConfigRules = &RuleConfigV1Alpha1{}
+	_ config.NamedDocument          = &RuleConfigV1Alpha1{}
+	_ config.Validator              = &RuleConfigV1Alpha1{}
+)
+
+// RuleConfigV1Alpha1 is a network firewall rule config document.
+//
+// The rule allows ingress traffic to the selected ports from the listed subnets.
+type RuleConfigV1Alpha1 struct {
+	meta.Meta    `yaml:",inline"`
+	MetaName     string           `yaml:"name"`
+	PortSelector RulePortSelector `yaml:"portSelector"`
+	Ingress      IngressConfig    `yaml:"ingress"`
+}
+
+// RulePortSelector is a port selector for the network rule.
+type RulePortSelector struct {
+	Ports    PortRanges          `yaml:"ports"`
+	Protocol nethelpers.Protocol `yaml:"protocol"`
+}
+
+// IngressConfig is a ingress config.
+type IngressConfig []IngressRule
+
+// IngressRule is a ingress rule.
+type IngressRule struct {
+	Subnet Prefix `yaml:"subnet"`
+	Except Prefix `yaml:"except,omitempty"`
+}
+
+// NewRuleConfigV1Alpha1 creates a new RuleConfig config document.
+func NewRuleConfigV1Alpha1() *RuleConfigV1Alpha1 {
+	return &RuleConfigV1Alpha1{
+		Meta: meta.Meta{
+			MetaKind:       RuleConfigKind,
+			MetaAPIVersion: "v1alpha1",
+		},
+	}
+}
+
+// Name implements config.NamedDocument interface.
+func (s *RuleConfigV1Alpha1) Name() string {
+	return s.MetaName
+}
+
+// Clone implements config.Document interface.
+func (s *RuleConfigV1Alpha1) Clone() config.Document {
+	return s.DeepCopy()
+}
+
+// Rules implements config.NetworkRuleConfigRules interface.
+func (s *RuleConfigV1Alpha1) Rules() []config.NetworkRule {
+	return []config.NetworkRule{s}
+}
+
+// Protocol implements config.NetworkRule interface.
+func (s *RuleConfigV1Alpha1) Protocol() nethelpers.Protocol {
+	return s.PortSelector.Protocol
+}
+
+// PortRanges implements config.NetworkRule interface.
+func (s *RuleConfigV1Alpha1) PortRanges() [][2]uint16 {
+	result := make([][2]uint16, 0, len(s.PortSelector.Ports))
+
+	for _, portRange := range s.PortSelector.Ports {
+		result = append(result, [2]uint16{portRange.Lo, portRange.Hi})
+	}
+
+	return result
+}
+
+// IngressSubnets implements config.NetworkRule interface.
+func (s *RuleConfigV1Alpha1) IngressSubnets() []config.NetworkRuleIngress {
+	result := make([]config.NetworkRuleIngress, 0, len(s.Ingress))
+
+	for _, rule := range s.Ingress {
+		result = append(result, config.NetworkRuleIngress{
+			Subnet: rule.Subnet.Prefix,
+			Except: rule.Except.Prefix,
+		})
+	}
+
+	return result
+}
+
+// Validate implements config.Validator interface.
+func (s *RuleConfigV1Alpha1) Validate(validation.RuntimeMode, ...validation.Option) ([]string, error) {
+	var errs error
+
+	if s.MetaName == "" {
+		errs = multierror.Append(errs, errors.New("name is required"))
+	}
+
+	switch s.PortSelector.Protocol {
+	case nethelpers.ProtocolTCP, nethelpers.ProtocolUDP:
+	default:
+		errs = multierror.Append(errs, fmt.Errorf("invalid protocol %q: only tcp and udp are supported", s.PortSelector.Protocol))
+	}
+
+	if len(s.PortSelector.Ports) == 0 {
+		errs = multierror.Append(errs, errors.New("portSelector.ports is required"))
+	}
+
+	if err := s.PortSelector.Ports.Validate(); err != nil {
+		errs = multierror.Append(errs, err)
+	}
+
+	if len(s.Ingress) == 0 {
+		errs = multierror.Append(errs, errors.New("ingress is required"))
+	}
+
+	for _, rule := range s.Ingress {
+		if !rule.Subnet.IsValid() {
+			errs = multierror.Append(errs, errors.New("ingress subnet is required"))
+
+			continue
+		}
+
+		if rule.Except.IsValid() && (rule.Except.Addr().Is4() != rule.Subnet.Addr().Is4() || !rule.Subnet.Contains(rule.Except.Addr())) {
+			errs = multierror.Append(errs, fmt.Errorf("except subnet %s is not a part of the subnet %s", rule.Except, rule.Subnet))
+		}
+	}
+
+	return nil, errs
+}
+
+// PortRange is a port range.
+//
+// It is represented as a single port number or a range "lo-hi" in YAML.
+type PortRange struct {
+	Lo uint16
+	Hi uint16
+}
+
+// UnmarshalYAML is a custom unmarshaller for `PortRange`.
+func (pr *PortRange) UnmarshalYAML(unmarshal func(any) error) error {
+	var port uint16
+
+	if err := unmarshal(&port); err == nil {
+		pr.Lo, pr.Hi = port, port
+
+		return nil
+	}
+
+	var portRange string
+
+	if err := unmarshal(&portRange); err != nil {
+		return err
+	}
+
+	lo, hi, ok := strings.Cut(portRange, "-")
+	if !ok {
+		return fmt.Errorf("invalid port range: %q", portRange)
+	}
+
+	loPort, err := strconv.ParseUint(lo, 10, 16)
+	if err != nil {
+		return fmt.Errorf("invalid port range: %q", portRange)
+	}
+
+	hiPort, err := strconv.ParseUint(hi, 10, 16)
+	if err != nil {
+		return fmt.Errorf("invalid port range: %q", portRange)
+	}
+
+	pr.Lo, pr.Hi = uint16(loPort), uint16(hiPort)
+
+	return nil
+}
+
+// MarshalYAML is a custom marshaller for `PortRange`.
+func (pr PortRange) MarshalYAML() (any, error) {
+	if pr.Lo == pr.Hi {
+		return pr.Lo, nil
+	}
+
+	return fmt.Sprintf("%d-%d", pr.Lo, pr.Hi), nil
+}
+
+// String implements fmt.Stringer interface.
+func (pr PortRange) String() string {
+	if pr.Lo == pr.Hi {
+		return strconv.Itoa(int(pr.Lo))
+	}
+
+	return fmt.Sprintf("%d-%d", pr.Lo, pr.Hi)
+}
+
+// PortRanges is a slice of port ranges.
+type PortRanges []PortRange
+
+// Validate the port ranges: ranges should be well-formed and should not overlap.
+func (prs PortRanges) Validate() error {
+	var errs error
+
+	sorted := make(PortRanges, 0, len(prs))
+
+	for _, pr := range prs {
+		if pr.Lo > pr.Hi {
+			errs = multierror.Append(errs, fmt.Errorf("invalid port range: %s", pr))
+
+			continue
+		}
+
+		sorted = append(sorted, pr)
+	}
+
+	sort.Slice(sorted, func(i, j int) bool {
+		return sorted[i].Lo < sorted[j].Lo
+	})
+
+	for i := 1; i < len(sorted); i++ {
+		if sorted[i].Lo <= sorted[i-1].Hi {
+			errs = multierror.Append(errs, fmt.Errorf("port ranges %s and %s overlap", sorted[i-1], sorted[i]))
+		}
+	}
+
+	return errs
+}
@@ -0,0 +1,174 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package network_test
+
+import (
+	_ "embed"
+	"net/netip"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/siderolabs/talos/pkg/machinery/config/config"
+	"github.com/siderolabs/talos/pkg/machinery/config/configloader"
+	"github.com/siderolabs/talos/pkg/machinery/config/encoder"
+	"github.com/siderolabs/talos/pkg/machinery/config/types/network"
+	"github.com/siderolabs/talos/pkg/machinery/nethelpers"
+)
+
+//go:embed testdata/ruleconfig.yaml
+var expectedRuleConfigDocument []byte
+
+func newRuleConfig() *network.RuleConfigV1Alpha1 {
+	cfg := network.NewRuleConfigV1Alpha1()
+	cfg.MetaName = "test"
+	cfg.PortSelector.Ports = network.PortRanges{
+		{Lo: 53, Hi: 53},
+		{Lo: 8000, Hi: 9000},
+	}
+	cfg.PortSelector.Protocol = nethelpers.ProtocolUDP
+	cfg.Ingress = network.IngressConfig{
+		{
+			Subnet: network.Prefix{Prefix: netip.MustParsePrefix("192.168.0.0/16")},
+			Except: network.Prefix{Prefix: netip.MustParsePrefix("192.168.0.3/32")},
+		},
+		{
+			Subnet: network.Prefix{Prefix: netip.MustParsePrefix("2001::/16")},
+		},
+	}
+
+	return cfg
+}
+
+func TestRuleConfigMarshalStability(t *testing.T) {
+	marshaled, err := encoder.NewEncoder(newRuleConfig()).Encode()
+	require.NoError(t, err)
+
+	assert.Equal(t, expectedRuleConfigDocument, marshaled)
+}
+
+func TestRuleConfigUnmarshal(t *testing.T) {
+	provider, err := configloader.NewFromBytes(expectedRuleConfigDocument)
+	require.NoError(t, err)
+
+	docs := provider.Documents()
+	require.Len(t, docs, 1)
+
+	assert.Equal(t, newRuleConfig(), docs[0])
+
+	rules := provider.NetworkRules()
+	require.NotNil(t, rules)
+
+	assert.Equal(t, nethelpers.DefaultActionAccept, rules.DefaultAction())
+	require.Len(t, rules.Rules(), 1)
+
+	rule := rules.Rules()[0]
+
+	assert.Equal(t, "test", rule.Name())
+	assert.Equal(t, nethelpers.ProtocolUDP, rule.Protocol())
+	assert.Equal(t, [][2]uint16{{53, 53}, {8000, 9000}}, rule.PortRanges())
+	assert.Equal(t, []config.NetworkRuleIngress{
+		{
+			Subnet: netip.MustParsePrefix("192.168.0.0/16"),
+			Except: netip.MustParsePrefix("192.168.0.3/32"),
+		},
+		{
+			Subnet: netip.MustParsePrefix("2001::/16"),
+		},
+	}, rule.IngressSubnets())
+}
+
+func TestRuleConfigValidate(t *testing.T) {
+	t.Parallel()
+
+	for _, test := range []struct {
+		name string
+		cfg  func() *network.RuleConfigV1Alpha1
+
+		expectedError string
+	}{
+		{
+			name: "valid",
+			cfg:  newRuleConfig,
+		},
+		{
+			name: "empty",
+			cfg:  network.NewRuleConfigV1Alpha1,
+
+			expectedError: "4 errors occurred:\n\t* name is required\n\t* invalid protocol \"Protocol(0)\": only tcp and udp are supported\n\t* portSelector.ports is required\n\t* ingress is required\n\n",
+		},
+		{
+			name: "invalid ports",
+			cfg: func() *network.RuleConfigV1Alpha1 {
+				cfg := newRuleConfig()
+				cfg.PortSelector.Ports = network.PortRanges{
+					{Lo: 80, Hi: 79},
+					{Lo: 100, Hi: 200},
+					{Lo: 150, Hi: 150},
+				}
+
+				return cfg
+			},
+
+			expectedError: "2 errors occurred:\n\t* invalid port range: 80-79\n\t* port ranges 100-200 and 150 overlap\n\n",
+		},
+		{
+			name: "invalid ingress",
+			cfg: func() *network.RuleConfigV1Alpha1 {
+				cfg := newRuleConfig()
+				cfg.PortSelector.Protocol = nethelpers.ProtocolICMP
+				cfg.Ingress = network.IngressConfig{
+					{
+						Subnet: network.Prefix{Prefix: netip.MustParsePrefix("10.0.0.0/8")},
+						Except: network.Prefix{Prefix: netip.MustParsePrefix("192.168.0.0/24")},
+					},
+					{},
+				}
+
+				return cfg
+			},
+
+			expectedError: "3 errors occurred:\n\t* invalid protocol \"icmp\": only tcp and udp are supported\n\t* except subnet 192.168.0.0/24 is not a part of the subnet 10.0.0.0/8\n\t* ingress subnet is required\n\n",
+		},
+	} {
+		test := test
+
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			_, err := test.cfg().Validate(validationMode{})
+
+			if test.expectedError == "" {
+				require.NoError(t, err)
+			} else {
+				require.EqualError(t, err, test.expectedError)
+			}
+		})
+	}
+}
+
+func TestPortRangeUnmarshal(t *testing.T) {
+	_, err := configloader.NewFromBytes([]byte(`apiVersion: v1alpha1
+kind: NetworkRuleConfig
+name: test
+portSelector:
+    ports:
+        - 80-abc
+    protocol: tcp
+`))
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), `invalid port range: "80-abc"`)
+}
+
+type validationMode struct{}
+
+func (validationMode) String() string {
+	return ""
+}
+
+func (validationMode) RequiresInstall() bool {
+	return false
+}
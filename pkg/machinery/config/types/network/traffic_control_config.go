@@ -0,0 +1,405 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package network
+
+import (
+	"errors"
+	"fmt"
+	"path"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/go-multierror"
+
+	"github.com/siderolabs/talos/pkg/machinery/config/config"
+	"github.com/siderolabs/talos/pkg/machinery/config/internal/registry"
+	"github.com/siderolabs/talos/pkg/machinery/config/types/meta"
+	"github.com/siderolabs/talos/pkg/machinery/config/validation"
+	"github.com/siderolabs/talos/pkg/machinery/nethelpers"
+)
+
+// TrafficControlConfigKind is a TrafficControl config document kind.
+const TrafficControlConfigKind = "TrafficControlConfig"
+
+func init() {
+	registry.Register(TrafficControlConfigKind, func(version string) config.Document {
+		switch version {
+		case "v1alpha1":
+			return &TrafficControlConfigV1Alpha1{}
+		default:
+			return nil
+		}
+	})
+}
+
+// Check interfaces.
+var (
+	_ config.TrafficControlConfig = &TrafficControlConfigV1Alpha1{}
+	_ config.NamedDocument        = &TrafficControlConfigV1Alpha1{}
+	_ config.Validator            = &TrafficControlConfigV1Alpha1{}
+)
+
+// TrafficControlConfigV1Alpha1 is a config document to configure the egress traffic control (tc) of a link.
+//
+// The document name is the name of the link, the configured qdisc replaces the root qdisc of the link.
+type TrafficControlConfigV1Alpha1 struct {
+	meta.Meta     `yaml:",inline"`
+	MetaName      string                       `yaml:"name"`
+	QdiscKind     nethelpers.QdiscKind         `yaml:"qdisc"`
+	FQCodelConfig TrafficControlFQCodelConfig  `yaml:"fqCodel,omitempty"`
+	HTBConfig     TrafficControlHTBConfig      `yaml:"htb,omitempty"`
+	TBFConfig     TrafficControlTBFConfig      `yaml:"tbf,omitempty"`
+	FilterConfigs []TrafficControlFilterConfig `yaml:"filters,omitempty"`
+}
+
+// TrafficControlFQCodelConfig is a configuration for the fq_codel qdisc.
+type TrafficControlFQCodelConfig struct {
+	// Limit is the hard limit on the queue size in packets.
+	Limit uint32 `yaml:"limit,omitempty"`
+	// Flows is the number of flows the traffic is hashed into.
+	Flows uint32 `yaml:"flows,omitempty"`
+	// Interval is the CoDel interval.
+	Interval time.Duration `yaml:"interval,omitempty"`
+	// ECN enables marking packets with ECN instead of dropping them, defaults to true.
+	ECN *bool `yaml:"ecn,omitempty"`
+}
+
+// TrafficControlHTBConfig is a configuration for the htb qdisc.
+type TrafficControlHTBConfig struct {
+	// DefaultClass is the class unclassified traffic is sent to.
+	//
+	// If not set, unclassified traffic is not shaped.
+	DefaultClass uint16                         `yaml:"defaultClass,omitempty"`
+	Classes      []TrafficControlHTBClassConfig `yaml:"classes,omitempty"`
+}
+
+// TrafficControlHTBClassConfig is a configuration for the htb class.
+type TrafficControlHTBClassConfig struct {
+	// ClassID is the minor number of the class, the class handle is 1:<classID>.
+	ClassID uint16 `yaml:"classID"`
+	// Rate is the guaranteed rate of the class.
+	Rate Rate `yaml:"rate"`
+	// Ceil is the maximum rate of the class, defaults to the rate.
+	Ceil Rate `yaml:"ceil,omitempty"`
+	// Priority of the class for borrowing the spare bandwidth, lower is higher priority.
+	Priority uint32 `yaml:"priority,omitempty"`
+}
+
+// TrafficControlTBFConfig is a configuration for the tbf qdisc.
+type TrafficControlTBFConfig struct {
+	Rate Rate `yaml:"rate"`
+	// Burst is the size of the bucket in bytes.
+	Burst uint32 `yaml:"burst"`
+	// Latency is the maximum time a packet can sit in the queue.
+	Latency time.Duration `yaml:"latency"`
+}
+
+// TrafficControlFilterConfig is a configuration for the filter which classifies traffic into a htb class.
+type TrafficControlFilterConfig struct {
+	ClassID uint16                              `yaml:"classID,omitempty"`
+	Kind    nethelpers.TrafficControlFilterKind `yaml:"kind"`
+	// FwMark and FwMask match the firewall mark of the packet (kind fwmark).
+	FwMark uint32 `yaml:"fwMark,omitempty"`
+	FwMask uint32 `yaml:"fwMask,omitempty"`
+	// DSCP matches the DSCP value of IPv4 and IPv6 packets (kind dscp).
+	DSCP uint8 `yaml:"dscp,omitempty"`
+	// CgroupPath matches the packets sent by the sockets of the cgroup or its descendants (kind cgroup).
+	//
+	// The path is relative to the root of the cgroup v2 hierarchy, e.g. /podruntime/kubelet.
+	CgroupPath string `yaml:"cgroupPath,omitempty"`
+}
+
+// NewTrafficControlConfigV1Alpha1 creates a new TrafficControlConfig config document.
+func NewTrafficControlConfigV1Alpha1(name string) *TrafficControlConfigV1Alpha1 {
+	return &TrafficControlConfigV1Alpha1{
+		Meta: meta.Meta{
+			MetaKind:       TrafficControlConfigKind,
+			MetaAPIVersion: "v1alpha1",
+		},
+		MetaName: name,
+	}
+}
+
+// Name implements config.NamedDocument interface.
+func (s *TrafficControlConfigV1Alpha1) Name() string {
+	return s.MetaName
+}
+
+// Clone implements config.Document interface.
+func (s *TrafficControlConfigV1Alpha1) Clone() config.Document {
+	return s.DeepCopy()
+}
+
+// Qdisc implements config.TrafficControlConfig interface.
+func (s *TrafficControlConfigV1Alpha1) Qdisc() nethelpers.QdiscKind {
+	return s.QdiscKind
+}
+
+// FQCodel implements config.TrafficControlConfig interface.
+func (s *TrafficControlConfigV1Alpha1) FQCodel() config.TrafficControlFQCodelConfig {
+	return config.TrafficControlFQCodelConfig{
+		Limit:    s.FQCodelConfig.Limit,
+		Flows:    s.FQCodelConfig.Flows,
+		Interval: s.FQCodelConfig.Interval,
+		ECN:      s.FQCodelConfig.ECN == nil || *s.FQCodelConfig.ECN,
+	}
+}
+
+// HTB implements config.TrafficControlConfig interface.
+func (s *TrafficControlConfigV1Alpha1) HTB() config.TrafficControlHTBConfig {
+	htb := config.TrafficControlHTBConfig{
+		DefaultClass: s.HTBConfig.DefaultClass,
+	}
+
+	for _, class := range s.HTBConfig.Classes {
+		ceil := class.Ceil
+		if ceil == 0 {
+			ceil = class.Rate
+		}
+
+		htb.Classes = append(htb.Classes, config.TrafficControlHTBClassConfig{
+			ClassID:  class.ClassID,
+			Rate:     uint64(class.Rate),
+			Ceil:     uint64(ceil),
+			Priority: class.Priority,
+		})
+	}
+
+	return htb
+}
+
+// TBF implements config.TrafficControlConfig interface.
+func (s *TrafficControlConfigV1Alpha1) TBF() config.TrafficControlTBFConfig {
+	return config.TrafficControlTBFConfig{
+		Rate:    uint64(s.TBFConfig.Rate),
+		Burst:   s.TBFConfig.Burst,
+		Latency: s.TBFConfig.Latency,
+	}
+}
+
+// Filters implements config.TrafficControlConfig interface.
+func (s *TrafficControlConfigV1Alpha1) Filters() []config.TrafficControlFilterConfig {
+	filters := make([]config.TrafficControlFilterConfig, 0, len(s.FilterConfigs))
+
+	for _, filter := range s.FilterConfigs {
+		filters = append(filters, config.TrafficControlFilterConfig{
+			Kind:       filter.Kind,
+			ClassID:    filter.ClassID,
+			FwMark:     filter.FwMark,
+			FwMask:     filter.FwMask,
+			DSCP:       filter.DSCP,
+			CgroupPath: filter.CgroupPath,
+		})
+	}
+
+	return filters
+}
+
+// Validate implements config.Validator interface.
+//
+//nolint:gocyclo,cyclop
+func (s *TrafficControlConfigV1Alpha1) Validate(validation.RuntimeMode, ...validation.Option) ([]string, error) {
+	var errs error
+
+	if s.MetaName == "" {
+		errs = multierror.Append(errs, errors.New("name is required"))
+	}
+
+	if !s.QdiscKind.IsAQdiscKind() {
+		errs = multierror.Append(errs, fmt.Errorf("invalid qdisc %d", s.QdiscKind))
+	}
+
+	classes := map[uint16]struct{}{}
+
+	for i, class := range s.HTBConfig.Classes {
+		if class.ClassID == 0 {
+			errs = multierror.Append(errs, fmt.Errorf("htb.classes[%d].classID should be greater than zero", i))
+		}
+
+		if _, exists := classes[class.ClassID]; exists {
+			errs = multierror.Append(errs, fmt.Errorf("htb.classes[%d].classID %d is duplicate", i, class.ClassID))
+		}
+
+		classes[class.ClassID] = struct{}{}
+
+		if class.Rate == 0 {
+			errs = multierror.Append(errs, fmt.Errorf("htb.classes[%d].rate is required", i))
+		}
+
+		if class.Ceil != 0 && class.Ceil < class.Rate {
+			errs = multierror.Append(errs, fmt.Errorf("htb.classes[%d].ceil should be greater or equal to the rate", i))
+		}
+
+		// htb class rates are 32-bit in the kernel API
+		if class.Rate > maxHTBRate || class.Ceil > maxHTBRate {
+			errs = multierror.Append(errs, fmt.Errorf("htb.classes[%d] rate and ceil should not exceed %s", i, Rate(maxHTBRate)))
+		}
+	}
+
+	switch s.QdiscKind {
+	case nethelpers.QdiscKindHTB:
+		if _, exists := classes[s.HTBConfig.DefaultClass]; s.HTBConfig.DefaultClass != 0 && !exists {
+			errs = multierror.Append(errs, fmt.Errorf("htb.defaultClass %d is not defined", s.HTBConfig.DefaultClass))
+		}
+	case nethelpers.QdiscKindTBF:
+		if s.TBFConfig.Rate == 0 {
+			errs = multierror.Append(errs, errors.New("tbf.rate is required"))
+		}
+
+		if s.TBFConfig.Burst == 0 {
+			errs = multierror.Append(errs, errors.New("tbf.burst is required"))
+		}
+
+		if s.TBFConfig.Latency <= 0 {
+			errs = multierror.Append(errs, errors.New("tbf.latency is required"))
+		}
+	}
+
+	if s.QdiscKind != nethelpers.QdiscKindHTB {
+		if len(s.HTBConfig.Classes) > 0 {
+			errs = multierror.Append(errs, errors.New("htb.classes can be only used with htb qdisc"))
+		}
+
+		if len(s.FilterConfigs) > 0 {
+			errs = multierror.Append(errs, errors.New("filters can be only used with htb qdisc"))
+		}
+	}
+
+	for i, filter := range s.FilterConfigs {
+		if _, exists := classes[filter.ClassID]; !exists {
+			errs = multierror.Append(errs, fmt.Errorf("filters[%d].classID %d is not defined", i, filter.ClassID))
+		}
+
+		switch filter.Kind {
+		case nethelpers.TrafficControlFilterKindFwMark:
+			if filter.FwMark == 0 {
+				errs = multierror.Append(errs, fmt.Errorf("filters[%d].fwMark is required", i))
+			}
+		case nethelpers.TrafficControlFilterKindDSCP:
+			if filter.DSCP > 63 {
+				errs = multierror.Append(errs, fmt.Errorf("filters[%d].dscp should be in range 0-63", i))
+			}
+		case nethelpers.TrafficControlFilterKindCgroup:
+			if !strings.HasPrefix(filter.CgroupPath, "/") || path.Clean(filter.CgroupPath) == "/" {
+				errs = multierror.Append(errs, fmt.Errorf("filters[%d].cgroupPath should be an absolute path of a non-root cgroup", i))
+			}
+		default:
+			errs = multierror.Append(errs, fmt.Errorf("filters[%d].kind %d is invalid", i, filter.Kind))
+		}
+
+		if filter.Kind != nethelpers.TrafficControlFilterKindFwMark && (filter.FwMark != 0 || filter.FwMask != 0) {
+			errs = multierror.Append(errs, fmt.Errorf("filters[%d].fwMark can be only used with fwmark filter", i))
+		}
+
+		if filter.Kind != nethelpers.TrafficControlFilterKindDSCP && filter.DSCP != 0 {
+			errs = multierror.Append(errs, fmt.Errorf("filters[%d].dscp can be only used with dscp filter", i))
+		}
+
+		if filter.Kind != nethelpers.TrafficControlFilterKindCgroup && filter.CgroupPath != "" {
+			errs = multierror.Append(errs, fmt.Errorf("filters[%d].cgroupPath can be only used with cgroup filter", i))
+		}
+	}
+
+	return nil, errs
+}
+
+// maxHTBRate is the maximum htb class rate in bytes per second.
+const maxHTBRate = 1<<32 - 1
+
+// Rate is a data rate in bytes per second.
+//
+// Rate is encoded in YAML using tc(8) units: bit, kbit, mbit, gbit, tbit for bits per second,
+// bps, kbps, mbps, gbps, tbps for bytes per second, a plain number is bits per second.
+type Rate uint64
+
+var rateUnits = []struct {
+	suffix     string
+	bitsPerSec uint64
+}{
+	// longer suffixes first, as "bit" is a suffix of "kbit"
+	{"tbit", 1e12},
+	{"gbit", 1e9},
+	{"mbit", 1e6},
+	{"kbit", 1e3},
+	{"tbps", 8e12},
+	{"gbps", 8e9},
+	{"mbps", 8e6},
+	{"kbps", 8e3},
+	{"bit", 1},
+	{"bps", 8},
+}
+
+// ParseRate parses tc(8) style rate.
+func ParseRate(s string) (Rate, error) {
+	lower := strings.ToLower(strings.TrimSpace(s))
+	multiplier := uint64(1)
+
+	for _, unit := range rateUnits {
+		if strings.HasSuffix(lower, unit.suffix) {
+			lower = strings.TrimSuffix(lower, unit.suffix)
+			multiplier = unit.bitsPerSec
+
+			break
+		}
+	}
+
+	value, err := strconv.ParseFloat(strings.TrimSpace(lower), 64)
+	if err != nil || value < 0 {
+		return 0, fmt.Errorf("invalid rate %q", s)
+	}
+
+	return Rate(value * float64(multiplier) / 8), nil
+}
+
+// String implements fmt.Stringer interface.
+func (r Rate) String() string {
+	bits := uint64(r) * 8
+
+	for _, unit := range rateUnits {
+		if !strings.HasSuffix(unit.suffix, "bit") {
+			continue
+		}
+
+		if bits >= unit.bitsPerSec && bits%unit.bitsPerSec == 0 {
+			return strconv.FormatUint(bits/unit.bitsPerSec, 10) + unit.suffix
+		}
+	}
+
+	return "0bit"
+}
+
+// UnmarshalYAML is a custom unmarshaller for `Rate`.
+func (r *Rate) UnmarshalYAML(unmarshal func(any) error) error {
+	var rate string
+
+	if err := unmarshal(&rate); err != nil {
+		return err
+	}
+
+	if rate == "" {
+		*r = 0
+
+		return nil
+	}
+
+	parsed, err := ParseRate(rate)
+	if err != nil {
+		return err
+	}
+
+	*r = parsed
+
+	return nil
+}
+
+// MarshalYAML is a custom marshaller for `Rate`.
+func (r Rate) MarshalYAML() (any, error) {
+	if r == 0 {
+		return "", nil
+	}
+
+	return r.String(), nil
+}
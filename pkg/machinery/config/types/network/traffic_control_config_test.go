@@ -0,0 +1,225 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package network_test
+
+import (
+	_ "embed"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/siderolabs/talos/pkg/machinery/config/config"
+	"github.com/siderolabs/talos/pkg/machinery/config/configloader"
+	"github.com/siderolabs/talos/pkg/machinery/config/encoder"
+	"github.com/siderolabs/talos/pkg/machinery/config/types/network"
+	"github.com/siderolabs/talos/pkg/machinery/nethelpers"
+)
+
+//go:embed testdata/trafficcontrolconfig.yaml
+var expectedTrafficControlConfigDocument []byte
+
+func newTrafficControlConfig() *network.TrafficControlConfigV1Alpha1 {
+	cfg := network.NewTrafficControlConfigV1Alpha1("enp0s2")
+	cfg.QdiscKind = nethelpers.QdiscKindHTB
+	cfg.HTBConfig = network.TrafficControlHTBConfig{
+		DefaultClass: 30,
+		Classes: []network.TrafficControlHTBClassConfig{
+			{
+				ClassID: 10,
+				Rate:    network.Rate(2_000_000_000 / 8),
+				Ceil:    network.Rate(10_000_000_000 / 8),
+			},
+			{
+				ClassID:  20,
+				Rate:     network.Rate(5_000_000_000 / 8),
+				Priority: 1,
+			},
+			{
+				ClassID: 30,
+				Rate:    network.Rate(1_000_000_000 / 8),
+				Ceil:    network.Rate(10_000_000_000 / 8),
+			},
+		},
+	}
+	cfg.FilterConfigs = []network.TrafficControlFilterConfig{
+		{
+			ClassID: 10,
+			Kind:    nethelpers.TrafficControlFilterKindDSCP,
+			DSCP:    46,
+		},
+		{
+			ClassID: 20,
+			Kind:    nethelpers.TrafficControlFilterKindFwMark,
+			FwMark:  0x100,
+			FwMask:  0xf00,
+		},
+		{
+			ClassID:    30,
+			Kind:       nethelpers.TrafficControlFilterKindCgroup,
+			CgroupPath: "/kubepods/besteffort",
+		},
+	}
+
+	return cfg
+}
+
+func TestTrafficControlConfigMarshalStability(t *testing.T) {
+	marshaled, err := encoder.NewEncoder(newTrafficControlConfig()).Encode()
+	require.NoError(t, err)
+
+	assert.Equal(t, expectedTrafficControlConfigDocument, marshaled)
+}
+
+func TestTrafficControlConfigUnmarshal(t *testing.T) {
+	provider, err := configloader.NewFromBytes(expectedTrafficControlConfigDocument)
+	require.NoError(t, err)
+
+	docs := provider.Documents()
+	require.Len(t, docs, 1)
+
+	assert.Equal(t, newTrafficControlConfig(), docs[0])
+
+	configs := provider.TrafficControlConfigs()
+	require.Len(t, configs, 1)
+
+	assert.Equal(t, "enp0s2", configs[0].Name())
+	assert.Equal(t, nethelpers.QdiscKindHTB, configs[0].Qdisc())
+	assert.Equal(t, config.TrafficControlHTBConfig{
+		DefaultClass: 30,
+		Classes: []config.TrafficControlHTBClassConfig{
+			{ClassID: 10, Rate: 250_000_000, Ceil: 1_250_000_000},
+			{ClassID: 20, Rate: 625_000_000, Ceil: 625_000_000, Priority: 1},
+			{ClassID: 30, Rate: 125_000_000, Ceil: 1_250_000_000},
+		},
+	}, configs[0].HTB())
+	assert.Equal(t, []config.TrafficControlFilterConfig{
+		{Kind: nethelpers.TrafficControlFilterKindDSCP, ClassID: 10, DSCP: 46},
+		{Kind: nethelpers.TrafficControlFilterKindFwMark, ClassID: 20, FwMark: 0x100, FwMask: 0xf00},
+		{Kind: nethelpers.TrafficControlFilterKindCgroup, ClassID: 30, CgroupPath: "/kubepods/besteffort"},
+	}, configs[0].Filters())
+	assert.True(t, configs[0].FQCodel().ECN)
+}
+
+func TestTrafficControlConfigValidate(t *testing.T) {
+	t.Parallel()
+
+	for _, test := range []struct {
+		name string
+		cfg  func() *network.TrafficControlConfigV1Alpha1
+
+		expectedError string
+	}{
+		{
+			name: "valid",
+			cfg:  newTrafficControlConfig,
+		},
+		{
+			name: "fq_codel",
+			cfg: func() *network.TrafficControlConfigV1Alpha1 {
+				cfg := network.NewTrafficControlConfigV1Alpha1("eth0")
+				cfg.FQCodelConfig.Interval = 100 * time.Millisecond
+
+				return cfg
+			},
+		},
+		{
+			name: "empty",
+			cfg: func() *network.TrafficControlConfigV1Alpha1 {
+				return network.NewTrafficControlConfigV1Alpha1("")
+			},
+
+			expectedError: "1 error occurred:\n\t* name is required\n\n",
+		},
+		{
+			name: "invalid htb",
+			cfg: func() *network.TrafficControlConfigV1Alpha1 {
+				cfg := newTrafficControlConfig()
+				cfg.HTBConfig.DefaultClass = 40
+				cfg.HTBConfig.Classes[1].ClassID = 10
+				cfg.HTBConfig.Classes[2].Ceil = 1
+				cfg.FilterConfigs[0].DSCP = 64
+				cfg.FilterConfigs[1].ClassID = 50
+				cfg.FilterConfigs[1].DSCP = 1
+				cfg.FilterConfigs[2].CgroupPath = "kubepods"
+				cfg.FilterConfigs = append(cfg.FilterConfigs, network.TrafficControlFilterConfig{
+					ClassID:    10,
+					Kind:       nethelpers.TrafficControlFilterKindFwMark,
+					FwMark:     0x200,
+					CgroupPath: "/kubepods",
+				})
+
+				return cfg
+			},
+
+			expectedError: "8 errors occurred:\n" +
+				"\t* htb.classes[1].classID 10 is duplicate\n" +
+				"\t* htb.classes[2].ceil should be greater or equal to the rate\n" +
+				"\t* htb.defaultClass 40 is not defined\n" +
+				"\t* filters[0].dscp should be in range 0-63\n" +
+				"\t* filters[1].classID 50 is not defined\n" +
+				"\t* filters[1].dscp can be only used with dscp filter\n" +
+				"\t* filters[2].cgroupPath should be an absolute path of a non-root cgroup\n" +
+				"\t* filters[3].cgroupPath can be only used with cgroup filter\n\n",
+		},
+		{
+			name: "invalid tbf",
+			cfg: func() *network.TrafficControlConfigV1Alpha1 {
+				cfg := newTrafficControlConfig()
+				cfg.QdiscKind = nethelpers.QdiscKindTBF
+
+				return cfg
+			},
+
+			expectedError: "5 errors occurred:\n" +
+				"\t* tbf.rate is required\n" +
+				"\t* tbf.burst is required\n" +
+				"\t* tbf.latency is required\n" +
+				"\t* htb.classes can be only used with htb qdisc\n" +
+				"\t* filters can be only used with htb qdisc\n\n",
+		},
+	} {
+		test := test
+
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			_, err := test.cfg().Validate(validationMode{})
+
+			if test.expectedError == "" {
+				require.NoError(t, err)
+			} else {
+				require.EqualError(t, err, test.expectedError)
+			}
+		})
+	}
+}
+
+func TestParseRate(t *testing.T) {
+	t.Parallel()
+
+	for _, test := range []struct {
+		in       string
+		expected network.Rate
+		str      string
+	}{
+		{in: "1000", expected: 125, str: "1kbit"},
+		{in: "100mbit", expected: 12_500_000, str: "100mbit"},
+		{in: "1.5Gbit", expected: 187_500_000, str: "1500mbit"},
+		{in: "10gbit", expected: 1_250_000_000, str: "10gbit"},
+		{in: "1mbps", expected: 1_000_000, str: "8mbit"},
+		{in: "100bps", expected: 100, str: "800bit"},
+	} {
+		rate, err := network.ParseRate(test.in)
+		require.NoError(t, err, test.in)
+
+		assert.Equal(t, test.expected, rate, test.in)
+		assert.Equal(t, test.str, rate.String(), test.in)
+	}
+
+	_, err := network.ParseRate("fast")
+	require.Error(t, err)
+}
@@ -0,0 +1,117 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package runtime
+
+import (
+	"errors"
+	"fmt"
+	"path/filepath"
+	"strings"
+
+	"github.com/hashicorp/go-multierror"
+
+	"github.com/siderolabs/talos/pkg/machinery/config/config"
+	"github.com/siderolabs/talos/pkg/machinery/config/internal/registry"
+	"github.com/siderolabs/talos/pkg/machinery/config/types/meta"
+	"github.com/siderolabs/talos/pkg/machinery/config/validation"
+	"github.com/siderolabs/talos/pkg/machinery/constants"
+)
+
+// PersistentLogsConfigKind is a persistent logs config document kind.
+const PersistentLogsConfigKind = "PersistentLogsConfig"
+
+func init() {
+	registry.Register(PersistentLogsConfigKind, func(version string) config.Document {
+		switch version {
+		case "v1alpha1":
+			return &PersistentLogsConfigV1Alpha1{}
+		default:
+			return nil
+		}
+	})
+}
+
+// Check interfaces.
+var (
+	_ config.PersistentLogsConfig = &PersistentLogsConfigV1Alpha1{}
+	_ config.Validator            = &PersistentLogsConfigV1Alpha1{}
+)
+
+// PersistentLogsConfigV1Alpha1 is a persistent service logs configuration document.
+//
+// When present, service logs are additionally written to disk as compressed rotated segments,
+// so that the logs of the previous boots are available.
+type PersistentLogsConfigV1Alpha1 struct {
+	meta.Meta       `yaml:",inline"`
+	LogsPath        string `yaml:"path,omitempty"`
+	LogsMaxBoots    int    `yaml:"maxBoots,omitempty"`
+	LogsMaxSegments int    `yaml:"maxSegments,omitempty"`
+}
+
+// NewPersistentLogsConfigV1Alpha1 creates a new PersistentLogsConfig config document.
+func NewPersistentLogsConfigV1Alpha1() *PersistentLogsConfigV1Alpha1 {
+	return &PersistentLogsConfigV1Alpha1{
+		Meta: meta.Meta{
+			MetaKind:       PersistentLogsConfigKind,
+			MetaAPIVersion: "v1alpha1",
+		},
+	}
+}
+
+// Clone implements config.Document interface.
+func (s *PersistentLogsConfigV1Alpha1) Clone() config.Document {
+	return s.DeepCopy()
+}
+
+// Path implements config.PersistentLogsConfig interface.
+func (s *PersistentLogsConfigV1Alpha1) Path() string {
+	if s.LogsPath == "" {
+		return constants.PersistentLogPath
+	}
+
+	return s.LogsPath
+}
+
+// MaxBoots implements config.PersistentLogsConfig interface.
+func (s *PersistentLogsConfigV1Alpha1) MaxBoots() int {
+	if s.LogsMaxBoots == 0 {
+		return constants.PersistentLogMaxBoots
+	}
+
+	return s.LogsMaxBoots
+}
+
+// MaxSegments implements config.PersistentLogsConfig interface.
+func (s *PersistentLogsConfigV1Alpha1) MaxSegments() int {
+	if s.LogsMaxSegments == 0 {
+		return constants.PersistentLogMaxSegments
+	}
+
+	return s.LogsMaxSegments
+}
+
+// Validate implements config.Validator interface.
+func (s *PersistentLogsConfigV1Alpha1) Validate(validation.RuntimeMode, ...validation.Option) ([]string, error) {
+	var errs error
+
+	if s.LogsPath != "" {
+		switch {
+		case !filepath.IsAbs(s.LogsPath) || filepath.Clean(s.LogsPath) != s.LogsPath:
+			errs = multierror.Append(errs, fmt.Errorf("path %q should be an absolute clean path", s.LogsPath))
+		case !strings.HasPrefix(s.LogsPath, "/var/"):
+			errs = multierror.Append(errs, fmt.Errorf("path %q should be under /var", s.LogsPath))
+		}
+	}
+
+	if s.LogsMaxBoots < 0 {
+		errs = multierror.Append(errs, errors.New("maxBoots should not be negative"))
+	}
+
+	if s.LogsMaxSegments < 0 {
+		errs = multierror.Append(errs, errors.New("maxSegments should not be negative"))
+	}
+
+	return nil, errs
+}
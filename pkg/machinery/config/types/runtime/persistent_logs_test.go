@@ -0,0 +1,140 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package runtime_test
+
+import (
+	_ "embed"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/siderolabs/talos/pkg/machinery/config/configloader"
+	"github.com/siderolabs/talos/pkg/machinery/config/encoder"
+	"github.com/siderolabs/talos/pkg/machinery/config/types/runtime"
+	"github.com/siderolabs/talos/pkg/machinery/constants"
+)
+
+//go:embed testdata/persistentlogsconfig.yaml
+var expectedPersistentLogsConfigDocument []byte
+
+func newPersistentLogsConfig() *runtime.PersistentLogsConfigV1Alpha1 {
+	cfg := runtime.NewPersistentLogsConfigV1Alpha1()
+	cfg.LogsPath = "/var/mnt/logs"
+	cfg.LogsMaxBoots = 3
+	cfg.LogsMaxSegments = 20
+
+	return cfg
+}
+
+func TestPersistentLogsConfigMarshalStability(t *testing.T) {
+	marshaled, err := encoder.NewEncoder(newPersistentLogsConfig()).Encode()
+	require.NoError(t, err)
+
+	assert.Equal(t, expectedPersistentLogsConfigDocument, marshaled)
+}
+
+func TestPersistentLogsConfigUnmarshal(t *testing.T) {
+	provider, err := configloader.NewFromBytes(expectedPersistentLogsConfigDocument)
+	require.NoError(t, err)
+
+	docs := provider.Documents()
+	require.Len(t, docs, 1)
+
+	assert.Equal(t, newPersistentLogsConfig(), docs[0])
+
+	logs := provider.PersistentLogs()
+	require.NotNil(t, logs)
+
+	assert.Equal(t, "/var/mnt/logs", logs.Path())
+	assert.Equal(t, 3, logs.MaxBoots())
+	assert.Equal(t, 20, logs.MaxSegments())
+}
+
+func TestPersistentLogsConfigDefaults(t *testing.T) {
+	cfg := runtime.NewPersistentLogsConfigV1Alpha1()
+
+	assert.Equal(t, constants.PersistentLogPath, cfg.Path())
+	assert.Equal(t, constants.PersistentLogMaxBoots, cfg.MaxBoots())
+	assert.Equal(t, constants.PersistentLogMaxSegments, cfg.MaxSegments())
+}
+
+func TestPersistentLogsConfigValidate(t *testing.T) {
+	t.Parallel()
+
+	for _, test := range []struct {
+		name string
+		cfg  func() *runtime.PersistentLogsConfigV1Alpha1
+
+		expectedError string
+	}{
+		{
+			name: "valid",
+			cfg:  newPersistentLogsConfig,
+		},
+		{
+			name: "empty",
+			cfg:  runtime.NewPersistentLogsConfigV1Alpha1,
+		},
+		{
+			name: "relative path",
+			cfg: func() *runtime.PersistentLogsConfigV1Alpha1 {
+				cfg := newPersistentLogsConfig()
+				cfg.LogsPath = "var/log"
+
+				return cfg
+			},
+
+			expectedError: "1 error occurred:\n\t* path \"var/log\" should be an absolute clean path\n\n",
+		},
+		{
+			name: "path outside of var",
+			cfg: func() *runtime.PersistentLogsConfigV1Alpha1 {
+				cfg := newPersistentLogsConfig()
+				cfg.LogsPath = "/system/logs"
+
+				return cfg
+			},
+
+			expectedError: "1 error occurred:\n\t* path \"/system/logs\" should be under /var\n\n",
+		},
+		{
+			name: "negative limits",
+			cfg: func() *runtime.PersistentLogsConfigV1Alpha1 {
+				cfg := newPersistentLogsConfig()
+				cfg.LogsMaxBoots = -1
+				cfg.LogsMaxSegments = -1
+
+				return cfg
+			},
+
+			expectedError: "2 errors occurred:\n\t* maxBoots should not be negative\n\t* maxSegments should not be negative\n\n",
+		},
+	} {
+		test := test
+
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			_, err := test.cfg().Validate(validationMode{})
+
+			if test.expectedError == "" {
+				require.NoError(t, err)
+			} else {
+				require.EqualError(t, err, test.expectedError)
+			}
+		})
+	}
+}
+
+type validationMode struct{}
+
+func (validationMode) String() string {
+	return ""
+}
+
+func (validationMode) RequiresInstall() bool {
+	return false
+}
@@ -6,6 +6,10 @@
 package types
 
 import (
+	_ "github.com/siderolabs/talos/pkg/machinery/config/types/block"      //nolint:revive
+	_ "github.com/siderolabs/talos/pkg/machinery/config/types/etcd"       //nolint:revive
+	_ "github.com/siderolabs/talos/pkg/machinery/config/types/network"    //nolint:revive
+	_ "github.com/siderolabs/talos/pkg/machinery/config/types/runtime"    //nolint:revive
 	_ "github.com/siderolabs/talos/pkg/machinery/config/types/siderolink" //nolint:revive
 	_ "github.com/siderolabs/talos/pkg/machinery/config/types/v1alpha1"
 )
@@ -106,6 +106,15 @@ func (c *ClusterConfig) SecretboxEncryptionSecret() string {
 	return c.ClusterSecretboxEncryptionSecret
 }
 
+// KMSEncryption implements the config.ClusterConfig interface.
+func (c *ClusterConfig) KMSEncryption() config.KMSEncryption {
+	if c.ClusterKMSEncryptionConfig == nil {
+		return &KMSEncryptionConfig{}
+	}
+
+	return c.ClusterKMSEncryptionConfig
+}
+
 // Etcd implements the config.ClusterConfig interface.
 func (c *ClusterConfig) Etcd() config.Etcd {
 	if c.EtcdConfig == nil {
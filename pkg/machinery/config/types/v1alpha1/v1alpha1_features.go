@@ -5,6 +5,7 @@
 package v1alpha1
 
 import (
+	"github.com/siderolabs/gen/slices"
 	"github.com/siderolabs/go-pointer"
 
 	"github.com/siderolabs/talos/pkg/machinery/config/config"
@@ -48,6 +49,15 @@ func (f *FeaturesConfig) APIServerBalancer() config.APIServerBalancer {
 	return f.APIServerBalancerSupport
 }
 
+// HostDNS implements config.Features interface.
+func (f *FeaturesConfig) HostDNS() config.HostDNS {
+	if f.HostDNSSupport == nil {
+		return &HostDNSConfig{}
+	}
+
+	return f.HostDNSSupport
+}
+
 const defaultAPIBalancerPort = 7445
 
 // Enabled implements config.APIServerBalancer.
@@ -63,3 +73,28 @@ func (a *APIServerBalancer) Port() int {
 
 	return a.ServerPort
 }
+
+// Enabled implements config.HostDNS.
+func (h *HostDNSConfig) Enabled() bool {
+	return pointer.SafeDeref(h.HostDNSEnabled)
+}
+
+// ForwardKubeDNSToHost implements config.HostDNS.
+func (h *HostDNSConfig) ForwardKubeDNSToHost() bool {
+	return pointer.SafeDeref(h.HostDNSForwardKubeDNSToHost)
+}
+
+// ForwardDomains implements config.HostDNS.
+func (h *HostDNSConfig) ForwardDomains() []config.HostDNSForwardDomain {
+	return slices.Map(h.HostDNSForwardDomains, func(d *HostDNSForwardDomain) config.HostDNSForwardDomain { return d })
+}
+
+// Domain implements config.HostDNSForwardDomain.
+func (d *HostDNSForwardDomain) Domain() string {
+	return d.ForwardDomain
+}
+
+// Servers implements config.HostDNSForwardDomain.
+func (d *HostDNSForwardDomain) Servers() []string {
+	return d.ForwardServers
+}
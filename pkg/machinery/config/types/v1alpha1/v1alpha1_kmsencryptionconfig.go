@@ -0,0 +1,55 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package v1alpha1
+
+import (
+	"time"
+
+	"github.com/siderolabs/go-pointer"
+
+	"github.com/siderolabs/talos/pkg/machinery/constants"
+)
+
+// Enabled implements the config.KMSEncryption interface.
+func (kms *KMSEncryptionConfig) Enabled() bool {
+	return pointer.SafeDeref(kms.KMSEnabled)
+}
+
+// Name implements the config.KMSEncryption interface.
+func (kms *KMSEncryptionConfig) Name() string {
+	if kms.KMSName == "" {
+		return constants.KubernetesKMSPluginDefaultName
+	}
+
+	return kms.KMSName
+}
+
+// Endpoint implements the config.KMSEncryption interface.
+func (kms *KMSEncryptionConfig) Endpoint() string {
+	if kms.KMSEndpoint == "" {
+		return constants.KubernetesKMSPluginDefaultEndpoint
+	}
+
+	return kms.KMSEndpoint
+}
+
+// Timeout implements the config.KMSEncryption interface.
+func (kms *KMSEncryptionConfig) Timeout() time.Duration {
+	if kms.KMSTimeout == 0 {
+		return constants.KubernetesKMSPluginDefaultTimeout
+	}
+
+	return kms.KMSTimeout
+}
+
+// PluginImage implements the config.KMSEncryption interface.
+func (kms *KMSEncryptionConfig) PluginImage() string {
+	return kms.KMSPluginImage
+}
+
+// PluginExtraArgs implements the config.KMSEncryption interface.
+func (kms *KMSEncryptionConfig) PluginExtraArgs() map[string]string {
+	return kms.KMSPluginExtraArgs
+}
@@ -5,11 +5,15 @@
 package v1alpha1
 
 import (
+	"crypto/tls"
+	stdx509 "crypto/x509"
 	"fmt"
 	"net/url"
 
 	"github.com/hashicorp/go-multierror"
+	"github.com/siderolabs/crypto/x509"
 	"github.com/siderolabs/gen/slices"
+	"github.com/siderolabs/go-pointer"
 
 	"github.com/siderolabs/talos/pkg/machinery/config/config"
 	"github.com/siderolabs/talos/pkg/machinery/constants"
@@ -38,11 +42,21 @@ func (lc *LoggingConfig) Validate() error {
 		}
 
 		switch f := dest.LoggingFormat; f {
-		case constants.LoggingFormatJSONLines:
+		case constants.LoggingFormatJSONLines, constants.LoggingFormatSyslog, constants.LoggingFormatGELF:
 			// nothing
 		default:
 			errs = multierror.Append(errs, fmt.Errorf("unknown logging format %q", f))
 		}
+
+		if dest.LoggingTLS != nil {
+			if endpoint != nil && endpoint.Scheme != "tcp" {
+				errs = multierror.Append(errs, fmt.Errorf("TLS is supported only for \"tcp\" logging endpoints, got %q", endpoint.Scheme))
+			}
+
+			if _, err := dest.LoggingTLS.GetTLSConfig(); err != nil {
+				errs = multierror.Append(errs, fmt.Errorf("invalid logging TLS configuration: %w", err))
+			}
+		}
 	}
 
 	return errs.ErrorOrNil()
@@ -62,3 +76,55 @@ func (ld LoggingDestination) Endpoint() *url.URL {
 func (ld LoggingDestination) Format() string {
 	return ld.LoggingFormat
 }
+
+// TLS implements config.LoggingDestination interface.
+func (ld LoggingDestination) TLS() config.LoggingTLSConfig {
+	if ld.LoggingTLS == nil {
+		return nil
+	}
+
+	return ld.LoggingTLS
+}
+
+// ClientIdentity implements config.LoggingTLSConfig interface.
+func (t *LoggingTLSConfig) ClientIdentity() *x509.PEMEncodedCertificateAndKey {
+	return t.TLSClientIdentity
+}
+
+// CA implements config.LoggingTLSConfig interface.
+func (t *LoggingTLSConfig) CA() []byte {
+	return t.TLSCA
+}
+
+// InsecureSkipVerify implements config.LoggingTLSConfig interface.
+func (t *LoggingTLSConfig) InsecureSkipVerify() bool {
+	return pointer.SafeDeref(t.TLSInsecureSkipVerify)
+}
+
+// GetTLSConfig prepares TLS configuration for connection.
+func (t *LoggingTLSConfig) GetTLSConfig() (*tls.Config, error) {
+	tlsConfig := &tls.Config{}
+
+	if t.TLSClientIdentity != nil {
+		cert, err := tls.X509KeyPair(t.TLSClientIdentity.Crt, t.TLSClientIdentity.Key)
+		if err != nil {
+			return nil, fmt.Errorf("error parsing client identity: %w", err)
+		}
+
+		tlsConfig.Certificates = []tls.Certificate{cert}
+	}
+
+	if t.TLSCA != nil {
+		tlsConfig.RootCAs = stdx509.NewCertPool()
+
+		if !tlsConfig.RootCAs.AppendCertsFromPEM(t.TLSCA) {
+			return nil, fmt.Errorf("error parsing CA certificate")
+		}
+	}
+
+	if t.InsecureSkipVerify() {
+		tlsConfig.InsecureSkipVerify = true
+	}
+
+	return tlsConfig, nil
+}
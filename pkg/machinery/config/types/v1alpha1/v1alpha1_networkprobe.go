@@ -0,0 +1,91 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package v1alpha1
+
+import (
+	"time"
+
+	"github.com/siderolabs/talos/pkg/machinery/config/config"
+	"github.com/siderolabs/talos/pkg/machinery/constants"
+)
+
+// Interval implements the config.NetworkProbe interface.
+func (p *NetworkProbe) Interval() time.Duration {
+	if p.ProbeInterval == 0 {
+		return constants.NetworkProbeDefaultInterval
+	}
+
+	return p.ProbeInterval
+}
+
+// FailureThreshold implements the config.NetworkProbe interface.
+func (p *NetworkProbe) FailureThreshold() int {
+	if p.ProbeFailureThreshold == 0 {
+		return constants.NetworkProbeDefaultFailureThreshold
+	}
+
+	return p.ProbeFailureThreshold
+}
+
+// Timeout implements the config.NetworkProbe interface.
+func (p *NetworkProbe) Timeout() time.Duration {
+	if p.ProbeTimeout == 0 {
+		return constants.NetworkProbeDefaultTimeout
+	}
+
+	return p.ProbeTimeout
+}
+
+// TCP implements the config.NetworkProbe interface.
+func (p *NetworkProbe) TCP() string {
+	return p.ProbeTCP
+}
+
+// ICMP implements the config.NetworkProbe interface.
+func (p *NetworkProbe) ICMP() string {
+	return p.ProbeICMP
+}
+
+// HTTP implements the config.NetworkProbe interface.
+func (p *NetworkProbe) HTTP() config.NetworkProbeHTTP {
+	if p.ProbeHTTP == nil {
+		return nil
+	}
+
+	return p.ProbeHTTP
+}
+
+// DNS implements the config.NetworkProbe interface.
+func (p *NetworkProbe) DNS() config.NetworkProbeDNS {
+	if p.ProbeDNS == nil {
+		return nil
+	}
+
+	return p.ProbeDNS
+}
+
+// URL implements the config.NetworkProbeHTTP interface.
+func (h *NetworkProbeHTTP) URL() string {
+	return h.HTTPURL
+}
+
+// ExpectedStatus implements the config.NetworkProbeHTTP interface.
+func (h *NetworkProbeHTTP) ExpectedStatus() int {
+	if h.HTTPExpectedStatus == 0 {
+		return constants.NetworkProbeDefaultHTTPStatus
+	}
+
+	return h.HTTPExpectedStatus
+}
+
+// Name implements the config.NetworkProbeDNS interface.
+func (d *NetworkProbeDNS) Name() string {
+	return d.DNSName
+}
+
+// Server implements the config.NetworkProbeDNS interface.
+func (d *NetworkProbeDNS) Server() string {
+	return d.DNSServer
+}
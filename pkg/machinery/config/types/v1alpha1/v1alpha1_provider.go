@@ -566,6 +566,15 @@ func (n *NetworkConfig) KubeSpan() config.KubeSpan {
 	return n.NetworkKubeSpan
 }
 
+// LLDP implements the config.Provider interface.
+func (n *NetworkConfig) LLDP() config.LLDP {
+	if n.NetworkLLDP == nil {
+		return &NetworkLLDP{}
+	}
+
+	return n.NetworkLLDP
+}
+
 // IP implements the MachineNetwork interface.
 func (e *ExtraHost) IP() string {
 	return e.HostIP
@@ -598,6 +607,16 @@ func (d *Device) Routes() []config.Route {
 	return slices.Map(d.DeviceRoutes, func(r *Route) config.Route { return r })
 }
 
+// Rules implements the MachineNetwork interface.
+func (d *Device) Rules() []config.RoutingRule {
+	return slices.Map(d.DeviceRules, func(r *RoutingRule) config.RoutingRule { return r })
+}
+
+// Neighbors implements the MachineNetwork interface.
+func (d *Device) Neighbors() []config.Neighbor {
+	return slices.Map(d.DeviceNeighbors, func(n *Neighbor) config.Neighbor { return n })
+}
+
 // Bond implements the MachineNetwork interface.
 func (d *Device) Bond() config.Bond {
 	if d.DeviceBond == nil {
@@ -653,6 +672,15 @@ func (d *Device) DHCPOptions() config.DHCPOptions {
 	return d.DeviceDHCPOptions
 }
 
+// IPv6Config implements the MachineNetwork interface.
+func (d *Device) IPv6Config() config.IPv6Config {
+	if d.DeviceIPv6 == nil {
+		return nil
+	}
+
+	return d.DeviceIPv6
+}
+
 // VIPConfig implements the MachineNetwork interface.
 func (d *Device) VIPConfig() config.VIPConfig {
 	if d.DeviceVIPConfig == nil {
@@ -685,6 +713,15 @@ func (d *DeviceVIPConfig) EquinixMetal() config.VIPEquinixMetal {
 	return d.EquinixMetalConfig
 }
 
+// Probe implements the config.VIPConfig interface.
+func (d *DeviceVIPConfig) Probe() config.NetworkProbe {
+	if d.VIPProbe == nil {
+		return nil
+	}
+
+	return d.VIPProbe
+}
+
 // APIToken implements the config.VIPEquinixMetal interface.
 func (v *VIPEquinixMetalConfig) APIToken() string {
 	return v.EquinixMetalAPIToken
@@ -704,6 +741,45 @@ func (v *VIPHCloudConfig) APIToken() string {
 	return v.HCloudAPIToken
 }
 
+// BGP implements the config.VIPConfig interface.
+func (d *DeviceVIPConfig) BGP() config.VIPBGP {
+	if d.BGPConfig == nil {
+		return nil
+	}
+
+	return d.BGPConfig
+}
+
+// LocalASN implements the config.VIPBGP interface.
+func (v *VIPBGPConfig) LocalASN() uint32 {
+	return v.BGPLocalASN
+}
+
+// RouterID implements the config.VIPBGP interface.
+func (v *VIPBGPConfig) RouterID() string {
+	return v.BGPRouterID
+}
+
+// Peers implements the config.VIPBGP interface.
+func (v *VIPBGPConfig) Peers() []config.VIPBGPPeer {
+	return slices.Map(v.BGPPeers, func(p *VIPBGPPeer) config.VIPBGPPeer { return p })
+}
+
+// Address implements the config.VIPBGPPeer interface.
+func (p *VIPBGPPeer) Address() string {
+	return p.PeerAddress
+}
+
+// ASN implements the config.VIPBGPPeer interface.
+func (p *VIPBGPPeer) ASN() uint32 {
+	return p.PeerASN
+}
+
+// Password implements the config.VIPBGPPeer interface.
+func (p *VIPBGPPeer) Password() string {
+	return p.PeerPassword
+}
+
 // WireguardConfig implements the MachineNetwork interface.
 func (d *Device) WireguardConfig() config.WireguardConfig {
 	if d.DeviceWireguardConfig == nil {
@@ -713,6 +789,92 @@ func (d *Device) WireguardConfig() config.WireguardConfig {
 	return d.DeviceWireguardConfig
 }
 
+// VRF implements the MachineNetwork interface.
+func (d *Device) VRF() config.VRF {
+	if d.DeviceVRF == nil {
+		return nil
+	}
+
+	return d.DeviceVRF
+}
+
+// VXLAN implements the MachineNetwork interface.
+func (d *Device) VXLAN() config.VXLAN {
+	if d.DeviceVXLAN == nil {
+		return nil
+	}
+
+	return d.DeviceVXLAN
+}
+
+// MACVLAN implements the MachineNetwork interface.
+func (d *Device) MACVLAN() config.MACVLAN {
+	if d.DeviceMACVLAN == nil {
+		return nil
+	}
+
+	return d.DeviceMACVLAN
+}
+
+// IPVLAN implements the MachineNetwork interface.
+func (d *Device) IPVLAN() config.IPVLAN {
+	if d.DeviceIPVLAN == nil {
+		return nil
+	}
+
+	return d.DeviceIPVLAN
+}
+
+// Interfaces implements the config.VRF interface.
+func (v *VRF) Interfaces() []string {
+	return v.VRFInterfaces
+}
+
+// Table implements the config.VRF interface.
+func (v *VRF) Table() uint32 {
+	return v.VRFTable
+}
+
+// ID implements the config.VXLAN interface.
+func (v *VXLAN) ID() uint32 {
+	return v.VXLANID
+}
+
+// Local implements the config.VXLAN interface.
+func (v *VXLAN) Local() string {
+	return v.VXLANLocal
+}
+
+// Remote implements the config.VXLAN interface.
+func (v *VXLAN) Remote() string {
+	return v.VXLANRemote
+}
+
+// Port implements the config.VXLAN interface.
+func (v *VXLAN) Port() uint16 {
+	return v.VXLANPort
+}
+
+// Parent implements the config.MACVLAN interface.
+func (m *MACVLAN) Parent() string {
+	return m.MACVLANParent
+}
+
+// Mode implements the config.MACVLAN interface.
+func (m *MACVLAN) Mode() string {
+	return m.MACVLANMode
+}
+
+// Parent implements the config.IPVLAN interface.
+func (i *IPVLAN) Parent() string {
+	return i.IPVLANParent
+}
+
+// Mode implements the config.IPVLAN interface.
+func (i *IPVLAN) Mode() string {
+	return i.IPVLANMode
+}
+
 // RouteMetric implements the DHCPOptions interface.
 func (d *DHCPOptions) RouteMetric() uint32 {
 	return d.DHCPRouteMetric
@@ -736,6 +898,39 @@ func (d *DHCPOptions) IPv6() bool {
 	return *d.DHCPIPv6
 }
 
+// AcceptRA implements the IPv6Config interface.
+func (c *DeviceIPv6Config) AcceptRA() bool {
+	if c.IPv6AcceptRA == nil {
+		return true
+	}
+
+	return *c.IPv6AcceptRA
+}
+
+// Autoconf implements the IPv6Config interface.
+func (c *DeviceIPv6Config) Autoconf() bool {
+	if c.IPv6Autoconf == nil {
+		return true
+	}
+
+	return *c.IPv6Autoconf
+}
+
+// PrivacyExtensions implements the IPv6Config interface.
+func (c *DeviceIPv6Config) PrivacyExtensions() bool {
+	return pointer.SafeDeref(c.IPv6PrivacyExtensions)
+}
+
+// AddrGenMode implements the IPv6Config interface.
+func (c *DeviceIPv6Config) AddrGenMode() string {
+	return c.IPv6AddrGenMode
+}
+
+// RouteMetric implements the IPv6Config interface.
+func (c *DeviceIPv6Config) RouteMetric() uint32 {
+	return c.IPv6RouteMetric
+}
+
 // DUIDv6 implements the DHCPOptions interface.
 func (d *DHCPOptions) DUIDv6() string {
 	return d.DHCPDUIDv6
@@ -826,6 +1021,70 @@ func (r *Route) MTU() uint32 {
 	return r.RouteMTU
 }
 
+// Probe implements the MachineNetwork interface.
+func (r *Route) Probe() config.NetworkProbe {
+	if r.RouteProbe == nil {
+		return nil
+	}
+
+	return r.RouteProbe
+}
+
+// Priority implements the config.RoutingRule interface.
+func (r *RoutingRule) Priority() uint32 {
+	return r.RulePriority
+}
+
+// From implements the config.RoutingRule interface.
+func (r *RoutingRule) From() string {
+	return r.RuleFrom
+}
+
+// To implements the config.RoutingRule interface.
+func (r *RoutingRule) To() string {
+	return r.RuleTo
+}
+
+// FwMark implements the config.RoutingRule interface.
+func (r *RoutingRule) FwMark() uint32 {
+	return r.RuleFwMark
+}
+
+// FwMask implements the config.RoutingRule interface.
+func (r *RoutingRule) FwMask() uint32 {
+	return r.RuleFwMask
+}
+
+// IIF implements the config.RoutingRule interface.
+func (r *RoutingRule) IIF() string {
+	return r.RuleIIF
+}
+
+// OIF implements the config.RoutingRule interface.
+func (r *RoutingRule) OIF() string {
+	return r.RuleOIF
+}
+
+// Table implements the config.RoutingRule interface.
+func (r *RoutingRule) Table() uint32 {
+	return r.RuleTable
+}
+
+// IP implements the config.Neighbor interface.
+func (n *Neighbor) IP() string {
+	return n.NeighborIP
+}
+
+// LinkAddr implements the config.Neighbor interface.
+func (n *Neighbor) LinkAddr() string {
+	return n.NeighborLinkAddr
+}
+
+// Proxy implements the config.Neighbor interface.
+func (n *Neighbor) Proxy() bool {
+	return n.NeighborProxy
+}
+
 // Interfaces implements the MachineNetwork interface.
 func (b *Bond) Interfaces() []string {
 	if b == nil {
@@ -1058,6 +1317,20 @@ func (v *Vlan) ID() uint16 {
 	return v.VlanID
 }
 
+// Transmit implements LLDP interface.
+func (l *NetworkLLDP) Transmit() bool {
+	return pointer.SafeDeref(l.LLDPTransmit)
+}
+
+// TransmitInterval implements LLDP interface.
+func (l *NetworkLLDP) TransmitInterval() time.Duration {
+	if l.LLDPTransmitInterval == 0 {
+		return constants.LLDPDefaultTransmitInterval
+	}
+
+	return l.LLDPTransmitInterval
+}
+
 // Enabled implements KubeSpan interface.
 func (k *NetworkKubeSpan) Enabled() bool {
 	return pointer.SafeDeref(k.KubeSpanEnabled)
@@ -1112,6 +1385,68 @@ func (t *TimeConfig) BootTimeout() time.Duration {
 	return t.TimeBootTimeout
 }
 
+// NTSFallback implements the config.Provider interface.
+func (t *TimeConfig) NTSFallback() bool {
+	return pointer.SafeDeref(t.TimeNTSFallback)
+}
+
+// NTSNoCertTimeCheck implements the config.Provider interface.
+func (t *TimeConfig) NTSNoCertTimeCheck() int {
+	return t.TimeNTSNoCertTimeCheck
+}
+
+// NTPServer implements the config.Provider interface.
+func (t *TimeConfig) NTPServer() config.NTPServer {
+	if t.TimeNTPServer == nil {
+		return &TimeNTPServerConfig{}
+	}
+
+	return t.TimeNTPServer
+}
+
+// PTP implements the config.Provider interface.
+func (t *TimeConfig) PTP() config.PTP {
+	if t.TimePTP == nil {
+		return &TimePTPConfig{}
+	}
+
+	return t.TimePTP
+}
+
+// Enabled implements the config.PTP interface.
+func (p *TimePTPConfig) Enabled() bool {
+	return pointer.SafeDeref(p.PTPEnabled)
+}
+
+// Device implements the config.PTP interface.
+func (p *TimePTPConfig) Device() string {
+	return p.PTPDevice
+}
+
+// Interface implements the config.PTP interface.
+func (p *TimePTPConfig) Interface() string {
+	return p.PTPInterface
+}
+
+// PollInterval implements the config.PTP interface.
+func (p *TimePTPConfig) PollInterval() time.Duration {
+	if p.PTPPollInterval == 0 {
+		return constants.DefaultPTPPollInterval
+	}
+
+	return p.PTPPollInterval
+}
+
+// Enabled implements the config.NTPServer interface.
+func (s *TimeNTPServerConfig) Enabled() bool {
+	return pointer.SafeDeref(s.NTPServerEnabled)
+}
+
+// Interfaces implements the config.NTPServer interface.
+func (s *TimeNTPServerConfig) Interfaces() []string {
+	return s.NTPServerInterfaces
+}
+
 // Image implements the config.Provider interface.
 func (i *InstallConfig) Image() string {
 	return i.InstallImage
@@ -1353,6 +1688,29 @@ func (e *EncryptionKey) NodeID() config.EncryptionKeyNodeID {
 	return e.KeyNodeID
 }
 
+// TPM implements the config.Provider interface.
+func (e *EncryptionKey) TPM() config.EncryptionKeyTPM {
+	if e.KeyTPM == nil {
+		return nil
+	}
+
+	return e.KeyTPM
+}
+
+// KMS implements the config.Provider interface.
+func (e *EncryptionKey) KMS() config.EncryptionKeyKMS {
+	if e.KeyKMS == nil {
+		return nil
+	}
+
+	return e.KeyKMS
+}
+
+// Endpoint implements the config.Provider interface.
+func (e *EncryptionKeyKMS) Endpoint() string {
+	return e.KMSEndpoint
+}
+
 // Slot implements the config.Provider interface.
 func (e *EncryptionKey) Slot() int {
 	return e.KeySlot
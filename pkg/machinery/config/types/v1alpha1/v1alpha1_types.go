@@ -238,6 +238,16 @@ var (
 		TimeBootTimeout: 2 * time.Minute,
 	}
 
+	machineTimePTPExample = &TimePTPConfig{
+		PTPEnabled:   pointer.To(true),
+		PTPInterface: "eth0",
+	}
+
+	machineTimeNTPServerExample = &TimeNTPServerConfig{
+		NTPServerEnabled:    pointer.To(true),
+		NTPServerInterfaces: []string{"eth1"},
+	}
+
 	machineSysctlsExample = map[string]string{
 		"kernel.domainname":   "talos.dev",
 		"net.ipv4.ip_forward": "0",
@@ -362,6 +372,17 @@ var (
 		},
 	}
 
+	clusterKMSEncryptionExample = &KMSEncryptionConfig{
+		KMSEnabled:  pointer.To(true),
+		KMSName:     "vault",
+		KMSEndpoint: "unix:///var/run/kmsplugin/vault.sock",
+		KMSTimeout:  5 * time.Second,
+	}
+
+	clusterKMSPluginExtraArgsExample = map[string]string{
+		"listen-addr": "unix:///var/run/kmsplugin/kms.sock",
+	}
+
 	clusterAdminKubeconfigExample = &AdminKubeconfigConfig{
 		AdminKubeconfigCertLifetime: time.Hour,
 	}
@@ -421,6 +442,31 @@ var (
 		},
 	}
 
+	networkConfigRulesExample = []*RoutingRule{
+		{
+			RulePriority: 1000,
+			RuleFrom:     "10.2.0.0/16",
+			RuleTable:    100,
+		},
+		{
+			RulePriority: 1001,
+			RuleFwMark:   0x20,
+			RuleFwMask:   0xff,
+			RuleTable:    101,
+		},
+	}
+
+	networkConfigNeighborsExample = []*Neighbor{
+		{
+			NeighborIP:       "10.2.0.10",
+			NeighborLinkAddr: "02:00:00:00:00:0a",
+		},
+		{
+			NeighborIP:    "10.2.0.100",
+			NeighborProxy: true,
+		},
+	}
+
 	networkConfigBondExample = &Bond{
 		BondMode:       "802.3ad",
 		BondLACPRate:   "fast",
@@ -434,14 +480,62 @@ var (
 		},
 	}
 
+	networkConfigVRFExample = &VRF{
+		VRFInterfaces: []string{"eth1", "eth2"},
+		VRFTable:      10,
+	}
+
+	networkConfigVXLANExample = &VXLAN{
+		VXLANID:     100,
+		VXLANLocal:  "10.5.0.2",
+		VXLANRemote: "239.1.1.1",
+		VXLANPort:   4789,
+	}
+
+	networkConfigMACVLANExample = &MACVLAN{
+		MACVLANParent: "eth0",
+		MACVLANMode:   "bridge",
+	}
+
+	networkConfigIPVLANExample = &IPVLAN{
+		IPVLANParent: "eth0",
+		IPVLANMode:   "l3",
+	}
+
 	networkConfigDHCPOptionsExample = &DHCPOptions{
 		DHCPRouteMetric: 1024,
 	}
 
+	networkConfigIPv6Example = &DeviceIPv6Config{
+		IPv6AcceptRA:          pointer.To(true),
+		IPv6PrivacyExtensions: pointer.To(true),
+		IPv6AddrGenMode:       "stable-privacy",
+		IPv6RouteMetric:       1024,
+	}
+
 	networkConfigVIPLayer2Example = &DeviceVIPConfig{
 		SharedIP: "172.16.199.55",
 	}
 
+	networkConfigVIPBGPExample = &DeviceVIPConfig{
+		SharedIP: "10.5.0.100",
+		BGPConfig: &VIPBGPConfig{
+			BGPLocalASN: 65001,
+			BGPPeers: []*VIPBGPPeer{
+				{
+					PeerAddress: "10.5.0.1",
+					PeerASN:     65000,
+				},
+			},
+		},
+	}
+
+	networkConfigProbeExample = &NetworkProbe{
+		ProbeInterval:         5 * time.Second,
+		ProbeFailureThreshold: 3,
+		ProbeICMP:             "192.168.1.1",
+	}
+
 	networkConfigWireguardHostExample = &DeviceWireguardConfig{
 		WireguardPrivateKey: "ABCDEF...",
 		WireguardListenPort: 51111,
@@ -489,6 +583,10 @@ metadata:
 		KubeSpanEnabled: pointer.To(true),
 	}
 
+	networkLLDPExample = &NetworkLLDP{
+		LLDPTransmit: pointer.To(true),
+	}
+
 	networkDeviceSelectorExamples = []NetworkDeviceSelector{
 		{
 			NetworkDeviceBus: "00:*",
@@ -530,6 +628,11 @@ metadata:
 		mustParseURL("tcp://1.2.3.4:12345"),
 	}
 
+	loggingTLSConfigExample = &LoggingTLSConfig{
+		TLSClientIdentity: pemEncodedCertificateExample,
+		TLSCA:             []byte("--- EXAMPLE CA CERTIFICATE ---"),
+	}
+
 	machineLoggingExample = LoggingConfig{
 		LoggingDestinations: []LoggingDestination{
 			{
@@ -607,6 +710,17 @@ metadata:
 			"kube-system",
 		},
 	}
+
+	hostDNSConfigExample = &HostDNSConfig{
+		HostDNSEnabled:              pointer.To(true),
+		HostDNSForwardKubeDNSToHost: pointer.To(true),
+		HostDNSForwardDomains: []*HostDNSForwardDomain{
+			{
+				ForwardDomain:  "corp.example",
+				ForwardServers: []string{"10.0.0.53", "10.0.1.53:5353"},
+			},
+		},
+	}
 )
 
 // Config defines the v1alpha1 configuration file.
@@ -923,6 +1037,12 @@ type ClusterConfig struct {
 	//       value: '"z01mye6j16bspJYtTB/5SFX8j7Ph4JXxM2Xuu4vsBPM="'
 	ClusterSecretboxEncryptionSecret string `yaml:"secretboxEncryptionSecret,omitempty"`
 	//   description: |
+	//     Configures the [KMSv2 plugin](https://kubernetes.io/docs/tasks/administer-cluster/kms-provider/) used for the encryption of secret data at rest.
+	//     KMS has precedence over secretbox and AESCBC, which are still used to decrypt the data encrypted before KMS was enabled.
+	//   examples:
+	//     - value: clusterKMSEncryptionExample
+	ClusterKMSEncryptionConfig *KMSEncryptionConfig `yaml:"kmsEncryption,omitempty"`
+	//   description: |
 	//     The base64 encoded root certificate authority used by Kubernetes.
 	//   examples:
 	//     - name: ClusterCA example.
@@ -1230,6 +1350,12 @@ type NetworkConfig struct {
 	//     - false
 	//     - no
 	NetworkDisableSearchDomain *bool `yaml:"disableSearchDomain,omitempty"`
+	//   description: |
+	//     Configures LLDP advertisements.
+	//     LLDP neighbors are always discovered on the physical links, see `talosctl get lldpneighbors`.
+	//   examples:
+	//     - value: networkLLDPExample
+	NetworkLLDP *NetworkLLDP `yaml:"lldp,omitempty"`
 }
 
 // NetworkDeviceList is a list of *Device structures with overridden merge process.
@@ -1502,8 +1628,25 @@ type TimeConfig struct {
 	//   description: |
 	//     Specifies time (NTP) servers to use for setting the system time.
 	//     Defaults to `pool.ntp.org`
+	//
+	//     Servers in `nts://host[:port]` format are queried using Network Time Security (NTS):
+	//     keys are negotiated over TLS with the NTS-KE server (port defaults to 4460),
+	//     and NTP responses are authenticated.
+	//   examples:
+	//     - value: '[]string{"nts://time.cloudflare.com"}'
 	TimeServers []string `yaml:"servers,omitempty"`
 	//   description: |
+	//     Allows falling back to unauthenticated NTP for `nts://` servers when NTS fails.
+	//     Fallback might be required if the NTS-KE port is blocked on the network.
+	//     Defaults to `false`, which means `nts://` servers are only used with NTS.
+	TimeNTSFallback *bool `yaml:"ntsFallback,omitempty"`
+	//   description: |
+	//     Number of NTS-KE exchanges before the first time sync which skip the validity period check of the server certificate.
+	//     The check fails if the local clock is too far off (e.g. RTC is not set), so NTS can't correct the clock,
+	//     but skipping it allows expired (and possibly compromised) certificates, similar to chrony's `nocerttimecheck`.
+	//     Defaults to `0`, which means the validity period is always checked.
+	TimeNTSNoCertTimeCheck int `yaml:"ntsNoCertTimeCheck,omitempty"`
+	//   description: |
 	//     Specifies the timeout when the node time is considered to be in sync unlocking the boot sequence.
 	//     NTP sync will be still running in the background.
 	//     Defaults to "infinity" (waiting forever for time sync)
@@ -1511,6 +1654,53 @@ type TimeConfig struct {
 	//     type: string
 	//     pattern: ^[-+]?(((\d+(\.\d*)?|\d*(\.\d+)+)([nuµm]?s|m|h))|0)+$
 	TimeBootTimeout time.Duration `yaml:"bootTimeout,omitempty"`
+	//   description: |
+	//     Configures the NTP server on the node, other hosts can sync time from this node.
+	//     NTP server requires time sync to be enabled, the node answers with the stratum of the upstream server plus one.
+	//   examples:
+	//     - value: machineTimeNTPServerExample
+	TimeNTPServer *TimeNTPServerConfig `yaml:"ntpServer,omitempty"`
+	//   description: |
+	//     Configures time sync from the PTP (IEEE 1588) hardware clock instead of NTP servers.
+	//     The PTP hardware clock is either exposed by the NIC (which should be synced by a PTP daemon),
+	//     or it is the `ptp_kvm` clock of the hypervisor in virtual machines.
+	//   examples:
+	//     - value: machineTimePTPExample
+	TimePTP *TimePTPConfig `yaml:"ptp,omitempty"`
+}
+
+// TimePTPConfig configures time sync from the PTP hardware clock.
+type TimePTPConfig struct {
+	//   description: |
+	//     Enables time sync from the PTP hardware clock.
+	PTPEnabled *bool `yaml:"enabled"`
+	//   description: |
+	//     Path to the PTP hardware clock device (e.g. `/dev/ptp0`).
+	//     If not set, the PTP hardware clock of the `interface` is used.
+	//     If neither `device` nor `interface` is set, the `ptp_kvm` clock is used
+	//     (the `ptp_kvm` kernel module should be loaded).
+	PTPDevice string `yaml:"device,omitempty"`
+	//   description: |
+	//     Name of the network interface to use the PTP hardware clock of.
+	PTPInterface string `yaml:"interface,omitempty"`
+	//   description: |
+	//     Interval between PTP hardware clock measurements.
+	//     Defaults to 1s.
+	//   schema:
+	//     type: string
+	//     pattern: ^[-+]?(((\d+(\.\d*)?|\d*(\.\d+)+)([nuµm]?s|m|h))|0)+$
+	PTPPollInterval time.Duration `yaml:"pollInterval,omitempty"`
+}
+
+// TimeNTPServerConfig configures the NTP server on the node.
+type TimeNTPServerConfig struct {
+	//   description: |
+	//     Enables the NTP server (UDP port 123).
+	NTPServerEnabled *bool `yaml:"enabled"`
+	//   description: |
+	//     Names of the interfaces to answer NTP requests on.
+	//     Defaults to all interfaces.
+	NTPServerInterfaces []string `yaml:"interfaces,omitempty"`
 }
 
 // RegistriesConfig represents the image pull options.
@@ -1947,6 +2137,49 @@ type ExternalCloudProviderConfig struct {
 	ExternalManifests []string `yaml:"manifests,omitempty"`
 }
 
+var _ config.KMSEncryption = (*KMSEncryptionConfig)(nil)
+
+// KMSEncryptionConfig contains the settings of the KMSv2 plugin used for the encryption of secret data at rest.
+type KMSEncryptionConfig struct {
+	//   description: |
+	//     Enable encryption with the KMS plugin.
+	//   values:
+	//     - true
+	//     - yes
+	//     - false
+	//     - no
+	KMSEnabled *bool `yaml:"enabled,omitempty"`
+	//   description: |
+	//     Name of the KMS provider (default is `talos-kms`).
+	//     Kubernetes stores the name with the encrypted data, so it should not be changed once the data is encrypted.
+	KMSName string `yaml:"name,omitempty"`
+	//   description: |
+	//     The gRPC endpoint of the KMS plugin (default is `unix:///var/run/kmsplugin/kms.sock`).
+	//     The endpoint should be a UNIX socket in `/var/run/kmsplugin`, which is mounted into the `kube-apiserver` pod.
+	//     The KMS plugin might be run as a static pod or as a system extension service.
+	KMSEndpoint string `yaml:"endpoint,omitempty"`
+	//   description: |
+	//     Timeout for the calls to the KMS plugin (default is 3 seconds).
+	//     Field format accepts any Go time.Duration format ('1h' for one hour, '10m' for ten minutes).
+	//   schema:
+	//     type: string
+	//     pattern: ^[-+]?(((\d+(\.\d*)?|\d*(\.\d+)+)([nuµm]?s|m|h))|0)+$
+	KMSTimeout time.Duration `yaml:"timeout,omitempty"`
+	//   description: |
+	//     The container image of the KMS plugin.
+	//     If set, Talos runs the KMS plugin as a static pod next to `kube-apiserver` on the controlplane nodes,
+	//     with the `/var/run/kmsplugin` directory mounted into the pod.
+	//   examples:
+	//     - value: '"registry.example.com/kms-plugin:v1.0.0"'
+	KMSPluginImage string `yaml:"image,omitempty"`
+	//   description: |
+	//     Extra arguments to supply to the KMS plugin static pod.
+	//     The KMS plugin should be configured to listen on the socket set as the `endpoint`.
+	//   examples:
+	//     - value: clusterKMSPluginExtraArgsExample
+	KMSPluginExtraArgs map[string]string `yaml:"extraArgs,omitempty"`
+}
+
 // AdminKubeconfigConfig contains admin kubeconfig settings.
 type AdminKubeconfigConfig struct {
 	//   description: |
@@ -2070,6 +2303,12 @@ type EncryptionKey struct {
 	//     Deterministically generated key from the node UUID and PartitionLabel.
 	KeyNodeID *EncryptionKeyNodeID `yaml:"nodeID,omitempty"`
 	//   description: >
+	//     Random key sealed by the TPM 2.0 device against the Secure Boot state (PCR 7).
+	KeyTPM *EncryptionKeyTPM `yaml:"tpm,omitempty"`
+	//   description: >
+	//     Random key sealed by the remote KMS endpoint, the key is unsealed over the network on boot.
+	KeyKMS *EncryptionKeyKMS `yaml:"kms,omitempty"`
+	//   description: >
 	//     Key slot number for LUKS2 encryption.
 	KeySlot int `yaml:"slot"`
 }
@@ -2084,6 +2323,18 @@ type EncryptionKeyStatic struct {
 // EncryptionKeyNodeID represents deterministically generated key from the node UUID and PartitionLabel.
 type EncryptionKeyNodeID struct{}
 
+// EncryptionKeyTPM represents a key that is generated and then sealed/unsealed by the TPM.
+type EncryptionKeyTPM struct{}
+
+// EncryptionKeyKMS represents a key that is generated and then sealed/unsealed by the KMS server.
+type EncryptionKeyKMS struct {
+	//   description: >
+	//     KMS endpoint to Seal/Unseal the key.
+	//   examples:
+	//     - value: '"https://192.168.88.21:4443"'
+	KMSEndpoint string `yaml:"endpoint"`
+}
+
 // Env represents a set of environment variables.
 type Env = map[string]string
 
@@ -2162,6 +2413,18 @@ type Device struct {
 	//   examples:
 	//     - value: networkConfigRoutesExample
 	DeviceRoutes []*Route `yaml:"routes,omitempty"`
+	//   description: |
+	//     A list of policy routing rules associated with the interface.
+	//     Rules select the routing table to look up based on the packet source/destination, firewall mark and interfaces.
+	//   examples:
+	//     - value: networkConfigRulesExample
+	DeviceRules []*RoutingRule `yaml:"rules,omitempty"`
+	//   description: |
+	//     A list of permanent neighbor (ARP/NDP) entries associated with the interface.
+	//     Proxy entries make the interface answer ARP/NDP requests for the address on behalf of another host.
+	//   examples:
+	//     - value: networkConfigNeighborsExample
+	DeviceNeighbors []*Neighbor `yaml:"neighbors,omitempty"`
 	//   description: Bond specific options.
 	//   examples:
 	//     - value: networkConfigBondExample
@@ -2201,6 +2464,13 @@ type Device struct {
 	//     - value: networkConfigDHCPOptionsExample
 	DeviceDHCPOptions *DHCPOptions `yaml:"dhcpOptions,omitempty"`
 	//   description: |
+	//     IPv6 router advertisement and address autoconfiguration (SLAAC) settings.
+	//     If set, router advertisements are processed by Talos instead of the kernel,
+	//     the learned addresses, routes and DNS servers are merged with the rest of the network configuration.
+	//   examples:
+	//     - value: networkConfigIPv6Example
+	DeviceIPv6 *DeviceIPv6Config `yaml:"ipv6,omitempty"`
+	//   description: |
 	//     Wireguard specific configuration.
 	//     Includes things like private key, listen port, peers.
 	//   examples:
@@ -2213,7 +2483,27 @@ type Device struct {
 	//   examples:
 	//     - name: layer2 vip example
 	//       value: networkConfigVIPLayer2Example
+	//     - name: BGP vip example
+	//       value: networkConfigVIPBGPExample
 	DeviceVIPConfig *DeviceVIPConfig `yaml:"vip,omitempty"`
+	//   description: |
+	//     VRF specific options.
+	//     The interface is created as a VRF bound to the routing table, the routes of the interface and of the enslaved interfaces are placed in the VRF table.
+	//   examples:
+	//     - value: networkConfigVRFExample
+	DeviceVRF *VRF `yaml:"vrf,omitempty"`
+	//   description: VXLAN specific options.
+	//   examples:
+	//     - value: networkConfigVXLANExample
+	DeviceVXLAN *VXLAN `yaml:"vxlan,omitempty"`
+	//   description: MACVLAN specific options.
+	//   examples:
+	//     - value: networkConfigMACVLANExample
+	DeviceMACVLAN *MACVLAN `yaml:"macvlan,omitempty"`
+	//   description: IPVLAN specific options.
+	//   examples:
+	//     - value: networkConfigIPVLANExample
+	DeviceIPVLAN *IPVLAN `yaml:"ipvlan,omitempty"`
 }
 
 // DHCPOptions contains options for configuring the DHCP settings for a given interface.
@@ -2228,6 +2518,31 @@ type DHCPOptions struct {
 	DHCPDUIDv6 string `yaml:"duidv6,omitempty"`
 }
 
+// DeviceIPv6Config contains IPv6 settings for the interface.
+type DeviceIPv6Config struct {
+	//   description: |
+	//     Accept IPv6 router advertisements on the interface (default is enabled).
+	//     If disabled, router advertisements are ignored both by Talos and the kernel.
+	IPv6AcceptRA *bool `yaml:"acceptRA,omitempty"`
+	//   description: |
+	//     Configure addresses from the prefixes announced in router advertisements (SLAAC, default is enabled).
+	IPv6Autoconf *bool `yaml:"autoconf,omitempty"`
+	//   description: |
+	//     Enable privacy extensions (RFC 4941): temporary addresses are generated for SLAAC addresses
+	//     and preferred for the outgoing connections (default is disabled).
+	IPv6PrivacyExtensions *bool `yaml:"privacyExtensions,omitempty"`
+	//   description: |
+	//     The address generation mode for the interface identifier of the link-local and SLAAC addresses.
+	//   values:
+	//     - eui64
+	//     - stable-privacy
+	//     - random
+	//     - none
+	IPv6AddrGenMode string `yaml:"addrGenMode,omitempty"`
+	//   description: The priority of the default routes learned from router advertisements.
+	IPv6RouteMetric uint32 `yaml:"routeMetric,omitempty"`
+}
+
 // DeviceWireguardConfig contains settings for configuring Wireguard network interface.
 type DeviceWireguardConfig struct {
 	//   description: |
@@ -2269,6 +2584,16 @@ type DeviceVIPConfig struct {
 	EquinixMetalConfig *VIPEquinixMetalConfig `yaml:"equinixMetal,omitempty"`
 	// description: Specifies the Hetzner Cloud API settings to assign VIP to the node.
 	HCloudConfig *VIPHCloudConfig `yaml:"hcloud,omitempty"`
+	//   description: |
+	//     Specifies the BGP settings to announce the VIP to the routers.
+	//     The VIP is announced as a host route while the node holds the VIP, and withdrawn when the node loses it.
+	BGPConfig *VIPBGPConfig `yaml:"bgp,omitempty"`
+	//   description: |
+	//     Specifies the network probe which should succeed for the VIP to be announced.
+	//     The VIP is released while the probe is failing.
+	//   examples:
+	//     - value: networkConfigProbeExample
+	VIPProbe *NetworkProbe `yaml:"probe,omitempty"`
 }
 
 // VIPEquinixMetalConfig contains settings for Equinix Metal VIP management.
@@ -2283,6 +2608,28 @@ type VIPHCloudConfig struct {
 	HCloudAPIToken string `yaml:"apiToken"`
 }
 
+// VIPBGPConfig contains settings for announcing the VIP over BGP.
+type VIPBGPConfig struct {
+	// description: Local autonomous system number.
+	BGPLocalASN uint32 `yaml:"localASN"`
+	//   description: |
+	//     BGP router identifier.
+	//     Defaults to the local IPv4 address of the BGP session, required for IPv6 sessions.
+	BGPRouterID string `yaml:"routerID,omitempty"`
+	// description: List of BGP peers to announce the VIP to.
+	BGPPeers []*VIPBGPPeer `yaml:"peers"`
+}
+
+// VIPBGPPeer contains settings of a BGP peer.
+type VIPBGPPeer struct {
+	// description: Peer IP address, should be of the same address family as the VIP.
+	PeerAddress string `yaml:"address"`
+	// description: Peer autonomous system number.
+	PeerASN uint32 `yaml:"asn"`
+	// description: TCP MD5 signature password.
+	PeerPassword string `yaml:"password,omitempty"`
+}
+
 // Bond contains the various options for configuring a bonded interface.
 type Bond struct {
 	//   description: The interfaces that make up the bond.
@@ -2419,6 +2766,54 @@ type Bridge struct {
 	BridgeSTP *STP `yaml:"stp,omitempty"`
 }
 
+// VRF contains the various options for configuring a VRF interface.
+type VRF struct {
+	//   description: The interfaces enslaved to the VRF.
+	VRFInterfaces []string `yaml:"interfaces,omitempty"`
+	//   description: The routing table the VRF is bound to.
+	VRFTable uint32 `yaml:"table"`
+}
+
+// VXLAN contains the various options for configuring a VXLAN interface.
+type VXLAN struct {
+	//   description: The VXLAN network identifier (VNI).
+	VXLANID uint32 `yaml:"id"`
+	//   description: The source IP address of the tunnel packets.
+	VXLANLocal string `yaml:"local,omitempty"`
+	//   description: |
+	//     The IP address of the remote tunnel endpoint.
+	//     If the address is a multicast address, it is used as the multicast group.
+	VXLANRemote string `yaml:"remote,omitempty"`
+	//   description: The UDP destination port of the remote tunnel endpoint (defaults to the kernel default port).
+	VXLANPort uint16 `yaml:"port,omitempty"`
+}
+
+// MACVLAN contains the various options for configuring a MACVLAN interface.
+type MACVLAN struct {
+	//   description: The parent interface of the MACVLAN interface.
+	MACVLANParent string `yaml:"parent"`
+	//   description: The MACVLAN mode.
+	//   values:
+	//     - bridge
+	//     - private
+	//     - vepa
+	//     - passthru
+	//     - source
+	MACVLANMode string `yaml:"mode,omitempty"`
+}
+
+// IPVLAN contains the various options for configuring an IPVLAN interface.
+type IPVLAN struct {
+	//   description: The parent interface of the IPVLAN interface.
+	IPVLANParent string `yaml:"parent"`
+	//   description: The IPVLAN mode.
+	//   values:
+	//     - l2
+	//     - l3
+	//     - l3s
+	IPVLANMode string `yaml:"mode,omitempty"`
+}
+
 // VlanList is a list of *Vlan structures with overridden merge process.
 //
 //docgen:alias
@@ -2494,6 +2889,93 @@ type Route struct {
 	RouteMetric uint32 `yaml:"metric,omitempty"`
 	//   description: The optional MTU for the route.
 	RouteMTU uint32 `yaml:"mtu,omitempty"`
+	//   description: |
+	//     The optional network probe which should succeed for the route to be installed.
+	//     The route is withdrawn while the probe is failing.
+	//   examples:
+	//     - value: networkConfigProbeExample
+	RouteProbe *NetworkProbe `yaml:"probe,omitempty"`
+}
+
+// NetworkProbe represents a network probe which gates a VIP or a route.
+//
+// Exactly one of the probe targets (`tcp`, `icmp`, `http` or `dns`) should be specified.
+type NetworkProbe struct {
+	//   description: |
+	//     Interval between the probes (default is 5 seconds).
+	//     Field format accepts any Go time.Duration format ('1h' for one hour, '10m' for ten minutes).
+	//   schema:
+	//     type: string
+	//     pattern: ^[-+]?(((\d+(\.\d*)?|\d*(\.\d+)+)([nuµm]?s|m|h))|0)+$
+	ProbeInterval time.Duration `yaml:"interval,omitempty"`
+	//   description: |
+	//     Number of consecutive failures for the probe to be considered failed after having succeeded (default is 3).
+	ProbeFailureThreshold int `yaml:"failureThreshold,omitempty"`
+	//   description: |
+	//     Timeout for a single probe (default is 3 seconds).
+	//     Field format accepts any Go time.Duration format ('1h' for one hour, '10m' for ten minutes).
+	//   schema:
+	//     type: string
+	//     pattern: ^[-+]?(((\d+(\.\d*)?|\d*(\.\d+)+)([nuµm]?s|m|h))|0)+$
+	ProbeTimeout time.Duration `yaml:"timeout,omitempty"`
+	//   description: The TCP endpoint (`host:port`) to connect to.
+	ProbeTCP string `yaml:"tcp,omitempty"`
+	//   description: The host (IP address or hostname) to send ICMP echo requests to.
+	ProbeICMP string `yaml:"icmp,omitempty"`
+	//   description: The HTTP(S) GET probe settings.
+	ProbeHTTP *NetworkProbeHTTP `yaml:"http,omitempty"`
+	//   description: The DNS resolution probe settings.
+	ProbeDNS *NetworkProbeDNS `yaml:"dns,omitempty"`
+}
+
+// NetworkProbeHTTP represents HTTP(S) GET probe settings.
+type NetworkProbeHTTP struct {
+	//   description: The URL to send the GET request to.
+	HTTPURL string `yaml:"url"`
+	//   description: The expected HTTP response status code (default is 200).
+	HTTPExpectedStatus int `yaml:"expectedStatus,omitempty"`
+}
+
+// NetworkProbeDNS represents DNS resolution probe settings.
+type NetworkProbeDNS struct {
+	//   description: The name to resolve.
+	DNSName string `yaml:"name"`
+	//   description: The DNS server (`host:port`) to query, system resolvers are used if not set.
+	DNSServer string `yaml:"server,omitempty"`
+}
+
+// RoutingRule represents a policy routing rule.
+type RoutingRule struct {
+	//   description: |
+	//     The rule's priority, rules are evaluated in order of increasing priority.
+	//     Should be unique per address family, priorities 0, 32766 and 32767 are reserved for the default kernel rules.
+	RulePriority uint32 `yaml:"priority"`
+	//   description: The source prefix to match (optional).
+	RuleFrom string `yaml:"from,omitempty"`
+	//   description: The destination prefix to match (optional).
+	RuleTo string `yaml:"to,omitempty"`
+	//   description: The firewall mark to match (optional).
+	RuleFwMark uint32 `yaml:"fwMark,omitempty"`
+	//   description: The mask applied to the firewall mark before matching (optional).
+	RuleFwMask uint32 `yaml:"fwMask,omitempty"`
+	//   description: The incoming interface name to match (optional).
+	RuleIIF string `yaml:"iif,omitempty"`
+	//   description: The outgoing interface name to match (optional).
+	RuleOIF string `yaml:"oif,omitempty"`
+	//   description: The routing table to look up for packets matching the rule.
+	RuleTable uint32 `yaml:"table"`
+}
+
+// Neighbor represents a permanent neighbor (ARP/NDP) entry.
+type Neighbor struct {
+	//   description: The IP address of the neighbor.
+	NeighborIP string `yaml:"ip"`
+	//   description: The link-layer (MAC) address of the neighbor, required unless `proxy` is set.
+	NeighborLinkAddr string `yaml:"lladdr,omitempty"`
+	//   description: |
+	//     Answer ARP/NDP requests for the IP address on this interface (proxy entry).
+	//     IPv4 proxy entries are only used with IP forwarding enabled, IPv6 proxy entries require `net.ipv6.conf.<interface>.proxy_ndp` sysctl.
+	NeighborProxy bool `yaml:"proxy,omitempty"`
 }
 
 // RegistryMirrorConfig represents mirror configuration for a registry.
@@ -2611,6 +3093,39 @@ type FeaturesConfig struct {
 	//     API server load balancer support - local proxy on defined port that will distribute
 	//     requests to all API servers in the cluster.
 	APIServerBalancerSupport *APIServerBalancer `yaml:"apiServerBalancerSupport,omitempty"`
+	//   description: |
+	//     Configures the host caching DNS resolver.
+	//   examples:
+	//     - value: hostDNSConfigExample
+	HostDNSSupport *HostDNSConfig `yaml:"hostDNS,omitempty"`
+}
+
+// HostDNSConfig describes the configuration for the host caching DNS resolver.
+type HostDNSConfig struct {
+	//   description: |
+	//     Enable the host caching DNS resolver listening on 127.0.0.53.
+	//
+	//     Host processes use the resolver via /etc/resolv.conf, the upstream servers are the configured DNS resolvers.
+	HostDNSEnabled *bool `yaml:"enabled,omitempty"`
+	//   description: |
+	//     Use the host caching DNS resolver as the upstream for the Kubernetes pods (and CoreDNS).
+	//
+	//     When enabled, the resolver also listens on a link-local address reachable from the pods,
+	//     and the kubelet passes it to the pods instead of the upstream DNS resolvers.
+	HostDNSForwardKubeDNSToHost *bool `yaml:"forwardKubeDNSToHost,omitempty"`
+	//   description: |
+	//     Forward the queries for the domain (and its subdomains) to the specific DNS servers (split DNS).
+	HostDNSForwardDomains []*HostDNSForwardDomain `yaml:"forwardDomains,omitempty"`
+}
+
+// HostDNSForwardDomain describes forwarding of a domain to specific DNS servers.
+type HostDNSForwardDomain struct {
+	//   description: |
+	//     The domain to forward.
+	ForwardDomain string `yaml:"domain"`
+	//   description: |
+	//     The DNS servers (IP address with an optional port) to forward the queries to.
+	ForwardServers []string `yaml:"servers"`
 }
 
 // APIServerBalancer describes the configuration for the API server load balancer.
@@ -2675,6 +3190,21 @@ type ClusterInlineManifest struct {
 	InlineManifestContents string `yaml:"contents"`
 }
 
+// NetworkLLDP struct describes LLDP configuration.
+type NetworkLLDP struct {
+	//   description: |
+	//     Transmit LLDP advertisements on the physical links.
+	//     The advertisements carry the hostname of the node and the name of the link.
+	LLDPTransmit *bool `yaml:"transmit,omitempty"`
+	//   description: |
+	//     Interval between the LLDP advertisements (default is 30 seconds).
+	//     Field format accepts any Go time.Duration format ('1h' for one hour, '10m' for ten minutes).
+	//   schema:
+	//     type: string
+	//     pattern: ^[-+]?(((\d+(\.\d*)?|\d*(\.\d+)+)([nuµm]?s|m|h))|0)+$
+	LLDPTransmitInterval time.Duration `yaml:"transmitInterval,omitempty"`
+}
+
 // NetworkKubeSpan struct describes KubeSpan configuration.
 type NetworkKubeSpan struct {
 	// description: |
@@ -2799,7 +3329,41 @@ type LoggingDestination struct {
 	//   Logs format.
 	// values:
 	//   - json_lines
+	//   - syslog_rfc5424
+	//   - gelf
 	LoggingFormat string `yaml:"format"`
+	// description: |
+	//   TLS configuration for the "tcp" endpoint.
+	// examples:
+	//   - value: loggingTLSConfigExample
+	LoggingTLS *LoggingTLSConfig `yaml:"tls,omitempty"`
+}
+
+// LoggingTLSConfig specifies TLS config for the logging destination.
+type LoggingTLSConfig struct {
+	//   description: |
+	//     Enable mutual TLS authentication with the log receiver.
+	//     Client certificate and key should be base64-encoded.
+	//   examples:
+	//     - value: pemEncodedCertificateExample
+	//   schema:
+	//     type: object
+	//     additionalProperties: false
+	//     properties:
+	//       crt:
+	//         type: string
+	//       key:
+	//         type: string
+	TLSClientIdentity *x509.PEMEncodedCertificateAndKey `yaml:"clientIdentity,omitempty"`
+	//   description: |
+	//     CA certificate to verify the log receiver certificate.
+	//     Certificate should be base64-encoded.
+	//   schema:
+	//     type: string
+	TLSCA Base64Bytes `yaml:"ca,omitempty"`
+	//   description: |
+	//     Skip TLS server certificate verification (not recommended).
+	TLSInsecureSkipVerify *bool `yaml:"insecureSkipVerify,omitempty"`
 }
 
 // KernelConfig struct configures Talos Linux kernel.
@@ -8,7 +8,6 @@ package v1alpha1
 
 import (
 	"github.com/siderolabs/go-pointer"
-
 	"github.com/siderolabs/talos/pkg/machinery/config/encoder"
 	"github.com/siderolabs/talos/pkg/machinery/constants"
 )
@@ -29,6 +28,8 @@ var (
 	InstallDiskSelectorDoc            encoder.Doc
 	InstallExtensionConfigDoc         encoder.Doc
 	TimeConfigDoc                     encoder.Doc
+	TimePTPConfigDoc                  encoder.Doc
+	TimeNTPServerConfigDoc            encoder.Doc
 	RegistriesConfigDoc               encoder.Doc
 	PodCheckpointerDoc                encoder.Doc
 	CoreDNSDoc                        encoder.Doc
@@ -43,6 +44,7 @@ var (
 	ClusterNetworkConfigDoc           encoder.Doc
 	CNIConfigDoc                      encoder.Doc
 	ExternalCloudProviderConfigDoc    encoder.Doc
+	KMSEncryptionConfigDoc            encoder.Doc
 	AdminKubeconfigConfigDoc          encoder.Doc
 	MachineDiskDoc                    encoder.Doc
 	DiskPartitionDoc                  encoder.Doc
@@ -50,30 +52,47 @@ var (
 	EncryptionKeyDoc                  encoder.Doc
 	EncryptionKeyStaticDoc            encoder.Doc
 	EncryptionKeyNodeIDDoc            encoder.Doc
+	EncryptionKeyTPMDoc               encoder.Doc
+	EncryptionKeyKMSDoc               encoder.Doc
 	MachineFileDoc                    encoder.Doc
 	ExtraHostDoc                      encoder.Doc
 	DeviceDoc                         encoder.Doc
 	DHCPOptionsDoc                    encoder.Doc
+	DeviceIPv6ConfigDoc               encoder.Doc
 	DeviceWireguardConfigDoc          encoder.Doc
 	DeviceWireguardPeerDoc            encoder.Doc
 	DeviceVIPConfigDoc                encoder.Doc
 	VIPEquinixMetalConfigDoc          encoder.Doc
 	VIPHCloudConfigDoc                encoder.Doc
+	VIPBGPConfigDoc                   encoder.Doc
+	VIPBGPPeerDoc                     encoder.Doc
 	BondDoc                           encoder.Doc
 	STPDoc                            encoder.Doc
 	BridgeDoc                         encoder.Doc
+	VRFDoc                            encoder.Doc
+	VXLANDoc                          encoder.Doc
+	MACVLANDoc                        encoder.Doc
+	IPVLANDoc                         encoder.Doc
 	VlanDoc                           encoder.Doc
 	RouteDoc                          encoder.Doc
+	NetworkProbeDoc                   encoder.Doc
+	NetworkProbeHTTPDoc               encoder.Doc
+	NetworkProbeDNSDoc                encoder.Doc
+	RoutingRuleDoc                    encoder.Doc
+	NeighborDoc                       encoder.Doc
 	RegistryMirrorConfigDoc           encoder.Doc
 	RegistryConfigDoc                 encoder.Doc
 	RegistryAuthConfigDoc             encoder.Doc
 	RegistryTLSConfigDoc              encoder.Doc
 	SystemDiskEncryptionConfigDoc     encoder.Doc
 	FeaturesConfigDoc                 encoder.Doc
+	HostDNSConfigDoc                  encoder.Doc
+	HostDNSForwardDomainDoc           encoder.Doc
 	APIServerBalancerDoc              encoder.Doc
 	KubernetesTalosAPIAccessConfigDoc encoder.Doc
 	VolumeMountConfigDoc              encoder.Doc
 	ClusterInlineManifestDoc          encoder.Doc
+	NetworkLLDPDoc                    encoder.Doc
 	NetworkKubeSpanDoc                encoder.Doc
 	KubeSpanFiltersDoc                encoder.Doc
 	NetworkDeviceSelectorDoc          encoder.Doc
@@ -84,6 +103,7 @@ var (
 	UdevConfigDoc                     encoder.Doc
 	LoggingConfigDoc                  encoder.Doc
 	LoggingDestinationDoc             encoder.Doc
+	LoggingTLSConfigDoc               encoder.Doc
 	KernelConfigDoc                   encoder.Doc
 	KernelModuleConfigDoc             encoder.Doc
 )
@@ -357,7 +377,7 @@ func init() {
 			FieldName: "cluster",
 		},
 	}
-	ClusterConfigDoc.Fields = make([]encoder.Doc, 25)
+	ClusterConfigDoc.Fields = make([]encoder.Doc, 26)
 	ClusterConfigDoc.Fields[0].Name = "id"
 	ClusterConfigDoc.Fields[0].Type = "string"
 	ClusterConfigDoc.Fields[0].Note = ""
@@ -408,125 +428,132 @@ func init() {
 	ClusterConfigDoc.Fields[7].Comments[encoder.LineComment] = "A key used for the [encryption of secret data at rest](https://kubernetes.io/docs/tasks/administer-cluster/encrypt-data/)."
 
 	ClusterConfigDoc.Fields[7].AddExample("Decryption secret example (do not use in production!).", "z01mye6j16bspJYtTB/5SFX8j7Ph4JXxM2Xuu4vsBPM=")
-	ClusterConfigDoc.Fields[8].Name = "ca"
-	ClusterConfigDoc.Fields[8].Type = "PEMEncodedCertificateAndKey"
+	ClusterConfigDoc.Fields[8].Name = "kmsEncryption"
+	ClusterConfigDoc.Fields[8].Type = "KMSEncryptionConfig"
 	ClusterConfigDoc.Fields[8].Note = ""
-	ClusterConfigDoc.Fields[8].Description = "The base64 encoded root certificate authority used by Kubernetes."
-	ClusterConfigDoc.Fields[8].Comments[encoder.LineComment] = "The base64 encoded root certificate authority used by Kubernetes."
+	ClusterConfigDoc.Fields[8].Description = "Configures the [KMSv2 plugin](https://kubernetes.io/docs/tasks/administer-cluster/kms-provider/) used for the encryption of secret data at rest.\nKMS has precedence over secretbox and AESCBC, which are still used to decrypt the data encrypted before KMS was enabled."
+	ClusterConfigDoc.Fields[8].Comments[encoder.LineComment] = "Configures the [KMSv2 plugin](https://kubernetes.io/docs/tasks/administer-cluster/kms-provider/) used for the encryption of secret data at rest."
 
-	ClusterConfigDoc.Fields[8].AddExample("ClusterCA example.", pemEncodedCertificateExample)
-	ClusterConfigDoc.Fields[9].Name = "aggregatorCA"
+	ClusterConfigDoc.Fields[8].AddExample("", clusterKMSEncryptionExample)
+	ClusterConfigDoc.Fields[9].Name = "ca"
 	ClusterConfigDoc.Fields[9].Type = "PEMEncodedCertificateAndKey"
 	ClusterConfigDoc.Fields[9].Note = ""
-	ClusterConfigDoc.Fields[9].Description = "The base64 encoded aggregator certificate authority used by Kubernetes for front-proxy certificate generation.\n\nThis CA can be self-signed."
-	ClusterConfigDoc.Fields[9].Comments[encoder.LineComment] = "The base64 encoded aggregator certificate authority used by Kubernetes for front-proxy certificate generation."
+	ClusterConfigDoc.Fields[9].Description = "The base64 encoded root certificate authority used by Kubernetes."
+	ClusterConfigDoc.Fields[9].Comments[encoder.LineComment] = "The base64 encoded root certificate authority used by Kubernetes."
 
-	ClusterConfigDoc.Fields[9].AddExample("AggregatorCA example.", pemEncodedCertificateExample)
-	ClusterConfigDoc.Fields[10].Name = "serviceAccount"
-	ClusterConfigDoc.Fields[10].Type = "PEMEncodedKey"
+	ClusterConfigDoc.Fields[9].AddExample("ClusterCA example.", pemEncodedCertificateExample)
+	ClusterConfigDoc.Fields[10].Name = "aggregatorCA"
+	ClusterConfigDoc.Fields[10].Type = "PEMEncodedCertificateAndKey"
 	ClusterConfigDoc.Fields[10].Note = ""
-	ClusterConfigDoc.Fields[10].Description = "The base64 encoded private key for service account token generation."
-	ClusterConfigDoc.Fields[10].Comments[encoder.LineComment] = "The base64 encoded private key for service account token generation."
+	ClusterConfigDoc.Fields[10].Description = "The base64 encoded aggregator certificate authority used by Kubernetes for front-proxy certificate generation.\n\nThis CA can be self-signed."
+	ClusterConfigDoc.Fields[10].Comments[encoder.LineComment] = "The base64 encoded aggregator certificate authority used by Kubernetes for front-proxy certificate generation."
 
-	ClusterConfigDoc.Fields[10].AddExample("AggregatorCA example.", pemEncodedKeyExample)
-	ClusterConfigDoc.Fields[11].Name = "apiServer"
-	ClusterConfigDoc.Fields[11].Type = "APIServerConfig"
+	ClusterConfigDoc.Fields[10].AddExample("AggregatorCA example.", pemEncodedCertificateExample)
+	ClusterConfigDoc.Fields[11].Name = "serviceAccount"
+	ClusterConfigDoc.Fields[11].Type = "PEMEncodedKey"
 	ClusterConfigDoc.Fields[11].Note = ""
-	ClusterConfigDoc.Fields[11].Description = "API server specific configuration options."
-	ClusterConfigDoc.Fields[11].Comments[encoder.LineComment] = "API server specific configuration options."
+	ClusterConfigDoc.Fields[11].Description = "The base64 encoded private key for service account token generation."
+	ClusterConfigDoc.Fields[11].Comments[encoder.LineComment] = "The base64 encoded private key for service account token generation."
 
-	ClusterConfigDoc.Fields[11].AddExample("", clusterAPIServerExample)
-	ClusterConfigDoc.Fields[12].Name = "controllerManager"
-	ClusterConfigDoc.Fields[12].Type = "ControllerManagerConfig"
+	ClusterConfigDoc.Fields[11].AddExample("AggregatorCA example.", pemEncodedKeyExample)
+	ClusterConfigDoc.Fields[12].Name = "apiServer"
+	ClusterConfigDoc.Fields[12].Type = "APIServerConfig"
 	ClusterConfigDoc.Fields[12].Note = ""
-	ClusterConfigDoc.Fields[12].Description = "Controller manager server specific configuration options."
-	ClusterConfigDoc.Fields[12].Comments[encoder.LineComment] = "Controller manager server specific configuration options."
+	ClusterConfigDoc.Fields[12].Description = "API server specific configuration options."
+	ClusterConfigDoc.Fields[12].Comments[encoder.LineComment] = "API server specific configuration options."
 
-	ClusterConfigDoc.Fields[12].AddExample("", clusterControllerManagerExample)
-	ClusterConfigDoc.Fields[13].Name = "proxy"
-	ClusterConfigDoc.Fields[13].Type = "ProxyConfig"
+	ClusterConfigDoc.Fields[12].AddExample("", clusterAPIServerExample)
+	ClusterConfigDoc.Fields[13].Name = "controllerManager"
+	ClusterConfigDoc.Fields[13].Type = "ControllerManagerConfig"
 	ClusterConfigDoc.Fields[13].Note = ""
-	ClusterConfigDoc.Fields[13].Description = "Kube-proxy server-specific configuration options"
-	ClusterConfigDoc.Fields[13].Comments[encoder.LineComment] = "Kube-proxy server-specific configuration options"
+	ClusterConfigDoc.Fields[13].Description = "Controller manager server specific configuration options."
+	ClusterConfigDoc.Fields[13].Comments[encoder.LineComment] = "Controller manager server specific configuration options."
 
-	ClusterConfigDoc.Fields[13].AddExample("", clusterProxyExample)
-	ClusterConfigDoc.Fields[14].Name = "scheduler"
-	ClusterConfigDoc.Fields[14].Type = "SchedulerConfig"
+	ClusterConfigDoc.Fields[13].AddExample("", clusterControllerManagerExample)
+	ClusterConfigDoc.Fields[14].Name = "proxy"
+	ClusterConfigDoc.Fields[14].Type = "ProxyConfig"
 	ClusterConfigDoc.Fields[14].Note = ""
-	ClusterConfigDoc.Fields[14].Description = "Scheduler server specific configuration options."
-	ClusterConfigDoc.Fields[14].Comments[encoder.LineComment] = "Scheduler server specific configuration options."
+	ClusterConfigDoc.Fields[14].Description = "Kube-proxy server-specific configuration options"
+	ClusterConfigDoc.Fields[14].Comments[encoder.LineComment] = "Kube-proxy server-specific configuration options"
 
-	ClusterConfigDoc.Fields[14].AddExample("", clusterSchedulerExample)
-	ClusterConfigDoc.Fields[15].Name = "discovery"
-	ClusterConfigDoc.Fields[15].Type = "ClusterDiscoveryConfig"
+	ClusterConfigDoc.Fields[14].AddExample("", clusterProxyExample)
+	ClusterConfigDoc.Fields[15].Name = "scheduler"
+	ClusterConfigDoc.Fields[15].Type = "SchedulerConfig"
 	ClusterConfigDoc.Fields[15].Note = ""
-	ClusterConfigDoc.Fields[15].Description = "Configures cluster member discovery."
-	ClusterConfigDoc.Fields[15].Comments[encoder.LineComment] = "Configures cluster member discovery."
+	ClusterConfigDoc.Fields[15].Description = "Scheduler server specific configuration options."
+	ClusterConfigDoc.Fields[15].Comments[encoder.LineComment] = "Scheduler server specific configuration options."
 
-	ClusterConfigDoc.Fields[15].AddExample("", clusterDiscoveryExample)
-	ClusterConfigDoc.Fields[16].Name = "etcd"
-	ClusterConfigDoc.Fields[16].Type = "EtcdConfig"
+	ClusterConfigDoc.Fields[15].AddExample("", clusterSchedulerExample)
+	ClusterConfigDoc.Fields[16].Name = "discovery"
+	ClusterConfigDoc.Fields[16].Type = "ClusterDiscoveryConfig"
 	ClusterConfigDoc.Fields[16].Note = ""
-	ClusterConfigDoc.Fields[16].Description = "Etcd specific configuration options."
-	ClusterConfigDoc.Fields[16].Comments[encoder.LineComment] = "Etcd specific configuration options."
+	ClusterConfigDoc.Fields[16].Description = "Configures cluster member discovery."
+	ClusterConfigDoc.Fields[16].Comments[encoder.LineComment] = "Configures cluster member discovery."
 
-	ClusterConfigDoc.Fields[16].AddExample("", clusterEtcdExample)
-	ClusterConfigDoc.Fields[17].Name = "coreDNS"
-	ClusterConfigDoc.Fields[17].Type = "CoreDNS"
+	ClusterConfigDoc.Fields[16].AddExample("", clusterDiscoveryExample)
+	ClusterConfigDoc.Fields[17].Name = "etcd"
+	ClusterConfigDoc.Fields[17].Type = "EtcdConfig"
 	ClusterConfigDoc.Fields[17].Note = ""
-	ClusterConfigDoc.Fields[17].Description = "Core DNS specific configuration options."
-	ClusterConfigDoc.Fields[17].Comments[encoder.LineComment] = "Core DNS specific configuration options."
+	ClusterConfigDoc.Fields[17].Description = "Etcd specific configuration options."
+	ClusterConfigDoc.Fields[17].Comments[encoder.LineComment] = "Etcd specific configuration options."
 
-	ClusterConfigDoc.Fields[17].AddExample("", clusterCoreDNSExample)
-	ClusterConfigDoc.Fields[18].Name = "externalCloudProvider"
-	ClusterConfigDoc.Fields[18].Type = "ExternalCloudProviderConfig"
+	ClusterConfigDoc.Fields[17].AddExample("", clusterEtcdExample)
+	ClusterConfigDoc.Fields[18].Name = "coreDNS"
+	ClusterConfigDoc.Fields[18].Type = "CoreDNS"
 	ClusterConfigDoc.Fields[18].Note = ""
-	ClusterConfigDoc.Fields[18].Description = "External cloud provider configuration."
-	ClusterConfigDoc.Fields[18].Comments[encoder.LineComment] = "External cloud provider configuration."
+	ClusterConfigDoc.Fields[18].Description = "Core DNS specific configuration options."
+	ClusterConfigDoc.Fields[18].Comments[encoder.LineComment] = "Core DNS specific configuration options."
 
-	ClusterConfigDoc.Fields[18].AddExample("", clusterExternalCloudProviderConfigExample)
-	ClusterConfigDoc.Fields[19].Name = "extraManifests"
-	ClusterConfigDoc.Fields[19].Type = "[]string"
+	ClusterConfigDoc.Fields[18].AddExample("", clusterCoreDNSExample)
+	ClusterConfigDoc.Fields[19].Name = "externalCloudProvider"
+	ClusterConfigDoc.Fields[19].Type = "ExternalCloudProviderConfig"
 	ClusterConfigDoc.Fields[19].Note = ""
-	ClusterConfigDoc.Fields[19].Description = "A list of urls that point to additional manifests.\nThese will get automatically deployed as part of the bootstrap."
-	ClusterConfigDoc.Fields[19].Comments[encoder.LineComment] = "A list of urls that point to additional manifests."
+	ClusterConfigDoc.Fields[19].Description = "External cloud provider configuration."
+	ClusterConfigDoc.Fields[19].Comments[encoder.LineComment] = "External cloud provider configuration."
+
+	ClusterConfigDoc.Fields[19].AddExample("", clusterExternalCloudProviderConfigExample)
+	ClusterConfigDoc.Fields[20].Name = "extraManifests"
+	ClusterConfigDoc.Fields[20].Type = "[]string"
+	ClusterConfigDoc.Fields[20].Note = ""
+	ClusterConfigDoc.Fields[20].Description = "A list of urls that point to additional manifests.\nThese will get automatically deployed as part of the bootstrap."
+	ClusterConfigDoc.Fields[20].Comments[encoder.LineComment] = "A list of urls that point to additional manifests."
 
-	ClusterConfigDoc.Fields[19].AddExample("", []string{
+	ClusterConfigDoc.Fields[20].AddExample("", []string{
 		"https://www.example.com/manifest1.yaml",
 		"https://www.example.com/manifest2.yaml",
 	})
-	ClusterConfigDoc.Fields[20].Name = "extraManifestHeaders"
-	ClusterConfigDoc.Fields[20].Type = "map[string]string"
-	ClusterConfigDoc.Fields[20].Note = ""
-	ClusterConfigDoc.Fields[20].Description = "A map of key value pairs that will be added while fetching the extraManifests."
-	ClusterConfigDoc.Fields[20].Comments[encoder.LineComment] = "A map of key value pairs that will be added while fetching the extraManifests."
+	ClusterConfigDoc.Fields[21].Name = "extraManifestHeaders"
+	ClusterConfigDoc.Fields[21].Type = "map[string]string"
+	ClusterConfigDoc.Fields[21].Note = ""
+	ClusterConfigDoc.Fields[21].Description = "A map of key value pairs that will be added while fetching the extraManifests."
+	ClusterConfigDoc.Fields[21].Comments[encoder.LineComment] = "A map of key value pairs that will be added while fetching the extraManifests."
 
-	ClusterConfigDoc.Fields[20].AddExample("", map[string]string{
+	ClusterConfigDoc.Fields[21].AddExample("", map[string]string{
 		"Token":       "1234567",
 		"X-ExtraInfo": "info",
 	})
-	ClusterConfigDoc.Fields[21].Name = "inlineManifests"
-	ClusterConfigDoc.Fields[21].Type = "ClusterInlineManifests"
-	ClusterConfigDoc.Fields[21].Note = ""
-	ClusterConfigDoc.Fields[21].Description = "A list of inline Kubernetes manifests.\nThese will get automatically deployed as part of the bootstrap."
-	ClusterConfigDoc.Fields[21].Comments[encoder.LineComment] = "A list of inline Kubernetes manifests."
-
-	ClusterConfigDoc.Fields[21].AddExample("", clusterInlineManifestsExample)
-	ClusterConfigDoc.Fields[22].Name = "adminKubeconfig"
-	ClusterConfigDoc.Fields[22].Type = "AdminKubeconfigConfig"
+	ClusterConfigDoc.Fields[22].Name = "inlineManifests"
+	ClusterConfigDoc.Fields[22].Type = "ClusterInlineManifests"
 	ClusterConfigDoc.Fields[22].Note = ""
-	ClusterConfigDoc.Fields[22].Description = "Settings for admin kubeconfig generation.\nCertificate lifetime can be configured."
-	ClusterConfigDoc.Fields[22].Comments[encoder.LineComment] = "Settings for admin kubeconfig generation."
-
-	ClusterConfigDoc.Fields[22].AddExample("", clusterAdminKubeconfigExample)
-	ClusterConfigDoc.Fields[24].Name = "allowSchedulingOnControlPlanes"
-	ClusterConfigDoc.Fields[24].Type = "bool"
-	ClusterConfigDoc.Fields[24].Note = ""
-	ClusterConfigDoc.Fields[24].Description = "Allows running workload on control-plane nodes."
-	ClusterConfigDoc.Fields[24].Comments[encoder.LineComment] = "Allows running workload on control-plane nodes."
-
-	ClusterConfigDoc.Fields[24].AddExample("", true)
-	ClusterConfigDoc.Fields[24].Values = []string{
+	ClusterConfigDoc.Fields[22].Description = "A list of inline Kubernetes manifests.\nThese will get automatically deployed as part of the bootstrap."
+	ClusterConfigDoc.Fields[22].Comments[encoder.LineComment] = "A list of inline Kubernetes manifests."
+
+	ClusterConfigDoc.Fields[22].AddExample("", clusterInlineManifestsExample)
+	ClusterConfigDoc.Fields[23].Name = "adminKubeconfig"
+	ClusterConfigDoc.Fields[23].Type = "AdminKubeconfigConfig"
+	ClusterConfigDoc.Fields[23].Note = ""
+	ClusterConfigDoc.Fields[23].Description = "Settings for admin kubeconfig generation.\nCertificate lifetime can be configured."
+	ClusterConfigDoc.Fields[23].Comments[encoder.LineComment] = "Settings for admin kubeconfig generation."
+
+	ClusterConfigDoc.Fields[23].AddExample("", clusterAdminKubeconfigExample)
+	ClusterConfigDoc.Fields[25].Name = "allowSchedulingOnControlPlanes"
+	ClusterConfigDoc.Fields[25].Type = "bool"
+	ClusterConfigDoc.Fields[25].Note = ""
+	ClusterConfigDoc.Fields[25].Description = "Allows running workload on control-plane nodes."
+	ClusterConfigDoc.Fields[25].Comments[encoder.LineComment] = "Allows running workload on control-plane nodes."
+
+	ClusterConfigDoc.Fields[25].AddExample("", true)
+	ClusterConfigDoc.Fields[25].Values = []string{
 		"true",
 		"yes",
 		"false",
@@ -731,7 +758,7 @@ func init() {
 			FieldName: "network",
 		},
 	}
-	NetworkConfigDoc.Fields = make([]encoder.Doc, 6)
+	NetworkConfigDoc.Fields = make([]encoder.Doc, 7)
 	NetworkConfigDoc.Fields[0].Name = "hostname"
 	NetworkConfigDoc.Fields[0].Type = "string"
 	NetworkConfigDoc.Fields[0].Note = ""
@@ -776,6 +803,13 @@ func init() {
 		"false",
 		"no",
 	}
+	NetworkConfigDoc.Fields[6].Name = "lldp"
+	NetworkConfigDoc.Fields[6].Type = "NetworkLLDP"
+	NetworkConfigDoc.Fields[6].Note = ""
+	NetworkConfigDoc.Fields[6].Description = "Configures LLDP advertisements.\nLLDP neighbors are always discovered on the physical links, see `talosctl get lldpneighbors`."
+	NetworkConfigDoc.Fields[6].Comments[encoder.LineComment] = "Configures LLDP advertisements."
+
+	NetworkConfigDoc.Fields[6].AddExample("", networkLLDPExample)
 
 	InstallConfigDoc.Type = "InstallConfig"
 	InstallConfigDoc.Comments[encoder.LineComment] = "InstallConfig represents the installation options for preparing a node."
@@ -957,7 +991,7 @@ func init() {
 			FieldName: "time",
 		},
 	}
-	TimeConfigDoc.Fields = make([]encoder.Doc, 3)
+	TimeConfigDoc.Fields = make([]encoder.Doc, 7)
 	TimeConfigDoc.Fields[0].Name = "disabled"
 	TimeConfigDoc.Fields[0].Type = "bool"
 	TimeConfigDoc.Fields[0].Note = ""
@@ -966,13 +1000,95 @@ func init() {
 	TimeConfigDoc.Fields[1].Name = "servers"
 	TimeConfigDoc.Fields[1].Type = "[]string"
 	TimeConfigDoc.Fields[1].Note = ""
-	TimeConfigDoc.Fields[1].Description = "Specifies time (NTP) servers to use for setting the system time.\nDefaults to `pool.ntp.org`"
+	TimeConfigDoc.Fields[1].Description = "Specifies time (NTP) servers to use for setting the system time.\nDefaults to `pool.ntp.org`\n\nServers in `nts://host[:port]` format are queried using Network Time Security (NTS):\nkeys are negotiated over TLS with the NTS-KE server (port defaults to 4460),\nand NTP responses are authenticated."
 	TimeConfigDoc.Fields[1].Comments[encoder.LineComment] = "Specifies time (NTP) servers to use for setting the system time."
-	TimeConfigDoc.Fields[2].Name = "bootTimeout"
-	TimeConfigDoc.Fields[2].Type = "Duration"
+
+	TimeConfigDoc.Fields[1].AddExample("", []string{"nts://time.cloudflare.com"})
+	TimeConfigDoc.Fields[2].Name = "ntsFallback"
+	TimeConfigDoc.Fields[2].Type = "bool"
 	TimeConfigDoc.Fields[2].Note = ""
-	TimeConfigDoc.Fields[2].Description = "Specifies the timeout when the node time is considered to be in sync unlocking the boot sequence.\nNTP sync will be still running in the background.\nDefaults to \"infinity\" (waiting forever for time sync)"
-	TimeConfigDoc.Fields[2].Comments[encoder.LineComment] = "Specifies the timeout when the node time is considered to be in sync unlocking the boot sequence."
+	TimeConfigDoc.Fields[2].Description = "Allows falling back to unauthenticated NTP for `nts://` servers when NTS fails.\nFallback might be required if the NTS-KE port is blocked on the network.\nDefaults to `false`, which means `nts://` servers are only used with NTS."
+	TimeConfigDoc.Fields[2].Comments[encoder.LineComment] = "Allows falling back to unauthenticated NTP for `nts://` servers when NTS fails."
+	TimeConfigDoc.Fields[3].Name = "ntsNoCertTimeCheck"
+	TimeConfigDoc.Fields[3].Type = "int"
+	TimeConfigDoc.Fields[3].Note = ""
+	TimeConfigDoc.Fields[3].Description = "Number of NTS-KE exchanges before the first time sync which skip the validity period check of the server certificate.\nThe check fails if the local clock is too far off (e.g. RTC is not set), so NTS can't correct the clock,\nbut skipping it allows expired (and possibly compromised) certificates, similar to chrony's `nocerttimecheck`.\nDefaults to `0`, which means the validity period is always checked."
+	TimeConfigDoc.Fields[3].Comments[encoder.LineComment] = "Number of NTS-KE exchanges before the first time sync which skip the validity period check of the server certificate."
+	TimeConfigDoc.Fields[4].Name = "bootTimeout"
+	TimeConfigDoc.Fields[4].Type = "Duration"
+	TimeConfigDoc.Fields[4].Note = ""
+	TimeConfigDoc.Fields[4].Description = "Specifies the timeout when the node time is considered to be in sync unlocking the boot sequence.\nNTP sync will be still running in the background.\nDefaults to \"infinity\" (waiting forever for time sync)"
+	TimeConfigDoc.Fields[4].Comments[encoder.LineComment] = "Specifies the timeout when the node time is considered to be in sync unlocking the boot sequence."
+	TimeConfigDoc.Fields[5].Name = "ntpServer"
+	TimeConfigDoc.Fields[5].Type = "TimeNTPServerConfig"
+	TimeConfigDoc.Fields[5].Note = ""
+	TimeConfigDoc.Fields[5].Description = "Configures the NTP server on the node, other hosts can sync time from this node.\nNTP server requires time sync to be enabled, the node answers with the stratum of the upstream server plus one."
+	TimeConfigDoc.Fields[5].Comments[encoder.LineComment] = "Configures the NTP server on the node, other hosts can sync time from this node."
+
+	TimeConfigDoc.Fields[5].AddExample("", machineTimeNTPServerExample)
+	TimeConfigDoc.Fields[6].Name = "ptp"
+	TimeConfigDoc.Fields[6].Type = "TimePTPConfig"
+	TimeConfigDoc.Fields[6].Note = ""
+	TimeConfigDoc.Fields[6].Description = "Configures time sync from the PTP (IEEE 1588) hardware clock instead of NTP servers.\nThe PTP hardware clock is either exposed by the NIC (which should be synced by a PTP daemon),\nor it is the `ptp_kvm` clock of the hypervisor in virtual machines."
+	TimeConfigDoc.Fields[6].Comments[encoder.LineComment] = "Configures time sync from the PTP (IEEE 1588) hardware clock instead of NTP servers."
+
+	TimeConfigDoc.Fields[6].AddExample("", machineTimePTPExample)
+
+	TimePTPConfigDoc.Type = "TimePTPConfig"
+	TimePTPConfigDoc.Comments[encoder.LineComment] = "TimePTPConfig configures time sync from the PTP hardware clock."
+	TimePTPConfigDoc.Description = "TimePTPConfig configures time sync from the PTP hardware clock."
+
+	TimePTPConfigDoc.AddExample("", machineTimePTPExample)
+	TimePTPConfigDoc.AppearsIn = []encoder.Appearance{
+		{
+			TypeName:  "TimeConfig",
+			FieldName: "ptp",
+		},
+	}
+	TimePTPConfigDoc.Fields = make([]encoder.Doc, 4)
+	TimePTPConfigDoc.Fields[0].Name = "enabled"
+	TimePTPConfigDoc.Fields[0].Type = "bool"
+	TimePTPConfigDoc.Fields[0].Note = ""
+	TimePTPConfigDoc.Fields[0].Description = "Enables time sync from the PTP hardware clock."
+	TimePTPConfigDoc.Fields[0].Comments[encoder.LineComment] = "Enables time sync from the PTP hardware clock."
+	TimePTPConfigDoc.Fields[1].Name = "device"
+	TimePTPConfigDoc.Fields[1].Type = "string"
+	TimePTPConfigDoc.Fields[1].Note = ""
+	TimePTPConfigDoc.Fields[1].Description = "Path to the PTP hardware clock device (e.g. `/dev/ptp0`).\nIf not set, the PTP hardware clock of the `interface` is used.\nIf neither `device` nor `interface` is set, the `ptp_kvm` clock is used\n(the `ptp_kvm` kernel module should be loaded)."
+	TimePTPConfigDoc.Fields[1].Comments[encoder.LineComment] = "Path to the PTP hardware clock device (e.g. `/dev/ptp0`)."
+	TimePTPConfigDoc.Fields[2].Name = "interface"
+	TimePTPConfigDoc.Fields[2].Type = "string"
+	TimePTPConfigDoc.Fields[2].Note = ""
+	TimePTPConfigDoc.Fields[2].Description = "Name of the network interface to use the PTP hardware clock of."
+	TimePTPConfigDoc.Fields[2].Comments[encoder.LineComment] = "Name of the network interface to use the PTP hardware clock of."
+	TimePTPConfigDoc.Fields[3].Name = "pollInterval"
+	TimePTPConfigDoc.Fields[3].Type = "Duration"
+	TimePTPConfigDoc.Fields[3].Note = ""
+	TimePTPConfigDoc.Fields[3].Description = "Interval between PTP hardware clock measurements.\nDefaults to 1s."
+	TimePTPConfigDoc.Fields[3].Comments[encoder.LineComment] = "Interval between PTP hardware clock measurements."
+
+	TimeNTPServerConfigDoc.Type = "TimeNTPServerConfig"
+	TimeNTPServerConfigDoc.Comments[encoder.LineComment] = "TimeNTPServerConfig configures the NTP server on the node."
+	TimeNTPServerConfigDoc.Description = "TimeNTPServerConfig configures the NTP server on the node."
+
+	TimeNTPServerConfigDoc.AddExample("", machineTimeNTPServerExample)
+	TimeNTPServerConfigDoc.AppearsIn = []encoder.Appearance{
+		{
+			TypeName:  "TimeConfig",
+			FieldName: "ntpServer",
+		},
+	}
+	TimeNTPServerConfigDoc.Fields = make([]encoder.Doc, 2)
+	TimeNTPServerConfigDoc.Fields[0].Name = "enabled"
+	TimeNTPServerConfigDoc.Fields[0].Type = "bool"
+	TimeNTPServerConfigDoc.Fields[0].Note = ""
+	TimeNTPServerConfigDoc.Fields[0].Description = "Enables the NTP server (UDP port 123)."
+	TimeNTPServerConfigDoc.Fields[0].Comments[encoder.LineComment] = "Enables the NTP server (UDP port 123)."
+	TimeNTPServerConfigDoc.Fields[1].Name = "interfaces"
+	TimeNTPServerConfigDoc.Fields[1].Type = "[]string"
+	TimeNTPServerConfigDoc.Fields[1].Note = ""
+	TimeNTPServerConfigDoc.Fields[1].Description = "Names of the interfaces to answer NTP requests on.\nDefaults to all interfaces."
+	TimeNTPServerConfigDoc.Fields[1].Comments[encoder.LineComment] = "Names of the interfaces to answer NTP requests on."
 
 	RegistriesConfigDoc.Type = "RegistriesConfig"
 	RegistriesConfigDoc.Comments[encoder.LineComment] = "RegistriesConfig represents the image pull options."
@@ -1421,6 +1537,59 @@ func init() {
 		"https://raw.githubusercontent.com/kubernetes/cloud-provider-aws/v1.20.0-alpha.0/manifests/aws-cloud-controller-manager-daemonset.yaml",
 	})
 
+	KMSEncryptionConfigDoc.Type = "KMSEncryptionConfig"
+	KMSEncryptionConfigDoc.Comments[encoder.LineComment] = "KMSEncryptionConfig contains the settings of the KMSv2 plugin used for the encryption of secret data at rest."
+	KMSEncryptionConfigDoc.Description = "KMSEncryptionConfig contains the settings of the KMSv2 plugin used for the encryption of secret data at rest."
+
+	KMSEncryptionConfigDoc.AddExample("", clusterKMSEncryptionExample)
+	KMSEncryptionConfigDoc.AppearsIn = []encoder.Appearance{
+		{
+			TypeName:  "ClusterConfig",
+			FieldName: "kmsEncryption",
+		},
+	}
+	KMSEncryptionConfigDoc.Fields = make([]encoder.Doc, 6)
+	KMSEncryptionConfigDoc.Fields[0].Name = "enabled"
+	KMSEncryptionConfigDoc.Fields[0].Type = "bool"
+	KMSEncryptionConfigDoc.Fields[0].Note = ""
+	KMSEncryptionConfigDoc.Fields[0].Description = "Enable encryption with the KMS plugin."
+	KMSEncryptionConfigDoc.Fields[0].Comments[encoder.LineComment] = "Enable encryption with the KMS plugin."
+	KMSEncryptionConfigDoc.Fields[0].Values = []string{
+		"true",
+		"yes",
+		"false",
+		"no",
+	}
+	KMSEncryptionConfigDoc.Fields[1].Name = "name"
+	KMSEncryptionConfigDoc.Fields[1].Type = "string"
+	KMSEncryptionConfigDoc.Fields[1].Note = ""
+	KMSEncryptionConfigDoc.Fields[1].Description = "Name of the KMS provider (default is `talos-kms`).\nKubernetes stores the name with the encrypted data, so it should not be changed once the data is encrypted."
+	KMSEncryptionConfigDoc.Fields[1].Comments[encoder.LineComment] = "Name of the KMS provider (default is `talos-kms`)."
+	KMSEncryptionConfigDoc.Fields[2].Name = "endpoint"
+	KMSEncryptionConfigDoc.Fields[2].Type = "string"
+	KMSEncryptionConfigDoc.Fields[2].Note = ""
+	KMSEncryptionConfigDoc.Fields[2].Description = "The gRPC endpoint of the KMS plugin (default is `unix:///var/run/kmsplugin/kms.sock`).\nThe endpoint should be a UNIX socket in `/var/run/kmsplugin`, which is mounted into the `kube-apiserver` pod.\nThe KMS plugin might be run as a static pod or as a system extension service."
+	KMSEncryptionConfigDoc.Fields[2].Comments[encoder.LineComment] = "The gRPC endpoint of the KMS plugin (default is `unix:///var/run/kmsplugin/kms.sock`)."
+	KMSEncryptionConfigDoc.Fields[3].Name = "timeout"
+	KMSEncryptionConfigDoc.Fields[3].Type = "Duration"
+	KMSEncryptionConfigDoc.Fields[3].Note = ""
+	KMSEncryptionConfigDoc.Fields[3].Description = "Timeout for the calls to the KMS plugin (default is 3 seconds).\nField format accepts any Go time.Duration format ('1h' for one hour, '10m' for ten minutes)."
+	KMSEncryptionConfigDoc.Fields[3].Comments[encoder.LineComment] = "Timeout for the calls to the KMS plugin (default is 3 seconds)."
+	KMSEncryptionConfigDoc.Fields[4].Name = "image"
+	KMSEncryptionConfigDoc.Fields[4].Type = "string"
+	KMSEncryptionConfigDoc.Fields[4].Note = ""
+	KMSEncryptionConfigDoc.Fields[4].Description = "The container image of the KMS plugin.\nIf set, Talos runs the KMS plugin as a static pod next to `kube-apiserver` on the controlplane nodes,\nwith the `/var/run/kmsplugin` directory mounted into the pod."
+	KMSEncryptionConfigDoc.Fields[4].Comments[encoder.LineComment] = "The container image of the KMS plugin."
+
+	KMSEncryptionConfigDoc.Fields[4].AddExample("", "registry.example.com/kms-plugin:v1.0.0")
+	KMSEncryptionConfigDoc.Fields[5].Name = "extraArgs"
+	KMSEncryptionConfigDoc.Fields[5].Type = "map[string]string"
+	KMSEncryptionConfigDoc.Fields[5].Note = ""
+	KMSEncryptionConfigDoc.Fields[5].Description = "Extra arguments to supply to the KMS plugin static pod.\nThe KMS plugin should be configured to listen on the socket set as the `endpoint`."
+	KMSEncryptionConfigDoc.Fields[5].Comments[encoder.LineComment] = "Extra arguments to supply to the KMS plugin static pod."
+
+	KMSEncryptionConfigDoc.Fields[5].AddExample("", clusterKMSPluginExtraArgsExample)
+
 	AdminKubeconfigConfigDoc.Type = "AdminKubeconfigConfig"
 	AdminKubeconfigConfigDoc.Comments[encoder.LineComment] = "AdminKubeconfigConfig contains admin kubeconfig settings."
 	AdminKubeconfigConfigDoc.Description = "AdminKubeconfigConfig contains admin kubeconfig settings."
@@ -1559,7 +1728,7 @@ func init() {
 			FieldName: "keys",
 		},
 	}
-	EncryptionKeyDoc.Fields = make([]encoder.Doc, 3)
+	EncryptionKeyDoc.Fields = make([]encoder.Doc, 5)
 	EncryptionKeyDoc.Fields[0].Name = "static"
 	EncryptionKeyDoc.Fields[0].Type = "EncryptionKeyStatic"
 	EncryptionKeyDoc.Fields[0].Note = ""
@@ -1570,11 +1739,21 @@ func init() {
 	EncryptionKeyDoc.Fields[1].Note = ""
 	EncryptionKeyDoc.Fields[1].Description = "Deterministically generated key from the node UUID and PartitionLabel."
 	EncryptionKeyDoc.Fields[1].Comments[encoder.LineComment] = "Deterministically generated key from the node UUID and PartitionLabel."
-	EncryptionKeyDoc.Fields[2].Name = "slot"
-	EncryptionKeyDoc.Fields[2].Type = "int"
+	EncryptionKeyDoc.Fields[2].Name = "tpm"
+	EncryptionKeyDoc.Fields[2].Type = "EncryptionKeyTPM"
 	EncryptionKeyDoc.Fields[2].Note = ""
-	EncryptionKeyDoc.Fields[2].Description = "Key slot number for LUKS2 encryption."
-	EncryptionKeyDoc.Fields[2].Comments[encoder.LineComment] = "Key slot number for LUKS2 encryption."
+	EncryptionKeyDoc.Fields[2].Description = "Random key sealed by the TPM 2.0 device against the Secure Boot state (PCR 7)."
+	EncryptionKeyDoc.Fields[2].Comments[encoder.LineComment] = "Random key sealed by the TPM 2.0 device against the Secure Boot state (PCR 7)."
+	EncryptionKeyDoc.Fields[3].Name = "kms"
+	EncryptionKeyDoc.Fields[3].Type = "EncryptionKeyKMS"
+	EncryptionKeyDoc.Fields[3].Note = ""
+	EncryptionKeyDoc.Fields[3].Description = "Random key sealed by the remote KMS endpoint, the key is unsealed over the network on boot."
+	EncryptionKeyDoc.Fields[3].Comments[encoder.LineComment] = "Random key sealed by the remote KMS endpoint, the key is unsealed over the network on boot."
+	EncryptionKeyDoc.Fields[4].Name = "slot"
+	EncryptionKeyDoc.Fields[4].Type = "int"
+	EncryptionKeyDoc.Fields[4].Note = ""
+	EncryptionKeyDoc.Fields[4].Description = "Key slot number for LUKS2 encryption."
+	EncryptionKeyDoc.Fields[4].Comments[encoder.LineComment] = "Key slot number for LUKS2 encryption."
 
 	EncryptionKeyStaticDoc.Type = "EncryptionKeyStatic"
 	EncryptionKeyStaticDoc.Comments[encoder.LineComment] = "EncryptionKeyStatic represents throw away key type."
@@ -1603,6 +1782,35 @@ func init() {
 	}
 	EncryptionKeyNodeIDDoc.Fields = make([]encoder.Doc, 0)
 
+	EncryptionKeyTPMDoc.Type = "EncryptionKeyTPM"
+	EncryptionKeyTPMDoc.Comments[encoder.LineComment] = "EncryptionKeyTPM represents a key that is generated and then sealed/unsealed by the TPM."
+	EncryptionKeyTPMDoc.Description = "EncryptionKeyTPM represents a key that is generated and then sealed/unsealed by the TPM."
+	EncryptionKeyTPMDoc.AppearsIn = []encoder.Appearance{
+		{
+			TypeName:  "EncryptionKey",
+			FieldName: "tpm",
+		},
+	}
+	EncryptionKeyTPMDoc.Fields = make([]encoder.Doc, 0)
+
+	EncryptionKeyKMSDoc.Type = "EncryptionKeyKMS"
+	EncryptionKeyKMSDoc.Comments[encoder.LineComment] = "EncryptionKeyKMS represents a key that is generated and then sealed/unsealed by the KMS server."
+	EncryptionKeyKMSDoc.Description = "EncryptionKeyKMS represents a key that is generated and then sealed/unsealed by the KMS server."
+	EncryptionKeyKMSDoc.AppearsIn = []encoder.Appearance{
+		{
+			TypeName:  "EncryptionKey",
+			FieldName: "kms",
+		},
+	}
+	EncryptionKeyKMSDoc.Fields = make([]encoder.Doc, 1)
+	EncryptionKeyKMSDoc.Fields[0].Name = "endpoint"
+	EncryptionKeyKMSDoc.Fields[0].Type = "string"
+	EncryptionKeyKMSDoc.Fields[0].Note = ""
+	EncryptionKeyKMSDoc.Fields[0].Description = "KMS endpoint to Seal/Unseal the key."
+	EncryptionKeyKMSDoc.Fields[0].Comments[encoder.LineComment] = "KMS endpoint to Seal/Unseal the key."
+
+	EncryptionKeyKMSDoc.Fields[0].AddExample("", "https://192.168.88.21:4443")
+
 	MachineFileDoc.Type = "MachineFile"
 	MachineFileDoc.Comments[encoder.LineComment] = "MachineFile represents a file to write to disk."
 	MachineFileDoc.Description = "MachineFile represents a file to write to disk."
@@ -1675,7 +1883,7 @@ func init() {
 			FieldName: "interfaces",
 		},
 	}
-	DeviceDoc.Fields = make([]encoder.Doc, 15)
+	DeviceDoc.Fields = make([]encoder.Doc, 22)
 	DeviceDoc.Fields[0].Name = "interface"
 	DeviceDoc.Fields[0].Type = "string"
 	DeviceDoc.Fields[0].Note = ""
@@ -1706,70 +1914,121 @@ func init() {
 	DeviceDoc.Fields[4].Comments[encoder.LineComment] = "A list of routes associated with the interface."
 
 	DeviceDoc.Fields[4].AddExample("", networkConfigRoutesExample)
-	DeviceDoc.Fields[5].Name = "bond"
-	DeviceDoc.Fields[5].Type = "Bond"
+	DeviceDoc.Fields[5].Name = "rules"
+	DeviceDoc.Fields[5].Type = "[]RoutingRule"
 	DeviceDoc.Fields[5].Note = ""
-	DeviceDoc.Fields[5].Description = "Bond specific options."
-	DeviceDoc.Fields[5].Comments[encoder.LineComment] = "Bond specific options."
+	DeviceDoc.Fields[5].Description = "A list of policy routing rules associated with the interface.\nRules select the routing table to look up based on the packet source/destination, firewall mark and interfaces."
+	DeviceDoc.Fields[5].Comments[encoder.LineComment] = "A list of policy routing rules associated with the interface."
 
-	DeviceDoc.Fields[5].AddExample("", networkConfigBondExample)
-	DeviceDoc.Fields[6].Name = "bridge"
-	DeviceDoc.Fields[6].Type = "Bridge"
+	DeviceDoc.Fields[5].AddExample("", networkConfigRulesExample)
+	DeviceDoc.Fields[6].Name = "neighbors"
+	DeviceDoc.Fields[6].Type = "[]Neighbor"
 	DeviceDoc.Fields[6].Note = ""
-	DeviceDoc.Fields[6].Description = "Bridge specific options."
-	DeviceDoc.Fields[6].Comments[encoder.LineComment] = "Bridge specific options."
+	DeviceDoc.Fields[6].Description = "A list of permanent neighbor (ARP/NDP) entries associated with the interface.\nProxy entries make the interface answer ARP/NDP requests for the address on behalf of another host."
+	DeviceDoc.Fields[6].Comments[encoder.LineComment] = "A list of permanent neighbor (ARP/NDP) entries associated with the interface."
 
-	DeviceDoc.Fields[6].AddExample("", networkConfigBridgeExample)
-	DeviceDoc.Fields[7].Name = "vlans"
-	DeviceDoc.Fields[7].Type = "[]Vlan"
+	DeviceDoc.Fields[6].AddExample("", networkConfigNeighborsExample)
+	DeviceDoc.Fields[7].Name = "bond"
+	DeviceDoc.Fields[7].Type = "Bond"
 	DeviceDoc.Fields[7].Note = ""
-	DeviceDoc.Fields[7].Description = "VLAN specific options."
-	DeviceDoc.Fields[7].Comments[encoder.LineComment] = "VLAN specific options."
-	DeviceDoc.Fields[8].Name = "mtu"
-	DeviceDoc.Fields[8].Type = "int"
+	DeviceDoc.Fields[7].Description = "Bond specific options."
+	DeviceDoc.Fields[7].Comments[encoder.LineComment] = "Bond specific options."
+
+	DeviceDoc.Fields[7].AddExample("", networkConfigBondExample)
+	DeviceDoc.Fields[8].Name = "bridge"
+	DeviceDoc.Fields[8].Type = "Bridge"
 	DeviceDoc.Fields[8].Note = ""
-	DeviceDoc.Fields[8].Description = "The interface's MTU.\nIf used in combination with DHCP, this will override any MTU settings returned from DHCP server."
-	DeviceDoc.Fields[8].Comments[encoder.LineComment] = "The interface's MTU."
-	DeviceDoc.Fields[9].Name = "dhcp"
-	DeviceDoc.Fields[9].Type = "bool"
-	DeviceDoc.Fields[9].Note = ""
-	DeviceDoc.Fields[9].Description = "Indicates if DHCP should be used to configure the interface.\nThe following DHCP options are supported:\n\n- `OptionClasslessStaticRoute`\n- `OptionDomainNameServer`\n- `OptionDNSDomainSearchList`\n- `OptionHostName`"
-	DeviceDoc.Fields[9].Comments[encoder.LineComment] = "Indicates if DHCP should be used to configure the interface."
+	DeviceDoc.Fields[8].Description = "Bridge specific options."
+	DeviceDoc.Fields[8].Comments[encoder.LineComment] = "Bridge specific options."
 
-	DeviceDoc.Fields[9].AddExample("", true)
-	DeviceDoc.Fields[10].Name = "ignore"
-	DeviceDoc.Fields[10].Type = "bool"
+	DeviceDoc.Fields[8].AddExample("", networkConfigBridgeExample)
+	DeviceDoc.Fields[9].Name = "vlans"
+	DeviceDoc.Fields[9].Type = "[]Vlan"
+	DeviceDoc.Fields[9].Note = ""
+	DeviceDoc.Fields[9].Description = "VLAN specific options."
+	DeviceDoc.Fields[9].Comments[encoder.LineComment] = "VLAN specific options."
+	DeviceDoc.Fields[10].Name = "mtu"
+	DeviceDoc.Fields[10].Type = "int"
 	DeviceDoc.Fields[10].Note = ""
-	DeviceDoc.Fields[10].Description = "Indicates if the interface should be ignored (skips configuration)."
-	DeviceDoc.Fields[10].Comments[encoder.LineComment] = "Indicates if the interface should be ignored (skips configuration)."
-	DeviceDoc.Fields[11].Name = "dummy"
+	DeviceDoc.Fields[10].Description = "The interface's MTU.\nIf used in combination with DHCP, this will override any MTU settings returned from DHCP server."
+	DeviceDoc.Fields[10].Comments[encoder.LineComment] = "The interface's MTU."
+	DeviceDoc.Fields[11].Name = "dhcp"
 	DeviceDoc.Fields[11].Type = "bool"
 	DeviceDoc.Fields[11].Note = ""
-	DeviceDoc.Fields[11].Description = "Indicates if the interface is a dummy interface.\n`dummy` is used to specify that this interface should be a virtual-only, dummy interface."
-	DeviceDoc.Fields[11].Comments[encoder.LineComment] = "Indicates if the interface is a dummy interface."
-	DeviceDoc.Fields[12].Name = "dhcpOptions"
-	DeviceDoc.Fields[12].Type = "DHCPOptions"
-	DeviceDoc.Fields[12].Note = ""
-	DeviceDoc.Fields[12].Description = "DHCP specific options.\n`dhcp` *must* be set to true for these to take effect."
-	DeviceDoc.Fields[12].Comments[encoder.LineComment] = "DHCP specific options."
+	DeviceDoc.Fields[11].Description = "Indicates if DHCP should be used to configure the interface.\nThe following DHCP options are supported:\n\n- `OptionClasslessStaticRoute`\n- `OptionDomainNameServer`\n- `OptionDNSDomainSearchList`\n- `OptionHostName`"
+	DeviceDoc.Fields[11].Comments[encoder.LineComment] = "Indicates if DHCP should be used to configure the interface."
 
-	DeviceDoc.Fields[12].AddExample("", networkConfigDHCPOptionsExample)
-	DeviceDoc.Fields[13].Name = "wireguard"
-	DeviceDoc.Fields[13].Type = "DeviceWireguardConfig"
+	DeviceDoc.Fields[11].AddExample("", true)
+	DeviceDoc.Fields[12].Name = "ignore"
+	DeviceDoc.Fields[12].Type = "bool"
+	DeviceDoc.Fields[12].Note = ""
+	DeviceDoc.Fields[12].Description = "Indicates if the interface should be ignored (skips configuration)."
+	DeviceDoc.Fields[12].Comments[encoder.LineComment] = "Indicates if the interface should be ignored (skips configuration)."
+	DeviceDoc.Fields[13].Name = "dummy"
+	DeviceDoc.Fields[13].Type = "bool"
 	DeviceDoc.Fields[13].Note = ""
-	DeviceDoc.Fields[13].Description = "Wireguard specific configuration.\nIncludes things like private key, listen port, peers."
-	DeviceDoc.Fields[13].Comments[encoder.LineComment] = "Wireguard specific configuration."
-
-	DeviceDoc.Fields[13].AddExample("wireguard server example", networkConfigWireguardHostExample)
-
-	DeviceDoc.Fields[13].AddExample("wireguard peer example", networkConfigWireguardPeerExample)
-	DeviceDoc.Fields[14].Name = "vip"
-	DeviceDoc.Fields[14].Type = "DeviceVIPConfig"
+	DeviceDoc.Fields[13].Description = "Indicates if the interface is a dummy interface.\n`dummy` is used to specify that this interface should be a virtual-only, dummy interface."
+	DeviceDoc.Fields[13].Comments[encoder.LineComment] = "Indicates if the interface is a dummy interface."
+	DeviceDoc.Fields[14].Name = "dhcpOptions"
+	DeviceDoc.Fields[14].Type = "DHCPOptions"
 	DeviceDoc.Fields[14].Note = ""
-	DeviceDoc.Fields[14].Description = "Virtual (shared) IP address configuration."
-	DeviceDoc.Fields[14].Comments[encoder.LineComment] = "Virtual (shared) IP address configuration."
-
-	DeviceDoc.Fields[14].AddExample("layer2 vip example", networkConfigVIPLayer2Example)
+	DeviceDoc.Fields[14].Description = "DHCP specific options.\n`dhcp` *must* be set to true for these to take effect."
+	DeviceDoc.Fields[14].Comments[encoder.LineComment] = "DHCP specific options."
+
+	DeviceDoc.Fields[14].AddExample("", networkConfigDHCPOptionsExample)
+	DeviceDoc.Fields[15].Name = "ipv6"
+	DeviceDoc.Fields[15].Type = "DeviceIPv6Config"
+	DeviceDoc.Fields[15].Note = ""
+	DeviceDoc.Fields[15].Description = "IPv6 router advertisement and address autoconfiguration (SLAAC) settings.\nIf set, router advertisements are processed by Talos instead of the kernel,\nthe learned addresses, routes and DNS servers are merged with the rest of the network configuration."
+	DeviceDoc.Fields[15].Comments[encoder.LineComment] = "IPv6 router advertisement and address autoconfiguration (SLAAC) settings."
+
+	DeviceDoc.Fields[15].AddExample("", networkConfigIPv6Example)
+	DeviceDoc.Fields[16].Name = "wireguard"
+	DeviceDoc.Fields[16].Type = "DeviceWireguardConfig"
+	DeviceDoc.Fields[16].Note = ""
+	DeviceDoc.Fields[16].Description = "Wireguard specific configuration.\nIncludes things like private key, listen port, peers."
+	DeviceDoc.Fields[16].Comments[encoder.LineComment] = "Wireguard specific configuration."
+
+	DeviceDoc.Fields[16].AddExample("wireguard server example", networkConfigWireguardHostExample)
+
+	DeviceDoc.Fields[16].AddExample("wireguard peer example", networkConfigWireguardPeerExample)
+	DeviceDoc.Fields[17].Name = "vip"
+	DeviceDoc.Fields[17].Type = "DeviceVIPConfig"
+	DeviceDoc.Fields[17].Note = ""
+	DeviceDoc.Fields[17].Description = "Virtual (shared) IP address configuration."
+	DeviceDoc.Fields[17].Comments[encoder.LineComment] = "Virtual (shared) IP address configuration."
+
+	DeviceDoc.Fields[17].AddExample("layer2 vip example", networkConfigVIPLayer2Example)
+
+	DeviceDoc.Fields[17].AddExample("BGP vip example", networkConfigVIPBGPExample)
+	DeviceDoc.Fields[18].Name = "vrf"
+	DeviceDoc.Fields[18].Type = "VRF"
+	DeviceDoc.Fields[18].Note = ""
+	DeviceDoc.Fields[18].Description = "VRF specific options.\nThe interface is created as a VRF bound to the routing table, the routes of the interface and of the enslaved interfaces are placed in the VRF table."
+	DeviceDoc.Fields[18].Comments[encoder.LineComment] = "VRF specific options."
+
+	DeviceDoc.Fields[18].AddExample("", networkConfigVRFExample)
+	DeviceDoc.Fields[19].Name = "vxlan"
+	DeviceDoc.Fields[19].Type = "VXLAN"
+	DeviceDoc.Fields[19].Note = ""
+	DeviceDoc.Fields[19].Description = "VXLAN specific options."
+	DeviceDoc.Fields[19].Comments[encoder.LineComment] = "VXLAN specific options."
+
+	DeviceDoc.Fields[19].AddExample("", networkConfigVXLANExample)
+	DeviceDoc.Fields[20].Name = "macvlan"
+	DeviceDoc.Fields[20].Type = "MACVLAN"
+	DeviceDoc.Fields[20].Note = ""
+	DeviceDoc.Fields[20].Description = "MACVLAN specific options."
+	DeviceDoc.Fields[20].Comments[encoder.LineComment] = "MACVLAN specific options."
+
+	DeviceDoc.Fields[20].AddExample("", networkConfigMACVLANExample)
+	DeviceDoc.Fields[21].Name = "ipvlan"
+	DeviceDoc.Fields[21].Type = "IPVLAN"
+	DeviceDoc.Fields[21].Note = ""
+	DeviceDoc.Fields[21].Description = "IPVLAN specific options."
+	DeviceDoc.Fields[21].Comments[encoder.LineComment] = "IPVLAN specific options."
+
+	DeviceDoc.Fields[21].AddExample("", networkConfigIPVLANExample)
 
 	DHCPOptionsDoc.Type = "DHCPOptions"
 	DHCPOptionsDoc.Comments[encoder.LineComment] = "DHCPOptions contains options for configuring the DHCP settings for a given interface."
@@ -1808,6 +2067,50 @@ func init() {
 	DHCPOptionsDoc.Fields[3].Description = "Set client DUID (hex string)."
 	DHCPOptionsDoc.Fields[3].Comments[encoder.LineComment] = "Set client DUID (hex string)."
 
+	DeviceIPv6ConfigDoc.Type = "DeviceIPv6Config"
+	DeviceIPv6ConfigDoc.Comments[encoder.LineComment] = "DeviceIPv6Config contains IPv6 settings for the interface."
+	DeviceIPv6ConfigDoc.Description = "DeviceIPv6Config contains IPv6 settings for the interface."
+
+	DeviceIPv6ConfigDoc.AddExample("", networkConfigIPv6Example)
+	DeviceIPv6ConfigDoc.AppearsIn = []encoder.Appearance{
+		{
+			TypeName:  "Device",
+			FieldName: "ipv6",
+		},
+	}
+	DeviceIPv6ConfigDoc.Fields = make([]encoder.Doc, 5)
+	DeviceIPv6ConfigDoc.Fields[0].Name = "acceptRA"
+	DeviceIPv6ConfigDoc.Fields[0].Type = "bool"
+	DeviceIPv6ConfigDoc.Fields[0].Note = ""
+	DeviceIPv6ConfigDoc.Fields[0].Description = "Accept IPv6 router advertisements on the interface (default is enabled).\nIf disabled, router advertisements are ignored both by Talos and the kernel."
+	DeviceIPv6ConfigDoc.Fields[0].Comments[encoder.LineComment] = "Accept IPv6 router advertisements on the interface (default is enabled)."
+	DeviceIPv6ConfigDoc.Fields[1].Name = "autoconf"
+	DeviceIPv6ConfigDoc.Fields[1].Type = "bool"
+	DeviceIPv6ConfigDoc.Fields[1].Note = ""
+	DeviceIPv6ConfigDoc.Fields[1].Description = "Configure addresses from the prefixes announced in router advertisements (SLAAC, default is enabled)."
+	DeviceIPv6ConfigDoc.Fields[1].Comments[encoder.LineComment] = "Configure addresses from the prefixes announced in router advertisements (SLAAC, default is enabled)."
+	DeviceIPv6ConfigDoc.Fields[2].Name = "privacyExtensions"
+	DeviceIPv6ConfigDoc.Fields[2].Type = "bool"
+	DeviceIPv6ConfigDoc.Fields[2].Note = ""
+	DeviceIPv6ConfigDoc.Fields[2].Description = "Enable privacy extensions (RFC 4941): temporary addresses are generated for SLAAC addresses\nand preferred for the outgoing connections (default is disabled)."
+	DeviceIPv6ConfigDoc.Fields[2].Comments[encoder.LineComment] = "Enable privacy extensions (RFC 4941): temporary addresses are generated for SLAAC addresses"
+	DeviceIPv6ConfigDoc.Fields[3].Name = "addrGenMode"
+	DeviceIPv6ConfigDoc.Fields[3].Type = "string"
+	DeviceIPv6ConfigDoc.Fields[3].Note = ""
+	DeviceIPv6ConfigDoc.Fields[3].Description = "The address generation mode for the interface identifier of the link-local and SLAAC addresses."
+	DeviceIPv6ConfigDoc.Fields[3].Comments[encoder.LineComment] = "The address generation mode for the interface identifier of the link-local and SLAAC addresses."
+	DeviceIPv6ConfigDoc.Fields[3].Values = []string{
+		"eui64",
+		"stable-privacy",
+		"random",
+		"none",
+	}
+	DeviceIPv6ConfigDoc.Fields[4].Name = "routeMetric"
+	DeviceIPv6ConfigDoc.Fields[4].Type = "uint32"
+	DeviceIPv6ConfigDoc.Fields[4].Note = ""
+	DeviceIPv6ConfigDoc.Fields[4].Description = "The priority of the default routes learned from router advertisements."
+	DeviceIPv6ConfigDoc.Fields[4].Comments[encoder.LineComment] = "The priority of the default routes learned from router advertisements."
+
 	DeviceWireguardConfigDoc.Type = "DeviceWireguardConfig"
 	DeviceWireguardConfigDoc.Comments[encoder.LineComment] = "DeviceWireguardConfig contains settings for configuring Wireguard network interface."
 	DeviceWireguardConfigDoc.Description = "DeviceWireguardConfig contains settings for configuring Wireguard network interface."
@@ -1879,6 +2182,8 @@ func init() {
 	DeviceVIPConfigDoc.Description = "DeviceVIPConfig contains settings for configuring a Virtual Shared IP on an interface."
 
 	DeviceVIPConfigDoc.AddExample("layer2 vip example", networkConfigVIPLayer2Example)
+
+	DeviceVIPConfigDoc.AddExample("BGP vip example", networkConfigVIPBGPExample)
 	DeviceVIPConfigDoc.AppearsIn = []encoder.Appearance{
 		{
 			TypeName:  "Device",
@@ -1889,7 +2194,7 @@ func init() {
 			FieldName: "vip",
 		},
 	}
-	DeviceVIPConfigDoc.Fields = make([]encoder.Doc, 3)
+	DeviceVIPConfigDoc.Fields = make([]encoder.Doc, 5)
 	DeviceVIPConfigDoc.Fields[0].Name = "ip"
 	DeviceVIPConfigDoc.Fields[0].Type = "string"
 	DeviceVIPConfigDoc.Fields[0].Note = ""
@@ -1905,6 +2210,18 @@ func init() {
 	DeviceVIPConfigDoc.Fields[2].Note = ""
 	DeviceVIPConfigDoc.Fields[2].Description = "Specifies the Hetzner Cloud API settings to assign VIP to the node."
 	DeviceVIPConfigDoc.Fields[2].Comments[encoder.LineComment] = "Specifies the Hetzner Cloud API settings to assign VIP to the node."
+	DeviceVIPConfigDoc.Fields[3].Name = "bgp"
+	DeviceVIPConfigDoc.Fields[3].Type = "VIPBGPConfig"
+	DeviceVIPConfigDoc.Fields[3].Note = ""
+	DeviceVIPConfigDoc.Fields[3].Description = "Specifies the BGP settings to announce the VIP to the routers.\nThe VIP is announced as a host route while the node holds the VIP, and withdrawn when the node loses it."
+	DeviceVIPConfigDoc.Fields[3].Comments[encoder.LineComment] = "Specifies the BGP settings to announce the VIP to the routers."
+	DeviceVIPConfigDoc.Fields[4].Name = "probe"
+	DeviceVIPConfigDoc.Fields[4].Type = "NetworkProbe"
+	DeviceVIPConfigDoc.Fields[4].Note = ""
+	DeviceVIPConfigDoc.Fields[4].Description = "Specifies the network probe which should succeed for the VIP to be announced.\nThe VIP is released while the probe is failing."
+	DeviceVIPConfigDoc.Fields[4].Comments[encoder.LineComment] = "Specifies the network probe which should succeed for the VIP to be announced."
+
+	DeviceVIPConfigDoc.Fields[4].AddExample("", networkConfigProbeExample)
 
 	VIPEquinixMetalConfigDoc.Type = "VIPEquinixMetalConfig"
 	VIPEquinixMetalConfigDoc.Comments[encoder.LineComment] = "VIPEquinixMetalConfig contains settings for Equinix Metal VIP management."
@@ -1938,6 +2255,58 @@ func init() {
 	VIPHCloudConfigDoc.Fields[0].Description = "Specifies the Hetzner Cloud API Token."
 	VIPHCloudConfigDoc.Fields[0].Comments[encoder.LineComment] = "Specifies the Hetzner Cloud API Token."
 
+	VIPBGPConfigDoc.Type = "VIPBGPConfig"
+	VIPBGPConfigDoc.Comments[encoder.LineComment] = "VIPBGPConfig contains settings for announcing the VIP over BGP."
+	VIPBGPConfigDoc.Description = "VIPBGPConfig contains settings for announcing the VIP over BGP."
+	VIPBGPConfigDoc.AppearsIn = []encoder.Appearance{
+		{
+			TypeName:  "DeviceVIPConfig",
+			FieldName: "bgp",
+		},
+	}
+	VIPBGPConfigDoc.Fields = make([]encoder.Doc, 3)
+	VIPBGPConfigDoc.Fields[0].Name = "localASN"
+	VIPBGPConfigDoc.Fields[0].Type = "uint32"
+	VIPBGPConfigDoc.Fields[0].Note = ""
+	VIPBGPConfigDoc.Fields[0].Description = "Local autonomous system number."
+	VIPBGPConfigDoc.Fields[0].Comments[encoder.LineComment] = "Local autonomous system number."
+	VIPBGPConfigDoc.Fields[1].Name = "routerID"
+	VIPBGPConfigDoc.Fields[1].Type = "string"
+	VIPBGPConfigDoc.Fields[1].Note = ""
+	VIPBGPConfigDoc.Fields[1].Description = "BGP router identifier.\nDefaults to the local IPv4 address of the BGP session, required for IPv6 sessions."
+	VIPBGPConfigDoc.Fields[1].Comments[encoder.LineComment] = "BGP router identifier."
+	VIPBGPConfigDoc.Fields[2].Name = "peers"
+	VIPBGPConfigDoc.Fields[2].Type = "[]VIPBGPPeer"
+	VIPBGPConfigDoc.Fields[2].Note = ""
+	VIPBGPConfigDoc.Fields[2].Description = "List of BGP peers to announce the VIP to."
+	VIPBGPConfigDoc.Fields[2].Comments[encoder.LineComment] = "List of BGP peers to announce the VIP to."
+
+	VIPBGPPeerDoc.Type = "VIPBGPPeer"
+	VIPBGPPeerDoc.Comments[encoder.LineComment] = "VIPBGPPeer contains settings of a BGP peer."
+	VIPBGPPeerDoc.Description = "VIPBGPPeer contains settings of a BGP peer."
+	VIPBGPPeerDoc.AppearsIn = []encoder.Appearance{
+		{
+			TypeName:  "VIPBGPConfig",
+			FieldName: "peers",
+		},
+	}
+	VIPBGPPeerDoc.Fields = make([]encoder.Doc, 3)
+	VIPBGPPeerDoc.Fields[0].Name = "address"
+	VIPBGPPeerDoc.Fields[0].Type = "string"
+	VIPBGPPeerDoc.Fields[0].Note = ""
+	VIPBGPPeerDoc.Fields[0].Description = "Peer IP address, should be of the same address family as the VIP."
+	VIPBGPPeerDoc.Fields[0].Comments[encoder.LineComment] = "Peer IP address, should be of the same address family as the VIP."
+	VIPBGPPeerDoc.Fields[1].Name = "asn"
+	VIPBGPPeerDoc.Fields[1].Type = "uint32"
+	VIPBGPPeerDoc.Fields[1].Note = ""
+	VIPBGPPeerDoc.Fields[1].Description = "Peer autonomous system number."
+	VIPBGPPeerDoc.Fields[1].Comments[encoder.LineComment] = "Peer autonomous system number."
+	VIPBGPPeerDoc.Fields[2].Name = "password"
+	VIPBGPPeerDoc.Fields[2].Type = "string"
+	VIPBGPPeerDoc.Fields[2].Note = ""
+	VIPBGPPeerDoc.Fields[2].Description = "TCP MD5 signature password."
+	VIPBGPPeerDoc.Fields[2].Comments[encoder.LineComment] = "TCP MD5 signature password."
+
 	BondDoc.Type = "Bond"
 	BondDoc.Comments[encoder.LineComment] = "Bond contains the various options for configuring a bonded interface."
 	BondDoc.Description = "Bond contains the various options for configuring a bonded interface."
@@ -2132,6 +2501,120 @@ func init() {
 	BridgeDoc.Fields[1].Description = "A bridge option.\nPlease see the official kernel documentation."
 	BridgeDoc.Fields[1].Comments[encoder.LineComment] = "A bridge option."
 
+	VRFDoc.Type = "VRF"
+	VRFDoc.Comments[encoder.LineComment] = "VRF contains the various options for configuring a VRF interface."
+	VRFDoc.Description = "VRF contains the various options for configuring a VRF interface."
+
+	VRFDoc.AddExample("", networkConfigVRFExample)
+	VRFDoc.AppearsIn = []encoder.Appearance{
+		{
+			TypeName:  "Device",
+			FieldName: "vrf",
+		},
+	}
+	VRFDoc.Fields = make([]encoder.Doc, 2)
+	VRFDoc.Fields[0].Name = "interfaces"
+	VRFDoc.Fields[0].Type = "[]string"
+	VRFDoc.Fields[0].Note = ""
+	VRFDoc.Fields[0].Description = "The interfaces enslaved to the VRF."
+	VRFDoc.Fields[0].Comments[encoder.LineComment] = "The interfaces enslaved to the VRF."
+	VRFDoc.Fields[1].Name = "table"
+	VRFDoc.Fields[1].Type = "uint32"
+	VRFDoc.Fields[1].Note = ""
+	VRFDoc.Fields[1].Description = "The routing table the VRF is bound to."
+	VRFDoc.Fields[1].Comments[encoder.LineComment] = "The routing table the VRF is bound to."
+
+	VXLANDoc.Type = "VXLAN"
+	VXLANDoc.Comments[encoder.LineComment] = "VXLAN contains the various options for configuring a VXLAN interface."
+	VXLANDoc.Description = "VXLAN contains the various options for configuring a VXLAN interface."
+
+	VXLANDoc.AddExample("", networkConfigVXLANExample)
+	VXLANDoc.AppearsIn = []encoder.Appearance{
+		{
+			TypeName:  "Device",
+			FieldName: "vxlan",
+		},
+	}
+	VXLANDoc.Fields = make([]encoder.Doc, 4)
+	VXLANDoc.Fields[0].Name = "id"
+	VXLANDoc.Fields[0].Type = "uint32"
+	VXLANDoc.Fields[0].Note = ""
+	VXLANDoc.Fields[0].Description = "The VXLAN network identifier (VNI)."
+	VXLANDoc.Fields[0].Comments[encoder.LineComment] = "The VXLAN network identifier (VNI)."
+	VXLANDoc.Fields[1].Name = "local"
+	VXLANDoc.Fields[1].Type = "string"
+	VXLANDoc.Fields[1].Note = ""
+	VXLANDoc.Fields[1].Description = "The source IP address of the tunnel packets."
+	VXLANDoc.Fields[1].Comments[encoder.LineComment] = "The source IP address of the tunnel packets."
+	VXLANDoc.Fields[2].Name = "remote"
+	VXLANDoc.Fields[2].Type = "string"
+	VXLANDoc.Fields[2].Note = ""
+	VXLANDoc.Fields[2].Description = "The IP address of the remote tunnel endpoint.\nIf the address is a multicast address, it is used as the multicast group."
+	VXLANDoc.Fields[2].Comments[encoder.LineComment] = "The IP address of the remote tunnel endpoint."
+	VXLANDoc.Fields[3].Name = "port"
+	VXLANDoc.Fields[3].Type = "uint16"
+	VXLANDoc.Fields[3].Note = ""
+	VXLANDoc.Fields[3].Description = "The UDP destination port of the remote tunnel endpoint (defaults to the kernel default port)."
+	VXLANDoc.Fields[3].Comments[encoder.LineComment] = "The UDP destination port of the remote tunnel endpoint (defaults to the kernel default port)."
+
+	MACVLANDoc.Type = "MACVLAN"
+	MACVLANDoc.Comments[encoder.LineComment] = "MACVLAN contains the various options for configuring a MACVLAN interface."
+	MACVLANDoc.Description = "MACVLAN contains the various options for configuring a MACVLAN interface."
+
+	MACVLANDoc.AddExample("", networkConfigMACVLANExample)
+	MACVLANDoc.AppearsIn = []encoder.Appearance{
+		{
+			TypeName:  "Device",
+			FieldName: "macvlan",
+		},
+	}
+	MACVLANDoc.Fields = make([]encoder.Doc, 2)
+	MACVLANDoc.Fields[0].Name = "parent"
+	MACVLANDoc.Fields[0].Type = "string"
+	MACVLANDoc.Fields[0].Note = ""
+	MACVLANDoc.Fields[0].Description = "The parent interface of the MACVLAN interface."
+	MACVLANDoc.Fields[0].Comments[encoder.LineComment] = "The parent interface of the MACVLAN interface."
+	MACVLANDoc.Fields[1].Name = "mode"
+	MACVLANDoc.Fields[1].Type = "string"
+	MACVLANDoc.Fields[1].Note = ""
+	MACVLANDoc.Fields[1].Description = "The MACVLAN mode."
+	MACVLANDoc.Fields[1].Comments[encoder.LineComment] = "The MACVLAN mode."
+	MACVLANDoc.Fields[1].Values = []string{
+		"bridge",
+		"private",
+		"vepa",
+		"passthru",
+		"source",
+	}
+
+	IPVLANDoc.Type = "IPVLAN"
+	IPVLANDoc.Comments[encoder.LineComment] = "IPVLAN contains the various options for configuring an IPVLAN interface."
+	IPVLANDoc.Description = "IPVLAN contains the various options for configuring an IPVLAN interface."
+
+	IPVLANDoc.AddExample("", networkConfigIPVLANExample)
+	IPVLANDoc.AppearsIn = []encoder.Appearance{
+		{
+			TypeName:  "Device",
+			FieldName: "ipvlan",
+		},
+	}
+	IPVLANDoc.Fields = make([]encoder.Doc, 2)
+	IPVLANDoc.Fields[0].Name = "parent"
+	IPVLANDoc.Fields[0].Type = "string"
+	IPVLANDoc.Fields[0].Note = ""
+	IPVLANDoc.Fields[0].Description = "The parent interface of the IPVLAN interface."
+	IPVLANDoc.Fields[0].Comments[encoder.LineComment] = "The parent interface of the IPVLAN interface."
+	IPVLANDoc.Fields[1].Name = "mode"
+	IPVLANDoc.Fields[1].Type = "string"
+	IPVLANDoc.Fields[1].Note = ""
+	IPVLANDoc.Fields[1].Description = "The IPVLAN mode."
+	IPVLANDoc.Fields[1].Comments[encoder.LineComment] = "The IPVLAN mode."
+	IPVLANDoc.Fields[1].Values = []string{
+		"l2",
+		"l3",
+		"l3s",
+	}
+
 	VlanDoc.Type = "Vlan"
 	VlanDoc.Comments[encoder.LineComment] = "Vlan represents vlan settings for a device."
 	VlanDoc.Description = "Vlan represents vlan settings for a device."
@@ -2193,7 +2676,7 @@ func init() {
 			FieldName: "routes",
 		},
 	}
-	RouteDoc.Fields = make([]encoder.Doc, 5)
+	RouteDoc.Fields = make([]encoder.Doc, 6)
 	RouteDoc.Fields[0].Name = "network"
 	RouteDoc.Fields[0].Type = "string"
 	RouteDoc.Fields[0].Note = ""
@@ -2219,6 +2702,190 @@ func init() {
 	RouteDoc.Fields[4].Note = ""
 	RouteDoc.Fields[4].Description = "The optional MTU for the route."
 	RouteDoc.Fields[4].Comments[encoder.LineComment] = "The optional MTU for the route."
+	RouteDoc.Fields[5].Name = "probe"
+	RouteDoc.Fields[5].Type = "NetworkProbe"
+	RouteDoc.Fields[5].Note = ""
+	RouteDoc.Fields[5].Description = "The optional network probe which should succeed for the route to be installed.\nThe route is withdrawn while the probe is failing."
+	RouteDoc.Fields[5].Comments[encoder.LineComment] = "The optional network probe which should succeed for the route to be installed."
+
+	RouteDoc.Fields[5].AddExample("", networkConfigProbeExample)
+
+	NetworkProbeDoc.Type = "NetworkProbe"
+	NetworkProbeDoc.Comments[encoder.LineComment] = "NetworkProbe represents a network probe which gates a VIP or a route."
+	NetworkProbeDoc.Description = "NetworkProbe represents a network probe which gates a VIP or a route.\n\nExactly one of the probe targets (`tcp`, `icmp`, `http` or `dns`) should be specified.\n"
+
+	NetworkProbeDoc.AddExample("", networkConfigProbeExample)
+
+	NetworkProbeDoc.AddExample("", networkConfigProbeExample)
+	NetworkProbeDoc.AppearsIn = []encoder.Appearance{
+		{
+			TypeName:  "DeviceVIPConfig",
+			FieldName: "probe",
+		},
+		{
+			TypeName:  "Route",
+			FieldName: "probe",
+		},
+	}
+	NetworkProbeDoc.Fields = make([]encoder.Doc, 7)
+	NetworkProbeDoc.Fields[0].Name = "interval"
+	NetworkProbeDoc.Fields[0].Type = "Duration"
+	NetworkProbeDoc.Fields[0].Note = ""
+	NetworkProbeDoc.Fields[0].Description = "Interval between the probes (default is 5 seconds).\nField format accepts any Go time.Duration format ('1h' for one hour, '10m' for ten minutes)."
+	NetworkProbeDoc.Fields[0].Comments[encoder.LineComment] = "Interval between the probes (default is 5 seconds)."
+	NetworkProbeDoc.Fields[1].Name = "failureThreshold"
+	NetworkProbeDoc.Fields[1].Type = "int"
+	NetworkProbeDoc.Fields[1].Note = ""
+	NetworkProbeDoc.Fields[1].Description = "Number of consecutive failures for the probe to be considered failed after having succeeded (default is 3)."
+	NetworkProbeDoc.Fields[1].Comments[encoder.LineComment] = "Number of consecutive failures for the probe to be considered failed after having succeeded (default is 3)."
+	NetworkProbeDoc.Fields[2].Name = "timeout"
+	NetworkProbeDoc.Fields[2].Type = "Duration"
+	NetworkProbeDoc.Fields[2].Note = ""
+	NetworkProbeDoc.Fields[2].Description = "Timeout for a single probe (default is 3 seconds).\nField format accepts any Go time.Duration format ('1h' for one hour, '10m' for ten minutes)."
+	NetworkProbeDoc.Fields[2].Comments[encoder.LineComment] = "Timeout for a single probe (default is 3 seconds)."
+	NetworkProbeDoc.Fields[3].Name = "tcp"
+	NetworkProbeDoc.Fields[3].Type = "string"
+	NetworkProbeDoc.Fields[3].Note = ""
+	NetworkProbeDoc.Fields[3].Description = "The TCP endpoint (`host:port`) to connect to."
+	NetworkProbeDoc.Fields[3].Comments[encoder.LineComment] = "The TCP endpoint (`host:port`) to connect to."
+	NetworkProbeDoc.Fields[4].Name = "icmp"
+	NetworkProbeDoc.Fields[4].Type = "string"
+	NetworkProbeDoc.Fields[4].Note = ""
+	NetworkProbeDoc.Fields[4].Description = "The host (IP address or hostname) to send ICMP echo requests to."
+	NetworkProbeDoc.Fields[4].Comments[encoder.LineComment] = "The host (IP address or hostname) to send ICMP echo requests to."
+	NetworkProbeDoc.Fields[5].Name = "http"
+	NetworkProbeDoc.Fields[5].Type = "NetworkProbeHTTP"
+	NetworkProbeDoc.Fields[5].Note = ""
+	NetworkProbeDoc.Fields[5].Description = "The HTTP(S) GET probe settings."
+	NetworkProbeDoc.Fields[5].Comments[encoder.LineComment] = "The HTTP(S) GET probe settings."
+	NetworkProbeDoc.Fields[6].Name = "dns"
+	NetworkProbeDoc.Fields[6].Type = "NetworkProbeDNS"
+	NetworkProbeDoc.Fields[6].Note = ""
+	NetworkProbeDoc.Fields[6].Description = "The DNS resolution probe settings."
+	NetworkProbeDoc.Fields[6].Comments[encoder.LineComment] = "The DNS resolution probe settings."
+
+	NetworkProbeHTTPDoc.Type = "NetworkProbeHTTP"
+	NetworkProbeHTTPDoc.Comments[encoder.LineComment] = "NetworkProbeHTTP represents HTTP(S) GET probe settings."
+	NetworkProbeHTTPDoc.Description = "NetworkProbeHTTP represents HTTP(S) GET probe settings."
+	NetworkProbeHTTPDoc.AppearsIn = []encoder.Appearance{
+		{
+			TypeName:  "NetworkProbe",
+			FieldName: "http",
+		},
+	}
+	NetworkProbeHTTPDoc.Fields = make([]encoder.Doc, 2)
+	NetworkProbeHTTPDoc.Fields[0].Name = "url"
+	NetworkProbeHTTPDoc.Fields[0].Type = "string"
+	NetworkProbeHTTPDoc.Fields[0].Note = ""
+	NetworkProbeHTTPDoc.Fields[0].Description = "The URL to send the GET request to."
+	NetworkProbeHTTPDoc.Fields[0].Comments[encoder.LineComment] = "The URL to send the GET request to."
+	NetworkProbeHTTPDoc.Fields[1].Name = "expectedStatus"
+	NetworkProbeHTTPDoc.Fields[1].Type = "int"
+	NetworkProbeHTTPDoc.Fields[1].Note = ""
+	NetworkProbeHTTPDoc.Fields[1].Description = "The expected HTTP response status code (default is 200)."
+	NetworkProbeHTTPDoc.Fields[1].Comments[encoder.LineComment] = "The expected HTTP response status code (default is 200)."
+
+	NetworkProbeDNSDoc.Type = "NetworkProbeDNS"
+	NetworkProbeDNSDoc.Comments[encoder.LineComment] = "NetworkProbeDNS represents DNS resolution probe settings."
+	NetworkProbeDNSDoc.Description = "NetworkProbeDNS represents DNS resolution probe settings."
+	NetworkProbeDNSDoc.AppearsIn = []encoder.Appearance{
+		{
+			TypeName:  "NetworkProbe",
+			FieldName: "dns",
+		},
+	}
+	NetworkProbeDNSDoc.Fields = make([]encoder.Doc, 2)
+	NetworkProbeDNSDoc.Fields[0].Name = "name"
+	NetworkProbeDNSDoc.Fields[0].Type = "string"
+	NetworkProbeDNSDoc.Fields[0].Note = ""
+	NetworkProbeDNSDoc.Fields[0].Description = "The name to resolve."
+	NetworkProbeDNSDoc.Fields[0].Comments[encoder.LineComment] = "The name to resolve."
+	NetworkProbeDNSDoc.Fields[1].Name = "server"
+	NetworkProbeDNSDoc.Fields[1].Type = "string"
+	NetworkProbeDNSDoc.Fields[1].Note = ""
+	NetworkProbeDNSDoc.Fields[1].Description = "The DNS server (`host:port`) to query, system resolvers are used if not set."
+	NetworkProbeDNSDoc.Fields[1].Comments[encoder.LineComment] = "The DNS server (`host:port`) to query, system resolvers are used if not set."
+
+	RoutingRuleDoc.Type = "RoutingRule"
+	RoutingRuleDoc.Comments[encoder.LineComment] = "RoutingRule represents a policy routing rule."
+	RoutingRuleDoc.Description = "RoutingRule represents a policy routing rule."
+
+	RoutingRuleDoc.AddExample("", networkConfigRulesExample)
+	RoutingRuleDoc.AppearsIn = []encoder.Appearance{
+		{
+			TypeName:  "Device",
+			FieldName: "rules",
+		},
+	}
+	RoutingRuleDoc.Fields = make([]encoder.Doc, 8)
+	RoutingRuleDoc.Fields[0].Name = "priority"
+	RoutingRuleDoc.Fields[0].Type = "uint32"
+	RoutingRuleDoc.Fields[0].Note = ""
+	RoutingRuleDoc.Fields[0].Description = "The rule's priority, rules are evaluated in order of increasing priority.\nShould be unique per address family, priorities 0, 32766 and 32767 are reserved for the default kernel rules."
+	RoutingRuleDoc.Fields[0].Comments[encoder.LineComment] = "The rule's priority, rules are evaluated in order of increasing priority."
+	RoutingRuleDoc.Fields[1].Name = "from"
+	RoutingRuleDoc.Fields[1].Type = "string"
+	RoutingRuleDoc.Fields[1].Note = ""
+	RoutingRuleDoc.Fields[1].Description = "The source prefix to match (optional)."
+	RoutingRuleDoc.Fields[1].Comments[encoder.LineComment] = "The source prefix to match (optional)."
+	RoutingRuleDoc.Fields[2].Name = "to"
+	RoutingRuleDoc.Fields[2].Type = "string"
+	RoutingRuleDoc.Fields[2].Note = ""
+	RoutingRuleDoc.Fields[2].Description = "The destination prefix to match (optional)."
+	RoutingRuleDoc.Fields[2].Comments[encoder.LineComment] = "The destination prefix to match (optional)."
+	RoutingRuleDoc.Fields[3].Name = "fwMark"
+	RoutingRuleDoc.Fields[3].Type = "uint32"
+	RoutingRuleDoc.Fields[3].Note = ""
+	RoutingRuleDoc.Fields[3].Description = "The firewall mark to match (optional)."
+	RoutingRuleDoc.Fields[3].Comments[encoder.LineComment] = "The firewall mark to match (optional)."
+	RoutingRuleDoc.Fields[4].Name = "fwMask"
+	RoutingRuleDoc.Fields[4].Type = "uint32"
+	RoutingRuleDoc.Fields[4].Note = ""
+	RoutingRuleDoc.Fields[4].Description = "The mask applied to the firewall mark before matching (optional)."
+	RoutingRuleDoc.Fields[4].Comments[encoder.LineComment] = "The mask applied to the firewall mark before matching (optional)."
+	RoutingRuleDoc.Fields[5].Name = "iif"
+	RoutingRuleDoc.Fields[5].Type = "string"
+	RoutingRuleDoc.Fields[5].Note = ""
+	RoutingRuleDoc.Fields[5].Description = "The incoming interface name to match (optional)."
+	RoutingRuleDoc.Fields[5].Comments[encoder.LineComment] = "The incoming interface name to match (optional)."
+	RoutingRuleDoc.Fields[6].Name = "oif"
+	RoutingRuleDoc.Fields[6].Type = "string"
+	RoutingRuleDoc.Fields[6].Note = ""
+	RoutingRuleDoc.Fields[6].Description = "The outgoing interface name to match (optional)."
+	RoutingRuleDoc.Fields[6].Comments[encoder.LineComment] = "The outgoing interface name to match (optional)."
+	RoutingRuleDoc.Fields[7].Name = "table"
+	RoutingRuleDoc.Fields[7].Type = "uint32"
+	RoutingRuleDoc.Fields[7].Note = ""
+	RoutingRuleDoc.Fields[7].Description = "The routing table to look up for packets matching the rule."
+	RoutingRuleDoc.Fields[7].Comments[encoder.LineComment] = "The routing table to look up for packets matching the rule."
+
+	NeighborDoc.Type = "Neighbor"
+	NeighborDoc.Comments[encoder.LineComment] = "Neighbor represents a permanent neighbor (ARP/NDP) entry."
+	NeighborDoc.Description = "Neighbor represents a permanent neighbor (ARP/NDP) entry."
+
+	NeighborDoc.AddExample("", networkConfigNeighborsExample)
+	NeighborDoc.AppearsIn = []encoder.Appearance{
+		{
+			TypeName:  "Device",
+			FieldName: "neighbors",
+		},
+	}
+	NeighborDoc.Fields = make([]encoder.Doc, 3)
+	NeighborDoc.Fields[0].Name = "ip"
+	NeighborDoc.Fields[0].Type = "string"
+	NeighborDoc.Fields[0].Note = ""
+	NeighborDoc.Fields[0].Description = "The IP address of the neighbor."
+	NeighborDoc.Fields[0].Comments[encoder.LineComment] = "The IP address of the neighbor."
+	NeighborDoc.Fields[1].Name = "lladdr"
+	NeighborDoc.Fields[1].Type = "string"
+	NeighborDoc.Fields[1].Note = ""
+	NeighborDoc.Fields[1].Description = "The link-layer (MAC) address of the neighbor, required unless `proxy` is set."
+	NeighborDoc.Fields[1].Comments[encoder.LineComment] = "The link-layer (MAC) address of the neighbor, required unless `proxy` is set."
+	NeighborDoc.Fields[2].Name = "proxy"
+	NeighborDoc.Fields[2].Type = "bool"
+	NeighborDoc.Fields[2].Note = ""
+	NeighborDoc.Fields[2].Description = "Answer ARP/NDP requests for the IP address on this interface (proxy entry).\nIPv4 proxy entries are only used with IP forwarding enabled, IPv6 proxy entries require `net.ipv6.conf.<interface>.proxy_ndp` sysctl."
+	NeighborDoc.Fields[2].Comments[encoder.LineComment] = "Answer ARP/NDP requests for the IP address on this interface (proxy entry)."
 
 	RegistryMirrorConfigDoc.Type = "RegistryMirrorConfig"
 	RegistryMirrorConfigDoc.Comments[encoder.LineComment] = "RegistryMirrorConfig represents mirror configuration for a registry."
@@ -2371,7 +3038,7 @@ func init() {
 			FieldName: "features",
 		},
 	}
-	FeaturesConfigDoc.Fields = make([]encoder.Doc, 6)
+	FeaturesConfigDoc.Fields = make([]encoder.Doc, 7)
 	FeaturesConfigDoc.Fields[0].Name = "rbac"
 	FeaturesConfigDoc.Fields[0].Type = "bool"
 	FeaturesConfigDoc.Fields[0].Note = ""
@@ -2404,6 +3071,62 @@ func init() {
 	FeaturesConfigDoc.Fields[5].Note = ""
 	FeaturesConfigDoc.Fields[5].Description = "API server load balancer support - local proxy on defined port that will distribute\nrequests to all API servers in the cluster."
 	FeaturesConfigDoc.Fields[5].Comments[encoder.LineComment] = "API server load balancer support - local proxy on defined port that will distribute"
+	FeaturesConfigDoc.Fields[6].Name = "hostDNS"
+	FeaturesConfigDoc.Fields[6].Type = "HostDNSConfig"
+	FeaturesConfigDoc.Fields[6].Note = ""
+	FeaturesConfigDoc.Fields[6].Description = "Configures the host caching DNS resolver."
+	FeaturesConfigDoc.Fields[6].Comments[encoder.LineComment] = "Configures the host caching DNS resolver."
+
+	FeaturesConfigDoc.Fields[6].AddExample("", hostDNSConfigExample)
+
+	HostDNSConfigDoc.Type = "HostDNSConfig"
+	HostDNSConfigDoc.Comments[encoder.LineComment] = "HostDNSConfig describes the configuration for the host caching DNS resolver."
+	HostDNSConfigDoc.Description = "HostDNSConfig describes the configuration for the host caching DNS resolver."
+
+	HostDNSConfigDoc.AddExample("", hostDNSConfigExample)
+	HostDNSConfigDoc.AppearsIn = []encoder.Appearance{
+		{
+			TypeName:  "FeaturesConfig",
+			FieldName: "hostDNS",
+		},
+	}
+	HostDNSConfigDoc.Fields = make([]encoder.Doc, 3)
+	HostDNSConfigDoc.Fields[0].Name = "enabled"
+	HostDNSConfigDoc.Fields[0].Type = "bool"
+	HostDNSConfigDoc.Fields[0].Note = ""
+	HostDNSConfigDoc.Fields[0].Description = "Enable the host caching DNS resolver listening on 127.0.0.53.\n\nHost processes use the resolver via /etc/resolv.conf, the upstream servers are the configured DNS resolvers."
+	HostDNSConfigDoc.Fields[0].Comments[encoder.LineComment] = "Enable the host caching DNS resolver listening on 127.0.0.53."
+	HostDNSConfigDoc.Fields[1].Name = "forwardKubeDNSToHost"
+	HostDNSConfigDoc.Fields[1].Type = "bool"
+	HostDNSConfigDoc.Fields[1].Note = ""
+	HostDNSConfigDoc.Fields[1].Description = "Use the host caching DNS resolver as the upstream for the Kubernetes pods (and CoreDNS).\n\nWhen enabled, the resolver also listens on a link-local address reachable from the pods,\nand the kubelet passes it to the pods instead of the upstream DNS resolvers."
+	HostDNSConfigDoc.Fields[1].Comments[encoder.LineComment] = "Use the host caching DNS resolver as the upstream for the Kubernetes pods (and CoreDNS)."
+	HostDNSConfigDoc.Fields[2].Name = "forwardDomains"
+	HostDNSConfigDoc.Fields[2].Type = "[]HostDNSForwardDomain"
+	HostDNSConfigDoc.Fields[2].Note = ""
+	HostDNSConfigDoc.Fields[2].Description = "Forward the queries for the domain (and its subdomains) to the specific DNS servers (split DNS)."
+	HostDNSConfigDoc.Fields[2].Comments[encoder.LineComment] = "Forward the queries for the domain (and its subdomains) to the specific DNS servers (split DNS)."
+
+	HostDNSForwardDomainDoc.Type = "HostDNSForwardDomain"
+	HostDNSForwardDomainDoc.Comments[encoder.LineComment] = "HostDNSForwardDomain describes forwarding of a domain to specific DNS servers."
+	HostDNSForwardDomainDoc.Description = "HostDNSForwardDomain describes forwarding of a domain to specific DNS servers."
+	HostDNSForwardDomainDoc.AppearsIn = []encoder.Appearance{
+		{
+			TypeName:  "HostDNSConfig",
+			FieldName: "forwardDomains",
+		},
+	}
+	HostDNSForwardDomainDoc.Fields = make([]encoder.Doc, 2)
+	HostDNSForwardDomainDoc.Fields[0].Name = "domain"
+	HostDNSForwardDomainDoc.Fields[0].Type = "string"
+	HostDNSForwardDomainDoc.Fields[0].Note = ""
+	HostDNSForwardDomainDoc.Fields[0].Description = "The domain to forward."
+	HostDNSForwardDomainDoc.Fields[0].Comments[encoder.LineComment] = "The domain to forward."
+	HostDNSForwardDomainDoc.Fields[1].Name = "servers"
+	HostDNSForwardDomainDoc.Fields[1].Type = "[]string"
+	HostDNSForwardDomainDoc.Fields[1].Note = ""
+	HostDNSForwardDomainDoc.Fields[1].Description = "The DNS servers (IP address with an optional port) to forward the queries to."
+	HostDNSForwardDomainDoc.Fields[1].Comments[encoder.LineComment] = "The DNS servers (IP address with an optional port) to forward the queries to."
 
 	APIServerBalancerDoc.Type = "APIServerBalancer"
 	APIServerBalancerDoc.Comments[encoder.LineComment] = "APIServerBalancer describes the configuration for the API server load balancer."
@@ -2513,6 +3236,29 @@ func init() {
 
 	ClusterInlineManifestDoc.Fields[1].AddExample("", "/etc/kubernetes/auth")
 
+	NetworkLLDPDoc.Type = "NetworkLLDP"
+	NetworkLLDPDoc.Comments[encoder.LineComment] = "NetworkLLDP struct describes LLDP configuration."
+	NetworkLLDPDoc.Description = "NetworkLLDP struct describes LLDP configuration."
+
+	NetworkLLDPDoc.AddExample("", networkLLDPExample)
+	NetworkLLDPDoc.AppearsIn = []encoder.Appearance{
+		{
+			TypeName:  "NetworkConfig",
+			FieldName: "lldp",
+		},
+	}
+	NetworkLLDPDoc.Fields = make([]encoder.Doc, 2)
+	NetworkLLDPDoc.Fields[0].Name = "transmit"
+	NetworkLLDPDoc.Fields[0].Type = "bool"
+	NetworkLLDPDoc.Fields[0].Note = ""
+	NetworkLLDPDoc.Fields[0].Description = "Transmit LLDP advertisements on the physical links.\nThe advertisements carry the hostname of the node and the name of the link."
+	NetworkLLDPDoc.Fields[0].Comments[encoder.LineComment] = "Transmit LLDP advertisements on the physical links."
+	NetworkLLDPDoc.Fields[1].Name = "transmitInterval"
+	NetworkLLDPDoc.Fields[1].Type = "Duration"
+	NetworkLLDPDoc.Fields[1].Note = ""
+	NetworkLLDPDoc.Fields[1].Description = "Interval between the LLDP advertisements (default is 30 seconds).\nField format accepts any Go time.Duration format ('1h' for one hour, '10m' for ten minutes)."
+	NetworkLLDPDoc.Fields[1].Comments[encoder.LineComment] = "Interval between the LLDP advertisements (default is 30 seconds)."
+
 	NetworkKubeSpanDoc.Type = "NetworkKubeSpan"
 	NetworkKubeSpanDoc.Comments[encoder.LineComment] = "NetworkKubeSpan struct describes KubeSpan configuration."
 	NetworkKubeSpanDoc.Description = "NetworkKubeSpan struct describes KubeSpan configuration."
@@ -2738,7 +3484,7 @@ func init() {
 			FieldName: "destinations",
 		},
 	}
-	LoggingDestinationDoc.Fields = make([]encoder.Doc, 2)
+	LoggingDestinationDoc.Fields = make([]encoder.Doc, 3)
 	LoggingDestinationDoc.Fields[0].Name = "endpoint"
 	LoggingDestinationDoc.Fields[0].Type = "Endpoint"
 	LoggingDestinationDoc.Fields[0].Note = ""
@@ -2755,7 +3501,46 @@ func init() {
 	LoggingDestinationDoc.Fields[1].Comments[encoder.LineComment] = "Logs format."
 	LoggingDestinationDoc.Fields[1].Values = []string{
 		"json_lines",
+		"syslog_rfc5424",
+		"gelf",
+	}
+	LoggingDestinationDoc.Fields[2].Name = "tls"
+	LoggingDestinationDoc.Fields[2].Type = "LoggingTLSConfig"
+	LoggingDestinationDoc.Fields[2].Note = ""
+	LoggingDestinationDoc.Fields[2].Description = "TLS configuration for the \"tcp\" endpoint."
+	LoggingDestinationDoc.Fields[2].Comments[encoder.LineComment] = "TLS configuration for the \"tcp\" endpoint."
+
+	LoggingDestinationDoc.Fields[2].AddExample("", loggingTLSConfigExample)
+
+	LoggingTLSConfigDoc.Type = "LoggingTLSConfig"
+	LoggingTLSConfigDoc.Comments[encoder.LineComment] = "LoggingTLSConfig specifies TLS config for the logging destination."
+	LoggingTLSConfigDoc.Description = "LoggingTLSConfig specifies TLS config for the logging destination."
+
+	LoggingTLSConfigDoc.AddExample("", loggingTLSConfigExample)
+	LoggingTLSConfigDoc.AppearsIn = []encoder.Appearance{
+		{
+			TypeName:  "LoggingDestination",
+			FieldName: "tls",
+		},
 	}
+	LoggingTLSConfigDoc.Fields = make([]encoder.Doc, 3)
+	LoggingTLSConfigDoc.Fields[0].Name = "clientIdentity"
+	LoggingTLSConfigDoc.Fields[0].Type = "PEMEncodedCertificateAndKey"
+	LoggingTLSConfigDoc.Fields[0].Note = ""
+	LoggingTLSConfigDoc.Fields[0].Description = "Enable mutual TLS authentication with the log receiver.\nClient certificate and key should be base64-encoded."
+	LoggingTLSConfigDoc.Fields[0].Comments[encoder.LineComment] = "Enable mutual TLS authentication with the log receiver."
+
+	LoggingTLSConfigDoc.Fields[0].AddExample("", pemEncodedCertificateExample)
+	LoggingTLSConfigDoc.Fields[1].Name = "ca"
+	LoggingTLSConfigDoc.Fields[1].Type = "Base64Bytes"
+	LoggingTLSConfigDoc.Fields[1].Note = ""
+	LoggingTLSConfigDoc.Fields[1].Description = "CA certificate to verify the log receiver certificate.\nCertificate should be base64-encoded."
+	LoggingTLSConfigDoc.Fields[1].Comments[encoder.LineComment] = "CA certificate to verify the log receiver certificate."
+	LoggingTLSConfigDoc.Fields[2].Name = "insecureSkipVerify"
+	LoggingTLSConfigDoc.Fields[2].Type = "bool"
+	LoggingTLSConfigDoc.Fields[2].Note = ""
+	LoggingTLSConfigDoc.Fields[2].Description = "Skip TLS server certificate verification (not recommended)."
+	LoggingTLSConfigDoc.Fields[2].Comments[encoder.LineComment] = "Skip TLS server certificate verification (not recommended)."
 
 	KernelConfigDoc.Type = "KernelConfig"
 	KernelConfigDoc.Comments[encoder.LineComment] = "KernelConfig struct configures Talos Linux kernel."
@@ -2857,6 +3642,14 @@ func (_ TimeConfig) Doc() *encoder.Doc {
 	return &TimeConfigDoc
 }
 
+func (_ TimePTPConfig) Doc() *encoder.Doc {
+	return &TimePTPConfigDoc
+}
+
+func (_ TimeNTPServerConfig) Doc() *encoder.Doc {
+	return &TimeNTPServerConfigDoc
+}
+
 func (_ RegistriesConfig) Doc() *encoder.Doc {
 	return &RegistriesConfigDoc
 }
@@ -2913,6 +3706,10 @@ func (_ ExternalCloudProviderConfig) Doc() *encoder.Doc {
 	return &ExternalCloudProviderConfigDoc
 }
 
+func (_ KMSEncryptionConfig) Doc() *encoder.Doc {
+	return &KMSEncryptionConfigDoc
+}
+
 func (_ AdminKubeconfigConfig) Doc() *encoder.Doc {
 	return &AdminKubeconfigConfigDoc
 }
@@ -2941,6 +3738,14 @@ func (_ EncryptionKeyNodeID) Doc() *encoder.Doc {
 	return &EncryptionKeyNodeIDDoc
 }
 
+func (_ EncryptionKeyTPM) Doc() *encoder.Doc {
+	return &EncryptionKeyTPMDoc
+}
+
+func (_ EncryptionKeyKMS) Doc() *encoder.Doc {
+	return &EncryptionKeyKMSDoc
+}
+
 func (_ MachineFile) Doc() *encoder.Doc {
 	return &MachineFileDoc
 }
@@ -2957,6 +3762,10 @@ func (_ DHCPOptions) Doc() *encoder.Doc {
 	return &DHCPOptionsDoc
 }
 
+func (_ DeviceIPv6Config) Doc() *encoder.Doc {
+	return &DeviceIPv6ConfigDoc
+}
+
 func (_ DeviceWireguardConfig) Doc() *encoder.Doc {
 	return &DeviceWireguardConfigDoc
 }
@@ -2977,6 +3786,14 @@ func (_ VIPHCloudConfig) Doc() *encoder.Doc {
 	return &VIPHCloudConfigDoc
 }
 
+func (_ VIPBGPConfig) Doc() *encoder.Doc {
+	return &VIPBGPConfigDoc
+}
+
+func (_ VIPBGPPeer) Doc() *encoder.Doc {
+	return &VIPBGPPeerDoc
+}
+
 func (_ Bond) Doc() *encoder.Doc {
 	return &BondDoc
 }
@@ -2989,6 +3806,22 @@ func (_ Bridge) Doc() *encoder.Doc {
 	return &BridgeDoc
 }
 
+func (_ VRF) Doc() *encoder.Doc {
+	return &VRFDoc
+}
+
+func (_ VXLAN) Doc() *encoder.Doc {
+	return &VXLANDoc
+}
+
+func (_ MACVLAN) Doc() *encoder.Doc {
+	return &MACVLANDoc
+}
+
+func (_ IPVLAN) Doc() *encoder.Doc {
+	return &IPVLANDoc
+}
+
 func (_ Vlan) Doc() *encoder.Doc {
 	return &VlanDoc
 }
@@ -2997,6 +3830,26 @@ func (_ Route) Doc() *encoder.Doc {
 	return &RouteDoc
 }
 
+func (_ NetworkProbe) Doc() *encoder.Doc {
+	return &NetworkProbeDoc
+}
+
+func (_ NetworkProbeHTTP) Doc() *encoder.Doc {
+	return &NetworkProbeHTTPDoc
+}
+
+func (_ NetworkProbeDNS) Doc() *encoder.Doc {
+	return &NetworkProbeDNSDoc
+}
+
+func (_ RoutingRule) Doc() *encoder.Doc {
+	return &RoutingRuleDoc
+}
+
+func (_ Neighbor) Doc() *encoder.Doc {
+	return &NeighborDoc
+}
+
 func (_ RegistryMirrorConfig) Doc() *encoder.Doc {
 	return &RegistryMirrorConfigDoc
 }
@@ -3021,6 +3874,14 @@ func (_ FeaturesConfig) Doc() *encoder.Doc {
 	return &FeaturesConfigDoc
 }
 
+func (_ HostDNSConfig) Doc() *encoder.Doc {
+	return &HostDNSConfigDoc
+}
+
+func (_ HostDNSForwardDomain) Doc() *encoder.Doc {
+	return &HostDNSForwardDomainDoc
+}
+
 func (_ APIServerBalancer) Doc() *encoder.Doc {
 	return &APIServerBalancerDoc
 }
@@ -3037,6 +3898,10 @@ func (_ ClusterInlineManifest) Doc() *encoder.Doc {
 	return &ClusterInlineManifestDoc
 }
 
+func (_ NetworkLLDP) Doc() *encoder.Doc {
+	return &NetworkLLDPDoc
+}
+
 func (_ NetworkKubeSpan) Doc() *encoder.Doc {
 	return &NetworkKubeSpanDoc
 }
@@ -3077,6 +3942,10 @@ func (_ LoggingDestination) Doc() *encoder.Doc {
 	return &LoggingDestinationDoc
 }
 
+func (_ LoggingTLSConfig) Doc() *encoder.Doc {
+	return &LoggingTLSConfigDoc
+}
+
 func (_ KernelConfig) Doc() *encoder.Doc {
 	return &KernelConfigDoc
 }
@@ -3106,6 +3975,8 @@ func GetConfigurationDoc() *encoder.FileDoc {
 			&InstallDiskSelectorDoc,
 			&InstallExtensionConfigDoc,
 			&TimeConfigDoc,
+			&TimePTPConfigDoc,
+			&TimeNTPServerConfigDoc,
 			&RegistriesConfigDoc,
 			&PodCheckpointerDoc,
 			&CoreDNSDoc,
@@ -3120,6 +3991,7 @@ func GetConfigurationDoc() *encoder.FileDoc {
 			&ClusterNetworkConfigDoc,
 			&CNIConfigDoc,
 			&ExternalCloudProviderConfigDoc,
+			&KMSEncryptionConfigDoc,
 			&AdminKubeconfigConfigDoc,
 			&MachineDiskDoc,
 			&DiskPartitionDoc,
@@ -3127,30 +3999,47 @@ func GetConfigurationDoc() *encoder.FileDoc {
 			&EncryptionKeyDoc,
 			&EncryptionKeyStaticDoc,
 			&EncryptionKeyNodeIDDoc,
+			&EncryptionKeyTPMDoc,
+			&EncryptionKeyKMSDoc,
 			&MachineFileDoc,
 			&ExtraHostDoc,
 			&DeviceDoc,
 			&DHCPOptionsDoc,
+			&DeviceIPv6ConfigDoc,
 			&DeviceWireguardConfigDoc,
 			&DeviceWireguardPeerDoc,
 			&DeviceVIPConfigDoc,
 			&VIPEquinixMetalConfigDoc,
 			&VIPHCloudConfigDoc,
+			&VIPBGPConfigDoc,
+			&VIPBGPPeerDoc,
 			&BondDoc,
 			&STPDoc,
 			&BridgeDoc,
+			&VRFDoc,
+			&VXLANDoc,
+			&MACVLANDoc,
+			&IPVLANDoc,
 			&VlanDoc,
 			&RouteDoc,
+			&NetworkProbeDoc,
+			&NetworkProbeHTTPDoc,
+			&NetworkProbeDNSDoc,
+			&RoutingRuleDoc,
+			&NeighborDoc,
 			&RegistryMirrorConfigDoc,
 			&RegistryConfigDoc,
 			&RegistryAuthConfigDoc,
 			&RegistryTLSConfigDoc,
 			&SystemDiskEncryptionConfigDoc,
 			&FeaturesConfigDoc,
+			&HostDNSConfigDoc,
+			&HostDNSForwardDomainDoc,
 			&APIServerBalancerDoc,
 			&KubernetesTalosAPIAccessConfigDoc,
 			&VolumeMountConfigDoc,
 			&ClusterInlineManifestDoc,
+			&NetworkLLDPDoc,
 			&NetworkKubeSpanDoc,
 			&KubeSpanFiltersDoc,
 			&NetworkDeviceSelectorDoc,
@@ -3161,6 +4050,7 @@ func GetConfigurationDoc() *encoder.FileDoc {
 			&UdevConfigDoc,
 			&LoggingConfigDoc,
 			&LoggingDestinationDoc,
+			&LoggingTLSConfigDoc,
 			&KernelConfigDoc,
 			&KernelModuleConfigDoc,
 		},
@@ -9,12 +9,15 @@ import (
 	"errors"
 	"fmt"
 	"net"
+	"net/netip"
 	"net/url"
 	"os"
+	"path/filepath"
 	"reflect"
 	"regexp"
 	"strconv"
 	"strings"
+	"time"
 
 	"github.com/hashicorp/go-multierror"
 	sideronet "github.com/siderolabs/net"
@@ -187,10 +190,24 @@ func (c *Config) Validate(mode validation.RuntimeMode, options ...validation.Opt
 			}
 		}
 
+		rulePriorities := map[string]string{}
+
 		for _, device := range c.MachineConfig.MachineNetwork.NetworkInterfaces {
-			warn, err := ValidateNetworkDevices(device, bondedInterfaces, CheckDeviceInterface, CheckDeviceAddressing, CheckDeviceRoutes)
+			warn, err := ValidateNetworkDevices(device, bondedInterfaces, CheckDeviceInterface, CheckDeviceAddressing, CheckDeviceRoutes, CheckDeviceRules, CheckDeviceNeighbors)
 			warnings = append(warnings, warn...)
 			result = multierror.Append(result, err)
+
+			for _, rule := range device.DeviceRules {
+				for _, family := range routingRuleFamilies(rule) {
+					key := fmt.Sprintf("%s/%d", family, rule.RulePriority)
+
+					if otherIface, exists := rulePriorities[key]; exists {
+						result = multierror.Append(result, fmt.Errorf("routing rule priority %d (%s) is declared twice: on %q and %q", rule.RulePriority, family, otherIface, device.Interface()))
+					}
+
+					rulePriorities[key] = device.Interface()
+				}
+			}
 		}
 
 		if c.Machine().Network().KubeSpan().Enabled() {
@@ -198,6 +215,10 @@ func (c *Config) Validate(mode validation.RuntimeMode, options ...validation.Opt
 				result = multierror.Append(result, fmt.Errorf("kubespan link MTU must be at least %d", constants.KubeSpanLinkMinimumMTU))
 			}
 		}
+
+		if interval := c.Machine().Network().LLDP().TransmitInterval(); interval < time.Second || interval > time.Hour {
+			result = multierror.Append(result, fmt.Errorf("lldp transmit interval should be between 1s and 1h: %s", interval))
+		}
 	}
 
 	if c.MachineConfig.MachineDisks != nil {
@@ -231,9 +252,15 @@ func (c *Config) Validate(mode validation.RuntimeMode, options ...validation.Opt
 
 				slotsInUse[key.Slot()] = true
 
-				if key.NodeID() == nil && key.Static() == nil {
+				if key.NodeID() == nil && key.Static() == nil && key.TPM() == nil && key.KMS() == nil {
 					result = multierror.Append(result, fmt.Errorf("encryption key at slot %d doesn't have any settings", key.Slot()))
 				}
+
+				if key.KMS() != nil {
+					if err := validateKMSEndpoint(key.KMS().Endpoint()); err != nil {
+						result = multierror.Append(result, fmt.Errorf("encryption key at slot %d has invalid KMS endpoint %q: %w", key.Slot(), key.KMS().Endpoint(), err))
+					}
+				}
 			}
 		}
 	}
@@ -265,6 +292,10 @@ func (c *Config) Validate(mode validation.RuntimeMode, options ...validation.Opt
 		result = multierror.Append(result, err)
 	}
 
+	if c.MachineConfig.MachineTime != nil {
+		result = multierror.Append(result, c.MachineConfig.MachineTime.Validate())
+	}
+
 	if c.MachineConfig.MachineInstall != nil {
 		extensions := map[string]struct{}{}
 
@@ -297,6 +328,30 @@ func (c *Config) Validate(mode validation.RuntimeMode, options ...validation.Opt
 		}
 	}
 
+	if hostDNS := c.Machine().Features().HostDNS(); hostDNS.ForwardKubeDNSToHost() && !hostDNS.Enabled() {
+		result = multierror.Append(result, fmt.Errorf("feature host DNS should be enabled when forwarding Kubernetes DNS to host"))
+	}
+
+	for _, forward := range c.Machine().Features().HostDNS().ForwardDomains() {
+		if !isValidDNSName(forward.Domain()) {
+			result = multierror.Append(result, fmt.Errorf("host DNS forward domain %q is not a valid DNS name", forward.Domain()))
+		}
+
+		if len(forward.Servers()) == 0 {
+			result = multierror.Append(result, fmt.Errorf("host DNS forward domain %q: %w", forward.Domain(), ErrRequiredSection))
+		}
+
+		for _, server := range forward.Servers() {
+			if _, err := netip.ParseAddrPort(server); err == nil {
+				continue
+			}
+
+			if _, err := netip.ParseAddr(server); err != nil {
+				result = multierror.Append(result, fmt.Errorf("host DNS forward domain %q: invalid server %q", forward.Domain(), server))
+			}
+		}
+	}
+
 	if opts.Strict {
 		for _, w := range warnings {
 			result = multierror.Append(result, fmt.Errorf("warning: %s", w))
@@ -348,6 +403,10 @@ func (c *ClusterConfig) Validate() error {
 		result = multierror.Append(result, c.EtcdConfig.Validate())
 	}
 
+	if kms := c.ClusterKMSEncryptionConfig; kms != nil {
+		result = multierror.Append(result, kms.Validate())
+	}
+
 	result = multierror.Append(result, c.ClusterInlineManifests.Validate(), c.ClusterDiscoveryConfig.Validate(c))
 
 	return result.ErrorOrNil()
@@ -407,6 +466,32 @@ func (ecp *ExternalCloudProviderConfig) Validate() error {
 	return result.ErrorOrNil()
 }
 
+// Validate validates KMS encryption configuration.
+func (kms *KMSEncryptionConfig) Validate() error {
+	var result *multierror.Error
+
+	if strings.Contains(kms.Name(), ":") {
+		result = multierror.Append(result, fmt.Errorf("KMS provider name %q should not contain ':'", kms.Name()))
+	}
+
+	socketPath, ok := strings.CutPrefix(kms.Endpoint(), "unix://")
+	if !ok {
+		result = multierror.Append(result, fmt.Errorf("KMS endpoint %q should be a UNIX socket", kms.Endpoint()))
+	} else if filepath.Dir(socketPath) != constants.KubernetesKMSPluginSocketDir {
+		result = multierror.Append(result, fmt.Errorf("KMS endpoint %q should be a socket in %q", kms.Endpoint(), constants.KubernetesKMSPluginSocketDir))
+	}
+
+	if kms.KMSTimeout < 0 {
+		result = multierror.Append(result, fmt.Errorf("KMS timeout should not be negative"))
+	}
+
+	if kms.KMSPluginImage == "" && len(kms.KMSPluginExtraArgs) > 0 {
+		result = multierror.Append(result, fmt.Errorf("KMS plugin extra args require the KMS plugin image to be set"))
+	}
+
+	return result.ErrorOrNil()
+}
+
 // Validate the inline manifests.
 func (manifests ClusterInlineManifests) Validate() error {
 	var result *multierror.Error
@@ -514,9 +599,118 @@ func CheckDeviceInterface(d *Device, _ map[string]string) ([]string, error) {
 		result = multierror.Append(result, checkVlans(d))
 	}
 
+	if d.DeviceVIPConfig != nil && d.DeviceVIPConfig.VIPProbe != nil {
+		result = multierror.Append(result, checkNetworkProbe("networking.os.device.vip.probe", d.DeviceVIPConfig.VIPProbe))
+	}
+
+	if d.DeviceVIPConfig != nil {
+		result = multierror.Append(result, checkVIPBGP("networking.os.device.vip", d.DeviceVIPConfig))
+	}
+
+	if d.DeviceVRF != nil || d.DeviceVXLAN != nil || d.DeviceMACVLAN != nil || d.DeviceIPVLAN != nil {
+		kinds := 0
+
+		for _, set := range []bool{
+			d.DeviceBond != nil,
+			d.DeviceBridge != nil,
+			d.DeviceWireguardConfig != nil,
+			d.Dummy(),
+			d.DeviceVRF != nil,
+			d.DeviceVXLAN != nil,
+			d.DeviceMACVLAN != nil,
+			d.DeviceIPVLAN != nil,
+		} {
+			if set {
+				kinds++
+			}
+		}
+
+		if kinds > 1 {
+			result = multierror.Append(result, fmt.Errorf("[%s] %q: %w", "networking.os.device", d.DeviceInterface, ErrMutuallyExclusive))
+		}
+	}
+
+	if d.DeviceVRF != nil {
+		result = multierror.Append(result, checkVRF(d.DeviceVRF))
+	}
+
+	if d.DeviceVXLAN != nil {
+		result = multierror.Append(result, checkVXLAN(d.DeviceVXLAN))
+	}
+
+	if d.DeviceMACVLAN != nil {
+		if d.DeviceMACVLAN.MACVLANParent == "" {
+			result = multierror.Append(result, fmt.Errorf("[%s]: %w", "networking.os.device.macvlan.parent", ErrRequiredSection))
+		}
+
+		if _, err := nethelpers.MACVLANModeByName(d.DeviceMACVLAN.MACVLANMode); err != nil {
+			result = multierror.Append(result, err)
+		}
+	}
+
+	if d.DeviceIPVLAN != nil {
+		if d.DeviceIPVLAN.IPVLANParent == "" {
+			result = multierror.Append(result, fmt.Errorf("[%s]: %w", "networking.os.device.ipvlan.parent", ErrRequiredSection))
+		}
+
+		if _, err := nethelpers.IPVLANModeByName(d.DeviceIPVLAN.IPVLANMode); err != nil {
+			result = multierror.Append(result, err)
+		}
+	}
+
+	if d.DeviceIPv6 != nil {
+		if _, err := nethelpers.AddrGenModeByName(d.DeviceIPv6.IPv6AddrGenMode); err != nil {
+			result = multierror.Append(result, err)
+		}
+	}
+
 	return nil, result.ErrorOrNil()
 }
 
+func checkVRF(v *VRF) error {
+	switch nethelpers.RoutingTable(v.VRFTable) {
+	case nethelpers.TableUnspec, nethelpers.TableDefault, nethelpers.TableMain, nethelpers.TableLocal:
+		return fmt.Errorf("vrf.table %d is reserved", v.VRFTable)
+	default:
+		return nil
+	}
+}
+
+// maxVXLANID is the maximum VXLAN network identifier (24 bits).
+const maxVXLANID = 1<<24 - 1
+
+func checkVXLAN(v *VXLAN) error {
+	var result *multierror.Error
+
+	if v.VXLANID == 0 || v.VXLANID > maxVXLANID {
+		result = multierror.Append(result, fmt.Errorf("vxlan.id should be in range 1-%d", maxVXLANID))
+	}
+
+	var local, remote netip.Addr
+
+	if v.VXLANLocal != "" {
+		var err error
+
+		if local, err = netip.ParseAddr(v.VXLANLocal); err != nil {
+			result = multierror.Append(result, fmt.Errorf("[%s] %q: %w", "networking.os.device.vxlan.local", v.VXLANLocal, ErrInvalidAddress))
+		}
+	}
+
+	if v.VXLANRemote != "" {
+		var err error
+
+		if remote, err = netip.ParseAddr(v.VXLANRemote); err != nil {
+			result = multierror.Append(result, fmt.Errorf("[%s] %q: %w", "networking.os.device.vxlan.remote", v.VXLANRemote, ErrInvalidAddress))
+		}
+	}
+
+	if local.IsValid() && remote.IsValid() && local.Is4() != remote.Is4() {
+		result = multierror.Append(result, fmt.Errorf("vxlan.local and vxlan.remote should be of the same address family"))
+	}
+
+	return result.ErrorOrNil()
+}
+
 //nolint:gocyclo,cyclop
 func checkBond(b *Bond) error {
 	var result *multierror.Error
@@ -684,6 +878,130 @@ func checkVlans(d *Device) error {
 				result = multierror.Append(result, fmt.Errorf("[%s] %s.%d: %w", "networking.os.device.vlan.addresses", d.DeviceInterface, vlan.VlanID, err))
 			}
 		}
+
+		if vlan.VlanVIP != nil && vlan.VlanVIP.VIPProbe != nil {
+			result = multierror.Append(result, checkNetworkProbe("networking.os.device.vlan.vip.probe", vlan.VlanVIP.VIPProbe))
+		}
+
+		if vlan.VlanVIP != nil {
+			result = multierror.Append(result, checkVIPBGP("networking.os.device.vlan.vip", vlan.VlanVIP))
+		}
+	}
+
+	return result.ErrorOrNil()
+}
+
+func checkNetworkProbe(path string, p *NetworkProbe) error {
+	var result *multierror.Error
+
+	targets := 0
+
+	for _, set := range []bool{
+		p.ProbeTCP != "",
+		p.ProbeICMP != "",
+		p.ProbeHTTP != nil,
+		p.ProbeDNS != nil,
+	} {
+		if set {
+			targets++
+		}
+	}
+
+	switch {
+	case targets == 0:
+		result = multierror.Append(result, fmt.Errorf("[%s]: %w", path, ErrRequiredSectionOptions))
+	case targets > 1:
+		result = multierror.Append(result, fmt.Errorf("[%s]: %w", path, ErrMutuallyExclusive))
+	}
+
+	if p.ProbeInterval < 0 {
+		result = multierror.Append(result, fmt.Errorf("[%s] %s: interval should be positive", path+".interval", p.ProbeInterval))
+	}
+
+	if p.ProbeTimeout < 0 {
+		result = multierror.Append(result, fmt.Errorf("[%s] %s: timeout should be positive", path+".timeout", p.ProbeTimeout))
+	}
+
+	if p.ProbeFailureThreshold < 0 {
+		result = multierror.Append(result, fmt.Errorf("[%s] %d: failure threshold should be positive", path+".failureThreshold", p.ProbeFailureThreshold))
+	}
+
+	if p.ProbeTCP != "" {
+		if _, _, err := net.SplitHostPort(p.ProbeTCP); err != nil {
+			result = multierror.Append(result, fmt.Errorf("[%s] %q: %w", path+".tcp", p.ProbeTCP, err))
+		}
+	}
+
+	if p.ProbeHTTP != nil {
+		if u, err := url.Parse(p.ProbeHTTP.HTTPURL); err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+			result = multierror.Append(result, fmt.Errorf("[%s] %q: should be a valid http(s) URL", path+".http.url", p.ProbeHTTP.HTTPURL))
+		}
+
+		if p.ProbeHTTP.HTTPExpectedStatus != 0 && (p.ProbeHTTP.HTTPExpectedStatus < 100 || p.ProbeHTTP.HTTPExpectedStatus > 599) {
+			result = multierror.Append(result, fmt.Errorf("[%s] %d: invalid HTTP status code", path+".http.expectedStatus", p.ProbeHTTP.HTTPExpectedStatus))
+		}
+	}
+
+	if p.ProbeDNS != nil {
+		if p.ProbeDNS.DNSName == "" {
+			result = multierror.Append(result, fmt.Errorf("[%s]: %w", path+".dns.name", ErrRequiredSection))
+		}
+
+		if p.ProbeDNS.DNSServer != "" {
+			if _, _, err := net.SplitHostPort(p.ProbeDNS.DNSServer); err != nil {
+				result = multierror.Append(result, fmt.Errorf("[%s] %q: %w", path+".dns.server", p.ProbeDNS.DNSServer, err))
+			}
+		}
+	}
+
+	return result.ErrorOrNil()
+}
+
+//nolint:gocyclo
+func checkVIPBGP(path string, v *DeviceVIPConfig) error {
+	if v.BGPConfig == nil {
+		return nil
+	}
+
+	var result *multierror.Error
+
+	if v.EquinixMetalConfig != nil || v.HCloudConfig != nil {
+		result = multierror.Append(result, fmt.Errorf("[%s]: %w", path+".bgp", ErrMutuallyExclusive))
+	}
+
+	// the VIP itself is validated in CheckDeviceAddressing
+	vip, _ := netip.ParseAddr(v.SharedIP) //nolint:errcheck
+
+	if v.BGPConfig.BGPLocalASN == 0 {
+		result = multierror.Append(result, fmt.Errorf("[%s]: %w", path+".bgp.localASN", ErrRequiredSection))
+	}
+
+	if v.BGPConfig.BGPRouterID != "" {
+		if routerID, err := netip.ParseAddr(v.BGPConfig.BGPRouterID); err != nil || !routerID.Is4() {
+			result = multierror.Append(result, fmt.Errorf("[%s] %q: router ID should be an IPv4 address", path+".bgp.routerID", v.BGPConfig.BGPRouterID))
+		}
+	} else if vip.Is6() {
+		result = multierror.Append(result, fmt.Errorf("[%s]: router ID is required for IPv6 VIP", path+".bgp.routerID"))
+	}
+
+	if len(v.BGPConfig.BGPPeers) == 0 {
+		result = multierror.Append(result, fmt.Errorf("[%s]: %w", path+".bgp.peers", ErrRequiredSection))
+	}
+
+	for _, peer := range v.BGPConfig.BGPPeers {
+		if address, err := netip.ParseAddr(peer.PeerAddress); err != nil {
+			result = multierror.Append(result, fmt.Errorf("[%s] %q: %w", path+".bgp.peers.address", peer.PeerAddress, err))
+		} else if vip.IsValid() && address.Is4() != vip.Is4() {
+			result = multierror.Append(result, fmt.Errorf("[%s] %q: peer address family should match the VIP", path+".bgp.peers.address", peer.PeerAddress))
+		}
+
+		if peer.PeerASN == 0 {
+			result = multierror.Append(result, fmt.Errorf("[%s] %q: %w", path+".bgp.peers.asn", peer.PeerAddress, ErrRequiredSection))
+		}
+
+		if len(peer.PeerPassword) > 80 {
+			result = multierror.Append(result, fmt.Errorf("[%s] %q: password should be at most 80 characters", path+".bgp.peers.password", peer.PeerAddress))
+		}
 	}
 
 	return result.ErrorOrNil()
@@ -789,6 +1107,146 @@ func CheckDeviceRoutes(d *Device, _ map[string]string) ([]string, error) {
 				result = multierror.Append(result, fmt.Errorf("[%s] %q: %w", "networking.os.device.route["+strconv.Itoa(idx)+"].source", route.Source(), ErrInvalidAddress))
 			}
 		}
+
+		if route.RouteProbe != nil {
+			result = multierror.Append(result, checkNetworkProbe("networking.os.device.route["+strconv.Itoa(idx)+"].probe", route.RouteProbe))
+		}
+	}
+
+	return nil, result.ErrorOrNil()
+}
+
+// CheckDeviceRules ensures that the specified policy routing rules are valid.
+//
+//nolint:gocyclo
+func CheckDeviceRules(d *Device, _ map[string]string) ([]string, error) {
+	var result *multierror.Error
+
+	if d == nil {
+		return nil, fmt.Errorf("empty device")
+	}
+
+	for idx, rule := range d.DeviceRules {
+		path := "networking.os.device.rules[" + strconv.Itoa(idx) + "]"
+
+		if rule.RulePriority == 0 || rule.RulePriority >= maxRoutingRulePriority {
+			result = multierror.Append(result, fmt.Errorf("[%s] %d: priority should be in range 1-%d", path+".priority", rule.RulePriority, maxRoutingRulePriority-1))
+		}
+
+		if rule.RuleTable == 0 {
+			result = multierror.Append(result, fmt.Errorf("[%s]: %w", path+".table", ErrRequiredSection))
+		}
+
+		var from, to netip.Prefix
+
+		if rule.RuleFrom != "" {
+			var err error
+
+			if from, err = netip.ParsePrefix(rule.RuleFrom); err != nil {
+				result = multierror.Append(result, fmt.Errorf("[%s] %q: %w", path+".from", rule.RuleFrom, ErrInvalidAddress))
+			}
+		}
+
+		if rule.RuleTo != "" {
+			var err error
+
+			if to, err = netip.ParsePrefix(rule.RuleTo); err != nil {
+				result = multierror.Append(result, fmt.Errorf("[%s] %q: %w", path+".to", rule.RuleTo, ErrInvalidAddress))
+			}
+		}
+
+		if from.IsValid() && to.IsValid() && from.Addr().Is4() != to.Addr().Is4() {
+			result = multierror.Append(result, fmt.Errorf("[%s]: from and to should be of the same address family", path))
+		}
+
+		if rule.RuleFwMask != 0 && rule.RuleFwMark == 0 {
+			result = multierror.Append(result, fmt.Errorf("[%s]: fwMask requires fwMark to be set", path))
+		}
+	}
+
+	return nil, result.ErrorOrNil()
+}
+
+// maxRoutingRulePriority is the priority of the first default kernel rule (lookup main).
+const maxRoutingRulePriority = 32766
+
+// routingRuleFamilies returns address families the rule applies to.
+//
+// Rules without source and destination prefixes apply to both address families.
+func routingRuleFamilies(rule *RoutingRule) []nethelpers.Family {
+	for _, prefix := range []string{rule.RuleFrom, rule.RuleTo} {
+		if p, err := netip.ParsePrefix(prefix); err == nil {
+			if p.Addr().Is4() {
+				return []nethelpers.Family{nethelpers.FamilyInet4}
+			}
+
+			return []nethelpers.Family{nethelpers.FamilyInet6}
+		}
+	}
+
+	return []nethelpers.Family{nethelpers.FamilyInet4, nethelpers.FamilyInet6}
+}
+
+// validateKMSEndpoint checks the endpoint of the KMS server which seals the disk encryption key.
+//
+// The endpoint is validated strictly, as the invalid endpoint is otherwise only detected on boot, when the disk can't be unlocked.
+// The KMS server is dialed over gRPC at the host of the endpoint: https enables TLS, http is allowed for the KMS servers
+// which don't terminate TLS themselves (the reference talos-kms server runs without TLS unless a certificate is given).
+func validateKMSEndpoint(endpoint string) error {
+	u, err := url.Parse(endpoint)
+	if err != nil {
+		return err
+	}
+
+	if u.Scheme != "https" && u.Scheme != "http" {
+		return fmt.Errorf("scheme should be https or http")
+	}
+
+	if u.Host == "" {
+		return fmt.Errorf("host should be set")
+	}
+
+	return nil
+}
+
+// CheckDeviceNeighbors ensures that the specified neighbor entries are valid.
+func CheckDeviceNeighbors(d *Device, _ map[string]string) ([]string, error) {
+	var result *multierror.Error
+
+	if d == nil {
+		return nil, fmt.Errorf("empty device")
+	}
+
+	seen := map[string]struct{}{}
+
+	for idx, neighbor := range d.DeviceNeighbors {
+		path := "networking.os.device.neighbors[" + strconv.Itoa(idx) + "]"
+
+		addr, err := netip.ParseAddr(neighbor.NeighborIP)
+		if err != nil {
+			result = multierror.Append(result, fmt.Errorf("[%s] %q: %w", path+".ip", neighbor.NeighborIP, ErrInvalidAddress))
+		}
+
+		switch {
+		case neighbor.NeighborProxy && neighbor.NeighborLinkAddr != "":
+			result = multierror.Append(result, fmt.Errorf("[%s]: lladdr can't be set for a proxy entry", path))
+		case !neighbor.NeighborProxy && neighbor.NeighborLinkAddr == "":
+			result = multierror.Append(result, fmt.Errorf("[%s]: %w", path+".lladdr", ErrRequiredSection))
+		case neighbor.NeighborLinkAddr != "":
+			if _, err = net.ParseMAC(neighbor.NeighborLinkAddr); err != nil {
+				result = multierror.Append(result, fmt.Errorf("[%s] %q: invalid link-layer address", path+".lladdr", neighbor.NeighborLinkAddr))
+			}
+		}
+
+		if addr.IsValid() {
+			key := fmt.Sprintf("%s/%t", addr, neighbor.NeighborProxy)
+
+			if _, exists := seen[key]; exists {
+				result = multierror.Append(result, fmt.Errorf("[%s] %q: duplicate neighbor entry", path+".ip", neighbor.NeighborIP))
+			}
+
+			seen[key] = struct{}{}
+		}
 	}
 
 	return nil, result.ErrorOrNil()
@@ -847,3 +1305,67 @@ func (e *EtcdConfig) Validate() error {
 
 	return result.ErrorOrNil()
 }
+
+// Validate time configuration.
+func (t *TimeConfig) Validate() error {
+	var result *multierror.Error
+
+	for _, server := range t.TimeServers {
+		if !strings.Contains(server, "://") {
+			continue
+		}
+
+		u, err := url.Parse(server)
+		if err != nil {
+			result = multierror.Append(result, fmt.Errorf("time server %q is invalid: %w", server, err))
+
+			continue
+		}
+
+		if u.Scheme != "nts" {
+			result = multierror.Append(result, fmt.Errorf("time server %q has unsupported scheme %q", server, u.Scheme))
+
+			continue
+		}
+
+		if u.Hostname() == "" || u.User != nil || (u.Path != "" && u.Path != "/") || u.RawQuery != "" {
+			result = multierror.Append(result, fmt.Errorf("time server %q should be in nts://host[:port] format", server))
+		}
+	}
+
+	hasNTS := false
+
+	for _, server := range t.TimeServers {
+		hasNTS = hasNTS || strings.HasPrefix(server, "nts://")
+	}
+
+	if t.NTSFallback() && !hasNTS {
+		result = multierror.Append(result, fmt.Errorf("time NTS fallback requires at least one nts:// server"))
+	}
+
+	if t.TimeNTSNoCertTimeCheck < 0 {
+		result = multierror.Append(result, fmt.Errorf("time NTS certificate time check skips should not be negative"))
+	} else if t.TimeNTSNoCertTimeCheck > 0 && !hasNTS {
+		result = multierror.Append(result, fmt.Errorf("time NTS certificate time check skips require at least one nts:// server"))
+	}
+
+	if t.NTPServer().Enabled() && t.Disabled() {
+		result = multierror.Append(result, fmt.Errorf("time NTP server requires time sync to be enabled"))
+	}
+
+	if t.PTP().Enabled() {
+		if t.Disabled() {
+			result = multierror.Append(result, fmt.Errorf("time PTP sync requires time sync to be enabled"))
+		}
+
+		if t.PTP().Device() != "" && t.PTP().Interface() != "" {
+			result = multierror.Append(result, fmt.Errorf("time PTP device and interface are mutually exclusive"))
+		}
+
+		if t.PTP().PollInterval() < 0 {
+			result = multierror.Append(result, fmt.Errorf("time PTP poll interval should be positive"))
+		}
+	}
+
+	return result.ErrorOrNil()
+}
@@ -9,6 +9,7 @@ import (
 	"net/url"
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/siderolabs/crypto/x509"
 	"github.com/siderolabs/go-pointer"
@@ -188,6 +189,187 @@ func TestValidate(t *testing.T) {
 			requiresInstall: true,
 			expectedError:   "1 error occurred:\n\t* duplicate system extension \"ghcr.io/siderolabs/gvisor:v0.1.0\"\n\n",
 		},
+		{
+			name: "KMSEncryptionDefaults",
+			config: &v1alpha1.Config{
+				ConfigVersion: "v1alpha1",
+				MachineConfig: &v1alpha1.MachineConfig{
+					MachineType: "controlplane",
+				},
+				ClusterConfig: &v1alpha1.ClusterConfig{
+					ControlPlane: &v1alpha1.ControlPlaneConfig{
+						Endpoint: &v1alpha1.Endpoint{
+							endpointURL,
+						},
+					},
+					ClusterKMSEncryptionConfig: &v1alpha1.KMSEncryptionConfig{
+						KMSEnabled: pointer.To(true),
+					},
+				},
+			},
+		},
+		{
+			name: "KMSEncryptionInvalid",
+			config: &v1alpha1.Config{
+				ConfigVersion: "v1alpha1",
+				MachineConfig: &v1alpha1.MachineConfig{
+					MachineType: "controlplane",
+				},
+				ClusterConfig: &v1alpha1.ClusterConfig{
+					ControlPlane: &v1alpha1.ControlPlaneConfig{
+						Endpoint: &v1alpha1.Endpoint{
+							endpointURL,
+						},
+					},
+					ClusterKMSEncryptionConfig: &v1alpha1.KMSEncryptionConfig{
+						KMSEnabled:  pointer.To(true),
+						KMSName:     "vault:v2",
+						KMSEndpoint: "unix:///var/run/vault.sock",
+						KMSTimeout:  -time.Second,
+					},
+				},
+			},
+			expectedError: "3 errors occurred:\n\t* KMS provider name \"vault:v2\" should not contain ':'\n\t* KMS endpoint \"unix:///var/run/vault.sock\" should be a socket in \"/var/run/kmsplugin\"\n\t* KMS timeout should not be negative\n\n",
+		},
+		{
+			name: "KMSEncryptionTCPEndpoint",
+			config: &v1alpha1.Config{
+				ConfigVersion: "v1alpha1",
+				MachineConfig: &v1alpha1.MachineConfig{
+					MachineType: "controlplane",
+				},
+				ClusterConfig: &v1alpha1.ClusterConfig{
+					ControlPlane: &v1alpha1.ControlPlaneConfig{
+						Endpoint: &v1alpha1.Endpoint{
+							endpointURL,
+						},
+					},
+					ClusterKMSEncryptionConfig: &v1alpha1.KMSEncryptionConfig{
+						KMSEnabled:  pointer.To(true),
+						KMSEndpoint: "tcp://127.0.0.1:8080",
+					},
+				},
+			},
+			expectedError: "1 error occurred:\n\t* KMS endpoint \"tcp://127.0.0.1:8080\" should be a UNIX socket\n\n",
+		},
+		{
+			name: "KMSEncryptionPluginArgsWithoutImage",
+			config: &v1alpha1.Config{
+				ConfigVersion: "v1alpha1",
+				MachineConfig: &v1alpha1.MachineConfig{
+					MachineType: "controlplane",
+				},
+				ClusterConfig: &v1alpha1.ClusterConfig{
+					ControlPlane: &v1alpha1.ControlPlaneConfig{
+						Endpoint: &v1alpha1.Endpoint{
+							endpointURL,
+						},
+					},
+					ClusterKMSEncryptionConfig: &v1alpha1.KMSEncryptionConfig{
+						KMSEnabled: pointer.To(true),
+						KMSPluginExtraArgs: map[string]string{
+							"listen-addr": "unix:///var/run/kmsplugin/kms.sock",
+						},
+					},
+				},
+			},
+			expectedError: "1 error occurred:\n\t* KMS plugin extra args require the KMS plugin image to be set\n\n",
+		},
+		{
+			name: "DiskEncryptionKMS",
+			config: &v1alpha1.Config{
+				ConfigVersion: "v1alpha1",
+				MachineConfig: &v1alpha1.MachineConfig{
+					MachineType: "worker",
+					MachineSystemDiskEncryption: &v1alpha1.SystemDiskEncryptionConfig{
+						EphemeralPartition: &v1alpha1.EncryptionConfig{
+							EncryptionProvider: "luks2",
+							EncryptionKeys: []*v1alpha1.EncryptionKey{
+								{
+									KeySlot: 0,
+									KeyKMS: &v1alpha1.EncryptionKeyKMS{
+										KMSEndpoint: "https://192.168.88.21:4443",
+									},
+								},
+								{
+									KeySlot: 1,
+									KeyKMS: &v1alpha1.EncryptionKeyKMS{
+										KMSEndpoint: "http://kms.example.com:4050",
+									},
+								},
+							},
+						},
+					},
+				},
+				ClusterConfig: &v1alpha1.ClusterConfig{
+					ControlPlane: &v1alpha1.ControlPlaneConfig{
+						Endpoint: &v1alpha1.Endpoint{
+							endpointURL,
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "DiskEncryptionKMSInvalidEndpoint",
+			config: &v1alpha1.Config{
+				ConfigVersion: "v1alpha1",
+				MachineConfig: &v1alpha1.MachineConfig{
+					MachineType: "worker",
+					MachineSystemDiskEncryption: &v1alpha1.SystemDiskEncryptionConfig{
+						EphemeralPartition: &v1alpha1.EncryptionConfig{
+							EncryptionProvider: "luks2",
+							EncryptionKeys: []*v1alpha1.EncryptionKey{
+								{
+									KeySlot: 0,
+									KeyKMS: &v1alpha1.EncryptionKeyKMS{
+										KMSEndpoint: "",
+									},
+								},
+								{
+									KeySlot: 1,
+									KeyKMS: &v1alpha1.EncryptionKeyKMS{
+										KMSEndpoint: "kms.example.com:4050",
+									},
+								},
+								{
+									KeySlot: 2,
+									KeyKMS: &v1alpha1.EncryptionKeyKMS{
+										KMSEndpoint: "foo",
+									},
+								},
+								{
+									KeySlot: 3,
+									KeyKMS: &v1alpha1.EncryptionKeyKMS{
+										KMSEndpoint: "/path",
+									},
+								},
+								{
+									KeySlot: 4,
+									KeyKMS: &v1alpha1.EncryptionKeyKMS{
+										KMSEndpoint: "grpc://kms.example.com:4050",
+									},
+								},
+								{
+									KeySlot: 5,
+									KeyKMS: &v1alpha1.EncryptionKeyKMS{
+										KMSEndpoint: "https:///path",
+									},
+								},
+							},
+						},
+					},
+				},
+				ClusterConfig: &v1alpha1.ClusterConfig{
+					ControlPlane: &v1alpha1.ControlPlaneConfig{
+						Endpoint: &v1alpha1.Endpoint{
+							endpointURL,
+						},
+					},
+				},
+			},
+			expectedError: "6 errors occurred:\n\t* encryption key at slot 0 has invalid KMS endpoint \"\": scheme should be https or http\n\t* encryption key at slot 1 has invalid KMS endpoint \"kms.example.com:4050\": scheme should be https or http\n\t* encryption key at slot 2 has invalid KMS endpoint \"foo\": scheme should be https or http\n\t* encryption key at slot 3 has invalid KMS endpoint \"/path\": scheme should be https or http\n\t* encryption key at slot 4 has invalid KMS endpoint \"grpc://kms.example.com:4050\": scheme should be https or http\n\t* encryption key at slot 5 has invalid KMS endpoint \"https:///path\": host should be set\n\n",
+		},
 		{
 			name: "ExternalCloudProviderEnabled",
 			config: &v1alpha1.Config{
@@ -825,6 +1007,362 @@ func TestValidate(t *testing.T) {
 			},
 			expectedError: "1 error occurred:\n\t* interface \"eth1\" is declared as part of an interface and a bond: \"br0\" and \"bond0\"\n\n",
 		},
+		{
+			name: "LinkKinds",
+			config: &v1alpha1.Config{
+				ConfigVersion: "v1alpha1",
+				MachineConfig: &v1alpha1.MachineConfig{
+					MachineType: "controlplane",
+					MachineNetwork: &v1alpha1.NetworkConfig{
+						NetworkInterfaces: []*v1alpha1.Device{
+							{
+								DeviceInterface: "vrf0",
+								DeviceVRF: &v1alpha1.VRF{
+									VRFInterfaces: []string{"eth0"},
+									VRFTable:      254,
+								},
+							},
+							{
+								DeviceInterface: "vxlan0",
+								DeviceVXLAN: &v1alpha1.VXLAN{
+									VXLANID:     1 << 24,
+									VXLANLocal:  "10.0.0.1",
+									VXLANRemote: "2001:db8::1",
+								},
+							},
+							{
+								DeviceInterface: "macvlan0",
+								DeviceMACVLAN: &v1alpha1.MACVLAN{
+									MACVLANMode: "foo",
+								},
+							},
+							{
+								DeviceInterface: "ipvlan0",
+								DeviceIPVLAN: &v1alpha1.IPVLAN{
+									IPVLANParent: "eth0",
+									IPVLANMode:   "l3s",
+								},
+								DeviceDummy: pointer.To(true),
+							},
+						},
+					},
+				},
+				ClusterConfig: &v1alpha1.ClusterConfig{
+					ControlPlane: &v1alpha1.ControlPlaneConfig{
+						Endpoint: &v1alpha1.Endpoint{
+							endpointURL,
+						},
+					},
+				},
+			},
+			expectedError: "6 errors occurred:\n\t* vrf.table 254 is reserved\n\t* vxlan.id should be in range 1-16777215\n" +
+				"\t* vxlan.local and vxlan.remote should be of the same address family\n" +
+				"\t* [networking.os.device.macvlan.parent]: required config section\n\t* invalid macvlan mode foo\n" +
+				"\t* [networking.os.device] \"ipvlan0\": config sections are mutually exclusive\n\n",
+		},
+		{
+			name: "DeviceIPv6",
+			config: &v1alpha1.Config{
+				ConfigVersion: "v1alpha1",
+				MachineConfig: &v1alpha1.MachineConfig{
+					MachineType: "controlplane",
+					MachineNetwork: &v1alpha1.NetworkConfig{
+						NetworkInterfaces: []*v1alpha1.Device{
+							{
+								DeviceInterface: "eth0",
+								DeviceIPv6: &v1alpha1.DeviceIPv6Config{
+									IPv6AddrGenMode: "stable-privacy",
+								},
+							},
+							{
+								DeviceInterface: "eth1",
+								DeviceIPv6: &v1alpha1.DeviceIPv6Config{
+									IPv6AddrGenMode: "eui48",
+								},
+							},
+						},
+					},
+				},
+				ClusterConfig: &v1alpha1.ClusterConfig{
+					ControlPlane: &v1alpha1.ControlPlaneConfig{
+						Endpoint: &v1alpha1.Endpoint{
+							endpointURL,
+						},
+					},
+				},
+			},
+			expectedError: "1 error occurred:\n\t* invalid address generation mode eui48\n\n",
+		},
+		{
+			name: "VIPBGP",
+			config: &v1alpha1.Config{
+				ConfigVersion: "v1alpha1",
+				MachineConfig: &v1alpha1.MachineConfig{
+					MachineType: "controlplane",
+					MachineNetwork: &v1alpha1.NetworkConfig{
+						NetworkInterfaces: []*v1alpha1.Device{
+							{
+								DeviceInterface: "eth0",
+								DeviceVIPConfig: &v1alpha1.DeviceVIPConfig{
+									SharedIP: "10.5.0.100",
+									BGPConfig: &v1alpha1.VIPBGPConfig{
+										BGPLocalASN: 65001,
+										BGPPeers: []*v1alpha1.VIPBGPPeer{
+											{
+												PeerAddress:  "10.5.0.1",
+												PeerASN:      65000,
+												PeerPassword: "secret",
+											},
+										},
+									},
+								},
+							},
+							{
+								DeviceInterface: "eth1",
+								DeviceVIPConfig: &v1alpha1.DeviceVIPConfig{
+									SharedIP: "2001:db8::100",
+									BGPConfig: &v1alpha1.VIPBGPConfig{
+										BGPPeers: []*v1alpha1.VIPBGPPeer{
+											{
+												PeerAddress: "10.5.0.1",
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+				ClusterConfig: &v1alpha1.ClusterConfig{
+					ControlPlane: &v1alpha1.ControlPlaneConfig{
+						Endpoint: &v1alpha1.Endpoint{
+							endpointURL,
+						},
+					},
+				},
+			},
+			expectedError: "4 errors occurred:\n\t* [networking.os.device.vip.bgp.localASN]: required config section\n" +
+				"\t* [networking.os.device.vip.bgp.routerID]: router ID is required for IPv6 VIP\n" +
+				"\t* [networking.os.device.vip.bgp.peers.address] \"10.5.0.1\": peer address family should match the VIP\n" +
+				"\t* [networking.os.device.vip.bgp.peers.asn] \"10.5.0.1\": required config section\n\n",
+		},
+		{
+			name: "HostDNS",
+			config: &v1alpha1.Config{
+				ConfigVersion: "v1alpha1",
+				MachineConfig: &v1alpha1.MachineConfig{
+					MachineType: "controlplane",
+					MachineFeatures: &v1alpha1.FeaturesConfig{
+						HostDNSSupport: &v1alpha1.HostDNSConfig{
+							HostDNSForwardKubeDNSToHost: pointer.To(true),
+							HostDNSForwardDomains: []*v1alpha1.HostDNSForwardDomain{
+								{
+									ForwardDomain:  "corp.example",
+									ForwardServers: []string{"10.0.0.53", "[2001:db8::53]:5353"},
+								},
+								{
+									ForwardDomain: "corp..example",
+								},
+								{
+									ForwardDomain:  "lab.example",
+									ForwardServers: []string{"ns.lab.example"},
+								},
+							},
+						},
+					},
+				},
+				ClusterConfig: &v1alpha1.ClusterConfig{
+					ControlPlane: &v1alpha1.ControlPlaneConfig{
+						Endpoint: &v1alpha1.Endpoint{
+							endpointURL,
+						},
+					},
+				},
+			},
+			expectedError: "4 errors occurred:\n\t* feature host DNS should be enabled when forwarding Kubernetes DNS to host\n" +
+				"\t* host DNS forward domain \"corp..example\" is not a valid DNS name\n" +
+				"\t* host DNS forward domain \"corp..example\": required config section\n" +
+				"\t* host DNS forward domain \"lab.example\": invalid server \"ns.lab.example\"\n\n",
+		},
+		{
+			name: "RoutingRules",
+			config: &v1alpha1.Config{
+				ConfigVersion: "v1alpha1",
+				MachineConfig: &v1alpha1.MachineConfig{
+					MachineType: "controlplane",
+					MachineNetwork: &v1alpha1.NetworkConfig{
+						NetworkInterfaces: []*v1alpha1.Device{
+							{
+								DeviceInterface: "eth0",
+								DeviceRules: []*v1alpha1.RoutingRule{
+									{
+										RuleFrom: "10.0.0.0/8",
+									},
+									{
+										RulePriority: 1000,
+										RuleFrom:     "10.0.0.0/8",
+										RuleTo:       "2001:db8::/32",
+										RuleTable:    100,
+									},
+									{
+										RulePriority: 1001,
+										RuleFrom:     "foo",
+										RuleFwMask:   0xff,
+										RuleTable:    100,
+									},
+								},
+							},
+							{
+								DeviceInterface: "eth1",
+								DeviceRules: []*v1alpha1.RoutingRule{
+									{
+										RulePriority: 1000,
+										RuleTable:    101,
+									},
+								},
+							},
+						},
+					},
+				},
+				ClusterConfig: &v1alpha1.ClusterConfig{
+					ControlPlane: &v1alpha1.ControlPlaneConfig{
+						Endpoint: &v1alpha1.Endpoint{
+							endpointURL,
+						},
+					},
+				},
+			},
+			expectedError: "6 errors occurred:\n\t* [networking.os.device.rules[0].priority] 0: priority should be in range 1-32765\n" +
+				"\t* [networking.os.device.rules[0].table]: required config section\n" +
+				"\t* [networking.os.device.rules[1]]: from and to should be of the same address family\n" +
+				"\t* [networking.os.device.rules[2].from] \"foo\": invalid network address\n" +
+				"\t* [networking.os.device.rules[2]]: fwMask requires fwMark to be set\n" +
+				"\t* routing rule priority 1000 (inet4) is declared twice: on \"eth0\" and \"eth1\"\n\n",
+		},
+		{
+			name: "Neighbors",
+			config: &v1alpha1.Config{
+				ConfigVersion: "v1alpha1",
+				MachineConfig: &v1alpha1.MachineConfig{
+					MachineType: "controlplane",
+					MachineNetwork: &v1alpha1.NetworkConfig{
+						NetworkInterfaces: []*v1alpha1.Device{
+							{
+								DeviceInterface: "eth0",
+								DeviceNeighbors: []*v1alpha1.Neighbor{
+									{
+										NeighborIP:       "10.0.0.1",
+										NeighborLinkAddr: "02:00:00:00:00:01",
+									},
+									{
+										NeighborIP: "10.0.0.2",
+									},
+									{
+										NeighborIP:       "10.0.0.3",
+										NeighborLinkAddr: "02:00:00:00:00:03",
+										NeighborProxy:    true,
+									},
+									{
+										NeighborIP:       "foo",
+										NeighborLinkAddr: "bar",
+									},
+									{
+										NeighborIP:       "10.0.0.1",
+										NeighborLinkAddr: "02:00:00:00:00:04",
+									},
+									{
+										NeighborIP:    "10.0.0.1",
+										NeighborProxy: true,
+									},
+								},
+							},
+						},
+					},
+				},
+				ClusterConfig: &v1alpha1.ClusterConfig{
+					ControlPlane: &v1alpha1.ControlPlaneConfig{
+						Endpoint: &v1alpha1.Endpoint{
+							endpointURL,
+						},
+					},
+				},
+			},
+			expectedError: "5 errors occurred:\n\t* [networking.os.device.neighbors[1].lladdr]: required config section\n" +
+				"\t* [networking.os.device.neighbors[2]]: lladdr can't be set for a proxy entry\n" +
+				"\t* [networking.os.device.neighbors[3].ip] \"foo\": invalid network address\n" +
+				"\t* [networking.os.device.neighbors[3].lladdr] \"bar\": invalid link-layer address\n" +
+				"\t* [networking.os.device.neighbors[4].ip] \"10.0.0.1\": duplicate neighbor entry\n\n",
+		},
+		{
+			name: "NetworkProbes",
+			config: &v1alpha1.Config{
+				ConfigVersion: "v1alpha1",
+				MachineConfig: &v1alpha1.MachineConfig{
+					MachineType: "controlplane",
+					MachineNetwork: &v1alpha1.NetworkConfig{
+						NetworkInterfaces: []*v1alpha1.Device{
+							{
+								DeviceInterface: "eth0",
+								DeviceVIPConfig: &v1alpha1.DeviceVIPConfig{
+									SharedIP: "192.168.1.10",
+									VIPProbe: &v1alpha1.NetworkProbe{
+										ProbeICMP: "192.168.1.1",
+										ProbeTCP:  "192.168.1.1:80",
+									},
+								},
+								DeviceRoutes: []*v1alpha1.Route{
+									{
+										RouteNetwork: "10.0.0.0/8",
+										RouteGateway: "192.168.1.1",
+										RouteProbe:   &v1alpha1.NetworkProbe{},
+									},
+									{
+										RouteNetwork: "10.0.0.0/8",
+										RouteGateway: "192.168.1.2",
+										RouteProbe: &v1alpha1.NetworkProbe{
+											ProbeHTTP: &v1alpha1.NetworkProbeHTTP{
+												HTTPURL:            "ftp://example.com",
+												HTTPExpectedStatus: 42,
+											},
+										},
+									},
+									{
+										RouteNetwork: "10.0.0.0/8",
+										RouteGateway: "192.168.1.3",
+										RouteProbe: &v1alpha1.NetworkProbe{
+											ProbeDNS: &v1alpha1.NetworkProbeDNS{
+												DNSServer: "1.1.1.1",
+											},
+										},
+									},
+									{
+										RouteNetwork: "10.0.0.0/8",
+										RouteGateway: "192.168.1.4",
+										RouteProbe: &v1alpha1.NetworkProbe{
+											ProbeInterval: 10 * time.Second,
+											ProbeTCP:      "192.168.1.4:443",
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+				ClusterConfig: &v1alpha1.ClusterConfig{
+					ControlPlane: &v1alpha1.ControlPlaneConfig{
+						Endpoint: &v1alpha1.Endpoint{
+							endpointURL,
+						},
+					},
+				},
+			},
+			expectedError: "6 errors occurred:\n\t* [networking.os.device.vip.probe]: config sections are mutually exclusive\n" +
+				"\t* [networking.os.device.route[0].probe]: required either config section to be set\n" +
+				"\t* [networking.os.device.route[1].probe.http.url] \"ftp://example.com\": should be a valid http(s) URL\n" +
+				"\t* [networking.os.device.route[1].probe.http.expectedStatus] 42: invalid HTTP status code\n" +
+				"\t* [networking.os.device.route[2].probe.dns.name]: required config section\n" +
+				"\t* [networking.os.device.route[2].probe.dns.server] \"1.1.1.1\": address 1.1.1.1: missing port in address\n\n",
+		},
 		{
 			name: "Wireguard",
 			config: &v1alpha1.Config{
@@ -1402,6 +1940,141 @@ func TestValidate(t *testing.T) {
 			},
 			expectedError: "1 error occurred:\n\t* kubespan link MTU must be at least 1280\n\n",
 		},
+		{
+			name: "LLDPTransmitInterval",
+			config: &v1alpha1.Config{
+				ConfigVersion: "v1alpha1",
+				MachineConfig: &v1alpha1.MachineConfig{
+					MachineType: "worker",
+					MachineNetwork: &v1alpha1.NetworkConfig{
+						NetworkLLDP: &v1alpha1.NetworkLLDP{
+							LLDPTransmit:         pointer.To(true),
+							LLDPTransmitInterval: 100 * time.Millisecond,
+						},
+					},
+				},
+				ClusterConfig: &v1alpha1.ClusterConfig{
+					ControlPlane: &v1alpha1.ControlPlaneConfig{
+						Endpoint: &v1alpha1.Endpoint{
+							endpointURL,
+						},
+					},
+				},
+			},
+			expectedError: "1 error occurred:\n\t* lldp transmit interval should be between 1s and 1h: 100ms\n\n",
+		},
+		{
+			name: "TimeServersNTS",
+			config: &v1alpha1.Config{
+				ConfigVersion: "v1alpha1",
+				MachineConfig: &v1alpha1.MachineConfig{
+					MachineType: "worker",
+					MachineTime: &v1alpha1.TimeConfig{
+						TimeServers:            []string{"nts://time.cloudflare.com", "nts://10.5.0.1:4461", "pool.ntp.org"},
+						TimeNTSFallback:        pointer.To(true),
+						TimeNTSNoCertTimeCheck: 3,
+					},
+				},
+				ClusterConfig: &v1alpha1.ClusterConfig{
+					ControlPlane: &v1alpha1.ControlPlaneConfig{
+						Endpoint: &v1alpha1.Endpoint{
+							endpointURL,
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "TimeServersInvalid",
+			config: &v1alpha1.Config{
+				ConfigVersion: "v1alpha1",
+				MachineConfig: &v1alpha1.MachineConfig{
+					MachineType: "worker",
+					MachineTime: &v1alpha1.TimeConfig{
+						TimeServers:     []string{"https://time.cloudflare.com", "nts://time.cloudflare.com/foo", "nts://"},
+						TimeNTSFallback: pointer.To(true),
+					},
+				},
+				ClusterConfig: &v1alpha1.ClusterConfig{
+					ControlPlane: &v1alpha1.ControlPlaneConfig{
+						Endpoint: &v1alpha1.Endpoint{
+							endpointURL,
+						},
+					},
+				},
+			},
+			expectedError: "3 errors occurred:\n\t* time server \"https://time.cloudflare.com\" has unsupported scheme \"https\"\n" +
+				"\t* time server \"nts://time.cloudflare.com/foo\" should be in nts://host[:port] format\n" +
+				"\t* time server \"nts://\" should be in nts://host[:port] format\n\n",
+		},
+		{
+			name: "TimeNTSNoCertTimeCheckWithoutNTS",
+			config: &v1alpha1.Config{
+				ConfigVersion: "v1alpha1",
+				MachineConfig: &v1alpha1.MachineConfig{
+					MachineType: "worker",
+					MachineTime: &v1alpha1.TimeConfig{
+						TimeServers:            []string{"pool.ntp.org"},
+						TimeNTSNoCertTimeCheck: 3,
+					},
+				},
+				ClusterConfig: &v1alpha1.ClusterConfig{
+					ControlPlane: &v1alpha1.ControlPlaneConfig{
+						Endpoint: &v1alpha1.Endpoint{
+							endpointURL,
+						},
+					},
+				},
+			},
+			expectedError: "1 error occurred:\n\t* time NTS certificate time check skips require at least one nts:// server\n\n",
+		},
+		{
+			name: "TimeNTPServerDisabledSync",
+			config: &v1alpha1.Config{
+				ConfigVersion: "v1alpha1",
+				MachineConfig: &v1alpha1.MachineConfig{
+					MachineType: "worker",
+					MachineTime: &v1alpha1.TimeConfig{
+						TimeDisabled: pointer.To(true),
+						TimeNTPServer: &v1alpha1.TimeNTPServerConfig{
+							NTPServerEnabled: pointer.To(true),
+						},
+					},
+				},
+				ClusterConfig: &v1alpha1.ClusterConfig{
+					ControlPlane: &v1alpha1.ControlPlaneConfig{
+						Endpoint: &v1alpha1.Endpoint{
+							endpointURL,
+						},
+					},
+				},
+			},
+			expectedError: "1 error occurred:\n\t* time NTP server requires time sync to be enabled\n\n",
+		},
+		{
+			name: "TimePTPInvalid",
+			config: &v1alpha1.Config{
+				ConfigVersion: "v1alpha1",
+				MachineConfig: &v1alpha1.MachineConfig{
+					MachineType: "worker",
+					MachineTime: &v1alpha1.TimeConfig{
+						TimePTP: &v1alpha1.TimePTPConfig{
+							PTPEnabled:   pointer.To(true),
+							PTPDevice:    "/dev/ptp0",
+							PTPInterface: "eth0",
+						},
+					},
+				},
+				ClusterConfig: &v1alpha1.ClusterConfig{
+					ControlPlane: &v1alpha1.ControlPlaneConfig{
+						Endpoint: &v1alpha1.Endpoint{
+							endpointURL,
+						},
+					},
+				},
+			},
+			expectedError: "1 error occurred:\n\t* time PTP device and interface are mutually exclusive\n\n",
+		},
 	} {
 		test := test
 
@@ -1522,3 +2195,91 @@ func TestValidateCNI(t *testing.T) {
 		})
 	}
 }
+
+func TestValidateLogging(t *testing.T) {
+	t.Parallel()
+
+	mustParseURL := func(s string) *url.URL {
+		u, err := url.Parse(s)
+		require.NoError(t, err)
+
+		return u
+	}
+
+	for _, test := range []struct {
+		name          string
+		destination   v1alpha1.LoggingDestination
+		expectedError string
+	}{
+		{
+			name: "JSONLines",
+			destination: v1alpha1.LoggingDestination{
+				LoggingEndpoint: &v1alpha1.Endpoint{URL: mustParseURL("udp://127.0.0.1:12345")},
+				LoggingFormat:   constants.LoggingFormatJSONLines,
+			},
+		},
+		{
+			name: "SyslogTLS",
+			destination: v1alpha1.LoggingDestination{
+				LoggingEndpoint: &v1alpha1.Endpoint{URL: mustParseURL("tcp://127.0.0.1:6514")},
+				LoggingFormat:   constants.LoggingFormatSyslog,
+				LoggingTLS: &v1alpha1.LoggingTLSConfig{
+					TLSInsecureSkipVerify: pointer.To(true),
+				},
+			},
+		},
+		{
+			name: "GELF",
+			destination: v1alpha1.LoggingDestination{
+				LoggingEndpoint: &v1alpha1.Endpoint{URL: mustParseURL("udp://127.0.0.1:12201")},
+				LoggingFormat:   constants.LoggingFormatGELF,
+			},
+		},
+		{
+			name: "UnknownFormat",
+			destination: v1alpha1.LoggingDestination{
+				LoggingEndpoint: &v1alpha1.Endpoint{URL: mustParseURL("udp://127.0.0.1:12345")},
+				LoggingFormat:   "xml",
+			},
+			expectedError: "1 error occurred:\n\t* unknown logging format \"xml\"\n\n",
+		},
+		{
+			name: "TLSOverUDP",
+			destination: v1alpha1.LoggingDestination{
+				LoggingEndpoint: &v1alpha1.Endpoint{URL: mustParseURL("udp://127.0.0.1:12201")},
+				LoggingFormat:   constants.LoggingFormatGELF,
+				LoggingTLS:      &v1alpha1.LoggingTLSConfig{},
+			},
+			expectedError: "1 error occurred:\n\t* TLS is supported only for \"tcp\" logging endpoints, got \"udp\"\n\n",
+		},
+		{
+			name: "InvalidTLS",
+			destination: v1alpha1.LoggingDestination{
+				LoggingEndpoint: &v1alpha1.Endpoint{URL: mustParseURL("tcp://127.0.0.1:6514")},
+				LoggingFormat:   constants.LoggingFormatSyslog,
+				LoggingTLS: &v1alpha1.LoggingTLSConfig{
+					TLSCA: []byte("not a certificate"),
+				},
+			},
+			expectedError: "1 error occurred:\n\t* invalid logging TLS configuration: error parsing CA certificate\n\n",
+		},
+	} {
+		test := test
+
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			lc := &v1alpha1.LoggingConfig{
+				LoggingDestinations: []v1alpha1.LoggingDestination{test.destination},
+			}
+
+			err := lc.Validate()
+
+			if test.expectedError == "" {
+				assert.NoError(t, err)
+			} else {
+				assert.EqualError(t, err, test.expectedError)
+			}
+		})
+	}
+}
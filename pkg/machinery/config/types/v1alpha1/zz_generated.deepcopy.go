@@ -262,6 +262,11 @@ func (in *ClusterConfig) DeepCopyInto(out *ClusterConfig) {
 		*out = new(ClusterNetworkConfig)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.ClusterKMSEncryptionConfig != nil {
+		in, out := &in.ClusterKMSEncryptionConfig, &out.ClusterKMSEncryptionConfig
+		*out = new(KMSEncryptionConfig)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.ClusterCA != nil {
 		in, out := &in.ClusterCA, &out.ClusterCA
 		*out = (*in).DeepCopy()
@@ -606,6 +611,28 @@ func (in *Device) DeepCopyInto(out *Device) {
 			if (*in)[i] != nil {
 				in, out := &(*in)[i], &(*out)[i]
 				*out = new(Route)
+				(*in).DeepCopyInto(*out)
+			}
+		}
+	}
+	if in.DeviceRules != nil {
+		in, out := &in.DeviceRules, &out.DeviceRules
+		*out = make([]*RoutingRule, len(*in))
+		for i := range *in {
+			if (*in)[i] != nil {
+				in, out := &(*in)[i], &(*out)[i]
+				*out = new(RoutingRule)
+				**out = **in
+			}
+		}
+	}
+	if in.DeviceNeighbors != nil {
+		in, out := &in.DeviceNeighbors, &out.DeviceNeighbors
+		*out = make([]*Neighbor, len(*in))
+		for i := range *in {
+			if (*in)[i] != nil {
+				in, out := &(*in)[i], &(*out)[i]
+				*out = new(Neighbor)
 				**out = **in
 			}
 		}
@@ -651,6 +678,11 @@ func (in *Device) DeepCopyInto(out *Device) {
 		*out = new(DHCPOptions)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.DeviceIPv6 != nil {
+		in, out := &in.DeviceIPv6, &out.DeviceIPv6
+		*out = new(DeviceIPv6Config)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.DeviceWireguardConfig != nil {
 		in, out := &in.DeviceWireguardConfig, &out.DeviceWireguardConfig
 		*out = new(DeviceWireguardConfig)
@@ -661,6 +693,26 @@ func (in *Device) DeepCopyInto(out *Device) {
 		*out = new(DeviceVIPConfig)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.DeviceVRF != nil {
+		in, out := &in.DeviceVRF, &out.DeviceVRF
+		*out = new(VRF)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.DeviceVXLAN != nil {
+		in, out := &in.DeviceVXLAN, &out.DeviceVXLAN
+		*out = new(VXLAN)
+		**out = **in
+	}
+	if in.DeviceMACVLAN != nil {
+		in, out := &in.DeviceMACVLAN, &out.DeviceMACVLAN
+		*out = new(MACVLAN)
+		**out = **in
+	}
+	if in.DeviceIPVLAN != nil {
+		in, out := &in.DeviceIPVLAN, &out.DeviceIPVLAN
+		*out = new(IPVLAN)
+		**out = **in
+	}
 	return
 }
 
@@ -674,6 +726,37 @@ func (in *Device) DeepCopy() *Device {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DeviceIPv6Config) DeepCopyInto(out *DeviceIPv6Config) {
+	*out = *in
+	if in.IPv6AcceptRA != nil {
+		in, out := &in.IPv6AcceptRA, &out.IPv6AcceptRA
+		*out = new(bool)
+		**out = **in
+	}
+	if in.IPv6Autoconf != nil {
+		in, out := &in.IPv6Autoconf, &out.IPv6Autoconf
+		*out = new(bool)
+		**out = **in
+	}
+	if in.IPv6PrivacyExtensions != nil {
+		in, out := &in.IPv6PrivacyExtensions, &out.IPv6PrivacyExtensions
+		*out = new(bool)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DeviceIPv6Config.
+func (in *DeviceIPv6Config) DeepCopy() *DeviceIPv6Config {
+	if in == nil {
+		return nil
+	}
+	out := new(DeviceIPv6Config)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *DeviceVIPConfig) DeepCopyInto(out *DeviceVIPConfig) {
 	*out = *in
@@ -687,6 +770,16 @@ func (in *DeviceVIPConfig) DeepCopyInto(out *DeviceVIPConfig) {
 		*out = new(VIPHCloudConfig)
 		**out = **in
 	}
+	if in.BGPConfig != nil {
+		in, out := &in.BGPConfig, &out.BGPConfig
+		*out = new(VIPBGPConfig)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.VIPProbe != nil {
+		in, out := &in.VIPProbe, &out.VIPProbe
+		*out = new(NetworkProbe)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -827,6 +920,16 @@ func (in *EncryptionKey) DeepCopyInto(out *EncryptionKey) {
 		*out = new(EncryptionKeyNodeID)
 		**out = **in
 	}
+	if in.KeyTPM != nil {
+		in, out := &in.KeyTPM, &out.KeyTPM
+		*out = new(EncryptionKeyTPM)
+		**out = **in
+	}
+	if in.KeyKMS != nil {
+		in, out := &in.KeyKMS, &out.KeyKMS
+		*out = new(EncryptionKeyKMS)
+		**out = **in
+	}
 	return
 }
 
@@ -840,6 +943,22 @@ func (in *EncryptionKey) DeepCopy() *EncryptionKey {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *EncryptionKeyKMS) DeepCopyInto(out *EncryptionKeyKMS) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EncryptionKeyKMS.
+func (in *EncryptionKeyKMS) DeepCopy() *EncryptionKeyKMS {
+	if in == nil {
+		return nil
+	}
+	out := new(EncryptionKeyKMS)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *EncryptionKeyNodeID) DeepCopyInto(out *EncryptionKeyNodeID) {
 	*out = *in
@@ -872,6 +991,22 @@ func (in *EncryptionKeyStatic) DeepCopy() *EncryptionKeyStatic {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *EncryptionKeyTPM) DeepCopyInto(out *EncryptionKeyTPM) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EncryptionKeyTPM.
+func (in *EncryptionKeyTPM) DeepCopy() *EncryptionKeyTPM {
+	if in == nil {
+		return nil
+	}
+	out := new(EncryptionKeyTPM)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *EtcdConfig) DeepCopyInto(out *EtcdConfig) {
 	*out = *in
@@ -989,6 +1124,11 @@ func (in *FeaturesConfig) DeepCopyInto(out *FeaturesConfig) {
 		*out = new(APIServerBalancer)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.HostDNSSupport != nil {
+		in, out := &in.HostDNSSupport, &out.HostDNSSupport
+		*out = new(HostDNSConfig)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -1002,6 +1142,80 @@ func (in *FeaturesConfig) DeepCopy() *FeaturesConfig {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *HostDNSConfig) DeepCopyInto(out *HostDNSConfig) {
+	*out = *in
+	if in.HostDNSEnabled != nil {
+		in, out := &in.HostDNSEnabled, &out.HostDNSEnabled
+		*out = new(bool)
+		**out = **in
+	}
+	if in.HostDNSForwardKubeDNSToHost != nil {
+		in, out := &in.HostDNSForwardKubeDNSToHost, &out.HostDNSForwardKubeDNSToHost
+		*out = new(bool)
+		**out = **in
+	}
+	if in.HostDNSForwardDomains != nil {
+		in, out := &in.HostDNSForwardDomains, &out.HostDNSForwardDomains
+		*out = make([]*HostDNSForwardDomain, len(*in))
+		for i := range *in {
+			if (*in)[i] != nil {
+				in, out := &(*in)[i], &(*out)[i]
+				*out = new(HostDNSForwardDomain)
+				(*in).DeepCopyInto(*out)
+			}
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HostDNSConfig.
+func (in *HostDNSConfig) DeepCopy() *HostDNSConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(HostDNSConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *HostDNSForwardDomain) DeepCopyInto(out *HostDNSForwardDomain) {
+	*out = *in
+	if in.ForwardServers != nil {
+		in, out := &in.ForwardServers, &out.ForwardServers
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HostDNSForwardDomain.
+func (in *HostDNSForwardDomain) DeepCopy() *HostDNSForwardDomain {
+	if in == nil {
+		return nil
+	}
+	out := new(HostDNSForwardDomain)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *IPVLAN) DeepCopyInto(out *IPVLAN) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IPVLAN.
+func (in *IPVLAN) DeepCopy() *IPVLAN {
+	if in == nil {
+		return nil
+	}
+	out := new(IPVLAN)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *IfaceSelector) DeepCopyInto(out *IfaceSelector) {
 	*out = *in
@@ -1144,6 +1358,34 @@ func (in *InstallExtensionConfig) DeepCopy() *InstallExtensionConfig {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *KMSEncryptionConfig) DeepCopyInto(out *KMSEncryptionConfig) {
+	*out = *in
+	if in.KMSEnabled != nil {
+		in, out := &in.KMSEnabled, &out.KMSEnabled
+		*out = new(bool)
+		**out = **in
+	}
+	if in.KMSPluginExtraArgs != nil {
+		in, out := &in.KMSPluginExtraArgs, &out.KMSPluginExtraArgs
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KMSEncryptionConfig.
+func (in *KMSEncryptionConfig) DeepCopy() *KMSEncryptionConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(KMSEncryptionConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *KernelConfig) DeepCopyInto(out *KernelConfig) {
 	*out = *in
@@ -1356,6 +1598,11 @@ func (in *LoggingDestination) DeepCopyInto(out *LoggingDestination) {
 		in, out := &in.LoggingEndpoint, &out.LoggingEndpoint
 		*out = (*in).DeepCopy()
 	}
+	if in.LoggingTLS != nil {
+		in, out := &in.LoggingTLS, &out.LoggingTLS
+		*out = new(LoggingTLSConfig)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -1369,6 +1616,52 @@ func (in *LoggingDestination) DeepCopy() *LoggingDestination {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *LoggingTLSConfig) DeepCopyInto(out *LoggingTLSConfig) {
+	*out = *in
+	if in.TLSClientIdentity != nil {
+		in, out := &in.TLSClientIdentity, &out.TLSClientIdentity
+		*out = (*in).DeepCopy()
+	}
+	if in.TLSCA != nil {
+		in, out := &in.TLSCA, &out.TLSCA
+		*out = make(Base64Bytes, len(*in))
+		copy(*out, *in)
+	}
+	if in.TLSInsecureSkipVerify != nil {
+		in, out := &in.TLSInsecureSkipVerify, &out.TLSInsecureSkipVerify
+		*out = new(bool)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LoggingTLSConfig.
+func (in *LoggingTLSConfig) DeepCopy() *LoggingTLSConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(LoggingTLSConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MACVLAN) DeepCopyInto(out *MACVLAN) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MACVLAN.
+func (in *MACVLAN) DeepCopy() *MACVLAN {
+	if in == nil {
+		return nil
+	}
+	out := new(MACVLAN)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *MachineConfig) DeepCopyInto(out *MachineConfig) {
 	*out = *in
@@ -1641,6 +1934,22 @@ func (in *MachineSeccompProfile) DeepCopy() *MachineSeccompProfile {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Neighbor) DeepCopyInto(out *Neighbor) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Neighbor.
+func (in *Neighbor) DeepCopy() *Neighbor {
+	if in == nil {
+		return nil
+	}
+	out := new(Neighbor)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *NetworkConfig) DeepCopyInto(out *NetworkConfig) {
 	*out = *in
@@ -1681,6 +1990,11 @@ func (in *NetworkConfig) DeepCopyInto(out *NetworkConfig) {
 		*out = new(bool)
 		**out = **in
 	}
+	if in.NetworkLLDP != nil {
+		in, out := &in.NetworkLLDP, &out.NetworkLLDP
+		*out = new(NetworkLLDP)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -1777,6 +2091,85 @@ func (in *NetworkKubeSpan) DeepCopy() *NetworkKubeSpan {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NetworkLLDP) DeepCopyInto(out *NetworkLLDP) {
+	*out = *in
+	if in.LLDPTransmit != nil {
+		in, out := &in.LLDPTransmit, &out.LLDPTransmit
+		*out = new(bool)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NetworkLLDP.
+func (in *NetworkLLDP) DeepCopy() *NetworkLLDP {
+	if in == nil {
+		return nil
+	}
+	out := new(NetworkLLDP)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NetworkProbe) DeepCopyInto(out *NetworkProbe) {
+	*out = *in
+	if in.ProbeHTTP != nil {
+		in, out := &in.ProbeHTTP, &out.ProbeHTTP
+		*out = new(NetworkProbeHTTP)
+		**out = **in
+	}
+	if in.ProbeDNS != nil {
+		in, out := &in.ProbeDNS, &out.ProbeDNS
+		*out = new(NetworkProbeDNS)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NetworkProbe.
+func (in *NetworkProbe) DeepCopy() *NetworkProbe {
+	if in == nil {
+		return nil
+	}
+	out := new(NetworkProbe)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NetworkProbeDNS) DeepCopyInto(out *NetworkProbeDNS) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NetworkProbeDNS.
+func (in *NetworkProbeDNS) DeepCopy() *NetworkProbeDNS {
+	if in == nil {
+		return nil
+	}
+	out := new(NetworkProbeDNS)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NetworkProbeHTTP) DeepCopyInto(out *NetworkProbeHTTP) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NetworkProbeHTTP.
+func (in *NetworkProbeHTTP) DeepCopy() *NetworkProbeHTTP {
+	if in == nil {
+		return nil
+	}
+	out := new(NetworkProbeHTTP)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *PodCheckpointer) DeepCopyInto(out *PodCheckpointer) {
 	*out = *in
@@ -2010,6 +2403,11 @@ func (in *RegistryTLSConfig) DeepCopy() *RegistryTLSConfig {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Route) DeepCopyInto(out *Route) {
 	*out = *in
+	if in.RouteProbe != nil {
+		in, out := &in.RouteProbe, &out.RouteProbe
+		*out = new(NetworkProbe)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -2023,6 +2421,22 @@ func (in *Route) DeepCopy() *Route {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RoutingRule) DeepCopyInto(out *RoutingRule) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RoutingRule.
+func (in *RoutingRule) DeepCopy() *RoutingRule {
+	if in == nil {
+		return nil
+	}
+	out := new(RoutingRule)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *STP) DeepCopyInto(out *STP) {
 	*out = *in
@@ -2118,6 +2532,21 @@ func (in *TimeConfig) DeepCopyInto(out *TimeConfig) {
 		*out = make([]string, len(*in))
 		copy(*out, *in)
 	}
+	if in.TimeNTSFallback != nil {
+		in, out := &in.TimeNTSFallback, &out.TimeNTSFallback
+		*out = new(bool)
+		**out = **in
+	}
+	if in.TimeNTPServer != nil {
+		in, out := &in.TimeNTPServer, &out.TimeNTPServer
+		*out = new(TimeNTPServerConfig)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.TimePTP != nil {
+		in, out := &in.TimePTP, &out.TimePTP
+		*out = new(TimePTPConfig)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -2131,6 +2560,53 @@ func (in *TimeConfig) DeepCopy() *TimeConfig {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TimeNTPServerConfig) DeepCopyInto(out *TimeNTPServerConfig) {
+	*out = *in
+	if in.NTPServerEnabled != nil {
+		in, out := &in.NTPServerEnabled, &out.NTPServerEnabled
+		*out = new(bool)
+		**out = **in
+	}
+	if in.NTPServerInterfaces != nil {
+		in, out := &in.NTPServerInterfaces, &out.NTPServerInterfaces
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TimeNTPServerConfig.
+func (in *TimeNTPServerConfig) DeepCopy() *TimeNTPServerConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(TimeNTPServerConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TimePTPConfig) DeepCopyInto(out *TimePTPConfig) {
+	*out = *in
+	if in.PTPEnabled != nil {
+		in, out := &in.PTPEnabled, &out.PTPEnabled
+		*out = new(bool)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TimePTPConfig.
+func (in *TimePTPConfig) DeepCopy() *TimePTPConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(TimePTPConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *UdevConfig) DeepCopyInto(out *UdevConfig) {
 	*out = *in
@@ -2159,6 +2635,49 @@ func (in *Unstructured) DeepCopyInto(out *Unstructured) {
 	return
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VIPBGPConfig) DeepCopyInto(out *VIPBGPConfig) {
+	*out = *in
+	if in.BGPPeers != nil {
+		in, out := &in.BGPPeers, &out.BGPPeers
+		*out = make([]*VIPBGPPeer, len(*in))
+		for i := range *in {
+			if (*in)[i] != nil {
+				in, out := &(*in)[i], &(*out)[i]
+				*out = new(VIPBGPPeer)
+				**out = **in
+			}
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VIPBGPConfig.
+func (in *VIPBGPConfig) DeepCopy() *VIPBGPConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(VIPBGPConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VIPBGPPeer) DeepCopyInto(out *VIPBGPPeer) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VIPBGPPeer.
+func (in *VIPBGPPeer) DeepCopy() *VIPBGPPeer {
+	if in == nil {
+		return nil
+	}
+	out := new(VIPBGPPeer)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *VIPEquinixMetalConfig) DeepCopyInto(out *VIPEquinixMetalConfig) {
 	*out = *in
@@ -2191,6 +2710,43 @@ func (in *VIPHCloudConfig) DeepCopy() *VIPHCloudConfig {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VRF) DeepCopyInto(out *VRF) {
+	*out = *in
+	if in.VRFInterfaces != nil {
+		in, out := &in.VRFInterfaces, &out.VRFInterfaces
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VRF.
+func (in *VRF) DeepCopy() *VRF {
+	if in == nil {
+		return nil
+	}
+	out := new(VRF)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VXLAN) DeepCopyInto(out *VXLAN) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VXLAN.
+func (in *VXLAN) DeepCopy() *VXLAN {
+	if in == nil {
+		return nil
+	}
+	out := new(VXLAN)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Vlan) DeepCopyInto(out *Vlan) {
 	*out = *in
@@ -2206,7 +2762,7 @@ func (in *Vlan) DeepCopyInto(out *Vlan) {
 			if (*in)[i] != nil {
 				in, out := &(*in)[i], &(*out)[i]
 				*out = new(Route)
-				**out = **in
+				(*in).DeepCopyInto(*out)
 			}
 		}
 	}
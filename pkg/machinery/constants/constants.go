@@ -270,6 +270,33 @@ const (
 	// KubernetesAPIServerRunGroup defines GID to run the API Server.
 	KubernetesAPIServerRunGroup = 65534
 
+	// KubernetesKMSPluginSocketDir defines the directory for the KMS plugin sockets, it is mounted into kube-apiserver.
+	KubernetesKMSPluginSocketDir = "/var/run/kmsplugin"
+
+	// KubernetesKMSPluginDefaultEndpoint defines the default gRPC endpoint of the KMS plugin.
+	KubernetesKMSPluginDefaultEndpoint = "unix://" + KubernetesKMSPluginSocketDir + "/kms.sock"
+
+	// KubernetesKMSPluginDefaultName defines the default name of the KMS provider.
+	KubernetesKMSPluginDefaultName = "talos-kms"
+
+	// KubernetesKMSPluginDefaultTimeout defines the default timeout for the calls to the KMS plugin.
+	KubernetesKMSPluginDefaultTimeout = 3 * time.Second
+
+	// NetworkProbeDefaultInterval defines the default interval between the network probes.
+	NetworkProbeDefaultInterval = 5 * time.Second
+
+	// NetworkProbeDefaultFailureThreshold defines the default number of consecutive failures for the network probe to fail.
+	NetworkProbeDefaultFailureThreshold = 3
+
+	// NetworkProbeDefaultTimeout defines the default timeout of a single network probe.
+	NetworkProbeDefaultTimeout = 3 * time.Second
+
+	// NetworkProbeDefaultHTTPStatus defines the default expected status code of the HTTP network probe.
+	NetworkProbeDefaultHTTPStatus = 200
+
+	// LLDPDefaultTransmitInterval defines the default interval between the LLDP advertisements.
+	LLDPDefaultTransmitInterval = 30 * time.Second
+
 	// KubernetesControllerManagerRunUser defines UID to the Controller Manager.
 	KubernetesControllerManagerRunUser = 65535
 
@@ -390,6 +417,9 @@ const (
 	// EtcdRecoverySnapshotPath is the path where etcd snapshot is uploaded for recovery.
 	EtcdRecoverySnapshotPath = "/var/lib/etcd.snapshot"
 
+	// EtcdBackupPath is the path where scheduled etcd snapshots are stored.
+	EtcdBackupPath = "/var/lib/etcd-backup"
+
 	// EtcdUserID is the user ID for the etcd process.
 	EtcdUserID = 60
 
@@ -665,12 +695,24 @@ const (
 	// https://manage.ntppool.org/manage/vendor
 	DefaultNTPServer = "pool.ntp.org"
 
+	// DefaultPTPPollInterval is the default interval between PTP hardware clock measurements.
+	DefaultPTPPollInterval = time.Second
+
 	// DefaultPrimaryResolver is the default primary DNS server.
 	DefaultPrimaryResolver = "1.1.1.1"
 
 	// DefaultSecondaryResolver is the default secondary DNS server.
 	DefaultSecondaryResolver = "8.8.8.8"
 
+	// HostDNSLocalAddress is the loopback address the host caching DNS resolver listens on.
+	HostDNSLocalAddress = "127.0.0.53"
+
+	// HostDNSAddress is the link-local address the host caching DNS resolver listens on for Kubernetes pods.
+	HostDNSAddress = "169.254.116.108"
+
+	// PodResolvConfPath is the path to the resolv.conf file passed to the pods by the kubelet when the host caching DNS resolver is enabled.
+	PodResolvConfPath = SystemPath + "/resolved/resolv.conf"
+
 	// DefaultClusterIDSize is the default size in bytes for the cluster ID token.
 	DefaultClusterIDSize = 32
 
@@ -754,6 +796,33 @@ const (
 	// LoggingFormatJSONLines represents "JSON lines" logging format.
 	LoggingFormatJSONLines = "json_lines"
 
+	// LoggingFormatSyslog represents RFC 5424 syslog logging format.
+	LoggingFormatSyslog = "syslog_rfc5424"
+
+	// LoggingFormatGELF represents GELF (Graylog Extended Log Format) logging format.
+	LoggingFormatGELF = "gelf"
+
+	// PersistentLogPath is the default directory to store persistent service logs.
+	PersistentLogPath = "/var/log/talos"
+
+	// PersistentLogMaxBoots is the default number of boots to keep persistent service logs for.
+	PersistentLogMaxBoots = 5
+
+	// PersistentLogMaxSegments is the default number of compressed log segments to keep per service per boot.
+	PersistentLogMaxSegments = 10
+
+	// PersistentLogSegmentSize is the size of the uncompressed log segment which triggers rotation.
+	PersistentLogSegmentSize = 1024 * 1024
+
+	// UserVolumeMountPoint is the directory to mount user volumes under.
+	UserVolumeMountPoint = "/var/mnt"
+
+	// UserVolumePartitionLabelPrefix is the prefix of the user volume partition labels.
+	UserVolumePartitionLabelPrefix = "u-"
+
+	// UserVolumeDefaultMinSize is the default minimum size of the user volume.
+	UserVolumeDefaultMinSize = 100 * 1024 * 1024
+
 	// SideroLinkName is the interface name for SideroLink.
 	SideroLinkName = "siderolink"
 
@@ -845,6 +914,12 @@ const (
 
 	// PlatformMetal is the name of the metal platform.
 	PlatformMetal = "metal"
+
+	// TPMDevicePath is the path to the TPM 2.0 in-kernel resource manager device.
+	TPMDevicePath = "/dev/tpmrm0"
+
+	// SecureBootStatePCR is the PCR number where the Secure Boot state is measured.
+	SecureBootStatePCR = 7
 )
 
 // See https://linux.die.net/man/3/klogctl
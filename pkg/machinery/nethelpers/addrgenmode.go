@@ -0,0 +1,40 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package nethelpers
+
+import "fmt"
+
+//go:generate enumer -type=AddrGenMode -linecomment -text
+
+// AddrGenMode is an IPv6 address generation mode.
+type AddrGenMode uint8
+
+// AddrGenMode constants.
+//
+// See linux/if_link.h.
+//
+//structprotogen:gen_enum
+const (
+	AddrGenModeEUI64         AddrGenMode = iota // eui64
+	AddrGenModeNone                             // none
+	AddrGenModeStablePrivacy                    // stable-privacy
+	AddrGenModeRandom                           // random
+)
+
+// AddrGenModeByName converts string address generation mode into a constant.
+func AddrGenModeByName(mode string) (AddrGenMode, error) {
+	switch mode {
+	case "", "eui64":
+		return AddrGenModeEUI64, nil
+	case "none":
+		return AddrGenModeNone, nil
+	case "stable-privacy":
+		return AddrGenModeStablePrivacy, nil
+	case "random":
+		return AddrGenModeRandom, nil
+	default:
+		return 0, fmt.Errorf("invalid address generation mode %s", mode)
+	}
+}
@@ -0,0 +1,37 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package nethelpers
+
+import "fmt"
+
+//go:generate enumer -type=IPVLANMode -linecomment -text
+
+// IPVLANMode is an IPVLAN mode.
+type IPVLANMode uint16
+
+// IPVLANMode constants.
+//
+// See linux/if_link.h.
+//
+//structprotogen:gen_enum
+const (
+	IPVLANModeL2  IPVLANMode = iota // l2
+	IPVLANModeL3                    // l3
+	IPVLANModeL3S                   // l3s
+)
+
+// IPVLANModeByName converts string IPVLAN mode into a constant.
+func IPVLANModeByName(mode string) (IPVLANMode, error) {
+	switch mode {
+	case "", "l2":
+		return IPVLANModeL2, nil
+	case "l3":
+		return IPVLANModeL3, nil
+	case "l3s":
+		return IPVLANModeL3S, nil
+	default:
+		return 0, fmt.Errorf("invalid ipvlan mode %s", mode)
+	}
+}
@@ -0,0 +1,25 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package nethelpers
+
+//go:generate enumer -type=NeighborState -linecomment -text
+
+// NeighborState wraps NUD_* constants.
+type NeighborState uint16
+
+// NeighborState constants.
+//
+//structprotogen:gen_enum
+const (
+	NeighborStateNone       NeighborState = 0x00 // none
+	NeighborStateIncomplete NeighborState = 0x01 // incomplete
+	NeighborStateReachable  NeighborState = 0x02 // reachable
+	NeighborStateStale      NeighborState = 0x04 // stale
+	NeighborStateDelay      NeighborState = 0x08 // delay
+	NeighborStateProbe      NeighborState = 0x10 // probe
+	NeighborStateFailed     NeighborState = 0x20 // failed
+	NeighborStateNoARP      NeighborState = 0x40 // noarp
+	NeighborStatePermanent  NeighborState = 0x80 // permanent
+)
@@ -0,0 +1,19 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package nethelpers
+
+//go:generate enumer -type=QdiscKind -linecomment -text
+
+// QdiscKind is a kind of the traffic control queueing discipline.
+type QdiscKind uint8
+
+// QdiscKind constants.
+//
+//structprotogen:gen_enum
+const (
+	QdiscKindFQCodel QdiscKind = iota // fq_codel
+	QdiscKindHTB                      // htb
+	QdiscKindTBF                      // tbf
+)
@@ -0,0 +1,23 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package nethelpers
+
+//go:generate enumer -type=RoutingRuleAction -linecomment -text
+
+// RoutingRuleAction is a routing rule action.
+type RoutingRuleAction uint8
+
+// RoutingRuleAction constants.
+//
+//structprotogen:gen_enum
+const (
+	RoutingRuleActionUnspec      RoutingRuleAction = 0 // unspec
+	RoutingRuleActionTable       RoutingRuleAction = 1 // table
+	RoutingRuleActionGoto        RoutingRuleAction = 2 // goto
+	RoutingRuleActionNop         RoutingRuleAction = 3 // nop
+	RoutingRuleActionBlackhole   RoutingRuleAction = 6 // blackhole
+	RoutingRuleActionUnreachable RoutingRuleAction = 7 // unreachable
+	RoutingRuleActionProhibit    RoutingRuleAction = 8 // prohibit
+)
@@ -0,0 +1,19 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package nethelpers
+
+//go:generate enumer -type=TrafficControlFilterKind -linecomment -text
+
+// TrafficControlFilterKind is a kind of the traffic control filter match.
+type TrafficControlFilterKind uint8
+
+// TrafficControlFilterKind constants.
+//
+//structprotogen:gen_enum
+const (
+	TrafficControlFilterKindFwMark TrafficControlFilterKind = iota // fwmark
+	TrafficControlFilterKindDSCP                                   // dscp
+	TrafficControlFilterKindCgroup                                 // cgroup
+)
@@ -0,0 +1,17 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+// Package block provides resources related to the block devices.
+package block
+
+import (
+	"github.com/cosi-project/runtime/pkg/resource"
+
+	"github.com/siderolabs/talos/pkg/machinery/resources/v1alpha1"
+)
+
+//go:generate deep-copy -type BlockDeviceSpec -type DiscoveredVolumeSpec -type VolumeStatusSpec -header-file ../../../../hack/boilerplate.txt -o deep_copy.generated.go .
+
+// NamespaceName contains resources related to the block devices.
+const NamespaceName resource.Namespace = v1alpha1.NamespaceName
@@ -0,0 +1,102 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package block
+
+import (
+	"github.com/cosi-project/runtime/pkg/resource"
+	"github.com/cosi-project/runtime/pkg/resource/meta"
+	"github.com/cosi-project/runtime/pkg/resource/protobuf"
+	"github.com/cosi-project/runtime/pkg/resource/typed"
+
+	"github.com/siderolabs/talos/pkg/machinery/proto"
+)
+
+// BlockDeviceType is type of BlockDevice resource.
+const BlockDeviceType = resource.Type("BlockDevices.block.talos.dev")
+
+// BlockDevice resource describes a block device (a disk or a partition) as seen by the kernel.
+type BlockDevice = typed.Resource[BlockDeviceSpec, BlockDeviceExtension]
+
+// Block device types.
+const (
+	DeviceTypeDisk      = "disk"
+	DeviceTypePartition = "partition"
+)
+
+// BlockDeviceSpec is the spec for the BlockDevice resource.
+//
+//gotagsrewrite:gen
+type BlockDeviceSpec struct {
+	// Type is the device type: disk or partition.
+	Type string `yaml:"type" protobuf:"1"`
+	// DevPath is the path to the device node.
+	DevPath string `yaml:"devPath" protobuf:"2"`
+	Major   int    `yaml:"major" protobuf:"3"`
+	Minor   int    `yaml:"minor" protobuf:"4"`
+	// Parent is the ID of the disk for partitions.
+	Parent          string `yaml:"parent,omitempty" protobuf:"5"`
+	PartitionNumber int    `yaml:"partitionNumber,omitempty" protobuf:"6"`
+	// Size is the device size in bytes.
+	Size uint64 `yaml:"size" protobuf:"7"`
+
+	Model     string `yaml:"model,omitempty" protobuf:"8"`
+	Serial    string `yaml:"serial,omitempty" protobuf:"9"`
+	WWID      string `yaml:"wwid,omitempty" protobuf:"10"`
+	Transport string `yaml:"transport,omitempty" protobuf:"11"`
+	BusPath   string `yaml:"busPath,omitempty" protobuf:"12"`
+
+	Rotational bool `yaml:"rotational" protobuf:"13"`
+	ReadOnly   bool `yaml:"readOnly" protobuf:"14"`
+
+	// Generation is incremented on each change event of the device (e.g. the media change).
+	Generation int `yaml:"generation" protobuf:"15"`
+}
+
+// NewBlockDevice initializes a BlockDevice resource.
+func NewBlockDevice(namespace resource.Namespace, id resource.ID) *BlockDevice {
+	return typed.NewResource[BlockDeviceSpec, BlockDeviceExtension](
+		resource.NewMetadata(namespace, BlockDeviceType, id, resource.VersionUndefined),
+		BlockDeviceSpec{},
+	)
+}
+
+// BlockDeviceExtension provides auxiliary methods for BlockDevice.
+type BlockDeviceExtension struct{}
+
+// ResourceDefinition implements [typed.Extension] interface.
+func (BlockDeviceExtension) ResourceDefinition() meta.ResourceDefinitionSpec {
+	return meta.ResourceDefinitionSpec{
+		Type:             BlockDeviceType,
+		Aliases:          []resource.Type{},
+		DefaultNamespace: NamespaceName,
+		PrintColumns: []meta.PrintColumn{
+			{
+				Name:     "Type",
+				JSONPath: "{.type}",
+			},
+			{
+				Name:     "Size",
+				JSONPath: "{.size}",
+			},
+			{
+				Name:     "Model",
+				JSONPath: "{.model}",
+			},
+			{
+				Name:     "Transport",
+				JSONPath: "{.transport}",
+			},
+		},
+	}
+}
+
+func init() {
+	proto.RegisterDefaultTypes()
+
+	err := protobuf.RegisterDynamic[BlockDeviceSpec](BlockDeviceType, &BlockDevice{})
+	if err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,96 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package block
+
+import (
+	"github.com/cosi-project/runtime/pkg/resource"
+	"github.com/cosi-project/runtime/pkg/resource/meta"
+	"github.com/cosi-project/runtime/pkg/resource/protobuf"
+	"github.com/cosi-project/runtime/pkg/resource/typed"
+
+	"github.com/siderolabs/talos/pkg/machinery/proto"
+)
+
+// DiscoveredVolumeType is type of DiscoveredVolume resource.
+const DiscoveredVolumeType = resource.Type("DiscoveredVolumes.block.talos.dev")
+
+// DiscoveredVolume resource describes the contents of a block device: the partition table or the filesystem.
+type DiscoveredVolume = typed.Resource[DiscoveredVolumeSpec, DiscoveredVolumeExtension]
+
+// DiscoveredVolumeSpec is the spec for the DiscoveredVolume resource.
+//
+//gotagsrewrite:gen
+type DiscoveredVolumeSpec struct {
+	// Type is the device type: disk or partition.
+	Type    string `yaml:"type" protobuf:"1"`
+	DevPath string `yaml:"devPath" protobuf:"2"`
+	// Parent is the ID of the disk for partitions.
+	Parent string `yaml:"parent,omitempty" protobuf:"3"`
+	Size   uint64 `yaml:"size" protobuf:"4"`
+
+	// PartitionTable is the type of the partition table on the disk (gpt or dos).
+	PartitionTable string `yaml:"partitionTable,omitempty" protobuf:"5"`
+
+	PartitionIndex int    `yaml:"partitionIndex,omitempty" protobuf:"6"`
+	PartitionLabel string `yaml:"partitionLabel,omitempty" protobuf:"7"`
+	PartitionUUID  string `yaml:"partitionUUID,omitempty" protobuf:"8"`
+	PartitionType  string `yaml:"partitionType,omitempty" protobuf:"9"`
+
+	// FilesystemType is the type of the filesystem (or the encrypted volume) on the device.
+	FilesystemType string `yaml:"filesystemType,omitempty" protobuf:"10"`
+	Label          string `yaml:"label,omitempty" protobuf:"11"`
+	UUID           string `yaml:"uuid,omitempty" protobuf:"12"`
+}
+
+// NewDiscoveredVolume initializes a DiscoveredVolume resource.
+func NewDiscoveredVolume(namespace resource.Namespace, id resource.ID) *DiscoveredVolume {
+	return typed.NewResource[DiscoveredVolumeSpec, DiscoveredVolumeExtension](
+		resource.NewMetadata(namespace, DiscoveredVolumeType, id, resource.VersionUndefined),
+		DiscoveredVolumeSpec{},
+	)
+}
+
+// DiscoveredVolumeExtension provides auxiliary methods for DiscoveredVolume.
+type DiscoveredVolumeExtension struct{}
+
+// ResourceDefinition implements [typed.Extension] interface.
+func (DiscoveredVolumeExtension) ResourceDefinition() meta.ResourceDefinitionSpec {
+	return meta.ResourceDefinitionSpec{
+		Type:             DiscoveredVolumeType,
+		Aliases:          []resource.Type{},
+		DefaultNamespace: NamespaceName,
+		PrintColumns: []meta.PrintColumn{
+			{
+				Name:     "Type",
+				JSONPath: "{.type}",
+			},
+			{
+				Name:     "Size",
+				JSONPath: "{.size}",
+			},
+			{
+				Name:     "Discovered",
+				JSONPath: "{.filesystemType}",
+			},
+			{
+				Name:     "Label",
+				JSONPath: "{.label}",
+			},
+			{
+				Name:     "PartitionLabel",
+				JSONPath: "{.partitionLabel}",
+			},
+		},
+	}
+}
+
+func init() {
+	proto.RegisterDefaultTypes()
+
+	err := protobuf.RegisterDynamic[DiscoveredVolumeSpec](DiscoveredVolumeType, &DiscoveredVolume{})
+	if err != nil {
+		panic(err)
+	}
+}
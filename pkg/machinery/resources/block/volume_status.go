@@ -0,0 +1,99 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package block
+
+import (
+	"github.com/cosi-project/runtime/pkg/resource"
+	"github.com/cosi-project/runtime/pkg/resource/meta"
+	"github.com/cosi-project/runtime/pkg/resource/protobuf"
+	"github.com/cosi-project/runtime/pkg/resource/typed"
+
+	"github.com/siderolabs/talos/pkg/machinery/proto"
+)
+
+// VolumeStatusType is type of VolumeStatus resource.
+const VolumeStatusType = resource.Type("VolumeStatuses.block.talos.dev")
+
+// VolumeStatus resource describes the provisioning status of a user volume.
+type VolumeStatus = typed.Resource[VolumeStatusSpec, VolumeStatusExtension]
+
+// Volume provisioning phases.
+const (
+	// VolumePhaseWaiting is set while the disks are being discovered.
+	VolumePhaseWaiting = "waiting"
+	// VolumePhaseProvisioned is set when the partition is created (or located) and formatted.
+	VolumePhaseProvisioned = "provisioned"
+	// VolumePhaseReady is set when the volume is mounted.
+	VolumePhaseReady = "ready"
+	// VolumePhaseFailed is set when the volume can't be provisioned or mounted.
+	VolumePhaseFailed = "failed"
+)
+
+// VolumeStatusSpec is the spec for the VolumeStatus resource.
+//
+//gotagsrewrite:gen
+type VolumeStatusSpec struct {
+	Phase string `yaml:"phase" protobuf:"1"`
+
+	// Location is the path to the partition.
+	Location string `yaml:"location,omitempty" protobuf:"2"`
+	// ParentLocation is the path to the disk.
+	ParentLocation string `yaml:"parentLocation,omitempty" protobuf:"3"`
+	Size           uint64 `yaml:"size,omitempty" protobuf:"4"`
+
+	Filesystem string `yaml:"filesystem,omitempty" protobuf:"5"`
+	Encrypted  bool   `yaml:"encrypted" protobuf:"6"`
+
+	MountLocation string `yaml:"mountLocation,omitempty" protobuf:"7"`
+
+	ErrorMessage string `yaml:"errorMessage,omitempty" protobuf:"8"`
+}
+
+// NewVolumeStatus initializes a VolumeStatus resource.
+func NewVolumeStatus(namespace resource.Namespace, id resource.ID) *VolumeStatus {
+	return typed.NewResource[VolumeStatusSpec, VolumeStatusExtension](
+		resource.NewMetadata(namespace, VolumeStatusType, id, resource.VersionUndefined),
+		VolumeStatusSpec{},
+	)
+}
+
+// VolumeStatusExtension provides auxiliary methods for VolumeStatus.
+type VolumeStatusExtension struct{}
+
+// ResourceDefinition implements [typed.Extension] interface.
+func (VolumeStatusExtension) ResourceDefinition() meta.ResourceDefinitionSpec {
+	return meta.ResourceDefinitionSpec{
+		Type:             VolumeStatusType,
+		Aliases:          []resource.Type{},
+		DefaultNamespace: NamespaceName,
+		PrintColumns: []meta.PrintColumn{
+			{
+				Name:     "Phase",
+				JSONPath: "{.phase}",
+			},
+			{
+				Name:     "Location",
+				JSONPath: "{.location}",
+			},
+			{
+				Name:     "Size",
+				JSONPath: "{.size}",
+			},
+			{
+				Name:     "Mount",
+				JSONPath: "{.mountLocation}",
+			},
+		},
+	}
+}
+
+func init() {
+	proto.RegisterDefaultTypes()
+
+	err := protobuf.RegisterDynamic[VolumeStatusSpec](VolumeStatusType, &VolumeStatus{})
+	if err != nil {
+		panic(err)
+	}
+}
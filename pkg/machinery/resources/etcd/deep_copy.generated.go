@@ -2,7 +2,7 @@
 // License, v. 2.0. If a copy of the MPL was not distributed with this
 // file, You can obtain one at http://mozilla.org/MPL/2.0/.
 
-// Code generated by "deep-copy -type ConfigSpec -type PKIStatusSpec -type SpecSpec -type MemberSpec -header-file ../../../../hack/boilerplate.txt -o deep_copy.generated.go ."; DO NOT EDIT.
+// Code generated by "deep-copy -type ConfigSpec -type PKIStatusSpec -type SpecSpec -type MemberSpec -type SnapshotSpec -header-file ../../../../hack/boilerplate.txt -o deep_copy.generated.go ."; DO NOT EDIT.
 
 package etcd
 
@@ -73,3 +73,9 @@ func (o MemberSpec) DeepCopy() MemberSpec {
 	var cp MemberSpec = o
 	return cp
 }
+
+// DeepCopy generates a deep copy of SnapshotSpec.
+func (o SnapshotSpec) DeepCopy() SnapshotSpec {
+	var cp SnapshotSpec = o
+	return cp
+}
@@ -27,6 +27,7 @@ func TestRegisterResource(t *testing.T) {
 
 	for _, resource := range []meta.ResourceWithRD{
 		&etcd.PKIStatus{},
+		&etcd.Snapshot{},
 	} {
 		assert.NoError(t, resourceRegistry.Register(ctx, resource))
 	}
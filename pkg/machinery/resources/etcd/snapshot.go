@@ -0,0 +1,76 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package etcd
+
+import (
+	"time"
+
+	"github.com/cosi-project/runtime/pkg/resource"
+	"github.com/cosi-project/runtime/pkg/resource/meta"
+	"github.com/cosi-project/runtime/pkg/resource/protobuf"
+	"github.com/cosi-project/runtime/pkg/resource/typed"
+
+	"github.com/siderolabs/talos/pkg/machinery/proto"
+)
+
+// SnapshotType is type of Snapshot resource.
+const SnapshotType = resource.Type("EtcdSnapshots.etcd.talos.dev")
+
+// Snapshot resource describes an etcd snapshot stored on the local disk.
+type Snapshot = typed.Resource[SnapshotSpec, SnapshotExtension]
+
+// SnapshotSpec describes a scheduled etcd snapshot.
+//
+//gotagsrewrite:gen
+type SnapshotSpec struct {
+	Path      string    `yaml:"path" protobuf:"1"`
+	Size      int64     `yaml:"size" protobuf:"2"`
+	SHA256    string    `yaml:"sha256" protobuf:"3"`
+	Revision  int64     `yaml:"revision" protobuf:"4"`
+	CreatedAt time.Time `yaml:"createdAt" protobuf:"5"`
+}
+
+// NewSnapshot initializes a Snapshot resource.
+func NewSnapshot(namespace resource.Namespace, id resource.ID) *Snapshot {
+	return typed.NewResource[SnapshotSpec, SnapshotExtension](
+		resource.NewMetadata(namespace, SnapshotType, id, resource.VersionUndefined),
+		SnapshotSpec{},
+	)
+}
+
+// SnapshotExtension provides auxiliary methods for Snapshot.
+type SnapshotExtension struct{}
+
+// ResourceDefinition implements [typed.Extension] interface.
+func (SnapshotExtension) ResourceDefinition() meta.ResourceDefinitionSpec {
+	return meta.ResourceDefinitionSpec{
+		Type:             SnapshotType,
+		Aliases:          []resource.Type{},
+		DefaultNamespace: NamespaceName,
+		PrintColumns: []meta.PrintColumn{
+			{
+				Name:     "Created",
+				JSONPath: "{.createdAt}",
+			},
+			{
+				Name:     "Revision",
+				JSONPath: "{.revision}",
+			},
+			{
+				Name:     "Size",
+				JSONPath: "{.size}",
+			},
+		},
+	}
+}
+
+func init() {
+	proto.RegisterDefaultTypes()
+
+	err := protobuf.RegisterDynamic[SnapshotSpec](SnapshotType, &Snapshot{})
+	if err != nil {
+		panic(err)
+	}
+}
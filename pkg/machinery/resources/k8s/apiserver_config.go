@@ -50,6 +50,8 @@ type APIServerConfigSpec struct {
 	EnvironmentVariables     map[string]string `yaml:"environmentVariables" protobuf:"9"`
 	PodSecurityPolicyEnabled bool              `yaml:"podSecurityPolicyEnabled" protobuf:"10"`
 	AdvertisedAddress        string            `yaml:"advertisedAddress" protobuf:"11"`
+	KMSPluginImage           string            `yaml:"kmsPluginImage,omitempty" protobuf:"12"`
+	KMSPluginExtraArgs       map[string]string `yaml:"kmsPluginExtraArgs,omitempty" protobuf:"13"`
 }
 
 // NewAPIServerConfig returns new APIServerConfig resource.
@@ -67,6 +67,12 @@ func (o APIServerConfigSpec) DeepCopy() APIServerConfigSpec {
 			cp.EnvironmentVariables[k2] = v2
 		}
 	}
+	if o.KMSPluginExtraArgs != nil {
+		cp.KMSPluginExtraArgs = make(map[string]string, len(o.KMSPluginExtraArgs))
+		for k2, v2 := range o.KMSPluginExtraArgs {
+			cp.KMSPluginExtraArgs[k2] = v2
+		}
+	}
 	return cp
 }
 
@@ -30,3 +30,6 @@ const ControllerManagerID = "kube-controller-manager"
 
 // SchedulerID is a generic ID for resources related to kube-scheduler.
 const SchedulerID = "kube-scheduler"
+
+// KMSPluginID is a generic ID for resources related to the KMS plugin managed by Talos.
+const KMSPluginID = "kms-plugin"
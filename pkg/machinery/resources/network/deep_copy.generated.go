@@ -2,12 +2,14 @@
 // License, v. 2.0. If a copy of the MPL was not distributed with this
 // file, You can obtain one at http://mozilla.org/MPL/2.0/.
 
-// Code generated by "deep-copy -type AddressSpecSpec -type AddressStatusSpec -type HardwareAddrSpec -type HostnameSpecSpec -type HostnameStatusSpec -type LinkRefreshSpec -type LinkSpecSpec -type LinkStatusSpec -type NodeAddressSpec -type NodeAddressFilterSpec -type OperatorSpecSpec -type ProbeSpecSpec -type ProbeStatusSpec -type ResolverSpecSpec -type ResolverStatusSpec -type RouteSpecSpec -type RouteStatusSpec -type StatusSpec -type TimeServerSpecSpec -type TimeServerStatusSpec -header-file ../../../../hack/boilerplate.txt -o deep_copy.generated.go ."; DO NOT EDIT.
+// Code generated by "deep-copy -type AddressSpecSpec -type AddressStatusSpec -type DNSResolveCacheSpec -type EthernetSpecSpec -type EthernetStatusSpec -type HardwareAddrSpec -type HostDNSConfigSpec -type HostnameSpecSpec -type HostnameStatusSpec -type LinkRefreshSpec -type LinkSpecSpec -type LinkStatusSpec -type LLDPNeighborSpec -type NeighborSpecSpec -type NeighborStatusSpec -type NfTablesChainSpec -type NodeAddressSpec -type NodeAddressFilterSpec -type OperatorSpecSpec -type ProbeSpecSpec -type ProbeStatusSpec -type ResolverSpecSpec -type ResolverStatusSpec -type RouteSpecSpec -type RouteStatusSpec -type RoutingRuleSpecSpec -type RoutingRuleStatusSpec -type StatusSpec -type TimeServerSpecSpec -type TimeServerStatusSpec -type TrafficControlSpecSpec -type TrafficControlStatusSpec -header-file ../../../../hack/boilerplate.txt -o deep_copy.generated.go ."; DO NOT EDIT.
 
 package network
 
 import (
 	"net/netip"
+
+	"github.com/siderolabs/talos/pkg/machinery/nethelpers"
 )
 
 // DeepCopy generates a deep copy of AddressSpecSpec.
@@ -22,6 +24,82 @@ func (o AddressStatusSpec) DeepCopy() AddressStatusSpec {
 	return cp
 }
 
+// DeepCopy generates a deep copy of DNSResolveCacheSpec.
+func (o DNSResolveCacheSpec) DeepCopy() DNSResolveCacheSpec {
+	var cp DNSResolveCacheSpec = o
+	return cp
+}
+
+// DeepCopy generates a deep copy of EthernetSpecSpec.
+func (o EthernetSpecSpec) DeepCopy() EthernetSpecSpec {
+	var cp EthernetSpecSpec = o
+	if o.Rings.RX != nil {
+		cp.Rings.RX = new(uint32)
+		*cp.Rings.RX = *o.Rings.RX
+	}
+	if o.Rings.TX != nil {
+		cp.Rings.TX = new(uint32)
+		*cp.Rings.TX = *o.Rings.TX
+	}
+	if o.Features != nil {
+		cp.Features = make(map[string]bool, len(o.Features))
+		for k2, v2 := range o.Features {
+			cp.Features[k2] = v2
+		}
+	}
+	if o.Channels.RX != nil {
+		cp.Channels.RX = new(uint32)
+		*cp.Channels.RX = *o.Channels.RX
+	}
+	if o.Channels.TX != nil {
+		cp.Channels.TX = new(uint32)
+		*cp.Channels.TX = *o.Channels.TX
+	}
+	if o.Channels.Other != nil {
+		cp.Channels.Other = new(uint32)
+		*cp.Channels.Other = *o.Channels.Other
+	}
+	if o.Channels.Combined != nil {
+		cp.Channels.Combined = new(uint32)
+		*cp.Channels.Combined = *o.Channels.Combined
+	}
+	if o.Pause.Autonegotiate != nil {
+		cp.Pause.Autonegotiate = new(bool)
+		*cp.Pause.Autonegotiate = *o.Pause.Autonegotiate
+	}
+	if o.Pause.RX != nil {
+		cp.Pause.RX = new(bool)
+		*cp.Pause.RX = *o.Pause.RX
+	}
+	if o.Pause.TX != nil {
+		cp.Pause.TX = new(bool)
+		*cp.Pause.TX = *o.Pause.TX
+	}
+	return cp
+}
+
+// DeepCopy generates a deep copy of EthernetStatusSpec.
+func (o EthernetStatusSpec) DeepCopy() EthernetStatusSpec {
+	var cp EthernetStatusSpec = o
+	if o.Rings != nil {
+		cp.Rings = new(EthernetRingsStatus)
+		*cp.Rings = *o.Rings
+	}
+	if o.Features != nil {
+		cp.Features = make([]EthernetFeatureStatus, len(o.Features))
+		copy(cp.Features, o.Features)
+	}
+	if o.Channels != nil {
+		cp.Channels = new(EthernetChannelsStatus)
+		*cp.Channels = *o.Channels
+	}
+	if o.Pause != nil {
+		cp.Pause = new(EthernetPauseStatus)
+		*cp.Pause = *o.Pause
+	}
+	return cp
+}
+
 // DeepCopy generates a deep copy of HardwareAddrSpec.
 func (o HardwareAddrSpec) DeepCopy() HardwareAddrSpec {
 	var cp HardwareAddrSpec = o
@@ -32,6 +110,36 @@ func (o HardwareAddrSpec) DeepCopy() HardwareAddrSpec {
 	return cp
 }
 
+// DeepCopy generates a deep copy of HostDNSConfigSpec.
+func (o HostDNSConfigSpec) DeepCopy() HostDNSConfigSpec {
+	var cp HostDNSConfigSpec = o
+	if o.ListenAddresses != nil {
+		cp.ListenAddresses = make([]netip.AddrPort, len(o.ListenAddresses))
+		copy(cp.ListenAddresses, o.ListenAddresses)
+	}
+	if o.ForwardRules != nil {
+		cp.ForwardRules = make([]DNSForwardRule, len(o.ForwardRules))
+		copy(cp.ForwardRules, o.ForwardRules)
+		for i2 := range o.ForwardRules {
+			if o.ForwardRules[i2].Servers != nil {
+				cp.ForwardRules[i2].Servers = make([]netip.AddrPort, len(o.ForwardRules[i2].Servers))
+				copy(cp.ForwardRules[i2].Servers, o.ForwardRules[i2].Servers)
+			}
+		}
+	}
+	if o.ExtraHosts != nil {
+		cp.ExtraHosts = make([]DNSExtraHost, len(o.ExtraHosts))
+		copy(cp.ExtraHosts, o.ExtraHosts)
+		for i2 := range o.ExtraHosts {
+			if o.ExtraHosts[i2].Hostnames != nil {
+				cp.ExtraHosts[i2].Hostnames = make([]string, len(o.ExtraHosts[i2].Hostnames))
+				copy(cp.ExtraHosts[i2].Hostnames, o.ExtraHosts[i2].Hostnames)
+			}
+		}
+	}
+	return cp
+}
+
 // DeepCopy generates a deep copy of HostnameSpecSpec.
 func (o HostnameSpecSpec) DeepCopy() HostnameSpecSpec {
 	var cp HostnameSpecSpec = o
@@ -94,6 +202,80 @@ func (o LinkStatusSpec) DeepCopy() LinkStatusSpec {
 	return cp
 }
 
+// DeepCopy generates a deep copy of LLDPNeighborSpec.
+func (o LLDPNeighborSpec) DeepCopy() LLDPNeighborSpec {
+	var cp LLDPNeighborSpec = o
+	return cp
+}
+
+// DeepCopy generates a deep copy of NeighborSpecSpec.
+func (o NeighborSpecSpec) DeepCopy() NeighborSpecSpec {
+	var cp NeighborSpecSpec = o
+	if o.HardwareAddr != nil {
+		cp.HardwareAddr = make([]byte, len(o.HardwareAddr))
+		copy(cp.HardwareAddr, o.HardwareAddr)
+	}
+	return cp
+}
+
+// DeepCopy generates a deep copy of NeighborStatusSpec.
+func (o NeighborStatusSpec) DeepCopy() NeighborStatusSpec {
+	var cp NeighborStatusSpec = o
+	if o.HardwareAddr != nil {
+		cp.HardwareAddr = make([]byte, len(o.HardwareAddr))
+		copy(cp.HardwareAddr, o.HardwareAddr)
+	}
+	return cp
+}
+
+// DeepCopy generates a deep copy of NfTablesChainSpec.
+func (o NfTablesChainSpec) DeepCopy() NfTablesChainSpec {
+	var cp NfTablesChainSpec = o
+	if o.Rules != nil {
+		cp.Rules = make([]NfTablesRule, len(o.Rules))
+		copy(cp.Rules, o.Rules)
+		for i2 := range o.Rules {
+			if o.Rules[i2].MatchIIfName != nil {
+				cp.Rules[i2].MatchIIfName = new(NfTablesIfNameMatch)
+				*cp.Rules[i2].MatchIIfName = *o.Rules[i2].MatchIIfName
+			}
+			if o.Rules[i2].MatchSourceAddress != nil {
+				cp.Rules[i2].MatchSourceAddress = new(NfTablesAddressMatch)
+				*cp.Rules[i2].MatchSourceAddress = *o.Rules[i2].MatchSourceAddress
+				if o.Rules[i2].MatchSourceAddress.IncludeSubnets != nil {
+					cp.Rules[i2].MatchSourceAddress.IncludeSubnets = make([]netip.Prefix, len(o.Rules[i2].MatchSourceAddress.IncludeSubnets))
+					copy(cp.Rules[i2].MatchSourceAddress.IncludeSubnets, o.Rules[i2].MatchSourceAddress.IncludeSubnets)
+				}
+				if o.Rules[i2].MatchSourceAddress.ExcludeSubnets != nil {
+					cp.Rules[i2].MatchSourceAddress.ExcludeSubnets = make([]netip.Prefix, len(o.Rules[i2].MatchSourceAddress.ExcludeSubnets))
+					copy(cp.Rules[i2].MatchSourceAddress.ExcludeSubnets, o.Rules[i2].MatchSourceAddress.ExcludeSubnets)
+				}
+			}
+			if o.Rules[i2].MatchLayer4 != nil {
+				cp.Rules[i2].MatchLayer4 = new(NfTablesLayer4Match)
+				*cp.Rules[i2].MatchLayer4 = *o.Rules[i2].MatchLayer4
+				if o.Rules[i2].MatchLayer4.MatchDestinationPort != nil {
+					cp.Rules[i2].MatchLayer4.MatchDestinationPort = new(NfTablesPortMatch)
+					*cp.Rules[i2].MatchLayer4.MatchDestinationPort = *o.Rules[i2].MatchLayer4.MatchDestinationPort
+					if o.Rules[i2].MatchLayer4.MatchDestinationPort.Ranges != nil {
+						cp.Rules[i2].MatchLayer4.MatchDestinationPort.Ranges = make([]PortRange, len(o.Rules[i2].MatchLayer4.MatchDestinationPort.Ranges))
+						copy(cp.Rules[i2].MatchLayer4.MatchDestinationPort.Ranges, o.Rules[i2].MatchLayer4.MatchDestinationPort.Ranges)
+					}
+				}
+			}
+			if o.Rules[i2].MatchConntrackState != nil {
+				cp.Rules[i2].MatchConntrackState = new(NfTablesConntrackStateMatch)
+				*cp.Rules[i2].MatchConntrackState = *o.Rules[i2].MatchConntrackState
+				if o.Rules[i2].MatchConntrackState.States != nil {
+					cp.Rules[i2].MatchConntrackState.States = make([]nethelpers.ConntrackState, len(o.Rules[i2].MatchConntrackState.States))
+					copy(cp.Rules[i2].MatchConntrackState.States, o.Rules[i2].MatchConntrackState.States)
+				}
+			}
+		}
+	}
+	return cp
+}
+
 // DeepCopy generates a deep copy of NodeAddressSpec.
 func (o NodeAddressSpec) DeepCopy() NodeAddressSpec {
 	var cp NodeAddressSpec = o
@@ -121,6 +303,14 @@ func (o NodeAddressFilterSpec) DeepCopy() NodeAddressFilterSpec {
 // DeepCopy generates a deep copy of OperatorSpecSpec.
 func (o OperatorSpecSpec) DeepCopy() OperatorSpecSpec {
 	var cp OperatorSpecSpec = o
+	if o.VIP.BGP != nil {
+		cp.VIP.BGP = new(VIPBGPSpec)
+		*cp.VIP.BGP = *o.VIP.BGP
+		if o.VIP.BGP.Peers != nil {
+			cp.VIP.BGP.Peers = make([]VIPBGPPeerSpec, len(o.VIP.BGP.Peers))
+			copy(cp.VIP.BGP.Peers, o.VIP.BGP.Peers)
+		}
+	}
 	return cp
 }
 
@@ -168,6 +358,18 @@ func (o RouteStatusSpec) DeepCopy() RouteStatusSpec {
 	return cp
 }
 
+// DeepCopy generates a deep copy of RoutingRuleSpecSpec.
+func (o RoutingRuleSpecSpec) DeepCopy() RoutingRuleSpecSpec {
+	var cp RoutingRuleSpecSpec = o
+	return cp
+}
+
+// DeepCopy generates a deep copy of RoutingRuleStatusSpec.
+func (o RoutingRuleStatusSpec) DeepCopy() RoutingRuleStatusSpec {
+	var cp RoutingRuleStatusSpec = o
+	return cp
+}
+
 // DeepCopy generates a deep copy of StatusSpec.
 func (o StatusSpec) DeepCopy() StatusSpec {
 	var cp StatusSpec = o
@@ -193,3 +395,35 @@ func (o TimeServerStatusSpec) DeepCopy() TimeServerStatusSpec {
 	}
 	return cp
 }
+
+// DeepCopy generates a deep copy of TrafficControlSpecSpec.
+func (o TrafficControlSpecSpec) DeepCopy() TrafficControlSpecSpec {
+	var cp TrafficControlSpecSpec = o
+	if o.HTB.Classes != nil {
+		cp.HTB.Classes = make([]TrafficControlHTBClassSpec, len(o.HTB.Classes))
+		copy(cp.HTB.Classes, o.HTB.Classes)
+	}
+	if o.Filters != nil {
+		cp.Filters = make([]TrafficControlFilterSpec, len(o.Filters))
+		copy(cp.Filters, o.Filters)
+	}
+	return cp
+}
+
+// DeepCopy generates a deep copy of TrafficControlStatusSpec.
+func (o TrafficControlStatusSpec) DeepCopy() TrafficControlStatusSpec {
+	var cp TrafficControlStatusSpec = o
+	if o.Qdiscs != nil {
+		cp.Qdiscs = make([]TrafficControlQdiscStatus, len(o.Qdiscs))
+		copy(cp.Qdiscs, o.Qdiscs)
+	}
+	if o.Classes != nil {
+		cp.Classes = make([]TrafficControlClassStatus, len(o.Classes))
+		copy(cp.Classes, o.Classes)
+	}
+	if o.Filters != nil {
+		cp.Filters = make([]TrafficControlFilterStatus, len(o.Filters))
+		copy(cp.Filters, o.Filters)
+	}
+	return cp
+}
@@ -0,0 +1,119 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package network
+
+import (
+	"github.com/cosi-project/runtime/pkg/resource"
+	"github.com/cosi-project/runtime/pkg/resource/meta"
+	"github.com/cosi-project/runtime/pkg/resource/protobuf"
+	"github.com/cosi-project/runtime/pkg/resource/typed"
+
+	"github.com/siderolabs/talos/pkg/machinery/proto"
+)
+
+// EthernetStatusType is type of EthernetStatus resource.
+const EthernetStatusType = resource.Type("EthernetStatuses.net.talos.dev")
+
+// EthernetStatus resource holds the current ethtool settings of the link.
+//
+// EthernetStatus ID is the link name.
+type EthernetStatus = typed.Resource[EthernetStatusSpec, EthernetStatusExtension]
+
+// EthernetStatusSpec describes the current ethtool settings of the link.
+//
+// Settings which are not supported by the link driver are not set.
+//
+//gotagsrewrite:gen
+type EthernetStatusSpec struct {
+	Rings    *EthernetRingsStatus    `yaml:"rings,omitempty" protobuf:"1"`
+	Features []EthernetFeatureStatus `yaml:"features,omitempty" protobuf:"2"`
+	Channels *EthernetChannelsStatus `yaml:"channels,omitempty" protobuf:"3"`
+	Pause    *EthernetPauseStatus    `yaml:"pause,omitempty" protobuf:"4"`
+}
+
+// EthernetRingsStatus describes the current and maximum ring buffer sizes of the link.
+//
+//gotagsrewrite:gen
+type EthernetRingsStatus struct {
+	RXMax uint32 `yaml:"rx-max" protobuf:"1"`
+	TXMax uint32 `yaml:"tx-max" protobuf:"2"`
+	RX    uint32 `yaml:"rx" protobuf:"3"`
+	TX    uint32 `yaml:"tx" protobuf:"4"`
+}
+
+// EthernetFeatureStatus describes the state of the link feature.
+//
+//gotagsrewrite:gen
+type EthernetFeatureStatus struct {
+	Name string `yaml:"name" protobuf:"1"`
+	// Status is "on" or "off", suffixed with " [fixed]" if the feature can't be changed.
+	Status string `yaml:"status" protobuf:"2"`
+}
+
+// EthernetChannelsStatus describes the current and maximum channel counts of the link.
+//
+//gotagsrewrite:gen
+type EthernetChannelsStatus struct {
+	RXMax       uint32 `yaml:"rx-max" protobuf:"1"`
+	TXMax       uint32 `yaml:"tx-max" protobuf:"2"`
+	OtherMax    uint32 `yaml:"other-max" protobuf:"3"`
+	CombinedMax uint32 `yaml:"combined-max" protobuf:"4"`
+	RX          uint32 `yaml:"rx" protobuf:"5"`
+	TX          uint32 `yaml:"tx" protobuf:"6"`
+	Other       uint32 `yaml:"other" protobuf:"7"`
+	Combined    uint32 `yaml:"combined" protobuf:"8"`
+}
+
+// EthernetPauseStatus describes the current pause frame settings of the link.
+//
+//gotagsrewrite:gen
+type EthernetPauseStatus struct {
+	Autonegotiate bool `yaml:"autonegotiate" protobuf:"1"`
+	RX            bool `yaml:"rx" protobuf:"2"`
+	TX            bool `yaml:"tx" protobuf:"3"`
+}
+
+// NewEthernetStatus initializes a EthernetStatus resource.
+func NewEthernetStatus(namespace resource.Namespace, id resource.ID) *EthernetStatus {
+	return typed.NewResource[EthernetStatusSpec, EthernetStatusExtension](
+		resource.NewMetadata(namespace, EthernetStatusType, id, resource.VersionUndefined),
+		EthernetStatusSpec{},
+	)
+}
+
+// EthernetStatusExtension provides auxiliary methods for EthernetStatus.
+type EthernetStatusExtension struct{}
+
+// ResourceDefinition implements [typed.Extension] interface.
+func (EthernetStatusExtension) ResourceDefinition() meta.ResourceDefinitionSpec {
+	return meta.ResourceDefinitionSpec{
+		Type:             EthernetStatusType,
+		Aliases:          []resource.Type{"ethtool"},
+		DefaultNamespace: NamespaceName,
+		PrintColumns: []meta.PrintColumn{
+			{
+				Name:     "RX Ring",
+				JSONPath: "{.rings.rx}",
+			},
+			{
+				Name:     "TX Ring",
+				JSONPath: "{.rings.tx}",
+			},
+			{
+				Name:     "Combined Channels",
+				JSONPath: "{.channels.combined}",
+			},
+		},
+	}
+}
+
+func init() {
+	proto.RegisterDefaultTypes()
+
+	err := protobuf.RegisterDynamic[EthernetStatusSpec](EthernetStatusType, &EthernetStatus{})
+	if err != nil {
+		panic(err)
+	}
+}
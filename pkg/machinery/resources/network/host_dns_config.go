@@ -0,0 +1,95 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package network
+
+import (
+	"net/netip"
+
+	"github.com/cosi-project/runtime/pkg/resource"
+	"github.com/cosi-project/runtime/pkg/resource/meta"
+	"github.com/cosi-project/runtime/pkg/resource/protobuf"
+	"github.com/cosi-project/runtime/pkg/resource/typed"
+
+	"github.com/siderolabs/talos/pkg/machinery/proto"
+)
+
+// HostDNSConfigType is type of HostDNSConfig resource.
+const HostDNSConfigType = resource.Type("HostDNSConfigs.net.talos.dev")
+
+// HostDNSConfigID is the singleton resource ID.
+const HostDNSConfigID resource.ID = "config"
+
+// HostDNSConfig resource holds the configuration of the host caching DNS resolver.
+type HostDNSConfig = typed.Resource[HostDNSConfigSpec, HostDNSConfigExtension]
+
+// HostDNSConfigSpec describes the host caching DNS resolver.
+//
+//gotagsrewrite:gen
+type HostDNSConfigSpec struct {
+	Enabled bool `yaml:"enabled" protobuf:"1"`
+	// ListenAddresses the resolver listens on (both UDP and TCP).
+	ListenAddresses []netip.AddrPort `yaml:"listenAddresses,omitempty" protobuf:"2"`
+	// ServiceHostDNSAddress is the address Kubernetes pods use to reach the resolver, if set.
+	ServiceHostDNSAddress netip.Addr `yaml:"serviceHostDNSAddress,omitempty" protobuf:"3"`
+	// ForwardRules send the queries for the domain (and its subdomains) to the specific servers.
+	ForwardRules []DNSForwardRule `yaml:"forwardRules,omitempty" protobuf:"4"`
+	// ExtraHosts are served by the resolver in addition to /etc/hosts.
+	ExtraHosts []DNSExtraHost `yaml:"extraHosts,omitempty" protobuf:"5"`
+}
+
+// DNSForwardRule describes split-DNS forwarding of a domain.
+//
+//gotagsrewrite:gen
+type DNSForwardRule struct {
+	Domain  string           `yaml:"domain" protobuf:"1"`
+	Servers []netip.AddrPort `yaml:"servers" protobuf:"2"`
+}
+
+// DNSExtraHost describes a static host entry.
+//
+//gotagsrewrite:gen
+type DNSExtraHost struct {
+	Address   netip.Addr `yaml:"address" protobuf:"1"`
+	Hostnames []string   `yaml:"hostnames" protobuf:"2"`
+}
+
+// NewHostDNSConfig initializes a HostDNSConfig resource.
+func NewHostDNSConfig(namespace resource.Namespace, id resource.ID) *HostDNSConfig {
+	return typed.NewResource[HostDNSConfigSpec, HostDNSConfigExtension](
+		resource.NewMetadata(namespace, HostDNSConfigType, id, resource.VersionUndefined),
+		HostDNSConfigSpec{},
+	)
+}
+
+// HostDNSConfigExtension provides auxiliary methods for HostDNSConfig.
+type HostDNSConfigExtension struct{}
+
+// ResourceDefinition implements [typed.Extension] interface.
+func (HostDNSConfigExtension) ResourceDefinition() meta.ResourceDefinitionSpec {
+	return meta.ResourceDefinitionSpec{
+		Type:             HostDNSConfigType,
+		Aliases:          []resource.Type{},
+		DefaultNamespace: NamespaceName,
+		PrintColumns: []meta.PrintColumn{
+			{
+				Name:     "Enabled",
+				JSONPath: "{.enabled}",
+			},
+			{
+				Name:     "Listen Addresses",
+				JSONPath: "{.listenAddresses}",
+			},
+		},
+	}
+}
+
+func init() {
+	proto.RegisterDefaultTypes()
+
+	err := protobuf.RegisterDynamic[HostDNSConfigSpec](HostDNSConfigType, &HostDNSConfig{})
+	if err != nil {
+		panic(err)
+	}
+}
@@ -67,6 +67,42 @@ type STPSpec struct {
 	Enabled bool `yaml:"enabled" protobuf:"1"`
 }
 
+// VRFMasterSpec describes VRF settings if Kind == "vrf".
+//
+//gotagsrewrite:gen
+type VRFMasterSpec struct {
+	// Table is the routing table the VRF is bound to.
+	Table nethelpers.RoutingTable `yaml:"table" protobuf:"1"`
+}
+
+// VXLANSpec describes VXLAN settings if Kind == "vxlan".
+//
+//gotagsrewrite:gen
+type VXLANSpec struct {
+	// VNI is the VXLAN network identifier.
+	VNI uint32 `yaml:"vni" protobuf:"1"`
+	// Local is the source address of the tunnel packets.
+	Local netip.Addr `yaml:"local,omitempty" protobuf:"2"`
+	// Remote is the unicast remote tunnel endpoint or the multicast group address.
+	Remote netip.Addr `yaml:"remote,omitempty" protobuf:"3"`
+	// DstPort is the UDP destination port of the remote tunnel endpoint.
+	DstPort uint16 `yaml:"dstPort,omitempty" protobuf:"4"`
+}
+
+// MACVLANSpec describes MACVLAN settings if Kind == "macvlan".
+//
+//gotagsrewrite:gen
+type MACVLANSpec struct {
+	Mode nethelpers.MACVLANMode `yaml:"mode" protobuf:"1"`
+}
+
+// IPVLANSpec describes IPVLAN settings if Kind == "ipvlan".
+//
+//gotagsrewrite:gen
+type IPVLANSpec struct {
+	Mode nethelpers.IPVLANMode `yaml:"mode" protobuf:"1"`
+}
+
 // WireguardSpec describes Wireguard settings if Kind == "wireguard".
 //
 //gotagsrewrite:gen
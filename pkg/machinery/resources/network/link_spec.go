@@ -51,11 +51,18 @@ type LinkSpecSpec struct {
 	// BridgeSlave indicates master link for bridged interfaces.
 	BridgeSlave BridgeSlave `yaml:"bridgeSlave,omitempty" protobuf:"9"`
 
+	// VRFSlave indicates master link for interfaces enslaved to a VRF.
+	VRFSlave VRFSlave `yaml:"vrfSlave,omitempty" protobuf:"15"`
+
 	// These structures are present depending on "Kind" for Logical interfaces.
 	VLAN         VLANSpec         `yaml:"vlan,omitempty" protobuf:"10"`
 	BondMaster   BondMasterSpec   `yaml:"bondMaster,omitempty" protobuf:"11"`
 	BridgeMaster BridgeMasterSpec `yaml:"bridgeMaster,omitempty" protobuf:"12"`
 	Wireguard    WireguardSpec    `yaml:"wireguard,omitempty" protobuf:"13"`
+	VRFMaster    VRFMasterSpec    `yaml:"vrfMaster,omitempty" protobuf:"16"`
+	VXLAN        VXLANSpec        `yaml:"vxlan,omitempty" protobuf:"17"`
+	MACVLAN      MACVLANSpec      `yaml:"macvlan,omitempty" protobuf:"18"`
+	IPVLAN       IPVLANSpec       `yaml:"ipvlan,omitempty" protobuf:"19"`
 
 	// Configuration layer.
 	ConfigLayer ConfigLayer `yaml:"layer" protobuf:"14"`
@@ -80,6 +87,14 @@ type BridgeSlave struct {
 	MasterName string `yaml:"masterName,omitempty" protobuf:"1"`
 }
 
+// VRFSlave contains a VRF master name.
+//
+//gotagsrewrite:gen
+type VRFSlave struct {
+	// MasterName indicates master link for interfaces enslaved to a VRF.
+	MasterName string `yaml:"masterName,omitempty" protobuf:"1"`
+}
+
 // Merge with other, overwriting fields from other if set.
 //
 //nolint:gocyclo
@@ -96,6 +111,11 @@ func (spec *LinkSpecSpec) Merge(other *LinkSpecSpec) error {
 	updateIfNotZero(&spec.BondMaster, other.BondMaster)
 	updateIfNotZero(&spec.BridgeMaster, other.BridgeMaster)
 	updateIfNotZero(&spec.BridgeSlave, other.BridgeSlave)
+	updateIfNotZero(&spec.VRFSlave, other.VRFSlave)
+	updateIfNotZero(&spec.VRFMaster, other.VRFMaster)
+	updateIfNotZero(&spec.VXLAN, other.VXLAN)
+	updateIfNotZero(&spec.MACVLAN, other.MACVLAN)
+	updateIfNotZero(&spec.IPVLAN, other.IPVLAN)
 
 	// Wireguard config should be able to apply non-zero values in earlier config layers which may be zero values in later layers.
 	// Thus, we handle each Wireguard configuration value discretely.
@@ -57,6 +57,10 @@ type LinkStatusSpec struct {
 	BridgeMaster BridgeMasterSpec `yaml:"bridgeMaster,omitempty" protobuf:"27"`
 	BondMaster   BondMasterSpec   `yaml:"bondMaster,omitempty" protobuf:"28"`
 	Wireguard    WireguardSpec    `yaml:"wireguard,omitempty" protobuf:"29"`
+	VRFMaster    VRFMasterSpec    `yaml:"vrfMaster,omitempty" protobuf:"31"`
+	VXLAN        VXLANSpec        `yaml:"vxlan,omitempty" protobuf:"32"`
+	MACVLAN      MACVLANSpec      `yaml:"macvlan,omitempty" protobuf:"33"`
+	IPVLAN       IPVLANSpec       `yaml:"ipvlan,omitempty" protobuf:"34"`
 }
 
 // Physical checks if the link is physical ethernet.
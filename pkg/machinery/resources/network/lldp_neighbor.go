@@ -0,0 +1,87 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package network
+
+import (
+	"net/netip"
+	"time"
+
+	"github.com/cosi-project/runtime/pkg/resource"
+	"github.com/cosi-project/runtime/pkg/resource/meta"
+	"github.com/cosi-project/runtime/pkg/resource/protobuf"
+	"github.com/cosi-project/runtime/pkg/resource/typed"
+
+	"github.com/siderolabs/talos/pkg/machinery/proto"
+)
+
+// LLDPNeighborType is type of LLDPNeighbor resource.
+const LLDPNeighborType = resource.Type("LLDPNeighbors.net.talos.dev")
+
+// LLDPNeighbor resource holds the information about the neighbor discovered via LLDP.
+type LLDPNeighbor = typed.Resource[LLDPNeighborSpec, LLDPNeighborExtension]
+
+// LLDPNeighborSpec describes the neighbor discovered via LLDP on the link.
+//
+//gotagsrewrite:gen
+type LLDPNeighborSpec struct {
+	// LinkName is the local link the neighbor was seen on.
+	LinkName string `yaml:"linkName" protobuf:"1"`
+
+	ChassisID         string        `yaml:"chassisID" protobuf:"2"`
+	PortID            string        `yaml:"portID" protobuf:"3"`
+	PortDescription   string        `yaml:"portDescription,omitempty" protobuf:"4"`
+	SystemName        string        `yaml:"systemName,omitempty" protobuf:"5"`
+	SystemDescription string        `yaml:"systemDescription,omitempty" protobuf:"6"`
+	ManagementAddress netip.Addr    `yaml:"managementAddress,omitempty" protobuf:"7"`
+	VLANID            uint16        `yaml:"vlanID,omitempty" protobuf:"8"`
+	TTL               time.Duration `yaml:"ttl" protobuf:"9"`
+}
+
+// NewLLDPNeighbor initializes a LLDPNeighbor resource.
+func NewLLDPNeighbor(namespace resource.Namespace, id resource.ID) *LLDPNeighbor {
+	return typed.NewResource[LLDPNeighborSpec, LLDPNeighborExtension](
+		resource.NewMetadata(namespace, LLDPNeighborType, id, resource.VersionUndefined),
+		LLDPNeighborSpec{},
+	)
+}
+
+// LLDPNeighborExtension provides auxiliary methods for LLDPNeighbor.
+type LLDPNeighborExtension struct{}
+
+// ResourceDefinition implements [typed.Extension] interface.
+func (LLDPNeighborExtension) ResourceDefinition() meta.ResourceDefinitionSpec {
+	return meta.ResourceDefinitionSpec{
+		Type:             LLDPNeighborType,
+		Aliases:          []resource.Type{"lldp"},
+		DefaultNamespace: NamespaceName,
+		PrintColumns: []meta.PrintColumn{
+			{
+				Name:     "Link",
+				JSONPath: "{.linkName}",
+			},
+			{
+				Name:     "System Name",
+				JSONPath: "{.systemName}",
+			},
+			{
+				Name:     "Port ID",
+				JSONPath: "{.portID}",
+			},
+			{
+				Name:     "VLAN",
+				JSONPath: "{.vlanID}",
+			},
+		},
+	}
+}
+
+func init() {
+	proto.RegisterDefaultTypes()
+
+	err := protobuf.RegisterDynamic[LLDPNeighborSpec](LLDPNeighborType, &LLDPNeighbor{})
+	if err != nil {
+		panic(err)
+	}
+}
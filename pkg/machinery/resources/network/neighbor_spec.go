@@ -0,0 +1,67 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package network
+
+import (
+	"net/netip"
+
+	"github.com/cosi-project/runtime/pkg/resource"
+	"github.com/cosi-project/runtime/pkg/resource/meta"
+	"github.com/cosi-project/runtime/pkg/resource/protobuf"
+	"github.com/cosi-project/runtime/pkg/resource/typed"
+
+	"github.com/siderolabs/talos/pkg/machinery/nethelpers"
+	"github.com/siderolabs/talos/pkg/machinery/proto"
+)
+
+// NeighborSpecType is type of NeighborSpec resource.
+const NeighborSpecType = resource.Type("NeighborSpecs.net.talos.dev")
+
+// NeighborSpec resource holds permanent neighbor (ARP/NDP) entry specification to be applied to the kernel.
+type NeighborSpec = typed.Resource[NeighborSpecSpec, NeighborSpecExtension]
+
+// NeighborSpecSpec describes the permanent neighbor entry.
+//
+// Proxy entries make the link answer ARP/NDP requests for the address, they don't have a hardware address.
+//
+//gotagsrewrite:gen
+type NeighborSpecSpec struct {
+	Family       nethelpers.Family       `yaml:"family" protobuf:"1"`
+	Address      netip.Addr              `yaml:"address" protobuf:"2"`
+	LinkName     string                  `yaml:"linkName" protobuf:"3"`
+	HardwareAddr nethelpers.HardwareAddr `yaml:"hardwareAddr,omitempty" protobuf:"4"`
+	Proxy        bool                    `yaml:"proxy,omitempty" protobuf:"5"`
+	ConfigLayer  ConfigLayer             `yaml:"layer" protobuf:"6"`
+}
+
+// NewNeighborSpec initializes a NeighborSpec resource.
+func NewNeighborSpec(namespace resource.Namespace, id resource.ID) *NeighborSpec {
+	return typed.NewResource[NeighborSpecSpec, NeighborSpecExtension](
+		resource.NewMetadata(namespace, NeighborSpecType, id, resource.VersionUndefined),
+		NeighborSpecSpec{},
+	)
+}
+
+// NeighborSpecExtension provides auxiliary methods for NeighborSpec.
+type NeighborSpecExtension struct{}
+
+// ResourceDefinition implements [typed.Extension] interface.
+func (NeighborSpecExtension) ResourceDefinition() meta.ResourceDefinitionSpec {
+	return meta.ResourceDefinitionSpec{
+		Type:             NeighborSpecType,
+		Aliases:          []resource.Type{},
+		DefaultNamespace: NamespaceName,
+		PrintColumns:     []meta.PrintColumn{},
+	}
+}
+
+func init() {
+	proto.RegisterDefaultTypes()
+
+	err := protobuf.RegisterDynamic[NeighborSpecSpec](NeighborSpecType, &NeighborSpec{})
+	if err != nil {
+		panic(err)
+	}
+}
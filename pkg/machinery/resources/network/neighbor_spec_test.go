@@ -0,0 +1,54 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package network_test
+
+import (
+	"net"
+	"net/netip"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gopkg.in/yaml.v3"
+
+	"github.com/siderolabs/talos/pkg/machinery/nethelpers"
+	"github.com/siderolabs/talos/pkg/machinery/resources/network"
+)
+
+func TestNeighborSpecMarshalYAML(t *testing.T) {
+	mac, err := net.ParseMAC("02:00:00:00:00:01")
+	require.NoError(t, err)
+
+	spec := network.NeighborSpecSpec{
+		Family:       nethelpers.FamilyInet4,
+		Address:      netip.MustParseAddr("10.0.0.1"),
+		LinkName:     "eth0",
+		HardwareAddr: nethelpers.HardwareAddr(mac),
+		ConfigLayer:  network.ConfigMachineConfiguration,
+	}
+
+	marshaled, err := yaml.Marshal(spec)
+	require.NoError(t, err)
+
+	assert.Equal(t,
+		`family: inet4
+address: 10.0.0.1
+linkName: eth0
+hardwareAddr: "02:00:00:00:00:01"
+layer: configuration
+`,
+		string(marshaled))
+
+	var spec2 network.NeighborSpecSpec
+
+	require.NoError(t, yaml.Unmarshal(marshaled, &spec2))
+
+	assert.Equal(t, spec, spec2)
+}
+
+func TestNeighborID(t *testing.T) {
+	assert.Equal(t, "eth0/10.0.0.1", network.NeighborID("eth0", netip.MustParseAddr("10.0.0.1"), false))
+	assert.Equal(t, "eth0/proxy/2001:db8::1", network.NeighborID("eth0", netip.MustParseAddr("2001:db8::1"), true))
+}
@@ -0,0 +1,83 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package network
+
+import (
+	"net/netip"
+
+	"github.com/cosi-project/runtime/pkg/resource"
+	"github.com/cosi-project/runtime/pkg/resource/meta"
+	"github.com/cosi-project/runtime/pkg/resource/protobuf"
+	"github.com/cosi-project/runtime/pkg/resource/typed"
+
+	"github.com/siderolabs/talos/pkg/machinery/nethelpers"
+	"github.com/siderolabs/talos/pkg/machinery/proto"
+)
+
+// NeighborStatusType is type of NeighborStatus resource.
+const NeighborStatusType = resource.Type("NeighborStatuses.net.talos.dev")
+
+// NeighborStatus resource holds status of the neighbor (ARP/NDP) entry in the kernel.
+type NeighborStatus = typed.Resource[NeighborStatusSpec, NeighborStatusExtension]
+
+// NeighborStatusSpec describes status of the neighbor entry.
+//
+//gotagsrewrite:gen
+type NeighborStatusSpec struct {
+	Family       nethelpers.Family        `yaml:"family" protobuf:"1"`
+	Address      netip.Addr               `yaml:"address" protobuf:"2"`
+	LinkIndex    uint32                   `yaml:"linkIndex" protobuf:"3"`
+	LinkName     string                   `yaml:"linkName" protobuf:"4"`
+	HardwareAddr nethelpers.HardwareAddr  `yaml:"hardwareAddr,omitempty" protobuf:"5"`
+	State        nethelpers.NeighborState `yaml:"state" protobuf:"6"`
+	Proxy        bool                     `yaml:"proxy,omitempty" protobuf:"7"`
+}
+
+// NewNeighborStatus initializes a NeighborStatus resource.
+func NewNeighborStatus(namespace resource.Namespace, id resource.ID) *NeighborStatus {
+	return typed.NewResource[NeighborStatusSpec, NeighborStatusExtension](
+		resource.NewMetadata(namespace, NeighborStatusType, id, resource.VersionUndefined),
+		NeighborStatusSpec{},
+	)
+}
+
+// NeighborStatusExtension provides auxiliary methods for NeighborStatus.
+type NeighborStatusExtension struct{}
+
+// ResourceDefinition implements [typed.Extension] interface.
+func (NeighborStatusExtension) ResourceDefinition() meta.ResourceDefinitionSpec {
+	return meta.ResourceDefinitionSpec{
+		Type:             NeighborStatusType,
+		Aliases:          []resource.Type{"neighbor", "neighbors"},
+		DefaultNamespace: NamespaceName,
+		PrintColumns: []meta.PrintColumn{
+			{
+				Name:     "Address",
+				JSONPath: `{.address}`,
+			},
+			{
+				Name:     "Link",
+				JSONPath: `{.linkName}`,
+			},
+			{
+				Name:     "Hardware Address",
+				JSONPath: `{.hardwareAddr}`,
+			},
+			{
+				Name:     "State",
+				JSONPath: `{.state}`,
+			},
+		},
+	}
+}
+
+func init() {
+	proto.RegisterDefaultTypes()
+
+	err := protobuf.RegisterDynamic[NeighborStatusSpec](NeighborStatusType, &NeighborStatus{})
+	if err != nil {
+		panic(err)
+	}
+}
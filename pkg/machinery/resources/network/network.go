@@ -46,11 +46,30 @@ func RouteID(table nethelpers.RoutingTable, family nethelpers.Family, destinatio
 	return fmt.Sprintf("%s%s/%s/%s/%d", tablePrefix, family, string(gw), string(dst), priority)
 }
 
+// RoutingRuleID builds ID (primary key) for the policy routing rule.
+func RoutingRuleID(family nethelpers.Family, priority uint32) string {
+	return fmt.Sprintf("%s/%05d", family, priority)
+}
+
+// NeighborID builds ID (primary key) for the neighbor entry.
+func NeighborID(linkName string, addr netip.Addr, proxy bool) string {
+	if proxy {
+		return fmt.Sprintf("%s/proxy/%s", linkName, addr)
+	}
+
+	return fmt.Sprintf("%s/%s", linkName, addr)
+}
+
 // OperatorID builds ID (primary key) for the operators.
 func OperatorID(operator Operator, linkName string) string {
 	return fmt.Sprintf("%s/%s", operator, linkName)
 }
 
+// LLDPNeighborID builds ID (primary key) for the LLDP neighbor seen on the link.
+func LLDPNeighborID(linkName, chassisID, portID string) string {
+	return fmt.Sprintf("%s/%s/%s", linkName, chassisID, portID)
+}
+
 // LayeredID builds configuration for the entity at some layer.
 func LayeredID(layer ConfigLayer, id string) string {
 	return fmt.Sprintf("%s/%s", layer, id)
@@ -62,4 +81,8 @@ const (
 	LinkKindBond      = "bond"
 	LinkKindBridge    = "bridge"
 	LinkKindWireguard = "wireguard"
+	LinkKindVRF       = "vrf"
+	LinkKindVXLAN     = "vxlan"
+	LinkKindMACVLAN   = "macvlan"
+	LinkKindIPVLAN    = "ipvlan"
 )
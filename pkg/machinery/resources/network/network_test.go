@@ -27,12 +27,20 @@ func TestRegisterResource(t *testing.T) {
 	for _, resource := range []meta.ResourceWithRD{
 		&network.AddressStatus{},
 		&network.AddressSpec{},
+		&network.DNSResolveCache{},
+		&network.EthernetSpec{},
+		&network.EthernetStatus{},
 		&network.HardwareAddr{},
+		&network.HostDNSConfig{},
 		&network.HostnameStatus{},
 		&network.HostnameSpec{},
 		&network.LinkRefresh{},
 		&network.LinkStatus{},
 		&network.LinkSpec{},
+		&network.LLDPNeighbor{},
+		&network.NeighborSpec{},
+		&network.NeighborStatus{},
+		&network.NfTablesChain{},
 		&network.NodeAddress{},
 		&network.NodeAddressFilter{},
 		&network.OperatorSpec{},
@@ -41,9 +49,13 @@ func TestRegisterResource(t *testing.T) {
 		&network.ResolverSpec{},
 		&network.RouteStatus{},
 		&network.RouteSpec{},
+		&network.RoutingRuleStatus{},
+		&network.RoutingRuleSpec{},
 		&network.Status{},
 		&network.TimeServerStatus{},
 		&network.TimeServerSpec{},
+		&network.TrafficControlStatus{},
+		&network.TrafficControlSpec{},
 	} {
 		assert.NoError(t, resourceRegistry.Register(ctx, resource))
 	}
@@ -0,0 +1,143 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package network
+
+import (
+	"net/netip"
+
+	"github.com/cosi-project/runtime/pkg/resource"
+	"github.com/cosi-project/runtime/pkg/resource/meta"
+	"github.com/cosi-project/runtime/pkg/resource/protobuf"
+	"github.com/cosi-project/runtime/pkg/resource/typed"
+
+	"github.com/siderolabs/talos/pkg/machinery/nethelpers"
+	"github.com/siderolabs/talos/pkg/machinery/proto"
+)
+
+// NfTablesChainType is type of NfTablesChain resource.
+const NfTablesChainType = resource.Type("NfTablesChains.net.talos.dev")
+
+// NfTablesChain resource holds definition of the nftables chain.
+type NfTablesChain = typed.Resource[NfTablesChainSpec, NfTablesChainExtension]
+
+// NfTablesChainSpec describes nftables chain: base chain options and the list of rules.
+//
+//gotagsrewrite:gen
+type NfTablesChainSpec struct {
+	Hook     nethelpers.NfTablesChainHook     `yaml:"hook" protobuf:"1"`
+	Priority nethelpers.NfTablesChainPriority `yaml:"priority" protobuf:"2"`
+	Policy   nethelpers.NfTablesVerdict       `yaml:"policy" protobuf:"3"`
+
+	Rules []NfTablesRule `yaml:"rules" protobuf:"4"`
+}
+
+// NfTablesRule describes a single rule in the nftables chain.
+//
+// All the matches are combined with AND, the verdict is applied if all the matches are satisfied.
+//
+//gotagsrewrite:gen
+type NfTablesRule struct {
+	MatchIIfName        *NfTablesIfNameMatch         `yaml:"matchIIfName,omitempty" protobuf:"1"`
+	MatchSourceAddress  *NfTablesAddressMatch        `yaml:"matchSourceAddress,omitempty" protobuf:"2"`
+	MatchLayer4         *NfTablesLayer4Match         `yaml:"matchLayer4,omitempty" protobuf:"3"`
+	MatchConntrackState *NfTablesConntrackStateMatch `yaml:"matchConntrackState,omitempty" protobuf:"4"`
+
+	AnonCounter bool                       `yaml:"anonCounter,omitempty" protobuf:"5"`
+	Verdict     nethelpers.NfTablesVerdict `yaml:"verdict" protobuf:"6"`
+}
+
+// NfTablesIfNameMatch describes the match on the interface name.
+//
+//gotagsrewrite:gen
+type NfTablesIfNameMatch struct {
+	InterfaceName string `yaml:"interfaceName" protobuf:"1"`
+}
+
+// NfTablesAddressMatch describes the match on the IP address.
+//
+// The address matches if it is in any of the included subnets and not in any of the excluded subnets.
+//
+//gotagsrewrite:gen
+type NfTablesAddressMatch struct {
+	IncludeSubnets []netip.Prefix `yaml:"includeSubnets,omitempty" protobuf:"1"`
+	ExcludeSubnets []netip.Prefix `yaml:"excludeSubnets,omitempty" protobuf:"2"`
+}
+
+// NfTablesLayer4Match describes the match on the transport layer protocol.
+//
+//gotagsrewrite:gen
+type NfTablesLayer4Match struct {
+	Protocol             nethelpers.Protocol `yaml:"protocol" protobuf:"1"`
+	MatchDestinationPort *NfTablesPortMatch  `yaml:"matchDestinationPort,omitempty" protobuf:"2"`
+}
+
+// NfTablesPortMatch describes the match on the transport layer port.
+//
+//gotagsrewrite:gen
+type NfTablesPortMatch struct {
+	Ranges []PortRange `yaml:"ranges,omitempty" protobuf:"1"`
+}
+
+// PortRange describes a range of ports.
+//
+// Range is [lo, hi].
+//
+//gotagsrewrite:gen
+type PortRange struct {
+	Lo uint16 `yaml:"lo" protobuf:"1"`
+	Hi uint16 `yaml:"hi" protobuf:"2"`
+}
+
+// NfTablesConntrackStateMatch describes the match on the connection tracking state.
+//
+// The packet matches if its state is any of the listed states.
+//
+//gotagsrewrite:gen
+type NfTablesConntrackStateMatch struct {
+	States []nethelpers.ConntrackState `yaml:"states" protobuf:"1"`
+}
+
+// NewNfTablesChain initializes a NfTablesChain resource.
+func NewNfTablesChain(namespace resource.Namespace, id resource.ID) *NfTablesChain {
+	return typed.NewResource[NfTablesChainSpec, NfTablesChainExtension](
+		resource.NewMetadata(namespace, NfTablesChainType, id, resource.VersionUndefined),
+		NfTablesChainSpec{},
+	)
+}
+
+// NfTablesChainExtension provides auxiliary methods for NfTablesChain.
+type NfTablesChainExtension struct{}
+
+// ResourceDefinition implements [typed.Extension] interface.
+func (NfTablesChainExtension) ResourceDefinition() meta.ResourceDefinitionSpec {
+	return meta.ResourceDefinitionSpec{
+		Type:             NfTablesChainType,
+		Aliases:          []resource.Type{"chain", "chains"},
+		DefaultNamespace: NamespaceName,
+		PrintColumns: []meta.PrintColumn{
+			{
+				Name:     "Hook",
+				JSONPath: "{.hook}",
+			},
+			{
+				Name:     "Priority",
+				JSONPath: "{.priority}",
+			},
+			{
+				Name:     "Policy",
+				JSONPath: "{.policy}",
+			},
+		},
+	}
+}
+
+func init() {
+	proto.RegisterDefaultTypes()
+
+	err := protobuf.RegisterDynamic[NfTablesChainSpec](NfTablesChainType, &NfTablesChain{})
+	if err != nil {
+		panic(err)
+	}
+}
@@ -16,4 +16,5 @@ const (
 	OperatorDHCP4 Operator = iota // dhcp4
 	OperatorDHCP6                 // dhcp6
 	OperatorVIP                   // vip
+	OperatorRA                    // ra
 )
@@ -6,9 +6,9 @@ import (
 	"fmt"
 )
 
-const _OperatorName = "dhcp4dhcp6vip"
+const _OperatorName = "dhcp4dhcp6vipra"
 
-var _OperatorIndex = [...]uint8{0, 5, 10, 13}
+var _OperatorIndex = [...]uint8{0, 5, 10, 13, 15}
 
 func (i Operator) String() string {
 	if i < 0 || i >= Operator(len(_OperatorIndex)-1) {
@@ -17,12 +17,13 @@ func (i Operator) String() string {
 	return _OperatorName[_OperatorIndex[i]:_OperatorIndex[i+1]]
 }
 
-var _OperatorValues = []Operator{0, 1, 2}
+var _OperatorValues = []Operator{0, 1, 2, 3}
 
 var _OperatorNameToValueMap = map[string]Operator{
 	_OperatorName[0:5]:   0,
 	_OperatorName[5:10]:  1,
 	_OperatorName[10:13]: 2,
+	_OperatorName[13:15]: 3,
 }
 
 // OperatorString retrieves an enum value from the enum constants string name.
@@ -12,6 +12,7 @@ import (
 	"github.com/cosi-project/runtime/pkg/resource/protobuf"
 	"github.com/cosi-project/runtime/pkg/resource/typed"
 
+	"github.com/siderolabs/talos/pkg/machinery/nethelpers"
 	"github.com/siderolabs/talos/pkg/machinery/proto"
 )
 
@@ -34,14 +35,24 @@ type OperatorSpecSpec struct {
 	VIP   VIPOperatorSpec   `yaml:"vip,omitempty" protobuf:"6"`
 
 	ConfigLayer ConfigLayer `yaml:"layer" protobuf:"7"`
+
+	// RequireProbe is the ID of the probe which should succeed for the operator to run.
+	RequireProbe string `yaml:"requireProbe,omitempty" protobuf:"8"`
+
+	RA RAOperatorSpec `yaml:"ra,omitempty" protobuf:"9"`
 }
 
 // Equal implements equality check for OperatorSpecSpec.
 func (spec OperatorSpecSpec) Equal(other OperatorSpecSpec) bool {
 	// config layer is not important for equality check
-	spec.ConfigLayer = other.ConfigLayer
-
-	return spec == other
+	return spec.Operator == other.Operator &&
+		spec.LinkName == other.LinkName &&
+		spec.RequireUp == other.RequireUp &&
+		spec.DHCP4 == other.DHCP4 &&
+		spec.DHCP6 == other.DHCP6 &&
+		spec.VIP.Equal(other.VIP) &&
+		spec.RequireProbe == other.RequireProbe &&
+		spec.RA == other.RA
 }
 
 // DHCP4OperatorSpec describes DHCP4 operator options.
@@ -61,6 +72,17 @@ type DHCP6OperatorSpec struct {
 	SkipHostnameRequest bool   `yaml:"skipHostnameRequest,omitempty" protobuf:"3"`
 }
 
+// RAOperatorSpec describes IPv6 router advertisement operator options.
+//
+//gotagsrewrite:gen
+type RAOperatorSpec struct {
+	AcceptRA          bool                   `yaml:"acceptRA" protobuf:"1"`
+	Autoconf          bool                   `yaml:"autoconf" protobuf:"2"`
+	PrivacyExtensions bool                   `yaml:"privacyExtensions" protobuf:"3"`
+	AddrGenMode       nethelpers.AddrGenMode `yaml:"addrGenMode" protobuf:"4"`
+	RouteMetric       uint32                 `yaml:"routeMetric" protobuf:"5"`
+}
+
 // VIPOperatorSpec describes virtual IP operator options.
 //
 //gotagsrewrite:gen
@@ -70,6 +92,16 @@ type VIPOperatorSpec struct {
 
 	EquinixMetal VIPEquinixMetalSpec `yaml:"equinixMetal,omitempty" protobuf:"3"`
 	HCloud       VIPHCloudSpec       `yaml:"hcloud,omitempty" protobuf:"4"`
+	BGP          *VIPBGPSpec         `yaml:"bgp,omitempty" protobuf:"5"`
+}
+
+// Equal implements equality check for VIPOperatorSpec.
+func (spec VIPOperatorSpec) Equal(other VIPOperatorSpec) bool {
+	return spec.IP == other.IP &&
+		spec.GratuitousARP == other.GratuitousARP &&
+		spec.EquinixMetal == other.EquinixMetal &&
+		spec.HCloud == other.HCloud &&
+		spec.BGP.Equal(other.BGP)
 }
 
 // VIPEquinixMetalSpec describes virtual (elastic) IP settings for Equinix Metal.
@@ -90,6 +122,43 @@ type VIPHCloudSpec struct {
 	APIToken  string `yaml:"apiToken" protobuf:"3"`
 }
 
+// VIPBGPSpec describes settings for announcing the virtual IP over BGP.
+//
+//gotagsrewrite:gen
+type VIPBGPSpec struct {
+	LocalASN uint32           `yaml:"localASN" protobuf:"1"`
+	RouterID netip.Addr       `yaml:"routerID,omitempty" protobuf:"2"`
+	Peers    []VIPBGPPeerSpec `yaml:"peers" protobuf:"3"`
+}
+
+// Equal implements equality check for VIPBGPSpec.
+func (spec *VIPBGPSpec) Equal(other *VIPBGPSpec) bool {
+	if spec == nil || other == nil {
+		return spec == other
+	}
+
+	if spec.LocalASN != other.LocalASN || spec.RouterID != other.RouterID || len(spec.Peers) != len(other.Peers) {
+		return false
+	}
+
+	for i := range spec.Peers {
+		if spec.Peers[i] != other.Peers[i] {
+			return false
+		}
+	}
+
+	return true
+}
+
+// VIPBGPPeerSpec describes a BGP peer the virtual IP is announced to.
+//
+//gotagsrewrite:gen
+type VIPBGPPeerSpec struct {
+	Address  netip.Addr `yaml:"address" protobuf:"1"`
+	ASN      uint32     `yaml:"asn" protobuf:"2"`
+	Password string     `yaml:"password,omitempty" protobuf:"3"`
+}
+
 // NewOperatorSpec initializes a OperatorSpec resource.
 func NewOperatorSpec(namespace resource.Namespace, id resource.ID) *OperatorSpec {
 	return typed.NewResource[OperatorSpecSpec, OperatorSpecExtension](
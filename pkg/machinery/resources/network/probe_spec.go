@@ -30,21 +30,40 @@ type ProbeSpecSpec struct {
 	Interval time.Duration `yaml:"interval" protobuf:"1"`
 	// FailureThreshold is the number of consecutive failures for the probe to be considered failed after having succeeded.
 	FailureThreshold int `yaml:"failureThreshold" protobuf:"2"`
-	// One of the probe types should be specified, for now it's only TCP.
-	TCP TCPProbeSpec `yaml:"tcp,omitempty" protobuf:"3"`
+	// One of the probe types should be specified.
+	TCP  TCPProbeSpec  `yaml:"tcp,omitempty" protobuf:"3"`
+	ICMP ICMPProbeSpec `yaml:"icmp,omitempty" protobuf:"5"`
+	HTTP HTTPProbeSpec `yaml:"http,omitempty" protobuf:"6"`
+	DNS  DNSProbeSpec  `yaml:"dns,omitempty" protobuf:"7"`
 	// Configuration layer.
 	ConfigLayer ConfigLayer `yaml:"layer" protobuf:"4"`
 }
 
 // ID returns the ID of the resource based on the spec.
 func (spec *ProbeSpecSpec) ID() (resource.ID, error) {
-	var zeroTCP TCPProbeSpec
+	var (
+		zeroTCP  TCPProbeSpec
+		zeroICMP ICMPProbeSpec
+		zeroHTTP HTTPProbeSpec
+		zeroDNS  DNSProbeSpec
+	)
 
-	if spec.TCP == zeroTCP {
+	switch {
+	case spec.TCP != zeroTCP:
+		return fmt.Sprintf("tcp:%s", spec.TCP.Endpoint), nil
+	case spec.ICMP != zeroICMP:
+		return fmt.Sprintf("icmp:%s", spec.ICMP.Host), nil
+	case spec.HTTP != zeroHTTP:
+		return fmt.Sprintf("http:%s", spec.HTTP.URL), nil
+	case spec.DNS != zeroDNS:
+		if spec.DNS.Server != "" {
+			return fmt.Sprintf("dns:%s@%s", spec.DNS.Name, spec.DNS.Server), nil
+		}
+
+		return fmt.Sprintf("dns:%s", spec.DNS.Name), nil
+	default:
 		return "", fmt.Errorf("no probe type specified")
 	}
-
-	return fmt.Sprintf("tcp:%s", spec.TCP.Endpoint), nil
 }
 
 // Equal returns true if the specs are equal.
@@ -62,6 +81,40 @@ type TCPProbeSpec struct {
 	Timeout time.Duration `yaml:"timeout" protobuf:"2"`
 }
 
+// ICMPProbeSpec describes the ICMP echo Probe.
+//
+//gotagsrewrite:gen
+type ICMPProbeSpec struct {
+	// Host to probe: IP address or hostname.
+	Host string `yaml:"host" protobuf:"1"`
+	// Timeout for the probe.
+	Timeout time.Duration `yaml:"timeout" protobuf:"2"`
+}
+
+// HTTPProbeSpec describes the HTTP(S) GET Probe.
+//
+//gotagsrewrite:gen
+type HTTPProbeSpec struct {
+	// URL to probe.
+	URL string `yaml:"url" protobuf:"1"`
+	// ExpectedStatus is the expected HTTP response status code, defaults to 200.
+	ExpectedStatus int `yaml:"expectedStatus,omitempty" protobuf:"2"`
+	// Timeout for the probe.
+	Timeout time.Duration `yaml:"timeout" protobuf:"3"`
+}
+
+// DNSProbeSpec describes the DNS resolution Probe.
+//
+//gotagsrewrite:gen
+type DNSProbeSpec struct {
+	// Name to resolve.
+	Name string `yaml:"name" protobuf:"1"`
+	// Server to query: host:port, system resolvers are used if not set.
+	Server string `yaml:"server,omitempty" protobuf:"2"`
+	// Timeout for the probe.
+	Timeout time.Duration `yaml:"timeout" protobuf:"3"`
+}
+
 // NewProbeSpec initializes a ProbeSpec resource.
 func NewProbeSpec(namespace resource.Namespace, id resource.ID) *ProbeSpec {
 	return typed.NewResource[ProbeSpecSpec, ProbeSpecExtension](
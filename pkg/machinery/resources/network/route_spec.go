@@ -40,6 +40,9 @@ type RouteSpecSpec struct {
 	Protocol    nethelpers.RouteProtocol `yaml:"protocol" protobuf:"11"`
 	ConfigLayer ConfigLayer              `yaml:"layer" protobuf:"12"`
 	MTU         uint32                   `yaml:"mtu,omitempty" protobuf:"13"`
+
+	// RequireProbe is the ID of the probe which should succeed for the route to be installed.
+	RequireProbe string `yaml:"requireProbe,omitempty" protobuf:"14"`
 }
 
 var (
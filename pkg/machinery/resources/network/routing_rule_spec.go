@@ -0,0 +1,70 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package network
+
+import (
+	"net/netip"
+
+	"github.com/cosi-project/runtime/pkg/resource"
+	"github.com/cosi-project/runtime/pkg/resource/meta"
+	"github.com/cosi-project/runtime/pkg/resource/protobuf"
+	"github.com/cosi-project/runtime/pkg/resource/typed"
+
+	"github.com/siderolabs/talos/pkg/machinery/nethelpers"
+	"github.com/siderolabs/talos/pkg/machinery/proto"
+)
+
+// RoutingRuleSpecType is type of RoutingRuleSpec resource.
+const RoutingRuleSpecType = resource.Type("RoutingRuleSpecs.net.talos.dev")
+
+// RoutingRuleSpec resource holds policy routing rule specification to be applied to the kernel.
+type RoutingRuleSpec = typed.Resource[RoutingRuleSpecSpec, RoutingRuleSpecExtension]
+
+// RoutingRuleSpecSpec describes the policy routing rule.
+//
+//gotagsrewrite:gen
+type RoutingRuleSpecSpec struct {
+	Family      nethelpers.Family            `yaml:"family" protobuf:"1"`
+	Priority    uint32                       `yaml:"priority" protobuf:"2"`
+	Source      netip.Prefix                 `yaml:"src" protobuf:"3"`
+	Destination netip.Prefix                 `yaml:"dst" protobuf:"4"`
+	FwMark      uint32                       `yaml:"fwMark,omitempty" protobuf:"5"`
+	FwMask      uint32                       `yaml:"fwMask,omitempty" protobuf:"6"`
+	InLinkName  string                       `yaml:"iif,omitempty" protobuf:"7"`
+	OutLinkName string                       `yaml:"oif,omitempty" protobuf:"8"`
+	Action      nethelpers.RoutingRuleAction `yaml:"action" protobuf:"9"`
+	Table       nethelpers.RoutingTable      `yaml:"table" protobuf:"10"`
+	ConfigLayer ConfigLayer                  `yaml:"layer" protobuf:"11"`
+}
+
+// NewRoutingRuleSpec initializes a RoutingRuleSpec resource.
+func NewRoutingRuleSpec(namespace resource.Namespace, id resource.ID) *RoutingRuleSpec {
+	return typed.NewResource[RoutingRuleSpecSpec, RoutingRuleSpecExtension](
+		resource.NewMetadata(namespace, RoutingRuleSpecType, id, resource.VersionUndefined),
+		RoutingRuleSpecSpec{},
+	)
+}
+
+// RoutingRuleSpecExtension provides auxiliary methods for RoutingRuleSpec.
+type RoutingRuleSpecExtension struct{}
+
+// ResourceDefinition implements [typed.Extension] interface.
+func (RoutingRuleSpecExtension) ResourceDefinition() meta.ResourceDefinitionSpec {
+	return meta.ResourceDefinitionSpec{
+		Type:             RoutingRuleSpecType,
+		Aliases:          []resource.Type{},
+		DefaultNamespace: NamespaceName,
+		PrintColumns:     []meta.PrintColumn{},
+	}
+}
+
+func init() {
+	proto.RegisterDefaultTypes()
+
+	err := protobuf.RegisterDynamic[RoutingRuleSpecSpec](RoutingRuleSpecType, &RoutingRuleSpec{})
+	if err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,57 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package network_test
+
+import (
+	"net/netip"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gopkg.in/yaml.v3"
+
+	"github.com/siderolabs/talos/pkg/machinery/nethelpers"
+	"github.com/siderolabs/talos/pkg/machinery/resources/network"
+)
+
+func TestRoutingRuleSpecMarshalYAML(t *testing.T) {
+	spec := network.RoutingRuleSpecSpec{
+		Family:      nethelpers.FamilyInet4,
+		Priority:    1000,
+		Source:      netip.MustParsePrefix("10.0.0.0/24"),
+		Destination: netip.MustParsePrefix("192.168.0.0/16"),
+		FwMark:      0x20,
+		FwMask:      0xff,
+		InLinkName:  "eth0",
+		OutLinkName: "eth1",
+		Action:      nethelpers.RoutingRuleActionTable,
+		Table:       nethelpers.TableMain,
+		ConfigLayer: network.ConfigMachineConfiguration,
+	}
+
+	marshaled, err := yaml.Marshal(spec)
+	require.NoError(t, err)
+
+	assert.Equal(t,
+		`family: inet4
+priority: 1000
+src: 10.0.0.0/24
+dst: 192.168.0.0/16
+fwMark: 32
+fwMask: 255
+iif: eth0
+oif: eth1
+action: table
+table: main
+layer: configuration
+`,
+		string(marshaled))
+
+	var spec2 network.RoutingRuleSpecSpec
+
+	require.NoError(t, yaml.Unmarshal(marshaled, &spec2))
+
+	assert.Equal(t, spec, spec2)
+}
@@ -0,0 +1,151 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package network
+
+import (
+	"time"
+
+	"github.com/cosi-project/runtime/pkg/resource"
+	"github.com/cosi-project/runtime/pkg/resource/meta"
+	"github.com/cosi-project/runtime/pkg/resource/protobuf"
+	"github.com/cosi-project/runtime/pkg/resource/typed"
+
+	"github.com/siderolabs/talos/pkg/machinery/nethelpers"
+	"github.com/siderolabs/talos/pkg/machinery/proto"
+)
+
+// TrafficControlSpecType is type of TrafficControlSpec resource.
+const TrafficControlSpecType = resource.Type("TrafficControlSpecs.net.talos.dev")
+
+// TrafficControlSpec resource holds the traffic control (tc) settings of the link.
+//
+// TrafficControlSpec ID is the link name.
+type TrafficControlSpec = typed.Resource[TrafficControlSpecSpec, TrafficControlSpecExtension]
+
+// TrafficControlSpecSpec describes the root queueing discipline of the link, its classes and filters.
+//
+//gotagsrewrite:gen
+type TrafficControlSpecSpec struct {
+	LinkName    string                     `yaml:"linkName" protobuf:"1"`
+	Qdisc       nethelpers.QdiscKind       `yaml:"qdisc" protobuf:"2"`
+	FQCodel     TrafficControlFQCodelSpec  `yaml:"fqCodel,omitempty" protobuf:"3"`
+	HTB         TrafficControlHTBSpec      `yaml:"htb,omitempty" protobuf:"4"`
+	TBF         TrafficControlTBFSpec      `yaml:"tbf,omitempty" protobuf:"5"`
+	Filters     []TrafficControlFilterSpec `yaml:"filters,omitempty" protobuf:"6"`
+	ConfigLayer ConfigLayer                `yaml:"layer" protobuf:"7"`
+}
+
+// TrafficControlFQCodelSpec describes the fq_codel qdisc settings.
+//
+// Zero values keep the kernel defaults.
+//
+//gotagsrewrite:gen
+type TrafficControlFQCodelSpec struct {
+	Limit    uint32        `yaml:"limit,omitempty" protobuf:"1"`
+	Flows    uint32        `yaml:"flows,omitempty" protobuf:"2"`
+	Interval time.Duration `yaml:"interval,omitempty" protobuf:"3"`
+	ECN      bool          `yaml:"ecn" protobuf:"4"`
+}
+
+// TrafficControlHTBSpec describes the htb qdisc settings.
+//
+//gotagsrewrite:gen
+type TrafficControlHTBSpec struct {
+	DefaultClass uint16                       `yaml:"defaultClass,omitempty" protobuf:"1"`
+	Classes      []TrafficControlHTBClassSpec `yaml:"classes,omitempty" protobuf:"2"`
+}
+
+// TrafficControlHTBClassSpec describes a class of the htb qdisc.
+//
+// Rates are in bytes per second.
+//
+//gotagsrewrite:gen
+type TrafficControlHTBClassSpec struct {
+	ClassID  uint16 `yaml:"classID" protobuf:"1"`
+	Rate     uint64 `yaml:"rate" protobuf:"2"`
+	Ceil     uint64 `yaml:"ceil" protobuf:"3"`
+	Priority uint32 `yaml:"priority,omitempty" protobuf:"4"`
+}
+
+// TrafficControlTBFSpec describes the tbf qdisc settings.
+//
+// Rate is in bytes per second, burst is in bytes.
+//
+//gotagsrewrite:gen
+type TrafficControlTBFSpec struct {
+	Rate    uint64        `yaml:"rate" protobuf:"1"`
+	Burst   uint32        `yaml:"burst" protobuf:"2"`
+	Latency time.Duration `yaml:"latency" protobuf:"3"`
+}
+
+// TrafficControlFilterSpec describes a filter which classifies the traffic into a class of the root qdisc.
+//
+//gotagsrewrite:gen
+type TrafficControlFilterSpec struct {
+	Kind       nethelpers.TrafficControlFilterKind `yaml:"kind" protobuf:"1"`
+	ClassID    uint16                              `yaml:"classID" protobuf:"2"`
+	FwMark     uint32                              `yaml:"fwMark,omitempty" protobuf:"3"`
+	FwMask     uint32                              `yaml:"fwMask,omitempty" protobuf:"4"`
+	DSCP       uint8                               `yaml:"dscp,omitempty" protobuf:"5"`
+	CgroupPath string                              `yaml:"cgroupPath,omitempty" protobuf:"6"`
+}
+
+// Equal implements equality check for TrafficControlSpecSpec.
+func (spec *TrafficControlSpecSpec) Equal(other *TrafficControlSpecSpec) bool {
+	// config layer is not important for equality check
+	if spec.LinkName != other.LinkName ||
+		spec.Qdisc != other.Qdisc ||
+		spec.FQCodel != other.FQCodel ||
+		spec.TBF != other.TBF ||
+		spec.HTB.DefaultClass != other.HTB.DefaultClass ||
+		len(spec.HTB.Classes) != len(other.HTB.Classes) ||
+		len(spec.Filters) != len(other.Filters) {
+		return false
+	}
+
+	for i := range spec.HTB.Classes {
+		if spec.HTB.Classes[i] != other.HTB.Classes[i] {
+			return false
+		}
+	}
+
+	for i := range spec.Filters {
+		if spec.Filters[i] != other.Filters[i] {
+			return false
+		}
+	}
+
+	return true
+}
+
+// NewTrafficControlSpec initializes a TrafficControlSpec resource.
+func NewTrafficControlSpec(namespace resource.Namespace, id resource.ID) *TrafficControlSpec {
+	return typed.NewResource[TrafficControlSpecSpec, TrafficControlSpecExtension](
+		resource.NewMetadata(namespace, TrafficControlSpecType, id, resource.VersionUndefined),
+		TrafficControlSpecSpec{},
+	)
+}
+
+// TrafficControlSpecExtension provides auxiliary methods for TrafficControlSpec.
+type TrafficControlSpecExtension struct{}
+
+// ResourceDefinition implements [typed.Extension] interface.
+func (TrafficControlSpecExtension) ResourceDefinition() meta.ResourceDefinitionSpec {
+	return meta.ResourceDefinitionSpec{
+		Type:             TrafficControlSpecType,
+		Aliases:          []resource.Type{},
+		DefaultNamespace: NamespaceName,
+		PrintColumns:     []meta.PrintColumn{},
+	}
+}
+
+func init() {
+	proto.RegisterDefaultTypes()
+
+	err := protobuf.RegisterDynamic[TrafficControlSpecSpec](TrafficControlSpecType, &TrafficControlSpec{})
+	if err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,92 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package network
+
+import (
+	"github.com/cosi-project/runtime/pkg/resource"
+	"github.com/cosi-project/runtime/pkg/resource/meta"
+	"github.com/cosi-project/runtime/pkg/resource/protobuf"
+	"github.com/cosi-project/runtime/pkg/resource/typed"
+
+	"github.com/siderolabs/talos/pkg/machinery/proto"
+)
+
+// TrafficControlStatusType is type of TrafficControlStatus resource.
+const TrafficControlStatusType = resource.Type("TrafficControlStatuses.net.talos.dev")
+
+// TrafficControlStatus resource holds the traffic control (tc) state of the link in the kernel.
+//
+// TrafficControlStatus ID is the link name.
+type TrafficControlStatus = typed.Resource[TrafficControlStatusSpec, TrafficControlStatusExtension]
+
+// TrafficControlStatusSpec describes the qdiscs of the link, and the classes and filters of the root qdisc.
+//
+//gotagsrewrite:gen
+type TrafficControlStatusSpec struct {
+	Qdiscs  []TrafficControlQdiscStatus  `yaml:"qdiscs,omitempty" protobuf:"1"`
+	Classes []TrafficControlClassStatus  `yaml:"classes,omitempty" protobuf:"2"`
+	Filters []TrafficControlFilterStatus `yaml:"filters,omitempty" protobuf:"3"`
+}
+
+// TrafficControlQdiscStatus describes a qdisc of the link.
+//
+//gotagsrewrite:gen
+type TrafficControlQdiscStatus struct {
+	Kind   string `yaml:"kind" protobuf:"1"`
+	Handle string `yaml:"handle" protobuf:"2"`
+	Parent string `yaml:"parent" protobuf:"3"`
+}
+
+// TrafficControlClassStatus describes a class of the root qdisc.
+//
+// Rates are in bytes per second.
+//
+//gotagsrewrite:gen
+type TrafficControlClassStatus struct {
+	Kind   string `yaml:"kind" protobuf:"1"`
+	Handle string `yaml:"handle" protobuf:"2"`
+	Parent string `yaml:"parent" protobuf:"3"`
+	Rate   uint64 `yaml:"rate,omitempty" protobuf:"4"`
+	Ceil   uint64 `yaml:"ceil,omitempty" protobuf:"5"`
+}
+
+// TrafficControlFilterStatus describes a filter of the root qdisc.
+//
+//gotagsrewrite:gen
+type TrafficControlFilterStatus struct {
+	Kind     string `yaml:"kind" protobuf:"1"`
+	Priority uint32 `yaml:"priority" protobuf:"2"`
+	ClassID  string `yaml:"classID,omitempty" protobuf:"3"`
+}
+
+// NewTrafficControlStatus initializes a TrafficControlStatus resource.
+func NewTrafficControlStatus(namespace resource.Namespace, id resource.ID) *TrafficControlStatus {
+	return typed.NewResource[TrafficControlStatusSpec, TrafficControlStatusExtension](
+		resource.NewMetadata(namespace, TrafficControlStatusType, id, resource.VersionUndefined),
+		TrafficControlStatusSpec{},
+	)
+}
+
+// TrafficControlStatusExtension provides auxiliary methods for TrafficControlStatus.
+type TrafficControlStatusExtension struct{}
+
+// ResourceDefinition implements [typed.Extension] interface.
+func (TrafficControlStatusExtension) ResourceDefinition() meta.ResourceDefinitionSpec {
+	return meta.ResourceDefinitionSpec{
+		Type:             TrafficControlStatusType,
+		Aliases:          []resource.Type{"tc"},
+		DefaultNamespace: NamespaceName,
+		PrintColumns:     []meta.PrintColumn{},
+	}
+}
+
+func init() {
+	proto.RegisterDefaultTypes()
+
+	err := protobuf.RegisterDynamic[TrafficControlStatusSpec](TrafficControlStatusType, &TrafficControlStatus{})
+	if err != nil {
+		panic(err)
+	}
+}
@@ -7,6 +7,7 @@ package secrets
 import (
 	"net/netip"
 	"net/url"
+	"time"
 
 	"github.com/cosi-project/runtime/pkg/resource"
 	"github.com/cosi-project/runtime/pkg/resource/meta"
@@ -47,6 +48,10 @@ type KubernetesRootSpec struct {
 	BootstrapTokenSecret string `yaml:"bootstrapTokenSecret" protobuf:"12"`
 
 	SecretboxEncryptionSecret string `yaml:"secretboxEncryptionSecret" protobuf:"13"`
+
+	KMSEncryptionName     string        `yaml:"kmsEncryptionName,omitempty" protobuf:"15"`
+	KMSEncryptionEndpoint string        `yaml:"kmsEncryptionEndpoint,omitempty" protobuf:"16"`
+	KMSEncryptionTimeout  time.Duration `yaml:"kmsEncryptionTimeout,omitempty" protobuf:"17"`
 }
 
 // NewKubernetesRoot initializes a KubernetesRoot resource.
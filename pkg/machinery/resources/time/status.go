@@ -5,6 +5,8 @@
 package time
 
 import (
+	"time"
+
 	"github.com/cosi-project/runtime/pkg/resource"
 	"github.com/cosi-project/runtime/pkg/resource/meta"
 	"github.com/cosi-project/runtime/pkg/resource/protobuf"
@@ -38,6 +40,26 @@ type StatusSpec struct {
 
 	// SyncDisabled indicates if time sync is disabled.
 	SyncDisabled bool `yaml:"syncDisabled" protobuf:"3"`
+
+	// SyncServer is the time server which was last used to sync time.
+	SyncServer string `yaml:"syncServer,omitempty" protobuf:"4"`
+
+	// Authenticated indicates whether the sync server was authenticated via NTS.
+	Authenticated bool `yaml:"authenticated" protobuf:"5"`
+
+	// PTPClockIdentity is the clock identity of the PTP hardware clock used to sync time.
+	PTPClockIdentity string `yaml:"ptpClockIdentity,omitempty" protobuf:"6"`
+
+	// PTPOffset is the last measured offset of the PTP hardware clock relative to the system clock.
+	PTPOffset time.Duration `yaml:"ptpOffset,omitempty" protobuf:"7"`
+
+	// PTPReadDelay is the last measured delay of reading the PTP hardware clock.
+	PTPReadDelay time.Duration `yaml:"ptpReadDelay,omitempty" protobuf:"8"`
+
+	// PTPPathDelay is the mean path delay to the PTP master as reported by the PTP daemon (ptp4l).
+	//
+	// Talos doesn't ship ptp4l, so the path delay is not set unless the daemon is running (e.g. as an extension).
+	PTPPathDelay time.Duration `yaml:"ptpPathDelay,omitempty" protobuf:"9"`
 }
 
 // NewStatus initializes a TimeSync resource.
@@ -62,6 +84,14 @@ func (StatusExtension) ResourceDefinition() meta.ResourceDefinitionSpec {
 				Name:     "Synced",
 				JSONPath: "{.synced}",
 			},
+			{
+				Name:     "Server",
+				JSONPath: "{.syncServer}",
+			},
+			{
+				Name:     "Authenticated",
+				JSONPath: "{.authenticated}",
+			},
 		},
 	}
 }